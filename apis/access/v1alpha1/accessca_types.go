@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// AccessCAParameters are the configurable fields of an AccessCA.
+type AccessCAParameters struct {
+	// AccountID is the account the CA belongs to. Defaults to the account
+	// ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+}
+
+// AccessCAObservation are the observable fields of an AccessCA.
+type AccessCAObservation struct {
+	// ID of the CA.
+	ID string `json:"id,omitempty"`
+
+	// PublicKey of the CA in OpenSSH authorized_keys format. Servers trust
+	// certificates issued by the CA by listing it in TrustedUserCAKeys.
+	PublicKey string `json:"publicKey,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// An AccessCASpec defines the desired state of an AccessCA.
+type AccessCASpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessCAParameters `json:"forProvider,omitempty"`
+}
+
+// An AccessCAStatus represents the observed state of an AccessCA.
+type AccessCAStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessCAObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessCA is the SSH certificate authority of a Cloudflare account,
+// which issues the short-lived certificates users present to SSH targets
+// of Access for Infrastructure. Its public key is published in status and
+// as the publicKey connection detail, so that it can be distributed to
+// servers.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type AccessCA struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessCASpec   `json:"spec"`
+	Status AccessCAStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessCAList contains a list of AccessCA objects
+type AccessCAList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessCA `json:"items"`
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// AccessTagParameters are the configurable fields of an AccessTag.
+type AccessTagParameters struct {
+	// AccountID is the account the tag belongs to. Defaults to the account
+	// ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// Name of the tag, by which Access applications are tagged.
+	// +kubebuilder:validation:MinLength=1
+	// +immutable
+	Name string `json:"name"`
+}
+
+// AccessTagObservation are the observable fields of an AccessTag.
+type AccessTagObservation struct {
+	// AppCount is the number of Access applications with the tag.
+	AppCount int `json:"appCount,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// An AccessTagSpec defines the desired state of an AccessTag.
+type AccessTagSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessTagParameters `json:"forProvider"`
+}
+
+// An AccessTagStatus represents the observed state of an AccessTag.
+type AccessTagStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessTagObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessTag is a tag of a Cloudflare account that Access applications
+// are labelled with, to group them in the App Launcher. Tags are
+// identified by their name, so an existing tag is adopted.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TAG",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="APPS",type="integer",JSONPath=".status.atProvider.appCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type AccessTag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessTagSpec   `json:"spec"`
+	Status AccessTagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessTagList contains a list of AccessTag objects
+type AccessTagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessTag `json:"items"`
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// AppLauncherSettingsParameters are the configurable fields of an
+// AppLauncherSettings.
+type AppLauncherSettingsParameters struct {
+	// AccountID is the account of the Access application. Defaults to the
+	// account ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// ApplicationID is the ID of the Access application.
+	// +kubebuilder:validation:MinLength=1
+	// +immutable
+	ApplicationID string `json:"applicationId"`
+
+	// Visible shows the application in the App Launcher while true, and
+	// hides it while false. It is left alone while unset.
+	// +optional
+	Visible *bool `json:"visible,omitempty"`
+
+	// Tags are the names of the AccessTags the application is tagged with,
+	// replacing any other tags. They are left alone while unset.
+	// +listType=set
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// AppLauncherSettingsObservation are the observable fields of an
+// AppLauncherSettings.
+type AppLauncherSettingsObservation struct {
+	// Name of the Access application.
+	Name string `json:"name,omitempty"`
+
+	// Visible indicates whether the application is shown in the App
+	// Launcher.
+	Visible bool `json:"visible,omitempty"`
+
+	// Tags the application is tagged with.
+	Tags []string `json:"tags,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// An AppLauncherSettingsSpec defines the desired state of an
+// AppLauncherSettings.
+type AppLauncherSettingsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AppLauncherSettingsParameters `json:"forProvider"`
+}
+
+// An AppLauncherSettingsStatus represents the observed state of an
+// AppLauncherSettings.
+type AppLauncherSettingsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AppLauncherSettingsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AppLauncherSettings manages how an existing Access application is
+// presented in the App Launcher: whether it is visible, and the tags it is
+// grouped by. Other settings of the application are left alone. Deleting
+// it shows the application again and removes its tags.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPLICATION",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="VISIBLE",type="boolean",JSONPath=".status.atProvider.visible"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type AppLauncherSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppLauncherSettingsSpec   `json:"spec"`
+	Status AppLauncherSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppLauncherSettingsList contains a list of AppLauncherSettings objects
+type AppLauncherSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppLauncherSettings `json:"items"`
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "access.cloudflare.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// AccessCA type metadata.
+var (
+	AccessCAKind             = reflect.TypeOf(AccessCA{}).Name()
+	AccessCAGroupKind        = schema.GroupKind{Group: Group, Kind: AccessCAKind}.String()
+	AccessCAKindAPIVersion   = AccessCAKind + "." + SchemeGroupVersion.String()
+	AccessCAGroupVersionKind = SchemeGroupVersion.WithKind(AccessCAKind)
+)
+
+// ShortLivedCertificate type metadata.
+var (
+	ShortLivedCertificateKind             = reflect.TypeOf(ShortLivedCertificate{}).Name()
+	ShortLivedCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: ShortLivedCertificateKind}.String()
+	ShortLivedCertificateKindAPIVersion   = ShortLivedCertificateKind + "." + SchemeGroupVersion.String()
+	ShortLivedCertificateGroupVersionKind = SchemeGroupVersion.WithKind(ShortLivedCertificateKind)
+)
+
+// AccessTag type metadata.
+var (
+	AccessTagKind             = reflect.TypeOf(AccessTag{}).Name()
+	AccessTagGroupKind        = schema.GroupKind{Group: Group, Kind: AccessTagKind}.String()
+	AccessTagKindAPIVersion   = AccessTagKind + "." + SchemeGroupVersion.String()
+	AccessTagGroupVersionKind = SchemeGroupVersion.WithKind(AccessTagKind)
+)
+
+// AppLauncherSettings type metadata.
+var (
+	AppLauncherSettingsKind             = reflect.TypeOf(AppLauncherSettings{}).Name()
+	AppLauncherSettingsGroupKind        = schema.GroupKind{Group: Group, Kind: AppLauncherSettingsKind}.String()
+	AppLauncherSettingsKindAPIVersion   = AppLauncherSettingsKind + "." + SchemeGroupVersion.String()
+	AppLauncherSettingsGroupVersionKind = SchemeGroupVersion.WithKind(AppLauncherSettingsKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccessCA{}, &AccessCAList{})
+	SchemeBuilder.Register(&ShortLivedCertificate{}, &ShortLivedCertificateList{})
+	SchemeBuilder.Register(&AccessTag{}, &AccessTagList{})
+	SchemeBuilder.Register(&AppLauncherSettings{}, &AppLauncherSettingsList{})
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// ShortLivedCertificateParameters are the configurable fields of a
+// ShortLivedCertificate.
+type ShortLivedCertificateParameters struct {
+	// AccountID is the account of the Access application. Defaults to the
+	// account ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// ApplicationID is the ID of the self-hosted Access application
+	// protecting the SSH server.
+	// +kubebuilder:validation:MinLength=1
+	// +immutable
+	ApplicationID string `json:"applicationId"`
+}
+
+// ShortLivedCertificateObservation are the observable fields of a
+// ShortLivedCertificate.
+type ShortLivedCertificateObservation struct {
+	// ID of the CA issuing the certificates.
+	ID string `json:"id,omitempty"`
+
+	// AUD is the audience tag of the Access application.
+	AUD string `json:"aud,omitempty"`
+
+	// PublicKey of the CA in OpenSSH authorized_keys format. Servers trust
+	// certificates issued by the CA by listing it in TrustedUserCAKeys.
+	PublicKey string `json:"publicKey,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A ShortLivedCertificateSpec defines the desired state of a
+// ShortLivedCertificate.
+type ShortLivedCertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ShortLivedCertificateParameters `json:"forProvider"`
+}
+
+// A ShortLivedCertificateStatus represents the observed state of a
+// ShortLivedCertificate.
+type ShortLivedCertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ShortLivedCertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ShortLivedCertificate enables short-lived certificates for SSH via
+// Access on an Access application, by generating the CA that signs them.
+// Its public key is published in status and as the publicKey connection
+// detail, so that it can be distributed to servers. Deleting it deletes
+// the CA, after which servers no longer accept new certificates.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPLICATION",type="string",JSONPath=".spec.forProvider.applicationId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type ShortLivedCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ShortLivedCertificateSpec   `json:"spec"`
+	Status ShortLivedCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ShortLivedCertificateList contains a list of ShortLivedCertificate
+// objects
+type ShortLivedCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ShortLivedCertificate `json:"items"`
+}
@@ -0,0 +1,536 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCA) DeepCopyInto(out *AccessCA) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCA.
+func (in *AccessCA) DeepCopy() *AccessCA {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessCA) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCAList) DeepCopyInto(out *AccessCAList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessCA, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCAList.
+func (in *AccessCAList) DeepCopy() *AccessCAList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCAList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessCAList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCAObservation) DeepCopyInto(out *AccessCAObservation) {
+	*out = *in
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCAObservation.
+func (in *AccessCAObservation) DeepCopy() *AccessCAObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCAObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCAParameters) DeepCopyInto(out *AccessCAParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCAParameters.
+func (in *AccessCAParameters) DeepCopy() *AccessCAParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCAParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCASpec) DeepCopyInto(out *AccessCASpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCASpec.
+func (in *AccessCASpec) DeepCopy() *AccessCASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCAStatus) DeepCopyInto(out *AccessCAStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCAStatus.
+func (in *AccessCAStatus) DeepCopy() *AccessCAStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCAStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessTag) DeepCopyInto(out *AccessTag) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTag.
+func (in *AccessTag) DeepCopy() *AccessTag {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessTag) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessTagList) DeepCopyInto(out *AccessTagList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessTag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTagList.
+func (in *AccessTagList) DeepCopy() *AccessTagList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessTagList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessTagList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessTagObservation) DeepCopyInto(out *AccessTagObservation) {
+	*out = *in
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTagObservation.
+func (in *AccessTagObservation) DeepCopy() *AccessTagObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessTagObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessTagParameters) DeepCopyInto(out *AccessTagParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTagParameters.
+func (in *AccessTagParameters) DeepCopy() *AccessTagParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessTagParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessTagSpec) DeepCopyInto(out *AccessTagSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTagSpec.
+func (in *AccessTagSpec) DeepCopy() *AccessTagSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessTagSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessTagStatus) DeepCopyInto(out *AccessTagStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTagStatus.
+func (in *AccessTagStatus) DeepCopy() *AccessTagStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessTagStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppLauncherSettings) DeepCopyInto(out *AppLauncherSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppLauncherSettings.
+func (in *AppLauncherSettings) DeepCopy() *AppLauncherSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AppLauncherSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppLauncherSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppLauncherSettingsList) DeepCopyInto(out *AppLauncherSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AppLauncherSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppLauncherSettingsList.
+func (in *AppLauncherSettingsList) DeepCopy() *AppLauncherSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(AppLauncherSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppLauncherSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppLauncherSettingsObservation) DeepCopyInto(out *AppLauncherSettingsObservation) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppLauncherSettingsObservation.
+func (in *AppLauncherSettingsObservation) DeepCopy() *AppLauncherSettingsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AppLauncherSettingsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppLauncherSettingsParameters) DeepCopyInto(out *AppLauncherSettingsParameters) {
+	*out = *in
+	if in.Visible != nil {
+		in, out := &in.Visible, &out.Visible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppLauncherSettingsParameters.
+func (in *AppLauncherSettingsParameters) DeepCopy() *AppLauncherSettingsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AppLauncherSettingsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppLauncherSettingsSpec) DeepCopyInto(out *AppLauncherSettingsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppLauncherSettingsSpec.
+func (in *AppLauncherSettingsSpec) DeepCopy() *AppLauncherSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppLauncherSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppLauncherSettingsStatus) DeepCopyInto(out *AppLauncherSettingsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppLauncherSettingsStatus.
+func (in *AppLauncherSettingsStatus) DeepCopy() *AppLauncherSettingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AppLauncherSettingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShortLivedCertificate) DeepCopyInto(out *ShortLivedCertificate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShortLivedCertificate.
+func (in *ShortLivedCertificate) DeepCopy() *ShortLivedCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ShortLivedCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShortLivedCertificate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShortLivedCertificateList) DeepCopyInto(out *ShortLivedCertificateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShortLivedCertificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShortLivedCertificateList.
+func (in *ShortLivedCertificateList) DeepCopy() *ShortLivedCertificateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShortLivedCertificateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShortLivedCertificateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShortLivedCertificateObservation) DeepCopyInto(out *ShortLivedCertificateObservation) {
+	*out = *in
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShortLivedCertificateObservation.
+func (in *ShortLivedCertificateObservation) DeepCopy() *ShortLivedCertificateObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShortLivedCertificateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShortLivedCertificateParameters) DeepCopyInto(out *ShortLivedCertificateParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShortLivedCertificateParameters.
+func (in *ShortLivedCertificateParameters) DeepCopy() *ShortLivedCertificateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ShortLivedCertificateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShortLivedCertificateSpec) DeepCopyInto(out *ShortLivedCertificateSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShortLivedCertificateSpec.
+func (in *ShortLivedCertificateSpec) DeepCopy() *ShortLivedCertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShortLivedCertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShortLivedCertificateStatus) DeepCopyInto(out *ShortLivedCertificateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShortLivedCertificateStatus.
+func (in *ShortLivedCertificateStatus) DeepCopy() *ShortLivedCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShortLivedCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
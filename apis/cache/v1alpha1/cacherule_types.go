@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // CacheRuleParameters define the desired state of a Cloudflare Cache Rule
@@ -93,6 +95,8 @@ type CacheRuleParameters struct {
 
 	// AdditionalCacheablePorts specifies additional ports where content should be cached.
 	// +optional
+	// +kubebuilder:validation:items:Minimum=1
+	// +kubebuilder:validation:items:Maximum=65535
 	AdditionalCacheablePorts []int `json:"additionalCacheablePorts,omitempty"`
 
 	// ReadTimeout specifies the read timeout for origin requests in seconds.
@@ -105,10 +109,12 @@ type EdgeTTL struct {
 	// Mode controls how edge TTL is determined.
 	// Valid values: "respect_origin", "override_origin", "bypass"
 	// +required
+	// +kubebuilder:validation:Enum=respect_origin;override_origin;bypass
 	Mode string `json:"mode"`
 
 	// Default is the default TTL in seconds when mode is "override_origin".
 	// +optional
+	// +kubebuilder:validation:Minimum=0
 	Default *int `json:"default,omitempty"`
 
 	// StatusCodeTTL allows setting different TTLs based on origin response status codes.
@@ -121,6 +127,8 @@ type StatusCodeTTL struct {
 	// StatusCodeValue specifies a single status code (e.g., 200, 404).
 	// Either StatusCodeValue or StatusCodeRange must be specified.
 	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
 	StatusCodeValue *int `json:"statusCodeValue,omitempty"`
 
 	// StatusCodeRange specifies a range of status codes.
@@ -138,10 +146,14 @@ type StatusCodeTTL struct {
 type StatusCodeRange struct {
 	// From is the start of the status code range (inclusive).
 	// +required
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
 	From int `json:"from"`
 
 	// To is the end of the status code range (inclusive).
 	// +required
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
 	To int `json:"to"`
 }
 
@@ -150,10 +162,12 @@ type BrowserTTL struct {
 	// Mode controls how browser TTL is determined.
 	// Valid values: "respect_origin", "override_origin", "bypass"
 	// +required
+	// +kubebuilder:validation:Enum=respect_origin;override_origin;bypass
 	Mode string `json:"mode"`
 
 	// Default is the default TTL in seconds when mode is "override_origin".
 	// +optional
+	// +kubebuilder:validation:Minimum=0
 	Default *int `json:"default,omitempty"`
 }
 
@@ -311,6 +325,8 @@ type CacheRuleObservation struct {
 
 	// ModifiedOn is when the cache rule was last modified.
 	ModifiedOn *string `json:"modifiedOn,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // A CacheRuleSpec defines the desired state of a CacheRule.
@@ -181,6 +181,7 @@ func (in *CacheRuleObservation) DeepCopyInto(out *CacheRuleObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheRuleObservation.
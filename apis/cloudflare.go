@@ -20,13 +20,19 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	accessv1alpha1 "github.com/rossigee/provider-cloudflare/apis/access/v1alpha1"
 	cachev1alpha1 "github.com/rossigee/provider-cloudflare/apis/cache/v1alpha1"
+	datav1alpha1 "github.com/rossigee/provider-cloudflare/apis/data/v1alpha1"
 	dnsv1alpha1 "github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
 	emailroutingv1alpha1 "github.com/rossigee/provider-cloudflare/apis/emailrouting/v1alpha1"
 	firewallv1alpha1 "github.com/rossigee/provider-cloudflare/apis/firewall/v1alpha1"
 	loadbalancingv1alpha1 "github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1alpha1"
+	logpushv1alpha1 "github.com/rossigee/provider-cloudflare/apis/logpush/v1alpha1"
 	originsslv1alpha1 "github.com/rossigee/provider-cloudflare/apis/originssl/v1alpha1"
+	pagesv1alpha1 "github.com/rossigee/provider-cloudflare/apis/pages/v1alpha1"
 	r2v1alpha1 "github.com/rossigee/provider-cloudflare/apis/r2/v1alpha1"
+	registrarv1alpha1 "github.com/rossigee/provider-cloudflare/apis/registrar/v1alpha1"
 	rulesetsv1alpha1 "github.com/rossigee/provider-cloudflare/apis/rulesets/v1alpha1"
 	securityv1alpha1 "github.com/rossigee/provider-cloudflare/apis/security/v1alpha1"
 	spectrumv1alpha1 "github.com/rossigee/provider-cloudflare/apis/spectrum/v1alpha1"
@@ -35,7 +41,9 @@ import (
 	transformv1alpha1 "github.com/rossigee/provider-cloudflare/apis/transform/v1alpha1"
 	cloudflarev1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	workersv1alpha1 "github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+	workersv1beta1 "github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
 	zonev1alpha1 "github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	zonev1beta1 "github.com/rossigee/provider-cloudflare/apis/zone/v1beta1"
 )
 
 func init() {
@@ -57,6 +65,14 @@ func init() {
 		sslv1alpha1.SchemeBuilder.AddToScheme,
 		loadbalancingv1alpha1.SchemeBuilder.AddToScheme,
 		r2v1alpha1.SchemeBuilder.AddToScheme,
+		logpushv1alpha1.SchemeBuilder.AddToScheme,
+		registrarv1alpha1.SchemeBuilder.AddToScheme,
+		datav1alpha1.SchemeBuilder.AddToScheme,
+		accessv1alpha1.SchemeBuilder.AddToScheme,
+		pagesv1alpha1.SchemeBuilder.AddToScheme,
+		dnsv1beta1.SchemeBuilder.AddToScheme,
+		zonev1beta1.SchemeBuilder.AddToScheme,
+		workersv1beta1.SchemeBuilder.AddToScheme,
 	)
 }
 
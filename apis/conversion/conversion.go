@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion converts managed resources between API versions.
+package conversion
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Convert converts src to dst by round-tripping it through JSON, keeping the
+// type metadata of dst. It may only be used between versions whose fields
+// share the same JSON names and types, which holds for every version promoted
+// so far.
+func Convert(src, dst runtime.Object) error {
+	gvk := dst.GetObjectKind().GroupVersionKind()
+
+	b, err := json.Marshal(src)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal object to convert")
+	}
+	if err := json.Unmarshal(b, dst); err != nil {
+		return errors.Wrap(err, "cannot unmarshal converted object")
+	}
+
+	dst.GetObjectKind().SetGroupVersionKind(gvk)
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// AccountDetailsParameters are the configurable fields of an
+// AccountDetails.
+type AccountDetailsParameters struct {
+	// AccountID is the account whose details are observed. Defaults to the
+	// account ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// RefreshInterval is how often the details are read from Cloudflare.
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// AccountDetailsObservation are the observable fields of an
+// AccountDetails.
+type AccountDetailsObservation struct {
+	// ID of the account.
+	ID string `json:"id,omitempty"`
+
+	// Name of the account.
+	Name string `json:"name,omitempty"`
+
+	// Type of the account, standard or enterprise.
+	Type string `json:"type,omitempty"`
+
+	// CreatedOn is when the account was created.
+	CreatedOn *metav1.Time `json:"createdOn,omitempty"`
+
+	// EnforceTwoFactor is whether members of the account must use
+	// two-factor authentication.
+	EnforceTwoFactor bool `json:"enforceTwoFactor,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// An AccountDetailsSpec defines the desired state of an AccountDetails.
+type AccountDetailsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountDetailsParameters `json:"forProvider"`
+}
+
+// An AccountDetailsStatus represents the observed state of an
+// AccountDetails.
+type AccountDetailsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountDetailsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountDetails reflects the details of a Cloudflare account into its
+// status. It is observe-only: nothing is ever written to Cloudflare.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type AccountDetails struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountDetailsSpec   `json:"spec"`
+	Status AccountDetailsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountDetailsList contains a list of AccountDetails objects
+type AccountDetailsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountDetails `json:"items"`
+}
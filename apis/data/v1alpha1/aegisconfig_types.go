@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	zonev1alpha1 "github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+)
+
+// AegisConfigParameters are the configurable fields of an AegisConfig.
+type AegisConfigParameters struct {
+	// Zone is the ID of the zone whose Aegis configuration is observed.
+	// +immutable
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone whose Aegis configuration is observed.
+	// +immutable
+	// +optional
+	ZoneRef *xpv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone whose Aegis configuration is observed.
+	// +immutable
+	// +optional
+	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
+
+	// RefreshInterval is how often the configuration is read from
+	// Cloudflare.
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// AegisConfigObservation are the observable fields of an AegisConfig.
+type AegisConfigObservation struct {
+	// Enabled is whether the zone connects to its origins from the
+	// dedicated egress IPs of an Aegis pool.
+	Enabled bool `json:"enabled"`
+
+	// PoolID is the Aegis pool of dedicated egress IPs the zone uses. The
+	// addresses of a pool are assigned by Cloudflare when it is
+	// provisioned, and are not reported by the API.
+	PoolID string `json:"poolId,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// An AegisConfigSpec defines the desired state of an AegisConfig.
+type AegisConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AegisConfigParameters `json:"forProvider"`
+}
+
+// An AegisConfigStatus represents the observed state of an AegisConfig.
+type AegisConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AegisConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AegisConfig reflects the Aegis dedicated egress IP configuration of a
+// zone into its status, e.g. to allow only those IPs through an origin's
+// firewall. It is observe-only: nothing is ever written to Cloudflare.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="POOL",type="string",JSONPath=".status.atProvider.poolId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type AegisConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AegisConfigSpec   `json:"spec"`
+	Status AegisConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AegisConfigList contains a list of AegisConfig objects
+type AegisConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AegisConfig `json:"items"`
+}
+
+// ResolveReferences resolves references to the Zone whose Aegis
+// configuration this AegisConfig observes.
+func (a *AegisConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, a)
+
+	// Resolve spec.forProvider.zone
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(a.Spec.ForProvider.Zone),
+		Reference:    a.Spec.ForProvider.ZoneRef,
+		Selector:     a.Spec.ForProvider.ZoneSelector,
+		To:           reference.To{Managed: &zonev1alpha1.Zone{}, List: &zonev1alpha1.ZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zone")
+	}
+	a.Spec.ForProvider.Zone = reference.ToPtrValue(rsp.ResolvedValue)
+	a.Spec.ForProvider.ZoneRef = rsp.ResolvedReference
+
+	return nil
+}
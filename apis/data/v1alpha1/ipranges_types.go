@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// IPRangesParameters are the configurable fields of an IPRanges.
+type IPRangesParameters struct {
+	// JDCloud also observes the ranges of the JD Cloud network Cloudflare
+	// serves mainland China from.
+	// +optional
+	JDCloud *bool `json:"jdcloud,omitempty"`
+
+	// RefreshInterval is how often the ranges are read from Cloudflare.
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// IPRangesObservation are the observable fields of an IPRanges.
+type IPRangesObservation struct {
+	// IPv4CIDRs are the IPv4 ranges Cloudflare connects to origins from.
+	IPv4CIDRs []string `json:"ipv4Cidrs,omitempty"`
+
+	// IPv6CIDRs are the IPv6 ranges Cloudflare connects to origins from.
+	IPv6CIDRs []string `json:"ipv6Cidrs,omitempty"`
+
+	// JDCloudCIDRs are the ranges of the JD Cloud network, when requested.
+	JDCloudCIDRs []string `json:"jdcloudCidrs,omitempty"`
+
+	// ETag changes whenever the ranges change.
+	ETag string `json:"etag,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// An IPRangesSpec defines the desired state of an IPRanges.
+type IPRangesSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPRangesParameters `json:"forProvider,omitempty"`
+}
+
+// An IPRangesStatus represents the observed state of an IPRanges.
+type IPRangesStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IPRangesObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPRanges reflects the IP ranges of Cloudflare's network into its
+// status, e.g. to allow them through an origin's firewall. It is
+// observe-only: nothing is ever written to Cloudflare.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ETAG",type="string",JSONPath=".status.atProvider.etag"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type IPRanges struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPRangesSpec   `json:"spec"`
+	Status IPRangesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPRangesList contains a list of IPRanges objects
+type IPRangesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPRanges `json:"items"`
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetRefreshInterval of this AccountDetails.
+func (mg *AccountDetails) GetRefreshInterval() *metav1.Duration {
+	return mg.Spec.ForProvider.RefreshInterval
+}
+
+// GetRefreshInterval of this ZoneList.
+func (mg *ZoneList) GetRefreshInterval() *metav1.Duration {
+	return mg.Spec.ForProvider.RefreshInterval
+}
+
+// GetRefreshInterval of this IPRanges.
+func (mg *IPRanges) GetRefreshInterval() *metav1.Duration {
+	return mg.Spec.ForProvider.RefreshInterval
+}
+
+// GetRefreshInterval of this AegisConfig.
+func (mg *AegisConfig) GetRefreshInterval() *metav1.Duration {
+	return mg.Spec.ForProvider.RefreshInterval
+}
+
+// GetRefreshInterval of this TokenAudit.
+func (mg *TokenAudit) GetRefreshInterval() *metav1.Duration {
+	return mg.Spec.ForProvider.RefreshInterval
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "data.cloudflare.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// AccountDetails type metadata.
+var (
+	AccountDetailsKind             = reflect.TypeOf(AccountDetails{}).Name()
+	AccountDetailsGroupKind        = schema.GroupKind{Group: Group, Kind: AccountDetailsKind}.String()
+	AccountDetailsKindAPIVersion   = AccountDetailsKind + "." + SchemeGroupVersion.String()
+	AccountDetailsGroupVersionKind = SchemeGroupVersion.WithKind(AccountDetailsKind)
+)
+
+// ZoneList type metadata.
+var (
+	ZoneListKind             = reflect.TypeOf(ZoneList{}).Name()
+	ZoneListGroupKind        = schema.GroupKind{Group: Group, Kind: ZoneListKind}.String()
+	ZoneListKindAPIVersion   = ZoneListKind + "." + SchemeGroupVersion.String()
+	ZoneListGroupVersionKind = SchemeGroupVersion.WithKind(ZoneListKind)
+)
+
+// IPRanges type metadata.
+var (
+	IPRangesKind             = reflect.TypeOf(IPRanges{}).Name()
+	IPRangesGroupKind        = schema.GroupKind{Group: Group, Kind: IPRangesKind}.String()
+	IPRangesKindAPIVersion   = IPRangesKind + "." + SchemeGroupVersion.String()
+	IPRangesGroupVersionKind = SchemeGroupVersion.WithKind(IPRangesKind)
+)
+
+// AegisConfig type metadata.
+var (
+	AegisConfigKind             = reflect.TypeOf(AegisConfig{}).Name()
+	AegisConfigGroupKind        = schema.GroupKind{Group: Group, Kind: AegisConfigKind}.String()
+	AegisConfigKindAPIVersion   = AegisConfigKind + "." + SchemeGroupVersion.String()
+	AegisConfigGroupVersionKind = SchemeGroupVersion.WithKind(AegisConfigKind)
+)
+
+// TokenAudit type metadata.
+var (
+	TokenAuditKind             = reflect.TypeOf(TokenAudit{}).Name()
+	TokenAuditGroupKind        = schema.GroupKind{Group: Group, Kind: TokenAuditKind}.String()
+	TokenAuditKindAPIVersion   = TokenAuditKind + "." + SchemeGroupVersion.String()
+	TokenAuditGroupVersionKind = SchemeGroupVersion.WithKind(TokenAuditKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccountDetails{}, &AccountDetailsList{})
+	SchemeBuilder.Register(&ZoneList{}, &ZoneListList{})
+	SchemeBuilder.Register(&IPRanges{}, &IPRangesList{})
+	SchemeBuilder.Register(&AegisConfig{}, &AegisConfigList{})
+	SchemeBuilder.Register(&TokenAudit{}, &TokenAuditList{})
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// TokenOwner is who the API tokens audited by a TokenAudit belong to.
+type TokenOwner string
+
+// Owners of API tokens.
+const (
+	// TokenOwnerAccount audits the tokens owned by an account.
+	TokenOwnerAccount TokenOwner = "Account"
+
+	// TokenOwnerUser audits the tokens of the user the credentials belong
+	// to.
+	TokenOwnerUser TokenOwner = "User"
+)
+
+// TokenAuditParameters are the configurable fields of a TokenAudit.
+type TokenAuditParameters struct {
+	// AccountID is the account whose tokens are audited. Defaults to the
+	// account ID of the ProviderConfig when omitted. Ignored when owner is
+	// User.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// Owner selects the tokens owned by the account, or those of the user
+	// the credentials belong to. Listing account tokens requires the
+	// Account API Tokens Read permission, and listing user tokens the
+	// API Tokens Read user permission.
+	// +kubebuilder:validation:Enum=Account;User
+	// +kubebuilder:default=Account
+	// +optional
+	Owner TokenOwner `json:"owner,omitempty"`
+
+	// StaleAfter is how long a token may go unused before it is reported
+	// as stale. Tokens that were never used are stale once they were
+	// issued this long ago.
+	// +kubebuilder:default="2160h"
+	// +optional
+	StaleAfter *metav1.Duration `json:"staleAfter,omitempty"`
+
+	// RefreshInterval is how often the tokens are listed.
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// TokenSummary describes an API token of a TokenAudit.
+type TokenSummary struct {
+	// ID of the token.
+	ID string `json:"id"`
+
+	// Name of the token.
+	Name string `json:"name"`
+
+	// Status of the token, e.g. active, disabled or expired.
+	Status string `json:"status,omitempty"`
+
+	// IssuedOn is when the token was created.
+	IssuedOn *metav1.Time `json:"issuedOn,omitempty"`
+
+	// ModifiedOn is when the token was last changed.
+	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+
+	// ExpiresOn is when the token expires, if it does.
+	ExpiresOn *metav1.Time `json:"expiresOn,omitempty"`
+
+	// LastUsedOn is when the token was last used. It is unset for tokens
+	// that were never used.
+	LastUsedOn *metav1.Time `json:"lastUsedOn,omitempty"`
+
+	// Stale is whether the token has not been used for longer than
+	// staleAfter.
+	Stale bool `json:"stale,omitempty"`
+}
+
+// TokenAuditObservation are the observable fields of a TokenAudit.
+type TokenAuditObservation struct {
+	// Tokens are the audited API tokens, least recently used first.
+	Tokens []TokenSummary `json:"tokens,omitempty"`
+
+	// Count is the number of tokens listed.
+	Count int `json:"count"`
+
+	// StaleCount is the number of stale tokens.
+	StaleCount int `json:"staleCount"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A TokenAuditSpec defines the desired state of a TokenAudit.
+type TokenAuditSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TokenAuditParameters `json:"forProvider"`
+}
+
+// A TokenAuditStatus represents the observed state of a TokenAudit.
+type TokenAuditStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TokenAuditObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TokenAudit reflects the API tokens of a Cloudflare account or user,
+// and when they were last used, into its status, so that stale
+// credentials can be found from within Kubernetes. It is observe-only:
+// nothing is ever written to Cloudflare, and token values are never read.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TOKENS",type="integer",JSONPath=".status.atProvider.count"
+// +kubebuilder:printcolumn:name="STALE",type="integer",JSONPath=".status.atProvider.staleCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type TokenAudit struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TokenAuditSpec   `json:"spec"`
+	Status TokenAuditStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TokenAuditList contains a list of TokenAudit objects
+type TokenAuditList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TokenAudit `json:"items"`
+}
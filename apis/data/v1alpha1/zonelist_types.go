@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// ZoneListParameters are the configurable fields of a ZoneList.
+type ZoneListParameters struct {
+	// AccountID is the account whose zones are listed. Defaults to the
+	// account ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// Name only lists the zone with this domain name.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Status only lists zones with this status.
+	// +kubebuilder:validation:Enum=initializing;pending;active;moved
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// RefreshInterval is how often the zones are listed.
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// ZoneSummary describes a zone of a ZoneList.
+type ZoneSummary struct {
+	// ID of the zone.
+	ID string `json:"id"`
+
+	// Name is the domain name of the zone.
+	Name string `json:"name"`
+
+	// Status of the zone, e.g. active or pending.
+	Status string `json:"status,omitempty"`
+
+	// Type of the zone, e.g. full or partial.
+	Type string `json:"type,omitempty"`
+
+	// Paused is whether Cloudflare only serves DNS for the zone.
+	Paused bool `json:"paused,omitempty"`
+
+	// Plan is the name of the plan the zone is subscribed to.
+	Plan string `json:"plan,omitempty"`
+
+	// NameServers assigned to the zone by Cloudflare.
+	NameServers []string `json:"nameServers,omitempty"`
+}
+
+// ZoneListObservation are the observable fields of a ZoneList.
+type ZoneListObservation struct {
+	// Zones are the zones of the account matching the filters, sorted by
+	// name.
+	Zones []ZoneSummary `json:"zones,omitempty"`
+
+	// Count is the number of zones listed.
+	Count int `json:"count"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A ZoneListSpec defines the desired state of a ZoneList.
+type ZoneListSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ZoneListParameters `json:"forProvider"`
+}
+
+// A ZoneListStatus represents the observed state of a ZoneList.
+type ZoneListStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ZoneListObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ZoneList reflects the zones of a Cloudflare account, and the plans
+// they are subscribed to, into its status. It is observe-only: nothing is
+// ever written to Cloudflare.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONES",type="integer",JSONPath=".status.atProvider.count"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type ZoneList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ZoneListSpec   `json:"spec"`
+	Status ZoneListStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ZoneListList contains a list of ZoneList objects
+type ZoneListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ZoneList `json:"items"`
+}
@@ -0,0 +1,791 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountDetails) DeepCopyInto(out *AccountDetails) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountDetails.
+func (in *AccountDetails) DeepCopy() *AccountDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountDetails) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountDetailsList) DeepCopyInto(out *AccountDetailsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountDetails, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountDetailsList.
+func (in *AccountDetailsList) DeepCopy() *AccountDetailsList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountDetailsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountDetailsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountDetailsObservation) DeepCopyInto(out *AccountDetailsObservation) {
+	*out = *in
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = (*in).DeepCopy()
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountDetailsObservation.
+func (in *AccountDetailsObservation) DeepCopy() *AccountDetailsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountDetailsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountDetailsParameters) DeepCopyInto(out *AccountDetailsParameters) {
+	*out = *in
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountDetailsParameters.
+func (in *AccountDetailsParameters) DeepCopy() *AccountDetailsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountDetailsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountDetailsSpec) DeepCopyInto(out *AccountDetailsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountDetailsSpec.
+func (in *AccountDetailsSpec) DeepCopy() *AccountDetailsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountDetailsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountDetailsStatus) DeepCopyInto(out *AccountDetailsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountDetailsStatus.
+func (in *AccountDetailsStatus) DeepCopy() *AccountDetailsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountDetailsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AegisConfig) DeepCopyInto(out *AegisConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AegisConfig.
+func (in *AegisConfig) DeepCopy() *AegisConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AegisConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AegisConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AegisConfigList) DeepCopyInto(out *AegisConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AegisConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AegisConfigList.
+func (in *AegisConfigList) DeepCopy() *AegisConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AegisConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AegisConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AegisConfigObservation) DeepCopyInto(out *AegisConfigObservation) {
+	*out = *in
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AegisConfigObservation.
+func (in *AegisConfigObservation) DeepCopy() *AegisConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AegisConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AegisConfigParameters) DeepCopyInto(out *AegisConfigParameters) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AegisConfigParameters.
+func (in *AegisConfigParameters) DeepCopy() *AegisConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AegisConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AegisConfigSpec) DeepCopyInto(out *AegisConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AegisConfigSpec.
+func (in *AegisConfigSpec) DeepCopy() *AegisConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AegisConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AegisConfigStatus) DeepCopyInto(out *AegisConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AegisConfigStatus.
+func (in *AegisConfigStatus) DeepCopy() *AegisConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AegisConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRanges) DeepCopyInto(out *IPRanges) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRanges.
+func (in *IPRanges) DeepCopy() *IPRanges {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRanges)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPRanges) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRangesList) DeepCopyInto(out *IPRangesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPRanges, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRangesList.
+func (in *IPRangesList) DeepCopy() *IPRangesList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRangesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPRangesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRangesObservation) DeepCopyInto(out *IPRangesObservation) {
+	*out = *in
+	if in.IPv4CIDRs != nil {
+		in, out := &in.IPv4CIDRs, &out.IPv4CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CIDRs != nil {
+		in, out := &in.IPv6CIDRs, &out.IPv6CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JDCloudCIDRs != nil {
+		in, out := &in.JDCloudCIDRs, &out.JDCloudCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRangesObservation.
+func (in *IPRangesObservation) DeepCopy() *IPRangesObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRangesObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRangesParameters) DeepCopyInto(out *IPRangesParameters) {
+	*out = *in
+	if in.JDCloud != nil {
+		in, out := &in.JDCloud, &out.JDCloud
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRangesParameters.
+func (in *IPRangesParameters) DeepCopy() *IPRangesParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRangesParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRangesSpec) DeepCopyInto(out *IPRangesSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRangesSpec.
+func (in *IPRangesSpec) DeepCopy() *IPRangesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRangesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRangesStatus) DeepCopyInto(out *IPRangesStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRangesStatus.
+func (in *IPRangesStatus) DeepCopy() *IPRangesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRangesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenAudit) DeepCopyInto(out *TokenAudit) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenAudit.
+func (in *TokenAudit) DeepCopy() *TokenAudit {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenAudit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TokenAudit) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenAuditList) DeepCopyInto(out *TokenAuditList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TokenAudit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenAuditList.
+func (in *TokenAuditList) DeepCopy() *TokenAuditList {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenAuditList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TokenAuditList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenAuditObservation) DeepCopyInto(out *TokenAuditObservation) {
+	*out = *in
+	if in.Tokens != nil {
+		in, out := &in.Tokens, &out.Tokens
+		*out = make([]TokenSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenAuditObservation.
+func (in *TokenAuditObservation) DeepCopy() *TokenAuditObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenAuditObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenAuditParameters) DeepCopyInto(out *TokenAuditParameters) {
+	*out = *in
+	if in.StaleAfter != nil {
+		in, out := &in.StaleAfter, &out.StaleAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenAuditParameters.
+func (in *TokenAuditParameters) DeepCopy() *TokenAuditParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenAuditParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenAuditSpec) DeepCopyInto(out *TokenAuditSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenAuditSpec.
+func (in *TokenAuditSpec) DeepCopy() *TokenAuditSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenAuditSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenAuditStatus) DeepCopyInto(out *TokenAuditStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenAuditStatus.
+func (in *TokenAuditStatus) DeepCopy() *TokenAuditStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenAuditStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenSummary) DeepCopyInto(out *TokenSummary) {
+	*out = *in
+	if in.IssuedOn != nil {
+		in, out := &in.IssuedOn, &out.IssuedOn
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedOn != nil {
+		in, out := &in.ModifiedOn, &out.ModifiedOn
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresOn != nil {
+		in, out := &in.ExpiresOn, &out.ExpiresOn
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUsedOn != nil {
+		in, out := &in.LastUsedOn, &out.LastUsedOn
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenSummary.
+func (in *TokenSummary) DeepCopy() *TokenSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneList) DeepCopyInto(out *ZoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneList.
+func (in *ZoneList) DeepCopy() *ZoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneListList) DeepCopyInto(out *ZoneListList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ZoneList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneListList.
+func (in *ZoneListList) DeepCopy() *ZoneListList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneListList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneListList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneListObservation) DeepCopyInto(out *ZoneListObservation) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]ZoneSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneListObservation.
+func (in *ZoneListObservation) DeepCopy() *ZoneListObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneListObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneListParameters) DeepCopyInto(out *ZoneListParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneListParameters.
+func (in *ZoneListParameters) DeepCopy() *ZoneListParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneListParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneListSpec) DeepCopyInto(out *ZoneListSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneListSpec.
+func (in *ZoneListSpec) DeepCopy() *ZoneListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneListStatus) DeepCopyInto(out *ZoneListStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneListStatus.
+func (in *ZoneListStatus) DeepCopy() *ZoneListStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneListStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSummary) DeepCopyInto(out *ZoneSummary) {
+	*out = *in
+	if in.NameServers != nil {
+		in, out := &in.NameServers, &out.NameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSummary.
+func (in *ZoneSummary) DeepCopy() *ZoneSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSummary)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// DNSFirewallClusterParameters are the configurable fields of a
+// DNSFirewallCluster.
+type DNSFirewallClusterParameters struct {
+	// AccountID is the account the cluster belongs to. Defaults to the
+	// account ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// Name of the cluster.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=160
+	Name string `json:"name"`
+
+	// UpstreamIPs are the addresses of the authoritative nameservers
+	// queries are forwarded to.
+	// +kubebuilder:validation:MinItems=1
+	UpstreamIPs []string `json:"upstreamIps"`
+
+	// MinimumCacheTTL is the minimum time in seconds a response is
+	// cached for, regardless of its TTL.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=36000
+	// +optional
+	MinimumCacheTTL *int64 `json:"minimumCacheTtl,omitempty"`
+
+	// MaximumCacheTTL is the maximum time in seconds a response is
+	// cached for, regardless of its TTL.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=36000
+	// +optional
+	MaximumCacheTTL *int64 `json:"maximumCacheTtl,omitempty"`
+
+	// NegativeCacheTTL is the time in seconds NXDOMAIN and NODATA
+	// responses are cached for.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=36000
+	// +optional
+	NegativeCacheTTL *int64 `json:"negativeCacheTtl,omitempty"`
+
+	// RateLimit is the number of queries per second accepted from a
+	// single client before it is throttled.
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=1000000000
+	// +optional
+	RateLimit *int64 `json:"rateLimit,omitempty"`
+
+	// Retries is the number of times a query is retried against the
+	// upstream nameservers before giving up.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	Retries *int64 `json:"retries,omitempty"`
+
+	// DeprecateAnyRequests answers ANY queries with a minimal response
+	// instead of forwarding them upstream.
+	// +optional
+	DeprecateAnyRequests *bool `json:"deprecateAnyRequests,omitempty"`
+
+	// ECSFallback forwards the client's /24 subnet as EDNS Client Subnet
+	// when the query does not carry one.
+	// +optional
+	ECSFallback *bool `json:"ecsFallback,omitempty"`
+}
+
+// DNSFirewallClusterObservation are the observable fields of a
+// DNSFirewallCluster.
+type DNSFirewallClusterObservation struct {
+	// ID of the cluster.
+	ID string `json:"id,omitempty"`
+
+	// DNSFirewallIPs are the addresses Cloudflare assigned to the
+	// cluster. Point resolvers or NS records at these.
+	DNSFirewallIPs []string `json:"dnsFirewallIps,omitempty"`
+
+	// ModifiedOn is when the cluster was last modified.
+	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A DNSFirewallClusterSpec defines the desired state of a
+// DNSFirewallCluster.
+type DNSFirewallClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DNSFirewallClusterParameters `json:"forProvider"`
+}
+
+// A DNSFirewallClusterStatus represents the observed state of a
+// DNSFirewallCluster.
+type DNSFirewallClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DNSFirewallClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DNSFirewallCluster is a DNS Firewall cluster, a Cloudflare hosted
+// caching resolver in front of a set of upstream nameservers.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="IPS",type="string",JSONPath=".status.atProvider.dnsFirewallIps",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type DNSFirewallCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DNSFirewallClusterSpec   `json:"spec"`
+	Status DNSFirewallClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSFirewallClusterList contains a list of DNSFirewallCluster objects
+type DNSFirewallClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSFirewallCluster `json:"items"`
+}
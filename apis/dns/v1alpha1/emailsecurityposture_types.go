@@ -0,0 +1,245 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+)
+
+// SPFPolicy describes the SPF record published for a domain.
+type SPFPolicy struct {
+	// Includes are the domains of senders authorised to send mail for
+	// this domain, e.g. _spf.google.com.
+	// +optional
+	Includes []string `json:"includes,omitempty"`
+
+	// IP4 are IPv4 addresses or CIDR ranges authorised to send mail.
+	// +optional
+	// +kubebuilder:validation:items:Pattern=`^[0-9]{1,3}(\.[0-9]{1,3}){3}(/[0-9]{1,2})?$`
+	IP4 []string `json:"ip4,omitempty"`
+
+	// IP6 are IPv6 addresses or CIDR ranges authorised to send mail.
+	// +optional
+	// +kubebuilder:validation:items:Pattern=`^[0-9a-fA-F:.]+(/[0-9]{1,3})?$`
+	IP6 []string `json:"ip6,omitempty"`
+
+	// MX authorises the domain's MX hosts to send mail.
+	// +optional
+	MX *bool `json:"mx,omitempty"`
+
+	// All is the qualifier applied to senders that match no other
+	// mechanism.
+	// +kubebuilder:validation:Enum="-all";"~all";"?all"
+	// +kubebuilder:default="-all"
+	// +optional
+	All *string `json:"all,omitempty"`
+}
+
+// DKIMKey describes a DKIM public key published for a domain.
+type DKIMKey struct {
+	// Selector the key is published under, i.e. the record is created
+	// at <selector>._domainkey.<domain>.
+	// +kubebuilder:validation:MinLength=1
+	Selector string `json:"selector"`
+
+	// KeyType is the type of the public key.
+	// +kubebuilder:validation:Enum=rsa;ed25519
+	// +kubebuilder:default=rsa
+	// +optional
+	KeyType *string `json:"keyType,omitempty"`
+
+	// PublicKey is the base64 encoded public key.
+	// +kubebuilder:validation:MinLength=1
+	PublicKey string `json:"publicKey"`
+}
+
+// DMARCPolicy describes the DMARC record published for a domain.
+type DMARCPolicy struct {
+	// Policy applied to mail failing DMARC checks.
+	// +kubebuilder:validation:Enum=none;quarantine;reject
+	Policy string `json:"policy"`
+
+	// SubdomainPolicy applied to mail from subdomains failing DMARC
+	// checks. Defaults to Policy when unset.
+	// +kubebuilder:validation:Enum=none;quarantine;reject
+	// +optional
+	SubdomainPolicy *string `json:"subdomainPolicy,omitempty"`
+
+	// Percentage of failing mail the policy is applied to.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty"`
+
+	// AggregateReportAddresses receive aggregate (rua) reports.
+	// +optional
+	AggregateReportAddresses []string `json:"aggregateReportAddresses,omitempty"`
+
+	// ForensicReportAddresses receive forensic (ruf) reports.
+	// +optional
+	ForensicReportAddresses []string `json:"forensicReportAddresses,omitempty"`
+
+	// DKIMAlignment is the DKIM identifier alignment mode, r (relaxed)
+	// or s (strict).
+	// +kubebuilder:validation:Enum=r;s
+	// +optional
+	DKIMAlignment *string `json:"dkimAlignment,omitempty"`
+
+	// SPFAlignment is the SPF identifier alignment mode, r (relaxed)
+	// or s (strict).
+	// +kubebuilder:validation:Enum=r;s
+	// +optional
+	SPFAlignment *string `json:"spfAlignment,omitempty"`
+}
+
+// EmailSecurityPostureParameters are the configurable fields of an
+// EmailSecurityPosture.
+type EmailSecurityPostureParameters struct {
+	// Domain the email security records are published for, e.g.
+	// example.com or mail.example.com.
+	// +kubebuilder:validation:MaxLength=253
+	// +immutable
+	// +kubebuilder:validation:Format=hostname
+	Domain string `json:"domain"`
+
+	// SPF renders the SPF TXT record at the domain.
+	// +optional
+	SPF *SPFPolicy `json:"spf,omitempty"`
+
+	// DKIM renders a DKIM TXT record for each key.
+	// +optional
+	DKIM []DKIMKey `json:"dkim,omitempty"`
+
+	// DMARC renders the DMARC TXT record at _dmarc.<domain>.
+	// +optional
+	DMARC *DMARCPolicy `json:"dmarc,omitempty"`
+
+	// TTL of the rendered DNS Records.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+
+	// ZoneID the records are managed on.
+	// +immutable
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone object the records are managed on.
+	// +immutable
+	// +optional
+	ZoneRef *xpv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone object the records are managed on.
+	// +immutable
+	// +optional
+	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
+}
+
+// ManagedEmailRecord is a DNS Record managed by an EmailSecurityPosture.
+type ManagedEmailRecord struct {
+	// ID of the DNS Record.
+	ID string `json:"id,omitempty"`
+
+	// Name of the DNS Record.
+	Name string `json:"name"`
+
+	// Content of the DNS Record.
+	Content string `json:"content"`
+}
+
+// EmailSecurityPostureObservation are the observable fields of an
+// EmailSecurityPosture.
+type EmailSecurityPostureObservation struct {
+	// Records are the DNS Records currently managed for this domain.
+	Records []ManagedEmailRecord `json:"records,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// An EmailSecurityPostureSpec defines the desired state of an
+// EmailSecurityPosture.
+type EmailSecurityPostureSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EmailSecurityPostureParameters `json:"forProvider"`
+}
+
+// An EmailSecurityPostureStatus represents the observed state of an
+// EmailSecurityPosture.
+type EmailSecurityPostureStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EmailSecurityPostureObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EmailSecurityPosture renders and manages the SPF, DKIM and DMARC
+// records of a domain from structured parameters.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.domain"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type EmailSecurityPosture struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EmailSecurityPostureSpec   `json:"spec"`
+	Status EmailSecurityPostureStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EmailSecurityPostureList contains a list of EmailSecurityPosture objects
+type EmailSecurityPostureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EmailSecurityPosture `json:"items"`
+}
+
+// ResolveReferences resolves references to the Zone that the records of
+// this EmailSecurityPosture are managed on.
+func (esp *EmailSecurityPosture) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, esp)
+
+	// Resolve spec.forProvider.zone
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(esp.Spec.ForProvider.Zone),
+		Reference:    esp.Spec.ForProvider.ZoneRef,
+		Selector:     esp.Spec.ForProvider.ZoneSelector,
+		To:           reference.To{Managed: &v1alpha1.Zone{}, List: &v1alpha1.ZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zone")
+	}
+	esp.Spec.ForProvider.Zone = reference.ToPtrValue(rsp.ResolvedValue)
+	esp.Spec.ForProvider.ZoneRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -45,6 +45,33 @@ var (
 	RecordGroupVersionKind = SchemeGroupVersion.WithKind(RecordKind)
 )
 
+// EmailSecurityPosture type metadata.
+var (
+	EmailSecurityPostureKind             = reflect.TypeOf(EmailSecurityPosture{}).Name()
+	EmailSecurityPostureGroupKind        = schema.GroupKind{Group: Group, Kind: EmailSecurityPostureKind}.String()
+	EmailSecurityPostureKindAPIVersion   = EmailSecurityPostureKind + "." + SchemeGroupVersion.String()
+	EmailSecurityPostureGroupVersionKind = SchemeGroupVersion.WithKind(EmailSecurityPostureKind)
+)
+
+// DNSFirewallCluster type metadata.
+var (
+	DNSFirewallClusterKind             = reflect.TypeOf(DNSFirewallCluster{}).Name()
+	DNSFirewallClusterGroupKind        = schema.GroupKind{Group: Group, Kind: DNSFirewallClusterKind}.String()
+	DNSFirewallClusterKindAPIVersion   = DNSFirewallClusterKind + "." + SchemeGroupVersion.String()
+	DNSFirewallClusterGroupVersionKind = SchemeGroupVersion.WithKind(DNSFirewallClusterKind)
+)
+
+// ZoneBootstrap type metadata.
+var (
+	ZoneBootstrapKind             = reflect.TypeOf(ZoneBootstrap{}).Name()
+	ZoneBootstrapGroupKind        = schema.GroupKind{Group: Group, Kind: ZoneBootstrapKind}.String()
+	ZoneBootstrapKindAPIVersion   = ZoneBootstrapKind + "." + SchemeGroupVersion.String()
+	ZoneBootstrapGroupVersionKind = SchemeGroupVersion.WithKind(ZoneBootstrapKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Record{}, &RecordList{})
+	SchemeBuilder.Register(&EmailSecurityPosture{}, &EmailSecurityPostureList{})
+	SchemeBuilder.Register(&DNSFirewallCluster{}, &DNSFirewallClusterList{})
+	SchemeBuilder.Register(&ZoneBootstrap{}, &ZoneBootstrapList{})
 }
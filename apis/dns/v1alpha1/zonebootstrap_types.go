@@ -0,0 +1,210 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+)
+
+// BootstrapOrigin is the origin the apex of a zone points at.
+// +kubebuilder:validation:XValidation:rule="has(self.hostname) != (has(self.ipv4) || has(self.ipv6))",message="exactly one of hostname, or ipv4 and ipv6, must be specified"
+type BootstrapOrigin struct {
+	// Hostname of the origin. The apex is a CNAME to it, which Cloudflare
+	// flattens into A and AAAA records.
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Hostname *string `json:"hostname,omitempty"`
+
+	// IPv4 addresses of the origin, each published as an apex A record.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +optional
+	// +kubebuilder:validation:items:Format=ipv4
+	IPv4 []string `json:"ipv4,omitempty"`
+
+	// IPv6 addresses of the origin, each published as an apex AAAA record.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +optional
+	// +kubebuilder:validation:items:Format=ipv6
+	IPv6 []string `json:"ipv6,omitempty"`
+}
+
+// CAAPolicy describes the CAA records published at the apex of a zone.
+type CAAPolicy struct {
+	// Issuers are the certificate authorities allowed to issue
+	// certificates for the zone, e.g. letsencrypt.org.
+	// +listType=set
+	// +optional
+	Issuers []string `json:"issuers,omitempty"`
+
+	// WildcardIssuers are the certificate authorities allowed to issue
+	// wildcard certificates for the zone. Issuers may issue wildcard
+	// certificates when unset.
+	// +listType=set
+	// +optional
+	WildcardIssuers []string `json:"wildcardIssuers,omitempty"`
+
+	// IODEF is where certificate authorities report requests that violate
+	// the policy, e.g. mailto:security@example.com.
+	// +optional
+	IODEF *string `json:"iodef,omitempty"`
+}
+
+// ZoneBootstrapParameters are the configurable fields of a ZoneBootstrap.
+type ZoneBootstrapParameters struct {
+	// Origin the apex of the zone points at.
+	Origin BootstrapOrigin `json:"origin"`
+
+	// WWW publishes www as a CNAME to the apex.
+	// +kubebuilder:default=true
+	// +optional
+	WWW *bool `json:"www,omitempty"`
+
+	// Proxied sets whether the apex and www records are proxied through
+	// Cloudflare.
+	// +kubebuilder:default=true
+	// +optional
+	Proxied *bool `json:"proxied,omitempty"`
+
+	// CAA publishes CAA records at the apex.
+	// +optional
+	CAA *CAAPolicy `json:"caa,omitempty"`
+
+	// TTL of the records. 1 means automatic, and proxied records always
+	// use it.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+
+	// ZoneID the records are managed on.
+	// +immutable
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone object the records are managed on.
+	// +immutable
+	// +optional
+	ZoneRef *xpv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone object the records are managed on.
+	// +immutable
+	// +optional
+	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
+}
+
+// BootstrapRecord is a DNS Record managed by a ZoneBootstrap.
+type BootstrapRecord struct {
+	// ID of the DNS Record.
+	ID string `json:"id,omitempty"`
+
+	// Type of the DNS Record.
+	Type string `json:"type"`
+
+	// Name of the DNS Record.
+	Name string `json:"name"`
+
+	// Content of the DNS Record.
+	Content string `json:"content"`
+
+	// Proxied is true if the DNS Record is proxied through Cloudflare.
+	Proxied bool `json:"proxied,omitempty"`
+}
+
+// ZoneBootstrapObservation are the observable fields of a ZoneBootstrap.
+type ZoneBootstrapObservation struct {
+	// Domain is the name of the zone the records are published in.
+	Domain string `json:"domain,omitempty"`
+
+	// Records are the DNS Records currently managed for the zone.
+	Records []BootstrapRecord `json:"records,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A ZoneBootstrapSpec defines the desired state of a ZoneBootstrap.
+type ZoneBootstrapSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ZoneBootstrapParameters `json:"forProvider"`
+}
+
+// A ZoneBootstrapStatus represents the observed state of a ZoneBootstrap.
+type ZoneBootstrapStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ZoneBootstrapObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ZoneBootstrap publishes the canonical records of a zone, pointing its
+// apex and www at an origin and restricting certificate issuance with CAA
+// records, as one set.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".status.atProvider.domain"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type ZoneBootstrap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ZoneBootstrapSpec   `json:"spec"`
+	Status ZoneBootstrapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ZoneBootstrapList contains a list of ZoneBootstrap objects
+type ZoneBootstrapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ZoneBootstrap `json:"items"`
+}
+
+// ResolveReferences resolves references to the Zone that the records of
+// this ZoneBootstrap are managed on.
+func (zb *ZoneBootstrap) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, zb)
+
+	// Resolve spec.forProvider.zone
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(zb.Spec.ForProvider.Zone),
+		Reference:    zb.Spec.ForProvider.ZoneRef,
+		Selector:     zb.Spec.ForProvider.ZoneSelector,
+		To:           reference.To{Managed: &v1alpha1.Zone{}, List: &v1alpha1.ZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zone")
+	}
+	zb.Spec.ForProvider.Zone = reference.ToPtrValue(rsp.ResolvedValue)
+	zb.Spec.ForProvider.ZoneRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -25,6 +25,560 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapOrigin) DeepCopyInto(out *BootstrapOrigin) {
+	*out = *in
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapOrigin.
+func (in *BootstrapOrigin) DeepCopy() *BootstrapOrigin {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapOrigin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapRecord) DeepCopyInto(out *BootstrapRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapRecord.
+func (in *BootstrapRecord) DeepCopy() *BootstrapRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAAPolicy) DeepCopyInto(out *CAAPolicy) {
+	*out = *in
+	if in.Issuers != nil {
+		in, out := &in.Issuers, &out.Issuers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WildcardIssuers != nil {
+		in, out := &in.WildcardIssuers, &out.WildcardIssuers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IODEF != nil {
+		in, out := &in.IODEF, &out.IODEF
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAAPolicy.
+func (in *CAAPolicy) DeepCopy() *CAAPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CAAPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CERTRecordData) DeepCopyInto(out *CERTRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CERTRecordData.
+func (in *CERTRecordData) DeepCopy() *CERTRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(CERTRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DKIMKey) DeepCopyInto(out *DKIMKey) {
+	*out = *in
+	if in.KeyType != nil {
+		in, out := &in.KeyType, &out.KeyType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DKIMKey.
+func (in *DKIMKey) DeepCopy() *DKIMKey {
+	if in == nil {
+		return nil
+	}
+	out := new(DKIMKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DMARCPolicy) DeepCopyInto(out *DMARCPolicy) {
+	*out = *in
+	if in.SubdomainPolicy != nil {
+		in, out := &in.SubdomainPolicy, &out.SubdomainPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AggregateReportAddresses != nil {
+		in, out := &in.AggregateReportAddresses, &out.AggregateReportAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForensicReportAddresses != nil {
+		in, out := &in.ForensicReportAddresses, &out.ForensicReportAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DKIMAlignment != nil {
+		in, out := &in.DKIMAlignment, &out.DKIMAlignment
+		*out = new(string)
+		**out = **in
+	}
+	if in.SPFAlignment != nil {
+		in, out := &in.SPFAlignment, &out.SPFAlignment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DMARCPolicy.
+func (in *DMARCPolicy) DeepCopy() *DMARCPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DMARCPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSFirewallCluster) DeepCopyInto(out *DNSFirewallCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSFirewallCluster.
+func (in *DNSFirewallCluster) DeepCopy() *DNSFirewallCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSFirewallCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSFirewallCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSFirewallClusterList) DeepCopyInto(out *DNSFirewallClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSFirewallCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSFirewallClusterList.
+func (in *DNSFirewallClusterList) DeepCopy() *DNSFirewallClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSFirewallClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSFirewallClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSFirewallClusterObservation) DeepCopyInto(out *DNSFirewallClusterObservation) {
+	*out = *in
+	if in.DNSFirewallIPs != nil {
+		in, out := &in.DNSFirewallIPs, &out.DNSFirewallIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ModifiedOn != nil {
+		in, out := &in.ModifiedOn, &out.ModifiedOn
+		*out = (*in).DeepCopy()
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSFirewallClusterObservation.
+func (in *DNSFirewallClusterObservation) DeepCopy() *DNSFirewallClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSFirewallClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSFirewallClusterParameters) DeepCopyInto(out *DNSFirewallClusterParameters) {
+	*out = *in
+	if in.UpstreamIPs != nil {
+		in, out := &in.UpstreamIPs, &out.UpstreamIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinimumCacheTTL != nil {
+		in, out := &in.MinimumCacheTTL, &out.MinimumCacheTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaximumCacheTTL != nil {
+		in, out := &in.MaximumCacheTTL, &out.MaximumCacheTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NegativeCacheTTL != nil {
+		in, out := &in.NegativeCacheTTL, &out.NegativeCacheTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeprecateAnyRequests != nil {
+		in, out := &in.DeprecateAnyRequests, &out.DeprecateAnyRequests
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ECSFallback != nil {
+		in, out := &in.ECSFallback, &out.ECSFallback
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSFirewallClusterParameters.
+func (in *DNSFirewallClusterParameters) DeepCopy() *DNSFirewallClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSFirewallClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSFirewallClusterSpec) DeepCopyInto(out *DNSFirewallClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSFirewallClusterSpec.
+func (in *DNSFirewallClusterSpec) DeepCopy() *DNSFirewallClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSFirewallClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSFirewallClusterStatus) DeepCopyInto(out *DNSFirewallClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSFirewallClusterStatus.
+func (in *DNSFirewallClusterStatus) DeepCopy() *DNSFirewallClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSFirewallClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailSecurityPosture) DeepCopyInto(out *EmailSecurityPosture) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailSecurityPosture.
+func (in *EmailSecurityPosture) DeepCopy() *EmailSecurityPosture {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailSecurityPosture)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EmailSecurityPosture) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailSecurityPostureList) DeepCopyInto(out *EmailSecurityPostureList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EmailSecurityPosture, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailSecurityPostureList.
+func (in *EmailSecurityPostureList) DeepCopy() *EmailSecurityPostureList {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailSecurityPostureList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EmailSecurityPostureList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailSecurityPostureObservation) DeepCopyInto(out *EmailSecurityPostureObservation) {
+	*out = *in
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]ManagedEmailRecord, len(*in))
+		copy(*out, *in)
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailSecurityPostureObservation.
+func (in *EmailSecurityPostureObservation) DeepCopy() *EmailSecurityPostureObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailSecurityPostureObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailSecurityPostureParameters) DeepCopyInto(out *EmailSecurityPostureParameters) {
+	*out = *in
+	if in.SPF != nil {
+		in, out := &in.SPF, &out.SPF
+		*out = new(SPFPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DKIM != nil {
+		in, out := &in.DKIM, &out.DKIM
+		*out = make([]DKIMKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DMARC != nil {
+		in, out := &in.DMARC, &out.DMARC
+		*out = new(DMARCPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailSecurityPostureParameters.
+func (in *EmailSecurityPostureParameters) DeepCopy() *EmailSecurityPostureParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailSecurityPostureParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailSecurityPostureSpec) DeepCopyInto(out *EmailSecurityPostureSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailSecurityPostureSpec.
+func (in *EmailSecurityPostureSpec) DeepCopy() *EmailSecurityPostureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailSecurityPostureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailSecurityPostureStatus) DeepCopyInto(out *EmailSecurityPostureStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailSecurityPostureStatus.
+func (in *EmailSecurityPostureStatus) DeepCopy() *EmailSecurityPostureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailSecurityPostureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LOCRecordData) DeepCopyInto(out *LOCRecordData) {
+	*out = *in
+	if in.LatSeconds != nil {
+		in, out := &in.LatSeconds, &out.LatSeconds
+		*out = new(string)
+		**out = **in
+	}
+	if in.LongSeconds != nil {
+		in, out := &in.LongSeconds, &out.LongSeconds
+		*out = new(string)
+		**out = **in
+	}
+	if in.Altitude != nil {
+		in, out := &in.Altitude, &out.Altitude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrecisionHorz != nil {
+		in, out := &in.PrecisionHorz, &out.PrecisionHorz
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrecisionVert != nil {
+		in, out := &in.PrecisionVert, &out.PrecisionVert
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LOCRecordData.
+func (in *LOCRecordData) DeepCopy() *LOCRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(LOCRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedEmailRecord) DeepCopyInto(out *ManagedEmailRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedEmailRecord.
+func (in *ManagedEmailRecord) DeepCopy() *ManagedEmailRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedEmailRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Record) DeepCopyInto(out *Record) {
 	*out = *in
@@ -95,6 +649,7 @@ func (in *RecordObservation) DeepCopyInto(out *RecordObservation) {
 		in, out := &in.ModifiedOn, &out.ModifiedOn
 		*out = (*in).DeepCopy()
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordObservation.
@@ -125,6 +680,16 @@ func (in *RecordParameters) DeepCopyInto(out *RecordParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowProxiedPrivateTarget != nil {
+		in, out := &in.AllowProxiedPrivateTarget, &out.AllowProxiedPrivateTarget
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Priority != nil {
 		in, out := &in.Priority, &out.Priority
 		*out = new(int32)
@@ -140,11 +705,36 @@ func (in *RecordParameters) DeepCopyInto(out *RecordParameters) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.LOC != nil {
+		in, out := &in.LOC, &out.LOC
+		*out = new(LOCRecordData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CERT != nil {
+		in, out := &in.CERT, &out.CERT
+		*out = new(CERTRecordData)
+		**out = **in
+	}
+	if in.IgnoreChanges != nil {
+		in, out := &in.IgnoreChanges, &out.IgnoreChanges
+		*out = make([]RecordField, len(*in))
+		copy(*out, *in)
+	}
+	if in.TakeOwnership != nil {
+		in, out := &in.TakeOwnership, &out.TakeOwnership
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Zone != nil {
 		in, out := &in.Zone, &out.Zone
 		*out = new(string)
 		**out = **in
 	}
+	if in.ZoneName != nil {
+		in, out := &in.ZoneName, &out.ZoneName
+		*out = new(string)
+		**out = **in
+	}
 	if in.ZoneRef != nil {
 		in, out := &in.ZoneRef, &out.ZoneRef
 		*out = new(v1.Reference)
@@ -200,3 +790,208 @@ func (in *RecordStatus) DeepCopy() *RecordStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SPFPolicy) DeepCopyInto(out *SPFPolicy) {
+	*out = *in
+	if in.Includes != nil {
+		in, out := &in.Includes, &out.Includes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IP4 != nil {
+		in, out := &in.IP4, &out.IP4
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IP6 != nil {
+		in, out := &in.IP6, &out.IP6
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MX != nil {
+		in, out := &in.MX, &out.MX
+		*out = new(bool)
+		**out = **in
+	}
+	if in.All != nil {
+		in, out := &in.All, &out.All
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPFPolicy.
+func (in *SPFPolicy) DeepCopy() *SPFPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SPFPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneBootstrap) DeepCopyInto(out *ZoneBootstrap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneBootstrap.
+func (in *ZoneBootstrap) DeepCopy() *ZoneBootstrap {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneBootstrap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneBootstrap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneBootstrapList) DeepCopyInto(out *ZoneBootstrapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ZoneBootstrap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneBootstrapList.
+func (in *ZoneBootstrapList) DeepCopy() *ZoneBootstrapList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneBootstrapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneBootstrapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneBootstrapObservation) DeepCopyInto(out *ZoneBootstrapObservation) {
+	*out = *in
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]BootstrapRecord, len(*in))
+		copy(*out, *in)
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneBootstrapObservation.
+func (in *ZoneBootstrapObservation) DeepCopy() *ZoneBootstrapObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneBootstrapObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneBootstrapParameters) DeepCopyInto(out *ZoneBootstrapParameters) {
+	*out = *in
+	in.Origin.DeepCopyInto(&out.Origin)
+	if in.WWW != nil {
+		in, out := &in.WWW, &out.WWW
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Proxied != nil {
+		in, out := &in.Proxied, &out.Proxied
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CAA != nil {
+		in, out := &in.CAA, &out.CAA
+		*out = new(CAAPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneBootstrapParameters.
+func (in *ZoneBootstrapParameters) DeepCopy() *ZoneBootstrapParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneBootstrapParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneBootstrapSpec) DeepCopyInto(out *ZoneBootstrapSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneBootstrapSpec.
+func (in *ZoneBootstrapSpec) DeepCopy() *ZoneBootstrapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneBootstrapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneBootstrapStatus) DeepCopyInto(out *ZoneBootstrapStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneBootstrapStatus.
+func (in *ZoneBootstrapStatus) DeepCopy() *ZoneBootstrapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneBootstrapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
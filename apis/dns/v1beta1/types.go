@@ -0,0 +1,298 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// LOCRecordData describes the geographical location published by a LOC
+// record. Decimal values are given as strings, e.g. "12.345".
+type LOCRecordData struct {
+	// LatDegrees is the degrees of latitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=90
+	LatDegrees int32 `json:"latDegrees"`
+
+	// LatMinutes is the minutes of latitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	// +optional
+	LatMinutes int32 `json:"latMinutes,omitempty"`
+
+	// LatSeconds is the seconds of latitude, with up to three decimals.
+	// +kubebuilder:validation:Pattern=`^[0-5]?[0-9](\.[0-9]{1,3})?$`
+	// +optional
+	LatSeconds *string `json:"latSeconds,omitempty"`
+
+	// LatDirection is the latitude direction.
+	// +kubebuilder:validation:Enum=N;S
+	LatDirection string `json:"latDirection"`
+
+	// LongDegrees is the degrees of longitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=180
+	LongDegrees int32 `json:"longDegrees"`
+
+	// LongMinutes is the minutes of longitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	// +optional
+	LongMinutes int32 `json:"longMinutes,omitempty"`
+
+	// LongSeconds is the seconds of longitude, with up to three decimals.
+	// +kubebuilder:validation:Pattern=`^[0-5]?[0-9](\.[0-9]{1,3})?$`
+	// +optional
+	LongSeconds *string `json:"longSeconds,omitempty"`
+
+	// LongDirection is the longitude direction.
+	// +kubebuilder:validation:Enum=E;W
+	LongDirection string `json:"longDirection"`
+
+	// Altitude in meters, with up to two decimals.
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]{1,2})?$`
+	// +optional
+	Altitude *string `json:"altitude,omitempty"`
+
+	// Size of the location in meters, with up to two decimals.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]{1,2})?$`
+	// +optional
+	Size *string `json:"size,omitempty"`
+
+	// PrecisionHorz is the horizontal precision of the location in meters,
+	// with up to two decimals.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]{1,2})?$`
+	// +optional
+	PrecisionHorz *string `json:"precisionHorz,omitempty"`
+
+	// PrecisionVert is the vertical precision of the location in meters,
+	// with up to two decimals.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]{1,2})?$`
+	// +optional
+	PrecisionVert *string `json:"precisionVert,omitempty"`
+}
+
+// CERTRecordData describes the certificate published by a CERT record.
+type CERTRecordData struct {
+	// Type of the certificate, e.g. 1 for PKIX (X.509).
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Type int32 `json:"type"`
+
+	// KeyTag of the certificate.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	KeyTag int32 `json:"keyTag"`
+
+	// Algorithm of the certificate.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Algorithm int32 `json:"algorithm"`
+
+	// Certificate is the base64 encoded certificate or CRL.
+	// +kubebuilder:validation:MinLength=1
+	Certificate string `json:"certificate"`
+}
+
+// RecordParameters are the configurable fields of a DNS Record.
+// +kubebuilder:validation:XValidation:rule="has(self.zone) || has(self.zoneName) || has(self.zoneRef) || has(self.zoneSelector)",message="one of zone, zoneName, zoneRef or zoneSelector is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.loc) || (has(self.type) && self.type == 'LOC')",message="loc may only be set for LOC records"
+// +kubebuilder:validation:XValidation:rule="!has(self.cert) || (has(self.type) && self.type == 'CERT')",message="cert may only be set for CERT records"
+// +kubebuilder:validation:XValidation:rule="(has(self.content) && self.content != '') || has(self.loc) || has(self.cert)",message="content is required unless loc or cert is set"
+// +kubebuilder:validation:XValidation:rule="!(has(self.internal) && self.internal && has(self.proxied) && self.proxied)",message="internal records cannot be proxied"
+type RecordParameters struct {
+	// Type is the type of DNS Record.
+	// +kubebuilder:validation:Enum=A;AAAA;CAA;CNAME;TXT;SRV;LOC;MX;NS;SPF;CERT;DNSKEY;DS;NAPTR;SMIMEA;SSHFP;TLSA;URI
+	// +kubebuilder:default=A
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	// +immutable
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Name of the DNS Record.
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Content of the DNS Record. Not required for LOC and CERT records
+	// described by loc or cert.
+	// +optional
+	Content string `json:"content"`
+
+	// TTL of the DNS Record.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+
+	// Proxied enables or disables proxying traffic via Cloudflare.
+	// +optional
+	Proxied *bool `json:"proxied,omitempty"`
+
+	// Internal marks this record as only meant to be resolved by internal
+	// clients, e.g. one half of a split-horizon name. Internal records are
+	// never proxied, and are tagged visibility:internal so that they can be
+	// told apart from public ones in the dashboard.
+	// +optional
+	Internal *bool `json:"internal,omitempty"`
+
+	// AllowProxiedPrivateTarget permits proxying an A or AAAA record whose
+	// content is a private address, such as one in 10.0.0.0/8. Cloudflare
+	// cannot reach private addresses, so proxying one usually publishes an
+	// internal name by mistake, and is rejected unless this is set.
+	// +optional
+	AllowProxiedPrivateTarget *bool `json:"allowProxiedPrivateTarget,omitempty"`
+
+	// Priority of a record.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Weight for SRV records.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+
+	// Port for SRV records.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// LOC describes the location published by a LOC record, instead of
+	// encoding it in content.
+	// +optional
+	LOC *LOCRecordData `json:"loc,omitempty"`
+
+	// CERT describes the certificate published by a CERT record, instead
+	// of encoding it in content.
+	// +optional
+	CERT *CERTRecordData `json:"cert,omitempty"`
+
+	// IgnoreChanges lists fields that are set when the DNS Record is
+	// created, but whose later changes outside of this Record are left as
+	// they are rather than reverted, e.g. content rotated by external-dns or
+	// a dynamic DNS client.
+	// +optional
+	IgnoreChanges []RecordField `json:"ignoreChanges,omitempty"`
+
+	// TakeOwnership permits this Record to manage a DNS Record tagged as
+	// owned by another cluster, retagging it as owned by this one. It only
+	// has an effect when the ProviderConfig tracks ownership.
+	// +optional
+	TakeOwnership *bool `json:"takeOwnership,omitempty"`
+
+	// ZoneID this DNS Record is managed on.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zone is immutable"
+	// +immutable
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneName is the name of the zone this DNS Record is managed on, e.g.
+	// example.com. It is looked up to find the zone's ID when zone is not
+	// specified, so the same Record can be applied to accounts whose zones
+	// of that name have different IDs.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zoneName is immutable"
+	// +immutable
+	// +optional
+	ZoneName *string `json:"zoneName,omitempty"`
+
+	// ZoneRef references the Zone object this DNS Record is managed on.
+	// +immutable
+	// +optional
+	ZoneRef *xpv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone object this DNS Record is managed on.
+	// +immutable
+	// +optional
+	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
+}
+
+// A RecordField is a field of a DNS Record whose changes may be ignored.
+// +kubebuilder:validation:Enum=content;ttl;proxied;priority
+type RecordField string
+
+// RecordObservation is the observable fields of a DNS Record.
+type RecordObservation struct {
+	// Proxiable indicates whether this record _can be_ proxied
+	// via Cloudflare.
+	Proxiable bool `json:"proxiable,omitempty"`
+
+	// FQDN contains the full FQDN of the created record
+	// (Record Name + Zone).
+	FQDN string `json:"fqdn,omitempty"`
+
+	// Zone contains the name of the Zone this record
+	// is managed on.
+	Zone string `json:"zone,omitempty"`
+
+	// Locked indicates if this record is locked or not.
+	Locked bool `json:"locked,omitempty"`
+
+	// CreatedOn indicates when this record was created
+	// on Cloudflare.
+	CreatedOn *metav1.Time `json:"createdOn,omitempty"`
+
+	// ModifiedOn indicates when this record was modified
+	// on Cloudflare.
+	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A RecordSpec defines the desired state of a DNS Record.
+type RecordSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RecordParameters `json:"forProvider"`
+}
+
+// A RecordStatus represents the observed state of a DNS Record.
+type RecordStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RecordObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Record represents a single DNS Record managed on a Zone.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FQDN",type="string",JSONPath=".status.atProvider.fqdn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type Record struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RecordSpec   `json:"spec"`
+	Status RecordStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RecordList contains a list of DNS Record objects
+type RecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Record `json:"items"`
+}
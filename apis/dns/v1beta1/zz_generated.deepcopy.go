@@ -0,0 +1,298 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CERTRecordData) DeepCopyInto(out *CERTRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CERTRecordData.
+func (in *CERTRecordData) DeepCopy() *CERTRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(CERTRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LOCRecordData) DeepCopyInto(out *LOCRecordData) {
+	*out = *in
+	if in.LatSeconds != nil {
+		in, out := &in.LatSeconds, &out.LatSeconds
+		*out = new(string)
+		**out = **in
+	}
+	if in.LongSeconds != nil {
+		in, out := &in.LongSeconds, &out.LongSeconds
+		*out = new(string)
+		**out = **in
+	}
+	if in.Altitude != nil {
+		in, out := &in.Altitude, &out.Altitude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrecisionHorz != nil {
+		in, out := &in.PrecisionHorz, &out.PrecisionHorz
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrecisionVert != nil {
+		in, out := &in.PrecisionVert, &out.PrecisionVert
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LOCRecordData.
+func (in *LOCRecordData) DeepCopy() *LOCRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(LOCRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Record) DeepCopyInto(out *Record) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Record.
+func (in *Record) DeepCopy() *Record {
+	if in == nil {
+		return nil
+	}
+	out := new(Record)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Record) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordList) DeepCopyInto(out *RecordList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Record, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordList.
+func (in *RecordList) DeepCopy() *RecordList {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecordList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordObservation) DeepCopyInto(out *RecordObservation) {
+	*out = *in
+	if in.CreatedOn != nil {
+		in, out := &in.CreatedOn, &out.CreatedOn
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedOn != nil {
+		in, out := &in.ModifiedOn, &out.ModifiedOn
+		*out = (*in).DeepCopy()
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordObservation.
+func (in *RecordObservation) DeepCopy() *RecordObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordParameters) DeepCopyInto(out *RecordParameters) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Proxied != nil {
+		in, out := &in.Proxied, &out.Proxied
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowProxiedPrivateTarget != nil {
+		in, out := &in.AllowProxiedPrivateTarget, &out.AllowProxiedPrivateTarget
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LOC != nil {
+		in, out := &in.LOC, &out.LOC
+		*out = new(LOCRecordData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CERT != nil {
+		in, out := &in.CERT, &out.CERT
+		*out = new(CERTRecordData)
+		**out = **in
+	}
+	if in.IgnoreChanges != nil {
+		in, out := &in.IgnoreChanges, &out.IgnoreChanges
+		*out = make([]RecordField, len(*in))
+		copy(*out, *in)
+	}
+	if in.TakeOwnership != nil {
+		in, out := &in.TakeOwnership, &out.TakeOwnership
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneName != nil {
+		in, out := &in.ZoneName, &out.ZoneName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordParameters.
+func (in *RecordParameters) DeepCopy() *RecordParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSpec) DeepCopyInto(out *RecordSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSpec.
+func (in *RecordSpec) DeepCopy() *RecordSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordStatus) DeepCopyInto(out *RecordStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordStatus.
+func (in *RecordStatus) DeepCopy() *RecordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
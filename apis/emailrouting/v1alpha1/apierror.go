@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// SetLastAPIError of this DestinationAddress.
+func (mg *DestinationAddress) SetLastAPIError(e *pcv1alpha1.APIError) {
+	mg.Status.AtProvider.LastAPIError = e
+}
+
+// SetLastUpdateDiff of this DestinationAddress.
+func (mg *DestinationAddress) SetLastUpdateDiff(d []pcv1alpha1.FieldDiff) {
+	mg.Status.AtProvider.LastUpdateDiff = d
+}
+
+// SetLastAPIError of this Rule.
+func (mg *Rule) SetLastAPIError(e *pcv1alpha1.APIError) {
+	mg.Status.AtProvider.LastAPIError = e
+}
+
+// SetLastUpdateDiff of this Rule.
+func (mg *Rule) SetLastUpdateDiff(d []pcv1alpha1.FieldDiff) {
+	mg.Status.AtProvider.LastUpdateDiff = d
+}
+
+// SetLastAPIError of this Settings.
+func (mg *Settings) SetLastAPIError(e *pcv1alpha1.APIError) {
+	mg.Status.AtProvider.LastAPIError = e
+}
+
+// SetLastUpdateDiff of this Settings.
+func (mg *Settings) SetLastUpdateDiff(d []pcv1alpha1.FieldDiff) {
+	mg.Status.AtProvider.LastUpdateDiff = d
+}
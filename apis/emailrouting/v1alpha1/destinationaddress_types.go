@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// AnnotationKeyResendVerification requests that the verification email of
+// an unverified DestinationAddress is sent again whenever its value changes,
+// e.g. to the current time.
+const AnnotationKeyResendVerification = "cloudflare.crossplane.io/resend-verification"
+
+// DestinationAddressParameters are the configurable fields of an Email
+// Routing DestinationAddress.
+type DestinationAddressParameters struct {
+	// AccountID is the account the destination address belongs to.
+	// Defaults to the account ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// Email is the address that mail is forwarded to. Cloudflare sends it
+	// a verification email, and only forwards mail to it once verified.
+	// +kubebuilder:validation:Pattern=`^[^@\s]+@[^@\s]+$`
+	// +immutable
+	Email string `json:"email"`
+}
+
+// DestinationAddressObservation are the observable fields of an Email
+// Routing DestinationAddress.
+type DestinationAddressObservation struct {
+	// Tag is the identifier of the destination address.
+	Tag string `json:"tag,omitempty"`
+
+	// Email is the destination address.
+	Email string `json:"email,omitempty"`
+
+	// Verified is when the destination address was verified. It is unset
+	// while verification is pending.
+	Verified *metav1.Time `json:"verified,omitempty"`
+
+	// Created is when the destination address was created, i.e. when its
+	// latest verification email was sent.
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// Modified is when the destination address was last modified.
+	Modified *metav1.Time `json:"modified,omitempty"`
+
+	// LastVerificationRequest is the value of the resend-verification
+	// annotation the verification email was last sent again for.
+	LastVerificationRequest string `json:"lastVerificationRequest,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A DestinationAddressSpec defines the desired state of an Email Routing
+// DestinationAddress.
+type DestinationAddressSpec struct {
+	rtv1.ResourceSpec `json:",inline"`
+	ForProvider       DestinationAddressParameters `json:"forProvider"`
+}
+
+// A DestinationAddressStatus represents the observed state of an Email
+// Routing DestinationAddress.
+type DestinationAddressStatus struct {
+	rtv1.ResourceStatus `json:",inline"`
+	AtProvider          DestinationAddressObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DestinationAddress is an address Email Routing Rules of an account may
+// forward mail to. It is Ready once the address has been verified.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERIFIED",type="string",JSONPath=".status.conditions[?(@.type=='Verified')].status"
+// +kubebuilder:printcolumn:name="EMAIL",type="string",JSONPath=".spec.forProvider.email"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type DestinationAddress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DestinationAddressSpec   `json:"spec"`
+	Status DestinationAddressStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DestinationAddressList contains a list of DestinationAddress
+type DestinationAddressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DestinationAddress `json:"items"`
+}
+
+// DestinationAddress type metadata.
+var (
+	DestinationAddressKind             = "DestinationAddress"
+	DestinationAddressGroupKind        = schema.GroupKind{Group: Group, Kind: DestinationAddressKind}
+	DestinationAddressKindAPIVersion   = DestinationAddressKind + "." + GroupVersion.String()
+	DestinationAddressGroupVersionKind = GroupVersion.WithKind(DestinationAddressKind)
+)
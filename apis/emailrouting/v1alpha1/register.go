@@ -35,5 +35,7 @@ var (
 )
 
 func init() {
+	SchemeBuilder.Register(&DestinationAddress{}, &DestinationAddressList{})
 	SchemeBuilder.Register(&Rule{}, &RuleList{})
+	SchemeBuilder.Register(&Settings{}, &SettingsList{})
 }
\ No newline at end of file
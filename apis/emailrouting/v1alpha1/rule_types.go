@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // RuleParameters are the configurable fields of an Email Routing Rule.
@@ -53,24 +55,29 @@ type RuleParameters struct {
 	Actions []RuleAction `json:"actions"`
 }
 
-// RuleMatcher defines a condition for an email routing rule.
+// RuleMatcher defines a condition for an email routing rule. A matcher of
+// type "all" matches every message and takes no field or value; it is used
+// by catch-all rules.
+// +kubebuilder:validation:XValidation:rule="self.type == 'all' || (has(self.field) && has(self.value))",message="field and value are required for literal matchers"
+// +kubebuilder:validation:XValidation:rule="self.type != 'all' || (!has(self.field) && !has(self.value))",message="field and value must not be set for all matchers"
 type RuleMatcher struct {
 	// Type of matcher.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Enum=literal;all
 	Type string `json:"type"`
 
-	// Field to match against.
-	// +kubebuilder:validation:Required
+	// Field to match against. Required for literal matchers.
 	// +kubebuilder:validation:Enum=to;from;subject
-	Field string `json:"field"`
+	// +optional
+	Field string `json:"field,omitempty"`
 
-	// Value to match.
-	// +kubebuilder:validation:Required
-	Value string `json:"value"`
+	// Value to match. Required for literal matchers.
+	// +optional
+	Value string `json:"value,omitempty"`
 }
 
 // RuleAction defines an action for an email routing rule.
+// +kubebuilder:validation:XValidation:rule="self.type != 'forward' || (has(self.value) && size(self.value) > 0)",message="value is required for forward actions"
 type RuleAction struct {
 	// Type of action.
 	// +kubebuilder:validation:Required
@@ -80,9 +87,9 @@ type RuleAction struct {
 	// Value contains the action parameters.
 	// For "forward" actions, this should be email addresses.
 	// For "worker" actions, this should be worker script names.
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinItems=1
-	Value []string `json:"value"`
+	// "drop" actions take no value.
+	// +optional
+	Value []string `json:"value,omitempty"`
 }
 
 // RuleObservation are the observable fields of an Email Routing Rule.
@@ -107,6 +114,8 @@ type RuleObservation struct {
 
 	// Actions define what happens when the rule matches.
 	Actions []RuleAction `json:"actions,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // A RuleSpec defines the desired state of an Email Routing Rule.
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// SettingsParameters are the configurable fields of the Email Routing
+// Settings of a zone.
+type SettingsParameters struct {
+	// ZoneID is the zone identifier to target for the resource.
+	// +kubebuilder:validation:Required
+	// +immutable
+	ZoneID string `json:"zoneId"`
+
+	// Enabled indicates if Email Routing is enabled for the zone.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ManageDNSRecords creates the MX and TXT records Email Routing
+	// requires before it is enabled, and removes them once it is
+	// disabled or this resource is deleted. Existing records matching
+	// the required ones are adopted.
+	// +optional
+	ManageDNSRecords *bool `json:"manageDNSRecords,omitempty"`
+}
+
+// SettingsDNSRecord is a DNS Record managed for Email Routing.
+type SettingsDNSRecord struct {
+	// ID of the DNS Record.
+	ID string `json:"id,omitempty"`
+
+	// Type of the DNS Record.
+	Type string `json:"type"`
+
+	// Name of the DNS Record.
+	Name string `json:"name"`
+
+	// Content of the DNS Record.
+	Content string `json:"content"`
+
+	// Priority of the DNS Record, for MX records.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// SettingsObservation are the observable fields of the Email Routing
+// Settings of a zone.
+type SettingsObservation struct {
+	// Tag is the identifier of the Email Routing Settings.
+	Tag string `json:"tag,omitempty"`
+
+	// Name is the domain Email Routing is configured for.
+	Name string `json:"name,omitempty"`
+
+	// Enabled indicates if Email Routing is enabled for the zone.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Status of Email Routing, e.g. ready or misconfigured.
+	Status string `json:"status,omitempty"`
+
+	// DNSRecords are the DNS Records currently managed for Email
+	// Routing.
+	DNSRecords []SettingsDNSRecord `json:"dnsRecords,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A SettingsSpec defines the desired state of the Email Routing Settings
+// of a zone.
+type SettingsSpec struct {
+	rtv1.ResourceSpec `json:",inline"`
+	ForProvider       SettingsParameters `json:"forProvider"`
+}
+
+// A SettingsStatus represents the observed state of the Email Routing
+// Settings of a zone.
+type SettingsStatus struct {
+	rtv1.ResourceStatus `json:",inline"`
+	AtProvider          SettingsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Settings enables or disables Cloudflare Email Routing for a zone.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type Settings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SettingsSpec   `json:"spec"`
+	Status SettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SettingsList contains a list of Settings
+type SettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Settings `json:"items"`
+}
+
+// Settings type metadata.
+var (
+	SettingsKind             = "Settings"
+	SettingsGroupKind        = schema.GroupKind{Group: Group, Kind: SettingsKind}
+	SettingsKindAPIVersion   = SettingsKind + "." + GroupVersion.String()
+	SettingsGroupVersionKind = GroupVersion.WithKind(SettingsKind)
+)
@@ -24,6 +24,142 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationAddress) DeepCopyInto(out *DestinationAddress) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationAddress.
+func (in *DestinationAddress) DeepCopy() *DestinationAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DestinationAddress) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationAddressList) DeepCopyInto(out *DestinationAddressList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DestinationAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationAddressList.
+func (in *DestinationAddressList) DeepCopy() *DestinationAddressList {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationAddressList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DestinationAddressList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationAddressObservation) DeepCopyInto(out *DestinationAddressObservation) {
+	*out = *in
+	if in.Verified != nil {
+		in, out := &in.Verified, &out.Verified
+		*out = (*in).DeepCopy()
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.Modified != nil {
+		in, out := &in.Modified, &out.Modified
+		*out = (*in).DeepCopy()
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationAddressObservation.
+func (in *DestinationAddressObservation) DeepCopy() *DestinationAddressObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationAddressObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationAddressParameters) DeepCopyInto(out *DestinationAddressParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationAddressParameters.
+func (in *DestinationAddressParameters) DeepCopy() *DestinationAddressParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationAddressParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationAddressSpec) DeepCopyInto(out *DestinationAddressSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationAddressSpec.
+func (in *DestinationAddressSpec) DeepCopy() *DestinationAddressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationAddressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationAddressStatus) DeepCopyInto(out *DestinationAddressStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationAddressStatus.
+func (in *DestinationAddressStatus) DeepCopy() *DestinationAddressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationAddressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rule) DeepCopyInto(out *Rule) {
 	*out = *in
@@ -143,6 +279,7 @@ func (in *RuleObservation) DeepCopyInto(out *RuleObservation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleObservation.
@@ -220,3 +357,164 @@ func (in *RuleStatus) DeepCopy() *RuleStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Settings) DeepCopyInto(out *Settings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Settings.
+func (in *Settings) DeepCopy() *Settings {
+	if in == nil {
+		return nil
+	}
+	out := new(Settings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Settings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsDNSRecord) DeepCopyInto(out *SettingsDNSRecord) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsDNSRecord.
+func (in *SettingsDNSRecord) DeepCopy() *SettingsDNSRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsDNSRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsList) DeepCopyInto(out *SettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Settings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsList.
+func (in *SettingsList) DeepCopy() *SettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsObservation) DeepCopyInto(out *SettingsObservation) {
+	*out = *in
+	if in.DNSRecords != nil {
+		in, out := &in.DNSRecords, &out.DNSRecords
+		*out = make([]SettingsDNSRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsObservation.
+func (in *SettingsObservation) DeepCopy() *SettingsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsParameters) DeepCopyInto(out *SettingsParameters) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ManageDNSRecords != nil {
+		in, out := &in.ManageDNSRecords, &out.ManageDNSRecords
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsParameters.
+func (in *SettingsParameters) DeepCopy() *SettingsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsSpec) DeepCopyInto(out *SettingsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsSpec.
+func (in *SettingsSpec) DeepCopy() *SettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsStatus) DeepCopyInto(out *SettingsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsStatus.
+func (in *SettingsStatus) DeepCopy() *SettingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -25,6 +25,7 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
 
 	"github.com/pkg/errors"
@@ -61,7 +62,9 @@ type FilterParameters struct {
 }
 
 // FilterObservation is the observable fields of a Filter.
-type FilterObservation struct{}
+type FilterObservation struct {
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
 
 // A FilterSpec defines the desired state of a Filter.
 type FilterSpec struct {
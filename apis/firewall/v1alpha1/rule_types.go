@@ -25,6 +25,7 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	zone "github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
 
 	"github.com/pkg/errors"
@@ -99,7 +100,9 @@ type RuleParameters struct {
 }
 
 // RuleObservation is the observable fields of a Rule.
-type RuleObservation struct{}
+type RuleObservation struct {
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
 
 // A RuleSpec defines the desired state of a Rule.
 type RuleSpec struct {
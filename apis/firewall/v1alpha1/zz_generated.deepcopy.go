@@ -87,6 +87,7 @@ func (in *FilterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterObservation) DeepCopyInto(out *FilterObservation) {
 	*out = *in
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterObservation.
@@ -160,7 +161,7 @@ func (in *FilterSpec) DeepCopy() *FilterSpec {
 func (in *FilterStatus) DeepCopyInto(out *FilterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterStatus.
@@ -235,6 +236,7 @@ func (in *RuleList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleObservation) DeepCopyInto(out *RuleObservation) {
 	*out = *in
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleObservation.
@@ -333,7 +335,7 @@ func (in *RuleSpec) DeepCopy() *RuleSpec {
 func (in *RuleStatus) DeepCopyInto(out *RuleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleStatus.
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // LoadBalancerParameters define the desired state of a Cloudflare Load Balancer
@@ -86,8 +88,9 @@ type LoadBalancerParameters struct {
 	Enabled *bool `json:"enabled,omitempty"`
 
 	// SessionAffinity controls session stickiness.
-	// Valid values: "none", "cookie", "ip_cookie"
+	// Valid values: "none", "cookie", "ip_cookie", "header"
 	// +optional
+	// +kubebuilder:validation:Enum=none;cookie;ip_cookie;header
 	SessionAffinity *string `json:"sessionAffinity,omitempty"`
 
 	// SessionAffinityTTL is the TTL for session affinity in seconds.
@@ -118,6 +121,7 @@ type LoadBalancerParameters struct {
 	// Valid values: "off", "geo", "dynamic_latency", "random", "proximity", 
 	// "least_outstanding_requests", "least_connections"
 	// +optional
+	// +kubebuilder:validation:Enum=off;geo;dynamic_latency;random;proximity;least_outstanding_requests;least_connections
 	SteeringPolicy *string `json:"steeringPolicy,omitempty"`
 }
 
@@ -126,19 +130,24 @@ type SessionAffinityAttributes struct {
 	// SameSite controls the SameSite attribute for session affinity cookies.
 	// Valid values: "Auto", "Lax", "None", "Strict"
 	// +optional
+	// +kubebuilder:validation:Enum=Auto;Lax;None;Strict
 	SameSite *string `json:"sameSite,omitempty"`
 
 	// Secure indicates whether the session affinity cookie should be secure.
+	// Valid values: "Auto", "Always", "Never"
 	// +optional
+	// +kubebuilder:validation:Enum=Auto;Always;Never
 	Secure *string `json:"secure,omitempty"`
 
 	// DrainDuration is how long to honor session affinity when a pool becomes unhealthy.
 	// +optional
+	// +kubebuilder:validation:Minimum=0
 	DrainDuration *int `json:"drainDuration,omitempty"`
 
 	// ZeroDowntimeFailover controls zero-downtime failover.
 	// Valid values: "none", "sticky", "temporary"
 	// +optional
+	// +kubebuilder:validation:Enum=none;sticky;temporary
 	ZeroDowntimeFailover *string `json:"zeroDowntimeFailover,omitempty"`
 
 	// Headers contains headers for session affinity.
@@ -189,6 +198,8 @@ type LoadBalancerFixedResponse struct {
 
 	// StatusCode is the HTTP status code to return.
 	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
 	StatusCode *int `json:"statusCode,omitempty"`
 
 	// ContentType is the Content-Type header value.
@@ -204,6 +215,7 @@ type LoadBalancerFixedResponse struct {
 type LoadBalancerRuleOverrides struct {
 	// SessionAffinity overrides the session affinity setting.
 	// +optional
+	// +kubebuilder:validation:Enum=none;cookie;ip_cookie;header
 	SessionAffinity *string `json:"sessionAffinity,omitempty"`
 
 	// SessionAffinityTTL overrides the session affinity TTL.
@@ -220,6 +232,7 @@ type LoadBalancerRuleOverrides struct {
 
 	// SteeringPolicy overrides the steering policy.
 	// +optional
+	// +kubebuilder:validation:Enum=off;geo;dynamic_latency;random;proximity;least_outstanding_requests;least_connections
 	SteeringPolicy *string `json:"steeringPolicy,omitempty"`
 
 	// FallbackPool overrides the fallback pool.
@@ -278,6 +291,7 @@ type LocationStrategy struct {
 	// Mode determines how to get the client IP for location-based steering.
 	// Valid values: "pop", "resolver_ip"
 	// +optional
+	// +kubebuilder:validation:Enum=pop;resolver_ip
 	Mode *string `json:"mode,omitempty"`
 
 	// PreferECSRegion controls whether to prefer the ECS region.
@@ -295,6 +309,8 @@ type LoadBalancerObservation struct {
 
 	// ModifiedOn is when the load balancer was last modified.
 	ModifiedOn *string `json:"modifiedOn,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // LoadBalancerSpec defines the desired state of LoadBalancer
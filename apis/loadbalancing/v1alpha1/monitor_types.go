@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // LoadBalancerMonitorParameters define the desired state of a Cloudflare Load Balancer Monitor
@@ -37,6 +39,7 @@ type LoadBalancerMonitorParameters struct {
 	// Type is the protocol to use for the health check.
 	// Valid values: "http", "https", "tcp", "udp_icmp", "icmp_ping", "smtp"
 	// +required
+	// +kubebuilder:validation:Enum=http;https;tcp;udp_icmp;icmp_ping;smtp
 	Type string `json:"type"`
 
 	// Description is a human-readable description of the monitor.
@@ -46,6 +49,7 @@ type LoadBalancerMonitorParameters struct {
 	// Method is the HTTP method to use for the health check (only for http/https).
 	// Valid values: "GET", "HEAD"
 	// +optional
+	// +kubebuilder:validation:Enum=GET;HEAD
 	Method *string `json:"method,omitempty"`
 
 	// Path is the path to use for the health check (only for http/https).
@@ -59,33 +63,45 @@ type LoadBalancerMonitorParameters struct {
 	// Timeout is the timeout in seconds before marking the health check as failed.
 	// Valid range: 1-10 seconds.
 	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
 	Timeout *int `json:"timeout,omitempty"`
 
 	// Retries is the number of retries to attempt in case of a timeout before marking as failed.
 	// Valid range: 0-5 retries.
 	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=5
 	Retries *int `json:"retries,omitempty"`
 
 	// Interval is the interval in seconds between health checks.
 	// Valid range: 5-3600 seconds.
 	// +optional
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=3600
 	Interval *int `json:"interval,omitempty"`
 
 	// ConsecutiveUp is the number of consecutive successful health checks required
 	// before marking an origin as healthy.
 	// Valid range: 1-20.
 	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
 	ConsecutiveUp *int `json:"consecutiveUp,omitempty"`
 
 	// ConsecutiveDown is the number of consecutive failed health checks required
 	// before marking an origin as unhealthy.
 	// Valid range: 1-20.
 	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
 	ConsecutiveDown *int `json:"consecutiveDown,omitempty"`
 
 	// Port is the port to use for the health check.
 	// If not specified, defaults to 80 for HTTP and 443 for HTTPS.
 	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
 	Port *int `json:"port,omitempty"`
 
 	// ExpectedBody is the expected response body for the health check.
@@ -125,6 +141,8 @@ type LoadBalancerMonitorObservation struct {
 
 	// ModifiedOn is when the monitor was last modified.
 	ModifiedOn *string `json:"modifiedOn,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // LoadBalancerMonitorSpec defines the desired state of LoadBalancerMonitor
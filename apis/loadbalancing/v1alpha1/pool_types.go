@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // LoadBalancerPoolParameters define the desired state of a Cloudflare Load Balancer Pool
@@ -91,6 +93,7 @@ type LoadBalancerPoolParameters struct {
 	// Valid values: "WNAM", "ENAM", "WEU", "EEU", "NSAM", "SSAM", "OC", "ME", "NAF", "SAF", "IN", "SEAS", "NEAS"
 	// If empty, health checks will be performed from all regions.
 	// +optional
+	// +kubebuilder:validation:items:Enum=WNAM;ENAM;WEU;EEU;NSAM;SSAM;OC;ME;NAF;SAF;IN;SEAS;NEAS
 	CheckRegions []string `json:"checkRegions,omitempty"`
 }
 
@@ -127,6 +130,7 @@ type LoadBalancerOriginSteering struct {
 	// Policy determines the origin selection algorithm.
 	// Valid values: "random", "hash", "least_outstanding_requests", "least_connections"
 	// +optional
+	// +kubebuilder:validation:Enum=random;hash;least_outstanding_requests;least_connections
 	Policy *string `json:"policy,omitempty"`
 }
 
@@ -139,6 +143,7 @@ type LoadBalancerLoadShedding struct {
 	// DefaultPolicy is the default load shedding policy.
 	// Valid values: "random", "hash"
 	// +optional
+	// +kubebuilder:validation:Enum=random;hash
 	DefaultPolicy *string `json:"defaultPolicy,omitempty"`
 
 	// SessionPercent is the load shedding percentage for session affinity.
@@ -148,6 +153,7 @@ type LoadBalancerLoadShedding struct {
 	// SessionPolicy is the load shedding policy for session affinity.
 	// Valid values: "random", "hash"
 	// +optional
+	// +kubebuilder:validation:Enum=random;hash
 	SessionPolicy *string `json:"sessionPolicy,omitempty"`
 }
 
@@ -164,6 +170,8 @@ type LoadBalancerPoolObservation struct {
 
 	// Healthy indicates whether the pool is currently healthy.
 	Healthy *bool `json:"healthy,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // LoadBalancerPoolSpec defines the desired state of LoadBalancerPool
@@ -246,6 +246,7 @@ func (in *LoadBalancerMonitorObservation) DeepCopyInto(out *LoadBalancerMonitorO
 		*out = new(string)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerMonitorObservation.
@@ -416,6 +417,7 @@ func (in *LoadBalancerObservation) DeepCopyInto(out *LoadBalancerObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerObservation.
@@ -733,6 +735,7 @@ func (in *LoadBalancerPoolObservation) DeepCopyInto(out *LoadBalancerPoolObserva
 		*out = new(bool)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPoolObservation.
@@ -17,10 +17,18 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
+
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	zonev1alpha1 "github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
 )
 
 // JobParameters are the configurable fields of a Logpush Job.
@@ -29,18 +37,46 @@ type JobParameters struct {
 	// +kubebuilder:validation:Required
 	Dataset string `json:"dataset"`
 
+	// Zone is the ID of the zone whose logs are pushed. Zone datasets, such
+	// as http_requests and firewall_events, require a zone. Account
+	// datasets, such as audit_logs, gateway_dns, gateway_http and
+	// access_requests, are pushed by the account and must not set one.
+	// +kubebuilder:validation:Optional
+	// +immutable
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone whose logs are pushed.
+	// +kubebuilder:validation:Optional
+	// +immutable
+	ZoneRef *rtv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone whose logs are pushed.
+	// +kubebuilder:validation:Optional
+	// +immutable
+	ZoneSelector *rtv1.Selector `json:"zoneSelector,omitempty"`
+
 	// Enabled indicates if the logpush job is enabled.
 	// +kubebuilder:validation:Optional
 	Enabled *bool `json:"enabled,omitempty"`
 
-	// Kind is the logpush job type.
+	// Kind is the logpush job type. Set it to edge to deliver logs
+	// directly from Cloudflare's edge (Edge Log Delivery) rather than
+	// through the regular Logpush pipeline. The kind of a job cannot be
+	// changed once it is created; see AllowRecreate.
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum="";edge
 	Kind *string `json:"kind,omitempty"`
 
 	// Name of the logpush job.
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
+	// AllowRecreate permits the job to be deleted and created again when
+	// its kind changes. While unset a changed kind is only reported by the
+	// RequiresReplacement condition.
+	// +kubebuilder:validation:Optional
+	AllowRecreate *bool `json:"allowRecreate,omitempty"`
+
 	// LogpullOptions to configure the logpush behavior.
 	// +kubebuilder:validation:Optional
 	LogpullOptions *string `json:"logpullOptions,omitempty"`
@@ -58,20 +94,29 @@ type JobParameters struct {
 	// +kubebuilder:validation:Enum=high;low
 	Frequency *string `json:"frequency,omitempty"`
 
-	// Filter contains filtering configuration.
+	// Filter is a JSON filter expression selecting the logs that are
+	// pushed, e.g. {"where":{"and":[{"key":"ClientRequestPath","operator":"contains","value":"/api/"}]}}.
+	// It is compared to the job's filter after normalization, so formatting
+	// and key order do not matter.
 	// +kubebuilder:validation:Optional
-	Filter *JobFilters `json:"filter,omitempty"`
+	Filter *string `json:"filter,omitempty"`
 
 	// MaxUploadBytes is the maximum upload size in bytes.
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000000000
 	MaxUploadBytes *int `json:"maxUploadBytes,omitempty"`
 
 	// MaxUploadRecords is the maximum number of records to upload.
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000000
 	MaxUploadRecords *int `json:"maxUploadRecords,omitempty"`
 
 	// MaxUploadIntervalSeconds is the maximum upload interval in seconds.
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=300
 	MaxUploadIntervalSeconds *int `json:"maxUploadIntervalSeconds,omitempty"`
 }
 
@@ -122,29 +167,6 @@ type OutputOptions struct {
 	SampleRate *string `json:"sampleRate,omitempty"`
 }
 
-// JobFilters contains filtering configuration for logpush jobs.
-type JobFilters struct {
-	// Where contains the filter conditions.
-	// +kubebuilder:validation:Optional
-	Where *JobFilter `json:"where,omitempty"`
-}
-
-// JobFilter defines a single filter condition.
-type JobFilter struct {
-	// Key is the field name to filter on.
-	// +kubebuilder:validation:Optional
-	Key *string `json:"key,omitempty"`
-
-	// Operator is the comparison operator.
-	// +kubebuilder:validation:Optional
-	// +kubebuilder:validation:Enum=eq;!eq;lt;leq;gt;geq;startsWith;endsWith;!startsWith;!endsWith;contains;!contains;in;!in
-	Operator *string `json:"operator,omitempty"`
-
-	// Value is the value to compare against.
-	// +kubebuilder:validation:Optional
-	Value *string `json:"value,omitempty"`
-}
-
 // JobObservation are the observable fields of a Logpush Job.
 type JobObservation struct {
 	// ID of the logpush job.
@@ -186,8 +208,8 @@ type JobObservation struct {
 	// Frequency of log pushes.
 	Frequency *string `json:"frequency,omitempty"`
 
-	// Filter contains filtering configuration.
-	Filter *JobFilters `json:"filter,omitempty"`
+	// Filter is the normalized JSON filter expression of the job.
+	Filter *string `json:"filter,omitempty"`
 
 	// MaxUploadBytes is the maximum upload size in bytes.
 	MaxUploadBytes *int `json:"maxUploadBytes,omitempty"`
@@ -197,6 +219,8 @@ type JobObservation struct {
 
 	// MaxUploadIntervalSeconds is the maximum upload interval in seconds.
 	MaxUploadIntervalSeconds *int `json:"maxUploadIntervalSeconds,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // A JobSpec defines the desired state of a Logpush Job.
@@ -222,7 +246,7 @@ type JobStatus struct {
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
 type Job struct {
 	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec   JobSpec   `json:"spec"`
 	Status JobStatus `json:"status,omitempty"`
@@ -233,10 +257,32 @@ type Job struct {
 // JobList contains a list of Job
 type JobList struct {
 	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Job `json:"items"`
 }
 
+// ResolveReferences resolves references to the Zone whose logs this Job
+// pushes.
+func (j *Job) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, j)
+
+	// Resolve spec.forProvider.zone
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(j.Spec.ForProvider.Zone),
+		Reference:    j.Spec.ForProvider.ZoneRef,
+		Selector:     j.Spec.ForProvider.ZoneSelector,
+		To:           reference.To{Managed: &zonev1alpha1.Zone{}, List: &zonev1alpha1.ZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zone")
+	}
+	j.Spec.ForProvider.Zone = reference.ToPtrValue(rsp.ResolvedValue)
+	j.Spec.ForProvider.ZoneRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // Job type metadata.
 var (
 	JobKind             = "Job"
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// LogpullRetentionParameters are the configurable fields of a zone's
+// Logpull retention setting.
+type LogpullRetentionParameters struct {
+	// Zone is the zone ID the setting applies to.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Zone string `json:"zone"`
+
+	// Enabled controls whether Cloudflare retains HTTP request logs for
+	// the zone so they can be fetched with Logpull.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// LogpullRetentionObservation are the observable fields of a zone's
+// Logpull retention setting.
+type LogpullRetentionObservation struct {
+	// Enabled indicates whether log retention is currently enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A LogpullRetentionSpec defines the desired state of a LogpullRetention.
+type LogpullRetentionSpec struct {
+	rtv1.ResourceSpec `json:",inline"`
+	ForProvider       LogpullRetentionParameters `json:"forProvider"`
+}
+
+// A LogpullRetentionStatus represents the observed state of a
+// LogpullRetention.
+type LogpullRetentionStatus struct {
+	rtv1.ResourceStatus `json:",inline"`
+	AtProvider          LogpullRetentionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LogpullRetention manages whether Cloudflare retains a zone's HTTP
+// request logs for Logpull. Deleting it disables retention again.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type LogpullRetention struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogpullRetentionSpec   `json:"spec"`
+	Status LogpullRetentionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogpullRetentionList contains a list of LogpullRetention
+type LogpullRetentionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogpullRetention `json:"items"`
+}
+
+// LogpullRetention type metadata.
+var (
+	LogpullRetentionKind             = "LogpullRetention"
+	LogpullRetentionGroupKind        = schema.GroupKind{Group: Group, Kind: LogpullRetentionKind}
+	LogpullRetentionKindAPIVersion   = LogpullRetentionKind + "." + GroupVersion.String()
+	LogpullRetentionGroupVersionKind = GroupVersion.WithKind(LogpullRetentionKind)
+)
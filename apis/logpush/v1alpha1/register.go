@@ -36,4 +36,5 @@ var (
 
 func init() {
 	SchemeBuilder.Register(&Job{}, &JobList{})
+	SchemeBuilder.Register(&LogpullRetention{}, &LogpullRetentionList{})
 }
\ No newline at end of file
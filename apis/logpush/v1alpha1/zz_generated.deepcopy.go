@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -51,56 +52,6 @@ func (in *Job) DeepCopyObject() runtime.Object {
 	return nil
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JobFilter) DeepCopyInto(out *JobFilter) {
-	*out = *in
-	if in.Key != nil {
-		in, out := &in.Key, &out.Key
-		*out = new(string)
-		**out = **in
-	}
-	if in.Operator != nil {
-		in, out := &in.Operator, &out.Operator
-		*out = new(string)
-		**out = **in
-	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobFilter.
-func (in *JobFilter) DeepCopy() *JobFilter {
-	if in == nil {
-		return nil
-	}
-	out := new(JobFilter)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JobFilters) DeepCopyInto(out *JobFilters) {
-	*out = *in
-	if in.Where != nil {
-		in, out := &in.Where, &out.Where
-		*out = new(JobFilter)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobFilters.
-func (in *JobFilters) DeepCopy() *JobFilters {
-	if in == nil {
-		return nil
-	}
-	out := new(JobFilters)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JobList) DeepCopyInto(out *JobList) {
 	*out = *in
@@ -186,8 +137,8 @@ func (in *JobObservation) DeepCopyInto(out *JobObservation) {
 	}
 	if in.Filter != nil {
 		in, out := &in.Filter, &out.Filter
-		*out = new(JobFilters)
-		(*in).DeepCopyInto(*out)
+		*out = new(string)
+		**out = **in
 	}
 	if in.MaxUploadBytes != nil {
 		in, out := &in.MaxUploadBytes, &out.MaxUploadBytes
@@ -204,6 +155,7 @@ func (in *JobObservation) DeepCopyInto(out *JobObservation) {
 		*out = new(int)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobObservation.
@@ -219,6 +171,21 @@ func (in *JobObservation) DeepCopy() *JobObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JobParameters) DeepCopyInto(out *JobParameters) {
 	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
@@ -229,6 +196,11 @@ func (in *JobParameters) DeepCopyInto(out *JobParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AllowRecreate != nil {
+		in, out := &in.AllowRecreate, &out.AllowRecreate
+		*out = new(bool)
+		**out = **in
+	}
 	if in.LogpullOptions != nil {
 		in, out := &in.LogpullOptions, &out.LogpullOptions
 		*out = new(string)
@@ -246,8 +218,8 @@ func (in *JobParameters) DeepCopyInto(out *JobParameters) {
 	}
 	if in.Filter != nil {
 		in, out := &in.Filter, &out.Filter
-		*out = new(JobFilters)
-		(*in).DeepCopyInto(*out)
+		*out = new(string)
+		**out = **in
 	}
 	if in.MaxUploadBytes != nil {
 		in, out := &in.MaxUploadBytes, &out.MaxUploadBytes
@@ -310,6 +282,135 @@ func (in *JobStatus) DeepCopy() *JobStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogpullRetention) DeepCopyInto(out *LogpullRetention) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogpullRetention.
+func (in *LogpullRetention) DeepCopy() *LogpullRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(LogpullRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogpullRetention) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogpullRetentionList) DeepCopyInto(out *LogpullRetentionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogpullRetention, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogpullRetentionList.
+func (in *LogpullRetentionList) DeepCopy() *LogpullRetentionList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogpullRetentionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogpullRetentionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogpullRetentionObservation) DeepCopyInto(out *LogpullRetentionObservation) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogpullRetentionObservation.
+func (in *LogpullRetentionObservation) DeepCopy() *LogpullRetentionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LogpullRetentionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogpullRetentionParameters) DeepCopyInto(out *LogpullRetentionParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogpullRetentionParameters.
+func (in *LogpullRetentionParameters) DeepCopy() *LogpullRetentionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LogpullRetentionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogpullRetentionSpec) DeepCopyInto(out *LogpullRetentionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogpullRetentionSpec.
+func (in *LogpullRetentionSpec) DeepCopy() *LogpullRetentionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogpullRetentionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogpullRetentionStatus) DeepCopyInto(out *LogpullRetentionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogpullRetentionStatus.
+func (in *LogpullRetentionStatus) DeepCopy() *LogpullRetentionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogpullRetentionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutputOptions) DeepCopyInto(out *OutputOptions) {
 	*out = *in
@@ -27,3 +27,12 @@ func (l *JobList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this LogpullRetentionList.
+func (l *LogpullRetentionList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // CertificateParameters define the desired state of a Cloudflare Origin CA Certificate.
@@ -42,11 +44,54 @@ type CertificateParameters struct {
 	RequestValidity *int `json:"requestValidity,omitempty"`
 
 	// CSR is the Certificate Signing Request. Must be newline-encoded.
-	// If not provided, Cloudflare will generate a private key and CSR.
+	// If not provided, the provider generates a private key and CSR, and
+	// publishes the private key alongside the certificate.
 	// +optional
 	CSR *string `json:"csr,omitempty"`
 }
 
+// A CertificateSecretTemplate describes a Secret the certificate and its
+// private key are written to, laid out the way TLS consumers expect.
+type CertificateSecretTemplate struct {
+	// Name of the Secret.
+	Name string `json:"name"`
+
+	// Namespace of the Secret.
+	Namespace string `json:"namespace"`
+
+	// Type of the Secret. A kubernetes.io/tls Secret requires the
+	// provider to generate the private key, i.e. csr must not be set.
+	// +kubebuilder:validation:Enum="kubernetes.io/tls";Opaque
+	// +kubebuilder:default="kubernetes.io/tls"
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Labels added to the Secret.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to the Secret.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// PKCS12 additionally writes the certificate and private key to the
+	// Secret as a password protected PKCS#12 keystore.
+	// +optional
+	PKCS12 *PKCS12Keystore `json:"pkcs12,omitempty"`
+}
+
+// A PKCS12Keystore describes a PKCS#12 keystore written to a Secret.
+type PKCS12Keystore struct {
+	// Key of the Secret the keystore is written to.
+	// +kubebuilder:default="keystore.p12"
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// PasswordSecretRef selects the password the keystore is protected
+	// with.
+	PasswordSecretRef rtv1.SecretKeySelector `json:"passwordSecretRef"`
+}
+
 // CertificateObservation represents the observed state of a Cloudflare Origin CA Certificate.
 type CertificateObservation struct {
 	// ID is the certificate ID.
@@ -72,12 +117,19 @@ type CertificateObservation struct {
 
 	// CSR is the Certificate Signing Request used to generate this certificate.
 	CSR string `json:"csr,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // CertificateSpec defines the desired state of a Certificate.
 type CertificateSpec struct {
 	rtv1.ResourceSpec `json:",inline"`
 	ForProvider       CertificateParameters `json:"forProvider"`
+
+	// SecretTemplate writes the certificate and private key to a Secret
+	// of type kubernetes.io/tls, in addition to any connection secret.
+	// +optional
+	SecretTemplate *CertificateSecretTemplate `json:"secretTemplate,omitempty"`
 }
 
 // CertificateStatus defines the observed state of a Certificate.
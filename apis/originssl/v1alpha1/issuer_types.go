@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OriginIssuerSpec configures how cert-manager CertificateRequests that
+// reference an issuer are fulfilled by the Origin CA.
+type OriginIssuerSpec struct {
+	// ProviderConfigReference specifies the ProviderConfig whose
+	// credentials are used to request certificates.
+	// +kubebuilder:default={"name": "default"}
+	ProviderConfigReference rtv1.Reference `json:"providerConfigRef"`
+
+	// RequestType is the signature type of issued certificates. By default
+	// it follows the key type of each certificate request.
+	// +kubebuilder:validation:Enum=origin-rsa;origin-ecc
+	// +optional
+	RequestType *string `json:"requestType,omitempty"`
+
+	// RequestValidity is the number of days issued certificates are valid
+	// for, used when a certificate request does not specify a duration.
+	// Requested durations are rounded up to the nearest validity the
+	// Origin CA supports.
+	// +kubebuilder:validation:Enum=7;30;90;365;730;1095;5475
+	// +kubebuilder:default=5475
+	// +optional
+	RequestValidity *int `json:"requestValidity,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OriginIssuer is a cert-manager external issuer that fulfills
+// CertificateRequests in its namespace using the Cloudflare Origin CA.
+// +kubebuilder:resource:scope=Namespaced,categories={cloudflare}
+type OriginIssuer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OriginIssuerSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// OriginIssuerList contains a list of OriginIssuer
+type OriginIssuerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OriginIssuer `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterOriginIssuer is a cert-manager external issuer that fulfills
+// CertificateRequests in any namespace using the Cloudflare Origin CA.
+// +kubebuilder:resource:scope=Cluster,categories={cloudflare}
+type ClusterOriginIssuer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OriginIssuerSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterOriginIssuerList contains a list of ClusterOriginIssuer
+type ClusterOriginIssuerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterOriginIssuer `json:"items"`
+}
@@ -48,6 +48,22 @@ var (
 	CertificateGroupVersionKind = CRDGroupVersion.WithKind(CertificateKind)
 )
 
+// OriginIssuer type metadata.
+var (
+	OriginIssuerKind             = reflect.TypeOf(OriginIssuer{}).Name()
+	OriginIssuerGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: OriginIssuerKind}
+	OriginIssuerGroupVersionKind = CRDGroupVersion.WithKind(OriginIssuerKind)
+)
+
+// ClusterOriginIssuer type metadata.
+var (
+	ClusterOriginIssuerKind             = reflect.TypeOf(ClusterOriginIssuer{}).Name()
+	ClusterOriginIssuerGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ClusterOriginIssuerKind}
+	ClusterOriginIssuerGroupVersionKind = CRDGroupVersion.WithKind(ClusterOriginIssuerKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Certificate{}, &CertificateList{})
+	SchemeBuilder.Register(&OriginIssuer{}, &OriginIssuerList{})
+	SchemeBuilder.Register(&ClusterOriginIssuer{}, &ClusterOriginIssuerList{})
 }
\ No newline at end of file
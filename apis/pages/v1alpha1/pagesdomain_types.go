@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// PagesDomainParameters are the configurable fields of a PagesDomain.
+type PagesDomainParameters struct {
+	// AccountID is the account of the Pages project. Defaults to the
+	// account ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// ProjectName is the name of the Pages project to attach the domain
+	// to.
+	// +kubebuilder:validation:MinLength=1
+	// +immutable
+	ProjectName string `json:"projectName"`
+
+	// Domain is the custom domain to attach, for example
+	// www.example.com.
+	// +kubebuilder:validation:Format=hostname
+	// +immutable
+	Domain string `json:"domain"`
+
+	// ManageDNSRecord creates the CNAME record pointing Domain at the
+	// pages.dev subdomain of the project, in the zone of Domain, and
+	// deletes it with the PagesDomain. It requires Domain to be in a zone
+	// of the account. Records are marked with a managed-by comment. An
+	// existing CNAME for Domain is adopted if it already points at the
+	// project, and is never overwritten otherwise.
+	// +optional
+	ManageDNSRecord *bool `json:"manageDNSRecord,omitempty"`
+}
+
+// PagesDomainObservation are the observable fields of a PagesDomain.
+type PagesDomainObservation struct {
+	// ID of the domain.
+	ID string `json:"id,omitempty"`
+
+	// Status of the domain, for example initializing, pending or active.
+	Status string `json:"status,omitempty"`
+
+	// VerificationStatus is the status of the verification that the
+	// domain points at the project.
+	VerificationStatus string `json:"verificationStatus,omitempty"`
+
+	// ValidationStatus is the status of the validation of the certificate
+	// of the domain.
+	ValidationStatus string `json:"validationStatus,omitempty"`
+
+	// ValidationMethod is the method used to validate the certificate of
+	// the domain.
+	ValidationMethod string `json:"validationMethod,omitempty"`
+
+	// ZoneID of the zone of the domain, if it is in the account.
+	ZoneID string `json:"zoneId,omitempty"`
+
+	// DNSRecordID is the ID of the CNAME record managed for the domain.
+	DNSRecordID string `json:"dnsRecordId,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A PagesDomainSpec defines the desired state of a PagesDomain.
+type PagesDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PagesDomainParameters `json:"forProvider"`
+}
+
+// A PagesDomainStatus represents the observed state of a PagesDomain.
+type PagesDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PagesDomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PagesDomain attaches a custom domain to a Cloudflare Pages project,
+// optionally creating the CNAME record it needs. It becomes ready once
+// Cloudflare has verified the domain and it is active; the Verified
+// condition carries the verification and validation statuses until then.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.projectName"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.domain"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type PagesDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PagesDomainSpec   `json:"spec"`
+	Status PagesDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PagesDomainList contains a list of PagesDomain objects
+type PagesDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PagesDomain `json:"items"`
+}
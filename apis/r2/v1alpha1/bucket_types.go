@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // BucketParameters are the configurable fields of a Bucket.
@@ -29,11 +31,130 @@ type BucketParameters struct {
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
+	// AllowRecreate permits the bucket to be deleted and created again
+	// under its new name when Name changes. Deleting a bucket that still
+	// contains objects is subject to ForceDestroy. While unset a changed
+	// name is only reported by the RequiresReplacement condition.
+	// +kubebuilder:validation:Optional
+	AllowRecreate *bool `json:"allowRecreate,omitempty"`
+
 	// LocationHint for bucket location preference.
 	// Valid values: "apac", "eeur", "enam", "weur", "wnam"
+	// The location of a bucket is fixed at creation time.
+	// +immutable
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=apac;eeur;enam;weur;wnam
 	LocationHint *string `json:"locationHint,omitempty"`
+
+	// StorageClass is the default storage class of objects written to the
+	// bucket. It can be changed after the bucket has been created.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Standard;InfrequentAccess
+	StorageClass *string `json:"storageClass,omitempty"`
+
+	// ForceDestroy deletes all objects in the bucket before deleting the
+	// bucket itself. When false, deleting a bucket that still contains
+	// objects is blocked until it has been emptied.
+	// +kubebuilder:validation:Optional
+	ForceDestroy *bool `json:"forceDestroy,omitempty"`
+
+	// UsageRefreshInterval enables reporting the object count and size of
+	// the bucket in its status, refreshed at most this often. Usage is
+	// expensive to compute, so an interval of an hour or more is
+	// recommended. Usage is not reported when unset.
+	// +kubebuilder:validation:Optional
+	UsageRefreshInterval *metav1.Duration `json:"usageRefreshInterval,omitempty"`
+
+	// Sippy incrementally migrates objects to the bucket from another
+	// provider, copying each object from the source bucket the first time
+	// it is requested. Sippy is left unmanaged when unset.
+	// +kubebuilder:validation:Optional
+	Sippy *BucketSippy `json:"sippy,omitempty"`
+}
+
+// A SippyProvider is a provider objects can be migrated from.
+// +kubebuilder:validation:Enum=AWS;GCS
+type SippyProvider string
+
+// Sippy source providers.
+const (
+	SippyProviderAWS SippyProvider = "AWS"
+	SippyProviderGCS SippyProvider = "GCS"
+)
+
+// BucketSippy configures the incremental migration of objects to a bucket.
+type BucketSippy struct {
+	// Enabled migrates objects from the source bucket. Setting it to false
+	// disables Sippy, e.g. once every object has been copied.
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Source is the bucket objects are migrated from.
+	Source SippySource `json:"source"`
+
+	// Destination holds the R2 credentials Sippy writes objects with.
+	Destination SippyDestination `json:"destination"`
+}
+
+// SippySource is the bucket objects are migrated from. AWS sources require a
+// region and an access key, and GCS sources a service account.
+// +kubebuilder:validation:XValidation:rule="self.provider != 'AWS' || (has(self.region) && has(self.accessKeyIdSecretRef) && has(self.secretAccessKeySecretRef))",message="AWS sources require region, accessKeyIdSecretRef and secretAccessKeySecretRef"
+// +kubebuilder:validation:XValidation:rule="self.provider != 'GCS' || (has(self.clientEmailSecretRef) && has(self.privateKeySecretRef))",message="GCS sources require clientEmailSecretRef and privateKeySecretRef"
+type SippySource struct {
+	// Provider of the source bucket.
+	Provider SippyProvider `json:"provider"`
+
+	// Bucket is the name of the source bucket.
+	Bucket string `json:"bucket"`
+
+	// Region of an AWS source bucket.
+	// +kubebuilder:validation:Optional
+	Region *string `json:"region,omitempty"`
+
+	// AccessKeyIDSecretRef selects the ID of an AWS access key that can
+	// read the source bucket.
+	// +kubebuilder:validation:Optional
+	AccessKeyIDSecretRef *rtv1.SecretKeySelector `json:"accessKeyIdSecretRef,omitempty"`
+
+	// SecretAccessKeySecretRef selects the secret of the AWS access key.
+	// +kubebuilder:validation:Optional
+	SecretAccessKeySecretRef *rtv1.SecretKeySelector `json:"secretAccessKeySecretRef,omitempty"`
+
+	// ClientEmailSecretRef selects the client email of a GCS service
+	// account that can read the source bucket.
+	// +kubebuilder:validation:Optional
+	ClientEmailSecretRef *rtv1.SecretKeySelector `json:"clientEmailSecretRef,omitempty"`
+
+	// PrivateKeySecretRef selects the private key of the GCS service
+	// account.
+	// +kubebuilder:validation:Optional
+	PrivateKeySecretRef *rtv1.SecretKeySelector `json:"privateKeySecretRef,omitempty"`
+}
+
+// SippyDestination holds the R2 credentials Sippy writes objects with.
+type SippyDestination struct {
+	// AccessKeyIDSecretRef selects the ID of an R2 access key that can
+	// write to the bucket.
+	AccessKeyIDSecretRef rtv1.SecretKeySelector `json:"accessKeyIdSecretRef"`
+
+	// SecretAccessKeySecretRef selects the secret of the R2 access key.
+	SecretAccessKeySecretRef rtv1.SecretKeySelector `json:"secretAccessKeySecretRef"`
+}
+
+// BucketUsage is the storage used by a bucket.
+type BucketUsage struct {
+	// ObjectCount is the number of objects stored in the bucket.
+	ObjectCount int64 `json:"objectCount"`
+
+	// PayloadSize is the total size of the objects in bytes.
+	PayloadSize int64 `json:"payloadSize"`
+
+	// MetadataSize is the total size of the object metadata in bytes.
+	MetadataSize int64 `json:"metadataSize"`
+
+	// ObservedAt is when the usage was last retrieved.
+	ObservedAt metav1.Time `json:"observedAt"`
 }
 
 // BucketObservation are the observable fields of a Bucket.
@@ -46,6 +167,37 @@ type BucketObservation struct {
 
 	// Location where the bucket is stored.
 	Location string `json:"location,omitempty"`
+
+	// StorageClass is the default storage class of the bucket.
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// Usage of the bucket, if usage reporting is enabled.
+	Usage *BucketUsage `json:"usage,omitempty"`
+
+	// Sippy is the migration configured for the bucket, if Sippy is
+	// managed.
+	Sippy *BucketSippyObservation `json:"sippy,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// BucketSippyObservation is the observed Sippy configuration of a bucket.
+type BucketSippyObservation struct {
+	// Enabled is true while objects are being migrated to the bucket.
+	Enabled bool `json:"enabled"`
+
+	// SourceProvider is the provider objects are migrated from.
+	SourceProvider string `json:"sourceProvider,omitempty"`
+
+	// SourceBucket is the bucket objects are migrated from.
+	SourceBucket string `json:"sourceBucket,omitempty"`
+
+	// SourceRegion is the region of an AWS source bucket.
+	SourceRegion string `json:"sourceRegion,omitempty"`
+
+	// CredentialsHash is a hash of the credentials Sippy was last
+	// configured with, used to detect rotated credentials.
+	CredentialsHash string `json:"credentialsHash,omitempty"`
 }
 
 // A BucketSpec defines the desired state of a Bucket.
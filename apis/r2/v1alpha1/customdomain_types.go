@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	zonev1alpha1 "github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+)
+
+// R2CustomDomainParameters are the configurable fields of an R2CustomDomain.
+type R2CustomDomainParameters struct {
+	// Domain is the hostname objects in the bucket are served from, e.g.
+	// assets.example.com. It must belong to the zone.
+	// +immutable
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Format=hostname
+	Domain string `json:"domain"`
+
+	// Bucket is the name of the bucket the domain is attached to.
+	// +immutable
+	// +kubebuilder:validation:Optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references the Bucket the domain is attached to.
+	// +immutable
+	// +kubebuilder:validation:Optional
+	BucketRef *rtv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects the Bucket the domain is attached to.
+	// +immutable
+	// +kubebuilder:validation:Optional
+	BucketSelector *rtv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Enabled indicates whether public access to the bucket through the
+	// domain is allowed.
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinTLS is the minimum TLS version clients must use to connect to
+	// the domain.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum="1.0";"1.1";"1.2";"1.3"
+	MinTLS *string `json:"minTLS,omitempty"`
+
+	// Zone is the ID of the zone the domain belongs to.
+	// +immutable
+	// +kubebuilder:validation:Optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone the domain belongs to.
+	// +immutable
+	// +kubebuilder:validation:Optional
+	ZoneRef *rtv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone the domain belongs to.
+	// +immutable
+	// +kubebuilder:validation:Optional
+	ZoneSelector *rtv1.Selector `json:"zoneSelector,omitempty"`
+}
+
+// R2CustomDomainObservation are the observable fields of an R2CustomDomain.
+type R2CustomDomainObservation struct {
+	// Domain attached to the bucket.
+	Domain string `json:"domain,omitempty"`
+
+	// Enabled indicates whether public access through the domain is
+	// allowed.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// MinTLS is the minimum TLS version of the domain.
+	MinTLS string `json:"minTLS,omitempty"`
+
+	// ZoneName is the name of the zone the domain belongs to.
+	ZoneName string `json:"zoneName,omitempty"`
+
+	// OwnershipStatus is the status of the verification that the zone
+	// owns the domain.
+	OwnershipStatus string `json:"ownershipStatus,omitempty"`
+
+	// SSLStatus is the status of the certificate served for the domain.
+	// The domain only serves the bucket once it is active.
+	SSLStatus string `json:"sslStatus,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// An R2CustomDomainSpec defines the desired state of an R2CustomDomain.
+type R2CustomDomainSpec struct {
+	rtv1.ResourceSpec `json:",inline"`
+	ForProvider       R2CustomDomainParameters `json:"forProvider"`
+}
+
+// An R2CustomDomainStatus represents the observed state of an
+// R2CustomDomain.
+type R2CustomDomainStatus struct {
+	rtv1.ResourceStatus `json:",inline"`
+	AtProvider          R2CustomDomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An R2CustomDomain gives public access to the objects of an R2 Bucket
+// through a hostname of a zone.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.domain"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="SSL",type="string",JSONPath=".status.atProvider.sslStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type R2CustomDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   R2CustomDomainSpec   `json:"spec"`
+	Status R2CustomDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// R2CustomDomainList contains a list of R2CustomDomain
+type R2CustomDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []R2CustomDomain `json:"items"`
+}
+
+// R2CustomDomain type metadata.
+var (
+	R2CustomDomainKind             = "R2CustomDomain"
+	R2CustomDomainGroupKind        = schema.GroupKind{Group: Group, Kind: R2CustomDomainKind}
+	R2CustomDomainKindAPIVersion   = R2CustomDomainKind + "." + GroupVersion.String()
+	R2CustomDomainGroupVersionKind = GroupVersion.WithKind(R2CustomDomainKind)
+)
+
+// ResolveReferences resolves references to the Bucket and Zone of this
+// R2CustomDomain.
+func (d *R2CustomDomain) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, d)
+
+	// Resolve spec.forProvider.bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(d.Spec.ForProvider.Bucket),
+		Reference:    d.Spec.ForProvider.BucketRef,
+		Selector:     d.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &Bucket{}, List: &BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	d.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	d.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.zone
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(d.Spec.ForProvider.Zone),
+		Reference:    d.Spec.ForProvider.ZoneRef,
+		Selector:     d.Spec.ForProvider.ZoneSelector,
+		To:           reference.To{Managed: &zonev1alpha1.Zone{}, List: &zonev1alpha1.ZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zone")
+	}
+	d.Spec.ForProvider.Zone = reference.ToPtrValue(rsp.ResolvedValue)
+	d.Spec.ForProvider.ZoneRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -35,5 +35,5 @@ var (
 )
 
 func init() {
-	SchemeBuilder.Register(&Bucket{}, &BucketList{})
+	SchemeBuilder.Register(&Bucket{}, &BucketList{}, &R2CustomDomain{}, &R2CustomDomainList{})
 }
\ No newline at end of file
@@ -21,6 +21,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -90,6 +92,17 @@ func (in *BucketObservation) DeepCopyInto(out *BucketObservation) {
 		in, out := &in.CreationDate, &out.CreationDate
 		*out = (*in).DeepCopy()
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(BucketUsage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sippy != nil {
+		in, out := &in.Sippy, &out.Sippy
+		*out = new(BucketSippyObservation)
+		**out = **in
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObservation.
@@ -105,11 +118,36 @@ func (in *BucketObservation) DeepCopy() *BucketObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 	*out = *in
+	if in.AllowRecreate != nil {
+		in, out := &in.AllowRecreate, &out.AllowRecreate
+		*out = new(bool)
+		**out = **in
+	}
 	if in.LocationHint != nil {
 		in, out := &in.LocationHint, &out.LocationHint
 		*out = new(string)
 		**out = **in
 	}
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForceDestroy != nil {
+		in, out := &in.ForceDestroy, &out.ForceDestroy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsageRefreshInterval != nil {
+		in, out := &in.UsageRefreshInterval, &out.UsageRefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Sippy != nil {
+		in, out := &in.Sippy, &out.Sippy
+		*out = new(BucketSippy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -122,6 +160,43 @@ func (in *BucketParameters) DeepCopy() *BucketParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketSippy) DeepCopyInto(out *BucketSippy) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Source.DeepCopyInto(&out.Source)
+	out.Destination = in.Destination
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSippy.
+func (in *BucketSippy) DeepCopy() *BucketSippy {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketSippy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketSippyObservation) DeepCopyInto(out *BucketSippyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSippyObservation.
+func (in *BucketSippyObservation) DeepCopy() *BucketSippyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketSippyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
 	*out = *in
@@ -155,3 +230,240 @@ func (in *BucketStatus) DeepCopy() *BucketStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketUsage) DeepCopyInto(out *BucketUsage) {
+	*out = *in
+	in.ObservedAt.DeepCopyInto(&out.ObservedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketUsage.
+func (in *BucketUsage) DeepCopy() *BucketUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *R2CustomDomain) DeepCopyInto(out *R2CustomDomain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new R2CustomDomain.
+func (in *R2CustomDomain) DeepCopy() *R2CustomDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(R2CustomDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *R2CustomDomain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *R2CustomDomainList) DeepCopyInto(out *R2CustomDomainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]R2CustomDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new R2CustomDomainList.
+func (in *R2CustomDomainList) DeepCopy() *R2CustomDomainList {
+	if in == nil {
+		return nil
+	}
+	out := new(R2CustomDomainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *R2CustomDomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *R2CustomDomainObservation) DeepCopyInto(out *R2CustomDomainObservation) {
+	*out = *in
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new R2CustomDomainObservation.
+func (in *R2CustomDomainObservation) DeepCopy() *R2CustomDomainObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(R2CustomDomainObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *R2CustomDomainParameters) DeepCopyInto(out *R2CustomDomainParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinTLS != nil {
+		in, out := &in.MinTLS, &out.MinTLS
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new R2CustomDomainParameters.
+func (in *R2CustomDomainParameters) DeepCopy() *R2CustomDomainParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(R2CustomDomainParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *R2CustomDomainSpec) DeepCopyInto(out *R2CustomDomainSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new R2CustomDomainSpec.
+func (in *R2CustomDomainSpec) DeepCopy() *R2CustomDomainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(R2CustomDomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *R2CustomDomainStatus) DeepCopyInto(out *R2CustomDomainStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new R2CustomDomainStatus.
+func (in *R2CustomDomainStatus) DeepCopy() *R2CustomDomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(R2CustomDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SippyDestination) DeepCopyInto(out *SippyDestination) {
+	*out = *in
+	out.AccessKeyIDSecretRef = in.AccessKeyIDSecretRef
+	out.SecretAccessKeySecretRef = in.SecretAccessKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SippyDestination.
+func (in *SippyDestination) DeepCopy() *SippyDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(SippyDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SippySource) DeepCopyInto(out *SippySource) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessKeyIDSecretRef != nil {
+		in, out := &in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.SecretAccessKeySecretRef != nil {
+		in, out := &in.SecretAccessKeySecretRef, &out.SecretAccessKeySecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ClientEmailSecretRef != nil {
+		in, out := &in.ClientEmailSecretRef, &out.ClientEmailSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PrivateKeySecretRef != nil {
+		in, out := &in.PrivateKeySecretRef, &out.PrivateKeySecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SippySource.
+func (in *SippySource) DeepCopy() *SippySource {
+	if in == nil {
+		return nil
+	}
+	out := new(SippySource)
+	in.DeepCopyInto(out)
+	return out
+}
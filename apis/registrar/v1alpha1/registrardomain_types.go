@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// RegistrarDomainParameters are the configurable fields of a
+// RegistrarDomain.
+type RegistrarDomainParameters struct {
+	// AccountID is the account the domain is registered with. Defaults to
+	// the account ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// Domain is the registered domain name, e.g. example.com.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +immutable
+	// +kubebuilder:validation:Format=hostname
+	Domain string `json:"domain"`
+
+	// AutoRenew renews the registration automatically before it expires.
+	// +optional
+	AutoRenew *bool `json:"autoRenew,omitempty"`
+
+	// Locked applies a transfer lock, preventing the domain from being
+	// transferred to another registrar.
+	// +optional
+	Locked *bool `json:"locked,omitempty"`
+
+	// Privacy redacts the registrant contact from WHOIS.
+	// +optional
+	Privacy *bool `json:"privacy,omitempty"`
+
+	// NameServers the domain is delegated to. The nameservers Cloudflare
+	// assigned are left in place when unset.
+	// +kubebuilder:validation:MaxItems=13
+	// +optional
+	NameServers []string `json:"nameServers,omitempty"`
+}
+
+// RegistrantObservation is the registrant contact of a domain, as
+// published in WHOIS unless privacy is enabled.
+type RegistrantObservation struct {
+	// Organization of the registrant.
+	Organization string `json:"organization,omitempty"`
+
+	// Email of the registrant.
+	Email string `json:"email,omitempty"`
+
+	// Country of the registrant.
+	Country string `json:"country,omitempty"`
+}
+
+// RegistrarDomainObservation are the observable fields of a
+// RegistrarDomain.
+type RegistrarDomainObservation struct {
+	// ID of the domain.
+	ID string `json:"id,omitempty"`
+
+	// CurrentRegistrar is the registrar the domain is registered with.
+	CurrentRegistrar string `json:"currentRegistrar,omitempty"`
+
+	// ExpiresAt is when the registration expires.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// RegistryStatuses are the EPP status codes the registry reports for
+	// the domain, e.g. clientTransferProhibited.
+	RegistryStatuses string `json:"registryStatuses,omitempty"`
+
+	// AutoRenew is whether the registration is renewed automatically.
+	AutoRenew bool `json:"autoRenew,omitempty"`
+
+	// Locked is whether a transfer lock is applied.
+	Locked bool `json:"locked,omitempty"`
+
+	// Privacy is whether WHOIS privacy is enabled.
+	Privacy bool `json:"privacy,omitempty"`
+
+	// NameServers the domain is delegated to.
+	NameServers []string `json:"nameServers,omitempty"`
+
+	// Registrant is the registrant contact of the domain.
+	Registrant *RegistrantObservation `json:"registrant,omitempty"`
+
+	// CreatedAt is when the domain was registered.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is when the registration was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A RegistrarDomainSpec defines the desired state of a RegistrarDomain.
+type RegistrarDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RegistrarDomainParameters `json:"forProvider"`
+}
+
+// A RegistrarDomainStatus represents the observed state of a
+// RegistrarDomain.
+type RegistrarDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RegistrarDomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RegistrarDomain manages the settings of a domain registered with
+// Cloudflare Registrar. Domains cannot be registered or transferred in
+// through the API, so the domain must already be registered; deleting a
+// RegistrarDomain leaves the registration untouched.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.domain"
+// +kubebuilder:printcolumn:name="EXPIRES",type="date",JSONPath=".status.atProvider.expiresAt"
+// +kubebuilder:printcolumn:name="AUTO-RENEW",type="boolean",JSONPath=".status.atProvider.autoRenew",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type RegistrarDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegistrarDomainSpec   `json:"spec"`
+	Status RegistrarDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RegistrarDomainList contains a list of RegistrarDomain objects
+type RegistrarDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegistrarDomain `json:"items"`
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // RulesetParameters define the desired state of a Cloudflare Ruleset
@@ -45,6 +47,7 @@ type RulesetParameters struct {
 	// Kind specifies the kind of ruleset.
 	// Valid values: "managed", "custom", "root", "zone"
 	// +required
+	// +kubebuilder:validation:Enum=managed;custom;root;zone
 	Kind string `json:"kind"`
 
 	// Phase specifies when the ruleset is executed.
@@ -58,6 +61,28 @@ type RulesetParameters struct {
 	// Rules is the list of rules in this ruleset.
 	// +optional
 	Rules []RulesetRule `json:"rules,omitempty"`
+
+	// SharedEntrypoint contributes the rules to the entrypoint ruleset of
+	// the phase, alongside those of other Rulesets and any rules managed
+	// outside Crossplane, instead of managing a ruleset of its own. Name,
+	// Description and Kind are ignored, and the refs of the rules are
+	// assigned by the provider to identify the Ruleset they belong to.
+	// +optional
+	SharedEntrypoint *RulesetSharedEntrypoint `json:"sharedEntrypoint,omitempty"`
+}
+
+// RulesetSharedEntrypoint configures how the rules of a Ruleset are merged
+// into the entrypoint ruleset of its phase.
+type RulesetSharedEntrypoint struct {
+	// Priority orders the rules of the Rulesets sharing an entrypoint.
+	// Rules of Rulesets with a lower priority are evaluated first, and
+	// Rulesets of the same priority are ordered by name. Rules managed
+	// outside Crossplane are evaluated before all others.
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=9999
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
 }
 
 // RulesetRule represents a single rule in a ruleset
@@ -199,6 +224,7 @@ type RulesetRuleActionParametersHTTPHeader struct {
 	// Operation specifies the header operation.
 	// Valid values: "set", "add", "remove"
 	// +required
+	// +kubebuilder:validation:Enum=set;add;remove
 	Operation string `json:"operation"`
 
 	// Value is the header value for set/add operations.
@@ -214,6 +240,8 @@ type RulesetRuleActionParametersHTTPHeader struct {
 type RulesetRuleActionParametersBlockResponse struct {
 	// StatusCode is the HTTP status code to return.
 	// +required
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=499
 	StatusCode int `json:"statusCode"`
 
 	// ContentType is the response content type.
@@ -233,6 +261,8 @@ type RulesetRuleActionParametersOrigin struct {
 
 	// Port is the origin port.
 	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
 	Port *int `json:"port,omitempty"`
 }
 
@@ -305,6 +335,7 @@ type RulesetRuleRateLimit struct {
 
 	// RequestsPerPeriod is the number of requests allowed per period.
 	// +optional
+	// +kubebuilder:validation:Minimum=1
 	RequestsPerPeriod *int `json:"requestsPerPeriod,omitempty"`
 
 	// ScorePerPeriod is the score per period for rate limiting.
@@ -313,10 +344,13 @@ type RulesetRuleRateLimit struct {
 
 	// Period is the time period in seconds.
 	// +optional
+	// +kubebuilder:validation:Enum=10;60;120;300;600;3600
 	Period *int `json:"period,omitempty"`
 
 	// MitigationTimeout is how long to block after rate limit hit.
 	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=86400
 	MitigationTimeout *int `json:"mitigationTimeout,omitempty"`
 
 	// CountingExpression is the expression for counting requests.
@@ -348,6 +382,8 @@ type RulesetObservation struct {
 
 	// ShareableEntitlementName is the shareable entitlement name.
 	ShareableEntitlementName *string `json:"shareableEntitlementName,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // RulesetSpec defines the desired state of Ruleset
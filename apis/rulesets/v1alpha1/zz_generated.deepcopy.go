@@ -101,6 +101,7 @@ func (in *RulesetObservation) DeepCopyInto(out *RulesetObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetObservation.
@@ -138,6 +139,11 @@ func (in *RulesetParameters) DeepCopyInto(out *RulesetParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SharedEntrypoint != nil {
+		in, out := &in.SharedEntrypoint, &out.SharedEntrypoint
+		*out = new(RulesetSharedEntrypoint)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetParameters.
@@ -612,6 +618,26 @@ func (in *RulesetRuleRateLimit) DeepCopy() *RulesetRuleRateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetSharedEntrypoint) DeepCopyInto(out *RulesetSharedEntrypoint) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetSharedEntrypoint.
+func (in *RulesetSharedEntrypoint) DeepCopy() *RulesetSharedEntrypoint {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetSharedEntrypoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RulesetSpec) DeepCopyInto(out *RulesetSpec) {
 	*out = *in
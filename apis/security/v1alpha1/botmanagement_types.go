@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // BotManagementParameters define the desired state of Cloudflare Bot Management for a zone.
@@ -74,11 +76,18 @@ type BotManagementParameters struct {
 	// +optional
 	AutoUpdateModel *bool `json:"autoUpdateModel,omitempty"`
 
-	// AIBotsProtection configures protection level for AI/ML bots.
-	// Valid values: "allow", "block", "challenge", "js_challenge", "managed_challenge"
+	// AIBotsProtection configures blocking of AI bots, such as crawlers
+	// scraping content for model training. They may be blocked everywhere,
+	// only on pages showing ads, or not at all.
 	// +optional
-	// +kubebuilder:validation:Enum=allow;block;challenge;js_challenge;managed_challenge
+	// +kubebuilder:validation:Enum=block;only_on_ad_pages;disabled
 	AIBotsProtection *string `json:"aiBotsProtection,omitempty"`
+
+	// CrawlerProtection configures AI Labyrinth, which serves
+	// AI-generated decoy pages to crawlers that ignore crawling directives.
+	// +optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	CrawlerProtection *string `json:"crawlerProtection,omitempty"`
 }
 
 // BotManagementObservation are the observable fields of Bot Management.
@@ -113,8 +122,13 @@ type BotManagementObservation struct {
 	// UsingLatestModel indicates whether the zone is using the latest bot detection model.
 	UsingLatestModel *bool `json:"usingLatestModel,omitempty"`
 
-	// AIBotsProtection shows the protection level for AI/ML bots.
+	// AIBotsProtection shows how AI bots are blocked.
 	AIBotsProtection *string `json:"aiBotsProtection,omitempty"`
+
+	// CrawlerProtection shows whether AI Labyrinth is enabled.
+	CrawlerProtection *string `json:"crawlerProtection,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // BotManagementSpec defines the desired state of Bot Management.
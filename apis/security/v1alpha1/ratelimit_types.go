@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // RateLimitParameters define the desired state of a Cloudflare Rate Limit rule.
@@ -93,6 +95,8 @@ type RateLimitObservation struct {
 
 	// Correlate defines how requests are correlated for rate limiting.
 	Correlate *RateLimitCorrelate `json:"correlate,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // RateLimitTrafficMatcher contains the rules that will be used to apply a rate limit to traffic.
@@ -127,6 +131,8 @@ type RateLimitRequestMatcher struct {
 type RateLimitResponseMatcher struct {
 	// Statuses is a list of HTTP status codes to match.
 	// +optional
+	// +kubebuilder:validation:items:Minimum=100
+	// +kubebuilder:validation:items:Maximum=599
 	Statuses []int `json:"statuses,omitempty"`
 
 	// OriginTraffic indicates if origin traffic should be considered.
@@ -64,6 +64,14 @@ var (
 	TurnstileGroupVersionKind = CRDGroupVersion.WithKind(TurnstileKind)
 )
 
+// SecurityHeader type metadata.
+var (
+	SecurityHeaderKind             = reflect.TypeOf(SecurityHeader{}).Name()
+	SecurityHeaderGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SecurityHeaderKind}
+	SecurityHeaderKindAPIVersion   = SecurityHeaderKind + "." + CRDGroupVersion.String()
+	SecurityHeaderGroupVersionKind = CRDGroupVersion.WithKind(SecurityHeaderKind)
+)
+
 func init() {
-	SchemeBuilder.Register(&RateLimit{}, &RateLimitList{}, &BotManagement{}, &BotManagementList{}, &Turnstile{}, &TurnstileList{})
+	SchemeBuilder.Register(&RateLimit{}, &RateLimitList{}, &BotManagement{}, &BotManagementList{}, &Turnstile{}, &TurnstileList{}, &SecurityHeader{}, &SecurityHeaderList{})
 }
\ No newline at end of file
@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// SecurityHeaderParameters define the desired HTTP Strict Transport Security
+// (HSTS) settings of a zone.
+// +kubebuilder:validation:XValidation:rule="!has(self.preload) || !self.preload || (has(self.includeSubdomains) && self.includeSubdomains && has(self.maxAge) && self.maxAge >= 31536000)",message="preload requires includeSubdomains and a maxAge of at least 31536000"
+type SecurityHeaderParameters struct {
+	// Zone is the zone ID the security header settings are applied to.
+	// +required
+	Zone string `json:"zone"`
+
+	// Enabled indicates whether the Strict-Transport-Security header is
+	// served.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaxAge is the max-age directive of the header, in seconds.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=31536000
+	// +optional
+	MaxAge *int64 `json:"maxAge,omitempty"`
+
+	// IncludeSubdomains indicates whether the includeSubDomains directive
+	// is served, applying the policy to every subdomain of the zone.
+	// +optional
+	IncludeSubdomains *bool `json:"includeSubdomains,omitempty"`
+
+	// Preload indicates whether the preload directive is served, allowing
+	// the zone to be submitted to browser preload lists.
+	// +optional
+	Preload *bool `json:"preload,omitempty"`
+
+	// NoSniff indicates whether the X-Content-Type-Options: nosniff header
+	// is served.
+	// +optional
+	NoSniff *bool `json:"noSniff,omitempty"`
+}
+
+// SecurityHeaderObservation are the observable fields of a SecurityHeader.
+type SecurityHeaderObservation struct {
+	// Enabled indicates whether the Strict-Transport-Security header is served.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaxAge is the served max-age directive, in seconds.
+	MaxAge *int64 `json:"maxAge,omitempty"`
+
+	// IncludeSubdomains indicates whether the includeSubDomains directive is served.
+	IncludeSubdomains *bool `json:"includeSubdomains,omitempty"`
+
+	// Preload indicates whether the preload directive is served.
+	Preload *bool `json:"preload,omitempty"`
+
+	// NoSniff indicates whether the X-Content-Type-Options: nosniff header is served.
+	NoSniff *bool `json:"noSniff,omitempty"`
+
+	// ModifiedOn is when the settings were last changed.
+	ModifiedOn *string `json:"modifiedOn,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// SecurityHeaderSpec defines the desired state of a SecurityHeader.
+type SecurityHeaderSpec struct {
+	rtv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityHeaderParameters `json:"forProvider"`
+}
+
+// SecurityHeaderStatus defines the observed state of a SecurityHeader.
+type SecurityHeaderStatus struct {
+	rtv1.ResourceStatus `json:",inline"`
+	AtProvider          SecurityHeaderObservation `json:"atProvider,omitempty"`
+}
+
+// A SecurityHeader is a managed resource that represents the HSTS settings
+// of a zone. It must not be combined with the securityHeader setting of a
+// Zone managing the same zone, as both would manage the same setting.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone"
+// +kubebuilder:printcolumn:name="HSTS",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="MAX_AGE",type="integer",JSONPath=".status.atProvider.maxAge"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+// +kubebuilder:object:root=true
+type SecurityHeader struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SecurityHeaderSpec   `json:"spec"`
+	Status            SecurityHeaderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// SecurityHeaderList contains a list of SecurityHeader objects.
+type SecurityHeaderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityHeader `json:"items"`
+}
+
+// GetCondition of this SecurityHeader.
+func (mg *SecurityHeader) GetCondition(ct rtv1.ConditionType) rtv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this SecurityHeader.
+func (mg *SecurityHeader) GetDeletionPolicy() rtv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetManagementPolicies of this SecurityHeader.
+func (mg *SecurityHeader) GetManagementPolicies() rtv1.ManagementPolicies {
+	return mg.Spec.ManagementPolicies
+}
+
+// GetProviderConfigReference of this SecurityHeader.
+func (mg *SecurityHeader) GetProviderConfigReference() *rtv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+// GetPublishConnectionDetailsTo of this SecurityHeader.
+func (mg *SecurityHeader) GetPublishConnectionDetailsTo() *rtv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// GetWriteConnectionSecretToReference of this SecurityHeader.
+func (mg *SecurityHeader) GetWriteConnectionSecretToReference() *rtv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this SecurityHeader.
+func (mg *SecurityHeader) SetConditions(c ...rtv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this SecurityHeader.
+func (mg *SecurityHeader) SetDeletionPolicy(r rtv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetManagementPolicies of this SecurityHeader.
+func (mg *SecurityHeader) SetManagementPolicies(r rtv1.ManagementPolicies) {
+	mg.Spec.ManagementPolicies = r
+}
+
+// SetProviderConfigReference of this SecurityHeader.
+func (mg *SecurityHeader) SetProviderConfigReference(r *rtv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+// SetPublishConnectionDetailsTo of this SecurityHeader.
+func (mg *SecurityHeader) SetPublishConnectionDetailsTo(r *rtv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// SetWriteConnectionSecretToReference of this SecurityHeader.
+func (mg *SecurityHeader) SetWriteConnectionSecretToReference(r *rtv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetGroupVersionKind returns the GroupVersionKind for SecurityHeader.
+func (mg *SecurityHeader) GetGroupVersionKind() schema.GroupVersionKind {
+	return SecurityHeaderGroupVersionKind
+}
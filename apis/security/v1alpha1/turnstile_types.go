@@ -21,42 +21,81 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // TurnstileParameters define the desired state of a Cloudflare Turnstile widget.
 type TurnstileParameters struct {
 	// AccountID is the account identifier to target for the resource.
-	// +required
-	AccountID string `json:"accountId"`
+	// Defaults to the account ID of the ProviderConfig when omitted.
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
 
 	// Name is the human readable widget name.
 	// +required
 	Name string `json:"name"`
 
-	// Domains are the domains for which the widget is active.
+	// Domains are the domains for which the widget is active. They are
+	// compared with those of the widget regardless of order, case and
+	// duplicates. Cloudflare allows at most 200 domains per widget.
 	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=200
 	Domains []string `json:"domains"`
 
 	// Mode describes how Cloudflare will handle the traffic coming from human or bot.
 	// Valid values: "non-interactive", "invisible", "managed"
+	// Changing the mode of an existing widget between managed and
+	// non-interactive is always allowed. Changing it to or from invisible
+	// changes how the widget is embedded in pages, so it is only applied
+	// while allowModeMigration is true.
 	// +optional
 	// +kubebuilder:validation:Enum=non-interactive;invisible;managed
 	Mode *string `json:"mode,omitempty"`
 
+	// AllowModeMigration permits changing the mode of the widget to or from
+	// invisible. While unset such a change is not applied, and is reported
+	// by the ModeMigrationBlocked condition.
+	// +optional
+	AllowModeMigration *bool `json:"allowModeMigration,omitempty"`
+
 	// BotFightMode indicates whether Bot Fight Mode is enabled for this widget.
 	// If true, the widget will enable Cloudflare's Bot Fight Mode.
 	// +optional
 	BotFightMode *bool `json:"botFightMode,omitempty"`
 
-	// Region is the region for this widget. Valid values: "world" or specific region codes.
+	// Region restricts where the widget's challenges are served from.
+	// Valid values: "world", the default, serves them globally and "china"
+	// from within mainland China (requires China Network).
 	// +optional
-	// +kubebuilder:validation:Enum=world
+	// +kubebuilder:validation:Enum=world;china
 	Region *string `json:"region,omitempty"`
 
 	// OffLabel indicates whether to show/hide Cloudflare branding on the widget.
 	// If true, Cloudflare branding is hidden (requires appropriate subscription).
 	// +optional
 	OffLabel *bool `json:"offLabel,omitempty"`
+
+	// ClearanceLevel is the level of Cloudflare clearance issued to a
+	// visitor who solves the widget, letting them skip challenges on zones
+	// with a matching security level. Any level but no_clearance enables
+	// pre-clearance. The clearance lasts for the challengeTtl of the zone
+	// the visitor is cleared on.
+	// +optional
+	// +kubebuilder:validation:Enum=no_clearance;jschallenge;managed;interactive
+	ClearanceLevel *string `json:"clearanceLevel,omitempty"`
+
+	// EphemeralID indicates whether an ephemeral ID is returned with each
+	// siteverify response (requires Enterprise Bot Management).
+	// +optional
+	EphemeralID *bool `json:"ephemeralId,omitempty"`
+
+	// AdoptExisting makes the provider look for an existing widget with the
+	// same name and domains before creating one, and manage it instead of
+	// creating a duplicate.
+	// +optional
+	AdoptExisting *bool `json:"adoptExisting,omitempty"`
 }
 
 // TurnstileObservation are the observable fields of a Turnstile widget.
@@ -82,6 +121,13 @@ type TurnstileObservation struct {
 	// Mode describes how Cloudflare handles the traffic.
 	Mode *string `json:"mode,omitempty"`
 
+	// PreviousMode is the mode of the widget before its mode last changed.
+	PreviousMode *string `json:"previousMode,omitempty"`
+
+	// ModeChangedAt is when the mode of the widget was last observed to
+	// change, whether by the provider or outside of it.
+	ModeChangedAt *metav1.Time `json:"modeChangedAt,omitempty"`
+
 	// BotFightMode indicates whether Bot Fight Mode is enabled.
 	BotFightMode *bool `json:"botFightMode,omitempty"`
 
@@ -90,6 +136,19 @@ type TurnstileObservation struct {
 
 	// OffLabel indicates whether Cloudflare branding is hidden.
 	OffLabel *bool `json:"offLabel,omitempty"`
+
+	// ClearanceLevel is the clearance issued when the widget is solved.
+	ClearanceLevel *string `json:"clearanceLevel,omitempty"`
+
+	// PreClearance indicates whether visitors who solve the widget are
+	// issued a pre-clearance cookie, i.e. whether the clearance level is
+	// anything but no_clearance.
+	PreClearance *bool `json:"preClearance,omitempty"`
+
+	// EphemeralID indicates whether ephemeral IDs are enabled.
+	EphemeralID *bool `json:"ephemeralId,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // TurnstileSpec defines the desired state of Turnstile.
@@ -110,6 +169,8 @@ type TurnstileStatus struct {
 // +kubebuilder:printcolumn:name="SITEKEY",type="string",JSONPath=".status.atProvider.siteKey"
 // +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name"
 // +kubebuilder:printcolumn:name="MODE",type="string",JSONPath=".status.atProvider.mode"
+// +kubebuilder:printcolumn:name="MODE-CHANGED",type="date",JSONPath=".status.atProvider.modeChangedAt",priority=1
+// +kubebuilder:printcolumn:name="PRECLEARANCE",type="boolean",JSONPath=".status.atProvider.preClearance",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
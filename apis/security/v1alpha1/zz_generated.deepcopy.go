@@ -141,6 +141,12 @@ func (in *BotManagementObservation) DeepCopyInto(out *BotManagementObservation)
 		*out = new(string)
 		**out = **in
 	}
+	if in.CrawlerProtection != nil {
+		in, out := &in.CrawlerProtection, &out.CrawlerProtection
+		*out = new(string)
+		**out = **in
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BotManagementObservation.
@@ -206,6 +212,11 @@ func (in *BotManagementParameters) DeepCopyInto(out *BotManagementParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CrawlerProtection != nil {
+		in, out := &in.CrawlerProtection, &out.CrawlerProtection
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BotManagementParameters.
@@ -396,6 +407,7 @@ func (in *RateLimitObservation) DeepCopyInto(out *RateLimitObservation) {
 		*out = new(RateLimitCorrelate)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitObservation.
@@ -575,6 +587,185 @@ func (in *RateLimitTrafficMatcher) DeepCopy() *RateLimitTrafficMatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityHeader) DeepCopyInto(out *SecurityHeader) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHeader.
+func (in *SecurityHeader) DeepCopy() *SecurityHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityHeader) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityHeaderList) DeepCopyInto(out *SecurityHeaderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHeaderList.
+func (in *SecurityHeaderList) DeepCopy() *SecurityHeaderList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityHeaderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityHeaderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityHeaderObservation) DeepCopyInto(out *SecurityHeaderObservation) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IncludeSubdomains != nil {
+		in, out := &in.IncludeSubdomains, &out.IncludeSubdomains
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Preload != nil {
+		in, out := &in.Preload, &out.Preload
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NoSniff != nil {
+		in, out := &in.NoSniff, &out.NoSniff
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ModifiedOn != nil {
+		in, out := &in.ModifiedOn, &out.ModifiedOn
+		*out = new(string)
+		**out = **in
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHeaderObservation.
+func (in *SecurityHeaderObservation) DeepCopy() *SecurityHeaderObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityHeaderObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityHeaderParameters) DeepCopyInto(out *SecurityHeaderParameters) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IncludeSubdomains != nil {
+		in, out := &in.IncludeSubdomains, &out.IncludeSubdomains
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Preload != nil {
+		in, out := &in.Preload, &out.Preload
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NoSniff != nil {
+		in, out := &in.NoSniff, &out.NoSniff
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHeaderParameters.
+func (in *SecurityHeaderParameters) DeepCopy() *SecurityHeaderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityHeaderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityHeaderSpec) DeepCopyInto(out *SecurityHeaderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHeaderSpec.
+func (in *SecurityHeaderSpec) DeepCopy() *SecurityHeaderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityHeaderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityHeaderStatus) DeepCopyInto(out *SecurityHeaderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHeaderStatus.
+func (in *SecurityHeaderStatus) DeepCopy() *SecurityHeaderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityHeaderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Turnstile) DeepCopyInto(out *Turnstile) {
 	*out = *in
@@ -670,6 +861,15 @@ func (in *TurnstileObservation) DeepCopyInto(out *TurnstileObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PreviousMode != nil {
+		in, out := &in.PreviousMode, &out.PreviousMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModeChangedAt != nil {
+		in, out := &in.ModeChangedAt, &out.ModeChangedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.BotFightMode != nil {
 		in, out := &in.BotFightMode, &out.BotFightMode
 		*out = new(bool)
@@ -685,6 +885,22 @@ func (in *TurnstileObservation) DeepCopyInto(out *TurnstileObservation) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ClearanceLevel != nil {
+		in, out := &in.ClearanceLevel, &out.ClearanceLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreClearance != nil {
+		in, out := &in.PreClearance, &out.PreClearance
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EphemeralID != nil {
+		in, out := &in.EphemeralID, &out.EphemeralID
+		*out = new(bool)
+		**out = **in
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TurnstileObservation.
@@ -710,6 +926,11 @@ func (in *TurnstileParameters) DeepCopyInto(out *TurnstileParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AllowModeMigration != nil {
+		in, out := &in.AllowModeMigration, &out.AllowModeMigration
+		*out = new(bool)
+		**out = **in
+	}
 	if in.BotFightMode != nil {
 		in, out := &in.BotFightMode, &out.BotFightMode
 		*out = new(bool)
@@ -725,6 +946,21 @@ func (in *TurnstileParameters) DeepCopyInto(out *TurnstileParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ClearanceLevel != nil {
+		in, out := &in.ClearanceLevel, &out.ClearanceLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.EphemeralID != nil {
+		in, out := &in.EphemeralID, &out.EphemeralID
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdoptExisting != nil {
+		in, out := &in.AdoptExisting, &out.AdoptExisting
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TurnstileParameters.
@@ -37,6 +37,15 @@ func (l *RateLimitList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this SecurityHeaderList.
+func (l *SecurityHeaderList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this TurnstileList.
 func (l *TurnstileList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
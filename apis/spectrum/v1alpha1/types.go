@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
 )
 
@@ -150,6 +151,8 @@ type ApplicationParameters struct {
 type ApplicationObservation struct {
 	CreatedOn  *metav1.Time `json:"createdOn,omitempty"`
 	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // A ApplicationSpec defines the desired state of a Spectrum Application.
@@ -95,6 +95,7 @@ func (in *ApplicationObservation) DeepCopyInto(out *ApplicationObservation) {
 		in, out := &in.ModifiedOn, &out.ModifiedOn
 		*out = (*in).DeepCopy()
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationObservation.
@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // CertificatePackParameters define the desired state of a Cloudflare Certificate Pack.
@@ -60,6 +62,48 @@ type CertificatePackParameters struct {
 	// CloudflareBranding indicates whether to show Cloudflare branding on the certificate.
 	// +optional
 	CloudflareBranding *bool `json:"cloudflareBranding,omitempty"`
+
+	// ExpiryAlert creates an account notification policy alerting the
+	// supplied destinations of the validation, issuance, renewal and
+	// expiry of the advanced certificates of the zone. The policy is
+	// deleted with the Certificate Pack, or when this is removed.
+	// +optional
+	ExpiryAlert *CertificatePackExpiryAlert `json:"expiryAlert,omitempty"`
+}
+
+// CertificatePackExpiryAlert is the notification policy of a Certificate Pack.
+// +kubebuilder:validation:XValidation:rule="(has(self.emails) && size(self.emails) > 0) || (has(self.webhooks) && size(self.webhooks) > 0) || (has(self.pagerDuty) && size(self.pagerDuty) > 0)",message="at least one of emails, webhooks or pagerDuty must be set"
+type CertificatePackExpiryAlert struct {
+	// Enabled alerts. Disabling them keeps the policy, but sends nothing.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// AccountID is the account of the zone, which owns the policy.
+	// +kubebuilder:validation:MinLength=1
+	AccountID string `json:"accountId"`
+
+	// Emails are the addresses alerted.
+	// +optional
+	Emails []string `json:"emails,omitempty"`
+
+	// Webhooks are the IDs of the webhook destinations alerted.
+	// +optional
+	Webhooks []string `json:"webhooks,omitempty"`
+
+	// PagerDuty are the IDs of the PagerDuty services alerted.
+	// +optional
+	PagerDuty []string `json:"pagerDuty,omitempty"`
+}
+
+// CertificatePackExpiryAlertObservation is the observed notification policy
+// of a Certificate Pack.
+type CertificatePackExpiryAlertObservation struct {
+	// AccountID is the account owning the policy.
+	AccountID string `json:"accountId,omitempty"`
+
+	// PolicyID is the ID of the notification policy.
+	PolicyID string `json:"policyId,omitempty"`
 }
 
 // SSLValidationRecord represents SSL validation information.
@@ -147,6 +191,12 @@ type CertificatePackObservation struct {
 
 	// ValidationErrors contain any validation errors.
 	ValidationErrors []SSLValidationError `json:"validationErrors,omitempty"`
+
+	// ExpiryAlert is the notification policy managed for the Certificate
+	// Pack, if any.
+	ExpiryAlert *CertificatePackExpiryAlertObservation `json:"expiryAlert,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // CertificatePackSpec defines the desired state of Certificate Pack.
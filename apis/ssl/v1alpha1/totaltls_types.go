@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // TotalTLSParameters define the desired state of Cloudflare Total TLS for a zone.
@@ -56,6 +58,8 @@ type TotalTLSObservation struct {
 
 	// ValidityDays is the number of days the certificate is valid.
 	ValidityDays *int `json:"validityDays,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // TotalTLSSpec defines the desired state of Total TLS.
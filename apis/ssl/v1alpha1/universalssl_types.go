@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // UniversalSSLParameters define the desired state of Cloudflare Universal SSL for a zone.
@@ -38,6 +40,8 @@ type UniversalSSLParameters struct {
 type UniversalSSLObservation struct {
 	// Enabled indicates whether Universal SSL is enabled for this zone.
 	Enabled *bool `json:"enabled,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // UniversalSSLSpec defines the desired state of Universal SSL.
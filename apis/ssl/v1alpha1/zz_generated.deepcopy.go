@@ -98,6 +98,56 @@ func (in *CertificatePack) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatePackExpiryAlert) DeepCopyInto(out *CertificatePackExpiryAlert) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Emails != nil {
+		in, out := &in.Emails, &out.Emails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePackExpiryAlert.
+func (in *CertificatePackExpiryAlert) DeepCopy() *CertificatePackExpiryAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatePackExpiryAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatePackExpiryAlertObservation) DeepCopyInto(out *CertificatePackExpiryAlertObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePackExpiryAlertObservation.
+func (in *CertificatePackExpiryAlertObservation) DeepCopy() *CertificatePackExpiryAlertObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatePackExpiryAlertObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificatePackList) DeepCopyInto(out *CertificatePackList) {
 	*out = *in
@@ -199,6 +249,12 @@ func (in *CertificatePackObservation) DeepCopyInto(out *CertificatePackObservati
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExpiryAlert != nil {
+		in, out := &in.ExpiryAlert, &out.ExpiryAlert
+		*out = new(CertificatePackExpiryAlertObservation)
+		**out = **in
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePackObservation.
@@ -234,6 +290,11 @@ func (in *CertificatePackParameters) DeepCopyInto(out *CertificatePackParameters
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ExpiryAlert != nil {
+		in, out := &in.ExpiryAlert, &out.ExpiryAlert
+		*out = new(CertificatePackExpiryAlert)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePackParameters.
@@ -417,6 +478,7 @@ func (in *TotalTLSObservation) DeepCopyInto(out *TotalTLSObservation) {
 		*out = new(int)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TotalTLSObservation.
@@ -560,6 +622,7 @@ func (in *UniversalSSLObservation) DeepCopyInto(out *UniversalSSLObservation) {
 		*out = new(bool)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UniversalSSLObservation.
@@ -28,6 +28,7 @@ import (
 	"github.com/pkg/errors"
 
 	dns "github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	zone "github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
 )
 
@@ -205,6 +206,8 @@ type CustomHostnameObservation struct {
 	OwnershipVerification CustomHostnameOwnershipVerification `json:"ownershipVerification,omitempty"`
 	VerificationErrors    []string                            `json:"verificationErrors,omitempty"`
 	SSL                   CustomHostnameSSLObserved           `json:"ssl,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // A CustomHostnameSpec defines the desired state of a custom hostname.
@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dns "github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	zone "github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -64,11 +65,18 @@ type FallbackOriginParameters struct {
 
 // FallbackOriginObservation are the observable fields of a Fallback Origin.
 type FallbackOriginObservation struct {
-	// Status of the fallback origin and if its completed deployment
+	// Origin currently configured as the Fallback Origin.
+	Origin string `json:"origin,omitempty"`
+
+	// Status of the fallback origin and if its completed deployment.
+	// One of initializing, pending_deployment, pending_deletion, active,
+	// deployment_timed_out or deletion_timed_out.
 	Status string `json:"status,omitempty"`
 
 	// Errors if there any of the fallback origin
 	Errors []string `json:"errors,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // A FallbackOriginSpec defines the desired state of a Fallback Origin.
@@ -89,6 +97,9 @@ type FallbackOriginStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ORIGIN",type="string",JSONPath=".status.atProvider.origin"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
 type FallbackOrigin struct {
 	metav1.TypeMeta   `json:",inline"`
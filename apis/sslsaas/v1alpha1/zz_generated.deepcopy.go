@@ -94,6 +94,7 @@ func (in *CustomHostnameObservation) DeepCopyInto(out *CustomHostnameObservation
 		copy(*out, *in)
 	}
 	in.SSL.DeepCopyInto(&out.SSL)
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomHostnameObservation.
@@ -444,6 +445,7 @@ func (in *FallbackOriginObservation) DeepCopyInto(out *FallbackOriginObservation
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackOriginObservation.
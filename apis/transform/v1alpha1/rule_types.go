@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // Transform Rule phases for different types of transformations
@@ -163,6 +165,8 @@ type RuleObservation struct {
 
 	// LastUpdated indicates when the rule was last modified
 	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // RuleSpec defines the desired state of Rule
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// An APIError is an error returned by the Cloudflare API.
+type APIError struct {
+	// Code is the Cloudflare error code, or the HTTP status code when the
+	// response carried no error code.
+	Code int `json:"code,omitempty"`
+
+	// Message describes the error.
+	Message string `json:"message"`
+
+	// RayID identifies the failed request to Cloudflare support.
+	// +optional
+	RayID string `json:"rayID,omitempty"`
+
+	// Timestamp is when the error was observed.
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// A FieldDiff is a field of a managed resource whose desired value differs
+// from the value observed in the external resource.
+type FieldDiff struct {
+	// Path of the field, relative to spec.forProvider.
+	Path string `json:"path"`
+
+	// Desired value of the field. Values of sensitive fields are redacted.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Observed value of the field. Values of sensitive fields are redacted.
+	// +optional
+	Observed string `json:"observed,omitempty"`
+}
+
+// APIErrorObservation is embedded in the observation of each managed
+// resource to surface the last error returned by the Cloudflare API, and
+// why the resource was last updated.
+type APIErrorObservation struct {
+	// LastAPIError is the error returned by the Cloudflare API the last
+	// time the resource was reconciled. It is cleared once the resource
+	// is observed successfully.
+	// +optional
+	LastAPIError *APIError `json:"lastAPIError,omitempty"`
+
+	// LastUpdateDiff lists the fields that differed between the desired
+	// and the observed state the last time the external resource was
+	// found to be out of date. Fields the observation does not report are
+	// not listed.
+	// +optional
+	LastUpdateDiff []FieldDiff `json:"lastUpdateDiff,omitempty"`
+}
+
+// An APIErrorRecorder records the last error returned by the Cloudflare API
+// in the status of a managed resource.
+// +kubebuilder:object:generate=false
+type APIErrorRecorder interface {
+	SetLastAPIError(e *APIError)
+}
+
+// An UpdateDiffRecorder records why the external resource of a managed
+// resource was last found to be out of date in its status.
+// +kubebuilder:object:generate=false
+type UpdateDiffRecorder interface {
+	SetLastUpdateDiff(d []FieldDiff)
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TypeIssued resources have a certificate that was issued by its
+// certificate authority. Readiness only reflects that a resource exists, so
+// compositions that need a usable certificate should check this condition.
+const TypeIssued xpv1.ConditionType = "Issued"
+
+// Reasons a resource's certificate is or is not issued.
+const (
+	ReasonIssued          xpv1.ConditionReason = "Issued"
+	ReasonPendingIssuance xpv1.ConditionReason = "PendingIssuance"
+)
+
+// Issued returns a condition that indicates a resource's certificate was
+// issued.
+func Issued() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeIssued,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonIssued,
+	}
+}
+
+// PendingIssuance returns a condition that indicates a resource's
+// certificate is not issued yet, with the status reported by Cloudflare.
+func PendingIssuance(status string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeIssued,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPendingIssuance,
+		Message:            "Certificate status is " + status,
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DefaultProviderConfigSpec defines which managed resources a
+// ProviderConfig is the default for.
+type DefaultProviderConfigSpec struct {
+	// ProviderConfigRef references the ProviderConfig used by matching
+	// managed resources.
+	ProviderConfigRef xpv1.Reference `json:"providerConfigRef"`
+
+	// Kinds limits this default to managed resources of the supplied
+	// kinds, e.g. Record.dns.cloudflare.crossplane.io. Resources of any
+	// kind match when empty.
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+
+	// Selector limits this default to managed resources whose labels
+	// match. Resources with any labels match when unset.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DefaultProviderConfig points managed resources that reference the
+// ProviderConfig named default at another ProviderConfig. When several
+// DefaultProviderConfigs match a resource the most specific one, i.e. the
+// one constraining both kinds and labels, wins; ties are broken by name.
+// +kubebuilder:printcolumn:name="CONFIG-NAME",type="string",JSONPath=".spec.providerConfigRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,cloudflare}
+type DefaultProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DefaultProviderConfigSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// DefaultProviderConfigList contains a list of DefaultProviderConfig.
+type DefaultProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DefaultProviderConfig `json:"items"`
+}
@@ -58,7 +58,16 @@ var (
 	ProviderConfigUsageListGroupVersionKind = SchemeGroupVersion.WithKind(ProviderConfigUsageListKind)
 )
 
+// DefaultProviderConfig type metadata.
+var (
+	DefaultProviderConfigKind             = reflect.TypeOf(DefaultProviderConfig{}).Name()
+	DefaultProviderConfigGroupKind        = schema.GroupKind{Group: Group, Kind: DefaultProviderConfigKind}.String()
+	DefaultProviderConfigKindAPIVersion   = DefaultProviderConfigKind + "." + SchemeGroupVersion.String()
+	DefaultProviderConfigGroupVersionKind = SchemeGroupVersion.WithKind(DefaultProviderConfigKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ProviderConfig{}, &ProviderConfigList{})
 	SchemeBuilder.Register(&ProviderConfigUsage{}, &ProviderConfigUsageList{})
+	SchemeBuilder.Register(&DefaultProviderConfig{}, &DefaultProviderConfigList{})
 }
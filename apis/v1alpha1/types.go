@@ -26,6 +26,100 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// MetadataPropagation writes selected labels and annotations of the
+	// managed resources using this ProviderConfig to Cloudflare, where the
+	// resource supports tags or comments, so that they can be identified
+	// in the dashboard. Nothing is propagated when unset.
+	// +optional
+	MetadataPropagation *MetadataPropagation `json:"metadataPropagation,omitempty"`
+
+	// AccountID is the default account of managed resources using this
+	// ProviderConfig that target an account but do not specify one.
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Ownership tags the resources created with this ProviderConfig with
+	// the cluster and managed resource that own them, where the resource
+	// supports tags, so that two clusters managing the same account do not
+	// fight over a resource. Ownership is not tracked when unset.
+	// +optional
+	Ownership *Ownership `json:"ownership,omitempty"`
+
+	// RequestPolicy tunes how requests made with these credentials are
+	// timed out, retried and rate limited. The provider's defaults apply
+	// when unset.
+	// +optional
+	RequestPolicy *RequestPolicy `json:"requestPolicy,omitempty"`
+}
+
+// RequestPolicy tunes the Cloudflare API requests of a ProviderConfig.
+type RequestPolicy struct {
+	// Timeout of a single API request. It replaces the
+	// --api-call-timeout flag, but not a controller's
+	// --api-call-timeout-override. Zero disables the timeout.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Retries of a request that failed with a server error or was rate
+	// limited. Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	Retries *int `json:"retries,omitempty"`
+
+	// RetryBackoff bounds the delay between retries, which doubles after
+	// each attempt.
+	// +optional
+	RetryBackoff *RetryBackoff `json:"retryBackoff,omitempty"`
+
+	// RateLimit is the number of requests per second that may be made with
+	// these credentials by each controller. Defaults to 4, which matches
+	// Cloudflare's limit of 1200 requests per 5 minutes.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	RateLimit *int `json:"rateLimit,omitempty"`
+}
+
+// RetryBackoff bounds the delay between retries of a request. Delays are
+// rounded up to whole seconds.
+type RetryBackoff struct {
+	// Min is the delay before the first retry. Defaults to 1s.
+	// +optional
+	Min *metav1.Duration `json:"min,omitempty"`
+
+	// Max is the longest delay between retries. Defaults to 30s.
+	// +optional
+	Max *metav1.Duration `json:"max,omitempty"`
+}
+
+// Ownership identifies the cluster managing resources in Cloudflare.
+type Ownership struct {
+	// ClusterID identifies this cluster among those managing the same
+	// Cloudflare account. Resources tagged as owned by another cluster are
+	// not managed unless their managed resource takes ownership of them.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9._-]+$`
+	ClusterID string `json:"clusterId"`
+}
+
+// MetadataPropagation selects the Kubernetes metadata of managed resources
+// that is written to Cloudflare.
+type MetadataPropagation struct {
+	// Labels whose keys are listed are written as key:value tags.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// Annotations whose keys are listed are written as key:value tags.
+	// +optional
+	Annotations []string `json:"annotations,omitempty"`
+
+	// CommentAnnotation is the key of an annotation whose value is written
+	// as the comment of resources that have one, such as DNS Records.
+	// +optional
+	CommentAnnotation *string `json:"commentAnnotation,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -40,6 +134,31 @@ type ProviderCredentials struct {
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// ProviderInfo describes the build of the provider reconciling this
+	// ProviderConfig.
+	// +optional
+	ProviderInfo *ProviderInfo `json:"providerInfo,omitempty"`
+}
+
+// ProviderInfo describes the build of a running provider, to help triage
+// issues that only affect some versions.
+type ProviderInfo struct {
+	// Version of the provider.
+	Version string `json:"version"`
+
+	// CloudflareGoVersion is the version of the cloudflare-go library the
+	// provider was built with.
+	CloudflareGoVersion string `json:"cloudflareGoVersion"`
+
+	// GoVersion is the version of Go the provider was built with.
+	// +optional
+	GoVersion string `json:"goVersion,omitempty"`
+
+	// ResourceGroups are the API groups of the resources the provider
+	// reconciles.
+	// +optional
+	ResourceGroups []string `json:"resourceGroups,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // CronTriggerParameters are the configurable fields of a Workers Cron Trigger.
@@ -31,7 +33,18 @@ type CronTriggerParameters struct {
 	// Cron is the cron expression for the schedule.
 	// Examples: "0 0 * * *" (daily at midnight), "*/5 * * * *" (every 5 minutes)
 	// Documentation: https://developers.cloudflare.com/workers/platform/cron-triggers/
+	// Equivalent expressions, such as "0 9 * * MON,FRI" and "0 9 * * 5,1",
+	// are treated as the same schedule.
 	Cron string `json:"cron"`
+
+	// Timezone is the IANA timezone the cron expression is written in,
+	// e.g. Europe/London. Cloudflare runs cron triggers in UTC, so the
+	// expression is translated to UTC and updated when the timezone's
+	// offset changes, e.g. for daylight saving time. Its minutes and hours
+	// must then be lists of numbers, or * for hours. Defaults to UTC.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 // CronTriggerObservation are the observable fields of a Workers Cron Trigger.
@@ -39,7 +52,7 @@ type CronTriggerObservation struct {
 	// ScriptName is the name of the Worker script.
 	ScriptName string `json:"scriptName,omitempty"`
 
-	// Cron is the cron expression for the schedule.
+	// Cron is the cron expression for the schedule, in UTC.
 	Cron string `json:"cron,omitempty"`
 
 	// CreatedOn is when the cron trigger was created.
@@ -47,6 +60,8 @@ type CronTriggerObservation struct {
 
 	// ModifiedOn is when the cron trigger was last modified.
 	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // A CronTriggerSpec defines the desired state of a Workers Cron Trigger.
@@ -69,6 +84,7 @@ type CronTriggerStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="SCRIPT",type="string",JSONPath=".spec.forProvider.scriptName"
 // +kubebuilder:printcolumn:name="CRON",type="string",JSONPath=".spec.forProvider.cron"
+// +kubebuilder:printcolumn:name="TIMEZONE",type="string",JSONPath=".spec.forProvider.timezone",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
 type CronTrigger struct {
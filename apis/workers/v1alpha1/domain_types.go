@@ -21,13 +21,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // DomainParameters define the desired state of a Cloudflare Workers Custom Domain.
 type DomainParameters struct {
 	// AccountID is the account identifier to target for the resource.
-	// +required
-	AccountID string `json:"accountId"`
+	// Defaults to the account ID of the ProviderConfig when omitted.
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
 
 	// ZoneID is the zone identifier where the custom domain will be created.
 	// +required
@@ -35,6 +38,7 @@ type DomainParameters struct {
 
 	// Hostname is the custom hostname to attach the Worker to.
 	// +required
+	// +kubebuilder:validation:Format=hostname
 	Hostname string `json:"hostname"`
 
 	// Service is the name of the Worker Script to attach to this domain.
@@ -43,9 +47,17 @@ type DomainParameters struct {
 
 	// Environment is the environment to use for this domain attachment.
 	// Valid values: "production", "staging"
-	// +required
 	// +kubebuilder:validation:Enum=production;staging
-	Environment string `json:"environment"`
+	// +kubebuilder:default=production
+	// +optional
+	Environment string `json:"environment,omitempty"`
+
+	// OverwriteExisting allows the controller to adopt or re-point an existing
+	// attachment for the same hostname when attaching fails because the
+	// hostname is already attached to another service. The previously
+	// attached service is recorded in an event.
+	// +optional
+	OverwriteExisting *bool `json:"overwriteExisting,omitempty"`
 }
 
 // DomainObservation are the observable fields of a Workers Custom Domain.
@@ -67,6 +79,12 @@ type DomainObservation struct {
 
 	// Environment is the environment used for this domain attachment.
 	Environment *string `json:"environment,omitempty"`
+
+	// CertificateStatus is whether the edge certificate of the hostname is
+	// pending, active or errored. The Domain is only ready once it is active.
+	CertificateStatus *string `json:"certificateStatus,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // DomainSpec defines the desired state of Domain.
@@ -87,6 +105,7 @@ type DomainStatus struct {
 // +kubebuilder:printcolumn:name="HOSTNAME",type="string",JSONPath=".status.atProvider.hostname"
 // +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".status.atProvider.service"
 // +kubebuilder:printcolumn:name="ENV",type="string",JSONPath=".status.atProvider.environment"
+// +kubebuilder:printcolumn:name="CERTIFICATE",type="string",JSONPath=".status.atProvider.certificateStatus",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // KVNamespaceParameters are the configurable fields of a Workers KV Namespace.
@@ -35,6 +37,8 @@ type KVNamespaceObservation struct {
 
 	// Title is the human-readable name of the KV namespace.
 	Title string `json:"title,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // A KVNamespaceSpec defines the desired state of a Workers KV Namespace.
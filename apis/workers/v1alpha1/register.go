@@ -85,6 +85,14 @@ var (
 	SubdomainGroupVersionKind = SchemeGroupVersion.WithKind(SubdomainKind)
 )
 
+// TailConsumer type metadata.
+var (
+	TailConsumerKind             = reflect.TypeOf(TailConsumer{}).Name()
+	TailConsumerGroupKind        = schema.GroupKind{Group: Group, Kind: TailConsumerKind}.String()
+	TailConsumerKindAPIVersion   = TailConsumerKind + "." + SchemeGroupVersion.String()
+	TailConsumerGroupVersionKind = SchemeGroupVersion.WithKind(TailConsumerKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Route{}, &RouteList{})
 	SchemeBuilder.Register(&Script{}, &ScriptList{})
@@ -92,4 +100,5 @@ func init() {
 	SchemeBuilder.Register(&CronTrigger{}, &CronTriggerList{})
 	SchemeBuilder.Register(&Domain{}, &DomainList{})
 	SchemeBuilder.Register(&Subdomain{}, &SubdomainList{})
+	SchemeBuilder.Register(&TailConsumer{}, &TailConsumerList{})
 }
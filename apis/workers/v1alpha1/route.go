@@ -26,6 +26,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/pkg/errors"
 
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
 )
 
@@ -55,7 +56,9 @@ type RouteParameters struct {
 }
 
 // RouteObservation is the observable fields of a Worker Route.
-type RouteObservation struct{}
+type RouteObservation struct {
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
 
 // A RouteSpec defines the desired state of a Worker Route.
 type RouteSpec struct {
@@ -77,6 +80,7 @@ type RouteStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="PATTERN",type="string",JSONPath=".spec.forProvider.pattern"
 // +kubebuilder:printcolumn:name="SCRIPT",type="string",JSONPath=".spec.forProvider.script"
+// +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
 type Route struct {
 	metav1.TypeMeta   `json:",inline"`
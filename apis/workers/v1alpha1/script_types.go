@@ -17,9 +17,18 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // PlacementMode represents the placement mode for a Worker script.
@@ -32,18 +41,49 @@ const (
 	PlacementModeSmart PlacementMode = "smart"
 )
 
+// DependentsPolicy controls what happens to the resources that depend on a
+// Worker when it is deleted.
+type DependentsPolicy string
+
+const (
+	// DependentsPolicyBlock leaves the Worker in place while any resource
+	// depends on it.
+	DependentsPolicyBlock DependentsPolicy = "Block"
+	// DependentsPolicyDetach removes the resources that depend on the
+	// Worker before deleting it.
+	DependentsPolicyDetach DependentsPolicy = "Detach"
+)
+
 // WorkerBinding represents different types of bindings available to Workers.
+// +kubebuilder:validation:XValidation:rule="!(has(self.json) && has(self.value))",message="json and value are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.value) || self.type == 'json_data'",message="value may only be set for json_data bindings"
+// +kubebuilder:validation:XValidation:rule="self.type != 'service' || has(self.service) || has(self.serviceRef) || has(self.serviceSelector)",message="service bindings require service, serviceRef or serviceSelector"
+// +kubebuilder:validation:XValidation:rule="!(has(self.service) || has(self.serviceRef) || has(self.serviceSelector) || has(self.environment)) || self.type == 'service'",message="service, serviceRef, serviceSelector and environment may only be set for service bindings"
+// +kubebuilder:validation:XValidation:rule="self.type != 'ratelimit' || (has(self.namespaceId) && has(self.rateLimit))",message="ratelimit bindings require namespaceId and rateLimit"
+// +kubebuilder:validation:XValidation:rule="!has(self.rateLimit) || self.type == 'ratelimit'",message="rateLimit may only be set for ratelimit bindings"
+// +kubebuilder:validation:XValidation:rule="!(self.name in ['break', 'case', 'catch', 'class', 'const', 'continue', 'debugger', 'default', 'delete', 'do', 'else', 'enum', 'export', 'extends', 'false', 'finally', 'for', 'function', 'if', 'import', 'in', 'instanceof', 'new', 'null', 'return', 'super', 'switch', 'this', 'throw', 'true', 'try', 'typeof', 'var', 'void', 'while', 'with', 'yield', 'let', 'static', 'implements', 'interface', 'package', 'private', 'protected', 'public', 'await'])",message="name must not be a reserved word of JavaScript"
 type WorkerBinding struct {
-	// Type specifies the binding type (kv_namespace, wasm_module, text_blob, json_data, etc.)
+	// Type specifies the binding type (kv_namespace, wasm_module, text_blob,
+	// json_data, service, browser, ratelimit, etc.)
 	Type string `json:"type"`
 
-	// Name is the variable name used in the Worker script to access this binding.
+	// Name is the variable name used in the Worker script to access this
+	// binding. It must be a valid JavaScript identifier that is not a
+	// reserved word, and unique among the bindings of the Worker.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_$][A-Za-z0-9_$]*$`
+	// +kubebuilder:validation:MaxLength=255
 	Name string `json:"name"`
 
-	// NamespaceID for KV namespace bindings.
+	// NamespaceID for KV namespace bindings, or the ID of the rate limiter
+	// of ratelimit bindings. Workers binding the same rate limiter share
+	// its counters.
 	// +optional
 	NamespaceID *string `json:"namespaceId,omitempty"`
 
+	// RateLimit configures a ratelimit binding.
+	// +optional
+	RateLimit *WorkerRateLimit `json:"rateLimit,omitempty"`
+
 	// Part for WASM module bindings.
 	// +optional
 	Part *string `json:"part,omitempty"`
@@ -55,10 +95,44 @@ type WorkerBinding struct {
 	// JSON for JSON data bindings (as string).
 	// +optional
 	JSON *string `json:"json,omitempty"`
+
+	// Value for JSON data bindings, as a structured alternative to JSON.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Value *runtime.RawExtension `json:"value,omitempty"`
+
+	// Service is the name of the Worker script a service binding calls.
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// ServiceRef references the Script a service binding calls.
+	// +optional
+	ServiceRef *xpv1.Reference `json:"serviceRef,omitempty"`
+
+	// ServiceSelector selects the Script a service binding calls.
+	// +optional
+	ServiceSelector *xpv1.Selector `json:"serviceSelector,omitempty"`
+
+	// Environment of the Worker a service binding calls. Defaults to the
+	// production environment.
+	// +optional
+	Environment *string `json:"environment,omitempty"`
 }
 
-// TailConsumer represents a Worker that consumes logs from another Worker.
-type TailConsumer struct {
+// WorkerRateLimit is the limit applied by a ratelimit binding.
+type WorkerRateLimit struct {
+	// Limit is the number of requests allowed per period.
+	// +kubebuilder:validation:Minimum=1
+	Limit int64 `json:"limit"`
+
+	// Period in seconds over which requests are counted.
+	// +kubebuilder:validation:Enum=10;60
+	Period int64 `json:"period"`
+}
+
+// ScriptTailConsumer represents a Worker that consumes logs from another
+// Worker.
+type ScriptTailConsumer struct {
 	// Service is the name of the Worker service that will consume logs.
 	Service string `json:"service"`
 
@@ -71,12 +145,132 @@ type TailConsumer struct {
 	Namespace *string `json:"namespace,omitempty"`
 }
 
+// GradualRollout splits traffic between the most recently uploaded version
+// of a Worker and a previous version.
+type GradualRollout struct {
+	// PreviousVersionID is the ID of the version that serves the traffic
+	// not sent to the most recently uploaded version.
+	PreviousVersionID string `json:"previousVersionId"`
+
+	// Percentage of traffic sent to the most recently uploaded version.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int32 `json:"percentage"`
+}
+
+// DeploymentVersion is a version of a Worker and the percentage of traffic
+// it serves.
+type DeploymentVersion struct {
+	// VersionID is the ID of the version.
+	VersionID string `json:"versionId"`
+
+	// Percentage of traffic the version serves.
+	Percentage int32 `json:"percentage"`
+}
+
+// ScriptDeployment is the deployment currently serving a Worker.
+type ScriptDeployment struct {
+	// ID of the deployment.
+	ID string `json:"id,omitempty"`
+
+	// Versions served by the deployment.
+	Versions []DeploymentVersion `json:"versions,omitempty"`
+}
+
+// WorkerAssets are the static assets a Worker serves. They are read from a
+// tar archive, either a layer of an OCI image or one downloaded from a URL,
+// and uploaded along with the Worker whenever their source changes.
+// +kubebuilder:validation:XValidation:rule="has(self.image) != has(self.url)",message="exactly one of image and url must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.layer) || has(self.image)",message="layer may only be set for image assets"
+type WorkerAssets struct {
+	// Image is the reference of an OCI image holding the assets, e.g.
+	// ghcr.io/example/site@sha256:<digest>. The image must be pullable
+	// without credentials. Assets are only uploaded again when the
+	// reference changes, so images should be referenced by digest.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Layer is the digest of the image layer holding the assets. Defaults
+	// to the last layer of the image.
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	// +optional
+	Layer *string `json:"layer,omitempty"`
+
+	// URL of a tar archive, optionally gzip compressed, holding the
+	// assets. Assets are only uploaded again when the URL changes.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// Directory of the image layer or archive holding the assets, e.g.
+	// dist. Defaults to its root.
+	// +optional
+	Directory *string `json:"directory,omitempty"`
+
+	// Binding is the name of the binding through which the Worker fetches
+	// its assets. Assets are only served directly when unset.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_$][A-Za-z0-9_$]*$`
+	// +optional
+	Binding *string `json:"binding,omitempty"`
+
+	// HTMLHandling controls how requests for HTML pages are matched to
+	// assets. Defaults to auto-trailing-slash.
+	// Documentation: https://developers.cloudflare.com/workers/static-assets/routing/#html_handling
+	// +kubebuilder:validation:Enum=auto-trailing-slash;force-trailing-slash;drop-trailing-slash;none
+	// +optional
+	HTMLHandling *string `json:"htmlHandling,omitempty"`
+
+	// NotFoundHandling controls what is served for requests matching no
+	// asset. Defaults to none.
+	// Documentation: https://developers.cloudflare.com/workers/static-assets/routing/#not_found_handling
+	// +kubebuilder:validation:Enum=none;404-page;single-page-application
+	// +optional
+	NotFoundHandling *string `json:"notFoundHandling,omitempty"`
+
+	// RunWorkerFirst invokes the Worker for every request, rather than only
+	// for requests matching no asset.
+	// +optional
+	RunWorkerFirst *bool `json:"runWorkerFirst,omitempty"`
+}
+
+// WorkerAssetsObservation describes the static assets last uploaded with a
+// Worker.
+type WorkerAssetsObservation struct {
+	// Source the assets were read from.
+	Source string `json:"source,omitempty"`
+
+	// ManifestHash is a hash of the paths and contents of the assets.
+	ManifestHash string `json:"manifestHash,omitempty"`
+
+	// Files is the number of assets.
+	Files int32 `json:"files,omitempty"`
+
+	// Binding the Worker fetches its assets through.
+	Binding *string `json:"binding,omitempty"`
+
+	// HTMLHandling the assets were uploaded with.
+	HTMLHandling *string `json:"htmlHandling,omitempty"`
+
+	// NotFoundHandling the assets were uploaded with.
+	NotFoundHandling *string `json:"notFoundHandling,omitempty"`
+
+	// RunWorkerFirst the assets were uploaded with.
+	RunWorkerFirst *bool `json:"runWorkerFirst,omitempty"`
+}
+
 // ScriptParameters are the configurable fields of a Worker Script.
+// +kubebuilder:validation:XValidation:rule="!(has(self.rollbackToVersion) && has(self.gradualRollout))",message="rollbackToVersion and gradualRollout are mutually exclusive"
 type ScriptParameters struct {
 	// ScriptName is the name of the Worker script.
 	// +immutable
 	ScriptName string `json:"scriptName"`
 
+	// AllowRecreate permits the Worker to be deleted and uploaded again
+	// under its new name when ScriptName changes. While unset a changed
+	// name is only reported by the RequiresReplacement condition.
+	// +optional
+	AllowRecreate *bool `json:"allowRecreate,omitempty"`
+
 	// Script is the JavaScript/WebAssembly content of the Worker.
 	Script string `json:"script"`
 
@@ -95,6 +289,8 @@ type ScriptParameters struct {
 	CompatibilityFlags []string `json:"compatibilityFlags,omitempty"`
 
 	// Bindings provide access to KV namespaces, WASM modules, and other resources.
+	// +listType=map
+	// +listMapKey=name
 	// +optional
 	Bindings []WorkerBinding `json:"bindings,omitempty"`
 
@@ -110,7 +306,7 @@ type ScriptParameters struct {
 	// TailConsumers specifies Workers that will consume logs from this Worker.
 	// Documentation: https://developers.cloudflare.com/workers/platform/tail-workers/
 	// +optional
-	TailConsumers []TailConsumer `json:"tailConsumers,omitempty"`
+	TailConsumers []ScriptTailConsumer `json:"tailConsumers,omitempty"`
 
 	// Tags help manage Workers at scale.
 	// Documentation: https://developers.cloudflare.com/cloudflare-for-platforms/workers-for-platforms/platform/tags/
@@ -120,6 +316,44 @@ type ScriptParameters struct {
 	// DispatchNamespace uploads the Worker to a Workers for Platforms dispatch namespace.
 	// +optional
 	DispatchNamespace *string `json:"dispatchNamespace,omitempty"`
+
+	// RollbackToVersion deploys the version with this ID to all traffic
+	// instead of the most recently uploaded version. Changes to the script
+	// and its settings are not uploaded while it is set. Not supported for
+	// Workers in a dispatch namespace.
+	// Documentation: https://developers.cloudflare.com/workers/configuration/versions-and-deployments/rollbacks/
+	// +optional
+	RollbackToVersion *string `json:"rollbackToVersion,omitempty"`
+
+	// GradualRollout splits traffic between the most recently uploaded
+	// version and a previous version. Not supported for Workers in a
+	// dispatch namespace.
+	// Documentation: https://developers.cloudflare.com/workers/configuration/versions-and-deployments/gradual-deployments/
+	// +optional
+	GradualRollout *GradualRollout `json:"gradualRollout,omitempty"`
+
+	// WorkersDev controls whether the Worker is served on its workers.dev
+	// subdomain, including preview URLs. Left unmanaged when unset. Not
+	// supported for Workers in a dispatch namespace.
+	// Documentation: https://developers.cloudflare.com/workers/configuration/routing/workers-dev/
+	// +optional
+	WorkersDev *bool `json:"workersDev,omitempty"`
+
+	// Assets are static assets served by the Worker, replacing Workers
+	// Sites. The Worker must be an ES module.
+	// Documentation: https://developers.cloudflare.com/workers/static-assets/
+	// +optional
+	Assets *WorkerAssets `json:"assets,omitempty"`
+
+	// DependentsPolicy controls what happens to the routes, custom
+	// domains, cron triggers and queue consumers of the Worker when it is
+	// deleted. Block, the default, leaves the Worker in place and lists
+	// them on the DeletionProtected condition until they are removed.
+	// Detach removes them before deleting the Worker. Not supported for
+	// Workers in a dispatch namespace.
+	// +kubebuilder:validation:Enum=Block;Detach
+	// +optional
+	DependentsPolicy *DependentsPolicy `json:"dependentsPolicy,omitempty"`
 }
 
 // ScriptObservation are the observable fields of a Worker Script.
@@ -153,6 +387,31 @@ type ScriptObservation struct {
 
 	// UsageModel indicates the billing model for the Worker.
 	UsageModel *string `json:"usageModel,omitempty"`
+
+	// LatestVersionID is the ID of the most recently uploaded version.
+	LatestVersionID *string `json:"latestVersionId,omitempty"`
+
+	// Deployment is the deployment currently serving the Worker.
+	Deployment *ScriptDeployment `json:"deployment,omitempty"`
+
+	// WorkersDev indicates whether the Worker is served on its workers.dev
+	// subdomain.
+	WorkersDev *bool `json:"workersDev,omitempty"`
+
+	// Assets are the static assets last uploaded with the Worker.
+	Assets *WorkerAssetsObservation `json:"assets,omitempty"`
+
+	// Vars are the plain text variables bound to the Worker, by name,
+	// including any set outside of Crossplane.
+	// +optional
+	Vars map[string]string `json:"vars,omitempty"`
+
+	// Secrets are the names of the secrets bound to the Worker. Secrets
+	// are not managed by the provider, so they are never compared.
+	// +optional
+	Secrets []string `json:"secrets,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // A ScriptSpec defines the desired state of a Worker Script.
@@ -194,3 +453,31 @@ type ScriptList struct {
 	Items           []Script `json:"items"`
 }
 
+// ResolveReferences resolves references to the Scripts that the service
+// bindings of this Script call.
+func (sc *Script) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, sc)
+
+	for i := range sc.Spec.ForProvider.Bindings {
+		b := &sc.Spec.ForProvider.Bindings[i]
+		if b.Type != "service" {
+			continue
+		}
+
+		// Resolve spec.forProvider.bindings[i].service
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(b.Service),
+			Reference:    b.ServiceRef,
+			Selector:     b.ServiceSelector,
+			To:           reference.To{Managed: &Script{}, List: &ScriptList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.bindings[%d].service", i))
+		}
+		b.Service = reference.ToPtrValue(rsp.ResolvedValue)
+		b.ServiceRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -21,23 +21,55 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// SubdomainDeletionBehavior is what happens to the workers.dev subdomain of
+// an account when its Subdomain is deleted.
+type SubdomainDeletionBehavior string
+
+// Subdomain deletion behaviors.
+const (
+	// SubdomainRetain leaves the workers.dev subdomain of the account, and
+	// the routing of Workers through it, as it is.
+	SubdomainRetain SubdomainDeletionBehavior = "Retain"
+
+	// SubdomainDisable deletes the workers.dev subdomain of the account, so
+	// that none of its Workers are reachable on workers.dev.
+	SubdomainDisable SubdomainDeletionBehavior = "Disable"
 )
 
 // SubdomainParameters define the desired state of a Cloudflare Workers Subdomain.
 type SubdomainParameters struct {
 	// AccountID is the account identifier to target for the resource.
-	// +required
-	AccountID string `json:"accountId"`
+	// Defaults to the account ID of the ProviderConfig when omitted.
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
 
 	// Name is the subdomain name to create (e.g., "myaccount" for myaccount.workers.dev).
 	// +required
 	Name string `json:"name"`
+
+	// DeletionBehavior is what happens to the workers.dev subdomain of the
+	// account when this Subdomain is deleted. Retain leaves it, and the
+	// routing of every Worker of the account through it, untouched.
+	// Disable deletes it, so that no Worker of the account is reachable on
+	// workers.dev until a subdomain is created again; routes and custom
+	// domains are not affected. Either way, a deletionPolicy of Orphan
+	// leaves the subdomain untouched.
+	// +kubebuilder:validation:Enum=Retain;Disable
+	// +kubebuilder:default=Retain
+	// +optional
+	DeletionBehavior SubdomainDeletionBehavior `json:"deletionBehavior,omitempty"`
 }
 
 // SubdomainObservation are the observable fields of a Workers Subdomain.
 type SubdomainObservation struct {
 	// Name is the subdomain name (e.g., "myaccount" for myaccount.workers.dev).
 	Name *string `json:"name,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
 }
 
 // SubdomainSpec defines the desired state of Subdomain.
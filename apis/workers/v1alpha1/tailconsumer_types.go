@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// TailConsumerParameters are the configurable fields of a TailConsumer.
+type TailConsumerParameters struct {
+	// AccountID is the account both Workers belong to.
+	// Defaults to the account ID of the ProviderConfig when omitted.
+	// +immutable
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// Producer is the name of the Worker whose tail events are consumed.
+	// +immutable
+	// +optional
+	Producer *string `json:"producer,omitempty"`
+
+	// ProducerRef references the Script whose tail events are consumed.
+	// +immutable
+	// +optional
+	ProducerRef *xpv1.Reference `json:"producerRef,omitempty"`
+
+	// ProducerSelector selects the Script whose tail events are consumed.
+	// +immutable
+	// +optional
+	ProducerSelector *xpv1.Selector `json:"producerSelector,omitempty"`
+
+	// Consumer is the name of the Worker that receives the tail events of
+	// the producer.
+	// +immutable
+	// +optional
+	Consumer *string `json:"consumer,omitempty"`
+
+	// ConsumerRef references the Script that receives the tail events of
+	// the producer.
+	// +immutable
+	// +optional
+	ConsumerRef *xpv1.Reference `json:"consumerRef,omitempty"`
+
+	// ConsumerSelector selects the Script that receives the tail events of
+	// the producer.
+	// +immutable
+	// +optional
+	ConsumerSelector *xpv1.Selector `json:"consumerSelector,omitempty"`
+}
+
+// TailConsumerObservation are the observable fields of a TailConsumer.
+type TailConsumerObservation struct {
+	// Consumers are all the Workers consuming the tail events of the
+	// producer, including those managed by other TailConsumers.
+	Consumers []string `json:"consumers,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A TailConsumerSpec defines the desired state of a TailConsumer.
+type TailConsumerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TailConsumerParameters `json:"forProvider"`
+}
+
+// A TailConsumerStatus represents the observed state of a TailConsumer.
+type TailConsumerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TailConsumerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TailConsumer sends the tail events of a producer Worker, such as its
+// logs and exceptions, to a consumer Worker.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRODUCER",type="string",JSONPath=".spec.forProvider.producer"
+// +kubebuilder:printcolumn:name="CONSUMER",type="string",JSONPath=".spec.forProvider.consumer"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type TailConsumer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TailConsumerSpec   `json:"spec"`
+	Status TailConsumerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TailConsumerList contains a list of TailConsumer objects
+type TailConsumerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TailConsumer `json:"items"`
+}
+
+// ResolveReferences resolves references to the producer and consumer
+// Scripts of this TailConsumer.
+func (tc *TailConsumer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, tc)
+
+	// Resolve spec.forProvider.producer
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(tc.Spec.ForProvider.Producer),
+		Reference:    tc.Spec.ForProvider.ProducerRef,
+		Selector:     tc.Spec.ForProvider.ProducerSelector,
+		To:           reference.To{Managed: &Script{}, List: &ScriptList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.producer")
+	}
+	tc.Spec.ForProvider.Producer = reference.ToPtrValue(rsp.ResolvedValue)
+	tc.Spec.ForProvider.ProducerRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.consumer
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(tc.Spec.ForProvider.Consumer),
+		Reference:    tc.Spec.ForProvider.ConsumerRef,
+		Selector:     tc.Spec.ForProvider.ConsumerSelector,
+		To:           reference.To{Managed: &Script{}, List: &ScriptList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.consumer")
+	}
+	tc.Spec.ForProvider.Consumer = reference.ToPtrValue(rsp.ResolvedValue)
+	tc.Spec.ForProvider.ConsumerRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -22,7 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -95,6 +95,7 @@ func (in *CronTriggerObservation) DeepCopyInto(out *CronTriggerObservation) {
 		in, out := &in.ModifiedOn, &out.ModifiedOn
 		*out = (*in).DeepCopy()
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronTriggerObservation.
@@ -110,6 +111,11 @@ func (in *CronTriggerObservation) DeepCopy() *CronTriggerObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronTriggerParameters) DeepCopyInto(out *CronTriggerParameters) {
 	*out = *in
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronTriggerParameters.
@@ -126,7 +132,7 @@ func (in *CronTriggerParameters) DeepCopy() *CronTriggerParameters {
 func (in *CronTriggerSpec) DeepCopyInto(out *CronTriggerSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronTriggerSpec.
@@ -156,6 +162,21 @@ func (in *CronTriggerStatus) DeepCopy() *CronTriggerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentVersion) DeepCopyInto(out *DeploymentVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentVersion.
+func (in *DeploymentVersion) DeepCopy() *DeploymentVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Domain) DeepCopyInto(out *Domain) {
 	*out = *in
@@ -248,6 +269,12 @@ func (in *DomainObservation) DeepCopyInto(out *DomainObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CertificateStatus != nil {
+		in, out := &in.CertificateStatus, &out.CertificateStatus
+		*out = new(string)
+		**out = **in
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainObservation.
@@ -263,6 +290,11 @@ func (in *DomainObservation) DeepCopy() *DomainObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainParameters) DeepCopyInto(out *DomainParameters) {
 	*out = *in
+	if in.OverwriteExisting != nil {
+		in, out := &in.OverwriteExisting, &out.OverwriteExisting
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainParameters.
@@ -279,7 +311,7 @@ func (in *DomainParameters) DeepCopy() *DomainParameters {
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSpec.
@@ -309,6 +341,21 @@ func (in *DomainStatus) DeepCopy() *DomainStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GradualRollout) DeepCopyInto(out *GradualRollout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GradualRollout.
+func (in *GradualRollout) DeepCopy() *GradualRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(GradualRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KVNamespace) DeepCopyInto(out *KVNamespace) {
 	*out = *in
@@ -371,6 +418,7 @@ func (in *KVNamespaceList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KVNamespaceObservation) DeepCopyInto(out *KVNamespaceObservation) {
 	*out = *in
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVNamespaceObservation.
@@ -419,7 +467,7 @@ func (in *KVNamespaceSpec) DeepCopy() *KVNamespaceSpec {
 func (in *KVNamespaceStatus) DeepCopyInto(out *KVNamespaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVNamespaceStatus.
@@ -494,6 +542,7 @@ func (in *RouteList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteObservation) DeepCopyInto(out *RouteObservation) {
 	*out = *in
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteObservation.
@@ -562,7 +611,7 @@ func (in *RouteSpec) DeepCopy() *RouteSpec {
 func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStatus.
@@ -602,6 +651,26 @@ func (in *Script) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScriptDeployment) DeepCopyInto(out *ScriptDeployment) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]DeploymentVersion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScriptDeployment.
+func (in *ScriptDeployment) DeepCopy() *ScriptDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(ScriptDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptList) DeepCopyInto(out *ScriptList) {
 	*out = *in
@@ -670,6 +739,39 @@ func (in *ScriptObservation) DeepCopyInto(out *ScriptObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LatestVersionID != nil {
+		in, out := &in.LatestVersionID, &out.LatestVersionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(ScriptDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkersDev != nil {
+		in, out := &in.WorkersDev, &out.WorkersDev
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Assets != nil {
+		in, out := &in.Assets, &out.Assets
+		*out = new(WorkerAssetsObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScriptObservation.
@@ -685,6 +787,11 @@ func (in *ScriptObservation) DeepCopy() *ScriptObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptParameters) DeepCopyInto(out *ScriptParameters) {
 	*out = *in
+	if in.AllowRecreate != nil {
+		in, out := &in.AllowRecreate, &out.AllowRecreate
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Module != nil {
 		in, out := &in.Module, &out.Module
 		*out = new(bool)
@@ -719,7 +826,7 @@ func (in *ScriptParameters) DeepCopyInto(out *ScriptParameters) {
 	}
 	if in.TailConsumers != nil {
 		in, out := &in.TailConsumers, &out.TailConsumers
-		*out = make([]TailConsumer, len(*in))
+		*out = make([]ScriptTailConsumer, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -734,6 +841,31 @@ func (in *ScriptParameters) DeepCopyInto(out *ScriptParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RollbackToVersion != nil {
+		in, out := &in.RollbackToVersion, &out.RollbackToVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.GradualRollout != nil {
+		in, out := &in.GradualRollout, &out.GradualRollout
+		*out = new(GradualRollout)
+		**out = **in
+	}
+	if in.WorkersDev != nil {
+		in, out := &in.WorkersDev, &out.WorkersDev
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Assets != nil {
+		in, out := &in.Assets, &out.Assets
+		*out = new(WorkerAssets)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependentsPolicy != nil {
+		in, out := &in.DependentsPolicy, &out.DependentsPolicy
+		*out = new(DependentsPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScriptParameters.
@@ -780,6 +912,31 @@ func (in *ScriptStatus) DeepCopy() *ScriptStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScriptTailConsumer) DeepCopyInto(out *ScriptTailConsumer) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScriptTailConsumer.
+func (in *ScriptTailConsumer) DeepCopy() *ScriptTailConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(ScriptTailConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subdomain) DeepCopyInto(out *Subdomain) {
 	*out = *in
@@ -847,6 +1004,7 @@ func (in *SubdomainObservation) DeepCopyInto(out *SubdomainObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubdomainObservation.
@@ -911,24 +1069,248 @@ func (in *SubdomainStatus) DeepCopy() *SubdomainStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TailConsumer) DeepCopyInto(out *TailConsumer) {
 	*out = *in
-	if in.Environment != nil {
-		in, out := &in.Environment, &out.Environment
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailConsumer.
+func (in *TailConsumer) DeepCopy() *TailConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(TailConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TailConsumer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailConsumerList) DeepCopyInto(out *TailConsumerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TailConsumer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailConsumerList.
+func (in *TailConsumerList) DeepCopy() *TailConsumerList {
+	if in == nil {
+		return nil
+	}
+	out := new(TailConsumerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TailConsumerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailConsumerObservation) DeepCopyInto(out *TailConsumerObservation) {
+	*out = *in
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailConsumerObservation.
+func (in *TailConsumerObservation) DeepCopy() *TailConsumerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TailConsumerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailConsumerParameters) DeepCopyInto(out *TailConsumerParameters) {
+	*out = *in
+	if in.Producer != nil {
+		in, out := &in.Producer, &out.Producer
 		*out = new(string)
 		**out = **in
 	}
-	if in.Namespace != nil {
-		in, out := &in.Namespace, &out.Namespace
+	if in.ProducerRef != nil {
+		in, out := &in.ProducerRef, &out.ProducerRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProducerSelector != nil {
+		in, out := &in.ProducerSelector, &out.ProducerSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Consumer != nil {
+		in, out := &in.Consumer, &out.Consumer
 		*out = new(string)
 		**out = **in
 	}
+	if in.ConsumerRef != nil {
+		in, out := &in.ConsumerRef, &out.ConsumerRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConsumerSelector != nil {
+		in, out := &in.ConsumerSelector, &out.ConsumerSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailConsumer.
-func (in *TailConsumer) DeepCopy() *TailConsumer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailConsumerParameters.
+func (in *TailConsumerParameters) DeepCopy() *TailConsumerParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(TailConsumer)
+	out := new(TailConsumerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailConsumerSpec) DeepCopyInto(out *TailConsumerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailConsumerSpec.
+func (in *TailConsumerSpec) DeepCopy() *TailConsumerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TailConsumerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailConsumerStatus) DeepCopyInto(out *TailConsumerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailConsumerStatus.
+func (in *TailConsumerStatus) DeepCopy() *TailConsumerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TailConsumerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerAssets) DeepCopyInto(out *WorkerAssets) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Layer != nil {
+		in, out := &in.Layer, &out.Layer
+		*out = new(string)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(string)
+		**out = **in
+	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTMLHandling != nil {
+		in, out := &in.HTMLHandling, &out.HTMLHandling
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotFoundHandling != nil {
+		in, out := &in.NotFoundHandling, &out.NotFoundHandling
+		*out = new(string)
+		**out = **in
+	}
+	if in.RunWorkerFirst != nil {
+		in, out := &in.RunWorkerFirst, &out.RunWorkerFirst
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerAssets.
+func (in *WorkerAssets) DeepCopy() *WorkerAssets {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerAssets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerAssetsObservation) DeepCopyInto(out *WorkerAssetsObservation) {
+	*out = *in
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTMLHandling != nil {
+		in, out := &in.HTMLHandling, &out.HTMLHandling
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotFoundHandling != nil {
+		in, out := &in.NotFoundHandling, &out.NotFoundHandling
+		*out = new(string)
+		**out = **in
+	}
+	if in.RunWorkerFirst != nil {
+		in, out := &in.RunWorkerFirst, &out.RunWorkerFirst
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerAssetsObservation.
+func (in *WorkerAssetsObservation) DeepCopy() *WorkerAssetsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerAssetsObservation)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -941,6 +1323,11 @@ func (in *WorkerBinding) DeepCopyInto(out *WorkerBinding) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(WorkerRateLimit)
+		**out = **in
+	}
 	if in.Part != nil {
 		in, out := &in.Part, &out.Part
 		*out = new(string)
@@ -956,6 +1343,31 @@ func (in *WorkerBinding) DeepCopyInto(out *WorkerBinding) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerBinding.
@@ -967,3 +1379,18 @@ func (in *WorkerBinding) DeepCopy() *WorkerBinding {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerRateLimit) DeepCopyInto(out *WorkerRateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerRateLimit.
+func (in *WorkerRateLimit) DeepCopy() *WorkerRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -72,3 +72,12 @@ func (l *SubdomainList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this TailConsumerList.
+func (l *TailConsumerList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
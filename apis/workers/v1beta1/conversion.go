@@ -0,0 +1,34 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	pcconversion "github.com/rossigee/provider-cloudflare/apis/conversion"
+	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+// ConvertTo converts this Route to the hub (v1alpha1) version.
+func (in *Route) ConvertTo(hub conversion.Hub) error {
+	return pcconversion.Convert(in, hub.(*v1alpha1.Route))
+}
+
+// ConvertFrom converts the hub (v1alpha1) version to this Route.
+func (in *Route) ConvertFrom(hub conversion.Hub) error {
+	return pcconversion.Convert(hub.(*v1alpha1.Route), in)
+}
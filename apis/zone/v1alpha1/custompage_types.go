@@ -0,0 +1,179 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// Custom pages served by Cloudflare Access rather than by a zone.
+const (
+	CustomPageAccessForbidden      = "access_forbidden"
+	CustomPageAccessIdentityDenied = "access_identity_denied"
+)
+
+// A ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key of the ConfigMap data to select.
+	Key string `json:"key"`
+}
+
+// CustomPageParameters are the configurable fields of a CustomPage.
+// +kubebuilder:validation:XValidation:rule="self.page.startsWith('access_') ? has(self.contentFrom) && !has(self.url) : has(self.url) && !has(self.contentFrom)",message="access pages require contentFrom and other pages require url"
+// +kubebuilder:validation:XValidation:rule="!self.page.startsWith('access_') || has(self.accountId)",message="access pages require accountId"
+// +kubebuilder:validation:XValidation:rule="has(self.accountId) || has(self.zone) || has(self.zoneRef) || has(self.zoneSelector)",message="one of accountId or zone must be specified"
+type CustomPageParameters struct {
+	// Page is the page customized. Pages prefixed with access_ are shown
+	// by Cloudflare Access when a user is denied access to an application.
+	// +kubebuilder:validation:Enum=basic_challenge;managed_challenge;waf_challenge;waf_block;ratelimit_block;country_challenge;ip_block;under_attack;"500_errors";"1000_errors";access_forbidden;access_identity_denied
+	// +immutable
+	Page string `json:"page"`
+
+	// URL Cloudflare fetches the page from. The page is fetched and
+	// cached when it is published, so it must be reachable from the
+	// internet and contain the tokens the page requires.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// ContentFrom selects the ConfigMap key holding the HTML of an
+	// access page.
+	// +optional
+	ContentFrom *ConfigMapKeySelector `json:"contentFrom,omitempty"`
+
+	// AccountID the page is customized on. Takes precedence over the
+	// zone.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// ZoneID the page is customized on.
+	// +immutable
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone object the page is customized on.
+	// +immutable
+	// +optional
+	ZoneRef *xpv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone object the page is customized on.
+	// +immutable
+	// +optional
+	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
+}
+
+// CustomPageObservation are the observable fields of a CustomPage.
+type CustomPageObservation struct {
+	// State of the page, either default or customized.
+	State string `json:"state,omitempty"`
+
+	// URL the published page was fetched from.
+	URL *string `json:"url,omitempty"`
+
+	// RequiredTokens are the tokens the content of the page must contain.
+	RequiredTokens []string `json:"requiredTokens,omitempty"`
+
+	// ContentHash is the SHA-256 hash of the published HTML of an access
+	// page.
+	ContentHash *string `json:"contentHash,omitempty"`
+
+	// AppCount is the number of Access applications using an access page.
+	AppCount *int `json:"appCount,omitempty"`
+
+	// ModifiedOn is when the page was last published.
+	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A CustomPageSpec defines the desired state of a CustomPage.
+type CustomPageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CustomPageParameters `json:"forProvider"`
+}
+
+// A CustomPageStatus represents the observed state of a CustomPage.
+type CustomPageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CustomPageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CustomPage replaces one of the pages Cloudflare serves when it blocks or
+// challenges a visitor, or when Access denies a user, with custom content.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PAGE",type="string",JSONPath=".spec.forProvider.page"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type CustomPage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomPageSpec   `json:"spec"`
+	Status CustomPageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomPageList contains a list of CustomPage objects
+type CustomPageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomPage `json:"items"`
+}
+
+// ResolveReferences resolves references to the Zone that this CustomPage
+// is customized on.
+func (p *CustomPage) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, p)
+
+	// Resolve spec.forProvider.zone
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.Spec.ForProvider.Zone),
+		Reference:    p.Spec.ForProvider.ZoneRef,
+		Selector:     p.Spec.ForProvider.ZoneSelector,
+		To:           reference.To{Managed: &Zone{}, List: &ZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zone")
+	}
+	p.Spec.ForProvider.Zone = reference.ToPtrValue(rsp.ResolvedValue)
+	p.Spec.ForProvider.ZoneRef = rsp.ResolvedReference
+
+	return nil
+}
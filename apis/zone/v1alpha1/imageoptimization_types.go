@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// ImageOptimizationParameters are the configurable fields of an
+// ImageOptimization. Settings that are left unset are not managed.
+// +kubebuilder:validation:XValidation:rule="has(self.polish) || has(self.webP) || has(self.mirage)",message="at least one of polish, webP or mirage must be specified"
+type ImageOptimizationParameters struct {
+	// Polish strips metadata from and compresses the images served by
+	// the zone.
+	// +kubebuilder:validation:Enum=off;lossless;lossy
+	// +optional
+	Polish *string `json:"polish,omitempty"`
+
+	// WebP serves images converted to WebP by Polish to browsers that
+	// support it. Has no effect unless Polish is enabled.
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	WebP *string `json:"webP,omitempty"`
+
+	// Mirage optimizes image loading for visitors on slow connections and
+	// mobile devices.
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	Mirage *string `json:"mirage,omitempty"`
+
+	// ZoneID the image optimization settings are managed on.
+	// +immutable
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone object the image optimization settings
+	// are managed on.
+	// +immutable
+	// +optional
+	ZoneRef *xpv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone object the image optimization
+	// settings are managed on.
+	// +immutable
+	// +optional
+	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
+}
+
+// ImageOptimizationObservation are the observable fields of an
+// ImageOptimization.
+type ImageOptimizationObservation struct {
+	// Polish is the Polish setting of the zone.
+	Polish *string `json:"polish,omitempty"`
+
+	// WebP is the WebP setting of the zone.
+	WebP *string `json:"webP,omitempty"`
+
+	// Mirage is the Mirage setting of the zone.
+	Mirage *string `json:"mirage,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// An ImageOptimizationSpec defines the desired state of an
+// ImageOptimization.
+type ImageOptimizationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImageOptimizationParameters `json:"forProvider"`
+}
+
+// An ImageOptimizationStatus represents the observed state of an
+// ImageOptimization.
+type ImageOptimizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ImageOptimizationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ImageOptimization manages the Polish, WebP and Mirage image
+// optimization settings of a Zone.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone"
+// +kubebuilder:printcolumn:name="POLISH",type="string",JSONPath=".status.atProvider.polish"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type ImageOptimization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageOptimizationSpec   `json:"spec"`
+	Status ImageOptimizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageOptimizationList contains a list of ImageOptimization objects
+type ImageOptimizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageOptimization `json:"items"`
+}
+
+// ResolveReferences resolves references to the Zone that the image
+// optimization settings of this ImageOptimization are managed on.
+func (o *ImageOptimization) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, o)
+
+	// Resolve spec.forProvider.zone
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(o.Spec.ForProvider.Zone),
+		Reference:    o.Spec.ForProvider.ZoneRef,
+		Selector:     o.Spec.ForProvider.ZoneSelector,
+		To:           reference.To{Managed: &Zone{}, List: &ZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zone")
+	}
+	o.Spec.ForProvider.Zone = reference.ToPtrValue(rsp.ResolvedValue)
+	o.Spec.ForProvider.ZoneRef = rsp.ResolvedReference
+
+	return nil
+}
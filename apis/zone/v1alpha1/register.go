@@ -45,6 +45,42 @@ var (
 	ZoneGroupVersionKind = SchemeGroupVersion.WithKind(ZoneKind)
 )
 
+// ImageOptimization type metadata.
+var (
+	ImageOptimizationKind             = reflect.TypeOf(ImageOptimization{}).Name()
+	ImageOptimizationGroupKind        = schema.GroupKind{Group: Group, Kind: ImageOptimizationKind}.String()
+	ImageOptimizationKindAPIVersion   = ImageOptimizationKind + "." + SchemeGroupVersion.String()
+	ImageOptimizationGroupVersionKind = SchemeGroupVersion.WithKind(ImageOptimizationKind)
+)
+
+// CustomPage type metadata.
+var (
+	CustomPageKind             = reflect.TypeOf(CustomPage{}).Name()
+	CustomPageGroupKind        = schema.GroupKind{Group: Group, Kind: CustomPageKind}.String()
+	CustomPageKindAPIVersion   = CustomPageKind + "." + SchemeGroupVersion.String()
+	CustomPageGroupVersionKind = SchemeGroupVersion.WithKind(CustomPageKind)
+)
+
+// SpeedSettings type metadata.
+var (
+	SpeedSettingsKind             = reflect.TypeOf(SpeedSettings{}).Name()
+	SpeedSettingsGroupKind        = schema.GroupKind{Group: Group, Kind: SpeedSettingsKind}.String()
+	SpeedSettingsKindAPIVersion   = SpeedSettingsKind + "." + SchemeGroupVersion.String()
+	SpeedSettingsGroupVersionKind = SchemeGroupVersion.WithKind(SpeedSettingsKind)
+)
+
+// ZoneSettingsTemplate type metadata.
+var (
+	ZoneSettingsTemplateKind             = reflect.TypeOf(ZoneSettingsTemplate{}).Name()
+	ZoneSettingsTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: ZoneSettingsTemplateKind}.String()
+	ZoneSettingsTemplateKindAPIVersion   = ZoneSettingsTemplateKind + "." + SchemeGroupVersion.String()
+	ZoneSettingsTemplateGroupVersionKind = SchemeGroupVersion.WithKind(ZoneSettingsTemplateKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Zone{}, &ZoneList{})
+	SchemeBuilder.Register(&ImageOptimization{}, &ImageOptimizationList{})
+	SchemeBuilder.Register(&CustomPage{}, &CustomPageList{})
+	SchemeBuilder.Register(&SpeedSettings{}, &SpeedSettingsList{})
+	SchemeBuilder.Register(&ZoneSettingsTemplate{}, &ZoneSettingsTemplateList{})
 }
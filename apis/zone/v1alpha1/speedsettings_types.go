@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// SpeedSettingsParameters are the configurable fields of a SpeedSettings.
+// Settings that are left unset are not managed.
+// +kubebuilder:validation:XValidation:rule="has(self.crawlerHints) || has(self.earlyHints) || has(self.rocketLoader) || has(self.h2Prioritization)",message="at least one of crawlerHints, earlyHints, rocketLoader or h2Prioritization must be specified"
+type SpeedSettingsParameters struct {
+	// CrawlerHints tells search engines and other crawlers when content
+	// served by the zone has changed, so that they crawl it less often.
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	CrawlerHints *string `json:"crawlerHints,omitempty"`
+
+	// EarlyHints sends 103 Early Hints responses, letting browsers preload
+	// the assets linked by a page before its response is ready.
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	EarlyHints *string `json:"earlyHints,omitempty"`
+
+	// RocketLoader defers loading the JavaScript of the pages served by
+	// the zone until they have rendered.
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	RocketLoader *string `json:"rocketLoader,omitempty"`
+
+	// H2Prioritization optimizes the order in which resources are sent
+	// over HTTP/2. Custom uses the priorities set by a Worker.
+	// +kubebuilder:validation:Enum=off;on;custom
+	// +optional
+	H2Prioritization *string `json:"h2Prioritization,omitempty"`
+
+	// ZoneID the speed settings are managed on.
+	// +immutable
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone object the speed settings are managed
+	// on.
+	// +immutable
+	// +optional
+	ZoneRef *xpv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone object the speed settings are managed
+	// on.
+	// +immutable
+	// +optional
+	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
+}
+
+// SpeedSettingsObservation are the observable fields of a SpeedSettings.
+type SpeedSettingsObservation struct {
+	// CrawlerHints is the Crawler Hints setting of the zone.
+	CrawlerHints *string `json:"crawlerHints,omitempty"`
+
+	// EarlyHints is the Early Hints setting of the zone.
+	EarlyHints *string `json:"earlyHints,omitempty"`
+
+	// RocketLoader is the Rocket Loader setting of the zone.
+	RocketLoader *string `json:"rocketLoader,omitempty"`
+
+	// H2Prioritization is the HTTP/2 prioritization setting of the zone.
+	H2Prioritization *string `json:"h2Prioritization,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A SpeedSettingsSpec defines the desired state of a SpeedSettings.
+type SpeedSettingsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SpeedSettingsParameters `json:"forProvider"`
+}
+
+// A SpeedSettingsStatus represents the observed state of a SpeedSettings.
+type SpeedSettingsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SpeedSettingsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SpeedSettings manages the Crawler Hints, Early Hints, Rocket Loader and
+// HTTP/2 prioritization settings of a Zone.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type SpeedSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpeedSettingsSpec   `json:"spec"`
+	Status SpeedSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpeedSettingsList contains a list of SpeedSettings objects
+type SpeedSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpeedSettings `json:"items"`
+}
+
+// ResolveReferences resolves references to the Zone that the speed
+// settings of this SpeedSettings are managed on.
+func (s *SpeedSettings) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, s)
+
+	// Resolve spec.forProvider.zone
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(s.Spec.ForProvider.Zone),
+		Reference:    s.Spec.ForProvider.ZoneRef,
+		Selector:     s.Spec.ForProvider.ZoneSelector,
+		To:           reference.To{Managed: &Zone{}, List: &ZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zone")
+	}
+	s.Spec.ForProvider.Zone = reference.ToPtrValue(rsp.ResolvedValue)
+	s.Spec.ForProvider.ZoneRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 )
 
 // MinifySettings represents the minify settings on a Zone
@@ -118,7 +120,9 @@ type ZoneSettings struct {
 	// +optional
 	CacheLevel *string `json:"cacheLevel,omitempty"`
 
-	// ChallengeTTL configures the edge cache ttl
+	// ChallengeTTL configures how long, in seconds, a visitor who passed
+	// a challenge or was issued Turnstile pre-clearance is allowed through
+	// before being challenged again
 	// +kubebuilder:validation:Enum=300;900;1800;2700;3600;7200;10800;14400;28800;57600;86400;604800;2592000;31536000
 	// +optional
 	ChallengeTTL *int64 `json:"challengeTtl,omitempty"`
@@ -313,6 +317,65 @@ type ZoneSettings struct {
 	ZeroRTT *string `json:"zeroRtt,omitempty"`
 }
 
+// Remediation policies of drifted Zone settings.
+const (
+	// RemediationEnforce reverts settings that drifted to their desired
+	// values.
+	RemediationEnforce = "Enforce"
+
+	// RemediationAlert reports settings that drifted, without reverting
+	// them.
+	RemediationAlert = "Alert"
+
+	// RemediationIgnore neither reports nor reverts settings that drifted.
+	RemediationIgnore = "Ignore"
+)
+
+// ZoneSettingsRemediationPolicy controls how settings of a Zone that were
+// changed outside of Crossplane are remediated, per group of settings.
+type ZoneSettingsRemediationPolicy struct {
+	// Default is the policy of settings whose group has no policy of its
+	// own, and of settings that belong to no group.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +kubebuilder:default=Enforce
+	// +optional
+	Default *string `json:"default,omitempty"`
+
+	// Security is the policy of advancedDdos, browserCheck, challengeTtl,
+	// emailObfuscation, hotlinkProtection, privacyPass, securityHeader,
+	// securityLevel, serverSideExclude and waf.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	Security *string `json:"security,omitempty"`
+
+	// TLS is the policy of alwaysUseHttps, automaticHttpsRewrites,
+	// ciphers, minTLSVersion, opportunisticEncryption, ssl, tls13 and
+	// tlsClientAuth.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	TLS *string `json:"tls,omitempty"`
+
+	// Caching is the policy of alwaysOnline, browserCacheTtl, cacheLevel,
+	// developmentMode, edgeCacheTtl and sortQueryStringForCache.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	Caching *string `json:"caching,omitempty"`
+
+	// Performance is the policy of brotli, http2, http3, minify, mirage,
+	// polish, prefetchPreload, responseBuffering, rocketLoader, webP and
+	// zeroRtt.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	Performance *string `json:"performance,omitempty"`
+
+	// Network is the policy of cnameFlattening, ipGeolocation, ipv6,
+	// maxUpload, opportunisticOnion, orangeToOrange, pseudoIpv4,
+	// trueClientIPHeader, visitorIP and webSockets.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	Network *string `json:"network,omitempty"`
+}
+
 // ZoneParameters are the configurable fields of a Zone.
 type ZoneParameters struct {
 	// Name is the name of the Zone, which should be a valid
@@ -322,6 +385,13 @@ type ZoneParameters struct {
 	// +immutable
 	Name string `json:"name"`
 
+	// AllowRecreate permits the Zone to be deleted and created again
+	// when Name changes. Deleting a Zone deletes all of its DNS records
+	// and settings. While unset a changed name is only reported by the
+	// RequiresReplacement condition.
+	// +optional
+	AllowRecreate *bool `json:"allowRecreate,omitempty"`
+
 	// AccountID is the account ID under which this Zone will be
 	// created.
 	// +immutable
@@ -337,6 +407,7 @@ type ZoneParameters struct {
 	// Cloudflare. To manage them with Crossplane, you must:
 	// 1. Create corresponding Record resources with matching settings
 	// 2. Import the external records using crossplane.io/external-name annotation
+	// The imported records are listed in status.atProvider.jumpStartRecords.
 	// 
 	// Recommendation: Leave disabled (false) for new zones to maintain
 	// full Crossplane control over DNS records.
@@ -367,6 +438,26 @@ type ZoneParameters struct {
 	// +optional
 	Settings ZoneSettings `json:"settings,omitempty"`
 
+	// SettingsTemplateRef references a ZoneSettingsTemplate this Zone
+	// inherits settings from. Settings set on the Zone override those of
+	// the template.
+	// +optional
+	SettingsTemplateRef *xpv1.Reference `json:"settingsTemplateRef,omitempty"`
+
+	// RemediationPolicy controls whether settings that were changed
+	// outside of Crossplane are reverted, only reported, or ignored.
+	// All settings are reverted when unset.
+	// +optional
+	RemediationPolicy *ZoneSettingsRemediationPolicy `json:"remediationPolicy,omitempty"`
+
+	// UnderAttackMode puts the zone in Under Attack Mode while true, by
+	// raising its security level to under_attack. When it is set to false
+	// the security level is restored to settings.securityLevel, or to
+	// medium if that is unset or under_attack. The security level is left
+	// alone while it is unset.
+	// +optional
+	UnderAttackMode *bool `json:"underAttackMode,omitempty"`
+
 	// VanityNameServers lists an array of domains to use for custom
 	// nameservers.
 	// +optional
@@ -435,6 +526,74 @@ type ZoneObservation struct {
 	// VanityNameServers lists the currently assigned vanity
 	// name server addresses.
 	VanityNameServers []string `json:"vanityNameServers,omitempty"`
+
+	// Entitlements are the features available on the plan of this Zone.
+	Entitlements *ZoneEntitlements `json:"entitlements,omitempty"`
+
+	// DriftedSettings lists the settings whose remediation policy is
+	// Alert that no longer have their desired values.
+	DriftedSettings []string `json:"driftedSettings,omitempty"`
+
+	// SecurityLevel is the current security level of the Zone.
+	SecurityLevel string `json:"securityLevel,omitempty"`
+
+	// UnderAttackMode indicates whether the Zone is in Under Attack Mode.
+	UnderAttackMode bool `json:"underAttackMode,omitempty"`
+
+	// JumpStartRecords lists the DNS records Cloudflare imported by
+	// scanning the previous nameservers of the Zone when it was created
+	// with jumpStart. They are not managed by Crossplane.
+	JumpStartRecords []JumpStartRecord `json:"jumpStartRecords,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A JumpStartRecord is a DNS record imported when a Zone was created, with
+// the fields needed to manage it with a Record.
+type JumpStartRecord struct {
+	// ID of the record, which is the external name of a Record managing
+	// it.
+	ID string `json:"id"`
+
+	// Type of the record, e.g. A or MX.
+	Type string `json:"type"`
+
+	// Name of the record.
+	Name string `json:"name"`
+
+	// Content of the record.
+	Content string `json:"content,omitempty"`
+
+	// TTL of the record, where 1 is automatic.
+	TTL int `json:"ttl,omitempty"`
+
+	// Proxied indicates whether the record is proxied by Cloudflare.
+	Proxied bool `json:"proxied,omitempty"`
+
+	// Priority of the record, for MX records.
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// ZoneEntitlements are the features available on the plan of a Zone, so
+// that compositions can decide what to create on it.
+type ZoneEntitlements struct {
+	// PageRules is the number of Page Rules available on the Zone.
+	PageRules *int64 `json:"pageRules,omitempty"`
+
+	// RateLimiting indicates whether rate limiting rules are available
+	// on the Zone.
+	RateLimiting *bool `json:"rateLimiting,omitempty"`
+
+	// RateLimitingRules is the number of rate limiting rules available
+	// on the Zone.
+	RateLimitingRules *int64 `json:"rateLimitingRules,omitempty"`
+
+	// WorkerRoutes is the number of Worker routes available on the Zone.
+	WorkerRoutes *int64 `json:"workerRoutes,omitempty"`
+
+	// Features maps the key of each entitlement of the Zone to its
+	// allocation, e.g. page_rules to 3.
+	Features map[string]string `json:"features,omitempty"`
 }
 
 // A ZoneSpec defines the desired state of a Zone.
@@ -459,6 +618,7 @@ type ZoneStatus struct {
 // +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.atProvider.accountId"
 // +kubebuilder:printcolumn:name="PLAN",type="string",JSONPath=".status.atProvider.plan"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
 type Zone struct {
 	metav1.TypeMeta   `json:",inline"`
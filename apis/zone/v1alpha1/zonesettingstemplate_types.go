@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ZoneSettingsTemplateSpec defines the settings a ZoneSettingsTemplate
+// provides.
+type ZoneSettingsTemplateSpec struct {
+	// Settings are inherited by the Zones and templates referencing this
+	// template, unless they set them themselves.
+	// +optional
+	Settings ZoneSettings `json:"settings,omitempty"`
+
+	// SettingsTemplateRef references a ZoneSettingsTemplate this template
+	// inherits settings from, so that baselines can be layered. Settings of
+	// this template override those of the referenced one.
+	// +optional
+	SettingsTemplateRef *xpv1.Reference `json:"settingsTemplateRef,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ZoneSettingsTemplate holds Zone settings that are defined once and
+// inherited by every Zone referencing it.
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,cloudflare}
+type ZoneSettingsTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ZoneSettingsTemplateSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ZoneSettingsTemplateList contains a list of ZoneSettingsTemplate
+type ZoneSettingsTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ZoneSettingsTemplate `json:"items"`
+}
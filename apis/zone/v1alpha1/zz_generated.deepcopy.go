@@ -21,85 +21,647 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPage) DeepCopyInto(out *CustomPage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPage.
+func (in *CustomPage) DeepCopy() *CustomPage {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomPage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPageList) DeepCopyInto(out *CustomPageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPageList.
+func (in *CustomPageList) DeepCopy() *CustomPageList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomPageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPageObservation) DeepCopyInto(out *CustomPageObservation) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequiredTokens != nil {
+		in, out := &in.RequiredTokens, &out.RequiredTokens
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ContentHash != nil {
+		in, out := &in.ContentHash, &out.ContentHash
+		*out = new(string)
+		**out = **in
+	}
+	if in.AppCount != nil {
+		in, out := &in.AppCount, &out.AppCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.ModifiedOn != nil {
+		in, out := &in.ModifiedOn, &out.ModifiedOn
+		*out = (*in).DeepCopy()
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPageObservation.
+func (in *CustomPageObservation) DeepCopy() *CustomPageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPageParameters) DeepCopyInto(out *CustomPageParameters) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentFrom != nil {
+		in, out := &in.ContentFrom, &out.ContentFrom
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPageParameters.
+func (in *CustomPageParameters) DeepCopy() *CustomPageParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPageParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPageSpec) DeepCopyInto(out *CustomPageSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPageSpec.
+func (in *CustomPageSpec) DeepCopy() *CustomPageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPageStatus) DeepCopyInto(out *CustomPageStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPageStatus.
+func (in *CustomPageStatus) DeepCopy() *CustomPageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOptimization) DeepCopyInto(out *ImageOptimization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOptimization.
+func (in *ImageOptimization) DeepCopy() *ImageOptimization {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOptimization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageOptimization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOptimizationList) DeepCopyInto(out *ImageOptimizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageOptimization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOptimizationList.
+func (in *ImageOptimizationList) DeepCopy() *ImageOptimizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOptimizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageOptimizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOptimizationObservation) DeepCopyInto(out *ImageOptimizationObservation) {
+	*out = *in
+	if in.Polish != nil {
+		in, out := &in.Polish, &out.Polish
+		*out = new(string)
+		**out = **in
+	}
+	if in.WebP != nil {
+		in, out := &in.WebP, &out.WebP
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mirage != nil {
+		in, out := &in.Mirage, &out.Mirage
+		*out = new(string)
+		**out = **in
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOptimizationObservation.
+func (in *ImageOptimizationObservation) DeepCopy() *ImageOptimizationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOptimizationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOptimizationParameters) DeepCopyInto(out *ImageOptimizationParameters) {
+	*out = *in
+	if in.Polish != nil {
+		in, out := &in.Polish, &out.Polish
+		*out = new(string)
+		**out = **in
+	}
+	if in.WebP != nil {
+		in, out := &in.WebP, &out.WebP
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mirage != nil {
+		in, out := &in.Mirage, &out.Mirage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOptimizationParameters.
+func (in *ImageOptimizationParameters) DeepCopy() *ImageOptimizationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOptimizationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOptimizationSpec) DeepCopyInto(out *ImageOptimizationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOptimizationSpec.
+func (in *ImageOptimizationSpec) DeepCopy() *ImageOptimizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOptimizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOptimizationStatus) DeepCopyInto(out *ImageOptimizationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOptimizationStatus.
+func (in *ImageOptimizationStatus) DeepCopy() *ImageOptimizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOptimizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JumpStartRecord) DeepCopyInto(out *JumpStartRecord) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JumpStartRecord.
+func (in *JumpStartRecord) DeepCopy() *JumpStartRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(JumpStartRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MinifySettings) DeepCopyInto(out *MinifySettings) {
 	*out = *in
-	if in.CSS != nil {
-		in, out := &in.CSS, &out.CSS
+	if in.CSS != nil {
+		in, out := &in.CSS, &out.CSS
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTML != nil {
+		in, out := &in.HTML, &out.HTML
+		*out = new(string)
+		**out = **in
+	}
+	if in.JS != nil {
+		in, out := &in.JS, &out.JS
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinifySettings.
+func (in *MinifySettings) DeepCopy() *MinifySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MinifySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MobileRedirectSettings) DeepCopyInto(out *MobileRedirectSettings) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subdomain != nil {
+		in, out := &in.Subdomain, &out.Subdomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.StripURI != nil {
+		in, out := &in.StripURI, &out.StripURI
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MobileRedirectSettings.
+func (in *MobileRedirectSettings) DeepCopy() *MobileRedirectSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MobileRedirectSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityHeaderSettings) DeepCopyInto(out *SecurityHeaderSettings) {
+	*out = *in
+	if in.StrictTransportSecurity != nil {
+		in, out := &in.StrictTransportSecurity, &out.StrictTransportSecurity
+		*out = new(StrictTransportSecuritySettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHeaderSettings.
+func (in *SecurityHeaderSettings) DeepCopy() *SecurityHeaderSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityHeaderSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpeedSettings) DeepCopyInto(out *SpeedSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpeedSettings.
+func (in *SpeedSettings) DeepCopy() *SpeedSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SpeedSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpeedSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpeedSettingsList) DeepCopyInto(out *SpeedSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SpeedSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpeedSettingsList.
+func (in *SpeedSettingsList) DeepCopy() *SpeedSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(SpeedSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpeedSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpeedSettingsObservation) DeepCopyInto(out *SpeedSettingsObservation) {
+	*out = *in
+	if in.CrawlerHints != nil {
+		in, out := &in.CrawlerHints, &out.CrawlerHints
 		*out = new(string)
 		**out = **in
 	}
-	if in.HTML != nil {
-		in, out := &in.HTML, &out.HTML
+	if in.EarlyHints != nil {
+		in, out := &in.EarlyHints, &out.EarlyHints
 		*out = new(string)
 		**out = **in
 	}
-	if in.JS != nil {
-		in, out := &in.JS, &out.JS
+	if in.RocketLoader != nil {
+		in, out := &in.RocketLoader, &out.RocketLoader
+		*out = new(string)
+		**out = **in
+	}
+	if in.H2Prioritization != nil {
+		in, out := &in.H2Prioritization, &out.H2Prioritization
 		*out = new(string)
 		**out = **in
 	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinifySettings.
-func (in *MinifySettings) DeepCopy() *MinifySettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpeedSettingsObservation.
+func (in *SpeedSettingsObservation) DeepCopy() *SpeedSettingsObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(MinifySettings)
+	out := new(SpeedSettingsObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MobileRedirectSettings) DeepCopyInto(out *MobileRedirectSettings) {
+func (in *SpeedSettingsParameters) DeepCopyInto(out *SpeedSettingsParameters) {
 	*out = *in
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.CrawlerHints != nil {
+		in, out := &in.CrawlerHints, &out.CrawlerHints
 		*out = new(string)
 		**out = **in
 	}
-	if in.Subdomain != nil {
-		in, out := &in.Subdomain, &out.Subdomain
+	if in.EarlyHints != nil {
+		in, out := &in.EarlyHints, &out.EarlyHints
 		*out = new(string)
 		**out = **in
 	}
-	if in.StripURI != nil {
-		in, out := &in.StripURI, &out.StripURI
-		*out = new(bool)
+	if in.RocketLoader != nil {
+		in, out := &in.RocketLoader, &out.RocketLoader
+		*out = new(string)
+		**out = **in
+	}
+	if in.H2Prioritization != nil {
+		in, out := &in.H2Prioritization, &out.H2Prioritization
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
 		**out = **in
 	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MobileRedirectSettings.
-func (in *MobileRedirectSettings) DeepCopy() *MobileRedirectSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpeedSettingsParameters.
+func (in *SpeedSettingsParameters) DeepCopy() *SpeedSettingsParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(MobileRedirectSettings)
+	out := new(SpeedSettingsParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityHeaderSettings) DeepCopyInto(out *SecurityHeaderSettings) {
+func (in *SpeedSettingsSpec) DeepCopyInto(out *SpeedSettingsSpec) {
 	*out = *in
-	if in.StrictTransportSecurity != nil {
-		in, out := &in.StrictTransportSecurity, &out.StrictTransportSecurity
-		*out = new(StrictTransportSecuritySettings)
-		(*in).DeepCopyInto(*out)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpeedSettingsSpec.
+func (in *SpeedSettingsSpec) DeepCopy() *SpeedSettingsSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(SpeedSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHeaderSettings.
-func (in *SecurityHeaderSettings) DeepCopy() *SecurityHeaderSettings {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpeedSettingsStatus) DeepCopyInto(out *SpeedSettingsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpeedSettingsStatus.
+func (in *SpeedSettingsStatus) DeepCopy() *SpeedSettingsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityHeaderSettings)
+	out := new(SpeedSettingsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -166,6 +728,48 @@ func (in *Zone) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneEntitlements) DeepCopyInto(out *ZoneEntitlements) {
+	*out = *in
+	if in.PageRules != nil {
+		in, out := &in.PageRules, &out.PageRules
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RateLimiting != nil {
+		in, out := &in.RateLimiting, &out.RateLimiting
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RateLimitingRules != nil {
+		in, out := &in.RateLimitingRules, &out.RateLimitingRules
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WorkerRoutes != nil {
+		in, out := &in.WorkerRoutes, &out.WorkerRoutes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneEntitlements.
+func (in *ZoneEntitlements) DeepCopy() *ZoneEntitlements {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneEntitlements)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZoneList) DeepCopyInto(out *ZoneList) {
 	*out = *in
@@ -221,6 +825,24 @@ func (in *ZoneObservation) DeepCopyInto(out *ZoneObservation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Entitlements != nil {
+		in, out := &in.Entitlements, &out.Entitlements
+		*out = new(ZoneEntitlements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftedSettings != nil {
+		in, out := &in.DriftedSettings, &out.DriftedSettings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JumpStartRecords != nil {
+		in, out := &in.JumpStartRecords, &out.JumpStartRecords
+		*out = make([]JumpStartRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.APIErrorObservation.DeepCopyInto(&out.APIErrorObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneObservation.
@@ -236,6 +858,11 @@ func (in *ZoneObservation) DeepCopy() *ZoneObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZoneParameters) DeepCopyInto(out *ZoneParameters) {
 	*out = *in
+	if in.AllowRecreate != nil {
+		in, out := &in.AllowRecreate, &out.AllowRecreate
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AccountID != nil {
 		in, out := &in.AccountID, &out.AccountID
 		*out = new(string)
@@ -257,6 +884,21 @@ func (in *ZoneParameters) DeepCopyInto(out *ZoneParameters) {
 		**out = **in
 	}
 	in.Settings.DeepCopyInto(&out.Settings)
+	if in.SettingsTemplateRef != nil {
+		in, out := &in.SettingsTemplateRef, &out.SettingsTemplateRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemediationPolicy != nil {
+		in, out := &in.RemediationPolicy, &out.RemediationPolicy
+		*out = new(ZoneSettingsRemediationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnderAttackMode != nil {
+		in, out := &in.UnderAttackMode, &out.UnderAttackMode
+		*out = new(bool)
+		**out = **in
+	}
 	if in.VanityNameServers != nil {
 		in, out := &in.VanityNameServers, &out.VanityNameServers
 		*out = make([]string, len(*in))
@@ -529,6 +1171,130 @@ func (in *ZoneSettings) DeepCopy() *ZoneSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSettingsRemediationPolicy) DeepCopyInto(out *ZoneSettingsRemediationPolicy) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(string)
+		**out = **in
+	}
+	if in.Caching != nil {
+		in, out := &in.Caching, &out.Caching
+		*out = new(string)
+		**out = **in
+	}
+	if in.Performance != nil {
+		in, out := &in.Performance, &out.Performance
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSettingsRemediationPolicy.
+func (in *ZoneSettingsRemediationPolicy) DeepCopy() *ZoneSettingsRemediationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSettingsRemediationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSettingsTemplate) DeepCopyInto(out *ZoneSettingsTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSettingsTemplate.
+func (in *ZoneSettingsTemplate) DeepCopy() *ZoneSettingsTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSettingsTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneSettingsTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSettingsTemplateList) DeepCopyInto(out *ZoneSettingsTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ZoneSettingsTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSettingsTemplateList.
+func (in *ZoneSettingsTemplateList) DeepCopy() *ZoneSettingsTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSettingsTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneSettingsTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSettingsTemplateSpec) DeepCopyInto(out *ZoneSettingsTemplateSpec) {
+	*out = *in
+	in.Settings.DeepCopyInto(&out.Settings)
+	if in.SettingsTemplateRef != nil {
+		in, out := &in.SettingsTemplateRef, &out.SettingsTemplateRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSettingsTemplateSpec.
+func (in *ZoneSettingsTemplateSpec) DeepCopy() *ZoneSettingsTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSettingsTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZoneSpec) DeepCopyInto(out *ZoneSpec) {
 	*out = *in
@@ -0,0 +1,625 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// MinifySettings represents the minify settings on a Zone
+type MinifySettings struct {
+	// CSS enables or disables minifying CSS assets
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	CSS *string `json:"css,omitempty"`
+	// HTML enables or disables minifying HTML assets
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	HTML *string `json:"html,omitempty"`
+	// JS enables or disables minifying JS assets
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	JS *string `json:"js,omitempty"`
+}
+
+// MobileRedirectSettings represents the mobile_redirect settings on a Zone
+type MobileRedirectSettings struct {
+	// Status enables or disables mobile redirection
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	Status *string `json:"status,omitempty"`
+	// Subdomain defines the subdomain prefix to redirect mobile devices to
+	// +optional
+	Subdomain *string `json:"subdomain,omitempty"`
+	// StripURI defines whether or not to strip the path from the URI when redirecting
+	// +optional
+	StripURI *bool `json:"stripURI,omitempty"`
+}
+
+// StrictTransportSecuritySettings represents the STS settings on a Zone's security headers
+type StrictTransportSecuritySettings struct {
+	// Enabled enables or disables STS settings
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// MaxAge defines the maximum age in seconds of the STS
+	// +optional
+	MaxAge *int64 `json:"maxAge,omitempty"`
+	// IncludeSubdomains defines whether or not to include all subdomains
+	// +optional
+	IncludeSubdomains *bool `json:"includeSubdomains,omitempty"`
+	// NoSniff defines whether or not to include 'X-Content-Type-Options: nosniff' header
+	// +optional
+	NoSniff *bool `json:"noSniff,omitempty"`
+}
+
+// SecurityHeaderSettings represents the security headers on a Zone
+type SecurityHeaderSettings struct {
+	// StrictTransportSecurity defines the STS settings on a Zone
+	// +optional
+	StrictTransportSecurity *StrictTransportSecuritySettings `json:"strictTransportSecurity,omitempty"`
+}
+
+// ZoneSettings represents settings on a Zone
+type ZoneSettings struct {
+	// AlwaysOnline enables or disables Always Online
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	AlwaysOnline *string `json:"alwaysOnline,omitempty"`
+
+	// AdvancedDDOS enables or disables Advanced DDoS mitigation
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	AdvancedDDOS *string `json:"advancedDdos,omitempty"`
+
+	// AlwaysUseHTTPS enables or disables Always use HTTPS
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	AlwaysUseHTTPS *string `json:"alwaysUseHttps,omitempty"`
+
+	// AutomaticHTTPSRewrites enables or disables Automatic HTTPS Rewrites
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	AutomaticHTTPSRewrites *string `json:"automaticHttpsRewrites,omitempty"`
+
+	// Brotli enables or disables Brotli
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	Brotli *string `json:"brotli,omitempty"`
+
+	// BrowserCacheTTL configures the browser cache ttl.
+	// 0 means respect existing headers
+	// +kubebuilder:validation:Enum=0;30;60;300;1200;1800;3600;7200;10800;14400;18000;28800;43200;57600;72000;86400;172800;259200;345600;432000;691200;1382400;2073600;2678400;5356800;16070400;31536000
+	// +optional
+	BrowserCacheTTL *int64 `json:"browserCacheTtl,omitempty"`
+
+	// BrowserCheck enables or disables Browser check
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	BrowserCheck *string `json:"browserCheck,omitempty"`
+
+	// CacheLevel configures the cache level
+	// +kubebuilder:validation:Enum=bypass;basic;simplified;aggressive;cache_everything
+	// +optional
+	CacheLevel *string `json:"cacheLevel,omitempty"`
+
+	// ChallengeTTL configures how long, in seconds, a visitor who passed
+	// a challenge or was issued Turnstile pre-clearance is allowed through
+	// before being challenged again
+	// +kubebuilder:validation:Enum=300;900;1800;2700;3600;7200;10800;14400;28800;57600;86400;604800;2592000;31536000
+	// +optional
+	ChallengeTTL *int64 `json:"challengeTtl,omitempty"`
+
+	// Ciphers configures which ciphers are allowed for TLS termination
+	// +optional
+	Ciphers []string `json:"ciphers,omitempty"`
+
+	// CnameFlattening configures CNAME flattening
+	// +kubebuilder:validation:Enum=flatten_at_root;flatten_all;flatten_none
+	// +optional
+	CnameFlattening *string `json:"cnameFlattening,omitempty"`
+
+	// DevelopmentMode enables or disables Development mode
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	DevelopmentMode *string `json:"developmentMode,omitempty"`
+
+	// EdgeCacheTTL configures the edge cache ttl
+	// +optional
+	EdgeCacheTTL *int64 `json:"edgeCacheTtl,omitempty"`
+
+	// EmailObfuscation enables or disables Email obfuscation
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	EmailObfuscation *string `json:"emailObfuscation,omitempty"`
+
+	// HotlinkProtection enables or disables Hotlink protection
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	HotlinkProtection *string `json:"hotlinkProtection,omitempty"`
+
+	// HTTP2 enables or disables HTTP2
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	HTTP2 *string `json:"http2,omitempty"`
+
+	// HTTP3 enables or disables HTTP3
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	HTTP3 *string `json:"http3,omitempty"`
+
+	// IPGeolocation enables or disables IP Geolocation
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	IPGeolocation *string `json:"ipGeolocation,omitempty"`
+
+	// IPv6 enables or disables IPv6
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	IPv6 *string `json:"ipv6,omitempty"`
+
+	// LogToCloudflare enables or disables Logging to cloudflare
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	LogToCloudflare *string `json:"logToCloudflare,omitempty"`
+
+	// MaxUpload configures the maximum upload payload size
+	// +optional
+	MaxUpload *int64 `json:"maxUpload,omitempty"`
+
+	// Minify configures minify settings for certain assets
+	// +optional
+	Minify *MinifySettings `json:"minify,omitempty"`
+
+	// MinTLSVersion configures the minimum TLS version
+	// +kubebuilder:validation:Enum="1.0";"1.1";"1.2";"1.3"
+	// +optional
+	MinTLSVersion *string `json:"minTLSVersion,omitempty"`
+
+	// Mirage enables or disables Mirage
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	Mirage *string `json:"mirage,omitempty"`
+
+	// MobileRedirect configures automatic redirections to mobile-optimized subdomains
+	// +optional
+	MobileRedirect *MobileRedirectSettings `json:"mobileRedirect,omitempty"`
+
+	// OpportunisticEncryption enables or disables Opportunistic encryption
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	OpportunisticEncryption *string `json:"opportunisticEncryption,omitempty"`
+
+	// OpportunisticOnion enables or disables Opportunistic onion
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	OpportunisticOnion *string `json:"opportunisticOnion,omitempty"`
+
+	// OrangeToOrange enables or disables Orange to orange
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	OrangeToOrange *string `json:"orangeToOrange,omitempty"`
+
+	// OriginErrorPagePassThru enables or disables Mirage
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	OriginErrorPagePassThru *string `json:"originErrorPagePassThru,omitempty"`
+
+	// Polish configures the Polish setting
+	// +kubebuilder:validation:Enum=off;lossless;lossy
+	// +optional
+	Polish *string `json:"polish,omitempty"`
+
+	// PrefetchPreload enables or disables Prefetch preload
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	PrefetchPreload *string `json:"prefetchPreload,omitempty"`
+
+	// PrivacyPass enables or disables Privacy pass
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	PrivacyPass *string `json:"privacyPass,omitempty"`
+
+	// PseudoIPv4 configures the Pseudo IPv4 setting
+	// +kubebuilder:validation:Enum=off;add_header;overwrite_header
+	// +optional
+	PseudoIPv4 *string `json:"pseudoIpv4,omitempty"`
+
+	// ResponseBuffering enables or disables Response buffering
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	ResponseBuffering *string `json:"responseBuffering,omitempty"`
+
+	// RocketLoader enables or disables Rocket loader
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	RocketLoader *string `json:"rocketLoader,omitempty"`
+
+	// SecurityHeader defines the security headers for a Zone
+	// +optional
+	SecurityHeader *SecurityHeaderSettings `json:"securityHeader,omitempty"`
+
+	// SecurityLevel configures the Security level
+	// +kubebuilder:validation:Enum=off;essentially_off;low;medium;high;under_attack
+	// +optional
+	SecurityLevel *string `json:"securityLevel,omitempty"`
+
+	// ServerSideExclude enables or disables Server side exclude
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	ServerSideExclude *string `json:"serverSideExclude,omitempty"`
+
+	// SortQueryStringForCache enables or disables Sort query string for cache
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	SortQueryStringForCache *string `json:"sortQueryStringForCache,omitempty"`
+
+	// SSL configures the SSL mode
+	// +kubebuilder:validation:Enum=off;flexible;full;strict;origin_pull
+	// +optional
+	SSL *string `json:"ssl,omitempty"`
+
+	// TLS13 configures TLS 1.3
+	// +kubebuilder:validation:Enum=off;on;zrt
+	// +optional
+	TLS13 *string `json:"tls13,omitempty"`
+
+	// TLSClientAuth enables or disables TLS client authentication
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	TLSClientAuth *string `json:"tlsClientAuth,omitempty"`
+
+	// TrueClientIPHeader enables or disables True client IP Header
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	TrueClientIPHeader *string `json:"trueClientIPHeader,omitempty"`
+
+	// VisitorIP enables or disables Visitor IP
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	VisitorIP *string `json:"visitorIP,omitempty"`
+
+	// WAF enables or disables the Web application firewall
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	WAF *string `json:"waf,omitempty"`
+
+	// WebP enables or disables WebP
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	WebP *string `json:"webP,omitempty"`
+
+	// WebSockets enables or disables Web sockets
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	WebSockets *string `json:"webSockets,omitempty"`
+
+	// ZeroRTT enables or disables Zero RTT
+	// +kubebuilder:validation:Enum=off;on
+	// +optional
+	ZeroRTT *string `json:"zeroRtt,omitempty"`
+}
+
+// ZoneSettingsRemediationPolicy controls how settings of a Zone that were
+// changed outside of Crossplane are remediated, per group of settings.
+type ZoneSettingsRemediationPolicy struct {
+	// Default is the policy of settings whose group has no policy of its
+	// own, and of settings that belong to no group.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +kubebuilder:default=Enforce
+	// +optional
+	Default *string `json:"default,omitempty"`
+
+	// Security is the policy of advancedDdos, browserCheck, challengeTtl,
+	// emailObfuscation, hotlinkProtection, privacyPass, securityHeader,
+	// securityLevel, serverSideExclude and waf.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	Security *string `json:"security,omitempty"`
+
+	// TLS is the policy of alwaysUseHttps, automaticHttpsRewrites,
+	// ciphers, minTLSVersion, opportunisticEncryption, ssl, tls13 and
+	// tlsClientAuth.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	TLS *string `json:"tls,omitempty"`
+
+	// Caching is the policy of alwaysOnline, browserCacheTtl, cacheLevel,
+	// developmentMode, edgeCacheTtl and sortQueryStringForCache.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	Caching *string `json:"caching,omitempty"`
+
+	// Performance is the policy of brotli, http2, http3, minify, mirage,
+	// polish, prefetchPreload, responseBuffering, rocketLoader, webP and
+	// zeroRtt.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	Performance *string `json:"performance,omitempty"`
+
+	// Network is the policy of cnameFlattening, ipGeolocation, ipv6,
+	// maxUpload, opportunisticOnion, orangeToOrange, pseudoIpv4,
+	// trueClientIPHeader, visitorIP and webSockets.
+	// +kubebuilder:validation:Enum=Enforce;Alert;Ignore
+	// +optional
+	Network *string `json:"network,omitempty"`
+}
+
+// ZoneParameters are the configurable fields of a Zone.
+// +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name || (has(self.allowRecreate) && self.allowRecreate)",message="name is immutable unless allowRecreate is true"
+type ZoneParameters struct {
+	// Name is the name of the Zone, which should be a valid
+	// domain.
+	// +kubebuilder:validation:Format=hostname
+	// +kubebuilder:validation:MaxLength=253
+	// +immutable
+	Name string `json:"name"`
+
+	// AllowRecreate permits the Zone to be deleted and created again
+	// when Name changes. Deleting a Zone deletes all of its DNS records
+	// and settings. While unset a changed name is only reported by the
+	// RequiresReplacement condition.
+	// +optional
+	AllowRecreate *bool `json:"allowRecreate,omitempty"`
+
+	// AccountID is the account ID under which this Zone will be
+	// created.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// JumpStart enables attempting to import existing DNS records
+	// when a new Zone is created.
+	//
+	// WARNING: When enabled, Cloudflare automatically creates DNS records
+	// by scanning your domain's existing nameservers. These auto-created
+	// records will NOT be managed by Crossplane and will exist only in
+	// Cloudflare. To manage them with Crossplane, you must:
+	// 1. Create corresponding Record resources with matching settings
+	// 2. Import the external records using crossplane.io/external-name annotation
+	// The imported records are listed in status.atProvider.jumpStartRecords.
+	//
+	// Recommendation: Leave disabled (false) for new zones to maintain
+	// full Crossplane control over DNS records.
+	// +kubebuilder:default=false
+	// +immutable
+	// +optional
+	JumpStart bool `json:"jumpStart"`
+
+	// Paused indicates if the zone is only using Cloudflare DNS services.
+	// +optional
+	Paused *bool `json:"paused,omitempty"`
+
+	// PlanID indicates the plan that this Zone will be subscribed
+	// to.
+	// +optional
+	PlanID *string `json:"planId,omitempty"`
+
+	// Type indicates the type of this zone - partial (partner-hosted
+	// or CNAME only) or full.
+	// +kubebuilder:validation:Enum=full;partial
+	// +kubebuilder:default=full
+	// +immutable
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Settings contains a Zone settings that can be applied
+	// to this zone.
+	// +optional
+	Settings ZoneSettings `json:"settings,omitempty"`
+
+	// SettingsTemplateRef references a ZoneSettingsTemplate this Zone
+	// inherits settings from. Settings set on the Zone override those of
+	// the template.
+	// +optional
+	SettingsTemplateRef *xpv1.Reference `json:"settingsTemplateRef,omitempty"`
+
+	// RemediationPolicy controls whether settings that were changed
+	// outside of Crossplane are reverted, only reported, or ignored.
+	// All settings are reverted when unset.
+	// +optional
+	RemediationPolicy *ZoneSettingsRemediationPolicy `json:"remediationPolicy,omitempty"`
+
+	// UnderAttackMode puts the zone in Under Attack Mode while true, by
+	// raising its security level to under_attack. When it is set to false
+	// the security level is restored to settings.securityLevel, or to
+	// medium if that is unset or under_attack. The security level is left
+	// alone while it is unset.
+	// +optional
+	UnderAttackMode *bool `json:"underAttackMode,omitempty"`
+
+	// VanityNameServers lists an array of domains to use for custom
+	// nameservers.
+	// +optional
+	VanityNameServers []string `json:"vanityNameServers,omitempty"`
+}
+
+// ZoneObservation are the observable fields of a Zone.
+type ZoneObservation struct {
+	// AccountID is the account ID that this zone exists under
+	AccountID string `json:"accountId,omitempty"`
+
+	// AccountName is the account name that this zone exists under
+	Account string `json:"accountName,omitempty"`
+
+	// DevModeTimer indicates the number of seconds left
+	// in dev mode (if positive), otherwise the number
+	// of seconds since dev mode expired.
+	DevModeTimer int `json:"devModeTimer,omitempty"`
+
+	// OriginalNS lists the original nameservers when
+	// this Zone was created.
+	OriginalNS []string `json:"originalNameServers,omitempty"`
+
+	// OriginalRegistrar indicates the original registrar
+	// when this Zone was created.
+	OriginalRegistrar string `json:"originalRegistrar,omitempty"`
+
+	// OriginalDNSHost indicates the original DNS host
+	// when this Zone was created.
+	OriginalDNSHost string `json:"originalDNSHost,omitempty"`
+
+	// NameServers lists the Name servers that are assigned
+	// to this Zone.
+	NameServers []string `json:"nameServers,omitempty"`
+
+	// PlanID indicates the billing plan ID assigned
+	// to this Zone.
+	PlanID string `json:"planId,omitempty"`
+
+	// Plan indicates the name of the plan assigned
+	// to this Zone.
+	Plan string `json:"plan,omitempty"`
+
+	// PlanPendingID indicates the ID of the pending plan
+	// assigned to this Zone.
+	PlanPendingID string `json:"planPendingId,omitempty"`
+
+	// PlanPending indicates the name of the pending plan
+	// assigned to this Zone.
+	PlanPending string `json:"planPending,omitempty"`
+
+	// Status indicates the status of this Zone.
+	Status string `json:"status,omitempty"`
+
+	// Betas indicates the betas available on this Zone.
+	Betas []string `json:"betas,omitempty"`
+
+	// DeactReason indicates the deactivation reason on
+	// this Zone.
+	DeactReason string `json:"deactivationReason,omitempty"`
+
+	// VerificationKey indicates the Verification key set
+	// on this Zone.
+	VerificationKey string `json:"verificationKey,omitempty"`
+
+	// VanityNameServers lists the currently assigned vanity
+	// name server addresses.
+	VanityNameServers []string `json:"vanityNameServers,omitempty"`
+
+	// Entitlements are the features available on the plan of this Zone.
+	Entitlements *ZoneEntitlements `json:"entitlements,omitempty"`
+
+	// DriftedSettings lists the settings whose remediation policy is
+	// Alert that no longer have their desired values.
+	DriftedSettings []string `json:"driftedSettings,omitempty"`
+
+	// SecurityLevel is the current security level of the Zone.
+	SecurityLevel string `json:"securityLevel,omitempty"`
+
+	// UnderAttackMode indicates whether the Zone is in Under Attack Mode.
+	UnderAttackMode bool `json:"underAttackMode,omitempty"`
+
+	// JumpStartRecords lists the DNS records Cloudflare imported by
+	// scanning the previous nameservers of the Zone when it was created
+	// with jumpStart. They are not managed by Crossplane.
+	JumpStartRecords []JumpStartRecord `json:"jumpStartRecords,omitempty"`
+
+	pcv1alpha1.APIErrorObservation `json:",inline"`
+}
+
+// A JumpStartRecord is a DNS record imported when a Zone was created, with
+// the fields needed to manage it with a Record.
+type JumpStartRecord struct {
+	// ID of the record, which is the external name of a Record managing
+	// it.
+	ID string `json:"id"`
+
+	// Type of the record, e.g. A or MX.
+	Type string `json:"type"`
+
+	// Name of the record.
+	Name string `json:"name"`
+
+	// Content of the record.
+	Content string `json:"content,omitempty"`
+
+	// TTL of the record, where 1 is automatic.
+	TTL int `json:"ttl,omitempty"`
+
+	// Proxied indicates whether the record is proxied by Cloudflare.
+	Proxied bool `json:"proxied,omitempty"`
+
+	// Priority of the record, for MX records.
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// ZoneEntitlements are the features available on the plan of a Zone, so
+// that compositions can decide what to create on it.
+type ZoneEntitlements struct {
+	// PageRules is the number of Page Rules available on the Zone.
+	PageRules *int64 `json:"pageRules,omitempty"`
+
+	// RateLimiting indicates whether rate limiting rules are available
+	// on the Zone.
+	RateLimiting *bool `json:"rateLimiting,omitempty"`
+
+	// RateLimitingRules is the number of rate limiting rules available
+	// on the Zone.
+	RateLimitingRules *int64 `json:"rateLimitingRules,omitempty"`
+
+	// WorkerRoutes is the number of Worker routes available on the Zone.
+	WorkerRoutes *int64 `json:"workerRoutes,omitempty"`
+
+	// Features maps the key of each entitlement of the Zone to its
+	// allocation, e.g. page_rules to 3.
+	Features map[string]string `json:"features,omitempty"`
+}
+
+// A ZoneSpec defines the desired state of a Zone.
+type ZoneSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ZoneParameters `json:"forProvider"`
+}
+
+// A ZoneStatus represents the observed state of a Zone.
+type ZoneStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ZoneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Zone is a set of common settings applied to one or more domains.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.atProvider.accountId"
+// +kubebuilder:printcolumn:name="PLAN",type="string",JSONPath=".status.atProvider.plan"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudflare}
+type Zone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ZoneSpec   `json:"spec"`
+	Status ZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ZoneList contains a list of Zone objects.
+type ZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Zone `json:"items"`
+}
@@ -17,18 +17,31 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"gopkg.in/alecthomas/kingpin.v2"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
 	"github.com/rossigee/provider-cloudflare/apis"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
 	"github.com/rossigee/provider-cloudflare/internal/controller"
+	"github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/migration"
+	"github.com/rossigee/provider-cloudflare/internal/snapshot"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+	"github.com/rossigee/provider-cloudflare/internal/version"
 )
 
 func main() {
@@ -37,8 +50,24 @@ func main() {
 		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod     = app.Flag("sync", "Controller manager sync period such as 300ms, 1.5h, or 2h45m").Short('s').Default("1h").Duration()
 		leaderElection = app.Flag("leader-election", "Use leader election for the controller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+		maxMutations   = app.Flag("max-inflight-mutations", "Maximum number of mutating Cloudflare API requests in flight across all controllers. 0 disables the limit.").Default(strconv.Itoa(clients.DefaultMaxInFlightMutations)).Int()
+		maxUploads     = app.Flag("max-inflight-large-uploads", "Maximum number of large Cloudflare API uploads, such as Worker scripts, in flight across all controllers. 0 disables the limit.").Default(strconv.Itoa(clients.DefaultMaxInFlightLargeUploads)).Int()
+		compressOver   = app.Flag("compress-uploads-over", "Gzip compress Cloudflare API uploads larger than this many bytes. 0 disables compression.").Default(strconv.Itoa(clients.DefaultCompressUploadsOver)).Int64()
+		maxQueued      = app.Flag("rate-limit-max-queued-per-kind", "Maximum number of requests of a single controller that may wait for the shared rate limit of a ProviderConfig. Further requests fail and are retried with backoff. 0 disables the limit.").Default(strconv.Itoa(clients.DefaultMaxQueuedPerKind)).Int()
+		limitWeights   = app.Flag("rate-limit-weight", "Share of the shared rate limit of a ProviderConfig given to a single controller, relative to the default of 1, as controller=weight, e.g. managed/certificatepack.ssl.cloudflare.crossplane.io=4. May be repeated.").StringMap()
+		callTimeout    = app.Flag("api-call-timeout", "Time a single Cloudflare API request may take before it is abandoned. 0 disables the timeout.").Default(metrics.DefaultCallTimeout.String()).Duration()
+		callTimeouts   = app.Flag("api-call-timeout-override", "Call timeout of a single controller, as controller=duration, e.g. managed/script.workers.cloudflare.crossplane.io=2m. May be repeated.").StringMap()
+		webhookCertDir = app.Flag("webhook-tls-cert-dir", "The directory of the TLS certificate (tls.crt and tls.key) used by the webhook server. Webhooks are disabled when unset.").Envar("WEBHOOK_TLS_CERT_DIR").String()
+		certManager    = app.Flag("enable-cert-manager-issuer", "Fulfill cert-manager CertificateRequests referencing an OriginIssuer or ClusterOriginIssuer. Requires cert-manager to be installed.").Default("false").Bool()
+		stateSnapshot  = app.Flag("enable-state-snapshot", "Serve a JSON summary of all managed resources at "+snapshot.Path+" on the metrics endpoint.").Default("false").Bool()
+		tracingOn      = app.Flag("enable-tracing", "Trace reconciles and Cloudflare API calls with OpenTelemetry, exporting them over OTLP/HTTP as configured by the OTEL_EXPORTER_OTLP_* environment variables.").Default("false").Bool()
+		tracingRatio   = app.Flag("tracing-sample-ratio", "Ratio of reconciles to trace when tracing is enabled, between 0 and 1.").Default("1").Float64()
+
+		migrateCmd    = app.Command("migrate-storage", "Rewrite stored Cloudflare resources at the storage version of their CRD, then exit. Run after upgrading the provider.")
+		migrateDryRun = migrateCmd.Flag("dry-run", "Report the resources that would be rewritten without writing them.").Bool()
 	)
-	kingpin.MustParse(app.Parse(os.Args[1:]))
+	app.Command("start", "Start the Cloudflare controllers.").Default()
+	cmd := kingpin.MustParse(app.Parse(os.Args[1:]))
 
 	zl := zap.New(zap.UseDevMode(*debug))
 	log := logging.NewLogrLogger(zl.WithName("provider-cloudflare"))
@@ -49,19 +78,74 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
-	log.Debug("Starting", "sync-period", syncPeriod.String())
+	if cmd == migrateCmd.FullCommand() {
+		cfg, err := ctrl.GetConfig()
+		kingpin.FatalIfError(err, "Cannot get API server rest config")
+		s := runtime.NewScheme()
+		kingpin.FatalIfError(extv1.AddToScheme(s), "Cannot add CustomResourceDefinition API to scheme")
+		kube, err := client.New(cfg, client.Options{Scheme: s})
+		kingpin.FatalIfError(err, "Cannot create API server client")
+		_, err = migration.NewMigrator(kube, migration.WithLogger(log), migration.WithDryRun(*migrateDryRun)).Migrate(context.Background())
+		kingpin.FatalIfError(err, "Cannot migrate stored resources")
+		return
+	}
+
+	log.Debug("Starting", "version", version.Version, "sync-period", syncPeriod.String(), "max-inflight-mutations", *maxMutations, "max-inflight-large-uploads", *maxUploads)
+
+	clients.SetMaxInFlightMutations(*maxMutations)
+	clients.SetMaxInFlightLargeUploads(*maxUploads)
+	clients.SetCompressUploadsOver(*compressOver)
+	clients.SetMaxQueuedPerKind(*maxQueued)
+	for c, v := range *limitWeights {
+		w, err := strconv.ParseFloat(v, 64)
+		kingpin.FatalIfError(err, "Cannot parse rate limit weight of controller %s", c)
+		if w <= 0 {
+			kingpin.Fatalf("Rate limit weight of controller %s must be positive", c)
+		}
+		clients.SetRateLimitWeight(c, w)
+	}
+	metrics.SetCallTimeout(*callTimeout)
+	for c, v := range *callTimeouts {
+		d, err := time.ParseDuration(v)
+		kingpin.FatalIfError(err, "Cannot parse call timeout of controller %s", c)
+		metrics.SetControllerCallTimeout(c, d)
+	}
+
+	if *tracingOn {
+		shutdown, err := tracing.Setup(context.Background(), *tracingRatio)
+		kingpin.FatalIfError(err, "Cannot set up tracing")
+		defer func() {
+			if err := shutdown(context.Background()); err != nil {
+				log.Info("Cannot flush traces", "error", err)
+			}
+		}()
+	}
 
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
-	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+	o := ctrl.Options{
 		LeaderElection:   *leaderElection,
 		LeaderElectionID: "crossplane-leader-election-provider-cloudflare",
-	})
+	}
+	if *webhookCertDir != "" {
+		o.WebhookServer = webhook.NewServer(webhook.Options{CertDir: *webhookCertDir})
+	}
+
+	mgr, err := ctrl.NewManager(cfg, o)
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
 	rl := workqueue.DefaultTypedControllerRateLimiter[any]()
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add CloudFlare APIs to scheme")
 	kingpin.FatalIfError(controller.SetupMinimal(mgr, log, rl), "Cannot setup minimal CloudFlare controllers")
+	if *webhookCertDir != "" {
+		kingpin.FatalIfError(controller.SetupWebhooks(mgr), "Cannot setup CloudFlare webhooks")
+	}
+	if *certManager {
+		kingpin.FatalIfError(controller.SetupCertManagerIssuer(mgr, log, rl), "Cannot setup cert-manager issuer controller")
+	}
+	if *stateSnapshot {
+		kingpin.FatalIfError(mgr.AddMetricsServerExtraHandler(snapshot.Path, snapshot.NewHandler(mgr.GetClient(), mgr.GetScheme())), "Cannot serve state snapshot")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
\ No newline at end of file
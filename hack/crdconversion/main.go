@@ -0,0 +1,88 @@
+//go:build generate
+// +build generate
+
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// crdconversion configures webhook conversion for every CRD in a directory
+// that serves more than one version. controller-gen has no marker for this.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: crdconversion <crd-directory>")
+		os.Exit(1)
+	}
+	if err := run(os.Args[1]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := patch(f); err != nil {
+			return fmt.Errorf("%s: %w", f, err)
+		}
+	}
+	return nil
+}
+
+func patch(file string) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	crd := map[string]interface{}{}
+	if err := yaml.Unmarshal(b, &crd); err != nil {
+		return err
+	}
+	spec, ok := crd["spec"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if versions, ok := spec["versions"].([]interface{}); !ok || len(versions) < 2 {
+		return nil
+	}
+
+	// Crossplane fills in the webhook client configuration when it
+	// installs the provider package.
+	spec["conversion"] = map[string]interface{}{
+		"strategy": "Webhook",
+		"webhook": map[string]interface{}{
+			"conversionReviewVersions": []string{"v1"},
+		},
+	}
+
+	out, err := yaml.Marshal(crd)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, append([]byte("---\n"), out...), 0o644) //nolint:gosec // CRDs are not sensitive.
+}
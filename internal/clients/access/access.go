@@ -0,0 +1,331 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package access contains the clients for Cloudflare Access resources.
+package access
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/rossigee/provider-cloudflare/apis/access/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	// ConnectionDetailPublicKey is the connection detail holding the
+	// public key of a CA, in OpenSSH authorized_keys format.
+	ConnectionDetailPublicKey = "publicKey"
+
+	errListCAs     = "cannot list Access SSH CAs"
+	errCreateCA    = "cannot create Access SSH CA"
+	errDeleteCA    = "cannot delete Access SSH CA"
+	errGetAppCA    = "cannot get Access application CA"
+	errCreateAppCA = "cannot create Access application CA"
+	errDeleteAppCA = "cannot delete Access application CA"
+	errGetTag      = "cannot get Access tag"
+	errCreateTag   = "cannot create Access tag"
+	errDeleteTag   = "cannot delete Access tag"
+	errGetApp      = "cannot get Access application"
+	errUpdateApp   = "cannot update Access application"
+
+	errCANotFound    = "Access SSH CA not found"
+	errAppCANotFound = "Access application CA not found"
+	errTagNotFound   = "Access tag not found"
+	errAppNotFound   = "Access application not found"
+
+	// Fields of an Access application holding its App Launcher settings.
+	appLauncherVisible = "app_launcher_visible"
+	appTags            = "tags"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with Access CAs, tags and applications. The SSH CA of an account is not
+// modelled by cloudflare-go, so it is read and written through the raw
+// API, as are applications so that fields cloudflare-go does not know of
+// survive an update.
+type Client interface {
+	clients.RawAPI
+	GetAccessCACertificate(ctx context.Context, rc *cloudflare.ResourceContainer, applicationID string) (cloudflare.AccessCACertificate, error)
+	CreateAccessCACertificate(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessCACertificateParams) (cloudflare.AccessCACertificate, error)
+	DeleteAccessCACertificate(ctx context.Context, rc *cloudflare.ResourceContainer, applicationID string) error
+	GetAccessTag(ctx context.Context, rc *cloudflare.ResourceContainer, tagName string) (cloudflare.AccessTag, error)
+	CreateAccessTag(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessTagParams) (cloudflare.AccessTag, error)
+	DeleteAccessTag(ctx context.Context, rc *cloudflare.ResourceContainer, tagName string) error
+}
+
+// NewClient returns a new Cloudflare API client for working with Access
+// CAs.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// CA is the SSH CA of an account.
+type CA struct {
+	ID        string `json:"id"`
+	PublicKey string `json:"public_key"`
+}
+
+func caEndpoint(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/access/gateway_ca", accountID)
+}
+
+// GetCA returns the SSH CA of an account with the supplied ID.
+func GetCA(ctx context.Context, client Client, accountID, id string) (CA, error) {
+	res, err := client.Raw(ctx, http.MethodGet, caEndpoint(accountID), nil, nil)
+	if err != nil {
+		return CA{}, errors.Wrap(err, errListCAs)
+	}
+	var cas []CA
+	if err := json.Unmarshal(res.Result, &cas); err != nil {
+		return CA{}, errors.Wrap(err, errListCAs)
+	}
+	for _, ca := range cas {
+		if ca.ID == id {
+			return ca, nil
+		}
+	}
+	return CA{}, clients.NewNotFoundError(errCANotFound)
+}
+
+// CreateCA creates the SSH CA of an account.
+func CreateCA(ctx context.Context, client Client, accountID string) (CA, error) {
+	res, err := client.Raw(ctx, http.MethodPost, caEndpoint(accountID), nil, nil)
+	if err != nil {
+		return CA{}, errors.Wrap(err, errCreateCA)
+	}
+	ca := CA{}
+	return ca, errors.Wrap(json.Unmarshal(res.Result, &ca), errCreateCA)
+}
+
+// DeleteCA deletes the SSH CA of an account with the supplied ID.
+func DeleteCA(ctx context.Context, client Client, accountID, id string) error {
+	_, err := client.Raw(ctx, http.MethodDelete, caEndpoint(accountID)+"/"+id, nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteCA)
+}
+
+// GenerateCAObservation creates an observation of the SSH CA of an
+// account.
+func GenerateCAObservation(in CA) v1alpha1.AccessCAObservation {
+	return v1alpha1.AccessCAObservation{ID: in.ID, PublicKey: in.PublicKey}
+}
+
+// GetApplicationCA returns the CA issuing short-lived certificates for an
+// Access application.
+func GetApplicationCA(ctx context.Context, client Client, accountID, applicationID string) (cloudflare.AccessCACertificate, error) {
+	ca, err := client.GetAccessCACertificate(ctx, cloudflare.AccountIdentifier(accountID), applicationID)
+	if err != nil {
+		if isNotFound(err) {
+			return cloudflare.AccessCACertificate{}, clients.NewNotFoundError(errAppCANotFound)
+		}
+		return cloudflare.AccessCACertificate{}, errors.Wrap(err, errGetAppCA)
+	}
+	return ca, nil
+}
+
+// CreateApplicationCA generates the CA issuing short-lived certificates
+// for an Access application.
+func CreateApplicationCA(ctx context.Context, client Client, accountID, applicationID string) (cloudflare.AccessCACertificate, error) {
+	ca, err := client.CreateAccessCACertificate(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateAccessCACertificateParams{ApplicationID: applicationID})
+	return ca, errors.Wrap(err, errCreateAppCA)
+}
+
+// DeleteApplicationCA deletes the CA issuing short-lived certificates for
+// an Access application.
+func DeleteApplicationCA(ctx context.Context, client Client, accountID, applicationID string) error {
+	err := client.DeleteAccessCACertificate(ctx, cloudflare.AccountIdentifier(accountID), applicationID)
+	if isNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteAppCA)
+}
+
+// GenerateApplicationCAObservation creates an observation of the CA
+// issuing short-lived certificates for an Access application.
+func GenerateApplicationCAObservation(in cloudflare.AccessCACertificate) v1alpha1.ShortLivedCertificateObservation {
+	return v1alpha1.ShortLivedCertificateObservation{ID: in.ID, AUD: in.Aud, PublicKey: in.PublicKey}
+}
+
+// GetTag returns the Access tag of an account with the supplied name.
+func GetTag(ctx context.Context, client Client, accountID, name string) (cloudflare.AccessTag, error) {
+	tag, err := client.GetAccessTag(ctx, cloudflare.AccountIdentifier(accountID), name)
+	if err != nil {
+		if isNotFound(err) {
+			return cloudflare.AccessTag{}, clients.NewNotFoundError(errTagNotFound)
+		}
+		return cloudflare.AccessTag{}, errors.Wrap(err, errGetTag)
+	}
+	return tag, nil
+}
+
+// CreateTag creates an Access tag of an account with the supplied name.
+func CreateTag(ctx context.Context, client Client, accountID, name string) (cloudflare.AccessTag, error) {
+	tag, err := client.CreateAccessTag(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateAccessTagParams{Name: name})
+	return tag, errors.Wrap(err, errCreateTag)
+}
+
+// DeleteTag deletes the Access tag of an account with the supplied name.
+func DeleteTag(ctx context.Context, client Client, accountID, name string) error {
+	err := client.DeleteAccessTag(ctx, cloudflare.AccountIdentifier(accountID), name)
+	if isNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteTag)
+}
+
+// GenerateTagObservation creates an observation of an Access tag.
+func GenerateTagObservation(in cloudflare.AccessTag) v1alpha1.AccessTagObservation {
+	return v1alpha1.AccessTagObservation{AppCount: in.AppCount}
+}
+
+func appEndpoint(accountID, applicationID string) string {
+	return fmt.Sprintf("/accounts/%s/access/apps/%s", accountID, applicationID)
+}
+
+// getApp returns every field of an Access application.
+func getApp(ctx context.Context, client Client, accountID, applicationID string) (map[string]interface{}, error) {
+	res, err := client.Raw(ctx, http.MethodGet, appEndpoint(accountID, applicationID), nil, nil)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, clients.NewNotFoundError(errAppNotFound)
+		}
+		return nil, errors.Wrap(err, errGetApp)
+	}
+	app := map[string]interface{}{}
+	return app, errors.Wrap(json.Unmarshal(res.Result, &app), errGetApp)
+}
+
+// GetAppLauncher returns an observation of the App Launcher settings of an
+// Access application.
+func GetAppLauncher(ctx context.Context, client Client, accountID, applicationID string) (v1alpha1.AppLauncherSettingsObservation, error) {
+	res, err := client.Raw(ctx, http.MethodGet, appEndpoint(accountID, applicationID), nil, nil)
+	if err != nil {
+		if isNotFound(err) {
+			return v1alpha1.AppLauncherSettingsObservation{}, clients.NewNotFoundError(errAppNotFound)
+		}
+		return v1alpha1.AppLauncherSettingsObservation{}, errors.Wrap(err, errGetApp)
+	}
+	app := cloudflare.AccessApplication{}
+	if err := json.Unmarshal(res.Result, &app); err != nil {
+		return v1alpha1.AppLauncherSettingsObservation{}, errors.Wrap(err, errGetApp)
+	}
+	return v1alpha1.AppLauncherSettingsObservation{
+		Name:    app.Name,
+		Visible: app.AppLauncherVisible != nil && *app.AppLauncherVisible,
+		Tags:    app.Tags,
+	}, nil
+}
+
+// UpdateAppLauncher applies the supplied App Launcher settings to an
+// Access application. Applications can only be replaced as a whole, so the
+// application is read and written back with only these settings changed.
+func UpdateAppLauncher(ctx context.Context, client Client, p v1alpha1.AppLauncherSettingsParameters) error {
+	return updateApp(ctx, client, p.AccountID, p.ApplicationID, func(app map[string]interface{}) {
+		if p.Visible != nil {
+			app[appLauncherVisible] = *p.Visible
+		}
+		if p.Tags != nil {
+			app[appTags] = p.Tags
+		}
+	})
+}
+
+// ResetAppLauncher shows an Access application in the App Launcher again
+// and removes its tags, insofar as the supplied settings manage them.
+func ResetAppLauncher(ctx context.Context, client Client, p v1alpha1.AppLauncherSettingsParameters) error {
+	err := updateApp(ctx, client, p.AccountID, p.ApplicationID, func(app map[string]interface{}) {
+		if p.Visible != nil {
+			app[appLauncherVisible] = true
+		}
+		if p.Tags != nil {
+			app[appTags] = []string{}
+		}
+	})
+	if clients.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func updateApp(ctx context.Context, client Client, accountID, applicationID string, update func(map[string]interface{})) error {
+	app, err := getApp(ctx, client, accountID, applicationID)
+	if err != nil {
+		return err
+	}
+	update(app)
+	_, err = client.Raw(ctx, http.MethodPut, appEndpoint(accountID, applicationID), app, nil)
+	return errors.Wrap(err, errUpdateApp)
+}
+
+// AppLauncherUpToDate returns true if the App Launcher settings of an
+// Access application match the supplied settings. Tags are compared
+// regardless of their order.
+func AppLauncherUpToDate(p v1alpha1.AppLauncherSettingsParameters, o v1alpha1.AppLauncherSettingsObservation) bool {
+	if p.Visible != nil && *p.Visible != o.Visible {
+		return false
+	}
+	if p.Tags != nil && !sameTags(p.Tags, o.Tags) {
+		return false
+	}
+	return true
+}
+
+// AppLauncherIsReset returns true if an Access application is shown in the
+// App Launcher and has no tags, insofar as the supplied settings manage
+// them.
+func AppLauncherIsReset(p v1alpha1.AppLauncherSettingsParameters, o v1alpha1.AppLauncherSettingsObservation) bool {
+	if p.Visible != nil && !o.Visible {
+		return false
+	}
+	if p.Tags != nil && len(o.Tags) > 0 {
+		return false
+	}
+	return true
+}
+
+func sameTags(a, b []string) bool {
+	as, bs := slices.Clone(a), slices.Clone(b)
+	slices.Sort(as)
+	slices.Sort(bs)
+	return slices.Equal(slices.Compact(as), slices.Compact(bs))
+}
+
+// ConnectionDetails returns the connection details of a CA, which is its
+// public key.
+func ConnectionDetails(publicKey string) managed.ConnectionDetails {
+	if publicKey == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{ConnectionDetailPublicKey: []byte(publicKey)}
+}
+
+func isNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/access/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/access/fake"
+)
+
+func TestGetCA(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		ca       CA
+		notFound bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		result string
+		err    error
+		want   want
+	}{
+		"Found": {
+			reason: "The CA with the supplied ID should be returned",
+			result: `[{"id":"other","public_key":"ecdsa-sha2-nistp256 BBBB"},{"id":"ca1","public_key":"ecdsa-sha2-nistp256 AAAA"}]`,
+			want:   want{ca: CA{ID: "ca1", PublicKey: "ecdsa-sha2-nistp256 AAAA"}},
+		},
+		"NotFound": {
+			reason: "A CA that is not listed should be reported as not found",
+			result: `[{"id":"other","public_key":"ecdsa-sha2-nistp256 BBBB"}]`,
+			want:   want{notFound: true},
+		},
+		"Error": {
+			reason: "Errors listing CAs should be returned",
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errListCAs)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &fake.ClientMock{RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				if method != http.MethodGet || endpoint != "/accounts/acc/access/gateway_ca" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+				}
+				return cloudflare.RawResponse{Result: json.RawMessage(tc.result)}, tc.err
+			}}
+			got, err := GetCA(context.Background(), c, "acc", "ca1")
+			if tc.want.notFound {
+				if !clients.IsNotFound(err) {
+					t.Errorf("\n%s\nGetCA(...): want not found error, got %v", tc.reason, err)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetCA(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.ca, got); diff != "" {
+				t.Errorf("\n%s\nGetCA(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeleteCA(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   error
+	}{
+		"Deleted": {
+			reason: "Deleting a CA should succeed",
+		},
+		"AlreadyGone": {
+			reason: "Deleting a CA that no longer exists should succeed",
+			err:    &cloudflare.NotFoundError{},
+		},
+		"Error": {
+			reason: "Other errors deleting a CA should be returned",
+			err:    errBoom,
+			want:   errors.Wrap(errBoom, errDeleteCA),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &fake.ClientMock{RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				if method != http.MethodDelete || endpoint != "/accounts/acc/access/gateway_ca/ca1" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+				}
+				return cloudflare.RawResponse{}, tc.err
+			}}
+			err := DeleteCA(context.Background(), c, "acc", "ca1")
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDeleteCA(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetApplicationCA(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason   string
+		err      error
+		notFound bool
+		want     error
+	}{
+		"Found": {
+			reason: "The CA of the application should be returned",
+		},
+		"NotFound": {
+			reason:   "An application without a CA should be reported as not found",
+			err:      &cloudflare.NotFoundError{},
+			notFound: true,
+		},
+		"Error": {
+			reason: "Other errors getting the CA should be returned",
+			err:    errBoom,
+			want:   errors.Wrap(errBoom, errGetAppCA),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ca := cloudflare.AccessCACertificate{ID: "ca1", Aud: "aud1", PublicKey: "ecdsa-sha2-nistp256 AAAA"}
+			c := &fake.ClientMock{GetAccessCACertificateFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, applicationID string) (cloudflare.AccessCACertificate, error) {
+				if rc.Identifier != "acc" || applicationID != "app1" {
+					return cloudflare.AccessCACertificate{}, errors.Errorf("unexpected application %s/%s", rc.Identifier, applicationID)
+				}
+				if tc.err != nil {
+					return cloudflare.AccessCACertificate{}, tc.err
+				}
+				return ca, nil
+			}}
+			got, err := GetApplicationCA(context.Background(), c, "acc", "app1")
+			if tc.notFound {
+				if !clients.IsNotFound(err) {
+					t.Errorf("\n%s\nGetApplicationCA(...): want not found error, got %v", tc.reason, err)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetApplicationCA(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if tc.want == nil {
+				if diff := cmp.Diff(ca, got); diff != "" {
+					t.Errorf("\n%s\nGetApplicationCA(...): -want, +got:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		publicKey string
+		want      managed.ConnectionDetails
+	}{
+		"PublicKey": {
+			reason:    "The public key of a CA should be published",
+			publicKey: "ecdsa-sha2-nistp256 AAAA",
+			want:      managed.ConnectionDetails{ConnectionDetailPublicKey: []byte("ecdsa-sha2-nistp256 AAAA")},
+		},
+		"Unknown": {
+			reason: "Nothing should be published before the public key is known",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ConnectionDetails(tc.publicKey)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nConnectionDetails(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetTag(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		tag      cloudflare.AccessTag
+		notFound bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		tag    cloudflare.AccessTag
+		err    error
+		want   want
+	}{
+		"Found": {
+			reason: "The tag with the supplied name should be returned",
+			tag:    cloudflare.AccessTag{Name: "engineering", AppCount: 3},
+			want:   want{tag: cloudflare.AccessTag{Name: "engineering", AppCount: 3}},
+		},
+		"NotFound": {
+			reason: "A tag that does not exist should be reported as not found",
+			err:    &cloudflare.NotFoundError{},
+			want:   want{notFound: true},
+		},
+		"Error": {
+			reason: "Other errors getting a tag should be returned",
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGetTag)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &fake.ClientMock{GetAccessTagFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, tagName string) (cloudflare.AccessTag, error) {
+				if rc.Identifier != "acc" || tagName != "engineering" {
+					return cloudflare.AccessTag{}, errors.Errorf("unexpected tag %s of %s", tagName, rc.Identifier)
+				}
+				return tc.tag, tc.err
+			}}
+			got, err := GetTag(context.Background(), c, "acc", "engineering")
+			if tc.want.notFound {
+				if !clients.IsNotFound(err) {
+					t.Errorf("\n%s\nGetTag(...): want not found error, got %v", tc.reason, err)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetTag(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.tag, got); diff != "" {
+				t.Errorf("\n%s\nGetTag(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateAppLauncher(t *testing.T) {
+	errBoom := errors.New("boom")
+	app := `{"id":"app1","name":"Grafana","domain":"grafana.example.com","type":"self_hosted","app_launcher_visible":true,"tags":["old"],"session_duration":"24h"}`
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.AppLauncherSettingsParameters
+		getErr error
+		want   map[string]interface{}
+		err    error
+	}{
+		"Applied": {
+			reason: "The App Launcher settings should be applied, leaving the other fields of the application alone",
+			params: v1alpha1.AppLauncherSettingsParameters{AccountID: "acc", ApplicationID: "app1", Visible: ptr.To(false), Tags: []string{"engineering", "observability"}},
+			want: map[string]interface{}{
+				"id": "app1", "name": "Grafana", "domain": "grafana.example.com", "type": "self_hosted", "session_duration": "24h",
+				"app_launcher_visible": false, "tags": []interface{}{"engineering", "observability"},
+			},
+		},
+		"Unset": {
+			reason: "Settings that are unset should be left alone",
+			params: v1alpha1.AppLauncherSettingsParameters{AccountID: "acc", ApplicationID: "app1", Visible: ptr.To(false)},
+			want: map[string]interface{}{
+				"id": "app1", "name": "Grafana", "domain": "grafana.example.com", "type": "self_hosted", "session_duration": "24h",
+				"app_launcher_visible": false, "tags": []interface{}{"old"},
+			},
+		},
+		"GetError": {
+			reason: "Errors getting the application should be returned",
+			params: v1alpha1.AppLauncherSettingsParameters{AccountID: "acc", ApplicationID: "app1", Visible: ptr.To(false)},
+			getErr: errBoom,
+			err:    errors.Wrap(errBoom, errGetApp),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var put map[string]interface{}
+			c := &fake.ClientMock{RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				if endpoint != "/accounts/acc/access/apps/app1" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+				}
+				switch method {
+				case http.MethodGet:
+					return cloudflare.RawResponse{Result: json.RawMessage(app)}, tc.getErr
+				case http.MethodPut:
+					b, _ := json.Marshal(data)
+					_ = json.Unmarshal(b, &put)
+					return cloudflare.RawResponse{}, nil
+				}
+				return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+			}}
+			err := UpdateAppLauncher(context.Background(), c, tc.params)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdateAppLauncher(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, put); diff != "" {
+				t.Errorf("\n%s\nUpdateAppLauncher(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAppLauncherUpToDate(t *testing.T) {
+	obs := v1alpha1.AppLauncherSettingsObservation{Name: "Grafana", Visible: true, Tags: []string{"observability", "engineering"}}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.AppLauncherSettingsParameters
+		want   bool
+	}{
+		"Unset": {
+			reason: "Settings that are unset should be considered up to date",
+			params: v1alpha1.AppLauncherSettingsParameters{},
+			want:   true,
+		},
+		"TagOrder": {
+			reason: "Tags should be compared regardless of their order",
+			params: v1alpha1.AppLauncherSettingsParameters{Visible: ptr.To(true), Tags: []string{"engineering", "observability"}},
+			want:   true,
+		},
+		"Hidden": {
+			reason: "An application that should be hidden but is visible should not be up to date",
+			params: v1alpha1.AppLauncherSettingsParameters{Visible: ptr.To(false)},
+			want:   false,
+		},
+		"TagMissing": {
+			reason: "An application missing a tag should not be up to date",
+			params: v1alpha1.AppLauncherSettingsParameters{Tags: []string{"engineering", "observability", "sre"}},
+			want:   false,
+		},
+		"TagsCleared": {
+			reason: "An application that should have no tags but has some should not be up to date",
+			params: v1alpha1.AppLauncherSettingsParameters{Tags: []string{}},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := AppLauncherUpToDate(tc.params, obs); got != tc.want {
+				t.Errorf("\n%s\nAppLauncherUpToDate(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,433 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"net/http"
+	"sync"
+)
+
+// ClientMock is a mock implementation of access.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked access.Client
+//		mockedClient := &ClientMock{
+//			CreateAccessCACertificateFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessCACertificateParams) (cloudflare.AccessCACertificate, error) {
+//				panic("mock out the CreateAccessCACertificate method")
+//			},
+//			CreateAccessTagFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessTagParams) (cloudflare.AccessTag, error) {
+//				panic("mock out the CreateAccessTag method")
+//			},
+//			DeleteAccessCACertificateFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, applicationID string) error {
+//				panic("mock out the DeleteAccessCACertificate method")
+//			},
+//			DeleteAccessTagFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, tagName string) error {
+//				panic("mock out the DeleteAccessTag method")
+//			},
+//			GetAccessCACertificateFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, applicationID string) (cloudflare.AccessCACertificate, error) {
+//				panic("mock out the GetAccessCACertificate method")
+//			},
+//			GetAccessTagFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, tagName string) (cloudflare.AccessTag, error) {
+//				panic("mock out the GetAccessTag method")
+//			},
+//			RawFunc: func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+//				panic("mock out the Raw method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires access.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// CreateAccessCACertificateFunc mocks the CreateAccessCACertificate method.
+	CreateAccessCACertificateFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessCACertificateParams) (cloudflare.AccessCACertificate, error)
+
+	// CreateAccessTagFunc mocks the CreateAccessTag method.
+	CreateAccessTagFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessTagParams) (cloudflare.AccessTag, error)
+
+	// DeleteAccessCACertificateFunc mocks the DeleteAccessCACertificate method.
+	DeleteAccessCACertificateFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, applicationID string) error
+
+	// DeleteAccessTagFunc mocks the DeleteAccessTag method.
+	DeleteAccessTagFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, tagName string) error
+
+	// GetAccessCACertificateFunc mocks the GetAccessCACertificate method.
+	GetAccessCACertificateFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, applicationID string) (cloudflare.AccessCACertificate, error)
+
+	// GetAccessTagFunc mocks the GetAccessTag method.
+	GetAccessTagFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, tagName string) (cloudflare.AccessTag, error)
+
+	// RawFunc mocks the Raw method.
+	RawFunc func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateAccessCACertificate holds details about calls to the CreateAccessCACertificate method.
+		CreateAccessCACertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateAccessCACertificateParams
+		}
+		// CreateAccessTag holds details about calls to the CreateAccessTag method.
+		CreateAccessTag []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateAccessTagParams
+		}
+		// DeleteAccessCACertificate holds details about calls to the DeleteAccessCACertificate method.
+		DeleteAccessCACertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ApplicationID is the applicationID argument value.
+			ApplicationID string
+		}
+		// DeleteAccessTag holds details about calls to the DeleteAccessTag method.
+		DeleteAccessTag []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// TagName is the tagName argument value.
+			TagName string
+		}
+		// GetAccessCACertificate holds details about calls to the GetAccessCACertificate method.
+		GetAccessCACertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ApplicationID is the applicationID argument value.
+			ApplicationID string
+		}
+		// GetAccessTag holds details about calls to the GetAccessTag method.
+		GetAccessTag []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// TagName is the tagName argument value.
+			TagName string
+		}
+		// Raw holds details about calls to the Raw method.
+		Raw []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Method is the method argument value.
+			Method string
+			// Endpoint is the endpoint argument value.
+			Endpoint string
+			// Data is the data argument value.
+			Data interface{}
+			// Headers is the headers argument value.
+			Headers http.Header
+		}
+	}
+	lockCreateAccessCACertificate sync.RWMutex
+	lockCreateAccessTag           sync.RWMutex
+	lockDeleteAccessCACertificate sync.RWMutex
+	lockDeleteAccessTag           sync.RWMutex
+	lockGetAccessCACertificate    sync.RWMutex
+	lockGetAccessTag              sync.RWMutex
+	lockRaw                       sync.RWMutex
+}
+
+// CreateAccessCACertificate calls CreateAccessCACertificateFunc.
+func (mock *ClientMock) CreateAccessCACertificate(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessCACertificateParams) (cloudflare.AccessCACertificate, error) {
+	if mock.CreateAccessCACertificateFunc == nil {
+		panic("ClientMock.CreateAccessCACertificateFunc: method is nil but Client.CreateAccessCACertificate was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateAccessCACertificateParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateAccessCACertificate.Lock()
+	mock.calls.CreateAccessCACertificate = append(mock.calls.CreateAccessCACertificate, callInfo)
+	mock.lockCreateAccessCACertificate.Unlock()
+	return mock.CreateAccessCACertificateFunc(ctx, rc, params)
+}
+
+// CreateAccessCACertificateCalls gets all the calls that were made to CreateAccessCACertificate.
+// Check the length with:
+//
+//	len(mockedClient.CreateAccessCACertificateCalls())
+func (mock *ClientMock) CreateAccessCACertificateCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateAccessCACertificateParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateAccessCACertificateParams
+	}
+	mock.lockCreateAccessCACertificate.RLock()
+	calls = mock.calls.CreateAccessCACertificate
+	mock.lockCreateAccessCACertificate.RUnlock()
+	return calls
+}
+
+// CreateAccessTag calls CreateAccessTagFunc.
+func (mock *ClientMock) CreateAccessTag(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessTagParams) (cloudflare.AccessTag, error) {
+	if mock.CreateAccessTagFunc == nil {
+		panic("ClientMock.CreateAccessTagFunc: method is nil but Client.CreateAccessTag was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateAccessTagParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateAccessTag.Lock()
+	mock.calls.CreateAccessTag = append(mock.calls.CreateAccessTag, callInfo)
+	mock.lockCreateAccessTag.Unlock()
+	return mock.CreateAccessTagFunc(ctx, rc, params)
+}
+
+// CreateAccessTagCalls gets all the calls that were made to CreateAccessTag.
+// Check the length with:
+//
+//	len(mockedClient.CreateAccessTagCalls())
+func (mock *ClientMock) CreateAccessTagCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateAccessTagParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateAccessTagParams
+	}
+	mock.lockCreateAccessTag.RLock()
+	calls = mock.calls.CreateAccessTag
+	mock.lockCreateAccessTag.RUnlock()
+	return calls
+}
+
+// DeleteAccessCACertificate calls DeleteAccessCACertificateFunc.
+func (mock *ClientMock) DeleteAccessCACertificate(ctx context.Context, rc *cloudflare.ResourceContainer, applicationID string) error {
+	if mock.DeleteAccessCACertificateFunc == nil {
+		panic("ClientMock.DeleteAccessCACertificateFunc: method is nil but Client.DeleteAccessCACertificate was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Rc            *cloudflare.ResourceContainer
+		ApplicationID string
+	}{
+		Ctx:           ctx,
+		Rc:            rc,
+		ApplicationID: applicationID,
+	}
+	mock.lockDeleteAccessCACertificate.Lock()
+	mock.calls.DeleteAccessCACertificate = append(mock.calls.DeleteAccessCACertificate, callInfo)
+	mock.lockDeleteAccessCACertificate.Unlock()
+	return mock.DeleteAccessCACertificateFunc(ctx, rc, applicationID)
+}
+
+// DeleteAccessCACertificateCalls gets all the calls that were made to DeleteAccessCACertificate.
+// Check the length with:
+//
+//	len(mockedClient.DeleteAccessCACertificateCalls())
+func (mock *ClientMock) DeleteAccessCACertificateCalls() []struct {
+	Ctx           context.Context
+	Rc            *cloudflare.ResourceContainer
+	ApplicationID string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Rc            *cloudflare.ResourceContainer
+		ApplicationID string
+	}
+	mock.lockDeleteAccessCACertificate.RLock()
+	calls = mock.calls.DeleteAccessCACertificate
+	mock.lockDeleteAccessCACertificate.RUnlock()
+	return calls
+}
+
+// DeleteAccessTag calls DeleteAccessTagFunc.
+func (mock *ClientMock) DeleteAccessTag(ctx context.Context, rc *cloudflare.ResourceContainer, tagName string) error {
+	if mock.DeleteAccessTagFunc == nil {
+		panic("ClientMock.DeleteAccessTagFunc: method is nil but Client.DeleteAccessTag was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Rc      *cloudflare.ResourceContainer
+		TagName string
+	}{
+		Ctx:     ctx,
+		Rc:      rc,
+		TagName: tagName,
+	}
+	mock.lockDeleteAccessTag.Lock()
+	mock.calls.DeleteAccessTag = append(mock.calls.DeleteAccessTag, callInfo)
+	mock.lockDeleteAccessTag.Unlock()
+	return mock.DeleteAccessTagFunc(ctx, rc, tagName)
+}
+
+// DeleteAccessTagCalls gets all the calls that were made to DeleteAccessTag.
+// Check the length with:
+//
+//	len(mockedClient.DeleteAccessTagCalls())
+func (mock *ClientMock) DeleteAccessTagCalls() []struct {
+	Ctx     context.Context
+	Rc      *cloudflare.ResourceContainer
+	TagName string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Rc      *cloudflare.ResourceContainer
+		TagName string
+	}
+	mock.lockDeleteAccessTag.RLock()
+	calls = mock.calls.DeleteAccessTag
+	mock.lockDeleteAccessTag.RUnlock()
+	return calls
+}
+
+// GetAccessCACertificate calls GetAccessCACertificateFunc.
+func (mock *ClientMock) GetAccessCACertificate(ctx context.Context, rc *cloudflare.ResourceContainer, applicationID string) (cloudflare.AccessCACertificate, error) {
+	if mock.GetAccessCACertificateFunc == nil {
+		panic("ClientMock.GetAccessCACertificateFunc: method is nil but Client.GetAccessCACertificate was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Rc            *cloudflare.ResourceContainer
+		ApplicationID string
+	}{
+		Ctx:           ctx,
+		Rc:            rc,
+		ApplicationID: applicationID,
+	}
+	mock.lockGetAccessCACertificate.Lock()
+	mock.calls.GetAccessCACertificate = append(mock.calls.GetAccessCACertificate, callInfo)
+	mock.lockGetAccessCACertificate.Unlock()
+	return mock.GetAccessCACertificateFunc(ctx, rc, applicationID)
+}
+
+// GetAccessCACertificateCalls gets all the calls that were made to GetAccessCACertificate.
+// Check the length with:
+//
+//	len(mockedClient.GetAccessCACertificateCalls())
+func (mock *ClientMock) GetAccessCACertificateCalls() []struct {
+	Ctx           context.Context
+	Rc            *cloudflare.ResourceContainer
+	ApplicationID string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Rc            *cloudflare.ResourceContainer
+		ApplicationID string
+	}
+	mock.lockGetAccessCACertificate.RLock()
+	calls = mock.calls.GetAccessCACertificate
+	mock.lockGetAccessCACertificate.RUnlock()
+	return calls
+}
+
+// GetAccessTag calls GetAccessTagFunc.
+func (mock *ClientMock) GetAccessTag(ctx context.Context, rc *cloudflare.ResourceContainer, tagName string) (cloudflare.AccessTag, error) {
+	if mock.GetAccessTagFunc == nil {
+		panic("ClientMock.GetAccessTagFunc: method is nil but Client.GetAccessTag was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Rc      *cloudflare.ResourceContainer
+		TagName string
+	}{
+		Ctx:     ctx,
+		Rc:      rc,
+		TagName: tagName,
+	}
+	mock.lockGetAccessTag.Lock()
+	mock.calls.GetAccessTag = append(mock.calls.GetAccessTag, callInfo)
+	mock.lockGetAccessTag.Unlock()
+	return mock.GetAccessTagFunc(ctx, rc, tagName)
+}
+
+// GetAccessTagCalls gets all the calls that were made to GetAccessTag.
+// Check the length with:
+//
+//	len(mockedClient.GetAccessTagCalls())
+func (mock *ClientMock) GetAccessTagCalls() []struct {
+	Ctx     context.Context
+	Rc      *cloudflare.ResourceContainer
+	TagName string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Rc      *cloudflare.ResourceContainer
+		TagName string
+	}
+	mock.lockGetAccessTag.RLock()
+	calls = mock.calls.GetAccessTag
+	mock.lockGetAccessTag.RUnlock()
+	return calls
+}
+
+// Raw calls RawFunc.
+func (mock *ClientMock) Raw(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if mock.RawFunc == nil {
+		panic("ClientMock.RawFunc: method is nil but Client.Raw was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}{
+		Ctx:      ctx,
+		Method:   method,
+		Endpoint: endpoint,
+		Data:     data,
+		Headers:  headers,
+	}
+	mock.lockRaw.Lock()
+	mock.calls.Raw = append(mock.calls.Raw, callInfo)
+	mock.lockRaw.Unlock()
+	return mock.RawFunc(ctx, method, endpoint, data, headers)
+}
+
+// RawCalls gets all the calls that were made to Raw.
+// Check the length with:
+//
+//	len(mockedClient.RawCalls())
+func (mock *ClientMock) RawCalls() []struct {
+	Ctx      context.Context
+	Method   string
+	Endpoint string
+	Data     interface{}
+	Headers  http.Header
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}
+	mock.lockRaw.RLock()
+	calls = mock.calls.Raw
+	mock.lockRaw.RUnlock()
+	return calls
+}
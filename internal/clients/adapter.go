@@ -20,14 +20,18 @@ import (
 	"context"
 
 	"github.com/cloudflare/cloudflare-go"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients/lookup"
 )
 
-// CloudflareAPIAdapter adapts *cloudflare.API to implement ClientInterface
+// CloudflareAPIAdapter adapts *cloudflare.API to implement WorkersAPI
 type CloudflareAPIAdapter struct {
 	api       *cloudflare.API
 	accountID string
 }
 
+var _ WorkersAPI = &CloudflareAPIAdapter{}
+
 // NewCloudflareAPIAdapter creates a new adapter for cloudflare.API
 func NewCloudflareAPIAdapter(api *cloudflare.API) *CloudflareAPIAdapter {
 	return &CloudflareAPIAdapter{
@@ -43,10 +47,9 @@ func (a *CloudflareAPIAdapter) GetAccountID() string {
 	
 	// Try to get account ID from Cloudflare API by listing accounts
 	// Most users have access to only one account, so we'll use the first one
-	accounts, _, err := a.api.Accounts(context.Background(), cloudflare.AccountsListParams{})
-	if err == nil && len(accounts) > 0 {
-		a.accountID = accounts[0].ID
-		// Log successful account ID retrieval
+	accountID, err := lookup.Default.AccountID(context.Background(), a.api, lookup.Scope(a.api))
+	if err == nil {
+		a.accountID = accountID
 		return a.accountID
 	}
 	
@@ -56,8 +59,12 @@ func (a *CloudflareAPIAdapter) GetAccountID() string {
 	return a.accountID
 }
 
-// UploadWorker wraps the cloudflare API
+// UploadWorker wraps the cloudflare API. Workers with bindings that
+// cloudflare-go does not support are uploaded by the adapter.
 func (a *CloudflareAPIAdapter) UploadWorker(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerParams) (cloudflare.WorkerScriptResponse, error) {
+	if hasExtendedBindings(params.Bindings) {
+		return uploadWorker(ctx, a.api, rc, params)
+	}
 	return a.api.UploadWorker(ctx, rc, params)
 }
 
@@ -81,6 +88,12 @@ func (a *CloudflareAPIAdapter) GetWorkersScriptSettings(ctx context.Context, rc
 	return a.api.GetWorkersScriptSettings(ctx, rc, scriptName)
 }
 
+// GetWorkersScriptBindings returns the bindings in the settings of a
+// Worker script.
+func (a *CloudflareAPIAdapter) GetWorkersScriptBindings(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) ([]WorkerScriptBinding, error) {
+	return getWorkerScriptBindings(ctx, a.api, rc, scriptName)
+}
+
 // ListWorkers wraps the cloudflare API
 func (a *CloudflareAPIAdapter) ListWorkers(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersParams) (cloudflare.WorkerListResponse, *cloudflare.ResultInfo, error) {
 	return a.api.ListWorkers(ctx, rc, params)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
@@ -37,6 +38,7 @@ const (
 	errPCRef        = "providerConfigRef not set"
 	errTrackPCUsage = "cannot track ProviderConfig usage"
 	errNoAuth       = "auth details not valid"
+	errOriginCAOnly = "an Origin CA key can only authenticate Origin CA certificate requests; configure an API token or an API key and email"
 )
 
 // AuthByAPIKey represents the details required to authenticate
@@ -53,30 +55,115 @@ type AuthByAPIToken struct {
 	Token *string `json:"token,omitempty"`
 }
 
+// AuthByOriginCAKey represents the details required to authenticate
+// Origin CA certificate requests using an Origin CA key, which cannot
+// authenticate any other request.
+type AuthByOriginCAKey struct {
+	OriginCAKey *string `json:"originCAKey,omitempty"`
+}
+
+// An AuthMode is a way of authenticating with the Cloudflare API.
+type AuthMode string
+
+// Supported auth modes.
+const (
+	AuthModeNone        AuthMode = ""
+	AuthModeAPIKey      AuthMode = "APIKey"
+	AuthModeAPIToken    AuthMode = "APIToken"
+	AuthModeOriginCAKey AuthMode = "OriginCAKey"
+)
+
 // Config represents the API configuration required to create
 // a new client.
 type Config struct {
-	*AuthByAPIKey   `json:",inline"`
-	*AuthByAPIToken `json:",inline"`
+	*AuthByAPIKey      `json:",inline"`
+	*AuthByAPIToken    `json:",inline"`
+	*AuthByOriginCAKey `json:",inline"`
+
+	// MetadataPropagation of the ProviderConfig the credentials were read
+	// from.
+	MetadataPropagation *v1alpha1.MetadataPropagation `json:"-"`
+
+	// Ownership of the ProviderConfig the credentials were read from.
+	Ownership *v1alpha1.Ownership `json:"-"`
+
+	// RequestPolicy of the ProviderConfig the credentials were read from.
+	RequestPolicy *v1alpha1.RequestPolicy `json:"-"`
+
+	// ProviderConfigName is the name of the ProviderConfig the credentials
+	// were read from, whose clients share a rate limit.
+	ProviderConfigName string `json:"-"`
+}
+
+// AuthMode returns the mode used to authenticate requests other than Origin
+// CA certificate requests. An API key and email take precedence over an API
+// token, and credentials that are empty are ignored.
+func (c Config) AuthMode() AuthMode {
+	switch {
+	case c.AuthByAPIKey != nil && nonEmpty(c.Key) && nonEmpty(c.Email):
+		return AuthModeAPIKey
+	case c.AuthByAPIToken != nil && nonEmpty(c.Token):
+		return AuthModeAPIToken
+	}
+	return AuthModeNone
+}
+
+// OriginCAAuthMode returns the mode used to authenticate Origin CA
+// certificate requests. An Origin CA key takes precedence, falling back to
+// the mode of other requests.
+func (c Config) OriginCAAuthMode() AuthMode {
+	if c.AuthByOriginCAKey != nil && nonEmpty(c.OriginCAKey) {
+		return AuthModeOriginCAKey
+	}
+	return c.AuthMode()
+}
+
+func nonEmpty(s *string) bool {
+	return s != nil && *s != ""
 }
 
 // NewClient creates a new Cloudflare Client with provided Credentials.
 func NewClient(c Config, hc *http.Client) (*cloudflare.API, error) {
+	return newClient(c, c.AuthMode(), hc)
+}
+
+// NewOriginCAClient creates a new Cloudflare Client with provided
+// Credentials for issuing and revoking Origin CA certificates. It
+// authenticates with the Origin CA key when one is configured.
+func NewOriginCAClient(c Config, hc *http.Client) (*cloudflare.API, error) {
+	return newClient(c, c.OriginCAAuthMode(), hc)
+}
+
+func newClient(c Config, mode AuthMode, hc *http.Client) (*cloudflare.API, error) {
 	if hc == nil {
 		hc = http.DefaultClient
 	}
-	ohc := cloudflare.HTTPClient(hc)
+	opts := append([]cloudflare.Option{cloudflare.HTTPClient(httpClientFor(c, hc))}, requestPolicyOptions(c)...)
 
-	if c.AuthByAPIKey != nil && c.Key != nil &&
-		c.Email != nil {
-		return cloudflare.New(*c.Key, *c.Email, ohc)
+	switch mode {
+	case AuthModeAPIKey:
+		return cloudflare.New(*c.Key, *c.Email, opts...)
+	case AuthModeAPIToken:
+		return cloudflare.NewWithAPIToken(*c.Token, opts...)
+	case AuthModeOriginCAKey:
+		return cloudflare.NewWithUserServiceKey(*c.OriginCAKey, opts...)
 	}
-	if c.AuthByAPIToken != nil && c.Token != nil {
-		return cloudflare.NewWithAPIToken(*c.Token, ohc)
+	if c.OriginCAAuthMode() == AuthModeOriginCAKey {
+		return nil, errors.New(errOriginCAOnly)
 	}
 	return nil, errors.New(errNoAuth)
 }
 
+// httpClientFor returns the supplied client wrapped to apply the request
+// policy of the supplied config, compress large uploads and share the
+// global limit of mutations in flight. Requests wait for the rate limit of
+// their ProviderConfig before taking a mutation slot, so a ProviderConfig
+// with a low rate limit cannot hold every slot, starving the others, while
+// it waits.
+func httpClientFor(c Config, hc *http.Client) *http.Client {
+	return applyRequestPolicy(c, limitMutations(compressUploads(hc)))
+}
+
 // GetConfig returns a valid Cloudflare API configuration
 func GetConfig(ctx context.Context, c client.Client, mg resource.Managed) (*Config, error) {
 	switch {
@@ -89,28 +176,63 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed) (*Conf
 }
 
 // UseProviderConfig produces a config that can be used to authenticate with Cloudflare.
+// Resources referencing the ProviderConfig named default may be pointed
+// elsewhere by a DefaultProviderConfig.
 func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (*Config, error) {
+	ref, err := ProviderConfigReference(ctx, c, mg)
+	if err != nil {
+		return nil, err
+	}
+
 	pc := &v1alpha1.ProviderConfig{}
-	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
 		return nil, errors.Wrap(err, errGetPC)
 	}
 
+	// Usage is tracked against the resolved ProviderConfig so that it
+	// cannot be deleted while resources default to it.
 	t := resource.NewProviderConfigUsageTracker(c, &v1alpha1.ProviderConfigUsage{})
-	if err := t.Track(ctx, mg); err != nil {
+	if err := t.Track(ctx, referencedManaged{Managed: mg, ref: ref}); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
+	return configFor(ctx, c, pc)
+}
+
+// GetConfigForProviderConfig returns the API configuration of the named
+// ProviderConfig, for callers that are not managed resources and so do not
+// track their usage of it.
+func GetConfigForProviderConfig(ctx context.Context, c client.Client, name string) (*Config, error) {
+	pc := &v1alpha1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+	return configFor(ctx, c, pc)
+}
+
+func configFor(ctx context.Context, c client.Client, pc *v1alpha1.ProviderConfig) (*Config, error) {
 	cd := pc.Spec.Credentials
 	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c, cd.CommonCredentialSelectors)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetPC)
 	}
-	return UseProviderSecret(ctx, data)
+	cfg, err := UseProviderSecret(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	cfg.MetadataPropagation = pc.Spec.MetadataPropagation
+	cfg.Ownership = pc.Spec.Ownership
+	cfg.RequestPolicy = pc.Spec.RequestPolicy
+	cfg.ProviderConfigName = pc.GetName()
+	return cfg, nil
 }
 
 // UseProviderSecret extracts a JSON blob containing configuration
-// keys.
+// keys. Data that is not a JSON object is taken to be a bare API token.
 func UseProviderSecret(ctx context.Context, data []byte) (*Config, error) {
+	if t := strings.TrimSpace(string(data)); t != "" && !strings.HasPrefix(t, "{") {
+		return &Config{AuthByAPIToken: &AuthByAPIToken{Token: &t}}, nil
+	}
 	config := &Config{}
 	if err := json.Unmarshal(data, config); err != nil {
 		return nil, err
@@ -216,6 +216,45 @@ func TestUseProviderSecret(t *testing.T) {
 				},
 			},
 		},
+		"ValidOriginCAKeySecret": {
+			reason: "An Origin CA key should be read alongside other credentials",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+			},
+			args: args{
+				data: []byte("{\"token\":\"beef\",\"originCAKey\":\"v1.0-cafe\"}"),
+			},
+			want: want{
+				o: &Config{
+					AuthByAPIToken: &AuthByAPIToken{
+						Token: ptr.To("beef"),
+					},
+					AuthByOriginCAKey: &AuthByOriginCAKey{
+						OriginCAKey: ptr.To("v1.0-cafe"),
+					},
+				},
+			},
+		},
+		"BareToken": {
+			reason: "A secret that is not a JSON object should be taken to be a bare API token",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+			},
+			args: args{
+				data: []byte("A7E0BA00E5E44574BFEC828D3F895973\n"),
+			},
+			want: want{
+				o: &Config{
+					AuthByAPIToken: &AuthByAPIToken{
+						Token: ptr.To("A7E0BA00E5E44574BFEC828D3F895973"),
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -326,6 +365,40 @@ func TestNewClient(t *testing.T) {
 				}("abcd", "foo@bar.com"),
 			},
 		},
+		"ValidAPITokenFallbackAuth": {
+			reason: "A cloudflare client should be configured with API token details if the API key details are empty",
+			args: args{
+				config: Config{
+					AuthByAPIKey: &AuthByAPIKey{
+						Key:   ptr.To(""),
+						Email: ptr.To(""),
+					},
+					AuthByAPIToken: &AuthByAPIToken{
+						Token: ptr.To("beef"),
+					},
+				},
+			},
+			want: want{
+				err: nil,
+				o: func(token string) *cloudflare.API {
+					api, _ := cloudflare.NewWithAPIToken(token)
+					return api
+				}("beef"),
+			},
+		},
+		"ErrOriginCAKeyOnlyAuth": {
+			reason: "An error should be returned if the config only contains an Origin CA key",
+			args: args{
+				config: Config{
+					AuthByOriginCAKey: &AuthByOriginCAKey{
+						OriginCAKey: ptr.To("v1.0-cafe"),
+					},
+				},
+			},
+			want: want{
+				err: errors.New(errOriginCAOnly),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -342,3 +415,81 @@ func TestNewClient(t *testing.T) {
 		})
 	}
 }
+
+func TestNewOriginCAClient(t *testing.T) {
+	type args struct {
+		config Config
+	}
+
+	type want struct {
+		o   *cloudflare.API
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ErrInvalidAuth": {
+			reason: "An error should be returned if the config does not contain any valid authentication details",
+			args: args{
+				config: Config{},
+			},
+			want: want{
+				err: errors.New(errNoAuth),
+			},
+		},
+		"ValidOriginCAKeyAuth": {
+			reason: "A cloudflare client should be configured with the Origin CA key if one is provided",
+			args: args{
+				config: Config{
+					AuthByAPIToken: &AuthByAPIToken{
+						Token: ptr.To("beef"),
+					},
+					AuthByOriginCAKey: &AuthByOriginCAKey{
+						OriginCAKey: ptr.To("v1.0-cafe"),
+					},
+				},
+			},
+			want: want{
+				err: nil,
+				o: func(key string) *cloudflare.API {
+					api, _ := cloudflare.NewWithUserServiceKey(key)
+					return api
+				}("v1.0-cafe"),
+			},
+		},
+		"ValidAPITokenFallbackAuth": {
+			reason: "A cloudflare client should be configured with API token details if no Origin CA key is provided",
+			args: args{
+				config: Config{
+					AuthByAPIToken: &AuthByAPIToken{
+						Token: ptr.To("beef"),
+					},
+				},
+			},
+			want: want{
+				err: nil,
+				o: func(token string) *cloudflare.API {
+					api, _ := cloudflare.NewWithAPIToken(token)
+					return api
+				}("beef"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewOriginCAClient(tc.args.config, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nNewOriginCAClient(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.o, got, cmpopts.IgnoreUnexported(cloudflare.API{})); diff != "" {
+				t.Errorf("\n%s\nNewOriginCAClient(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+
+		})
+	}
+}
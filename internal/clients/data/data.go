@@ -0,0 +1,260 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package data reads the account, zone and network data reflected by the
+// observe-only data resources.
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/data/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	errGetAccount   = "cannot get account"
+	errListZones    = "cannot list zones"
+	errGetIPRanges  = "cannot get IP ranges"
+	errParseIPRange = "cannot parse IP ranges"
+	errGetAegis     = "cannot get Aegis setting"
+	errParseAegis   = "cannot parse Aegis setting"
+	errListTokens   = "cannot list API tokens"
+	errParseTokens  = "cannot parse API tokens"
+)
+
+// tokensPerPage is the number of API tokens listed per request, which is
+// the most the API returns.
+const tokensPerPage = 50
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for reading
+// the data reflected by data resources. The JD Cloud ranges and the Aegis
+// setting are not modelled by cloudflare-go, so they are read through the
+// raw API.
+type Client interface {
+	clients.RawAPI
+	Account(ctx context.Context, accountID string) (cloudflare.Account, cloudflare.ResultInfo, error)
+	ListZonesContext(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error)
+}
+
+// NewClient returns a new Cloudflare API client for reading the data
+// reflected by data resources.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// AccountDetails returns the details of the supplied account.
+func AccountDetails(ctx context.Context, client Client, accountID string) (v1alpha1.AccountDetailsObservation, error) {
+	a, _, err := client.Account(ctx, accountID)
+	if err != nil {
+		return v1alpha1.AccountDetailsObservation{}, errors.Wrap(err, errGetAccount)
+	}
+
+	o := v1alpha1.AccountDetailsObservation{
+		ID:   a.ID,
+		Name: a.Name,
+		Type: a.Type,
+	}
+	if !a.CreatedOn.IsZero() {
+		o.CreatedOn = ptr.To(metav1.NewTime(a.CreatedOn))
+	}
+	if a.Settings != nil {
+		o.EnforceTwoFactor = a.Settings.EnforceTwoFactor
+	}
+	return o, nil
+}
+
+// Zones returns the zones of an account matching the supplied parameters,
+// sorted by name.
+func Zones(ctx context.Context, client Client, p v1alpha1.ZoneListParameters) (v1alpha1.ZoneListObservation, error) {
+	res, err := client.ListZonesContext(ctx, cloudflare.WithZoneFilters(ptr.Deref(p.Name, ""), p.AccountID, ptr.Deref(p.Status, "")))
+	if err != nil {
+		return v1alpha1.ZoneListObservation{}, errors.Wrap(err, errListZones)
+	}
+
+	o := v1alpha1.ZoneListObservation{Zones: make([]v1alpha1.ZoneSummary, 0, len(res.Result))}
+	for _, z := range res.Result {
+		o.Zones = append(o.Zones, v1alpha1.ZoneSummary{
+			ID:          z.ID,
+			Name:        z.Name,
+			Status:      z.Status,
+			Type:        z.Type,
+			Paused:      z.Paused,
+			Plan:        z.Plan.Name,
+			NameServers: z.NameServers,
+		})
+	}
+	sort.Slice(o.Zones, func(i, j int) bool { return o.Zones[i].Name < o.Zones[j].Name })
+	o.Count = len(o.Zones)
+	return o, nil
+}
+
+// ipRanges are the IP ranges as returned by the API.
+type ipRanges struct {
+	IPv4CIDRs    []string `json:"ipv4_cidrs"`
+	IPv6CIDRs    []string `json:"ipv6_cidrs"`
+	JDCloudCIDRs []string `json:"jdcloud_cidrs"`
+	ETag         string   `json:"etag"`
+}
+
+// IPRanges returns the IP ranges of Cloudflare's network, including those
+// of the JD Cloud network when requested.
+func IPRanges(ctx context.Context, client Client, p v1alpha1.IPRangesParameters) (v1alpha1.IPRangesObservation, error) {
+	endpoint := "/ips"
+	if ptr.Deref(p.JDCloud, false) {
+		endpoint += "?networks=jdcloud"
+	}
+
+	res, err := client.Raw(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return v1alpha1.IPRangesObservation{}, errors.Wrap(err, errGetIPRanges)
+	}
+	r := ipRanges{}
+	if err := json.Unmarshal(res.Result, &r); err != nil {
+		return v1alpha1.IPRangesObservation{}, errors.Wrap(err, errParseIPRange)
+	}
+
+	return v1alpha1.IPRangesObservation{
+		IPv4CIDRs:    r.IPv4CIDRs,
+		IPv6CIDRs:    r.IPv6CIDRs,
+		JDCloudCIDRs: r.JDCloudCIDRs,
+		ETag:         r.ETag,
+	}, nil
+}
+
+// aegis is the Aegis setting of a zone as returned by the API.
+type aegis struct {
+	Value struct {
+		Enabled bool   `json:"enabled"`
+		PoolID  string `json:"pool_id"`
+	} `json:"value"`
+}
+
+// Aegis returns the Aegis dedicated egress IP configuration of a zone.
+func Aegis(ctx context.Context, client Client, zoneID string) (v1alpha1.AegisConfigObservation, error) {
+	res, err := client.Raw(ctx, http.MethodGet, "/zones/"+zoneID+"/settings/aegis", nil, nil)
+	if err != nil {
+		return v1alpha1.AegisConfigObservation{}, errors.Wrap(err, errGetAegis)
+	}
+	a := aegis{}
+	if err := json.Unmarshal(res.Result, &a); err != nil {
+		return v1alpha1.AegisConfigObservation{}, errors.Wrap(err, errParseAegis)
+	}
+
+	return v1alpha1.AegisConfigObservation{
+		Enabled: a.Value.Enabled,
+		PoolID:  a.Value.PoolID,
+	}, nil
+}
+
+// apiToken is an API token as listed by the API. Its value is never
+// returned when listing tokens.
+type apiToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	IssuedOn   *time.Time `json:"issued_on"`
+	ModifiedOn *time.Time `json:"modified_on"`
+	ExpiresOn  *time.Time `json:"expires_on"`
+	LastUsedOn *time.Time `json:"last_used_on"`
+}
+
+// Tokens returns the API tokens selected by the supplied parameters, least
+// recently used first, reporting those not used for longer than their
+// staleAfter before now as stale.
+func Tokens(ctx context.Context, client Client, p v1alpha1.TokenAuditParameters, now time.Time) (v1alpha1.TokenAuditObservation, error) {
+	endpoint := "/accounts/" + p.AccountID + "/tokens"
+	if p.Owner == v1alpha1.TokenOwnerUser {
+		endpoint = "/user/tokens"
+	}
+
+	var tokens []apiToken
+	for page := 1; ; page++ {
+		res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s?page=%d&per_page=%d", endpoint, page, tokensPerPage), nil, nil)
+		if err != nil {
+			return v1alpha1.TokenAuditObservation{}, errors.Wrap(err, errListTokens)
+		}
+		var t []apiToken
+		if err := json.Unmarshal(res.Result, &t); err != nil {
+			return v1alpha1.TokenAuditObservation{}, errors.Wrap(err, errParseTokens)
+		}
+		tokens = append(tokens, t...)
+		if res.ResultInfo == nil || page >= res.ResultInfo.TotalPages || len(t) == 0 {
+			break
+		}
+	}
+
+	staleAfter := 90 * 24 * time.Hour
+	if p.StaleAfter != nil {
+		staleAfter = p.StaleAfter.Duration
+	}
+
+	o := v1alpha1.TokenAuditObservation{Tokens: make([]v1alpha1.TokenSummary, 0, len(tokens))}
+	for _, t := range tokens {
+		s := v1alpha1.TokenSummary{
+			ID:         t.ID,
+			Name:       t.Name,
+			Status:     t.Status,
+			IssuedOn:   metaTime(t.IssuedOn),
+			ModifiedOn: metaTime(t.ModifiedOn),
+			ExpiresOn:  metaTime(t.ExpiresOn),
+			LastUsedOn: metaTime(t.LastUsedOn),
+		}
+		// Tokens that were never used are judged by when they were issued.
+		used := t.LastUsedOn
+		if used == nil {
+			used = t.IssuedOn
+		}
+		s.Stale = used != nil && now.Sub(*used) > staleAfter
+		if s.Stale {
+			o.StaleCount++
+		}
+		o.Tokens = append(o.Tokens, s)
+	}
+	sort.SliceStable(o.Tokens, func(i, j int) bool {
+		return lastUsed(o.Tokens[i]).Before(lastUsed(o.Tokens[j]))
+	})
+	o.Count = len(o.Tokens)
+	return o, nil
+}
+
+func metaTime(t *time.Time) *metav1.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return ptr.To(metav1.NewTime(*t))
+}
+
+// lastUsed returns when a token was last used, or the zero time if it
+// never was.
+func lastUsed(t v1alpha1.TokenSummary) time.Time {
+	if t.LastUsedOn == nil {
+		return time.Time{}
+	}
+	return t.LastUsedOn.Time
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package data
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/data/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/data/fake"
+)
+
+func TestAccountDetails(t *testing.T) {
+	errBoom := errors.New("boom")
+	created := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		obs v1alpha1.AccountDetailsObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		account cloudflare.Account
+		err     error
+		want    want
+	}{
+		"Success": {
+			reason: "The details of the account should be observed",
+			account: cloudflare.Account{
+				ID:        "account-id",
+				Name:      "Example",
+				Type:      "enterprise",
+				CreatedOn: created,
+				Settings:  &cloudflare.AccountSettings{EnforceTwoFactor: true},
+			},
+			want: want{obs: v1alpha1.AccountDetailsObservation{
+				ID:               "account-id",
+				Name:             "Example",
+				Type:             "enterprise",
+				CreatedOn:        ptr.To(metav1.NewTime(created)),
+				EnforceTwoFactor: true,
+			}},
+		},
+		"Error": {
+			reason: "Errors getting the account should be returned",
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGetAccount)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &fake.ClientMock{
+				AccountFunc: func(ctx context.Context, accountID string) (cloudflare.Account, cloudflare.ResultInfo, error) {
+					return tc.account, cloudflare.ResultInfo{}, tc.err
+				},
+			}
+			got, err := AccountDetails(context.Background(), client, "account-id")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAccountDetails(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nAccountDetails(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestZones(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs v1alpha1.ZoneListObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.ZoneListParameters
+		zones  []cloudflare.Zone
+		err    error
+		want   want
+	}{
+		"Success": {
+			reason: "The zones of the account should be observed, sorted by name",
+			params: v1alpha1.ZoneListParameters{AccountID: "account-id", Status: ptr.To("active")},
+			zones: []cloudflare.Zone{
+				{ID: "b", Name: "example.org", Status: "active", Type: "full", Plan: cloudflare.ZonePlan{ZonePlanCommon: cloudflare.ZonePlanCommon{Name: "Free Website"}}},
+				{ID: "a", Name: "example.com", Status: "active", Type: "full", NameServers: []string{"ada.ns.cloudflare.com"}, Plan: cloudflare.ZonePlan{ZonePlanCommon: cloudflare.ZonePlanCommon{Name: "Pro Website"}}},
+			},
+			want: want{obs: v1alpha1.ZoneListObservation{
+				Zones: []v1alpha1.ZoneSummary{
+					{ID: "a", Name: "example.com", Status: "active", Type: "full", Plan: "Pro Website", NameServers: []string{"ada.ns.cloudflare.com"}},
+					{ID: "b", Name: "example.org", Status: "active", Type: "full", Plan: "Free Website"},
+				},
+				Count: 2,
+			}},
+		},
+		"Error": {
+			reason: "Errors listing zones should be returned",
+			params: v1alpha1.ZoneListParameters{AccountID: "account-id"},
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errListZones)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &fake.ClientMock{
+				ListZonesContextFunc: func(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error) {
+					return cloudflare.ZonesResponse{Result: tc.zones}, tc.err
+				},
+			}
+			got, err := Zones(context.Background(), client, tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nZones(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nZones(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIPRanges(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		endpoint string
+		obs      v1alpha1.IPRangesObservation
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.IPRangesParameters
+		result string
+		err    error
+		want   want
+	}{
+		"Success": {
+			reason: "Cloudflare's IP ranges should be observed",
+			result: `{"ipv4_cidrs":["173.245.48.0/20"],"ipv6_cidrs":["2400:cb00::/32"],"etag":"38f79d050aa027e3be3865e495dcc9bc"}`,
+			want: want{
+				endpoint: "/ips",
+				obs: v1alpha1.IPRangesObservation{
+					IPv4CIDRs: []string{"173.245.48.0/20"},
+					IPv6CIDRs: []string{"2400:cb00::/32"},
+					ETag:      "38f79d050aa027e3be3865e495dcc9bc",
+				},
+			},
+		},
+		"JDCloud": {
+			reason: "The JD Cloud ranges should be requested and observed when enabled",
+			params: v1alpha1.IPRangesParameters{JDCloud: ptr.To(true)},
+			result: `{"ipv4_cidrs":["173.245.48.0/20"],"jdcloud_cidrs":["106.38.179.0/24"],"etag":"e1"}`,
+			want: want{
+				endpoint: "/ips?networks=jdcloud",
+				obs: v1alpha1.IPRangesObservation{
+					IPv4CIDRs:    []string{"173.245.48.0/20"},
+					JDCloudCIDRs: []string{"106.38.179.0/24"},
+					ETag:         "e1",
+				},
+			},
+		},
+		"Error": {
+			reason: "Errors getting the IP ranges should be returned",
+			err:    errBoom,
+			want:   want{endpoint: "/ips", err: errors.Wrap(errBoom, errGetIPRanges)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var endpoint string
+			client := &fake.ClientMock{
+				RawFunc: func(ctx context.Context, method, ep string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					endpoint = ep
+					return cloudflare.RawResponse{Result: []byte(tc.result)}, tc.err
+				},
+			}
+			got, err := IPRanges(context.Background(), client, tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nIPRanges(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nIPRanges(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if endpoint != tc.want.endpoint {
+				t.Errorf("\n%s\nIPRanges(...): want endpoint %q, got %q\n", tc.reason, tc.want.endpoint, endpoint)
+			}
+		})
+	}
+}
+
+func TestAegis(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs v1alpha1.AegisConfigObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		result string
+		err    error
+		want   want
+	}{
+		"Enabled": {
+			reason: "The Aegis pool of a zone should be observed",
+			result: `{"id":"aegis","value":{"enabled":true,"pool_id":"pool-1"},"editable":true}`,
+			want: want{obs: v1alpha1.AegisConfigObservation{
+				Enabled: true,
+				PoolID:  "pool-1",
+			}},
+		},
+		"Disabled": {
+			reason: "A zone without Aegis should be observed as disabled",
+			result: `{"id":"aegis","value":{"enabled":false},"editable":false}`,
+			want:   want{obs: v1alpha1.AegisConfigObservation{}},
+		},
+		"Error": {
+			reason: "Errors getting the Aegis setting should be returned",
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGetAegis)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var endpoint string
+			client := &fake.ClientMock{
+				RawFunc: func(ctx context.Context, method, ep string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					endpoint = ep
+					return cloudflare.RawResponse{Result: []byte(tc.result)}, tc.err
+				},
+			}
+			got, err := Aegis(context.Background(), client, "zone-id")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAegis(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nAegis(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if want := "/zones/zone-id/settings/aegis"; endpoint != want {
+				t.Errorf("\n%s\nAegis(...): want endpoint %q, got %q\n", tc.reason, want, endpoint)
+			}
+		})
+	}
+}
+
+func TestTokens(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	recent := time.Date(2026, 5, 20, 0, 0, 0, 0, time.UTC)
+	old := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		obs       v1alpha1.TokenAuditObservation
+		endpoints []string
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.TokenAuditParameters
+		pages  []string
+		err    error
+		want   want
+	}{
+		"AccountTokens": {
+			reason: "The tokens of an account should be listed page by page, least recently used first, with unused ones reported as stale",
+			p:      v1alpha1.TokenAuditParameters{AccountID: "acc"},
+			pages: []string{
+				`[{"id":"t1","name":"ci","status":"active","issued_on":"2025-01-01T00:00:00Z","last_used_on":"2026-05-20T00:00:00Z"}]`,
+				`[{"id":"t2","name":"old","status":"active","issued_on":"2025-01-01T00:00:00Z","last_used_on":"2025-01-01T00:00:00Z"},{"id":"t3","name":"unused","status":"disabled","issued_on":"2025-01-01T00:00:00Z"}]`,
+			},
+			want: want{
+				obs: v1alpha1.TokenAuditObservation{
+					Tokens: []v1alpha1.TokenSummary{
+						{ID: "t3", Name: "unused", Status: "disabled", IssuedOn: ptr.To(metav1.NewTime(old)), Stale: true},
+						{ID: "t2", Name: "old", Status: "active", IssuedOn: ptr.To(metav1.NewTime(old)), LastUsedOn: ptr.To(metav1.NewTime(old)), Stale: true},
+						{ID: "t1", Name: "ci", Status: "active", IssuedOn: ptr.To(metav1.NewTime(old)), LastUsedOn: ptr.To(metav1.NewTime(recent))},
+					},
+					Count:      3,
+					StaleCount: 2,
+				},
+				endpoints: []string{"/accounts/acc/tokens?page=1&per_page=50", "/accounts/acc/tokens?page=2&per_page=50"},
+			},
+		},
+		"UserTokensStaleAfter": {
+			reason: "The tokens of the user should be listed, and judged stale by staleAfter",
+			p:      v1alpha1.TokenAuditParameters{Owner: v1alpha1.TokenOwnerUser, StaleAfter: &metav1.Duration{Duration: 24 * time.Hour}},
+			pages:  []string{`[{"id":"t1","name":"ci","last_used_on":"2026-05-20T00:00:00Z"}]`},
+			want: want{
+				obs: v1alpha1.TokenAuditObservation{
+					Tokens:     []v1alpha1.TokenSummary{{ID: "t1", Name: "ci", LastUsedOn: ptr.To(metav1.NewTime(recent)), Stale: true}},
+					Count:      1,
+					StaleCount: 1,
+				},
+				endpoints: []string{"/user/tokens?page=1&per_page=50"},
+			},
+		},
+		"Error": {
+			reason: "Errors listing tokens should be returned",
+			p:      v1alpha1.TokenAuditParameters{AccountID: "acc"},
+			err:    errBoom,
+			want: want{
+				endpoints: []string{"/accounts/acc/tokens?page=1&per_page=50"},
+				err:       errors.Wrap(errBoom, errListTokens),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var endpoints []string
+			client := &fake.ClientMock{
+				RawFunc: func(ctx context.Context, method, ep string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					endpoints = append(endpoints, ep)
+					if tc.err != nil {
+						return cloudflare.RawResponse{}, tc.err
+					}
+					page := len(endpoints)
+					return cloudflare.RawResponse{
+						Result:     []byte(tc.pages[page-1]),
+						ResultInfo: &cloudflare.ResultInfo{Page: page, TotalPages: len(tc.pages)},
+					}, nil
+				},
+			}
+			got, err := Tokens(context.Background(), client, tc.p, now)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nTokens(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nTokens(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.endpoints, endpoints); diff != "" {
+				t.Errorf("\n%s\nTokens(...): -want endpoints, +got endpoints:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
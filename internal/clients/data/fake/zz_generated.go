@@ -0,0 +1,197 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"net/http"
+	"sync"
+)
+
+// ClientMock is a mock implementation of data.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked data.Client
+//		mockedClient := &ClientMock{
+//			AccountFunc: func(ctx context.Context, accountID string) (cloudflare.Account, cloudflare.ResultInfo, error) {
+//				panic("mock out the Account method")
+//			},
+//			ListZonesContextFunc: func(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error) {
+//				panic("mock out the ListZonesContext method")
+//			},
+//			RawFunc: func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+//				panic("mock out the Raw method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires data.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// AccountFunc mocks the Account method.
+	AccountFunc func(ctx context.Context, accountID string) (cloudflare.Account, cloudflare.ResultInfo, error)
+
+	// ListZonesContextFunc mocks the ListZonesContext method.
+	ListZonesContextFunc func(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error)
+
+	// RawFunc mocks the Raw method.
+	RawFunc func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Account holds details about calls to the Account method.
+		Account []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountID is the accountID argument value.
+			AccountID string
+		}
+		// ListZonesContext holds details about calls to the ListZonesContext method.
+		ListZonesContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts []cloudflare.ReqOption
+		}
+		// Raw holds details about calls to the Raw method.
+		Raw []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Method is the method argument value.
+			Method string
+			// Endpoint is the endpoint argument value.
+			Endpoint string
+			// Data is the data argument value.
+			Data interface{}
+			// Headers is the headers argument value.
+			Headers http.Header
+		}
+	}
+	lockAccount          sync.RWMutex
+	lockListZonesContext sync.RWMutex
+	lockRaw              sync.RWMutex
+}
+
+// Account calls AccountFunc.
+func (mock *ClientMock) Account(ctx context.Context, accountID string) (cloudflare.Account, cloudflare.ResultInfo, error) {
+	if mock.AccountFunc == nil {
+		panic("ClientMock.AccountFunc: method is nil but Client.Account was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		AccountID string
+	}{
+		Ctx:       ctx,
+		AccountID: accountID,
+	}
+	mock.lockAccount.Lock()
+	mock.calls.Account = append(mock.calls.Account, callInfo)
+	mock.lockAccount.Unlock()
+	return mock.AccountFunc(ctx, accountID)
+}
+
+// AccountCalls gets all the calls that were made to Account.
+// Check the length with:
+//
+//	len(mockedClient.AccountCalls())
+func (mock *ClientMock) AccountCalls() []struct {
+	Ctx       context.Context
+	AccountID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AccountID string
+	}
+	mock.lockAccount.RLock()
+	calls = mock.calls.Account
+	mock.lockAccount.RUnlock()
+	return calls
+}
+
+// ListZonesContext calls ListZonesContextFunc.
+func (mock *ClientMock) ListZonesContext(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error) {
+	if mock.ListZonesContextFunc == nil {
+		panic("ClientMock.ListZonesContextFunc: method is nil but Client.ListZonesContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []cloudflare.ReqOption
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListZonesContext.Lock()
+	mock.calls.ListZonesContext = append(mock.calls.ListZonesContext, callInfo)
+	mock.lockListZonesContext.Unlock()
+	return mock.ListZonesContextFunc(ctx, opts...)
+}
+
+// ListZonesContextCalls gets all the calls that were made to ListZonesContext.
+// Check the length with:
+//
+//	len(mockedClient.ListZonesContextCalls())
+func (mock *ClientMock) ListZonesContextCalls() []struct {
+	Ctx  context.Context
+	Opts []cloudflare.ReqOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []cloudflare.ReqOption
+	}
+	mock.lockListZonesContext.RLock()
+	calls = mock.calls.ListZonesContext
+	mock.lockListZonesContext.RUnlock()
+	return calls
+}
+
+// Raw calls RawFunc.
+func (mock *ClientMock) Raw(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if mock.RawFunc == nil {
+		panic("ClientMock.RawFunc: method is nil but Client.Raw was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}{
+		Ctx:      ctx,
+		Method:   method,
+		Endpoint: endpoint,
+		Data:     data,
+		Headers:  headers,
+	}
+	mock.lockRaw.Lock()
+	mock.calls.Raw = append(mock.calls.Raw, callInfo)
+	mock.lockRaw.Unlock()
+	return mock.RawFunc(ctx, method, endpoint, data, headers)
+}
+
+// RawCalls gets all the calls that were made to Raw.
+// Check the length with:
+//
+//	len(mockedClient.RawCalls())
+func (mock *ClientMock) RawCalls() []struct {
+	Ctx      context.Context
+	Method   string
+	Endpoint string
+	Data     interface{}
+	Headers  http.Header
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}
+	mock.lockRaw.RLock()
+	calls = mock.calls.Raw
+	mock.lockRaw.RUnlock()
+	return calls
+}
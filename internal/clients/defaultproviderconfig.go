@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+const (
+	errListDefaultPC     = "cannot list DefaultProviderConfigs"
+	errDefaultPCSelector = "cannot parse DefaultProviderConfig selector"
+
+	// defaultProviderConfigName is the name a managed resource's
+	// providerConfigRef defaults to when omitted.
+	defaultProviderConfigName = "default"
+)
+
+// ProviderConfigReference returns the ProviderConfig reference the supplied
+// managed resource should use. A resource referencing the ProviderConfig
+// named default uses the most specific matching DefaultProviderConfig
+// instead, if there is one.
+func ProviderConfigReference(ctx context.Context, c client.Reader, mg resource.Managed) (*xpv1.Reference, error) {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil || ref.Name != defaultProviderConfigName {
+		return ref, nil
+	}
+
+	l := &v1alpha1.DefaultProviderConfigList{}
+	if err := c.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListDefaultPC)
+	}
+
+	var best *v1alpha1.DefaultProviderConfig
+	bestScore := -1
+	sort.Slice(l.Items, func(i, j int) bool { return l.Items[i].GetName() < l.Items[j].GetName() })
+	for i := range l.Items {
+		d := &l.Items[i]
+		ok, err := defaultMatches(d.Spec, mg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %s", errDefaultPCSelector, d.GetName())
+		}
+		if s := specificity(d.Spec); ok && s > bestScore {
+			best, bestScore = d, s
+		}
+	}
+	if best == nil {
+		return ref, nil
+	}
+	return &xpv1.Reference{Name: best.Spec.ProviderConfigRef.Name}, nil
+}
+
+func defaultMatches(s v1alpha1.DefaultProviderConfigSpec, mg resource.Managed) (bool, error) {
+	if len(s.Kinds) > 0 {
+		gk := mg.GetObjectKind().GroupVersionKind().GroupKind().String()
+		found := false
+		for _, k := range s.Kinds {
+			if k == gk {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	if s.Selector == nil {
+		return true, nil
+	}
+	sel, err := metav1.LabelSelectorAsSelector(s.Selector)
+	if err != nil {
+		return false, err
+	}
+	return sel.Matches(labels.Set(mg.GetLabels())), nil
+}
+
+// specificity ranks DefaultProviderConfigs that constrain both kinds and
+// labels above those constraining either, and those above catch-alls.
+func specificity(s v1alpha1.DefaultProviderConfigSpec) int {
+	n := 0
+	if len(s.Kinds) > 0 {
+		n++
+	}
+	if s.Selector != nil {
+		n++
+	}
+	return n
+}
+
+// A referencedManaged is a managed resource whose ProviderConfig reference
+// has been resolved through a DefaultProviderConfig.
+type referencedManaged struct {
+	resource.Managed
+	ref *xpv1.Reference
+}
+
+func (m referencedManaged) GetProviderConfigReference() *xpv1.Reference {
+	return m.ref
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	dnsv1alpha1 "github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+func TestProviderConfigReference(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	record := func(ref string, labels map[string]string) resource.Managed {
+		mg := &dnsv1alpha1.Record{ObjectMeta: metav1.ObjectMeta{Labels: labels}}
+		mg.SetGroupVersionKind(dnsv1alpha1.RecordGroupVersionKind)
+		mg.SetProviderConfigReference(&xpv1.Reference{Name: ref})
+		return mg
+	}
+
+	defaults := func(items ...v1alpha1.DefaultProviderConfig) test.MockListFn {
+		return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*v1alpha1.DefaultProviderConfigList).Items = items
+			return nil
+		}
+	}
+
+	dpc := func(name, target string, kinds []string, sel *metav1.LabelSelector) v1alpha1.DefaultProviderConfig {
+		return v1alpha1.DefaultProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.DefaultProviderConfigSpec{
+				ProviderConfigRef: xpv1.Reference{Name: target},
+				Kinds:             kinds,
+				Selector:          sel,
+			},
+		}
+	}
+
+	type want struct {
+		ref *xpv1.Reference
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		list   test.MockListFn
+		mg     resource.Managed
+		want   want
+	}{
+		"ExplicitReference": {
+			reason: "A reference to a ProviderConfig other than default should be used as is",
+			mg:     record("explicit", nil),
+			want:   want{ref: &xpv1.Reference{Name: "explicit"}},
+		},
+		"NoDefaults": {
+			reason: "The default ProviderConfig should be used when no DefaultProviderConfig matches",
+			list:   defaults(dpc("zones", "zones", []string{"Zone.zone.cloudflare.crossplane.io"}, nil)),
+			mg:     record("default", nil),
+			want:   want{ref: &xpv1.Reference{Name: "default"}},
+		},
+		"CatchAll": {
+			reason: "A DefaultProviderConfig without constraints should match any resource",
+			list:   defaults(dpc("all", "fleet", nil, nil)),
+			mg:     record("default", nil),
+			want:   want{ref: &xpv1.Reference{Name: "fleet"}},
+		},
+		"MostSpecific": {
+			reason: "The DefaultProviderConfig constraining both kind and labels should win",
+			list: defaults(
+				dpc("a-all", "fleet", nil, nil),
+				dpc("b-records", "records", []string{"Record.dns.cloudflare.crossplane.io"}, nil),
+				dpc("c-team", "team", []string{"Record.dns.cloudflare.crossplane.io"}, &metav1.LabelSelector{MatchLabels: map[string]string{"team": "edge"}}),
+			),
+			mg:   record("default", map[string]string{"team": "edge"}),
+			want: want{ref: &xpv1.Reference{Name: "team"}},
+		},
+		"LabelMismatch": {
+			reason: "A DefaultProviderConfig whose selector does not match should be ignored",
+			list: defaults(
+				dpc("records", "records", []string{"Record.dns.cloudflare.crossplane.io"}, nil),
+				dpc("team", "team", []string{"Record.dns.cloudflare.crossplane.io"}, &metav1.LabelSelector{MatchLabels: map[string]string{"team": "edge"}}),
+			),
+			mg:   record("default", map[string]string{"team": "core"}),
+			want: want{ref: &xpv1.Reference{Name: "records"}},
+		},
+		"TieBrokenByName": {
+			reason: "Equally specific DefaultProviderConfigs should be ordered by name",
+			list:   defaults(dpc("b", "second", nil, nil), dpc("a", "first", nil, nil)),
+			mg:     record("default", nil),
+			want:   want{ref: &xpv1.Reference{Name: "first"}},
+		},
+		"ListError": {
+			reason: "Errors listing DefaultProviderConfigs should be returned",
+			list:   test.NewMockListFn(errBoom),
+			mg:     record("default", nil),
+			want:   want{err: errors.Wrap(errBoom, errListDefaultPC)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &test.MockClient{MockList: tc.list}
+			got, err := ProviderConfigReference(context.Background(), c, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nProviderConfigReference(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.ref, got); diff != "" {
+				t.Errorf("\n%s\nProviderConfigReference(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
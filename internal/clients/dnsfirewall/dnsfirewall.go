@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsfirewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	errGetCluster    = "cannot get DNS firewall cluster"
+	errCreateCluster = "cannot create DNS firewall cluster"
+	errUpdateCluster = "cannot update DNS firewall cluster"
+	errDeleteCluster = "cannot delete DNS firewall cluster"
+	errParseCluster  = "cannot parse DNS firewall cluster"
+
+	errClusterNotFound = "DNS firewall cluster not found"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with DNS Firewall clusters. The rate limiting and negative caching
+// settings are not modelled by cloudflare-go, so clusters are read and
+// written through the raw API.
+type Client interface {
+	clients.RawAPI
+	DeleteDNSFirewallCluster(ctx context.Context, rc *cloudflare.ResourceContainer, clusterID string) error
+}
+
+// NewClient returns a new Cloudflare API client for working with DNS
+// Firewall clusters.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// Cluster is a DNS Firewall cluster as returned by the API, including the
+// settings cloudflare-go does not model.
+type Cluster struct {
+	cloudflare.DNSFirewallCluster
+	NegativeCacheTTL uint  `json:"negative_cache_ttl,omitempty"`
+	RateLimit        uint  `json:"ratelimit,omitempty"`
+	Retries          *uint `json:"retries,omitempty"`
+	ECSFallback      bool  `json:"ecs_fallback"`
+}
+
+// clusterBody is the request body used to create or update a cluster.
+type clusterBody struct {
+	Name                 string   `json:"name"`
+	UpstreamIPs          []string `json:"upstream_ips"`
+	MinimumCacheTTL      *int64   `json:"minimum_cache_ttl,omitempty"`
+	MaximumCacheTTL      *int64   `json:"maximum_cache_ttl,omitempty"`
+	NegativeCacheTTL     *int64   `json:"negative_cache_ttl,omitempty"`
+	RateLimit            *int64   `json:"ratelimit,omitempty"`
+	Retries              *int64   `json:"retries,omitempty"`
+	DeprecateAnyRequests *bool    `json:"deprecate_any_requests,omitempty"`
+	ECSFallback          *bool    `json:"ecs_fallback,omitempty"`
+}
+
+func clusterEndpoint(accountID, clusterID string) string {
+	if clusterID == "" {
+		return fmt.Sprintf("/accounts/%s/dns_firewall", accountID)
+	}
+	return fmt.Sprintf("/accounts/%s/dns_firewall/%s", accountID, clusterID)
+}
+
+func body(p v1alpha1.DNSFirewallClusterParameters) clusterBody {
+	return clusterBody{
+		Name:                 p.Name,
+		UpstreamIPs:          p.UpstreamIPs,
+		MinimumCacheTTL:      p.MinimumCacheTTL,
+		MaximumCacheTTL:      p.MaximumCacheTTL,
+		NegativeCacheTTL:     p.NegativeCacheTTL,
+		RateLimit:            p.RateLimit,
+		Retries:              p.Retries,
+		DeprecateAnyRequests: p.DeprecateAnyRequests,
+		ECSFallback:          p.ECSFallback,
+	}
+}
+
+func parse(res cloudflare.RawResponse) (Cluster, error) {
+	c := Cluster{}
+	err := json.Unmarshal(res.Result, &c)
+	return c, errors.Wrap(err, errParseCluster)
+}
+
+// Get returns the DNS Firewall cluster with the supplied ID.
+func Get(ctx context.Context, client Client, accountID, clusterID string) (Cluster, error) {
+	res, err := client.Raw(ctx, http.MethodGet, clusterEndpoint(accountID, clusterID), nil, nil)
+	if err != nil {
+		if IsClusterNotFound(err) {
+			return Cluster{}, clients.NewNotFoundError(errClusterNotFound)
+		}
+		return Cluster{}, errors.Wrap(err, errGetCluster)
+	}
+	return parse(res)
+}
+
+// Create creates a DNS Firewall cluster from the supplied parameters.
+func Create(ctx context.Context, client Client, p v1alpha1.DNSFirewallClusterParameters) (Cluster, error) {
+	res, err := client.Raw(ctx, http.MethodPost, clusterEndpoint(p.AccountID, ""), body(p), nil)
+	if err != nil {
+		return Cluster{}, errors.Wrap(err, errCreateCluster)
+	}
+	return parse(res)
+}
+
+// Update updates the DNS Firewall cluster with the supplied ID to match the
+// supplied parameters.
+func Update(ctx context.Context, client Client, clusterID string, p v1alpha1.DNSFirewallClusterParameters) (Cluster, error) {
+	res, err := client.Raw(ctx, http.MethodPatch, clusterEndpoint(p.AccountID, clusterID), body(p), nil)
+	if err != nil {
+		return Cluster{}, errors.Wrap(err, errUpdateCluster)
+	}
+	return parse(res)
+}
+
+// Delete deletes the DNS Firewall cluster with the supplied ID, ignoring
+// clusters that no longer exist.
+func Delete(ctx context.Context, client Client, accountID, clusterID string) error {
+	err := client.DeleteDNSFirewallCluster(ctx, cloudflare.AccountIdentifier(accountID), clusterID)
+	if err != nil && !IsClusterNotFound(err) {
+		return errors.Wrap(err, errDeleteCluster)
+	}
+	return nil
+}
+
+// IsClusterNotFound returns true if the supplied error indicates the DNS
+// Firewall cluster was not found.
+func IsClusterNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
+
+// GenerateObservation creates an observation of a DNS Firewall cluster.
+func GenerateObservation(in Cluster) v1alpha1.DNSFirewallClusterObservation {
+	o := v1alpha1.DNSFirewallClusterObservation{
+		ID:             in.ID,
+		DNSFirewallIPs: in.DNSFirewallIPs,
+	}
+	if t, err := time.Parse(time.RFC3339, in.ModifiedOn); err == nil {
+		o.ModifiedOn = &metav1.Time{Time: t}
+	}
+	return o
+}
+
+// UpToDate checks whether the supplied cluster matches the parameters.
+// Optional parameters that are unset are left to Cloudflare's defaults.
+func UpToDate(p v1alpha1.DNSFirewallClusterParameters, c Cluster) bool {
+	if p.Name != c.Name || !sameIPs(p.UpstreamIPs, c.UpstreamIPs) {
+		return false
+	}
+	if !uintUpToDate(p.MinimumCacheTTL, c.MinimumCacheTTL) ||
+		!uintUpToDate(p.MaximumCacheTTL, c.MaximumCacheTTL) ||
+		!uintUpToDate(p.NegativeCacheTTL, c.NegativeCacheTTL) ||
+		!uintUpToDate(p.RateLimit, c.RateLimit) {
+		return false
+	}
+	if p.Retries != nil && (c.Retries == nil || uint(*p.Retries) != *c.Retries) {
+		return false
+	}
+	if p.DeprecateAnyRequests != nil && *p.DeprecateAnyRequests != c.DeprecateAnyRequests {
+		return false
+	}
+	if p.ECSFallback != nil && *p.ECSFallback != c.ECSFallback {
+		return false
+	}
+	return true
+}
+
+func uintUpToDate(want *int64, got uint) bool {
+	return want == nil || uint(*want) == got
+}
+
+// sameIPs compares upstream addresses, which Cloudflare does not return in
+// any particular order.
+func sameIPs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
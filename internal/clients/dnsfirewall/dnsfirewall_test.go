@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsfirewall
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/dnsfirewall/fake"
+)
+
+func TestGet(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		cluster Cluster
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		raw    func(method, endpoint string) (cloudflare.RawResponse, error)
+		want   want
+	}{
+		"Success": {
+			reason: "The cluster, including settings cloudflare-go does not model, should be parsed",
+			raw: func(method, endpoint string) (cloudflare.RawResponse, error) {
+				if method != http.MethodGet || endpoint != "/accounts/acc/dns_firewall/cl" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected %s %s", method, endpoint)
+				}
+				return cloudflare.RawResponse{Result: []byte(`{"id":"cl","name":"resolver","upstream_ips":["192.0.2.1"],"dns_firewall_ips":["203.0.113.1"],"ratelimit":600,"retries":2,"ecs_fallback":true}`)}, nil
+			},
+			want: want{cluster: Cluster{
+				DNSFirewallCluster: cloudflare.DNSFirewallCluster{
+					ID:             "cl",
+					Name:           "resolver",
+					UpstreamIPs:    []string{"192.0.2.1"},
+					DNSFirewallIPs: []string{"203.0.113.1"},
+				},
+				RateLimit:   600,
+				Retries:     ptr.To[uint](2),
+				ECSFallback: true,
+			}},
+		},
+		"NotFound": {
+			reason: "A missing cluster should be reported as not found",
+			raw: func(method, endpoint string) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, &cloudflare.NotFoundError{}
+			},
+			want: want{err: clients.NewNotFoundError(errClusterNotFound)},
+		},
+		"Error": {
+			reason: "Other errors should be wrapped",
+			raw: func(method, endpoint string) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, errBoom
+			},
+			want: want{err: errors.Wrap(errBoom, errGetCluster)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &fake.ClientMock{
+				RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					return tc.raw(method, endpoint)
+				},
+			}
+			got, err := Get(context.Background(), client, "acc", "cl")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cluster, got); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	params := v1alpha1.DNSFirewallClusterParameters{
+		AccountID:       "acc",
+		Name:            "resolver",
+		UpstreamIPs:     []string{"192.0.2.1", "192.0.2.2"},
+		MinimumCacheTTL: ptr.To[int64](60),
+		RateLimit:       ptr.To[int64](600),
+		Retries:         ptr.To[int64](0),
+	}
+
+	var gotMethod, gotEndpoint string
+	var gotBody interface{}
+	client := &fake.ClientMock{
+		RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+			gotMethod, gotEndpoint, gotBody = method, endpoint, data
+			return cloudflare.RawResponse{Result: []byte(`{"id":"cl"}`)}, nil
+		},
+	}
+
+	if _, err := Create(context.Background(), client, params); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPost || gotEndpoint != "/accounts/acc/dns_firewall" {
+		t.Errorf("Create(...): want POST /accounts/acc/dns_firewall, got %s %s", gotMethod, gotEndpoint)
+	}
+	want := clusterBody{
+		Name:            "resolver",
+		UpstreamIPs:     []string{"192.0.2.1", "192.0.2.2"},
+		MinimumCacheTTL: ptr.To[int64](60),
+		RateLimit:       ptr.To[int64](600),
+		Retries:         ptr.To[int64](0),
+	}
+	if diff := cmp.Diff(want, gotBody); diff != "" {
+		t.Errorf("Create(...): -want body, +got body:\n%s\n", diff)
+	}
+}
+
+func TestUpToDate(t *testing.T) {
+	params := v1alpha1.DNSFirewallClusterParameters{
+		Name:        "resolver",
+		UpstreamIPs: []string{"192.0.2.1", "192.0.2.2"},
+		RateLimit:   ptr.To[int64](600),
+		Retries:     ptr.To[int64](0),
+	}
+	cluster := Cluster{
+		DNSFirewallCluster: cloudflare.DNSFirewallCluster{
+			Name:            "resolver",
+			UpstreamIPs:     []string{"192.0.2.2", "192.0.2.1"},
+			MinimumCacheTTL: 60,
+		},
+		RateLimit: 600,
+		Retries:   ptr.To[uint](0),
+	}
+
+	cases := map[string]struct {
+		reason string
+		params func(p *v1alpha1.DNSFirewallClusterParameters)
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "Upstream order and unset optional parameters should be ignored",
+			want:   true,
+		},
+		"UpstreamIPs": {
+			reason: "A changed upstream should be detected",
+			params: func(p *v1alpha1.DNSFirewallClusterParameters) { p.UpstreamIPs = []string{"192.0.2.1"} },
+			want:   false,
+		},
+		"RateLimit": {
+			reason: "A changed rate limit should be detected",
+			params: func(p *v1alpha1.DNSFirewallClusterParameters) { p.RateLimit = ptr.To[int64](1000) },
+			want:   false,
+		},
+		"MinimumCacheTTL": {
+			reason: "A changed minimum cache TTL should be detected",
+			params: func(p *v1alpha1.DNSFirewallClusterParameters) { p.MinimumCacheTTL = ptr.To[int64](30) },
+			want:   false,
+		},
+		"Retries": {
+			reason: "A changed retry count should be detected",
+			params: func(p *v1alpha1.DNSFirewallClusterParameters) { p.Retries = ptr.To[int64](2) },
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := *params.DeepCopy()
+			if tc.params != nil {
+				tc.params(&p)
+			}
+			got := UpToDate(p, cluster)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"net/http"
+	"sync"
+)
+
+// ClientMock is a mock implementation of dnsfirewall.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked dnsfirewall.Client
+//		mockedClient := &ClientMock{
+//			DeleteDNSFirewallClusterFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, clusterID string) error {
+//				panic("mock out the DeleteDNSFirewallCluster method")
+//			},
+//			RawFunc: func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+//				panic("mock out the Raw method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires dnsfirewall.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// DeleteDNSFirewallClusterFunc mocks the DeleteDNSFirewallCluster method.
+	DeleteDNSFirewallClusterFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, clusterID string) error
+
+	// RawFunc mocks the Raw method.
+	RawFunc func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteDNSFirewallCluster holds details about calls to the DeleteDNSFirewallCluster method.
+		DeleteDNSFirewallCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// Raw holds details about calls to the Raw method.
+		Raw []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Method is the method argument value.
+			Method string
+			// Endpoint is the endpoint argument value.
+			Endpoint string
+			// Data is the data argument value.
+			Data interface{}
+			// Headers is the headers argument value.
+			Headers http.Header
+		}
+	}
+	lockDeleteDNSFirewallCluster sync.RWMutex
+	lockRaw                      sync.RWMutex
+}
+
+// DeleteDNSFirewallCluster calls DeleteDNSFirewallClusterFunc.
+func (mock *ClientMock) DeleteDNSFirewallCluster(ctx context.Context, rc *cloudflare.ResourceContainer, clusterID string) error {
+	if mock.DeleteDNSFirewallClusterFunc == nil {
+		panic("ClientMock.DeleteDNSFirewallClusterFunc: method is nil but Client.DeleteDNSFirewallCluster was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Rc        *cloudflare.ResourceContainer
+		ClusterID string
+	}{
+		Ctx:       ctx,
+		Rc:        rc,
+		ClusterID: clusterID,
+	}
+	mock.lockDeleteDNSFirewallCluster.Lock()
+	mock.calls.DeleteDNSFirewallCluster = append(mock.calls.DeleteDNSFirewallCluster, callInfo)
+	mock.lockDeleteDNSFirewallCluster.Unlock()
+	return mock.DeleteDNSFirewallClusterFunc(ctx, rc, clusterID)
+}
+
+// DeleteDNSFirewallClusterCalls gets all the calls that were made to DeleteDNSFirewallCluster.
+// Check the length with:
+//
+//	len(mockedClient.DeleteDNSFirewallClusterCalls())
+func (mock *ClientMock) DeleteDNSFirewallClusterCalls() []struct {
+	Ctx       context.Context
+	Rc        *cloudflare.ResourceContainer
+	ClusterID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Rc        *cloudflare.ResourceContainer
+		ClusterID string
+	}
+	mock.lockDeleteDNSFirewallCluster.RLock()
+	calls = mock.calls.DeleteDNSFirewallCluster
+	mock.lockDeleteDNSFirewallCluster.RUnlock()
+	return calls
+}
+
+// Raw calls RawFunc.
+func (mock *ClientMock) Raw(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if mock.RawFunc == nil {
+		panic("ClientMock.RawFunc: method is nil but Client.Raw was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}{
+		Ctx:      ctx,
+		Method:   method,
+		Endpoint: endpoint,
+		Data:     data,
+		Headers:  headers,
+	}
+	mock.lockRaw.Lock()
+	mock.calls.Raw = append(mock.calls.Raw, callInfo)
+	mock.lockRaw.Unlock()
+	return mock.RawFunc(ctx, method, endpoint, data, headers)
+}
+
+// RawCalls gets all the calls that were made to Raw.
+// Check the length with:
+//
+//	len(mockedClient.RawCalls())
+func (mock *ClientMock) RawCalls() []struct {
+	Ctx      context.Context
+	Method   string
+	Endpoint string
+	Data     interface{}
+	Headers  http.Header
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}
+	mock.lockRaw.RLock()
+	calls = mock.calls.Raw
+	mock.lockRaw.RUnlock()
+	return calls
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package address
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rossigee/provider-cloudflare/apis/emailrouting/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	errListAddresses = "cannot list email routing destination addresses"
+	errCreateAddress = "cannot create email routing destination address"
+	errDeleteAddress = "cannot delete email routing destination address"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with Email Routing destination addresses.
+type Client interface {
+	ListEmailRoutingDestinationAddresses(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListEmailRoutingAddressParameters) ([]cloudflare.EmailRoutingDestinationAddress, *cloudflare.ResultInfo, error)
+	CreateEmailRoutingDestinationAddress(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateEmailRoutingAddressParameters) (cloudflare.EmailRoutingDestinationAddress, error)
+	DeleteEmailRoutingDestinationAddress(ctx context.Context, rc *cloudflare.ResourceContainer, addressID string) (cloudflare.EmailRoutingDestinationAddress, error)
+}
+
+// NewClient returns a new Cloudflare API client for working with Email
+// Routing destination addresses.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// Get returns the destination address of the supplied account for the
+// supplied email, or nil if there is none. Addresses are looked up by
+// email rather than tag, since their tag changes whenever the verification
+// email is sent again.
+func Get(ctx context.Context, c Client, accountID, email string) (*cloudflare.EmailRoutingDestinationAddress, error) {
+	as, _, err := c.ListEmailRoutingDestinationAddresses(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListEmailRoutingAddressParameters{})
+	if err != nil {
+		return nil, errors.Wrap(err, errListAddresses)
+	}
+	for i := range as {
+		if strings.EqualFold(as[i].Email, email) {
+			return &as[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Create a destination address, which sends it a verification email.
+func Create(ctx context.Context, c Client, accountID, email string) (cloudflare.EmailRoutingDestinationAddress, error) {
+	a, err := c.CreateEmailRoutingDestinationAddress(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateEmailRoutingAddressParameters{Email: email})
+	return a, errors.Wrap(err, errCreateAddress)
+}
+
+// Delete the destination address with the supplied tag.
+func Delete(ctx context.Context, c Client, accountID, tag string) error {
+	_, err := c.DeleteEmailRoutingDestinationAddress(ctx, cloudflare.AccountIdentifier(accountID), tag)
+	return errors.Wrap(err, errDeleteAddress)
+}
+
+// ResendVerification sends the verification email of an unverified
+// destination address again. The API has no endpoint for this, so the
+// address is deleted and created again.
+func ResendVerification(ctx context.Context, c Client, accountID string, a cloudflare.EmailRoutingDestinationAddress) (cloudflare.EmailRoutingDestinationAddress, error) {
+	if err := Delete(ctx, c, accountID, a.Tag); err != nil {
+		return cloudflare.EmailRoutingDestinationAddress{}, err
+	}
+	return Create(ctx, c, accountID, a.Email)
+}
+
+// IsVerified returns true if the supplied destination address has been
+// verified.
+func IsVerified(a cloudflare.EmailRoutingDestinationAddress) bool {
+	return a.Verified != nil && !a.Verified.IsZero()
+}
+
+// GenerateObservation creates an observation of a destination address.
+func GenerateObservation(a cloudflare.EmailRoutingDestinationAddress) v1alpha1.DestinationAddressObservation {
+	return v1alpha1.DestinationAddressObservation{
+		Tag:      a.Tag,
+		Email:    a.Email,
+		Verified: toTime(a.Verified),
+		Created:  toTime(a.Created),
+		Modified: toTime(a.Modified),
+	}
+}
+
+func toTime(t *time.Time) *metav1.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	mt := metav1.NewTime(*t)
+	return &mt
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package address
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/emailrouting/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/emailrouting/address/fake"
+)
+
+func TestGet(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		a   *cloudflare.EmailRoutingDestinationAddress
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		list   []cloudflare.EmailRoutingDestinationAddress
+		err    error
+		want   want
+	}{
+		"Found": {
+			reason: "The address should be matched by email, ignoring case",
+			list: []cloudflare.EmailRoutingDestinationAddress{
+				{Tag: "other", Email: "other@example.com"},
+				{Tag: "tag", Email: "Ops@Example.com"},
+			},
+			want: want{a: &cloudflare.EmailRoutingDestinationAddress{Tag: "tag", Email: "Ops@Example.com"}},
+		},
+		"NotFound": {
+			reason: "No address should be returned when none matches the email",
+			list:   []cloudflare.EmailRoutingDestinationAddress{{Tag: "other", Email: "other@example.com"}},
+			want:   want{},
+		},
+		"ListError": {
+			reason: "Errors listing addresses should be returned",
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errListAddresses)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &fake.ClientMock{
+				ListEmailRoutingDestinationAddressesFunc: func(_ context.Context, rc *cloudflare.ResourceContainer, _ cloudflare.ListEmailRoutingAddressParameters) ([]cloudflare.EmailRoutingDestinationAddress, *cloudflare.ResultInfo, error) {
+					if rc.Identifier != "account" {
+						return nil, nil, errors.New("unexpected account")
+					}
+					return tc.list, &cloudflare.ResultInfo{}, tc.err
+				},
+			}
+			got, err := Get(context.Background(), c, "account", "ops@example.com")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.a, got); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		calls []string
+		a     cloudflare.EmailRoutingDestinationAddress
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		deleteErr error
+		want      want
+	}{
+		"Resent": {
+			reason: "The address should be deleted and created again",
+			want: want{
+				calls: []string{"delete old", "create ops@example.com"},
+				a:     cloudflare.EmailRoutingDestinationAddress{Tag: "new", Email: "ops@example.com"},
+			},
+		},
+		"DeleteError": {
+			reason:    "The address should not be created again when it cannot be deleted",
+			deleteErr: errBoom,
+			want: want{
+				calls: []string{"delete old"},
+				err:   errors.Wrap(errBoom, errDeleteAddress),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			c := &fake.ClientMock{
+				DeleteEmailRoutingDestinationAddressFunc: func(_ context.Context, _ *cloudflare.ResourceContainer, addressID string) (cloudflare.EmailRoutingDestinationAddress, error) {
+					calls = append(calls, "delete "+addressID)
+					return cloudflare.EmailRoutingDestinationAddress{}, tc.deleteErr
+				},
+				CreateEmailRoutingDestinationAddressFunc: func(_ context.Context, _ *cloudflare.ResourceContainer, params cloudflare.CreateEmailRoutingAddressParameters) (cloudflare.EmailRoutingDestinationAddress, error) {
+					calls = append(calls, "create "+params.Email)
+					return cloudflare.EmailRoutingDestinationAddress{Tag: "new", Email: params.Email}, nil
+				},
+			}
+			got, err := ResendVerification(context.Background(), c, "account", cloudflare.EmailRoutingDestinationAddress{Tag: "old", Email: "ops@example.com"})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResendVerification(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.a, got); diff != "" {
+				t.Errorf("\n%s\nResendVerification(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nResendVerification(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	zero := time.Time{}
+
+	cases := map[string]struct {
+		reason string
+		a      cloudflare.EmailRoutingDestinationAddress
+		want   v1alpha1.DestinationAddressObservation
+	}{
+		"Pending": {
+			reason: "An address pending verification should have no verified time",
+			a:      cloudflare.EmailRoutingDestinationAddress{Tag: "tag", Email: "ops@example.com", Created: &created, Verified: &zero},
+			want: v1alpha1.DestinationAddressObservation{
+				Tag:     "tag",
+				Email:   "ops@example.com",
+				Created: &metav1.Time{Time: created},
+			},
+		},
+		"Verified": {
+			reason: "A verified address should have its verified time",
+			a:      cloudflare.EmailRoutingDestinationAddress{Tag: "tag", Email: "ops@example.com", Verified: &created},
+			want: v1alpha1.DestinationAddressObservation{
+				Tag:      "tag",
+				Email:    "ops@example.com",
+				Verified: &metav1.Time{Time: created},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateObservation(tc.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGenerateObservation(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.Verified != nil, IsVerified(tc.a)); diff != "" {
+				t.Errorf("\n%s\nIsVerified(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"sync"
+)
+
+// ClientMock is a mock implementation of address.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked address.Client
+//		mockedClient := &ClientMock{
+//			CreateEmailRoutingDestinationAddressFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateEmailRoutingAddressParameters) (cloudflare.EmailRoutingDestinationAddress, error) {
+//				panic("mock out the CreateEmailRoutingDestinationAddress method")
+//			},
+//			DeleteEmailRoutingDestinationAddressFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, addressID string) (cloudflare.EmailRoutingDestinationAddress, error) {
+//				panic("mock out the DeleteEmailRoutingDestinationAddress method")
+//			},
+//			ListEmailRoutingDestinationAddressesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListEmailRoutingAddressParameters) ([]cloudflare.EmailRoutingDestinationAddress, *cloudflare.ResultInfo, error) {
+//				panic("mock out the ListEmailRoutingDestinationAddresses method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires address.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// CreateEmailRoutingDestinationAddressFunc mocks the CreateEmailRoutingDestinationAddress method.
+	CreateEmailRoutingDestinationAddressFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateEmailRoutingAddressParameters) (cloudflare.EmailRoutingDestinationAddress, error)
+
+	// DeleteEmailRoutingDestinationAddressFunc mocks the DeleteEmailRoutingDestinationAddress method.
+	DeleteEmailRoutingDestinationAddressFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, addressID string) (cloudflare.EmailRoutingDestinationAddress, error)
+
+	// ListEmailRoutingDestinationAddressesFunc mocks the ListEmailRoutingDestinationAddresses method.
+	ListEmailRoutingDestinationAddressesFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListEmailRoutingAddressParameters) ([]cloudflare.EmailRoutingDestinationAddress, *cloudflare.ResultInfo, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateEmailRoutingDestinationAddress holds details about calls to the CreateEmailRoutingDestinationAddress method.
+		CreateEmailRoutingDestinationAddress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateEmailRoutingAddressParameters
+		}
+		// DeleteEmailRoutingDestinationAddress holds details about calls to the DeleteEmailRoutingDestinationAddress method.
+		DeleteEmailRoutingDestinationAddress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// AddressID is the addressID argument value.
+			AddressID string
+		}
+		// ListEmailRoutingDestinationAddresses holds details about calls to the ListEmailRoutingDestinationAddresses method.
+		ListEmailRoutingDestinationAddresses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListEmailRoutingAddressParameters
+		}
+	}
+	lockCreateEmailRoutingDestinationAddress sync.RWMutex
+	lockDeleteEmailRoutingDestinationAddress sync.RWMutex
+	lockListEmailRoutingDestinationAddresses sync.RWMutex
+}
+
+// CreateEmailRoutingDestinationAddress calls CreateEmailRoutingDestinationAddressFunc.
+func (mock *ClientMock) CreateEmailRoutingDestinationAddress(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateEmailRoutingAddressParameters) (cloudflare.EmailRoutingDestinationAddress, error) {
+	if mock.CreateEmailRoutingDestinationAddressFunc == nil {
+		panic("ClientMock.CreateEmailRoutingDestinationAddressFunc: method is nil but Client.CreateEmailRoutingDestinationAddress was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateEmailRoutingAddressParameters
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateEmailRoutingDestinationAddress.Lock()
+	mock.calls.CreateEmailRoutingDestinationAddress = append(mock.calls.CreateEmailRoutingDestinationAddress, callInfo)
+	mock.lockCreateEmailRoutingDestinationAddress.Unlock()
+	return mock.CreateEmailRoutingDestinationAddressFunc(ctx, rc, params)
+}
+
+// CreateEmailRoutingDestinationAddressCalls gets all the calls that were made to CreateEmailRoutingDestinationAddress.
+// Check the length with:
+//
+//	len(mockedClient.CreateEmailRoutingDestinationAddressCalls())
+func (mock *ClientMock) CreateEmailRoutingDestinationAddressCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateEmailRoutingAddressParameters
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateEmailRoutingAddressParameters
+	}
+	mock.lockCreateEmailRoutingDestinationAddress.RLock()
+	calls = mock.calls.CreateEmailRoutingDestinationAddress
+	mock.lockCreateEmailRoutingDestinationAddress.RUnlock()
+	return calls
+}
+
+// DeleteEmailRoutingDestinationAddress calls DeleteEmailRoutingDestinationAddressFunc.
+func (mock *ClientMock) DeleteEmailRoutingDestinationAddress(ctx context.Context, rc *cloudflare.ResourceContainer, addressID string) (cloudflare.EmailRoutingDestinationAddress, error) {
+	if mock.DeleteEmailRoutingDestinationAddressFunc == nil {
+		panic("ClientMock.DeleteEmailRoutingDestinationAddressFunc: method is nil but Client.DeleteEmailRoutingDestinationAddress was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Rc        *cloudflare.ResourceContainer
+		AddressID string
+	}{
+		Ctx:       ctx,
+		Rc:        rc,
+		AddressID: addressID,
+	}
+	mock.lockDeleteEmailRoutingDestinationAddress.Lock()
+	mock.calls.DeleteEmailRoutingDestinationAddress = append(mock.calls.DeleteEmailRoutingDestinationAddress, callInfo)
+	mock.lockDeleteEmailRoutingDestinationAddress.Unlock()
+	return mock.DeleteEmailRoutingDestinationAddressFunc(ctx, rc, addressID)
+}
+
+// DeleteEmailRoutingDestinationAddressCalls gets all the calls that were made to DeleteEmailRoutingDestinationAddress.
+// Check the length with:
+//
+//	len(mockedClient.DeleteEmailRoutingDestinationAddressCalls())
+func (mock *ClientMock) DeleteEmailRoutingDestinationAddressCalls() []struct {
+	Ctx       context.Context
+	Rc        *cloudflare.ResourceContainer
+	AddressID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Rc        *cloudflare.ResourceContainer
+		AddressID string
+	}
+	mock.lockDeleteEmailRoutingDestinationAddress.RLock()
+	calls = mock.calls.DeleteEmailRoutingDestinationAddress
+	mock.lockDeleteEmailRoutingDestinationAddress.RUnlock()
+	return calls
+}
+
+// ListEmailRoutingDestinationAddresses calls ListEmailRoutingDestinationAddressesFunc.
+func (mock *ClientMock) ListEmailRoutingDestinationAddresses(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListEmailRoutingAddressParameters) ([]cloudflare.EmailRoutingDestinationAddress, *cloudflare.ResultInfo, error) {
+	if mock.ListEmailRoutingDestinationAddressesFunc == nil {
+		panic("ClientMock.ListEmailRoutingDestinationAddressesFunc: method is nil but Client.ListEmailRoutingDestinationAddresses was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListEmailRoutingAddressParameters
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListEmailRoutingDestinationAddresses.Lock()
+	mock.calls.ListEmailRoutingDestinationAddresses = append(mock.calls.ListEmailRoutingDestinationAddresses, callInfo)
+	mock.lockListEmailRoutingDestinationAddresses.Unlock()
+	return mock.ListEmailRoutingDestinationAddressesFunc(ctx, rc, params)
+}
+
+// ListEmailRoutingDestinationAddressesCalls gets all the calls that were made to ListEmailRoutingDestinationAddresses.
+// Check the length with:
+//
+//	len(mockedClient.ListEmailRoutingDestinationAddressesCalls())
+func (mock *ClientMock) ListEmailRoutingDestinationAddressesCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListEmailRoutingAddressParameters
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListEmailRoutingAddressParameters
+	}
+	mock.lockListEmailRoutingDestinationAddresses.RLock()
+	calls = mock.calls.ListEmailRoutingDestinationAddresses
+	mock.lockListEmailRoutingDestinationAddresses.RUnlock()
+	return calls
+}
@@ -40,6 +40,9 @@ const (
 	errGetRule    = "cannot get email routing rule"
 	errDeleteRule = "cannot delete email routing rule"
 	errListRules  = "cannot list email routing rules"
+
+	matcherTypeAll = "all"
+	actionTypeDrop = "drop"
 )
 
 // RuleClient provides operations for Email Routing Rules.
@@ -95,6 +98,58 @@ func convertToObservation(rule cloudflare.EmailRoutingRule) v1alpha1.RuleObserva
 	return obs
 }
 
+// normalizeMatcher clears the field and value of catch-all matchers, which
+// the API neither requires nor preserves.
+func normalizeMatcher(m v1alpha1.RuleMatcher) v1alpha1.RuleMatcher {
+	if m.Type == matcherTypeAll {
+		return v1alpha1.RuleMatcher{Type: m.Type}
+	}
+	return m
+}
+
+// normalizeAction clears the value of drop actions and treats an empty
+// value as unset, so that actions round-trip regardless of how the API
+// encodes them.
+func normalizeAction(a v1alpha1.RuleAction) v1alpha1.RuleAction {
+	if a.Type == actionTypeDrop || len(a.Value) == 0 {
+		return v1alpha1.RuleAction{Type: a.Type}
+	}
+	return a
+}
+
+// convertMatchers converts Crossplane matchers to cloudflare-go matchers.
+func convertMatchers(in []v1alpha1.RuleMatcher) []cloudflare.EmailRoutingRuleMatcher {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]cloudflare.EmailRoutingRuleMatcher, len(in))
+	for i, matcher := range in {
+		matcher = normalizeMatcher(matcher)
+		out[i] = cloudflare.EmailRoutingRuleMatcher{
+			Type:  matcher.Type,
+			Field: matcher.Field,
+			Value: matcher.Value,
+		}
+	}
+	return out
+}
+
+// convertActions converts Crossplane actions to cloudflare-go actions.
+func convertActions(in []v1alpha1.RuleAction) []cloudflare.EmailRoutingRuleAction {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]cloudflare.EmailRoutingRuleAction, len(in))
+	for i, action := range in {
+		action = normalizeAction(action)
+		out[i] = cloudflare.EmailRoutingRuleAction{
+			Type:  action.Type,
+			Value: action.Value,
+		}
+	}
+	return out
+}
+
 // convertToCloudflareParams converts Crossplane parameters to cloudflare-go parameters.
 func convertToCloudflareParams(params v1alpha1.RuleParameters) cloudflare.CreateEmailRoutingRuleParameters {
 	cfParams := cloudflare.CreateEmailRoutingRuleParameters{
@@ -103,28 +158,8 @@ func convertToCloudflareParams(params v1alpha1.RuleParameters) cloudflare.Create
 		Enabled:  params.Enabled,
 	}
 
-	// Convert matchers
-	if len(params.Matchers) > 0 {
-		cfParams.Matchers = make([]cloudflare.EmailRoutingRuleMatcher, len(params.Matchers))
-		for i, matcher := range params.Matchers {
-			cfParams.Matchers[i] = cloudflare.EmailRoutingRuleMatcher{
-				Type:  matcher.Type,
-				Field: matcher.Field,
-				Value: matcher.Value,
-			}
-		}
-	}
-
-	// Convert actions
-	if len(params.Actions) > 0 {
-		cfParams.Actions = make([]cloudflare.EmailRoutingRuleAction, len(params.Actions))
-		for i, action := range params.Actions {
-			cfParams.Actions[i] = cloudflare.EmailRoutingRuleAction{
-				Type:  action.Type,
-				Value: action.Value,
-			}
-		}
-	}
+	cfParams.Matchers = convertMatchers(params.Matchers)
+	cfParams.Actions = convertActions(params.Actions)
 
 	return cfParams
 }
@@ -137,28 +172,8 @@ func convertToUpdateParams(ruleTag string, params v1alpha1.RuleParameters) cloud
 		Enabled:  params.Enabled,
 	}
 
-	// Convert matchers
-	if len(params.Matchers) > 0 {
-		cfParams.Matchers = make([]cloudflare.EmailRoutingRuleMatcher, len(params.Matchers))
-		for i, matcher := range params.Matchers {
-			cfParams.Matchers[i] = cloudflare.EmailRoutingRuleMatcher{
-				Type:  matcher.Type,
-				Field: matcher.Field,
-				Value: matcher.Value,
-			}
-		}
-	}
-
-	// Convert actions
-	if len(params.Actions) > 0 {
-		cfParams.Actions = make([]cloudflare.EmailRoutingRuleAction, len(params.Actions))
-		for i, action := range params.Actions {
-			cfParams.Actions[i] = cloudflare.EmailRoutingRuleAction{
-				Type:  action.Type,
-				Value: action.Value,
-			}
-		}
-	}
+	cfParams.Matchers = convertMatchers(params.Matchers)
+	cfParams.Actions = convertActions(params.Actions)
 
 	return cfParams
 }
@@ -252,10 +267,7 @@ func (c *RuleClient) IsUpToDate(ctx context.Context, params v1alpha1.RuleParamet
 		return false, nil
 	}
 	for i, matcher := range params.Matchers {
-		if i >= len(obs.Matchers) ||
-			matcher.Type != obs.Matchers[i].Type ||
-			matcher.Field != obs.Matchers[i].Field ||
-			matcher.Value != obs.Matchers[i].Value {
+		if i >= len(obs.Matchers) || normalizeMatcher(matcher) != normalizeMatcher(obs.Matchers[i]) {
 			return false, nil
 		}
 	}
@@ -265,13 +277,14 @@ func (c *RuleClient) IsUpToDate(ctx context.Context, params v1alpha1.RuleParamet
 		return false, nil
 	}
 	for i, action := range params.Actions {
-		if i >= len(obs.Actions) ||
-			action.Type != obs.Actions[i].Type ||
-			len(action.Value) != len(obs.Actions[i].Value) {
+		action = normalizeAction(action)
+		observed := normalizeAction(obs.Actions[i])
+		if action.Type != observed.Type ||
+			len(action.Value) != len(observed.Value) {
 			return false, nil
 		}
 		for j, value := range action.Value {
-			if j >= len(obs.Actions[i].Value) || value != obs.Actions[i].Value[j] {
+			if value != observed.Value[j] {
 				return false, nil
 			}
 		}
@@ -492,8 +492,7 @@ func TestUpdate(t *testing.T) {
 					},
 					Actions: []v1alpha1.RuleAction{
 						{
-							Type:  "drop",
-							Value: []string{},
+							Type: "drop",
 						},
 					},
 				},
@@ -1015,6 +1014,32 @@ func TestIsUpToDate(t *testing.T) {
 				err:      nil,
 			},
 		},
+		"IsUpToDateTrueCatchAll": {
+			reason: "IsUpToDate should return true for a catch-all drop rule the API returns without field, value or action value",
+			fields: fields{
+				client: &MockEmailRoutingRuleAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.RuleParameters{
+					ZoneID:   zoneID,
+					Name:     "Catch All",
+					Priority: 100,
+					Matchers: []v1alpha1.RuleMatcher{{Type: "all"}},
+					Actions:  []v1alpha1.RuleAction{{Type: "drop", Value: []string{}}},
+				},
+				obs: v1alpha1.RuleObservation{
+					Name:     "Catch All",
+					Priority: ptr.To(100),
+					Matchers: []v1alpha1.RuleMatcher{{Type: "all"}},
+					Actions:  []v1alpha1.RuleAction{{Type: "drop"}},
+				},
+			},
+			want: want{
+				upToDate: true,
+				err:      nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -1109,4 +1134,47 @@ func TestIsRuleNotFound(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestConvertToCloudflareParams(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.RuleParameters
+		want   cloudflare.CreateEmailRoutingRuleParameters
+	}{
+		"Literal": {
+			reason: "Literal matchers and forward actions should be passed through unchanged",
+			params: v1alpha1.RuleParameters{
+				Name:     "Forward",
+				Matchers: []v1alpha1.RuleMatcher{{Type: "literal", Field: "to", Value: "info@example.com"}},
+				Actions:  []v1alpha1.RuleAction{{Type: "forward", Value: []string{"team@example.com"}}},
+			},
+			want: cloudflare.CreateEmailRoutingRuleParameters{
+				Name:     "Forward",
+				Matchers: []cloudflare.EmailRoutingRuleMatcher{{Type: "literal", Field: "to", Value: "info@example.com"}},
+				Actions:  []cloudflare.EmailRoutingRuleAction{{Type: "forward", Value: []string{"team@example.com"}}},
+			},
+		},
+		"CatchAll": {
+			reason: "All matchers should carry only their type and drop actions no value",
+			params: v1alpha1.RuleParameters{
+				Name:     "Catch All",
+				Matchers: []v1alpha1.RuleMatcher{{Type: "all", Field: "to", Value: "ignored"}},
+				Actions:  []v1alpha1.RuleAction{{Type: "drop", Value: []string{}}},
+			},
+			want: cloudflare.CreateEmailRoutingRuleParameters{
+				Name:     "Catch All",
+				Matchers: []cloudflare.EmailRoutingRuleMatcher{{Type: "all"}},
+				Actions:  []cloudflare.EmailRoutingRuleAction{{Type: "drop"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := convertToCloudflareParams(tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nconvertToCloudflareParams(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,508 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"sync"
+)
+
+// ClientMock is a mock implementation of settings.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked settings.Client
+//		mockedClient := &ClientMock{
+//			CreateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+//				panic("mock out the CreateDNSRecord method")
+//			},
+//			DeleteDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+//				panic("mock out the DeleteDNSRecord method")
+//			},
+//			DisableEmailRoutingFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error) {
+//				panic("mock out the DisableEmailRouting method")
+//			},
+//			EnableEmailRoutingFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error) {
+//				panic("mock out the EnableEmailRouting method")
+//			},
+//			GetDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+//				panic("mock out the GetDNSRecord method")
+//			},
+//			GetEmailRoutingDNSSettingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer) ([]cloudflare.DNSRecord, error) {
+//				panic("mock out the GetEmailRoutingDNSSettings method")
+//			},
+//			GetEmailRoutingSettingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error) {
+//				panic("mock out the GetEmailRoutingSettings method")
+//			},
+//			ListDNSRecordsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+//				panic("mock out the ListDNSRecords method")
+//			},
+//			UpdateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+//				panic("mock out the UpdateDNSRecord method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires settings.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// CreateDNSRecordFunc mocks the CreateDNSRecord method.
+	CreateDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
+
+	// DeleteDNSRecordFunc mocks the DeleteDNSRecord method.
+	DeleteDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+
+	// DisableEmailRoutingFunc mocks the DisableEmailRouting method.
+	DisableEmailRoutingFunc func(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error)
+
+	// EnableEmailRoutingFunc mocks the EnableEmailRouting method.
+	EnableEmailRoutingFunc func(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error)
+
+	// GetDNSRecordFunc mocks the GetDNSRecord method.
+	GetDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
+
+	// GetEmailRoutingDNSSettingsFunc mocks the GetEmailRoutingDNSSettings method.
+	GetEmailRoutingDNSSettingsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer) ([]cloudflare.DNSRecord, error)
+
+	// GetEmailRoutingSettingsFunc mocks the GetEmailRoutingSettings method.
+	GetEmailRoutingSettingsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error)
+
+	// ListDNSRecordsFunc mocks the ListDNSRecords method.
+	ListDNSRecordsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
+
+	// UpdateDNSRecordFunc mocks the UpdateDNSRecord method.
+	UpdateDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateDNSRecord holds details about calls to the CreateDNSRecord method.
+		CreateDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateDNSRecordParams
+		}
+		// DeleteDNSRecord holds details about calls to the DeleteDNSRecord method.
+		DeleteDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// RecordID is the recordID argument value.
+			RecordID string
+		}
+		// DisableEmailRouting holds details about calls to the DisableEmailRouting method.
+		DisableEmailRouting []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+		}
+		// EnableEmailRouting holds details about calls to the EnableEmailRouting method.
+		EnableEmailRouting []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+		}
+		// GetDNSRecord holds details about calls to the GetDNSRecord method.
+		GetDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// RecordID is the recordID argument value.
+			RecordID string
+		}
+		// GetEmailRoutingDNSSettings holds details about calls to the GetEmailRoutingDNSSettings method.
+		GetEmailRoutingDNSSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+		}
+		// GetEmailRoutingSettings holds details about calls to the GetEmailRoutingSettings method.
+		GetEmailRoutingSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+		}
+		// ListDNSRecords holds details about calls to the ListDNSRecords method.
+		ListDNSRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListDNSRecordsParams
+		}
+		// UpdateDNSRecord holds details about calls to the UpdateDNSRecord method.
+		UpdateDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.UpdateDNSRecordParams
+		}
+	}
+	lockCreateDNSRecord            sync.RWMutex
+	lockDeleteDNSRecord            sync.RWMutex
+	lockDisableEmailRouting        sync.RWMutex
+	lockEnableEmailRouting         sync.RWMutex
+	lockGetDNSRecord               sync.RWMutex
+	lockGetEmailRoutingDNSSettings sync.RWMutex
+	lockGetEmailRoutingSettings    sync.RWMutex
+	lockListDNSRecords             sync.RWMutex
+	lockUpdateDNSRecord            sync.RWMutex
+}
+
+// CreateDNSRecord calls CreateDNSRecordFunc.
+func (mock *ClientMock) CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	if mock.CreateDNSRecordFunc == nil {
+		panic("ClientMock.CreateDNSRecordFunc: method is nil but Client.CreateDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateDNSRecordParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateDNSRecord.Lock()
+	mock.calls.CreateDNSRecord = append(mock.calls.CreateDNSRecord, callInfo)
+	mock.lockCreateDNSRecord.Unlock()
+	return mock.CreateDNSRecordFunc(ctx, rc, params)
+}
+
+// CreateDNSRecordCalls gets all the calls that were made to CreateDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.CreateDNSRecordCalls())
+func (mock *ClientMock) CreateDNSRecordCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateDNSRecordParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateDNSRecordParams
+	}
+	mock.lockCreateDNSRecord.RLock()
+	calls = mock.calls.CreateDNSRecord
+	mock.lockCreateDNSRecord.RUnlock()
+	return calls
+}
+
+// DeleteDNSRecord calls DeleteDNSRecordFunc.
+func (mock *ClientMock) DeleteDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+	if mock.DeleteDNSRecordFunc == nil {
+		panic("ClientMock.DeleteDNSRecordFunc: method is nil but Client.DeleteDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}{
+		Ctx:      ctx,
+		Rc:       rc,
+		RecordID: recordID,
+	}
+	mock.lockDeleteDNSRecord.Lock()
+	mock.calls.DeleteDNSRecord = append(mock.calls.DeleteDNSRecord, callInfo)
+	mock.lockDeleteDNSRecord.Unlock()
+	return mock.DeleteDNSRecordFunc(ctx, rc, recordID)
+}
+
+// DeleteDNSRecordCalls gets all the calls that were made to DeleteDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.DeleteDNSRecordCalls())
+func (mock *ClientMock) DeleteDNSRecordCalls() []struct {
+	Ctx      context.Context
+	Rc       *cloudflare.ResourceContainer
+	RecordID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}
+	mock.lockDeleteDNSRecord.RLock()
+	calls = mock.calls.DeleteDNSRecord
+	mock.lockDeleteDNSRecord.RUnlock()
+	return calls
+}
+
+// DisableEmailRouting calls DisableEmailRoutingFunc.
+func (mock *ClientMock) DisableEmailRouting(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error) {
+	if mock.DisableEmailRoutingFunc == nil {
+		panic("ClientMock.DisableEmailRoutingFunc: method is nil but Client.DisableEmailRouting was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+	}{
+		Ctx: ctx,
+		Rc:  rc,
+	}
+	mock.lockDisableEmailRouting.Lock()
+	mock.calls.DisableEmailRouting = append(mock.calls.DisableEmailRouting, callInfo)
+	mock.lockDisableEmailRouting.Unlock()
+	return mock.DisableEmailRoutingFunc(ctx, rc)
+}
+
+// DisableEmailRoutingCalls gets all the calls that were made to DisableEmailRouting.
+// Check the length with:
+//
+//	len(mockedClient.DisableEmailRoutingCalls())
+func (mock *ClientMock) DisableEmailRoutingCalls() []struct {
+	Ctx context.Context
+	Rc  *cloudflare.ResourceContainer
+} {
+	var calls []struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+	}
+	mock.lockDisableEmailRouting.RLock()
+	calls = mock.calls.DisableEmailRouting
+	mock.lockDisableEmailRouting.RUnlock()
+	return calls
+}
+
+// EnableEmailRouting calls EnableEmailRoutingFunc.
+func (mock *ClientMock) EnableEmailRouting(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error) {
+	if mock.EnableEmailRoutingFunc == nil {
+		panic("ClientMock.EnableEmailRoutingFunc: method is nil but Client.EnableEmailRouting was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+	}{
+		Ctx: ctx,
+		Rc:  rc,
+	}
+	mock.lockEnableEmailRouting.Lock()
+	mock.calls.EnableEmailRouting = append(mock.calls.EnableEmailRouting, callInfo)
+	mock.lockEnableEmailRouting.Unlock()
+	return mock.EnableEmailRoutingFunc(ctx, rc)
+}
+
+// EnableEmailRoutingCalls gets all the calls that were made to EnableEmailRouting.
+// Check the length with:
+//
+//	len(mockedClient.EnableEmailRoutingCalls())
+func (mock *ClientMock) EnableEmailRoutingCalls() []struct {
+	Ctx context.Context
+	Rc  *cloudflare.ResourceContainer
+} {
+	var calls []struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+	}
+	mock.lockEnableEmailRouting.RLock()
+	calls = mock.calls.EnableEmailRouting
+	mock.lockEnableEmailRouting.RUnlock()
+	return calls
+}
+
+// GetDNSRecord calls GetDNSRecordFunc.
+func (mock *ClientMock) GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+	if mock.GetDNSRecordFunc == nil {
+		panic("ClientMock.GetDNSRecordFunc: method is nil but Client.GetDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}{
+		Ctx:      ctx,
+		Rc:       rc,
+		RecordID: recordID,
+	}
+	mock.lockGetDNSRecord.Lock()
+	mock.calls.GetDNSRecord = append(mock.calls.GetDNSRecord, callInfo)
+	mock.lockGetDNSRecord.Unlock()
+	return mock.GetDNSRecordFunc(ctx, rc, recordID)
+}
+
+// GetDNSRecordCalls gets all the calls that were made to GetDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.GetDNSRecordCalls())
+func (mock *ClientMock) GetDNSRecordCalls() []struct {
+	Ctx      context.Context
+	Rc       *cloudflare.ResourceContainer
+	RecordID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}
+	mock.lockGetDNSRecord.RLock()
+	calls = mock.calls.GetDNSRecord
+	mock.lockGetDNSRecord.RUnlock()
+	return calls
+}
+
+// GetEmailRoutingDNSSettings calls GetEmailRoutingDNSSettingsFunc.
+func (mock *ClientMock) GetEmailRoutingDNSSettings(ctx context.Context, rc *cloudflare.ResourceContainer) ([]cloudflare.DNSRecord, error) {
+	if mock.GetEmailRoutingDNSSettingsFunc == nil {
+		panic("ClientMock.GetEmailRoutingDNSSettingsFunc: method is nil but Client.GetEmailRoutingDNSSettings was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+	}{
+		Ctx: ctx,
+		Rc:  rc,
+	}
+	mock.lockGetEmailRoutingDNSSettings.Lock()
+	mock.calls.GetEmailRoutingDNSSettings = append(mock.calls.GetEmailRoutingDNSSettings, callInfo)
+	mock.lockGetEmailRoutingDNSSettings.Unlock()
+	return mock.GetEmailRoutingDNSSettingsFunc(ctx, rc)
+}
+
+// GetEmailRoutingDNSSettingsCalls gets all the calls that were made to GetEmailRoutingDNSSettings.
+// Check the length with:
+//
+//	len(mockedClient.GetEmailRoutingDNSSettingsCalls())
+func (mock *ClientMock) GetEmailRoutingDNSSettingsCalls() []struct {
+	Ctx context.Context
+	Rc  *cloudflare.ResourceContainer
+} {
+	var calls []struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+	}
+	mock.lockGetEmailRoutingDNSSettings.RLock()
+	calls = mock.calls.GetEmailRoutingDNSSettings
+	mock.lockGetEmailRoutingDNSSettings.RUnlock()
+	return calls
+}
+
+// GetEmailRoutingSettings calls GetEmailRoutingSettingsFunc.
+func (mock *ClientMock) GetEmailRoutingSettings(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error) {
+	if mock.GetEmailRoutingSettingsFunc == nil {
+		panic("ClientMock.GetEmailRoutingSettingsFunc: method is nil but Client.GetEmailRoutingSettings was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+	}{
+		Ctx: ctx,
+		Rc:  rc,
+	}
+	mock.lockGetEmailRoutingSettings.Lock()
+	mock.calls.GetEmailRoutingSettings = append(mock.calls.GetEmailRoutingSettings, callInfo)
+	mock.lockGetEmailRoutingSettings.Unlock()
+	return mock.GetEmailRoutingSettingsFunc(ctx, rc)
+}
+
+// GetEmailRoutingSettingsCalls gets all the calls that were made to GetEmailRoutingSettings.
+// Check the length with:
+//
+//	len(mockedClient.GetEmailRoutingSettingsCalls())
+func (mock *ClientMock) GetEmailRoutingSettingsCalls() []struct {
+	Ctx context.Context
+	Rc  *cloudflare.ResourceContainer
+} {
+	var calls []struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+	}
+	mock.lockGetEmailRoutingSettings.RLock()
+	calls = mock.calls.GetEmailRoutingSettings
+	mock.lockGetEmailRoutingSettings.RUnlock()
+	return calls
+}
+
+// ListDNSRecords calls ListDNSRecordsFunc.
+func (mock *ClientMock) ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+	if mock.ListDNSRecordsFunc == nil {
+		panic("ClientMock.ListDNSRecordsFunc: method is nil but Client.ListDNSRecords was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListDNSRecords.Lock()
+	mock.calls.ListDNSRecords = append(mock.calls.ListDNSRecords, callInfo)
+	mock.lockListDNSRecords.Unlock()
+	return mock.ListDNSRecordsFunc(ctx, rc, params)
+}
+
+// ListDNSRecordsCalls gets all the calls that were made to ListDNSRecords.
+// Check the length with:
+//
+//	len(mockedClient.ListDNSRecordsCalls())
+func (mock *ClientMock) ListDNSRecordsCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListDNSRecordsParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}
+	mock.lockListDNSRecords.RLock()
+	calls = mock.calls.ListDNSRecords
+	mock.lockListDNSRecords.RUnlock()
+	return calls
+}
+
+// UpdateDNSRecord calls UpdateDNSRecordFunc.
+func (mock *ClientMock) UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	if mock.UpdateDNSRecordFunc == nil {
+		panic("ClientMock.UpdateDNSRecordFunc: method is nil but Client.UpdateDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateDNSRecordParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockUpdateDNSRecord.Lock()
+	mock.calls.UpdateDNSRecord = append(mock.calls.UpdateDNSRecord, callInfo)
+	mock.lockUpdateDNSRecord.Unlock()
+	return mock.UpdateDNSRecordFunc(ctx, rc, params)
+}
+
+// UpdateDNSRecordCalls gets all the calls that were made to UpdateDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.UpdateDNSRecordCalls())
+func (mock *ClientMock) UpdateDNSRecordCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.UpdateDNSRecordParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateDNSRecordParams
+	}
+	mock.lockUpdateDNSRecord.RLock()
+	calls = mock.calls.UpdateDNSRecord
+	mock.lockUpdateDNSRecord.RUnlock()
+	return calls
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package settings
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/emailrouting/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/records"
+)
+
+const (
+	errGetSettings    = "cannot get email routing settings"
+	errGetDNSSettings = "cannot get email routing DNS records"
+	errEnable         = "cannot enable email routing"
+	errDisable        = "cannot disable email routing"
+	errListRecords    = "cannot list DNS records"
+	errCreateRecord   = "cannot create DNS record"
+	errUpdateRecord   = "cannot update DNS record"
+	errDeleteRecord   = "cannot delete DNS record"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with the Email Routing Settings of a zone and the DNS Records they
+// require.
+type Client interface {
+	clients.DNSAPI
+	GetEmailRoutingSettings(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error)
+	EnableEmailRouting(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error)
+	DisableEmailRouting(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error)
+	GetEmailRoutingDNSSettings(ctx context.Context, rc *cloudflare.ResourceContainer) ([]cloudflare.DNSRecord, error)
+}
+
+// NewClient returns a new Cloudflare API client for working with Email
+// Routing Settings.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// ManagedComment returns the DNS record comment used to mark records as
+// owned by the named Email Routing Settings.
+func ManagedComment(name string) string {
+	return "managed-by: crossplane emailrouting-settings/" + name
+}
+
+// Enabled returns whether the supplied parameters enable Email Routing.
+func Enabled(p v1alpha1.SettingsParameters) bool {
+	return p.Enabled == nil || *p.Enabled
+}
+
+// ManagesDNSRecords returns whether the supplied parameters manage the DNS
+// Records Email Routing requires.
+func ManagesDNSRecords(p v1alpha1.SettingsParameters) bool {
+	return p.ManageDNSRecords != nil && *p.ManageDNSRecords
+}
+
+// Get returns the Email Routing Settings of the supplied zone.
+func Get(ctx context.Context, client Client, zoneID string) (cloudflare.EmailRoutingSettings, error) {
+	s, err := client.GetEmailRoutingSettings(ctx, cloudflare.ZoneIdentifier(zoneID))
+	return s, errors.Wrap(err, errGetSettings)
+}
+
+// Required returns the DNS Records Email Routing requires in the supplied
+// zone.
+func Required(ctx context.Context, client Client, zoneID string) ([]cloudflare.DNSRecord, error) {
+	recs, err := client.GetEmailRoutingDNSSettings(ctx, cloudflare.ZoneIdentifier(zoneID))
+	return recs, errors.Wrap(err, errGetDNSSettings)
+}
+
+// ListManaged returns the DNS Records owned by the named Email Routing
+// Settings.
+func ListManaged(ctx context.Context, client Client, zoneID, name string) ([]cloudflare.DNSRecord, error) {
+	recs, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{
+		Comment: ManagedComment(name),
+	})
+	return recs, errors.Wrap(err, errListRecords)
+}
+
+// GenerateObservation creates an observation of the supplied Email Routing
+// Settings and the DNS Records managed for them.
+func GenerateObservation(s cloudflare.EmailRoutingSettings, managed []cloudflare.DNSRecord) v1alpha1.SettingsObservation {
+	o := v1alpha1.SettingsObservation{
+		Tag:     s.Tag,
+		Name:    s.Name,
+		Enabled: s.Enabled,
+		Status:  s.Status,
+	}
+	for _, r := range managed {
+		rec := v1alpha1.SettingsDNSRecord{ID: r.ID, Type: r.Type, Name: r.Name, Content: r.Content}
+		if r.Priority != nil {
+			rec.Priority = ptr.To(int32(*r.Priority))
+		}
+		o.DNSRecords = append(o.DNSRecords, rec)
+	}
+	sort.Slice(o.DNSRecords, func(i, j int) bool { return key(o.DNSRecords[i]) < key(o.DNSRecords[j]) })
+	return o
+}
+
+// UpToDate checks whether Email Routing is enabled as desired and, when
+// DNS Records are managed, whether exactly the required records are owned.
+func UpToDate(p v1alpha1.SettingsParameters, s cloudflare.EmailRoutingSettings, required, managed []cloudflare.DNSRecord) bool {
+	if s.Enabled != Enabled(p) {
+		return false
+	}
+	if !ManagesDNSRecords(p) {
+		return true
+	}
+	if !Enabled(p) {
+		return len(managed) == 0
+	}
+	if len(required) != len(managed) {
+		return false
+	}
+	for _, r := range required {
+		if _, ok := find(managed, r); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply enables or disables Email Routing for the supplied zone. When DNS
+// Records are managed the required records are provisioned before Email
+// Routing is enabled, and removed after it is disabled, so that mail is
+// never routed to a zone that is not set up to receive it.
+func Apply(ctx context.Context, client Client, zoneID, name string, p v1alpha1.SettingsParameters) error {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+
+	if !Enabled(p) {
+		if _, err := client.DisableEmailRouting(ctx, rc); err != nil {
+			return errors.Wrap(err, errDisable)
+		}
+		if !ManagesDNSRecords(p) {
+			return nil
+		}
+		return RemoveDNSRecords(ctx, client, zoneID, name)
+	}
+
+	if ManagesDNSRecords(p) {
+		if err := provisionDNSRecords(ctx, client, zoneID, name); err != nil {
+			return err
+		}
+	}
+	_, err := client.EnableEmailRouting(ctx, rc)
+	return errors.Wrap(err, errEnable)
+}
+
+// provisionDNSRecords creates the DNS Records Email Routing requires that
+// do not exist, adopting identical unowned records, and deletes owned
+// records that are no longer required.
+func provisionDNSRecords(ctx context.Context, client Client, zoneID, name string) error {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	comment := ManagedComment(name)
+
+	required, err := Required(ctx, client, zoneID)
+	if err != nil {
+		return err
+	}
+	owned, err := ListManaged(ctx, client, zoneID, name)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range required {
+		if i, ok := find(owned, r); ok {
+			owned = append(owned[:i], owned[i+1:]...)
+			continue
+		}
+
+		existing, _, err := client.ListDNSRecords(ctx, rc, cloudflare.ListDNSRecordsParams{Type: r.Type, Name: r.Name})
+		if err != nil {
+			return errors.Wrap(err, errListRecords)
+		}
+		if i, ok := find(existing, r); ok {
+			_, err := client.UpdateDNSRecord(ctx, rc, cloudflare.UpdateDNSRecordParams{
+				ID:       existing[i].ID,
+				Type:     r.Type,
+				Name:     r.Name,
+				Content:  r.Content,
+				Priority: r.Priority,
+				TTL:      existing[i].TTL,
+				Comment:  &comment,
+			})
+			if err != nil {
+				return errors.Wrap(err, errUpdateRecord)
+			}
+			continue
+		}
+
+		_, err = client.CreateDNSRecord(ctx, rc, cloudflare.CreateDNSRecordParams{
+			Type:     r.Type,
+			Name:     r.Name,
+			Content:  r.Content,
+			Priority: r.Priority,
+			TTL:      r.TTL,
+			Comment:  comment,
+		})
+		if err != nil {
+			return errors.Wrap(err, errCreateRecord)
+		}
+	}
+
+	// Anything left was owned by us but is no longer required.
+	return deleteRecords(ctx, client, zoneID, owned)
+}
+
+// RemoveDNSRecords deletes the DNS Records owned by the named Email Routing
+// Settings.
+func RemoveDNSRecords(ctx context.Context, client Client, zoneID, name string) error {
+	owned, err := ListManaged(ctx, client, zoneID, name)
+	if err != nil {
+		return err
+	}
+	return deleteRecords(ctx, client, zoneID, owned)
+}
+
+func deleteRecords(ctx context.Context, client Client, zoneID string, recs []cloudflare.DNSRecord) error {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	for _, r := range recs {
+		if err := client.DeleteDNSRecord(ctx, rc, r.ID); err != nil && !records.IsRecordNotFound(err) {
+			return errors.Wrap(err, errDeleteRecord)
+		}
+	}
+	return nil
+}
+
+// find returns the index of the record in recs matching the type, name,
+// content and priority of want.
+func find(recs []cloudflare.DNSRecord, want cloudflare.DNSRecord) (int, bool) {
+	for i, r := range recs {
+		if r.Type == want.Type && r.Name == want.Name && r.Content == want.Content &&
+			ptr.Deref(r.Priority, 0) == ptr.Deref(want.Priority, 0) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func key(r v1alpha1.SettingsDNSRecord) string {
+	return r.Type + "/" + r.Name + "/" + r.Content
+}
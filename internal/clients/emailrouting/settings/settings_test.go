@@ -0,0 +1,223 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/emailrouting/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/emailrouting/settings/fake"
+)
+
+var (
+	mx1 = cloudflare.DNSRecord{Type: "MX", Name: "example.com", Content: "route1.mx.cloudflare.net", Priority: ptr.To[uint16](13)}
+	mx2 = cloudflare.DNSRecord{Type: "MX", Name: "example.com", Content: "route2.mx.cloudflare.net", Priority: ptr.To[uint16](86)}
+	spf = cloudflare.DNSRecord{Type: "TXT", Name: "example.com", Content: "v=spf1 include:_spf.mx.cloudflare.net ~all"}
+)
+
+func owned(r cloudflare.DNSRecord, id string) cloudflare.DNSRecord {
+	r.ID = id
+	r.Comment = ManagedComment("settings")
+	return r
+}
+
+func TestUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		params   v1alpha1.SettingsParameters
+		settings cloudflare.EmailRoutingSettings
+		required []cloudflare.DNSRecord
+		managed  []cloudflare.DNSRecord
+		want     bool
+	}{
+		"Enabled": {
+			reason:   "Settings should be up to date when Email Routing is enabled as desired",
+			params:   v1alpha1.SettingsParameters{},
+			settings: cloudflare.EmailRoutingSettings{Enabled: true},
+			want:     true,
+		},
+		"NotEnabled": {
+			reason:   "Settings should not be up to date when Email Routing is disabled but desired enabled",
+			params:   v1alpha1.SettingsParameters{},
+			settings: cloudflare.EmailRoutingSettings{},
+			want:     false,
+		},
+		"RecordsProvisioned": {
+			reason:   "Settings should be up to date when all required records are owned",
+			params:   v1alpha1.SettingsParameters{ManageDNSRecords: ptr.To(true)},
+			settings: cloudflare.EmailRoutingSettings{Enabled: true},
+			required: []cloudflare.DNSRecord{mx1, mx2, spf},
+			managed:  []cloudflare.DNSRecord{owned(spf, "3"), owned(mx2, "2"), owned(mx1, "1")},
+			want:     true,
+		},
+		"RecordMissing": {
+			reason:   "Settings should not be up to date when a required record is not owned",
+			params:   v1alpha1.SettingsParameters{ManageDNSRecords: ptr.To(true)},
+			settings: cloudflare.EmailRoutingSettings{Enabled: true},
+			required: []cloudflare.DNSRecord{mx1, mx2, spf},
+			managed:  []cloudflare.DNSRecord{owned(mx1, "1"), owned(mx2, "2")},
+			want:     false,
+		},
+		"RecordsRemaining": {
+			reason:   "Settings should not be up to date when owned records remain after disabling",
+			params:   v1alpha1.SettingsParameters{Enabled: ptr.To(false), ManageDNSRecords: ptr.To(true)},
+			settings: cloudflare.EmailRoutingSettings{},
+			managed:  []cloudflare.DNSRecord{owned(mx1, "1")},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := UpToDate(tc.params, tc.settings, tc.required, tc.managed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		calls   []string
+		created []string
+		updated []string
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		params   v1alpha1.SettingsParameters
+		owned    []cloudflare.DNSRecord
+		existing []cloudflare.DNSRecord
+		enable   error
+		want     want
+	}{
+		"EnableOnly": {
+			reason: "Email Routing should be enabled without touching DNS Records unless they are managed",
+			params: v1alpha1.SettingsParameters{},
+			want:   want{calls: []string{"enable"}},
+		},
+		"ProvisionThenEnable": {
+			reason: "Missing required records should be created before Email Routing is enabled",
+			params: v1alpha1.SettingsParameters{ManageDNSRecords: ptr.To(true)},
+			owned:  []cloudflare.DNSRecord{owned(mx1, "1")},
+			want: want{
+				calls:   []string{"create", "create", "enable"},
+				created: []string{"route2.mx.cloudflare.net", "v=spf1 include:_spf.mx.cloudflare.net ~all"},
+			},
+		},
+		"AdoptExisting": {
+			reason: "Identical unowned records should be adopted rather than duplicated",
+			params: v1alpha1.SettingsParameters{ManageDNSRecords: ptr.To(true)},
+			owned:  []cloudflare.DNSRecord{owned(mx1, "1"), owned(mx2, "2")},
+			existing: []cloudflare.DNSRecord{
+				{ID: "other", Type: "TXT", Name: "example.com", Content: "google-site-verification=abc"},
+				func() cloudflare.DNSRecord { r := spf; r.ID = "3"; return r }(),
+			},
+			want: want{calls: []string{"update", "enable"}, updated: []string{"3"}},
+		},
+		"DeleteStale": {
+			reason: "Owned records that are no longer required should be deleted",
+			params: v1alpha1.SettingsParameters{ManageDNSRecords: ptr.To(true)},
+			owned: []cloudflare.DNSRecord{
+				owned(mx1, "1"), owned(mx2, "2"), owned(spf, "3"),
+				owned(cloudflare.DNSRecord{Type: "MX", Name: "example.com", Content: "route3.mx.cloudflare.net"}, "4"),
+			},
+			want: want{calls: []string{"delete", "enable"}, deleted: []string{"4"}},
+		},
+		"DisableThenRemove": {
+			reason: "Owned records should be removed after Email Routing is disabled",
+			params: v1alpha1.SettingsParameters{Enabled: ptr.To(false), ManageDNSRecords: ptr.To(true)},
+			owned:  []cloudflare.DNSRecord{owned(mx1, "1"), owned(spf, "3")},
+			want: want{
+				calls:   []string{"disable", "delete", "delete"},
+				deleted: []string{"1", "3"},
+			},
+		},
+		"EnableError": {
+			reason: "Errors enabling Email Routing should be returned",
+			params: v1alpha1.SettingsParameters{},
+			enable: errBoom,
+			want:   want{calls: []string{"enable"}, err: errors.Wrap(errBoom, errEnable)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			client := &fake.ClientMock{
+				GetEmailRoutingDNSSettingsFunc: func(_ context.Context, _ *cloudflare.ResourceContainer) ([]cloudflare.DNSRecord, error) {
+					return []cloudflare.DNSRecord{mx1, mx2, spf}, nil
+				},
+				ListDNSRecordsFunc: func(_ context.Context, _ *cloudflare.ResourceContainer, p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+					if p.Comment != "" {
+						return append([]cloudflare.DNSRecord{}, tc.owned...), &cloudflare.ResultInfo{}, nil
+					}
+					return tc.existing, &cloudflare.ResultInfo{}, nil
+				},
+				CreateDNSRecordFunc: func(_ context.Context, _ *cloudflare.ResourceContainer, p cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+					got.calls = append(got.calls, "create")
+					got.created = append(got.created, p.Content)
+					if p.Comment != ManagedComment("settings") {
+						return cloudflare.DNSRecord{}, errors.New("record created without ownership comment")
+					}
+					return cloudflare.DNSRecord{}, nil
+				},
+				UpdateDNSRecordFunc: func(_ context.Context, _ *cloudflare.ResourceContainer, p cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+					got.calls = append(got.calls, "update")
+					got.updated = append(got.updated, p.ID)
+					return cloudflare.DNSRecord{}, nil
+				},
+				DeleteDNSRecordFunc: func(_ context.Context, _ *cloudflare.ResourceContainer, id string) error {
+					got.calls = append(got.calls, "delete")
+					got.deleted = append(got.deleted, id)
+					return nil
+				},
+				EnableEmailRoutingFunc: func(_ context.Context, _ *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error) {
+					got.calls = append(got.calls, "enable")
+					return cloudflare.EmailRoutingSettings{}, tc.enable
+				},
+				DisableEmailRoutingFunc: func(_ context.Context, _ *cloudflare.ResourceContainer) (cloudflare.EmailRoutingSettings, error) {
+					got.calls = append(got.calls, "disable")
+					return cloudflare.EmailRoutingSettings{}, nil
+				},
+			}
+
+			err := Apply(context.Background(), client, "zone-id", "settings", tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), cmp.FilterPath(func(p cmp.Path) bool {
+				return p.Last().String() == ".err"
+			}, cmp.Ignore())); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package emailsecurity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/records"
+)
+
+const (
+	errListRecords  = "cannot list DNS records"
+	errCreateRecord = "cannot create DNS record"
+	errUpdateRecord = "cannot update DNS record"
+	errDeleteRecord = "cannot delete DNS record"
+
+	recordTypeTXT = "TXT"
+
+	spfVersion   = "v=spf1"
+	dkimVersion  = "v=DKIM1"
+	dmarcVersion = "v=DMARC1"
+)
+
+// Client is a Cloudflare API client that implements methods for working
+// with the DNS Records managed by an EmailSecurityPosture.
+type Client = clients.DNSAPI
+
+// NewClient returns a new Cloudflare API client for working with the DNS
+// Records managed by an EmailSecurityPosture.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// ManagedComment returns the DNS record comment used to mark records as
+// owned by the named EmailSecurityPosture.
+func ManagedComment(name string) string {
+	return "managed-by: crossplane emailsecurityposture/" + name
+}
+
+// Render returns the TXT records described by the supplied parameters,
+// ordered by name.
+func Render(p v1alpha1.EmailSecurityPostureParameters) []v1alpha1.ManagedEmailRecord {
+	out := []v1alpha1.ManagedEmailRecord{}
+	if p.SPF != nil {
+		out = append(out, v1alpha1.ManagedEmailRecord{Name: p.Domain, Content: renderSPF(p.SPF)})
+	}
+	for _, k := range p.DKIM {
+		out = append(out, v1alpha1.ManagedEmailRecord{
+			Name:    k.Selector + "._domainkey." + p.Domain,
+			Content: renderDKIM(k),
+		})
+	}
+	if p.DMARC != nil {
+		out = append(out, v1alpha1.ManagedEmailRecord{Name: "_dmarc." + p.Domain, Content: renderDMARC(p.DMARC)})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func renderSPF(s *v1alpha1.SPFPolicy) string {
+	parts := []string{spfVersion}
+	if s.MX != nil && *s.MX {
+		parts = append(parts, "mx")
+	}
+	for _, ip := range s.IP4 {
+		parts = append(parts, "ip4:"+ip)
+	}
+	for _, ip := range s.IP6 {
+		parts = append(parts, "ip6:"+ip)
+	}
+	for _, inc := range s.Includes {
+		parts = append(parts, "include:"+inc)
+	}
+	all := "-all"
+	if s.All != nil {
+		all = *s.All
+	}
+	return strings.Join(append(parts, all), " ")
+}
+
+func renderDKIM(k v1alpha1.DKIMKey) string {
+	kt := "rsa"
+	if k.KeyType != nil {
+		kt = *k.KeyType
+	}
+	return fmt.Sprintf("%s; k=%s; p=%s", dkimVersion, kt, k.PublicKey)
+}
+
+func renderDMARC(d *v1alpha1.DMARCPolicy) string {
+	parts := []string{dmarcVersion, "p=" + d.Policy}
+	if d.SubdomainPolicy != nil {
+		parts = append(parts, "sp="+*d.SubdomainPolicy)
+	}
+	if d.Percentage != nil {
+		parts = append(parts, fmt.Sprintf("pct=%d", *d.Percentage))
+	}
+	if len(d.AggregateReportAddresses) > 0 {
+		parts = append(parts, "rua="+mailtoList(d.AggregateReportAddresses))
+	}
+	if len(d.ForensicReportAddresses) > 0 {
+		parts = append(parts, "ruf="+mailtoList(d.ForensicReportAddresses))
+	}
+	if d.DKIMAlignment != nil {
+		parts = append(parts, "adkim="+*d.DKIMAlignment)
+	}
+	if d.SPFAlignment != nil {
+		parts = append(parts, "aspf="+*d.SPFAlignment)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func mailtoList(addrs []string) string {
+	out := make([]string, len(addrs))
+	for i, a := range addrs {
+		if !strings.HasPrefix(a, "mailto:") {
+			a = "mailto:" + a
+		}
+		out[i] = a
+	}
+	return strings.Join(out, ",")
+}
+
+// unquote strips the quotes Cloudflare may return around TXT content.
+func unquote(content string) string {
+	return strings.Trim(content, `"`)
+}
+
+// version returns the version tag (e.g. v=spf1) a TXT record starts with.
+func version(content string) string {
+	v, _, _ := strings.Cut(unquote(content), " ")
+	return strings.TrimSuffix(v, ";")
+}
+
+// ListManaged returns the DNS Records owned by the named
+// EmailSecurityPosture.
+func ListManaged(ctx context.Context, client Client, zoneID, name string) ([]cloudflare.DNSRecord, error) {
+	recs, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{
+		Type:    recordTypeTXT,
+		Comment: ManagedComment(name),
+	})
+	return recs, errors.Wrap(err, errListRecords)
+}
+
+// GenerateObservation creates an observation of the supplied DNS Records.
+func GenerateObservation(in []cloudflare.DNSRecord) v1alpha1.EmailSecurityPostureObservation {
+	o := v1alpha1.EmailSecurityPostureObservation{}
+	for _, r := range in {
+		o.Records = append(o.Records, v1alpha1.ManagedEmailRecord{ID: r.ID, Name: r.Name, Content: unquote(r.Content)})
+	}
+	sort.Slice(o.Records, func(i, j int) bool { return o.Records[i].Name < o.Records[j].Name })
+	return o
+}
+
+// UpToDate checks whether the supplied records match the rendered
+// parameters exactly.
+func UpToDate(p v1alpha1.EmailSecurityPostureParameters, actual []cloudflare.DNSRecord) bool {
+	desired := Render(p)
+	if len(desired) != len(actual) {
+		return false
+	}
+	byName := map[string]cloudflare.DNSRecord{}
+	for _, r := range actual {
+		byName[r.Name] = r
+	}
+	for _, d := range desired {
+		r, ok := byName[d.Name]
+		if !ok || unquote(r.Content) != d.Content || r.TTL != ttl(p) {
+			return false
+		}
+	}
+	return true
+}
+
+func ttl(p v1alpha1.EmailSecurityPostureParameters) int {
+	if p.TTL == nil {
+		return 1
+	}
+	return int(*p.TTL)
+}
+
+// Apply reconciles the DNS Records owned by the named EmailSecurityPosture
+// with the rendered parameters. Records that are no longer rendered are
+// deleted. An existing unowned SPF, DKIM or DMARC record at a rendered name
+// is adopted rather than duplicated, since publishing two such records at
+// one name invalidates both.
+func Apply(ctx context.Context, client Client, zoneID, name string, p v1alpha1.EmailSecurityPostureParameters) error {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	comment := ManagedComment(name)
+
+	owned, err := ListManaged(ctx, client, zoneID, name)
+	if err != nil {
+		return err
+	}
+	byName := map[string]cloudflare.DNSRecord{}
+	for _, r := range owned {
+		byName[r.Name] = r
+	}
+
+	for _, d := range Render(p) {
+		existing, ok := byName[d.Name]
+		delete(byName, d.Name)
+		if !ok {
+			existing, ok, err = findAdoptable(ctx, client, zoneID, d)
+			if err != nil {
+				return err
+			}
+		}
+		if !ok {
+			_, err := client.CreateDNSRecord(ctx, rc, cloudflare.CreateDNSRecordParams{
+				Type:    recordTypeTXT,
+				Name:    d.Name,
+				Content: d.Content,
+				TTL:     ttl(p),
+				Comment: comment,
+			})
+			if err != nil {
+				return errors.Wrap(err, errCreateRecord)
+			}
+			continue
+		}
+		if unquote(existing.Content) == d.Content && existing.TTL == ttl(p) && existing.Comment == comment {
+			continue
+		}
+		_, err := client.UpdateDNSRecord(ctx, rc, cloudflare.UpdateDNSRecordParams{
+			ID:      existing.ID,
+			Type:    recordTypeTXT,
+			Name:    d.Name,
+			Content: d.Content,
+			TTL:     ttl(p),
+			Comment: &comment,
+		})
+		if err != nil {
+			return errors.Wrap(err, errUpdateRecord)
+		}
+	}
+
+	// Anything left was owned by us but is no longer rendered, for
+	// example a rotated out DKIM selector.
+	return Delete(ctx, client, zoneID, mapValues(byName))
+}
+
+// findAdoptable looks for an existing record at the desired name carrying
+// the same version tag as the desired content.
+func findAdoptable(ctx context.Context, client Client, zoneID string, d v1alpha1.ManagedEmailRecord) (cloudflare.DNSRecord, bool, error) {
+	recs, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{
+		Type: recordTypeTXT,
+		Name: d.Name,
+	})
+	if err != nil {
+		return cloudflare.DNSRecord{}, false, errors.Wrap(err, errListRecords)
+	}
+	want := version(d.Content)
+	for _, r := range recs {
+		if strings.EqualFold(version(r.Content), want) {
+			return r, true, nil
+		}
+	}
+	return cloudflare.DNSRecord{}, false, nil
+}
+
+// Delete deletes the supplied DNS Records, ignoring any that no longer
+// exist.
+func Delete(ctx context.Context, client Client, zoneID string, recs []cloudflare.DNSRecord) error {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	for _, r := range recs {
+		if err := client.DeleteDNSRecord(ctx, rc, r.ID); err != nil && !records.IsRecordNotFound(err) {
+			return errors.Wrap(err, errDeleteRecord)
+		}
+	}
+	return nil
+}
+
+func mapValues(m map[string]cloudflare.DNSRecord) []cloudflare.DNSRecord {
+	out := make([]cloudflare.DNSRecord, 0, len(m))
+	for _, r := range m {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package emailsecurity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/fake"
+)
+
+func TestRender(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.EmailSecurityPostureParameters
+		want   []v1alpha1.ManagedEmailRecord
+	}{
+		"Empty": {
+			reason: "No records should be rendered when no policy is specified",
+			params: v1alpha1.EmailSecurityPostureParameters{Domain: "example.com"},
+			want:   []v1alpha1.ManagedEmailRecord{},
+		},
+		"Full": {
+			reason: "SPF, DKIM and DMARC records should be rendered at their conventional names",
+			params: v1alpha1.EmailSecurityPostureParameters{
+				Domain: "example.com",
+				SPF: &v1alpha1.SPFPolicy{
+					MX:       ptr.To(true),
+					IP4:      []string{"192.0.2.0/24"},
+					Includes: []string{"_spf.google.com"},
+					All:      ptr.To("~all"),
+				},
+				DKIM: []v1alpha1.DKIMKey{{Selector: "google", PublicKey: "MIGf"}},
+				DMARC: &v1alpha1.DMARCPolicy{
+					Policy:                   "reject",
+					SubdomainPolicy:          ptr.To("quarantine"),
+					Percentage:               ptr.To[int32](50),
+					AggregateReportAddresses: []string{"dmarc@example.com", "mailto:reports@example.net"},
+					DKIMAlignment:            ptr.To("s"),
+				},
+			},
+			want: []v1alpha1.ManagedEmailRecord{
+				{Name: "_dmarc.example.com", Content: "v=DMARC1; p=reject; sp=quarantine; pct=50; rua=mailto:dmarc@example.com,mailto:reports@example.net; adkim=s"},
+				{Name: "example.com", Content: "v=spf1 mx ip4:192.0.2.0/24 include:_spf.google.com ~all"},
+				{Name: "google._domainkey.example.com", Content: "v=DKIM1; k=rsa; p=MIGf"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Render(tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nRender(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	errBoom := errors.New("boom")
+	comment := ManagedComment("posture")
+
+	params := v1alpha1.EmailSecurityPostureParameters{
+		Domain: "example.com",
+		SPF:    &v1alpha1.SPFPolicy{Includes: []string{"_spf.google.com"}},
+		DMARC:  &v1alpha1.DMARCPolicy{Policy: "none"},
+	}
+
+	type want struct {
+		created []string
+		updated []string
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		list   func(params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, error)
+		create error
+		want   want
+	}{
+		"CreatesMissing": {
+			reason: "Rendered records that do not exist should be created",
+			want:   want{created: []string{"_dmarc.example.com", "example.com"}},
+		},
+		"AdoptsUnowned": {
+			reason: "An existing unowned SPF record should be updated rather than duplicated",
+			list: func(p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, error) {
+				if p.Name == "example.com" {
+					return []cloudflare.DNSRecord{
+						{ID: "verification", Name: "example.com", Content: "google-site-verification=abc"},
+						{ID: "spf", Name: "example.com", Content: `"v=spf1 include:old.example.net -all"`},
+					}, nil
+				}
+				return nil, nil
+			},
+			want: want{created: []string{"_dmarc.example.com"}, updated: []string{"spf"}},
+		},
+		"LeavesUpToDate": {
+			reason: "Owned records that are up to date should not be touched",
+			list: func(p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, error) {
+				if p.Comment == comment {
+					return []cloudflare.DNSRecord{
+						{ID: "spf", Name: "example.com", Content: "v=spf1 include:_spf.google.com -all", TTL: 1, Comment: comment},
+						{ID: "dmarc", Name: "_dmarc.example.com", Content: "v=DMARC1; p=none", TTL: 1, Comment: comment},
+					}, nil
+				}
+				return nil, errors.New("unexpected lookup")
+			},
+			want: want{},
+		},
+		"DeletesStale": {
+			reason: "Owned records that are no longer rendered should be deleted",
+			list: func(p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, error) {
+				if p.Comment == comment {
+					return []cloudflare.DNSRecord{
+						{ID: "spf", Name: "example.com", Content: "v=spf1 include:_spf.google.com -all", TTL: 1, Comment: comment},
+						{ID: "dmarc", Name: "_dmarc.example.com", Content: "v=DMARC1; p=none", TTL: 1, Comment: comment},
+						{ID: "dkim", Name: "old._domainkey.example.com", Content: "v=DKIM1; k=rsa; p=MIGf", TTL: 1, Comment: comment},
+					}, nil
+				}
+				return nil, nil
+			},
+			want: want{deleted: []string{"dkim"}},
+		},
+		"CreateError": {
+			reason: "Errors creating records should be returned",
+			create: errBoom,
+			want:   want{created: []string{"_dmarc.example.com"}, err: errors.Wrap(errBoom, errCreateRecord)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			client := &fake.DNSAPIMock{
+				ListDNSRecordsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+					if tc.list == nil {
+						return nil, &cloudflare.ResultInfo{}, nil
+					}
+					recs, err := tc.list(p)
+					return recs, &cloudflare.ResultInfo{}, err
+				},
+				CreateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+					got.created = append(got.created, p.Name)
+					if p.Comment != comment {
+						return cloudflare.DNSRecord{}, errors.New("record created without ownership comment")
+					}
+					return cloudflare.DNSRecord{}, tc.create
+				},
+				UpdateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+					got.updated = append(got.updated, p.ID)
+					return cloudflare.DNSRecord{}, nil
+				},
+				DeleteDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+					got.deleted = append(got.deleted, recordID)
+					return nil
+				},
+			}
+
+			err := Apply(context.Background(), client, "zone-id", "posture", params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, got.created); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want created, +got created:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, got.updated); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want updated, +got updated:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, got.deleted); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
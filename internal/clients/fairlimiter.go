@@ -0,0 +1,266 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+// DefaultMaxQueuedPerKind is the default number of requests of a single
+// controller that may wait for a ProviderConfig's shared rate limit.
+const DefaultMaxQueuedPerKind = 100
+
+const errQueueFull = "too many requests of this kind are waiting for the rate limit of the ProviderConfig"
+
+var (
+	// maxQueuedPerKind bounds the requests of a controller waiting for a
+	// shared rate limit. Zero or less disables the bound.
+	maxQueuedPerKind = DefaultMaxQueuedPerKind
+
+	// rateLimitWeights are the shares of a shared rate limit given to each
+	// controller. Controllers without one have a weight of 1.
+	rateLimitWeights = map[string]float64{}
+)
+
+// SetMaxQueuedPerKind sets the number of requests of a single controller
+// that may wait for a ProviderConfig's shared rate limit. Further requests
+// fail immediately, and are retried with backoff. A value of zero or less
+// disables the bound. It must be called before any controllers are started.
+func SetMaxQueuedPerKind(n int) {
+	maxQueuedPerKind = n
+}
+
+// SetRateLimitWeight sets the share of a ProviderConfig's shared rate limit
+// given to the named controller, e.g.
+// managed/certificatepack.ssl.cloudflare.crossplane.io, relative to other
+// controllers waiting for it. It must be called before any controllers are
+// started.
+func SetRateLimitWeight(controller string, w float64) {
+	rateLimitWeights[controller] = w
+}
+
+type controllerKey struct{}
+
+// ContextWithController returns a copy of the supplied context whose API
+// requests wait for the rate limit of their ProviderConfig as requests of
+// the named controller, e.g.
+// managed/certificatepack.ssl.cloudflare.crossplane.io, and so with its
+// weight.
+func ContextWithController(ctx context.Context, controller string) context.Context {
+	return context.WithValue(ctx, controllerKey{}, controller)
+}
+
+// ControllerFromContext returns the controller the requests of the supplied
+// context are made for, or an empty string if it is unknown.
+func ControllerFromContext(ctx context.Context) string {
+	c, _ := ctx.Value(controllerKey{}).(string)
+	return c
+}
+
+// A fairLimiter shares a rate limit between controllers by start-time fair
+// queuing, so that a flood of requests of one kind (e.g. thousands of
+// Records) waits behind itself rather than in front of every other kind.
+// Each controller's requests are served in order, and controllers with
+// requests waiting are served in proportion to their weights.
+type fairLimiter struct {
+	limiter   *rate.Limiter
+	weights   map[string]float64
+	maxQueued int
+
+	mu sync.Mutex
+
+	// vtime is the start tag of the request last given the limit.
+	vtime float64
+
+	// finish is the finish tag of the last request of each controller.
+	finish map[string]float64
+
+	// queued counts the requests of each controller that are waiting or
+	// being served.
+	queued map[string]int
+
+	waiting waiters
+	serving bool
+	seq     uint64
+}
+
+func newFairLimiter(rps int) *fairLimiter {
+	return &fairLimiter{
+		limiter:   rate.NewLimiter(rate.Limit(rps), 1),
+		weights:   rateLimitWeights,
+		maxQueued: maxQueuedPerKind,
+		finish:    map[string]float64{},
+		queued:    map[string]int{},
+	}
+}
+
+// Limit returns the shared rate limit.
+func (l *fairLimiter) Limit() rate.Limit {
+	return l.limiter.Limit()
+}
+
+// SetLimit changes the shared rate limit.
+func (l *fairLimiter) SetLimit(r rate.Limit) {
+	l.limiter.SetLimit(r)
+}
+
+// Wait blocks until a request of the named controller may be sent.
+func (l *fairLimiter) Wait(ctx context.Context, controller string) error {
+	start := time.Now()
+	w, err := l.enqueue(controller)
+	if err != nil {
+		metrics.RecordRateLimitShed(controller)
+		return err
+	}
+	defer l.release(w)
+
+	select {
+	case <-w.ready:
+	case <-ctx.Done():
+		if l.cancel(w) {
+			return ctx.Err()
+		}
+	}
+	if err := l.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	metrics.ObserveRateLimitWait(controller, time.Since(start))
+	return nil
+}
+
+func (l *fairLimiter) weight(controller string) float64 {
+	if w, ok := l.weights[controller]; ok && w > 0 {
+		return w
+	}
+	return 1
+}
+
+// enqueue tags a request of the named controller and queues it, unless
+// too many of its requests are already queued.
+func (l *fairLimiter) enqueue(controller string) (*waiter, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.maxQueued > 0 && l.queued[controller] >= l.maxQueued {
+		return nil, errors.New(errQueueFull)
+	}
+
+	// A controller that was idle starts at the current virtual time, so
+	// it cannot save up a share it did not use.
+	w := &waiter{controller: controller, start: max(l.vtime, l.finish[controller]), seq: l.seq, ready: make(chan struct{})}
+	l.finish[controller] = w.start + 1/l.weight(controller)
+	l.queued[controller]++
+	l.seq++
+	heap.Push(&l.waiting, w)
+	l.dispatch()
+	return w, nil
+}
+
+// cancel removes a request that was abandoned while waiting, and returns
+// true if it was removed. A request that was given the limit in the
+// meantime is not.
+func (l *fairLimiter) cancel(w *waiter) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if w.granted {
+		return false
+	}
+	heap.Remove(&l.waiting, w.index)
+	return true
+}
+
+// release gives the limit to the next waiting request once a request is
+// done with it.
+func (l *fairLimiter) release(w *waiter) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.queued[w.controller]--
+	if l.queued[w.controller] == 0 {
+		delete(l.queued, w.controller)
+	}
+	if w.granted {
+		l.serving = false
+	}
+	l.dispatch()
+}
+
+// dispatch gives the limit to the waiting request with the earliest start
+// tag, unless another request holds it. It must be called with mu held.
+func (l *fairLimiter) dispatch() {
+	if l.serving || l.waiting.Len() == 0 {
+		return
+	}
+	w := heap.Pop(&l.waiting).(*waiter)
+	l.vtime = max(l.vtime, w.start)
+	l.serving = true
+	w.granted = true
+	close(w.ready)
+}
+
+// A waiter is a request waiting for a shared rate limit.
+type waiter struct {
+	controller string
+	start      float64
+	seq        uint64
+	ready      chan struct{}
+	granted    bool
+
+	// index in the waiting heap.
+	index int
+}
+
+// waiters is a heap of waiting requests ordered by start tag, and then by
+// arrival.
+type waiters []*waiter
+
+func (h waiters) Len() int { return len(h) }
+
+func (h waiters) Less(i, j int) bool {
+	if h[i].start != h[j].start {
+		return h[i].start < h[j].start
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h waiters) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *waiters) Push(x any) {
+	w := x.(*waiter)
+	w.index = len(*h)
+	*h = append(*h, w)
+}
+
+func (h *waiters) Pop() any {
+	old := *h
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return w
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+func TestFairLimiter(t *testing.T) {
+	type want struct {
+		order []string
+		shed  []string
+	}
+
+	cases := map[string]struct {
+		reason    string
+		weights   map[string]float64
+		maxQueued int
+		requests  []string
+		want      want
+	}{
+		"FairShare": {
+			reason:   "A request of a quiet controller should not wait behind a flood of requests of another",
+			requests: []string{"record", "record", "record", "record", "certificatepack"},
+			want: want{
+				order: []string{"record", "certificatepack", "record", "record", "record"},
+			},
+		},
+		"Weighted": {
+			reason:   "Controllers should be served in proportion to their weights",
+			weights:  map[string]float64{"certificatepack": 2},
+			requests: []string{"record", "record", "record", "certificatepack", "certificatepack", "certificatepack", "certificatepack"},
+			want: want{
+				order: []string{"record", "certificatepack", "certificatepack", "record", "certificatepack", "certificatepack", "record"},
+			},
+		},
+		"Shed": {
+			reason:    "Requests of a controller with too many requests waiting should be shed",
+			maxQueued: 2,
+			requests:  []string{"record", "record", "record", "certificatepack"},
+			want: want{
+				order: []string{"record", "certificatepack", "record"},
+				shed:  []string{"record"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &fairLimiter{
+				limiter:   rate.NewLimiter(rate.Inf, 1),
+				weights:   tc.weights,
+				maxQueued: tc.maxQueued,
+				finish:    map[string]float64{},
+				queued:    map[string]int{},
+			}
+
+			got := want{}
+			queued := make([]*waiter, 0, len(tc.requests))
+			for _, c := range tc.requests {
+				w, err := l.enqueue(c)
+				if err != nil {
+					got.shed = append(got.shed, c)
+					continue
+				}
+				queued = append(queued, w)
+			}
+
+			// Release whichever request was given the limit until none
+			// are left waiting.
+			for range queued {
+				for _, w := range queued {
+					if w.granted && w.ready != nil {
+						got.order = append(got.order, w.controller)
+						w.ready = nil
+						l.release(w)
+						break
+					}
+				}
+			}
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nfairLimiter: -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFairLimiterCancel(t *testing.T) {
+	l := newFairLimiter(1)
+	first, _ := l.enqueue("record")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx, "record"); err == nil {
+		t.Errorf("fairLimiter.Wait(...): a cancelled request should return an error")
+	}
+
+	// The cancelled request must not hold the limit, or be counted as
+	// waiting, once the first request is done with it.
+	l.release(first)
+	if diff := cmp.Diff(map[string]int{}, l.queued); diff != "" {
+		t.Errorf("fairLimiter.Wait(...): -want queued, +got queued:\n%s\n", diff)
+	}
+	if l.serving {
+		t.Errorf("fairLimiter.Wait(...): a cancelled request should not hold the limit")
+	}
+}
+
+func TestHTTPClientForController(t *testing.T) {
+	const controller = "managed/certificatepack.ssl.cloudflare.crossplane.io"
+	SetRateLimitWeight(controller, 4)
+	defer delete(rateLimitWeights, controller)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer srv.Close()
+
+	cfg := Config{ProviderConfigName: "weighted", RequestPolicy: &v1alpha1.RequestPolicy{RateLimit: ptr.To(100)}}
+	got := httpClientFor(cfg, metrics.NewInstrumentedHTTPClient(controller))
+	rt, ok := got.Transport.(*policyRoundTripper)
+	if !ok {
+		t.Fatalf("httpClientFor(...): requests should wait for the rate limit before anything else, got transport %T", got.Transport)
+	}
+	if _, ok := rt.next.(*mutationLimiter); !ok {
+		t.Errorf("httpClientFor(...): requests should take a mutation slot only once rate limited, got next transport %T", rt.next)
+	}
+
+	req, _ := http.NewRequestWithContext(ContextWithController(context.Background(), controller), http.MethodGet, srv.URL, nil)
+	resp, err := got.Do(req)
+	if err != nil {
+		t.Fatalf("httpClientFor(...): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	// A request of a controller with a weight of 4 advances its finish tag
+	// by a quarter of that of an unweighted request.
+	l := rateLimiterFor(cfg.ProviderConfigName, 100)
+	if diff := cmp.Diff(map[string]float64{controller: 0.25}, l.finish); diff != "" {
+		t.Errorf("httpClientFor(...): requests should wait for the rate limit with the weight of their controller: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,404 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"net/http"
+	"sync"
+)
+
+// Ensure, that RawAPIMock does implement clients.RawAPI.
+// If this is not the case, regenerate this file with moq.
+var _ clients.RawAPI = &RawAPIMock{}
+
+// RawAPIMock is a mock implementation of clients.RawAPI.
+//
+//	func TestSomethingThatUsesRawAPI(t *testing.T) {
+//
+//		// make and configure a mocked clients.RawAPI
+//		mockedRawAPI := &RawAPIMock{
+//			RawFunc: func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+//				panic("mock out the Raw method")
+//			},
+//		}
+//
+//		// use mockedRawAPI in code that requires clients.RawAPI
+//		// and then make assertions.
+//
+//	}
+type RawAPIMock struct {
+	// RawFunc mocks the Raw method.
+	RawFunc func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Raw holds details about calls to the Raw method.
+		Raw []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Method is the method argument value.
+			Method string
+			// Endpoint is the endpoint argument value.
+			Endpoint string
+			// Data is the data argument value.
+			Data interface{}
+			// Headers is the headers argument value.
+			Headers http.Header
+		}
+	}
+	lockRaw sync.RWMutex
+}
+
+// Raw calls RawFunc.
+func (mock *RawAPIMock) Raw(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if mock.RawFunc == nil {
+		panic("RawAPIMock.RawFunc: method is nil but RawAPI.Raw was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}{
+		Ctx:      ctx,
+		Method:   method,
+		Endpoint: endpoint,
+		Data:     data,
+		Headers:  headers,
+	}
+	mock.lockRaw.Lock()
+	mock.calls.Raw = append(mock.calls.Raw, callInfo)
+	mock.lockRaw.Unlock()
+	return mock.RawFunc(ctx, method, endpoint, data, headers)
+}
+
+// RawCalls gets all the calls that were made to Raw.
+// Check the length with:
+//
+//	len(mockedRawAPI.RawCalls())
+func (mock *RawAPIMock) RawCalls() []struct {
+	Ctx      context.Context
+	Method   string
+	Endpoint string
+	Data     interface{}
+	Headers  http.Header
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}
+	mock.lockRaw.RLock()
+	calls = mock.calls.Raw
+	mock.lockRaw.RUnlock()
+	return calls
+}
+
+// Ensure, that DNSAPIMock does implement clients.DNSAPI.
+// If this is not the case, regenerate this file with moq.
+var _ clients.DNSAPI = &DNSAPIMock{}
+
+// DNSAPIMock is a mock implementation of clients.DNSAPI.
+//
+//	func TestSomethingThatUsesDNSAPI(t *testing.T) {
+//
+//		// make and configure a mocked clients.DNSAPI
+//		mockedDNSAPI := &DNSAPIMock{
+//			CreateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+//				panic("mock out the CreateDNSRecord method")
+//			},
+//			DeleteDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+//				panic("mock out the DeleteDNSRecord method")
+//			},
+//			GetDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+//				panic("mock out the GetDNSRecord method")
+//			},
+//			ListDNSRecordsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+//				panic("mock out the ListDNSRecords method")
+//			},
+//			UpdateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+//				panic("mock out the UpdateDNSRecord method")
+//			},
+//		}
+//
+//		// use mockedDNSAPI in code that requires clients.DNSAPI
+//		// and then make assertions.
+//
+//	}
+type DNSAPIMock struct {
+	// CreateDNSRecordFunc mocks the CreateDNSRecord method.
+	CreateDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
+
+	// DeleteDNSRecordFunc mocks the DeleteDNSRecord method.
+	DeleteDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+
+	// GetDNSRecordFunc mocks the GetDNSRecord method.
+	GetDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
+
+	// ListDNSRecordsFunc mocks the ListDNSRecords method.
+	ListDNSRecordsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
+
+	// UpdateDNSRecordFunc mocks the UpdateDNSRecord method.
+	UpdateDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateDNSRecord holds details about calls to the CreateDNSRecord method.
+		CreateDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateDNSRecordParams
+		}
+		// DeleteDNSRecord holds details about calls to the DeleteDNSRecord method.
+		DeleteDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// RecordID is the recordID argument value.
+			RecordID string
+		}
+		// GetDNSRecord holds details about calls to the GetDNSRecord method.
+		GetDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// RecordID is the recordID argument value.
+			RecordID string
+		}
+		// ListDNSRecords holds details about calls to the ListDNSRecords method.
+		ListDNSRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListDNSRecordsParams
+		}
+		// UpdateDNSRecord holds details about calls to the UpdateDNSRecord method.
+		UpdateDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.UpdateDNSRecordParams
+		}
+	}
+	lockCreateDNSRecord sync.RWMutex
+	lockDeleteDNSRecord sync.RWMutex
+	lockGetDNSRecord    sync.RWMutex
+	lockListDNSRecords  sync.RWMutex
+	lockUpdateDNSRecord sync.RWMutex
+}
+
+// CreateDNSRecord calls CreateDNSRecordFunc.
+func (mock *DNSAPIMock) CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	if mock.CreateDNSRecordFunc == nil {
+		panic("DNSAPIMock.CreateDNSRecordFunc: method is nil but DNSAPI.CreateDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateDNSRecordParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateDNSRecord.Lock()
+	mock.calls.CreateDNSRecord = append(mock.calls.CreateDNSRecord, callInfo)
+	mock.lockCreateDNSRecord.Unlock()
+	return mock.CreateDNSRecordFunc(ctx, rc, params)
+}
+
+// CreateDNSRecordCalls gets all the calls that were made to CreateDNSRecord.
+// Check the length with:
+//
+//	len(mockedDNSAPI.CreateDNSRecordCalls())
+func (mock *DNSAPIMock) CreateDNSRecordCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateDNSRecordParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateDNSRecordParams
+	}
+	mock.lockCreateDNSRecord.RLock()
+	calls = mock.calls.CreateDNSRecord
+	mock.lockCreateDNSRecord.RUnlock()
+	return calls
+}
+
+// DeleteDNSRecord calls DeleteDNSRecordFunc.
+func (mock *DNSAPIMock) DeleteDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+	if mock.DeleteDNSRecordFunc == nil {
+		panic("DNSAPIMock.DeleteDNSRecordFunc: method is nil but DNSAPI.DeleteDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}{
+		Ctx:      ctx,
+		Rc:       rc,
+		RecordID: recordID,
+	}
+	mock.lockDeleteDNSRecord.Lock()
+	mock.calls.DeleteDNSRecord = append(mock.calls.DeleteDNSRecord, callInfo)
+	mock.lockDeleteDNSRecord.Unlock()
+	return mock.DeleteDNSRecordFunc(ctx, rc, recordID)
+}
+
+// DeleteDNSRecordCalls gets all the calls that were made to DeleteDNSRecord.
+// Check the length with:
+//
+//	len(mockedDNSAPI.DeleteDNSRecordCalls())
+func (mock *DNSAPIMock) DeleteDNSRecordCalls() []struct {
+	Ctx      context.Context
+	Rc       *cloudflare.ResourceContainer
+	RecordID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}
+	mock.lockDeleteDNSRecord.RLock()
+	calls = mock.calls.DeleteDNSRecord
+	mock.lockDeleteDNSRecord.RUnlock()
+	return calls
+}
+
+// GetDNSRecord calls GetDNSRecordFunc.
+func (mock *DNSAPIMock) GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+	if mock.GetDNSRecordFunc == nil {
+		panic("DNSAPIMock.GetDNSRecordFunc: method is nil but DNSAPI.GetDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}{
+		Ctx:      ctx,
+		Rc:       rc,
+		RecordID: recordID,
+	}
+	mock.lockGetDNSRecord.Lock()
+	mock.calls.GetDNSRecord = append(mock.calls.GetDNSRecord, callInfo)
+	mock.lockGetDNSRecord.Unlock()
+	return mock.GetDNSRecordFunc(ctx, rc, recordID)
+}
+
+// GetDNSRecordCalls gets all the calls that were made to GetDNSRecord.
+// Check the length with:
+//
+//	len(mockedDNSAPI.GetDNSRecordCalls())
+func (mock *DNSAPIMock) GetDNSRecordCalls() []struct {
+	Ctx      context.Context
+	Rc       *cloudflare.ResourceContainer
+	RecordID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}
+	mock.lockGetDNSRecord.RLock()
+	calls = mock.calls.GetDNSRecord
+	mock.lockGetDNSRecord.RUnlock()
+	return calls
+}
+
+// ListDNSRecords calls ListDNSRecordsFunc.
+func (mock *DNSAPIMock) ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+	if mock.ListDNSRecordsFunc == nil {
+		panic("DNSAPIMock.ListDNSRecordsFunc: method is nil but DNSAPI.ListDNSRecords was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListDNSRecords.Lock()
+	mock.calls.ListDNSRecords = append(mock.calls.ListDNSRecords, callInfo)
+	mock.lockListDNSRecords.Unlock()
+	return mock.ListDNSRecordsFunc(ctx, rc, params)
+}
+
+// ListDNSRecordsCalls gets all the calls that were made to ListDNSRecords.
+// Check the length with:
+//
+//	len(mockedDNSAPI.ListDNSRecordsCalls())
+func (mock *DNSAPIMock) ListDNSRecordsCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListDNSRecordsParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}
+	mock.lockListDNSRecords.RLock()
+	calls = mock.calls.ListDNSRecords
+	mock.lockListDNSRecords.RUnlock()
+	return calls
+}
+
+// UpdateDNSRecord calls UpdateDNSRecordFunc.
+func (mock *DNSAPIMock) UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	if mock.UpdateDNSRecordFunc == nil {
+		panic("DNSAPIMock.UpdateDNSRecordFunc: method is nil but DNSAPI.UpdateDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateDNSRecordParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockUpdateDNSRecord.Lock()
+	mock.calls.UpdateDNSRecord = append(mock.calls.UpdateDNSRecord, callInfo)
+	mock.lockUpdateDNSRecord.Unlock()
+	return mock.UpdateDNSRecordFunc(ctx, rc, params)
+}
+
+// UpdateDNSRecordCalls gets all the calls that were made to UpdateDNSRecord.
+// Check the length with:
+//
+//	len(mockedDNSAPI.UpdateDNSRecordCalls())
+func (mock *DNSAPIMock) UpdateDNSRecordCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.UpdateDNSRecordParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateDNSRecordParams
+	}
+	mock.lockUpdateDNSRecord.RLock()
+	calls = mock.calls.UpdateDNSRecord
+	mock.lockUpdateDNSRecord.RUnlock()
+	return calls
+}
@@ -0,0 +1,320 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"net/http"
+	"sync"
+)
+
+// Ensure, that R2APIMock does implement clients.R2API.
+// If this is not the case, regenerate this file with moq.
+var _ clients.R2API = &R2APIMock{}
+
+// R2APIMock is a mock implementation of clients.R2API.
+//
+//	func TestSomethingThatUsesR2API(t *testing.T) {
+//
+//		// make and configure a mocked clients.R2API
+//		mockedR2API := &R2APIMock{
+//			AccountsFunc: func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+//				panic("mock out the Accounts method")
+//			},
+//			CreateR2BucketFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateR2BucketParameters) (cloudflare.R2Bucket, error) {
+//				panic("mock out the CreateR2Bucket method")
+//			},
+//			DeleteR2BucketFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) error {
+//				panic("mock out the DeleteR2Bucket method")
+//			},
+//			ListR2BucketsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListR2BucketsParams) ([]cloudflare.R2Bucket, error) {
+//				panic("mock out the ListR2Buckets method")
+//			},
+//			RawFunc: func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+//				panic("mock out the Raw method")
+//			},
+//		}
+//
+//		// use mockedR2API in code that requires clients.R2API
+//		// and then make assertions.
+//
+//	}
+type R2APIMock struct {
+	// AccountsFunc mocks the Accounts method.
+	AccountsFunc func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error)
+
+	// CreateR2BucketFunc mocks the CreateR2Bucket method.
+	CreateR2BucketFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateR2BucketParameters) (cloudflare.R2Bucket, error)
+
+	// DeleteR2BucketFunc mocks the DeleteR2Bucket method.
+	DeleteR2BucketFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) error
+
+	// ListR2BucketsFunc mocks the ListR2Buckets method.
+	ListR2BucketsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListR2BucketsParams) ([]cloudflare.R2Bucket, error)
+
+	// RawFunc mocks the Raw method.
+	RawFunc func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Accounts holds details about calls to the Accounts method.
+		Accounts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params cloudflare.AccountsListParams
+		}
+		// CreateR2Bucket holds details about calls to the CreateR2Bucket method.
+		CreateR2Bucket []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateR2BucketParameters
+		}
+		// DeleteR2Bucket holds details about calls to the DeleteR2Bucket method.
+		DeleteR2Bucket []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// BucketName is the bucketName argument value.
+			BucketName string
+		}
+		// ListR2Buckets holds details about calls to the ListR2Buckets method.
+		ListR2Buckets []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListR2BucketsParams
+		}
+		// Raw holds details about calls to the Raw method.
+		Raw []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Method is the method argument value.
+			Method string
+			// Endpoint is the endpoint argument value.
+			Endpoint string
+			// Data is the data argument value.
+			Data interface{}
+			// Headers is the headers argument value.
+			Headers http.Header
+		}
+	}
+	lockAccounts       sync.RWMutex
+	lockCreateR2Bucket sync.RWMutex
+	lockDeleteR2Bucket sync.RWMutex
+	lockListR2Buckets  sync.RWMutex
+	lockRaw            sync.RWMutex
+}
+
+// Accounts calls AccountsFunc.
+func (mock *R2APIMock) Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+	if mock.AccountsFunc == nil {
+		panic("R2APIMock.AccountsFunc: method is nil but R2API.Accounts was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params cloudflare.AccountsListParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	}
+	mock.lockAccounts.Lock()
+	mock.calls.Accounts = append(mock.calls.Accounts, callInfo)
+	mock.lockAccounts.Unlock()
+	return mock.AccountsFunc(ctx, params)
+}
+
+// AccountsCalls gets all the calls that were made to Accounts.
+// Check the length with:
+//
+//	len(mockedR2API.AccountsCalls())
+func (mock *R2APIMock) AccountsCalls() []struct {
+	Ctx    context.Context
+	Params cloudflare.AccountsListParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params cloudflare.AccountsListParams
+	}
+	mock.lockAccounts.RLock()
+	calls = mock.calls.Accounts
+	mock.lockAccounts.RUnlock()
+	return calls
+}
+
+// CreateR2Bucket calls CreateR2BucketFunc.
+func (mock *R2APIMock) CreateR2Bucket(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateR2BucketParameters) (cloudflare.R2Bucket, error) {
+	if mock.CreateR2BucketFunc == nil {
+		panic("R2APIMock.CreateR2BucketFunc: method is nil but R2API.CreateR2Bucket was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateR2BucketParameters
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateR2Bucket.Lock()
+	mock.calls.CreateR2Bucket = append(mock.calls.CreateR2Bucket, callInfo)
+	mock.lockCreateR2Bucket.Unlock()
+	return mock.CreateR2BucketFunc(ctx, rc, params)
+}
+
+// CreateR2BucketCalls gets all the calls that were made to CreateR2Bucket.
+// Check the length with:
+//
+//	len(mockedR2API.CreateR2BucketCalls())
+func (mock *R2APIMock) CreateR2BucketCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateR2BucketParameters
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateR2BucketParameters
+	}
+	mock.lockCreateR2Bucket.RLock()
+	calls = mock.calls.CreateR2Bucket
+	mock.lockCreateR2Bucket.RUnlock()
+	return calls
+}
+
+// DeleteR2Bucket calls DeleteR2BucketFunc.
+func (mock *R2APIMock) DeleteR2Bucket(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) error {
+	if mock.DeleteR2BucketFunc == nil {
+		panic("R2APIMock.DeleteR2BucketFunc: method is nil but R2API.DeleteR2Bucket was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		BucketName string
+	}{
+		Ctx:        ctx,
+		Rc:         rc,
+		BucketName: bucketName,
+	}
+	mock.lockDeleteR2Bucket.Lock()
+	mock.calls.DeleteR2Bucket = append(mock.calls.DeleteR2Bucket, callInfo)
+	mock.lockDeleteR2Bucket.Unlock()
+	return mock.DeleteR2BucketFunc(ctx, rc, bucketName)
+}
+
+// DeleteR2BucketCalls gets all the calls that were made to DeleteR2Bucket.
+// Check the length with:
+//
+//	len(mockedR2API.DeleteR2BucketCalls())
+func (mock *R2APIMock) DeleteR2BucketCalls() []struct {
+	Ctx        context.Context
+	Rc         *cloudflare.ResourceContainer
+	BucketName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		BucketName string
+	}
+	mock.lockDeleteR2Bucket.RLock()
+	calls = mock.calls.DeleteR2Bucket
+	mock.lockDeleteR2Bucket.RUnlock()
+	return calls
+}
+
+// ListR2Buckets calls ListR2BucketsFunc.
+func (mock *R2APIMock) ListR2Buckets(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListR2BucketsParams) ([]cloudflare.R2Bucket, error) {
+	if mock.ListR2BucketsFunc == nil {
+		panic("R2APIMock.ListR2BucketsFunc: method is nil but R2API.ListR2Buckets was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListR2BucketsParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListR2Buckets.Lock()
+	mock.calls.ListR2Buckets = append(mock.calls.ListR2Buckets, callInfo)
+	mock.lockListR2Buckets.Unlock()
+	return mock.ListR2BucketsFunc(ctx, rc, params)
+}
+
+// ListR2BucketsCalls gets all the calls that were made to ListR2Buckets.
+// Check the length with:
+//
+//	len(mockedR2API.ListR2BucketsCalls())
+func (mock *R2APIMock) ListR2BucketsCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListR2BucketsParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListR2BucketsParams
+	}
+	mock.lockListR2Buckets.RLock()
+	calls = mock.calls.ListR2Buckets
+	mock.lockListR2Buckets.RUnlock()
+	return calls
+}
+
+// Raw calls RawFunc.
+func (mock *R2APIMock) Raw(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if mock.RawFunc == nil {
+		panic("R2APIMock.RawFunc: method is nil but R2API.Raw was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}{
+		Ctx:      ctx,
+		Method:   method,
+		Endpoint: endpoint,
+		Data:     data,
+		Headers:  headers,
+	}
+	mock.lockRaw.Lock()
+	mock.calls.Raw = append(mock.calls.Raw, callInfo)
+	mock.lockRaw.Unlock()
+	return mock.RawFunc(ctx, method, endpoint, data, headers)
+}
+
+// RawCalls gets all the calls that were made to Raw.
+// Check the length with:
+//
+//	len(mockedR2API.RawCalls())
+func (mock *R2APIMock) RawCalls() []struct {
+	Ctx      context.Context
+	Method   string
+	Endpoint string
+	Data     interface{}
+	Headers  http.Header
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}
+	mock.lockRaw.RLock()
+	calls = mock.calls.Raw
+	mock.lockRaw.RUnlock()
+	return calls
+}
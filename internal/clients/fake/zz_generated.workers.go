@@ -0,0 +1,1162 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"sync"
+)
+
+// Ensure, that WorkerScriptAPIMock does implement clients.WorkerScriptAPI.
+// If this is not the case, regenerate this file with moq.
+var _ clients.WorkerScriptAPI = &WorkerScriptAPIMock{}
+
+// WorkerScriptAPIMock is a mock implementation of clients.WorkerScriptAPI.
+//
+//	func TestSomethingThatUsesWorkerScriptAPI(t *testing.T) {
+//
+//		// make and configure a mocked clients.WorkerScriptAPI
+//		mockedWorkerScriptAPI := &WorkerScriptAPIMock{
+//			DeleteWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.DeleteWorkerParams) error {
+//				panic("mock out the DeleteWorker method")
+//			},
+//			GetAccountIDFunc: func() string {
+//				panic("mock out the GetAccountID method")
+//			},
+//			GetWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptResponse, error) {
+//				panic("mock out the GetWorker method")
+//			},
+//			GetWorkersScriptBindingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) ([]clients.WorkerScriptBinding, error) {
+//				panic("mock out the GetWorkersScriptBindings method")
+//			},
+//			GetWorkersScriptContentFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (string, error) {
+//				panic("mock out the GetWorkersScriptContent method")
+//			},
+//			GetWorkersScriptSettingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+//				panic("mock out the GetWorkersScriptSettings method")
+//			},
+//			ListWorkersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersParams) (cloudflare.WorkerListResponse, *cloudflare.ResultInfo, error) {
+//				panic("mock out the ListWorkers method")
+//			},
+//			UploadWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerParams) (cloudflare.WorkerScriptResponse, error) {
+//				panic("mock out the UploadWorker method")
+//			},
+//		}
+//
+//		// use mockedWorkerScriptAPI in code that requires clients.WorkerScriptAPI
+//		// and then make assertions.
+//
+//	}
+type WorkerScriptAPIMock struct {
+	// DeleteWorkerFunc mocks the DeleteWorker method.
+	DeleteWorkerFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.DeleteWorkerParams) error
+
+	// GetAccountIDFunc mocks the GetAccountID method.
+	GetAccountIDFunc func() string
+
+	// GetWorkerFunc mocks the GetWorker method.
+	GetWorkerFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptResponse, error)
+
+	// GetWorkersScriptBindingsFunc mocks the GetWorkersScriptBindings method.
+	GetWorkersScriptBindingsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) ([]clients.WorkerScriptBinding, error)
+
+	// GetWorkersScriptContentFunc mocks the GetWorkersScriptContent method.
+	GetWorkersScriptContentFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (string, error)
+
+	// GetWorkersScriptSettingsFunc mocks the GetWorkersScriptSettings method.
+	GetWorkersScriptSettingsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error)
+
+	// ListWorkersFunc mocks the ListWorkers method.
+	ListWorkersFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersParams) (cloudflare.WorkerListResponse, *cloudflare.ResultInfo, error)
+
+	// UploadWorkerFunc mocks the UploadWorker method.
+	UploadWorkerFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerParams) (cloudflare.WorkerScriptResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteWorker holds details about calls to the DeleteWorker method.
+		DeleteWorker []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.DeleteWorkerParams
+		}
+		// GetAccountID holds details about calls to the GetAccountID method.
+		GetAccountID []struct {
+		}
+		// GetWorker holds details about calls to the GetWorker method.
+		GetWorker []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ScriptName is the scriptName argument value.
+			ScriptName string
+		}
+		// GetWorkersScriptBindings holds details about calls to the GetWorkersScriptBindings method.
+		GetWorkersScriptBindings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ScriptName is the scriptName argument value.
+			ScriptName string
+		}
+		// GetWorkersScriptContent holds details about calls to the GetWorkersScriptContent method.
+		GetWorkersScriptContent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ScriptName is the scriptName argument value.
+			ScriptName string
+		}
+		// GetWorkersScriptSettings holds details about calls to the GetWorkersScriptSettings method.
+		GetWorkersScriptSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ScriptName is the scriptName argument value.
+			ScriptName string
+		}
+		// ListWorkers holds details about calls to the ListWorkers method.
+		ListWorkers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListWorkersParams
+		}
+		// UploadWorker holds details about calls to the UploadWorker method.
+		UploadWorker []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateWorkerParams
+		}
+	}
+	lockDeleteWorker             sync.RWMutex
+	lockGetAccountID             sync.RWMutex
+	lockGetWorker                sync.RWMutex
+	lockGetWorkersScriptBindings sync.RWMutex
+	lockGetWorkersScriptContent  sync.RWMutex
+	lockGetWorkersScriptSettings sync.RWMutex
+	lockListWorkers              sync.RWMutex
+	lockUploadWorker             sync.RWMutex
+}
+
+// DeleteWorker calls DeleteWorkerFunc.
+func (mock *WorkerScriptAPIMock) DeleteWorker(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.DeleteWorkerParams) error {
+	if mock.DeleteWorkerFunc == nil {
+		panic("WorkerScriptAPIMock.DeleteWorkerFunc: method is nil but WorkerScriptAPI.DeleteWorker was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.DeleteWorkerParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockDeleteWorker.Lock()
+	mock.calls.DeleteWorker = append(mock.calls.DeleteWorker, callInfo)
+	mock.lockDeleteWorker.Unlock()
+	return mock.DeleteWorkerFunc(ctx, rc, params)
+}
+
+// DeleteWorkerCalls gets all the calls that were made to DeleteWorker.
+// Check the length with:
+//
+//	len(mockedWorkerScriptAPI.DeleteWorkerCalls())
+func (mock *WorkerScriptAPIMock) DeleteWorkerCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.DeleteWorkerParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.DeleteWorkerParams
+	}
+	mock.lockDeleteWorker.RLock()
+	calls = mock.calls.DeleteWorker
+	mock.lockDeleteWorker.RUnlock()
+	return calls
+}
+
+// GetAccountID calls GetAccountIDFunc.
+func (mock *WorkerScriptAPIMock) GetAccountID() string {
+	if mock.GetAccountIDFunc == nil {
+		panic("WorkerScriptAPIMock.GetAccountIDFunc: method is nil but WorkerScriptAPI.GetAccountID was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetAccountID.Lock()
+	mock.calls.GetAccountID = append(mock.calls.GetAccountID, callInfo)
+	mock.lockGetAccountID.Unlock()
+	return mock.GetAccountIDFunc()
+}
+
+// GetAccountIDCalls gets all the calls that were made to GetAccountID.
+// Check the length with:
+//
+//	len(mockedWorkerScriptAPI.GetAccountIDCalls())
+func (mock *WorkerScriptAPIMock) GetAccountIDCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetAccountID.RLock()
+	calls = mock.calls.GetAccountID
+	mock.lockGetAccountID.RUnlock()
+	return calls
+}
+
+// GetWorker calls GetWorkerFunc.
+func (mock *WorkerScriptAPIMock) GetWorker(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptResponse, error) {
+	if mock.GetWorkerFunc == nil {
+		panic("WorkerScriptAPIMock.GetWorkerFunc: method is nil but WorkerScriptAPI.GetWorker was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}{
+		Ctx:        ctx,
+		Rc:         rc,
+		ScriptName: scriptName,
+	}
+	mock.lockGetWorker.Lock()
+	mock.calls.GetWorker = append(mock.calls.GetWorker, callInfo)
+	mock.lockGetWorker.Unlock()
+	return mock.GetWorkerFunc(ctx, rc, scriptName)
+}
+
+// GetWorkerCalls gets all the calls that were made to GetWorker.
+// Check the length with:
+//
+//	len(mockedWorkerScriptAPI.GetWorkerCalls())
+func (mock *WorkerScriptAPIMock) GetWorkerCalls() []struct {
+	Ctx        context.Context
+	Rc         *cloudflare.ResourceContainer
+	ScriptName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}
+	mock.lockGetWorker.RLock()
+	calls = mock.calls.GetWorker
+	mock.lockGetWorker.RUnlock()
+	return calls
+}
+
+// GetWorkersScriptBindings calls GetWorkersScriptBindingsFunc.
+func (mock *WorkerScriptAPIMock) GetWorkersScriptBindings(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) ([]clients.WorkerScriptBinding, error) {
+	if mock.GetWorkersScriptBindingsFunc == nil {
+		panic("WorkerScriptAPIMock.GetWorkersScriptBindingsFunc: method is nil but WorkerScriptAPI.GetWorkersScriptBindings was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}{
+		Ctx:        ctx,
+		Rc:         rc,
+		ScriptName: scriptName,
+	}
+	mock.lockGetWorkersScriptBindings.Lock()
+	mock.calls.GetWorkersScriptBindings = append(mock.calls.GetWorkersScriptBindings, callInfo)
+	mock.lockGetWorkersScriptBindings.Unlock()
+	return mock.GetWorkersScriptBindingsFunc(ctx, rc, scriptName)
+}
+
+// GetWorkersScriptBindingsCalls gets all the calls that were made to GetWorkersScriptBindings.
+// Check the length with:
+//
+//	len(mockedWorkerScriptAPI.GetWorkersScriptBindingsCalls())
+func (mock *WorkerScriptAPIMock) GetWorkersScriptBindingsCalls() []struct {
+	Ctx        context.Context
+	Rc         *cloudflare.ResourceContainer
+	ScriptName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}
+	mock.lockGetWorkersScriptBindings.RLock()
+	calls = mock.calls.GetWorkersScriptBindings
+	mock.lockGetWorkersScriptBindings.RUnlock()
+	return calls
+}
+
+// GetWorkersScriptContent calls GetWorkersScriptContentFunc.
+func (mock *WorkerScriptAPIMock) GetWorkersScriptContent(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (string, error) {
+	if mock.GetWorkersScriptContentFunc == nil {
+		panic("WorkerScriptAPIMock.GetWorkersScriptContentFunc: method is nil but WorkerScriptAPI.GetWorkersScriptContent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}{
+		Ctx:        ctx,
+		Rc:         rc,
+		ScriptName: scriptName,
+	}
+	mock.lockGetWorkersScriptContent.Lock()
+	mock.calls.GetWorkersScriptContent = append(mock.calls.GetWorkersScriptContent, callInfo)
+	mock.lockGetWorkersScriptContent.Unlock()
+	return mock.GetWorkersScriptContentFunc(ctx, rc, scriptName)
+}
+
+// GetWorkersScriptContentCalls gets all the calls that were made to GetWorkersScriptContent.
+// Check the length with:
+//
+//	len(mockedWorkerScriptAPI.GetWorkersScriptContentCalls())
+func (mock *WorkerScriptAPIMock) GetWorkersScriptContentCalls() []struct {
+	Ctx        context.Context
+	Rc         *cloudflare.ResourceContainer
+	ScriptName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}
+	mock.lockGetWorkersScriptContent.RLock()
+	calls = mock.calls.GetWorkersScriptContent
+	mock.lockGetWorkersScriptContent.RUnlock()
+	return calls
+}
+
+// GetWorkersScriptSettings calls GetWorkersScriptSettingsFunc.
+func (mock *WorkerScriptAPIMock) GetWorkersScriptSettings(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+	if mock.GetWorkersScriptSettingsFunc == nil {
+		panic("WorkerScriptAPIMock.GetWorkersScriptSettingsFunc: method is nil but WorkerScriptAPI.GetWorkersScriptSettings was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}{
+		Ctx:        ctx,
+		Rc:         rc,
+		ScriptName: scriptName,
+	}
+	mock.lockGetWorkersScriptSettings.Lock()
+	mock.calls.GetWorkersScriptSettings = append(mock.calls.GetWorkersScriptSettings, callInfo)
+	mock.lockGetWorkersScriptSettings.Unlock()
+	return mock.GetWorkersScriptSettingsFunc(ctx, rc, scriptName)
+}
+
+// GetWorkersScriptSettingsCalls gets all the calls that were made to GetWorkersScriptSettings.
+// Check the length with:
+//
+//	len(mockedWorkerScriptAPI.GetWorkersScriptSettingsCalls())
+func (mock *WorkerScriptAPIMock) GetWorkersScriptSettingsCalls() []struct {
+	Ctx        context.Context
+	Rc         *cloudflare.ResourceContainer
+	ScriptName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}
+	mock.lockGetWorkersScriptSettings.RLock()
+	calls = mock.calls.GetWorkersScriptSettings
+	mock.lockGetWorkersScriptSettings.RUnlock()
+	return calls
+}
+
+// ListWorkers calls ListWorkersFunc.
+func (mock *WorkerScriptAPIMock) ListWorkers(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersParams) (cloudflare.WorkerListResponse, *cloudflare.ResultInfo, error) {
+	if mock.ListWorkersFunc == nil {
+		panic("WorkerScriptAPIMock.ListWorkersFunc: method is nil but WorkerScriptAPI.ListWorkers was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListWorkersParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListWorkers.Lock()
+	mock.calls.ListWorkers = append(mock.calls.ListWorkers, callInfo)
+	mock.lockListWorkers.Unlock()
+	return mock.ListWorkersFunc(ctx, rc, params)
+}
+
+// ListWorkersCalls gets all the calls that were made to ListWorkers.
+// Check the length with:
+//
+//	len(mockedWorkerScriptAPI.ListWorkersCalls())
+func (mock *WorkerScriptAPIMock) ListWorkersCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListWorkersParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListWorkersParams
+	}
+	mock.lockListWorkers.RLock()
+	calls = mock.calls.ListWorkers
+	mock.lockListWorkers.RUnlock()
+	return calls
+}
+
+// UploadWorker calls UploadWorkerFunc.
+func (mock *WorkerScriptAPIMock) UploadWorker(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerParams) (cloudflare.WorkerScriptResponse, error) {
+	if mock.UploadWorkerFunc == nil {
+		panic("WorkerScriptAPIMock.UploadWorkerFunc: method is nil but WorkerScriptAPI.UploadWorker was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateWorkerParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockUploadWorker.Lock()
+	mock.calls.UploadWorker = append(mock.calls.UploadWorker, callInfo)
+	mock.lockUploadWorker.Unlock()
+	return mock.UploadWorkerFunc(ctx, rc, params)
+}
+
+// UploadWorkerCalls gets all the calls that were made to UploadWorker.
+// Check the length with:
+//
+//	len(mockedWorkerScriptAPI.UploadWorkerCalls())
+func (mock *WorkerScriptAPIMock) UploadWorkerCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateWorkerParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateWorkerParams
+	}
+	mock.lockUploadWorker.RLock()
+	calls = mock.calls.UploadWorker
+	mock.lockUploadWorker.RUnlock()
+	return calls
+}
+
+// Ensure, that WorkersKVAPIMock does implement clients.WorkersKVAPI.
+// If this is not the case, regenerate this file with moq.
+var _ clients.WorkersKVAPI = &WorkersKVAPIMock{}
+
+// WorkersKVAPIMock is a mock implementation of clients.WorkersKVAPI.
+//
+//	func TestSomethingThatUsesWorkersKVAPI(t *testing.T) {
+//
+//		// make and configure a mocked clients.WorkersKVAPI
+//		mockedWorkersKVAPI := &WorkersKVAPIMock{
+//			CreateWorkersKVNamespaceFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkersKVNamespaceParams) (cloudflare.WorkersKVNamespaceResponse, error) {
+//				panic("mock out the CreateWorkersKVNamespace method")
+//			},
+//			DeleteWorkersKVNamespaceFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, namespaceID string) (cloudflare.Response, error) {
+//				panic("mock out the DeleteWorkersKVNamespace method")
+//			},
+//			GetAccountIDFunc: func() string {
+//				panic("mock out the GetAccountID method")
+//			},
+//			ListWorkersKVNamespacesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVNamespacesParams) ([]cloudflare.WorkersKVNamespace, *cloudflare.ResultInfo, error) {
+//				panic("mock out the ListWorkersKVNamespaces method")
+//			},
+//			UpdateWorkersKVNamespaceFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkersKVNamespaceParams) (cloudflare.Response, error) {
+//				panic("mock out the UpdateWorkersKVNamespace method")
+//			},
+//		}
+//
+//		// use mockedWorkersKVAPI in code that requires clients.WorkersKVAPI
+//		// and then make assertions.
+//
+//	}
+type WorkersKVAPIMock struct {
+	// CreateWorkersKVNamespaceFunc mocks the CreateWorkersKVNamespace method.
+	CreateWorkersKVNamespaceFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkersKVNamespaceParams) (cloudflare.WorkersKVNamespaceResponse, error)
+
+	// DeleteWorkersKVNamespaceFunc mocks the DeleteWorkersKVNamespace method.
+	DeleteWorkersKVNamespaceFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, namespaceID string) (cloudflare.Response, error)
+
+	// GetAccountIDFunc mocks the GetAccountID method.
+	GetAccountIDFunc func() string
+
+	// ListWorkersKVNamespacesFunc mocks the ListWorkersKVNamespaces method.
+	ListWorkersKVNamespacesFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVNamespacesParams) ([]cloudflare.WorkersKVNamespace, *cloudflare.ResultInfo, error)
+
+	// UpdateWorkersKVNamespaceFunc mocks the UpdateWorkersKVNamespace method.
+	UpdateWorkersKVNamespaceFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkersKVNamespaceParams) (cloudflare.Response, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateWorkersKVNamespace holds details about calls to the CreateWorkersKVNamespace method.
+		CreateWorkersKVNamespace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateWorkersKVNamespaceParams
+		}
+		// DeleteWorkersKVNamespace holds details about calls to the DeleteWorkersKVNamespace method.
+		DeleteWorkersKVNamespace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// NamespaceID is the namespaceID argument value.
+			NamespaceID string
+		}
+		// GetAccountID holds details about calls to the GetAccountID method.
+		GetAccountID []struct {
+		}
+		// ListWorkersKVNamespaces holds details about calls to the ListWorkersKVNamespaces method.
+		ListWorkersKVNamespaces []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListWorkersKVNamespacesParams
+		}
+		// UpdateWorkersKVNamespace holds details about calls to the UpdateWorkersKVNamespace method.
+		UpdateWorkersKVNamespace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.UpdateWorkersKVNamespaceParams
+		}
+	}
+	lockCreateWorkersKVNamespace sync.RWMutex
+	lockDeleteWorkersKVNamespace sync.RWMutex
+	lockGetAccountID             sync.RWMutex
+	lockListWorkersKVNamespaces  sync.RWMutex
+	lockUpdateWorkersKVNamespace sync.RWMutex
+}
+
+// CreateWorkersKVNamespace calls CreateWorkersKVNamespaceFunc.
+func (mock *WorkersKVAPIMock) CreateWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkersKVNamespaceParams) (cloudflare.WorkersKVNamespaceResponse, error) {
+	if mock.CreateWorkersKVNamespaceFunc == nil {
+		panic("WorkersKVAPIMock.CreateWorkersKVNamespaceFunc: method is nil but WorkersKVAPI.CreateWorkersKVNamespace was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateWorkersKVNamespaceParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateWorkersKVNamespace.Lock()
+	mock.calls.CreateWorkersKVNamespace = append(mock.calls.CreateWorkersKVNamespace, callInfo)
+	mock.lockCreateWorkersKVNamespace.Unlock()
+	return mock.CreateWorkersKVNamespaceFunc(ctx, rc, params)
+}
+
+// CreateWorkersKVNamespaceCalls gets all the calls that were made to CreateWorkersKVNamespace.
+// Check the length with:
+//
+//	len(mockedWorkersKVAPI.CreateWorkersKVNamespaceCalls())
+func (mock *WorkersKVAPIMock) CreateWorkersKVNamespaceCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateWorkersKVNamespaceParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateWorkersKVNamespaceParams
+	}
+	mock.lockCreateWorkersKVNamespace.RLock()
+	calls = mock.calls.CreateWorkersKVNamespace
+	mock.lockCreateWorkersKVNamespace.RUnlock()
+	return calls
+}
+
+// DeleteWorkersKVNamespace calls DeleteWorkersKVNamespaceFunc.
+func (mock *WorkersKVAPIMock) DeleteWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, namespaceID string) (cloudflare.Response, error) {
+	if mock.DeleteWorkersKVNamespaceFunc == nil {
+		panic("WorkersKVAPIMock.DeleteWorkersKVNamespaceFunc: method is nil but WorkersKVAPI.DeleteWorkersKVNamespace was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Rc          *cloudflare.ResourceContainer
+		NamespaceID string
+	}{
+		Ctx:         ctx,
+		Rc:          rc,
+		NamespaceID: namespaceID,
+	}
+	mock.lockDeleteWorkersKVNamespace.Lock()
+	mock.calls.DeleteWorkersKVNamespace = append(mock.calls.DeleteWorkersKVNamespace, callInfo)
+	mock.lockDeleteWorkersKVNamespace.Unlock()
+	return mock.DeleteWorkersKVNamespaceFunc(ctx, rc, namespaceID)
+}
+
+// DeleteWorkersKVNamespaceCalls gets all the calls that were made to DeleteWorkersKVNamespace.
+// Check the length with:
+//
+//	len(mockedWorkersKVAPI.DeleteWorkersKVNamespaceCalls())
+func (mock *WorkersKVAPIMock) DeleteWorkersKVNamespaceCalls() []struct {
+	Ctx         context.Context
+	Rc          *cloudflare.ResourceContainer
+	NamespaceID string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Rc          *cloudflare.ResourceContainer
+		NamespaceID string
+	}
+	mock.lockDeleteWorkersKVNamespace.RLock()
+	calls = mock.calls.DeleteWorkersKVNamespace
+	mock.lockDeleteWorkersKVNamespace.RUnlock()
+	return calls
+}
+
+// GetAccountID calls GetAccountIDFunc.
+func (mock *WorkersKVAPIMock) GetAccountID() string {
+	if mock.GetAccountIDFunc == nil {
+		panic("WorkersKVAPIMock.GetAccountIDFunc: method is nil but WorkersKVAPI.GetAccountID was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetAccountID.Lock()
+	mock.calls.GetAccountID = append(mock.calls.GetAccountID, callInfo)
+	mock.lockGetAccountID.Unlock()
+	return mock.GetAccountIDFunc()
+}
+
+// GetAccountIDCalls gets all the calls that were made to GetAccountID.
+// Check the length with:
+//
+//	len(mockedWorkersKVAPI.GetAccountIDCalls())
+func (mock *WorkersKVAPIMock) GetAccountIDCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetAccountID.RLock()
+	calls = mock.calls.GetAccountID
+	mock.lockGetAccountID.RUnlock()
+	return calls
+}
+
+// ListWorkersKVNamespaces calls ListWorkersKVNamespacesFunc.
+func (mock *WorkersKVAPIMock) ListWorkersKVNamespaces(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVNamespacesParams) ([]cloudflare.WorkersKVNamespace, *cloudflare.ResultInfo, error) {
+	if mock.ListWorkersKVNamespacesFunc == nil {
+		panic("WorkersKVAPIMock.ListWorkersKVNamespacesFunc: method is nil but WorkersKVAPI.ListWorkersKVNamespaces was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListWorkersKVNamespacesParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListWorkersKVNamespaces.Lock()
+	mock.calls.ListWorkersKVNamespaces = append(mock.calls.ListWorkersKVNamespaces, callInfo)
+	mock.lockListWorkersKVNamespaces.Unlock()
+	return mock.ListWorkersKVNamespacesFunc(ctx, rc, params)
+}
+
+// ListWorkersKVNamespacesCalls gets all the calls that were made to ListWorkersKVNamespaces.
+// Check the length with:
+//
+//	len(mockedWorkersKVAPI.ListWorkersKVNamespacesCalls())
+func (mock *WorkersKVAPIMock) ListWorkersKVNamespacesCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListWorkersKVNamespacesParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListWorkersKVNamespacesParams
+	}
+	mock.lockListWorkersKVNamespaces.RLock()
+	calls = mock.calls.ListWorkersKVNamespaces
+	mock.lockListWorkersKVNamespaces.RUnlock()
+	return calls
+}
+
+// UpdateWorkersKVNamespace calls UpdateWorkersKVNamespaceFunc.
+func (mock *WorkersKVAPIMock) UpdateWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkersKVNamespaceParams) (cloudflare.Response, error) {
+	if mock.UpdateWorkersKVNamespaceFunc == nil {
+		panic("WorkersKVAPIMock.UpdateWorkersKVNamespaceFunc: method is nil but WorkersKVAPI.UpdateWorkersKVNamespace was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateWorkersKVNamespaceParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockUpdateWorkersKVNamespace.Lock()
+	mock.calls.UpdateWorkersKVNamespace = append(mock.calls.UpdateWorkersKVNamespace, callInfo)
+	mock.lockUpdateWorkersKVNamespace.Unlock()
+	return mock.UpdateWorkersKVNamespaceFunc(ctx, rc, params)
+}
+
+// UpdateWorkersKVNamespaceCalls gets all the calls that were made to UpdateWorkersKVNamespace.
+// Check the length with:
+//
+//	len(mockedWorkersKVAPI.UpdateWorkersKVNamespaceCalls())
+func (mock *WorkersKVAPIMock) UpdateWorkersKVNamespaceCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.UpdateWorkersKVNamespaceParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateWorkersKVNamespaceParams
+	}
+	mock.lockUpdateWorkersKVNamespace.RLock()
+	calls = mock.calls.UpdateWorkersKVNamespace
+	mock.lockUpdateWorkersKVNamespace.RUnlock()
+	return calls
+}
+
+// Ensure, that WorkerCronTriggerAPIMock does implement clients.WorkerCronTriggerAPI.
+// If this is not the case, regenerate this file with moq.
+var _ clients.WorkerCronTriggerAPI = &WorkerCronTriggerAPIMock{}
+
+// WorkerCronTriggerAPIMock is a mock implementation of clients.WorkerCronTriggerAPI.
+//
+//	func TestSomethingThatUsesWorkerCronTriggerAPI(t *testing.T) {
+//
+//		// make and configure a mocked clients.WorkerCronTriggerAPI
+//		mockedWorkerCronTriggerAPI := &WorkerCronTriggerAPIMock{
+//			GetAccountIDFunc: func() string {
+//				panic("mock out the GetAccountID method")
+//			},
+//			ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+//				panic("mock out the ListWorkerCronTriggers method")
+//			},
+//			UpdateWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+//				panic("mock out the UpdateWorkerCronTriggers method")
+//			},
+//		}
+//
+//		// use mockedWorkerCronTriggerAPI in code that requires clients.WorkerCronTriggerAPI
+//		// and then make assertions.
+//
+//	}
+type WorkerCronTriggerAPIMock struct {
+	// GetAccountIDFunc mocks the GetAccountID method.
+	GetAccountIDFunc func() string
+
+	// ListWorkerCronTriggersFunc mocks the ListWorkerCronTriggers method.
+	ListWorkerCronTriggersFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error)
+
+	// UpdateWorkerCronTriggersFunc mocks the UpdateWorkerCronTriggers method.
+	UpdateWorkerCronTriggersFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetAccountID holds details about calls to the GetAccountID method.
+		GetAccountID []struct {
+		}
+		// ListWorkerCronTriggers holds details about calls to the ListWorkerCronTriggers method.
+		ListWorkerCronTriggers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListWorkerCronTriggersParams
+		}
+		// UpdateWorkerCronTriggers holds details about calls to the UpdateWorkerCronTriggers method.
+		UpdateWorkerCronTriggers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.UpdateWorkerCronTriggersParams
+		}
+	}
+	lockGetAccountID             sync.RWMutex
+	lockListWorkerCronTriggers   sync.RWMutex
+	lockUpdateWorkerCronTriggers sync.RWMutex
+}
+
+// GetAccountID calls GetAccountIDFunc.
+func (mock *WorkerCronTriggerAPIMock) GetAccountID() string {
+	if mock.GetAccountIDFunc == nil {
+		panic("WorkerCronTriggerAPIMock.GetAccountIDFunc: method is nil but WorkerCronTriggerAPI.GetAccountID was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetAccountID.Lock()
+	mock.calls.GetAccountID = append(mock.calls.GetAccountID, callInfo)
+	mock.lockGetAccountID.Unlock()
+	return mock.GetAccountIDFunc()
+}
+
+// GetAccountIDCalls gets all the calls that were made to GetAccountID.
+// Check the length with:
+//
+//	len(mockedWorkerCronTriggerAPI.GetAccountIDCalls())
+func (mock *WorkerCronTriggerAPIMock) GetAccountIDCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetAccountID.RLock()
+	calls = mock.calls.GetAccountID
+	mock.lockGetAccountID.RUnlock()
+	return calls
+}
+
+// ListWorkerCronTriggers calls ListWorkerCronTriggersFunc.
+func (mock *WorkerCronTriggerAPIMock) ListWorkerCronTriggers(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+	if mock.ListWorkerCronTriggersFunc == nil {
+		panic("WorkerCronTriggerAPIMock.ListWorkerCronTriggersFunc: method is nil but WorkerCronTriggerAPI.ListWorkerCronTriggers was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListWorkerCronTriggersParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListWorkerCronTriggers.Lock()
+	mock.calls.ListWorkerCronTriggers = append(mock.calls.ListWorkerCronTriggers, callInfo)
+	mock.lockListWorkerCronTriggers.Unlock()
+	return mock.ListWorkerCronTriggersFunc(ctx, rc, params)
+}
+
+// ListWorkerCronTriggersCalls gets all the calls that were made to ListWorkerCronTriggers.
+// Check the length with:
+//
+//	len(mockedWorkerCronTriggerAPI.ListWorkerCronTriggersCalls())
+func (mock *WorkerCronTriggerAPIMock) ListWorkerCronTriggersCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListWorkerCronTriggersParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListWorkerCronTriggersParams
+	}
+	mock.lockListWorkerCronTriggers.RLock()
+	calls = mock.calls.ListWorkerCronTriggers
+	mock.lockListWorkerCronTriggers.RUnlock()
+	return calls
+}
+
+// UpdateWorkerCronTriggers calls UpdateWorkerCronTriggersFunc.
+func (mock *WorkerCronTriggerAPIMock) UpdateWorkerCronTriggers(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+	if mock.UpdateWorkerCronTriggersFunc == nil {
+		panic("WorkerCronTriggerAPIMock.UpdateWorkerCronTriggersFunc: method is nil but WorkerCronTriggerAPI.UpdateWorkerCronTriggers was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateWorkerCronTriggersParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockUpdateWorkerCronTriggers.Lock()
+	mock.calls.UpdateWorkerCronTriggers = append(mock.calls.UpdateWorkerCronTriggers, callInfo)
+	mock.lockUpdateWorkerCronTriggers.Unlock()
+	return mock.UpdateWorkerCronTriggersFunc(ctx, rc, params)
+}
+
+// UpdateWorkerCronTriggersCalls gets all the calls that were made to UpdateWorkerCronTriggers.
+// Check the length with:
+//
+//	len(mockedWorkerCronTriggerAPI.UpdateWorkerCronTriggersCalls())
+func (mock *WorkerCronTriggerAPIMock) UpdateWorkerCronTriggersCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.UpdateWorkerCronTriggersParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateWorkerCronTriggersParams
+	}
+	mock.lockUpdateWorkerCronTriggers.RLock()
+	calls = mock.calls.UpdateWorkerCronTriggers
+	mock.lockUpdateWorkerCronTriggers.RUnlock()
+	return calls
+}
+
+// Ensure, that WorkerRouteAPIMock does implement clients.WorkerRouteAPI.
+// If this is not the case, regenerate this file with moq.
+var _ clients.WorkerRouteAPI = &WorkerRouteAPIMock{}
+
+// WorkerRouteAPIMock is a mock implementation of clients.WorkerRouteAPI.
+//
+//	func TestSomethingThatUsesWorkerRouteAPI(t *testing.T) {
+//
+//		// make and configure a mocked clients.WorkerRouteAPI
+//		mockedWorkerRouteAPI := &WorkerRouteAPIMock{
+//			CreateWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error) {
+//				panic("mock out the CreateWorkerRoute method")
+//			},
+//			DeleteWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, routeID string) (cloudflare.WorkerRouteResponse, error) {
+//				panic("mock out the DeleteWorkerRoute method")
+//			},
+//			ListWorkerRoutesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+//				panic("mock out the ListWorkerRoutes method")
+//			},
+//			UpdateWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error) {
+//				panic("mock out the UpdateWorkerRoute method")
+//			},
+//		}
+//
+//		// use mockedWorkerRouteAPI in code that requires clients.WorkerRouteAPI
+//		// and then make assertions.
+//
+//	}
+type WorkerRouteAPIMock struct {
+	// CreateWorkerRouteFunc mocks the CreateWorkerRoute method.
+	CreateWorkerRouteFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error)
+
+	// DeleteWorkerRouteFunc mocks the DeleteWorkerRoute method.
+	DeleteWorkerRouteFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, routeID string) (cloudflare.WorkerRouteResponse, error)
+
+	// ListWorkerRoutesFunc mocks the ListWorkerRoutes method.
+	ListWorkerRoutesFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error)
+
+	// UpdateWorkerRouteFunc mocks the UpdateWorkerRoute method.
+	UpdateWorkerRouteFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateWorkerRoute holds details about calls to the CreateWorkerRoute method.
+		CreateWorkerRoute []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateWorkerRouteParams
+		}
+		// DeleteWorkerRoute holds details about calls to the DeleteWorkerRoute method.
+		DeleteWorkerRoute []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// RouteID is the routeID argument value.
+			RouteID string
+		}
+		// ListWorkerRoutes holds details about calls to the ListWorkerRoutes method.
+		ListWorkerRoutes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListWorkerRoutesParams
+		}
+		// UpdateWorkerRoute holds details about calls to the UpdateWorkerRoute method.
+		UpdateWorkerRoute []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.UpdateWorkerRouteParams
+		}
+	}
+	lockCreateWorkerRoute sync.RWMutex
+	lockDeleteWorkerRoute sync.RWMutex
+	lockListWorkerRoutes  sync.RWMutex
+	lockUpdateWorkerRoute sync.RWMutex
+}
+
+// CreateWorkerRoute calls CreateWorkerRouteFunc.
+func (mock *WorkerRouteAPIMock) CreateWorkerRoute(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error) {
+	if mock.CreateWorkerRouteFunc == nil {
+		panic("WorkerRouteAPIMock.CreateWorkerRouteFunc: method is nil but WorkerRouteAPI.CreateWorkerRoute was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateWorkerRouteParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateWorkerRoute.Lock()
+	mock.calls.CreateWorkerRoute = append(mock.calls.CreateWorkerRoute, callInfo)
+	mock.lockCreateWorkerRoute.Unlock()
+	return mock.CreateWorkerRouteFunc(ctx, rc, params)
+}
+
+// CreateWorkerRouteCalls gets all the calls that were made to CreateWorkerRoute.
+// Check the length with:
+//
+//	len(mockedWorkerRouteAPI.CreateWorkerRouteCalls())
+func (mock *WorkerRouteAPIMock) CreateWorkerRouteCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateWorkerRouteParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateWorkerRouteParams
+	}
+	mock.lockCreateWorkerRoute.RLock()
+	calls = mock.calls.CreateWorkerRoute
+	mock.lockCreateWorkerRoute.RUnlock()
+	return calls
+}
+
+// DeleteWorkerRoute calls DeleteWorkerRouteFunc.
+func (mock *WorkerRouteAPIMock) DeleteWorkerRoute(ctx context.Context, rc *cloudflare.ResourceContainer, routeID string) (cloudflare.WorkerRouteResponse, error) {
+	if mock.DeleteWorkerRouteFunc == nil {
+		panic("WorkerRouteAPIMock.DeleteWorkerRouteFunc: method is nil but WorkerRouteAPI.DeleteWorkerRoute was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Rc      *cloudflare.ResourceContainer
+		RouteID string
+	}{
+		Ctx:     ctx,
+		Rc:      rc,
+		RouteID: routeID,
+	}
+	mock.lockDeleteWorkerRoute.Lock()
+	mock.calls.DeleteWorkerRoute = append(mock.calls.DeleteWorkerRoute, callInfo)
+	mock.lockDeleteWorkerRoute.Unlock()
+	return mock.DeleteWorkerRouteFunc(ctx, rc, routeID)
+}
+
+// DeleteWorkerRouteCalls gets all the calls that were made to DeleteWorkerRoute.
+// Check the length with:
+//
+//	len(mockedWorkerRouteAPI.DeleteWorkerRouteCalls())
+func (mock *WorkerRouteAPIMock) DeleteWorkerRouteCalls() []struct {
+	Ctx     context.Context
+	Rc      *cloudflare.ResourceContainer
+	RouteID string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Rc      *cloudflare.ResourceContainer
+		RouteID string
+	}
+	mock.lockDeleteWorkerRoute.RLock()
+	calls = mock.calls.DeleteWorkerRoute
+	mock.lockDeleteWorkerRoute.RUnlock()
+	return calls
+}
+
+// ListWorkerRoutes calls ListWorkerRoutesFunc.
+func (mock *WorkerRouteAPIMock) ListWorkerRoutes(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+	if mock.ListWorkerRoutesFunc == nil {
+		panic("WorkerRouteAPIMock.ListWorkerRoutesFunc: method is nil but WorkerRouteAPI.ListWorkerRoutes was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListWorkerRoutesParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListWorkerRoutes.Lock()
+	mock.calls.ListWorkerRoutes = append(mock.calls.ListWorkerRoutes, callInfo)
+	mock.lockListWorkerRoutes.Unlock()
+	return mock.ListWorkerRoutesFunc(ctx, rc, params)
+}
+
+// ListWorkerRoutesCalls gets all the calls that were made to ListWorkerRoutes.
+// Check the length with:
+//
+//	len(mockedWorkerRouteAPI.ListWorkerRoutesCalls())
+func (mock *WorkerRouteAPIMock) ListWorkerRoutesCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListWorkerRoutesParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListWorkerRoutesParams
+	}
+	mock.lockListWorkerRoutes.RLock()
+	calls = mock.calls.ListWorkerRoutes
+	mock.lockListWorkerRoutes.RUnlock()
+	return calls
+}
+
+// UpdateWorkerRoute calls UpdateWorkerRouteFunc.
+func (mock *WorkerRouteAPIMock) UpdateWorkerRoute(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error) {
+	if mock.UpdateWorkerRouteFunc == nil {
+		panic("WorkerRouteAPIMock.UpdateWorkerRouteFunc: method is nil but WorkerRouteAPI.UpdateWorkerRoute was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateWorkerRouteParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockUpdateWorkerRoute.Lock()
+	mock.calls.UpdateWorkerRoute = append(mock.calls.UpdateWorkerRoute, callInfo)
+	mock.lockUpdateWorkerRoute.Unlock()
+	return mock.UpdateWorkerRouteFunc(ctx, rc, params)
+}
+
+// UpdateWorkerRouteCalls gets all the calls that were made to UpdateWorkerRoute.
+// Check the length with:
+//
+//	len(mockedWorkerRouteAPI.UpdateWorkerRouteCalls())
+func (mock *WorkerRouteAPIMock) UpdateWorkerRouteCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.UpdateWorkerRouteParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateWorkerRouteParams
+	}
+	mock.lockUpdateWorkerRoute.RLock()
+	calls = mock.calls.UpdateWorkerRoute
+	mock.lockUpdateWorkerRoute.RUnlock()
+	return calls
+}
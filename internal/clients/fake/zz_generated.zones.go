@@ -0,0 +1,716 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"net/http"
+	"sync"
+)
+
+// Ensure, that ZoneSettingsAPIMock does implement clients.ZoneSettingsAPI.
+// If this is not the case, regenerate this file with moq.
+var _ clients.ZoneSettingsAPI = &ZoneSettingsAPIMock{}
+
+// ZoneSettingsAPIMock is a mock implementation of clients.ZoneSettingsAPI.
+//
+//	func TestSomethingThatUsesZoneSettingsAPI(t *testing.T) {
+//
+//		// make and configure a mocked clients.ZoneSettingsAPI
+//		mockedZoneSettingsAPI := &ZoneSettingsAPIMock{
+//			UpdateZoneSettingsFunc: func(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error) {
+//				panic("mock out the UpdateZoneSettings method")
+//			},
+//			ZoneSettingsFunc: func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+//				panic("mock out the ZoneSettings method")
+//			},
+//		}
+//
+//		// use mockedZoneSettingsAPI in code that requires clients.ZoneSettingsAPI
+//		// and then make assertions.
+//
+//	}
+type ZoneSettingsAPIMock struct {
+	// UpdateZoneSettingsFunc mocks the UpdateZoneSettings method.
+	UpdateZoneSettingsFunc func(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error)
+
+	// ZoneSettingsFunc mocks the ZoneSettings method.
+	ZoneSettingsFunc func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// UpdateZoneSettings holds details about calls to the UpdateZoneSettings method.
+		UpdateZoneSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+			// Cs is the cs argument value.
+			Cs []cloudflare.ZoneSetting
+		}
+		// ZoneSettings holds details about calls to the ZoneSettings method.
+		ZoneSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+		}
+	}
+	lockUpdateZoneSettings sync.RWMutex
+	lockZoneSettings       sync.RWMutex
+}
+
+// UpdateZoneSettings calls UpdateZoneSettingsFunc.
+func (mock *ZoneSettingsAPIMock) UpdateZoneSettings(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error) {
+	if mock.UpdateZoneSettingsFunc == nil {
+		panic("ZoneSettingsAPIMock.UpdateZoneSettingsFunc: method is nil but ZoneSettingsAPI.UpdateZoneSettings was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneID string
+		Cs     []cloudflare.ZoneSetting
+	}{
+		Ctx:    ctx,
+		ZoneID: zoneID,
+		Cs:     cs,
+	}
+	mock.lockUpdateZoneSettings.Lock()
+	mock.calls.UpdateZoneSettings = append(mock.calls.UpdateZoneSettings, callInfo)
+	mock.lockUpdateZoneSettings.Unlock()
+	return mock.UpdateZoneSettingsFunc(ctx, zoneID, cs)
+}
+
+// UpdateZoneSettingsCalls gets all the calls that were made to UpdateZoneSettings.
+// Check the length with:
+//
+//	len(mockedZoneSettingsAPI.UpdateZoneSettingsCalls())
+func (mock *ZoneSettingsAPIMock) UpdateZoneSettingsCalls() []struct {
+	Ctx    context.Context
+	ZoneID string
+	Cs     []cloudflare.ZoneSetting
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneID string
+		Cs     []cloudflare.ZoneSetting
+	}
+	mock.lockUpdateZoneSettings.RLock()
+	calls = mock.calls.UpdateZoneSettings
+	mock.lockUpdateZoneSettings.RUnlock()
+	return calls
+}
+
+// ZoneSettings calls ZoneSettingsFunc.
+func (mock *ZoneSettingsAPIMock) ZoneSettings(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+	if mock.ZoneSettingsFunc == nil {
+		panic("ZoneSettingsAPIMock.ZoneSettingsFunc: method is nil but ZoneSettingsAPI.ZoneSettings was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneID string
+	}{
+		Ctx:    ctx,
+		ZoneID: zoneID,
+	}
+	mock.lockZoneSettings.Lock()
+	mock.calls.ZoneSettings = append(mock.calls.ZoneSettings, callInfo)
+	mock.lockZoneSettings.Unlock()
+	return mock.ZoneSettingsFunc(ctx, zoneID)
+}
+
+// ZoneSettingsCalls gets all the calls that were made to ZoneSettings.
+// Check the length with:
+//
+//	len(mockedZoneSettingsAPI.ZoneSettingsCalls())
+func (mock *ZoneSettingsAPIMock) ZoneSettingsCalls() []struct {
+	Ctx    context.Context
+	ZoneID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneID string
+	}
+	mock.lockZoneSettings.RLock()
+	calls = mock.calls.ZoneSettings
+	mock.lockZoneSettings.RUnlock()
+	return calls
+}
+
+// Ensure, that ZoneAPIMock does implement clients.ZoneAPI.
+// If this is not the case, regenerate this file with moq.
+var _ clients.ZoneAPI = &ZoneAPIMock{}
+
+// ZoneAPIMock is a mock implementation of clients.ZoneAPI.
+//
+//	func TestSomethingThatUsesZoneAPI(t *testing.T) {
+//
+//		// make and configure a mocked clients.ZoneAPI
+//		mockedZoneAPI := &ZoneAPIMock{
+//			CreateZoneFunc: func(ctx context.Context, name string, jumpstart bool, account cloudflare.Account, zoneType string) (cloudflare.Zone, error) {
+//				panic("mock out the CreateZone method")
+//			},
+//			DeleteZoneFunc: func(ctx context.Context, zoneID string) (cloudflare.ZoneID, error) {
+//				panic("mock out the DeleteZone method")
+//			},
+//			EditZoneFunc: func(ctx context.Context, zoneID string, zoneOpts cloudflare.ZoneOptions) (cloudflare.Zone, error) {
+//				panic("mock out the EditZone method")
+//			},
+//			ListDNSRecordsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+//				panic("mock out the ListDNSRecords method")
+//			},
+//			RawFunc: func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+//				panic("mock out the Raw method")
+//			},
+//			UpdateZoneSettingsFunc: func(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error) {
+//				panic("mock out the UpdateZoneSettings method")
+//			},
+//			ZoneDetailsFunc: func(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+//				panic("mock out the ZoneDetails method")
+//			},
+//			ZoneIDByNameFunc: func(zoneName string) (string, error) {
+//				panic("mock out the ZoneIDByName method")
+//			},
+//			ZoneSetPlanFunc: func(ctx context.Context, zoneID string, planType string) error {
+//				panic("mock out the ZoneSetPlan method")
+//			},
+//			ZoneSettingsFunc: func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+//				panic("mock out the ZoneSettings method")
+//			},
+//		}
+//
+//		// use mockedZoneAPI in code that requires clients.ZoneAPI
+//		// and then make assertions.
+//
+//	}
+type ZoneAPIMock struct {
+	// CreateZoneFunc mocks the CreateZone method.
+	CreateZoneFunc func(ctx context.Context, name string, jumpstart bool, account cloudflare.Account, zoneType string) (cloudflare.Zone, error)
+
+	// DeleteZoneFunc mocks the DeleteZone method.
+	DeleteZoneFunc func(ctx context.Context, zoneID string) (cloudflare.ZoneID, error)
+
+	// EditZoneFunc mocks the EditZone method.
+	EditZoneFunc func(ctx context.Context, zoneID string, zoneOpts cloudflare.ZoneOptions) (cloudflare.Zone, error)
+
+	// ListDNSRecordsFunc mocks the ListDNSRecords method.
+	ListDNSRecordsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
+
+	// RawFunc mocks the Raw method.
+	RawFunc func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+
+	// UpdateZoneSettingsFunc mocks the UpdateZoneSettings method.
+	UpdateZoneSettingsFunc func(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error)
+
+	// ZoneDetailsFunc mocks the ZoneDetails method.
+	ZoneDetailsFunc func(ctx context.Context, zoneID string) (cloudflare.Zone, error)
+
+	// ZoneIDByNameFunc mocks the ZoneIDByName method.
+	ZoneIDByNameFunc func(zoneName string) (string, error)
+
+	// ZoneSetPlanFunc mocks the ZoneSetPlan method.
+	ZoneSetPlanFunc func(ctx context.Context, zoneID string, planType string) error
+
+	// ZoneSettingsFunc mocks the ZoneSettings method.
+	ZoneSettingsFunc func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateZone holds details about calls to the CreateZone method.
+		CreateZone []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Jumpstart is the jumpstart argument value.
+			Jumpstart bool
+			// Account is the account argument value.
+			Account cloudflare.Account
+			// ZoneType is the zoneType argument value.
+			ZoneType string
+		}
+		// DeleteZone holds details about calls to the DeleteZone method.
+		DeleteZone []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+		}
+		// EditZone holds details about calls to the EditZone method.
+		EditZone []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+			// ZoneOpts is the zoneOpts argument value.
+			ZoneOpts cloudflare.ZoneOptions
+		}
+		// ListDNSRecords holds details about calls to the ListDNSRecords method.
+		ListDNSRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListDNSRecordsParams
+		}
+		// Raw holds details about calls to the Raw method.
+		Raw []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Method is the method argument value.
+			Method string
+			// Endpoint is the endpoint argument value.
+			Endpoint string
+			// Data is the data argument value.
+			Data interface{}
+			// Headers is the headers argument value.
+			Headers http.Header
+		}
+		// UpdateZoneSettings holds details about calls to the UpdateZoneSettings method.
+		UpdateZoneSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+			// Cs is the cs argument value.
+			Cs []cloudflare.ZoneSetting
+		}
+		// ZoneDetails holds details about calls to the ZoneDetails method.
+		ZoneDetails []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+		}
+		// ZoneIDByName holds details about calls to the ZoneIDByName method.
+		ZoneIDByName []struct {
+			// ZoneName is the zoneName argument value.
+			ZoneName string
+		}
+		// ZoneSetPlan holds details about calls to the ZoneSetPlan method.
+		ZoneSetPlan []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+			// PlanType is the planType argument value.
+			PlanType string
+		}
+		// ZoneSettings holds details about calls to the ZoneSettings method.
+		ZoneSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+		}
+	}
+	lockCreateZone         sync.RWMutex
+	lockDeleteZone         sync.RWMutex
+	lockEditZone           sync.RWMutex
+	lockListDNSRecords     sync.RWMutex
+	lockRaw                sync.RWMutex
+	lockUpdateZoneSettings sync.RWMutex
+	lockZoneDetails        sync.RWMutex
+	lockZoneIDByName       sync.RWMutex
+	lockZoneSetPlan        sync.RWMutex
+	lockZoneSettings       sync.RWMutex
+}
+
+// CreateZone calls CreateZoneFunc.
+func (mock *ZoneAPIMock) CreateZone(ctx context.Context, name string, jumpstart bool, account cloudflare.Account, zoneType string) (cloudflare.Zone, error) {
+	if mock.CreateZoneFunc == nil {
+		panic("ZoneAPIMock.CreateZoneFunc: method is nil but ZoneAPI.CreateZone was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Name      string
+		Jumpstart bool
+		Account   cloudflare.Account
+		ZoneType  string
+	}{
+		Ctx:       ctx,
+		Name:      name,
+		Jumpstart: jumpstart,
+		Account:   account,
+		ZoneType:  zoneType,
+	}
+	mock.lockCreateZone.Lock()
+	mock.calls.CreateZone = append(mock.calls.CreateZone, callInfo)
+	mock.lockCreateZone.Unlock()
+	return mock.CreateZoneFunc(ctx, name, jumpstart, account, zoneType)
+}
+
+// CreateZoneCalls gets all the calls that were made to CreateZone.
+// Check the length with:
+//
+//	len(mockedZoneAPI.CreateZoneCalls())
+func (mock *ZoneAPIMock) CreateZoneCalls() []struct {
+	Ctx       context.Context
+	Name      string
+	Jumpstart bool
+	Account   cloudflare.Account
+	ZoneType  string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Name      string
+		Jumpstart bool
+		Account   cloudflare.Account
+		ZoneType  string
+	}
+	mock.lockCreateZone.RLock()
+	calls = mock.calls.CreateZone
+	mock.lockCreateZone.RUnlock()
+	return calls
+}
+
+// DeleteZone calls DeleteZoneFunc.
+func (mock *ZoneAPIMock) DeleteZone(ctx context.Context, zoneID string) (cloudflare.ZoneID, error) {
+	if mock.DeleteZoneFunc == nil {
+		panic("ZoneAPIMock.DeleteZoneFunc: method is nil but ZoneAPI.DeleteZone was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneID string
+	}{
+		Ctx:    ctx,
+		ZoneID: zoneID,
+	}
+	mock.lockDeleteZone.Lock()
+	mock.calls.DeleteZone = append(mock.calls.DeleteZone, callInfo)
+	mock.lockDeleteZone.Unlock()
+	return mock.DeleteZoneFunc(ctx, zoneID)
+}
+
+// DeleteZoneCalls gets all the calls that were made to DeleteZone.
+// Check the length with:
+//
+//	len(mockedZoneAPI.DeleteZoneCalls())
+func (mock *ZoneAPIMock) DeleteZoneCalls() []struct {
+	Ctx    context.Context
+	ZoneID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneID string
+	}
+	mock.lockDeleteZone.RLock()
+	calls = mock.calls.DeleteZone
+	mock.lockDeleteZone.RUnlock()
+	return calls
+}
+
+// EditZone calls EditZoneFunc.
+func (mock *ZoneAPIMock) EditZone(ctx context.Context, zoneID string, zoneOpts cloudflare.ZoneOptions) (cloudflare.Zone, error) {
+	if mock.EditZoneFunc == nil {
+		panic("ZoneAPIMock.EditZoneFunc: method is nil but ZoneAPI.EditZone was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ZoneID   string
+		ZoneOpts cloudflare.ZoneOptions
+	}{
+		Ctx:      ctx,
+		ZoneID:   zoneID,
+		ZoneOpts: zoneOpts,
+	}
+	mock.lockEditZone.Lock()
+	mock.calls.EditZone = append(mock.calls.EditZone, callInfo)
+	mock.lockEditZone.Unlock()
+	return mock.EditZoneFunc(ctx, zoneID, zoneOpts)
+}
+
+// EditZoneCalls gets all the calls that were made to EditZone.
+// Check the length with:
+//
+//	len(mockedZoneAPI.EditZoneCalls())
+func (mock *ZoneAPIMock) EditZoneCalls() []struct {
+	Ctx      context.Context
+	ZoneID   string
+	ZoneOpts cloudflare.ZoneOptions
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ZoneID   string
+		ZoneOpts cloudflare.ZoneOptions
+	}
+	mock.lockEditZone.RLock()
+	calls = mock.calls.EditZone
+	mock.lockEditZone.RUnlock()
+	return calls
+}
+
+// ListDNSRecords calls ListDNSRecordsFunc.
+func (mock *ZoneAPIMock) ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+	if mock.ListDNSRecordsFunc == nil {
+		panic("ZoneAPIMock.ListDNSRecordsFunc: method is nil but ZoneAPI.ListDNSRecords was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListDNSRecords.Lock()
+	mock.calls.ListDNSRecords = append(mock.calls.ListDNSRecords, callInfo)
+	mock.lockListDNSRecords.Unlock()
+	return mock.ListDNSRecordsFunc(ctx, rc, params)
+}
+
+// ListDNSRecordsCalls gets all the calls that were made to ListDNSRecords.
+// Check the length with:
+//
+//	len(mockedZoneAPI.ListDNSRecordsCalls())
+func (mock *ZoneAPIMock) ListDNSRecordsCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListDNSRecordsParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}
+	mock.lockListDNSRecords.RLock()
+	calls = mock.calls.ListDNSRecords
+	mock.lockListDNSRecords.RUnlock()
+	return calls
+}
+
+// Raw calls RawFunc.
+func (mock *ZoneAPIMock) Raw(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if mock.RawFunc == nil {
+		panic("ZoneAPIMock.RawFunc: method is nil but ZoneAPI.Raw was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}{
+		Ctx:      ctx,
+		Method:   method,
+		Endpoint: endpoint,
+		Data:     data,
+		Headers:  headers,
+	}
+	mock.lockRaw.Lock()
+	mock.calls.Raw = append(mock.calls.Raw, callInfo)
+	mock.lockRaw.Unlock()
+	return mock.RawFunc(ctx, method, endpoint, data, headers)
+}
+
+// RawCalls gets all the calls that were made to Raw.
+// Check the length with:
+//
+//	len(mockedZoneAPI.RawCalls())
+func (mock *ZoneAPIMock) RawCalls() []struct {
+	Ctx      context.Context
+	Method   string
+	Endpoint string
+	Data     interface{}
+	Headers  http.Header
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}
+	mock.lockRaw.RLock()
+	calls = mock.calls.Raw
+	mock.lockRaw.RUnlock()
+	return calls
+}
+
+// UpdateZoneSettings calls UpdateZoneSettingsFunc.
+func (mock *ZoneAPIMock) UpdateZoneSettings(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error) {
+	if mock.UpdateZoneSettingsFunc == nil {
+		panic("ZoneAPIMock.UpdateZoneSettingsFunc: method is nil but ZoneAPI.UpdateZoneSettings was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneID string
+		Cs     []cloudflare.ZoneSetting
+	}{
+		Ctx:    ctx,
+		ZoneID: zoneID,
+		Cs:     cs,
+	}
+	mock.lockUpdateZoneSettings.Lock()
+	mock.calls.UpdateZoneSettings = append(mock.calls.UpdateZoneSettings, callInfo)
+	mock.lockUpdateZoneSettings.Unlock()
+	return mock.UpdateZoneSettingsFunc(ctx, zoneID, cs)
+}
+
+// UpdateZoneSettingsCalls gets all the calls that were made to UpdateZoneSettings.
+// Check the length with:
+//
+//	len(mockedZoneAPI.UpdateZoneSettingsCalls())
+func (mock *ZoneAPIMock) UpdateZoneSettingsCalls() []struct {
+	Ctx    context.Context
+	ZoneID string
+	Cs     []cloudflare.ZoneSetting
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneID string
+		Cs     []cloudflare.ZoneSetting
+	}
+	mock.lockUpdateZoneSettings.RLock()
+	calls = mock.calls.UpdateZoneSettings
+	mock.lockUpdateZoneSettings.RUnlock()
+	return calls
+}
+
+// ZoneDetails calls ZoneDetailsFunc.
+func (mock *ZoneAPIMock) ZoneDetails(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+	if mock.ZoneDetailsFunc == nil {
+		panic("ZoneAPIMock.ZoneDetailsFunc: method is nil but ZoneAPI.ZoneDetails was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneID string
+	}{
+		Ctx:    ctx,
+		ZoneID: zoneID,
+	}
+	mock.lockZoneDetails.Lock()
+	mock.calls.ZoneDetails = append(mock.calls.ZoneDetails, callInfo)
+	mock.lockZoneDetails.Unlock()
+	return mock.ZoneDetailsFunc(ctx, zoneID)
+}
+
+// ZoneDetailsCalls gets all the calls that were made to ZoneDetails.
+// Check the length with:
+//
+//	len(mockedZoneAPI.ZoneDetailsCalls())
+func (mock *ZoneAPIMock) ZoneDetailsCalls() []struct {
+	Ctx    context.Context
+	ZoneID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneID string
+	}
+	mock.lockZoneDetails.RLock()
+	calls = mock.calls.ZoneDetails
+	mock.lockZoneDetails.RUnlock()
+	return calls
+}
+
+// ZoneIDByName calls ZoneIDByNameFunc.
+func (mock *ZoneAPIMock) ZoneIDByName(zoneName string) (string, error) {
+	if mock.ZoneIDByNameFunc == nil {
+		panic("ZoneAPIMock.ZoneIDByNameFunc: method is nil but ZoneAPI.ZoneIDByName was just called")
+	}
+	callInfo := struct {
+		ZoneName string
+	}{
+		ZoneName: zoneName,
+	}
+	mock.lockZoneIDByName.Lock()
+	mock.calls.ZoneIDByName = append(mock.calls.ZoneIDByName, callInfo)
+	mock.lockZoneIDByName.Unlock()
+	return mock.ZoneIDByNameFunc(zoneName)
+}
+
+// ZoneIDByNameCalls gets all the calls that were made to ZoneIDByName.
+// Check the length with:
+//
+//	len(mockedZoneAPI.ZoneIDByNameCalls())
+func (mock *ZoneAPIMock) ZoneIDByNameCalls() []struct {
+	ZoneName string
+} {
+	var calls []struct {
+		ZoneName string
+	}
+	mock.lockZoneIDByName.RLock()
+	calls = mock.calls.ZoneIDByName
+	mock.lockZoneIDByName.RUnlock()
+	return calls
+}
+
+// ZoneSetPlan calls ZoneSetPlanFunc.
+func (mock *ZoneAPIMock) ZoneSetPlan(ctx context.Context, zoneID string, planType string) error {
+	if mock.ZoneSetPlanFunc == nil {
+		panic("ZoneAPIMock.ZoneSetPlanFunc: method is nil but ZoneAPI.ZoneSetPlan was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ZoneID   string
+		PlanType string
+	}{
+		Ctx:      ctx,
+		ZoneID:   zoneID,
+		PlanType: planType,
+	}
+	mock.lockZoneSetPlan.Lock()
+	mock.calls.ZoneSetPlan = append(mock.calls.ZoneSetPlan, callInfo)
+	mock.lockZoneSetPlan.Unlock()
+	return mock.ZoneSetPlanFunc(ctx, zoneID, planType)
+}
+
+// ZoneSetPlanCalls gets all the calls that were made to ZoneSetPlan.
+// Check the length with:
+//
+//	len(mockedZoneAPI.ZoneSetPlanCalls())
+func (mock *ZoneAPIMock) ZoneSetPlanCalls() []struct {
+	Ctx      context.Context
+	ZoneID   string
+	PlanType string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ZoneID   string
+		PlanType string
+	}
+	mock.lockZoneSetPlan.RLock()
+	calls = mock.calls.ZoneSetPlan
+	mock.lockZoneSetPlan.RUnlock()
+	return calls
+}
+
+// ZoneSettings calls ZoneSettingsFunc.
+func (mock *ZoneAPIMock) ZoneSettings(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+	if mock.ZoneSettingsFunc == nil {
+		panic("ZoneAPIMock.ZoneSettingsFunc: method is nil but ZoneAPI.ZoneSettings was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneID string
+	}{
+		Ctx:    ctx,
+		ZoneID: zoneID,
+	}
+	mock.lockZoneSettings.Lock()
+	mock.calls.ZoneSettings = append(mock.calls.ZoneSettings, callInfo)
+	mock.lockZoneSettings.Unlock()
+	return mock.ZoneSettingsFunc(ctx, zoneID)
+}
+
+// ZoneSettingsCalls gets all the calls that were made to ZoneSettings.
+// Check the length with:
+//
+//	len(mockedZoneAPI.ZoneSettingsCalls())
+func (mock *ZoneAPIMock) ZoneSettingsCalls() []struct {
+	Ctx    context.Context
+	ZoneID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneID string
+	}
+	mock.lockZoneSettings.RLock()
+	calls = mock.calls.ZoneSettings
+	mock.lockZoneSettings.RUnlock()
+	return calls
+}
@@ -18,71 +18,17 @@ package clients
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 )
 
-// CloudflareClient represents the interface for Cloudflare API operations
-type CloudflareClient interface {
-	// DNS Record operations
-	CreateDNSRecord(ctx context.Context, zoneID string, rr cloudflare.DNSRecord) (*cloudflare.DNSRecordResponse, error)
-	UpdateDNSRecord(ctx context.Context, zoneID, recordID string, rr cloudflare.DNSRecord) error
-	DNSRecord(ctx context.Context, zoneID, recordID string) (cloudflare.DNSRecord, error)
-	DeleteDNSRecord(ctx context.Context, zoneID, recordID string) error
-	DNSRecords(ctx context.Context, zoneID string, rr cloudflare.DNSRecord) ([]cloudflare.DNSRecord, error)
-
-	// Zone operations
-	CreateZone(ctx context.Context, name string, jumpstart bool, zoneType string, account cloudflare.Account) (cloudflare.Zone, error)
-	ZoneDetails(ctx context.Context, zoneID string) (cloudflare.Zone, error)
-	DeleteZone(ctx context.Context, zoneID string) (cloudflare.ZoneID, error)
-	EditZone(ctx context.Context, zoneID string, zoneOpts cloudflare.ZoneOptions) (cloudflare.Zone, error)
-
-	// Origin CA Certificate operations
-	CreateOriginCACertificate(ctx context.Context, params cloudflare.CreateOriginCertificateParams) (*cloudflare.OriginCACertificate, error)
-	GetOriginCACertificate(ctx context.Context, certificateID string) (*cloudflare.OriginCACertificate, error)
-	ListOriginCACertificates(ctx context.Context, params cloudflare.ListOriginCertificatesParams) ([]cloudflare.OriginCACertificate, error)
-	RevokeOriginCACertificate(ctx context.Context, certificateID string) (*cloudflare.OriginCACertificateID, error)
-
-	// Rate Limiting operations
-	CreateRateLimit(ctx context.Context, zoneID string, rateLimit cloudflare.RateLimit) (cloudflare.RateLimit, error)
-	RateLimit(ctx context.Context, zoneID, rateLimitID string) (cloudflare.RateLimit, error)
-	UpdateRateLimit(ctx context.Context, zoneID, rateLimitID string, rateLimit cloudflare.RateLimit) (cloudflare.RateLimit, error)
-	DeleteRateLimit(ctx context.Context, zoneID, rateLimitID string) error
-
-	// Bot Management operations
-	GetBotManagement(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.BotManagement, error)
-	UpdateBotManagement(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateBotManagementParams) (cloudflare.BotManagement, error)
-
-	// Turnstile operations
-	CreateTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error)
-	GetTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) (cloudflare.TurnstileWidget, error)
-	UpdateTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error)
-	DeleteTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) error
-
-	// Workers Custom Domain operations
-	AttachWorkersDomain(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error)
-	GetWorkersDomain(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) (cloudflare.WorkersDomain, error)
-	DetachWorkersDomain(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) error
-	ListWorkersDomains(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error)
-
-	// Workers Subdomain operations
-	WorkersCreateSubdomain(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.WorkersSubdomain) (cloudflare.WorkersSubdomain, error)
-	WorkersGetSubdomain(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.WorkersSubdomain, error)
-
-	// Universal SSL operations
-	UniversalSSLSettingDetails(ctx context.Context, zoneID string) (cloudflare.UniversalSSLSetting, error)
-	EditUniversalSSLSetting(ctx context.Context, zoneID string, setting cloudflare.UniversalSSLSetting) (cloudflare.UniversalSSLSetting, error)
-
-	// Total TLS operations
-	GetTotalTLS(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.TotalTLS, error)
-	SetTotalTLS(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.TotalTLS) (cloudflare.TotalTLS, error)
-
-	// Certificate Pack operations
-	CreateCertificatePack(ctx context.Context, zoneID string, cert cloudflare.CertificatePackRequest) (cloudflare.CertificatePack, error)
-	CertificatePack(ctx context.Context, zoneID, certificatePackID string) (cloudflare.CertificatePack, error)
-	DeleteCertificatePack(ctx context.Context, zoneID, certificateID string) error
-	RestartCertificateValidation(ctx context.Context, zoneID, certificateID string) (cloudflare.CertificatePack, error)
-}
+// Mocks of the interfaces below are generated into the fake package, so
+// that adding a method to one of them only requires running go generate.
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -pkg fake -out fake/zz_generated.workers.go . WorkerScriptAPI WorkersKVAPI WorkerCronTriggerAPI WorkerRouteAPI
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -pkg fake -out fake/zz_generated.dns.go . RawAPI DNSAPI
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -pkg fake -out fake/zz_generated.zones.go . ZoneSettingsAPI ZoneAPI
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -pkg fake -out fake/zz_generated.r2.go . R2API
 
 // DNSRecordValidator provides validation for DNS records
 type DNSRecordValidator interface {
@@ -91,31 +37,101 @@ type DNSRecordValidator interface {
 	ValidateRecord(recordType, content string, priority *int) error
 }
 
-// ConfigProvider provides configuration for Cloudflare clients
-type ConfigProvider interface {
-	GetAPIKey() string
-	GetEmail() string
-	GetAPIToken() string
-	IsValid() bool
+// AccountAPI resolves the account that account-scoped Workers resources
+// are managed in when none is specified.
+type AccountAPI interface {
+	GetAccountID() string
 }
 
-// ClientInterface defines the interface for Workers API operations
-type ClientInterface interface {
-	GetAccountID() string
+// WorkerScriptAPI is the subset of the Cloudflare API used to manage
+// Worker scripts.
+type WorkerScriptAPI interface {
+	AccountAPI
 	UploadWorker(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerParams) (cloudflare.WorkerScriptResponse, error)
 	GetWorker(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptResponse, error)
 	DeleteWorker(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.DeleteWorkerParams) error
 	GetWorkersScriptContent(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (string, error)
 	GetWorkersScriptSettings(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error)
+	GetWorkersScriptBindings(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) ([]WorkerScriptBinding, error)
 	ListWorkers(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersParams) (cloudflare.WorkerListResponse, *cloudflare.ResultInfo, error)
+}
+
+// WorkersKVAPI is the subset of the Cloudflare API used to manage Workers
+// KV namespaces.
+type WorkersKVAPI interface {
+	AccountAPI
 	CreateWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkersKVNamespaceParams) (cloudflare.WorkersKVNamespaceResponse, error)
 	ListWorkersKVNamespaces(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVNamespacesParams) ([]cloudflare.WorkersKVNamespace, *cloudflare.ResultInfo, error)
 	DeleteWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, namespaceID string) (cloudflare.Response, error)
 	UpdateWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkersKVNamespaceParams) (cloudflare.Response, error)
+}
+
+// WorkerCronTriggerAPI is the subset of the Cloudflare API used to manage
+// the cron triggers of Worker scripts.
+type WorkerCronTriggerAPI interface {
+	AccountAPI
 	ListWorkerCronTriggers(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error)
 	UpdateWorkerCronTriggers(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error)
+}
+
+// WorkerRouteAPI is the subset of the Cloudflare API used to manage Worker
+// routes.
+type WorkerRouteAPI interface {
 	ListWorkerRoutes(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error)
 	CreateWorkerRoute(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error)
 	UpdateWorkerRoute(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error)
 	DeleteWorkerRoute(ctx context.Context, rc *cloudflare.ResourceContainer, routeID string) (cloudflare.WorkerRouteResponse, error)
-}
\ No newline at end of file
+}
+
+// WorkersAPI is the Workers API implemented by CloudflareAPIAdapter.
+type WorkersAPI interface {
+	WorkerScriptAPI
+	WorkersKVAPI
+	WorkerCronTriggerAPI
+	WorkerRouteAPI
+}
+
+// RawAPI is used to call the Cloudflare endpoints that cloudflare-go has
+// no typed method for.
+type RawAPI interface {
+	Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+}
+
+// DNSAPI is the subset of the Cloudflare API used to manage DNS records.
+type DNSAPI interface {
+	CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
+	UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
+	GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
+	DeleteDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+	ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
+}
+
+// ZoneSettingsAPI is the subset of the Cloudflare API used to read and
+// change the settings of a zone.
+type ZoneSettingsAPI interface {
+	ZoneSettings(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error)
+	UpdateZoneSettings(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error)
+}
+
+// ZoneAPI is the subset of the Cloudflare API used to manage zones.
+type ZoneAPI interface {
+	RawAPI
+	ZoneSettingsAPI
+	CreateZone(ctx context.Context, name string, jumpstart bool, account cloudflare.Account, zoneType string) (cloudflare.Zone, error)
+	DeleteZone(ctx context.Context, zoneID string) (cloudflare.ZoneID, error)
+	EditZone(ctx context.Context, zoneID string, zoneOpts cloudflare.ZoneOptions) (cloudflare.Zone, error)
+	ZoneDetails(ctx context.Context, zoneID string) (cloudflare.Zone, error)
+	ZoneIDByName(zoneName string) (string, error)
+	ZoneSetPlan(ctx context.Context, zoneID string, planType string) error
+	ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
+}
+
+// R2API is the subset of the Cloudflare API used to manage R2 buckets and
+// their custom domains.
+type R2API interface {
+	RawAPI
+	Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error)
+	CreateR2Bucket(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateR2BucketParameters) (cloudflare.R2Bucket, error)
+	DeleteR2Bucket(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) error
+	ListR2Buckets(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListR2BucketsParams) ([]cloudflare.R2Bucket, error)
+}
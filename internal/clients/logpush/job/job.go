@@ -18,14 +18,18 @@ package job
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/rossigee/provider-cloudflare/apis/logpush/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/lookup"
 )
 
 // LogpushJobAPI defines the interface for Logpush Job operations
@@ -36,6 +40,7 @@ type LogpushJobAPI interface {
 	UpdateLogpushJob(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateLogpushJobParams) error
 	DeleteLogpushJob(ctx context.Context, rc *cloudflare.ResourceContainer, jobID int) error
 	ListLogpushJobs(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListLogpushJobsParams) ([]cloudflare.LogpushJob, error)
+	Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
 }
 
 const (
@@ -44,12 +49,78 @@ const (
 	errGetJob    = "cannot get logpush job"
 	errDeleteJob = "cannot delete logpush job"
 	errListJobs  = "cannot list logpush jobs"
+
+	errValidateDestination = "cannot validate logpush destination"
+	errValidateOrigin      = "cannot validate logpush origin"
+
+	errInvalidFilter = "invalid filter"
+
+	errZoneRequiredFmt  = "dataset %s is a zone dataset and requires a zone"
+	errZoneForbiddenFmt = "dataset %s is an account dataset and cannot be pushed by a zone; omit the zone"
+	errEdgeDatasetFmt   = "dataset %s cannot be delivered from the edge; only the http_requests dataset of a zone can"
+	errGetAccountID     = "failed to get account ID"
+
+	datasetHTTPRequests = "http_requests"
+	kindEdge            = "edge"
 )
 
+// zoneDatasets are the datasets that are pushed by a zone.
+var zoneDatasets = map[string]bool{
+	"dns_logs":           true,
+	"firewall_events":    true,
+	"http_requests":      true,
+	"nel_reports":        true,
+	"page_shield_events": true,
+	"spectrum_events":    true,
+}
+
+// accountDatasets are the datasets that are pushed by an account, such as
+// the audit and Zero Trust logs.
+var accountDatasets = map[string]bool{
+	"access_requests":             true,
+	"audit_logs":                  true,
+	"biso_user_actions":           true,
+	"casb_findings":               true,
+	"device_posture_results":      true,
+	"dns_firewall_logs":           true,
+	"gateway_dns":                 true,
+	"gateway_http":                true,
+	"gateway_network":             true,
+	"magic_ids_detections":        true,
+	"network_analytics_logs":      true,
+	"sinkhole_http_logs":          true,
+	"workers_trace_events":        true,
+	"zero_trust_network_sessions": true,
+}
+
+// IsAccountDataset returns true if the supplied dataset is pushed by an
+// account rather than a zone.
+func IsAccountDataset(dataset string) bool {
+	return accountDatasets[dataset]
+}
+
+// ValidateScope returns an error if the dataset of the supplied parameters
+// cannot be pushed by the zone or account they target. Datasets that are
+// not known to be either are not validated, so that new datasets can be
+// used before they are added here.
+func ValidateScope(params v1alpha1.JobParameters) error {
+	hasZone := params.Zone != nil && *params.Zone != ""
+	switch {
+	case zoneDatasets[params.Dataset] && !hasZone:
+		return errors.Errorf(errZoneRequiredFmt, params.Dataset)
+	case accountDatasets[params.Dataset] && hasZone:
+		return errors.Errorf(errZoneForbiddenFmt, params.Dataset)
+	case Kind(params.Kind) == kindEdge && (params.Dataset != datasetHTTPRequests || !hasZone):
+		return errors.Errorf(errEdgeDatasetFmt, params.Dataset)
+	}
+	return nil
+}
+
 // JobClient provides operations for Logpush Jobs.
 type JobClient struct {
 	client    LogpushJobAPI
 	accountID string
+	scope     string
 }
 
 // NewClient creates a new Logpush Job client.
@@ -57,7 +128,21 @@ func NewClient(client LogpushJobAPI) *JobClient {
 	return &JobClient{
 		client:    client,
 		accountID: "", // Account ID will be retrieved when needed
+		scope:     lookup.Scope(client),
+	}
+}
+
+// resourceContainer returns the zone jobs of the supplied parameters are
+// pushed by, or the account if they set no zone.
+func (c *JobClient) resourceContainer(ctx context.Context, params v1alpha1.JobParameters) (*cloudflare.ResourceContainer, error) {
+	if params.Zone != nil && *params.Zone != "" {
+		return cloudflare.ZoneIdentifier(*params.Zone), nil
+	}
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAccountID)
 	}
+	return cloudflare.AccountIdentifier(accountID), nil
 }
 
 // getAccountID gets the account ID from the Cloudflare API
@@ -66,19 +151,15 @@ func (c *JobClient) getAccountID(ctx context.Context) (string, error) {
 		return c.accountID, nil
 	}
 	
-	// Get account ID from Cloudflare API by listing accounts
-	// Most users have access to only one account, so we'll use the first one
-	accounts, _, err := c.client.Accounts(ctx, cloudflare.AccountsListParams{})
+	// Most users have access to only one account, so we'll use the first
+	// one. The lookup is shared with other clients using the same
+	// credentials.
+	accountID, err := lookup.Default.AccountID(ctx, c.client, c.scope)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to list accounts")
+		return "", err
 	}
-	
-	if len(accounts) == 0 {
-		return "", errors.New("no accounts found")
-	}
-	
-	// Use the first account (most common case for users)
-	c.accountID = accounts[0].ID
+
+	c.accountID = accountID
 	return c.accountID, nil
 }
 
@@ -127,9 +208,7 @@ func convertToObservation(job cloudflare.LogpushJob) v1alpha1.JobObservation {
 		obs.Frequency = &job.Frequency
 	}
 
-	if job.Filter != nil {
-		obs.Filter = convertJobFilters(job.Filter)
-	}
+	obs.Filter = formatFilter(job.Filter)
 
 	if job.MaxUploadBytes > 0 {
 		obs.MaxUploadBytes = &job.MaxUploadBytes
@@ -202,44 +281,48 @@ func convertOutputOptions(opts *cloudflare.LogpushOutputOptions) *v1alpha1.Outpu
 	return result
 }
 
-// convertJobFilters converts cloudflare-go job filters to Crossplane job filters.
-func convertJobFilters(filters *cloudflare.LogpushJobFilters) *v1alpha1.JobFilters {
+// formatFilter returns the JSON filter expression of cloudflare-go job
+// filters. Filters are always formatted the same way, so the result is
+// normalized.
+func formatFilter(filters *cloudflare.LogpushJobFilters) *string {
 	if filters == nil {
 		return nil
 	}
-
-	return &v1alpha1.JobFilters{
-		Where: convertJobFilter(&filters.Where),
-	}
-}
-
-// convertJobFilter converts cloudflare-go job filter to Crossplane job filter.
-func convertJobFilter(filter *cloudflare.LogpushJobFilter) *v1alpha1.JobFilter {
-	if filter == nil {
+	b, err := json.Marshal(filters)
+	if err != nil {
 		return nil
 	}
+	f := string(b)
+	return &f
+}
 
-	result := &v1alpha1.JobFilter{}
-
-	if filter.Key != "" {
-		result.Key = &filter.Key
+// parseFilter parses and validates a JSON filter expression.
+func parseFilter(filter string) (*cloudflare.LogpushJobFilters, error) {
+	d := json.NewDecoder(strings.NewReader(filter))
+	d.DisallowUnknownFields()
+	f := &cloudflare.LogpushJobFilters{}
+	if err := d.Decode(f); err != nil {
+		return nil, errors.Wrap(err, errInvalidFilter)
 	}
-
-	if filter.Operator != "" {
-		operatorStr := string(filter.Operator)
-		result.Operator = &operatorStr
+	if err := f.Where.Validate(); err != nil {
+		return nil, errors.Wrap(err, errInvalidFilter)
 	}
+	return f, nil
+}
 
-	if filter.Value != nil {
-		valueStr := fmt.Sprintf("%v", filter.Value)
-		result.Value = &valueStr
+// NormalizeFilter returns the normalized form of a JSON filter expression,
+// so that expressions differing only in formatting and key order compare
+// equal.
+func NormalizeFilter(filter string) (string, error) {
+	f, err := parseFilter(filter)
+	if err != nil {
+		return "", err
 	}
-
-	return result
+	return *formatFilter(f), nil
 }
 
 // convertToCloudflareParams converts Crossplane parameters to cloudflare-go parameters.
-func convertToCloudflareParams(params v1alpha1.JobParameters) cloudflare.CreateLogpushJobParams {
+func convertToCloudflareParams(params v1alpha1.JobParameters) (cloudflare.CreateLogpushJobParams, error) {
 	cfParams := cloudflare.CreateLogpushJobParams{
 		Dataset:         params.Dataset,
 		Name:            params.Name,
@@ -267,7 +350,11 @@ func convertToCloudflareParams(params v1alpha1.JobParameters) cloudflare.CreateL
 	}
 
 	if params.Filter != nil {
-		cfParams.Filter = convertToCloudflareJobFilters(params.Filter)
+		f, err := parseFilter(*params.Filter)
+		if err != nil {
+			return cloudflare.CreateLogpushJobParams{}, err
+		}
+		cfParams.Filter = f
 	}
 
 	if params.MaxUploadBytes != nil {
@@ -282,7 +369,7 @@ func convertToCloudflareParams(params v1alpha1.JobParameters) cloudflare.CreateL
 		cfParams.MaxUploadIntervalSeconds = *params.MaxUploadIntervalSeconds
 	}
 
-	return cfParams
+	return cfParams, nil
 }
 
 // convertToCloudflareOutputOptions converts Crossplane output options to cloudflare-go output options.
@@ -342,70 +429,103 @@ func convertToCloudflareOutputOptions(opts *v1alpha1.OutputOptions) *cloudflare.
 	return result
 }
 
-// convertToCloudflareJobFilters converts Crossplane job filters to cloudflare-go job filters.
-func convertToCloudflareJobFilters(filters *v1alpha1.JobFilters) *cloudflare.LogpushJobFilters {
-	if filters == nil {
-		return nil
+// Create creates a new Logpush Job.
+func (c *JobClient) Create(ctx context.Context, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error) {
+	if err := ValidateScope(params); err != nil {
+		return nil, errors.Wrap(err, errCreateJob)
+	}
+	rc, err := c.resourceContainer(ctx, params)
+	if err != nil {
+		return nil, err
 	}
 
-	result := &cloudflare.LogpushJobFilters{}
+	createParams, err := convertToCloudflareParams(params)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateJob)
+	}
 
-	if filters.Where != nil {
-		result.Where = *convertToCloudflareJobFilter(filters.Where)
+	job, err := c.client.CreateLogpushJob(ctx, rc, createParams)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateJob)
 	}
 
-	return result
+	obs := convertToObservation(*job)
+	return &obs, nil
 }
 
-// convertToCloudflareJobFilter converts Crossplane job filter to cloudflare-go job filter.
-func convertToCloudflareJobFilter(filter *v1alpha1.JobFilter) *cloudflare.LogpushJobFilter {
-	if filter == nil {
-		return nil
-	}
+// A ValidationFailure describes why Cloudflare rejected the destination or
+// logpull options of a job.
+type ValidationFailure struct {
+	// Field is the parameter that failed validation.
+	Field string
 
-	result := &cloudflare.LogpushJobFilter{}
+	// Message is the reason Cloudflare gave, if any.
+	Message string
+}
 
-	if filter.Key != nil {
-		result.Key = *filter.Key
+// Error describes the failure for humans.
+func (f ValidationFailure) Error() string {
+	if f.Message == "" {
+		return fmt.Sprintf("%s was rejected by Cloudflare", f.Field)
 	}
+	return fmt.Sprintf("%s was rejected by Cloudflare: %s", f.Field, f.Message)
+}
 
-	if filter.Operator != nil {
-		result.Operator = cloudflare.Operator(*filter.Operator)
+type validationResult struct {
+	Valid   bool   `json:"valid"`
+	Message string `json:"message"`
+}
+
+// Validate asks Cloudflare whether it can push to the destination of the
+// supplied parameters, and whether their logpull options are valid. It
+// returns the first failure, or nil if both are valid. Errors calling the
+// validate endpoints are returned as errors rather than failures.
+func (c *JobClient) Validate(ctx context.Context, params v1alpha1.JobParameters) (*ValidationFailure, error) {
+	rc, err := c.resourceContainer(ctx, params)
+	if err != nil {
+		return nil, err
 	}
 
-	if filter.Value != nil {
-		result.Value = *filter.Value
+	v, err := c.validate(ctx, rc, "destination", map[string]string{"destination_conf": params.DestinationConf})
+	if err != nil {
+		return nil, errors.Wrap(err, errValidateDestination)
+	}
+	if !v.Valid {
+		return &ValidationFailure{Field: "destinationConf", Message: v.Message}, nil
 	}
 
-	return result
+	if params.LogpullOptions == nil || *params.LogpullOptions == "" {
+		return nil, nil
+	}
+	v, err = c.validate(ctx, rc, "origin", map[string]string{"logpull_options": *params.LogpullOptions})
+	if err != nil {
+		return nil, errors.Wrap(err, errValidateOrigin)
+	}
+	if !v.Valid {
+		return &ValidationFailure{Field: "logpullOptions", Message: v.Message}, nil
+	}
+	return nil, nil
 }
 
-// Create creates a new Logpush Job.
-func (c *JobClient) Create(ctx context.Context, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error) {
-	accountID, err := c.getAccountID(ctx)
+func (c *JobClient) validate(ctx context.Context, rc *cloudflare.ResourceContainer, what string, body map[string]string) (validationResult, error) {
+	res, err := c.client.Raw(ctx, http.MethodPost, fmt.Sprintf("/%s/%s/logpush/validate/%s", rc.Level, rc.Identifier, what), body, nil)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get account ID")
+		return validationResult{}, err
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
-	
-	createParams := convertToCloudflareParams(params)
-	
-	job, err := c.client.CreateLogpushJob(ctx, rc, createParams)
-	if err != nil {
-		return nil, errors.Wrap(err, errCreateJob)
+	var v validationResult
+	if err := json.Unmarshal(res.Result, &v); err != nil {
+		return validationResult{}, err
 	}
-
-	obs := convertToObservation(*job)
-	return &obs, nil
+	return v, nil
 }
 
-// Get retrieves a Logpush Job.
-func (c *JobClient) Get(ctx context.Context, jobID int) (*v1alpha1.JobObservation, error) {
-	accountID, err := c.getAccountID(ctx)
+// Get retrieves a Logpush Job pushed by the zone or account of the
+// supplied parameters.
+func (c *JobClient) Get(ctx context.Context, jobID int, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error) {
+	rc, err := c.resourceContainer(ctx, params)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get account ID")
+		return nil, err
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
 
 	job, err := c.client.GetLogpushJob(ctx, rc, jobID)
 	if err != nil {
@@ -418,12 +538,14 @@ func (c *JobClient) Get(ctx context.Context, jobID int) (*v1alpha1.JobObservatio
 
 // Update updates an existing Logpush Job.
 func (c *JobClient) Update(ctx context.Context, jobID int, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error) {
-	accountID, err := c.getAccountID(ctx)
+	if err := ValidateScope(params); err != nil {
+		return nil, errors.Wrap(err, errUpdateJob)
+	}
+	rc, err := c.resourceContainer(ctx, params)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get account ID")
+		return nil, err
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
-	
+
 	updateParams := cloudflare.UpdateLogpushJobParams{
 		ID:              jobID,
 		Dataset:         params.Dataset,
@@ -435,9 +557,9 @@ func (c *JobClient) Update(ctx context.Context, jobID int, params v1alpha1.JobPa
 		updateParams.Enabled = *params.Enabled
 	}
 
-	if params.Kind != nil {
-		updateParams.Kind = *params.Kind
-	}
+	// The kind of a job cannot be changed, and the API rejects updates
+	// that try to, so it is never sent. Jobs whose kind changed must be
+	// replaced instead.
 
 	if params.LogpullOptions != nil {
 		updateParams.LogpullOptions = *params.LogpullOptions
@@ -452,7 +574,11 @@ func (c *JobClient) Update(ctx context.Context, jobID int, params v1alpha1.JobPa
 	}
 
 	if params.Filter != nil {
-		updateParams.Filter = convertToCloudflareJobFilters(params.Filter)
+		f, err := parseFilter(*params.Filter)
+		if err != nil {
+			return nil, errors.Wrap(err, errUpdateJob)
+		}
+		updateParams.Filter = f
 	}
 
 	if params.MaxUploadBytes != nil {
@@ -473,16 +599,16 @@ func (c *JobClient) Update(ctx context.Context, jobID int, params v1alpha1.JobPa
 	}
 
 	// Get the updated job to return the observation
-	return c.Get(ctx, jobID)
+	return c.Get(ctx, jobID, params)
 }
 
-// Delete removes a Logpush Job.
-func (c *JobClient) Delete(ctx context.Context, jobID int) error {
-	accountID, err := c.getAccountID(ctx)
+// Delete removes a Logpush Job pushed by the zone or account of the
+// supplied parameters.
+func (c *JobClient) Delete(ctx context.Context, jobID int, params v1alpha1.JobParameters) error {
+	rc, err := c.resourceContainer(ctx, params)
 	if err != nil {
-		return errors.Wrap(err, "failed to get account ID")
+		return err
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
 
 	err = c.client.DeleteLogpushJob(ctx, rc, jobID)
 	if err != nil && !IsJobNotFound(err) {
@@ -496,7 +622,7 @@ func (c *JobClient) Delete(ctx context.Context, jobID int) error {
 func (c *JobClient) List(ctx context.Context) ([]v1alpha1.JobObservation, error) {
 	accountID, err := c.getAccountID(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get account ID")
+		return nil, errors.Wrap(err, errGetAccountID)
 	}
 	rc := cloudflare.AccountIdentifier(accountID)
 
@@ -513,7 +639,8 @@ func (c *JobClient) List(ctx context.Context) ([]v1alpha1.JobObservation, error)
 	return observations, nil
 }
 
-// IsUpToDate checks if the Logpush Job is up to date.
+// IsUpToDate checks if the Logpush Job is up to date. Its kind is not
+// compared, since it cannot be updated.
 func (c *JobClient) IsUpToDate(ctx context.Context, params v1alpha1.JobParameters, obs v1alpha1.JobObservation) (bool, error) {
 	// Compare key fields to determine if update is needed
 	if obs.Name != params.Name ||
@@ -526,14 +653,37 @@ func (c *JobClient) IsUpToDate(ctx context.Context, params v1alpha1.JobParameter
 		return false, nil
 	}
 
+	if params.Filter != nil {
+		f, err := NormalizeFilter(*params.Filter)
+		if err != nil {
+			return false, err
+		}
+		if obs.Filter == nil || *obs.Filter != f {
+			return false, nil
+		}
+	}
+
 	return true, nil
 }
 
+// Kind returns the supplied job kind, or an empty string for the regular
+// Logpush pipeline.
+func Kind(k *string) string {
+	if k == nil {
+		return ""
+	}
+	return *k
+}
+
 // IsJobNotFound returns true if the error indicates the job was not found
 func IsJobNotFound(err error) bool {
 	if err == nil {
 		return false
 	}
+	var nf *cloudflare.NotFoundError
+	if errors.As(err, &nf) {
+		return true
+	}
 	return err.Error() == "job not found" ||
 		err.Error() == "404" ||
 		err.Error() == "Not found"
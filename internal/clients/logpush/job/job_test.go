@@ -18,6 +18,8 @@ package job
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
 	"testing"
 	"time"
 
@@ -40,6 +42,7 @@ type MockLogpushJobAPI struct {
 	MockUpdateLogpushJob   func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateLogpushJobParams) error
 	MockDeleteLogpushJob   func(ctx context.Context, rc *cloudflare.ResourceContainer, jobID int) error
 	MockListLogpushJobs    func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListLogpushJobsParams) ([]cloudflare.LogpushJob, error)
+	MockRaw                func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
 }
 
 func (m *MockLogpushJobAPI) Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
@@ -84,6 +87,13 @@ func (m *MockLogpushJobAPI) ListLogpushJobs(ctx context.Context, rc *cloudflare.
 	return []cloudflare.LogpushJob{}, nil
 }
 
+func (m *MockLogpushJobAPI) Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if m.MockRaw != nil {
+		return m.MockRaw(ctx, method, endpoint, data, headers)
+	}
+	return cloudflare.RawResponse{}, nil
+}
+
 func TestGetAccountID(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -221,7 +231,7 @@ func TestCreate(t *testing.T) {
 		want   want
 	}{
 		"CreateLogpushJobSuccess": {
-			reason: "Create should create logpush job when API call succeeds",
+			reason: "Create should create a zone's logpush job when API call succeeds",
 			fields: fields{
 				client: &MockLogpushJobAPI{
 					MockAccounts: func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
@@ -230,10 +240,10 @@ func TestCreate(t *testing.T) {
 						}, cloudflare.ResultInfo{}, nil
 					},
 					MockCreateLogpushJob: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateLogpushJobParams) (*cloudflare.LogpushJob, error) {
-						if rc.Identifier != "test-account-id" {
-							return nil, errors.New("wrong account ID")
+						if rc.Identifier != "test-zone-id" {
+							return nil, errors.New("wrong zone ID")
 						}
-						if rc.Type != cloudflare.AccountType {
+						if rc.Type != cloudflare.ZoneType {
 							return nil, errors.New("wrong resource type")
 						}
 						if params.Dataset != "http_requests" {
@@ -264,6 +274,7 @@ func TestCreate(t *testing.T) {
 				ctx: context.Background(),
 				params: v1alpha1.JobParameters{
 					Dataset:         "http_requests",
+					Zone:            ptr.To("test-zone-id"),
 					Name:            "test-job",
 					DestinationConf: "s3://bucket/path",
 					Enabled:         ptr.To(true),
@@ -288,7 +299,7 @@ func TestCreate(t *testing.T) {
 			},
 		},
 		"CreateLogpushJobMinimal": {
-			reason: "Create should create logpush job with minimal parameters",
+			reason: "Create should create an account's logpush job with minimal parameters",
 			fields: fields{
 				client: &MockLogpushJobAPI{
 					MockAccounts: func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
@@ -297,6 +308,9 @@ func TestCreate(t *testing.T) {
 						}, cloudflare.ResultInfo{}, nil
 					},
 					MockCreateLogpushJob: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateLogpushJobParams) (*cloudflare.LogpushJob, error) {
+						if rc.Identifier != "test-account-id" || rc.Type != cloudflare.AccountType {
+							return nil, errors.New("account dataset not pushed by the account")
+						}
 						return &cloudflare.LogpushJob{
 							ID:              456,
 							Dataset:         params.Dataset,
@@ -309,7 +323,7 @@ func TestCreate(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				params: v1alpha1.JobParameters{
-					Dataset:         "dns_logs",
+					Dataset:         "audit_logs",
 					Name:            "minimal-job",
 					DestinationConf: "gcs://bucket/path",
 				},
@@ -317,7 +331,7 @@ func TestCreate(t *testing.T) {
 			want: want{
 				obs: &v1alpha1.JobObservation{
 					ID:              ptr.To(456),
-					Dataset:         "dns_logs",
+					Dataset:         "audit_logs",
 					Name:            "minimal-job",
 					DestinationConf: "gcs://bucket/path",
 				},
@@ -336,7 +350,7 @@ func TestCreate(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				params: v1alpha1.JobParameters{
-					Dataset:         "http_requests",
+					Dataset:         "gateway_dns",
 					Name:            "test-job",
 					DestinationConf: "s3://bucket/path",
 				},
@@ -364,6 +378,7 @@ func TestCreate(t *testing.T) {
 				ctx: context.Background(),
 				params: v1alpha1.JobParameters{
 					Dataset:         "http_requests",
+					Zone:            ptr.To("test-zone-id"),
 					Name:            "test-job",
 					DestinationConf: "s3://bucket/path",
 				},
@@ -373,6 +388,41 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateJob),
 			},
 		},
+		"CreateZoneDatasetWithoutZone": {
+			reason: "Create should reject zone datasets that do not set a zone",
+			fields: fields{
+				client: &MockLogpushJobAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.JobParameters{
+					Dataset:         "http_requests",
+					Name:            "test-job",
+					DestinationConf: "s3://bucket/path",
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Errorf(errZoneRequiredFmt, "http_requests"), errCreateJob),
+			},
+		},
+		"CreateAccountDatasetWithZone": {
+			reason: "Create should reject account datasets that set a zone",
+			fields: fields{
+				client: &MockLogpushJobAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.JobParameters{
+					Dataset:         "gateway_dns",
+					Zone:            ptr.To("test-zone-id"),
+					Name:            "test-job",
+					DestinationConf: "s3://bucket/path",
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Errorf(errZoneForbiddenFmt, "gateway_dns"), errCreateJob),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -399,8 +449,9 @@ func TestGet(t *testing.T) {
 	}
 
 	type args struct {
-		ctx   context.Context
-		jobID int
+		ctx    context.Context
+		jobID  int
+		params v1alpha1.JobParameters
 	}
 
 	type want struct {
@@ -559,7 +610,7 @@ func TestGet(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			client := NewClient(tc.fields.client)
-			got, err := client.Get(tc.args.ctx, tc.args.jobID)
+			got, err := client.Get(tc.args.ctx, tc.args.jobID, tc.args.params)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -623,7 +674,7 @@ func TestUpdate(t *testing.T) {
 					MockGetLogpushJob: func(ctx context.Context, rc *cloudflare.ResourceContainer, jobID int) (cloudflare.LogpushJob, error) {
 						return cloudflare.LogpushJob{
 							ID:              123,
-							Dataset:         "http_requests",
+							Dataset:         "audit_logs",
 							Name:            "updated-job",
 							DestinationConf: "s3://updated-bucket/path",
 							Enabled:         false,
@@ -635,7 +686,7 @@ func TestUpdate(t *testing.T) {
 				ctx:   context.Background(),
 				jobID: jobID,
 				params: v1alpha1.JobParameters{
-					Dataset:         "http_requests",
+					Dataset:         "audit_logs",
 					Name:            "updated-job",
 					DestinationConf: "s3://updated-bucket/path",
 					Enabled:         ptr.To(false),
@@ -644,13 +695,49 @@ func TestUpdate(t *testing.T) {
 			want: want{
 				obs: &v1alpha1.JobObservation{
 					ID:              ptr.To(123),
-					Dataset:         "http_requests",
+					Dataset:         "audit_logs",
 					Name:            "updated-job",
 					DestinationConf: "s3://updated-bucket/path",
 				},
 				err: nil,
 			},
 		},
+		"UpdateLogpushJobNeverSendsKind": {
+			reason: "Update should not send the kind of a job, which cannot be changed",
+			fields: fields{
+				client: &MockLogpushJobAPI{
+					MockAccounts: func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+						return []cloudflare.Account{{ID: "test-account-id"}}, cloudflare.ResultInfo{}, nil
+					},
+					MockUpdateLogpushJob: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateLogpushJobParams) error {
+						if params.Kind != "" {
+							return errors.New("kind was sent")
+						}
+						return nil
+					},
+					MockGetLogpushJob: func(ctx context.Context, rc *cloudflare.ResourceContainer, jobID int) (cloudflare.LogpushJob, error) {
+						return cloudflare.LogpushJob{ID: 123, Dataset: "http_requests", Name: "updated-job"}, nil
+					},
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobID: jobID,
+				params: v1alpha1.JobParameters{
+					Dataset: "http_requests",
+					Zone:    ptr.To("test-zone-id"),
+					Name:    "updated-job",
+					Kind:    ptr.To("edge"),
+				},
+			},
+			want: want{
+				obs: &v1alpha1.JobObservation{
+					ID:      ptr.To(123),
+					Dataset: "http_requests",
+					Name:    "updated-job",
+				},
+			},
+		},
 		"UpdateLogpushJobAccountError": {
 			reason: "Update should return wrapped error when account lookup fails",
 			fields: fields{
@@ -664,7 +751,7 @@ func TestUpdate(t *testing.T) {
 				ctx:   context.Background(),
 				jobID: jobID,
 				params: v1alpha1.JobParameters{
-					Dataset:         "http_requests",
+					Dataset:         "gateway_http",
 					Name:            "updated-job",
 					DestinationConf: "s3://updated-bucket/path",
 				},
@@ -693,6 +780,7 @@ func TestUpdate(t *testing.T) {
 				jobID: jobID,
 				params: v1alpha1.JobParameters{
 					Dataset:         "http_requests",
+					Zone:            ptr.To("test-zone-id"),
 					Name:            "updated-job",
 					DestinationConf: "s3://updated-bucket/path",
 				},
@@ -728,8 +816,9 @@ func TestDelete(t *testing.T) {
 	}
 
 	type args struct {
-		ctx   context.Context
-		jobID int
+		ctx    context.Context
+		jobID  int
+		params v1alpha1.JobParameters
 	}
 
 	type want struct {
@@ -839,7 +928,7 @@ func TestDelete(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			client := NewClient(tc.fields.client)
-			err := client.Delete(tc.args.ctx, tc.args.jobID)
+			err := client.Delete(tc.args.ctx, tc.args.jobID, tc.args.params)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -1152,6 +1241,77 @@ func TestIsUpToDate(t *testing.T) {
 				err:      nil,
 			},
 		},
+		"IsUpToDateTrueFilter": {
+			reason: "IsUpToDate should return true when filters differ only in formatting",
+			fields: fields{
+				client: &MockLogpushJobAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.JobParameters{
+					Dataset:         "http_requests",
+					Name:            "test-job",
+					DestinationConf: "s3://bucket/path",
+					Filter:          ptr.To(`{ "where": { "and": [ { "value": "/api/", "operator": "contains", "key": "ClientRequestPath" } ] } }`),
+				},
+				obs: v1alpha1.JobObservation{
+					Dataset:         "http_requests",
+					Name:            "test-job",
+					DestinationConf: "s3://bucket/path",
+					Filter:          ptr.To(`{"where":{"and":[{"key":"ClientRequestPath","operator":"contains","value":"/api/"}]}}`),
+				},
+			},
+			want: want{
+				upToDate: true,
+			},
+		},
+		"IsUpToDateFalseFilter": {
+			reason: "IsUpToDate should return false when filters differ",
+			fields: fields{
+				client: &MockLogpushJobAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.JobParameters{
+					Dataset:         "http_requests",
+					Name:            "test-job",
+					DestinationConf: "s3://bucket/path",
+					Filter:          ptr.To(`{"where":{"key":"ClientCountry","operator":"eq","value":"ca"}}`),
+				},
+				obs: v1alpha1.JobObservation{
+					Dataset:         "http_requests",
+					Name:            "test-job",
+					DestinationConf: "s3://bucket/path",
+				},
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"IsUpToDateInvalidFilter": {
+			reason: "IsUpToDate should return an error when the desired filter is invalid",
+			fields: fields{
+				client: &MockLogpushJobAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.JobParameters{
+					Dataset:         "http_requests",
+					Name:            "test-job",
+					DestinationConf: "s3://bucket/path",
+					Filter:          ptr.To(`{"where":{"key":"ClientCountry"}}`),
+				},
+				obs: v1alpha1.JobObservation{
+					Dataset:         "http_requests",
+					Name:            "test-job",
+					DestinationConf: "s3://bucket/path",
+				},
+			},
+			want: want{
+				upToDate: false,
+				err:      errors.Wrap(errors.New("Operator is missing"), errInvalidFilter),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -1319,4 +1479,136 @@ func TestParseJobID(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestValidateScope(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.JobParameters
+		want   error
+	}{
+		"ZoneDataset": {
+			reason: "Zone datasets that set a zone should be valid",
+			params: v1alpha1.JobParameters{Dataset: "firewall_events", Zone: ptr.To("zone-id")},
+		},
+		"ZoneDatasetWithoutZone": {
+			reason: "Zone datasets that do not set a zone should be rejected",
+			params: v1alpha1.JobParameters{Dataset: "firewall_events"},
+			want:   errors.Errorf(errZoneRequiredFmt, "firewall_events"),
+		},
+		"AccountDataset": {
+			reason: "Account datasets that do not set a zone should be valid",
+			params: v1alpha1.JobParameters{Dataset: "access_requests"},
+		},
+		"AccountDatasetWithZone": {
+			reason: "Account datasets that set a zone should be rejected",
+			params: v1alpha1.JobParameters{Dataset: "audit_logs", Zone: ptr.To("zone-id")},
+			want:   errors.Errorf(errZoneForbiddenFmt, "audit_logs"),
+		},
+		"UnknownDataset": {
+			reason: "Datasets that are not known to be pushed by a zone or an account should not be validated",
+			params: v1alpha1.JobParameters{Dataset: "new_dataset", Zone: ptr.To("zone-id")},
+		},
+		"EdgeHTTPRequests": {
+			reason: "Edge jobs of the http_requests dataset of a zone should be valid",
+			params: v1alpha1.JobParameters{Dataset: "http_requests", Zone: ptr.To("zone-id"), Kind: ptr.To("edge")},
+		},
+		"EdgeOtherDataset": {
+			reason: "Edge jobs of other datasets should be rejected",
+			params: v1alpha1.JobParameters{Dataset: "dns_logs", Zone: ptr.To("zone-id"), Kind: ptr.To("edge")},
+			want:   errors.Errorf(errEdgeDatasetFmt, "dns_logs"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateScope(tc.params)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateScope(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		failure   *ValidationFailure
+		err       error
+		endpoints []string
+	}
+
+	cases := map[string]struct {
+		reason    string
+		params    v1alpha1.JobParameters
+		responses map[string]string
+		err       error
+		want      want
+	}{
+		"Valid": {
+			reason: "A job whose destination is valid should not fail validation",
+			params: v1alpha1.JobParameters{Zone: ptr.To("zone-id"), DestinationConf: "s3://bucket/logs"},
+			responses: map[string]string{
+				"/zones/zone-id/logpush/validate/destination": `{"valid":true,"message":""}`,
+			},
+			want: want{endpoints: []string{"/zones/zone-id/logpush/validate/destination"}},
+		},
+		"InvalidDestination": {
+			reason: "A destination Cloudflare cannot push to should fail validation with its message",
+			params: v1alpha1.JobParameters{Zone: ptr.To("zone-id"), DestinationConf: "s3://bucket/logs", LogpullOptions: ptr.To("fields=RayID")},
+			responses: map[string]string{
+				"/zones/zone-id/logpush/validate/destination": `{"valid":false,"message":"access denied"}`,
+			},
+			want: want{
+				failure:   &ValidationFailure{Field: "destinationConf", Message: "access denied"},
+				endpoints: []string{"/zones/zone-id/logpush/validate/destination"},
+			},
+		},
+		"InvalidOrigin": {
+			reason: "Invalid logpull options should fail validation",
+			params: v1alpha1.JobParameters{Zone: ptr.To("zone-id"), DestinationConf: "s3://bucket/logs", LogpullOptions: ptr.To("fields=Nope")},
+			responses: map[string]string{
+				"/zones/zone-id/logpush/validate/destination": `{"valid":true}`,
+				"/zones/zone-id/logpush/validate/origin":      `{"valid":false,"message":"unknown field Nope"}`,
+			},
+			want: want{
+				failure:   &ValidationFailure{Field: "logpullOptions", Message: "unknown field Nope"},
+				endpoints: []string{"/zones/zone-id/logpush/validate/destination", "/zones/zone-id/logpush/validate/origin"},
+			},
+		},
+		"Error": {
+			reason: "Errors calling the validate endpoints should be returned as errors",
+			params: v1alpha1.JobParameters{Zone: ptr.To("zone-id"), DestinationConf: "s3://bucket/logs"},
+			err:    errBoom,
+			want: want{
+				err:       errors.Wrap(errBoom, errValidateDestination),
+				endpoints: []string{"/zones/zone-id/logpush/validate/destination"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var endpoints []string
+			c := &JobClient{client: &MockLogpushJobAPI{
+				MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					endpoints = append(endpoints, endpoint)
+					if tc.err != nil {
+						return cloudflare.RawResponse{}, tc.err
+					}
+					return cloudflare.RawResponse{Result: json.RawMessage(tc.responses[endpoint])}, nil
+				},
+			}}
+			got, err := c.Validate(context.Background(), tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.failure, got); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.endpoints, endpoints); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want endpoints, +got endpoints:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retention
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+
+	"github.com/rossigee/provider-cloudflare/apis/logpush/v1alpha1"
+)
+
+// LogpullRetentionAPI defines the interface for Logpull retention operations
+type LogpullRetentionAPI interface {
+	GetLogpullRetentionFlag(ctx context.Context, zoneID string) (*cloudflare.LogpullRetentionConfiguration, error)
+	SetLogpullRetentionFlag(ctx context.Context, zoneID string, enabled bool) (*cloudflare.LogpullRetentionConfiguration, error)
+}
+
+const (
+	errGetRetention = "cannot get logpull retention"
+	errSetRetention = "cannot set logpull retention"
+)
+
+// RetentionClient provides operations for a zone's Logpull retention flag.
+type RetentionClient struct {
+	client LogpullRetentionAPI
+}
+
+// NewClient creates a new Logpull retention client.
+func NewClient(client LogpullRetentionAPI) *RetentionClient {
+	return &RetentionClient{client: client}
+}
+
+// Get retrieves the Logpull retention setting of a zone.
+func (c *RetentionClient) Get(ctx context.Context, zoneID string) (*v1alpha1.LogpullRetentionObservation, error) {
+	cfg, err := c.client.GetLogpullRetentionFlag(ctx, zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetRetention)
+	}
+	return &v1alpha1.LogpullRetentionObservation{Enabled: &cfg.Flag}, nil
+}
+
+// Set enables or disables Logpull retention for a zone.
+func (c *RetentionClient) Set(ctx context.Context, zoneID string, enabled bool) (*v1alpha1.LogpullRetentionObservation, error) {
+	cfg, err := c.client.SetLogpullRetentionFlag(ctx, zoneID, enabled)
+	if err != nil {
+		return nil, errors.Wrap(err, errSetRetention)
+	}
+	return &v1alpha1.LogpullRetentionObservation{Enabled: &cfg.Flag}, nil
+}
+
+// IsUpToDate checks if the observed setting matches the desired one.
+func IsUpToDate(params v1alpha1.LogpullRetentionParameters, obs v1alpha1.LogpullRetentionObservation) bool {
+	return obs.Enabled != nil && *obs.Enabled == params.Enabled
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retention
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/logpush/v1alpha1"
+)
+
+// MockLogpullRetentionAPI implements the LogpullRetentionAPI interface for testing
+type MockLogpullRetentionAPI struct {
+	MockGetLogpullRetentionFlag func(ctx context.Context, zoneID string) (*cloudflare.LogpullRetentionConfiguration, error)
+	MockSetLogpullRetentionFlag func(ctx context.Context, zoneID string, enabled bool) (*cloudflare.LogpullRetentionConfiguration, error)
+}
+
+func (m *MockLogpullRetentionAPI) GetLogpullRetentionFlag(ctx context.Context, zoneID string) (*cloudflare.LogpullRetentionConfiguration, error) {
+	return m.MockGetLogpullRetentionFlag(ctx, zoneID)
+}
+
+func (m *MockLogpullRetentionAPI) SetLogpullRetentionFlag(ctx context.Context, zoneID string, enabled bool) (*cloudflare.LogpullRetentionConfiguration, error) {
+	return m.MockSetLogpullRetentionFlag(ctx, zoneID, enabled)
+}
+
+func TestSet(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs *v1alpha1.LogpullRetentionObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		client  *MockLogpullRetentionAPI
+		enabled bool
+		want    want
+	}{
+		"Success": {
+			reason: "Set should return the setting reported by the API",
+			client: &MockLogpullRetentionAPI{
+				MockSetLogpullRetentionFlag: func(ctx context.Context, zoneID string, enabled bool) (*cloudflare.LogpullRetentionConfiguration, error) {
+					if zoneID != "zone-id" {
+						return nil, errors.New("wrong zone ID")
+					}
+					return &cloudflare.LogpullRetentionConfiguration{Flag: enabled}, nil
+				},
+			},
+			enabled: true,
+			want:    want{obs: &v1alpha1.LogpullRetentionObservation{Enabled: ptr.To(true)}},
+		},
+		"APIError": {
+			reason: "Set should return wrapped error when API call fails",
+			client: &MockLogpullRetentionAPI{
+				MockSetLogpullRetentionFlag: func(ctx context.Context, zoneID string, enabled bool) (*cloudflare.LogpullRetentionConfiguration, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{err: errors.Wrap(errBoom, errSetRetention)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := NewClient(tc.client).Set(context.Background(), "zone-id", tc.enabled)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSet(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\nSet(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.LogpullRetentionParameters
+		obs    v1alpha1.LogpullRetentionObservation
+		want   bool
+	}{
+		"Matches": {
+			reason: "IsUpToDate should return true when the flag matches",
+			params: v1alpha1.LogpullRetentionParameters{Enabled: true},
+			obs:    v1alpha1.LogpullRetentionObservation{Enabled: ptr.To(true)},
+			want:   true,
+		},
+		"Differs": {
+			reason: "IsUpToDate should return false when the flag differs",
+			params: v1alpha1.LogpullRetentionParameters{Enabled: false},
+			obs:    v1alpha1.LogpullRetentionObservation{Enabled: ptr.To(true)},
+			want:   false,
+		},
+		"Unknown": {
+			reason: "IsUpToDate should return false when the flag has not been observed",
+			params: v1alpha1.LogpullRetentionParameters{Enabled: true},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.params, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lookup caches the results of Cloudflare metadata lookups, such as
+// resolving a zone name to its ID, that many controllers would otherwise
+// repeat against list endpoints on every reconcile.
+package lookup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	// DefaultTTL is how long lookups are cached by the Default cache.
+	DefaultTTL = 10 * time.Minute
+
+	kindZone    = "zone"
+	kindAccount = "account"
+
+	errListZones    = "cannot list zones"
+	errListAccounts = "failed to list accounts"
+	errNoZone       = "zone could not be found"
+	errAmbiguous    = "ambiguous zone name"
+	errNoAccount    = "no accounts found"
+)
+
+// Default is the cache shared by all controllers.
+var Default = New(DefaultTTL)
+
+// ZoneAPI lists zones.
+type ZoneAPI interface {
+	ListZonesContext(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error)
+}
+
+// AccountAPI lists accounts.
+type AccountAPI interface {
+	Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error)
+}
+
+type entry struct {
+	value   string
+	expires time.Time
+}
+
+// A Cache caches lookups for a fixed TTL. Entries are scoped to the
+// credentials they were looked up with, as different credentials may see
+// different zones and accounts. It is safe for concurrent use.
+type Cache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+// New returns a Cache whose entries expire after the supplied TTL.
+func New(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, now: time.Now, entries: map[string]entry{}}
+}
+
+// Scope identifies the credentials of the supplied client, without
+// retaining them. It returns an empty scope, which is never cached, for
+// clients that are not a *cloudflare.API such as test fakes.
+func Scope(client interface{}) string {
+	api, ok := client.(*cloudflare.API)
+	if !ok || api == nil {
+		return ""
+	}
+	h := sha256.Sum256([]byte(strings.Join([]string{api.APIToken, api.APIKey, api.APIEmail, api.APIUserServiceKey}, "\x00")))
+	return hex.EncodeToString(h[:])
+}
+
+// ZoneID returns the ID of the named zone.
+func (c *Cache) ZoneID(ctx context.Context, api ZoneAPI, scope, name string) (string, error) {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	return c.lookup(kindZone, scope, name, func() (string, error) {
+		res, err := api.ListZonesContext(ctx, cloudflare.WithZoneFilters(name, "", ""))
+		if err != nil {
+			return "", errors.Wrap(err, errListZones)
+		}
+		switch len(res.Result) {
+		case 0:
+			return "", errors.New(errNoZone)
+		case 1:
+			return res.Result[0].ID, nil
+		default:
+			return "", errors.New(errAmbiguous)
+		}
+	})
+}
+
+// AccountID returns the ID of the first account the credentials can access,
+// for resources that do not specify one.
+func (c *Cache) AccountID(ctx context.Context, api AccountAPI, scope string) (string, error) {
+	return c.lookup(kindAccount, scope, "", func() (string, error) {
+		accounts, _, err := api.Accounts(ctx, cloudflare.AccountsListParams{})
+		if err != nil {
+			return "", errors.Wrap(err, errListAccounts)
+		}
+		if len(accounts) == 0 {
+			return "", errors.New(errNoAccount)
+		}
+		return accounts[0].ID, nil
+	})
+}
+
+// Invalidate forgets every entry of the supplied scope, e.g. when a cached
+// ID turns out to be stale.
+func (c *Cache) Invalidate(scope string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if strings.HasPrefix(k, scope+"/") {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// lookup returns the cached value of the key, or the result of fn. Failed
+// lookups are not cached.
+func (c *Cache) lookup(kind, scope, key string, fn func() (string, error)) (string, error) {
+	if scope == "" {
+		return fn()
+	}
+	k := scope + "/" + kind + "/" + key
+
+	c.mu.Lock()
+	e, ok := c.entries[k]
+	c.mu.Unlock()
+	if ok && c.now().Before(e.expires) {
+		metrics.RecordLookupCache(kind, true)
+		return e.value, nil
+	}
+	metrics.RecordLookupCache(kind, false)
+
+	v, err := fn()
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	c.entries[k] = entry{value: v, expires: c.now().Add(c.ttl)}
+	c.mu.Unlock()
+	return v, nil
+}
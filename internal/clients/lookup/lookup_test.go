@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lookup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type fakeZoneAPI struct {
+	calls int
+	zones []cloudflare.Zone
+	err   error
+}
+
+func (f *fakeZoneAPI) ListZonesContext(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error) {
+	f.calls++
+	return cloudflare.ZonesResponse{Result: f.zones}, f.err
+}
+
+type fakeAccountAPI struct {
+	calls    int
+	accounts []cloudflare.Account
+	err      error
+}
+
+func (f *fakeAccountAPI) Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+	f.calls++
+	return f.accounts, cloudflare.ResultInfo{}, f.err
+}
+
+func TestZoneID(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		id    string
+		err   error
+		calls int
+	}
+
+	cases := map[string]struct {
+		reason  string
+		api     *fakeZoneAPI
+		scope   string
+		names   []string
+		advance time.Duration
+		want    want
+	}{
+		"Cached": {
+			reason: "Repeated lookups of the same zone should be served from the cache",
+			api:    &fakeZoneAPI{zones: []cloudflare.Zone{{ID: "zone-id"}}},
+			scope:  "creds",
+			names:  []string{"example.com", "Example.com.", "example.com"},
+			want:   want{id: "zone-id", calls: 1},
+		},
+		"Expired": {
+			reason:  "Lookups should be repeated once the cached entry expires",
+			api:     &fakeZoneAPI{zones: []cloudflare.Zone{{ID: "zone-id"}}},
+			scope:   "creds",
+			names:   []string{"example.com", "example.com"},
+			advance: 2 * time.Minute,
+			want:    want{id: "zone-id", calls: 2},
+		},
+		"Unscoped": {
+			reason: "Lookups without a scope should never be cached",
+			api:    &fakeZoneAPI{zones: []cloudflare.Zone{{ID: "zone-id"}}},
+			names:  []string{"example.com", "example.com"},
+			want:   want{id: "zone-id", calls: 2},
+		},
+		"NotFound": {
+			reason: "An error should be returned, and not cached, when no zone matches",
+			api:    &fakeZoneAPI{},
+			scope:  "creds",
+			names:  []string{"example.com", "example.com"},
+			want:   want{err: errors.New(errNoZone), calls: 2},
+		},
+		"Ambiguous": {
+			reason: "An error should be returned when several zones match",
+			api:    &fakeZoneAPI{zones: []cloudflare.Zone{{ID: "a"}, {ID: "b"}}},
+			scope:  "creds",
+			names:  []string{"example.com"},
+			want:   want{err: errors.New(errAmbiguous), calls: 1},
+		},
+		"ListError": {
+			reason: "Errors listing zones should be returned",
+			api:    &fakeZoneAPI{err: errBoom},
+			scope:  "creds",
+			names:  []string{"example.com"},
+			want:   want{err: errors.Wrap(errBoom, errListZones), calls: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			c := New(time.Minute)
+			c.now = func() time.Time { return now }
+
+			var id string
+			var err error
+			for _, n := range tc.names {
+				id, err = c.ZoneID(context.Background(), tc.api, tc.scope, n)
+				now = now.Add(tc.advance)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nZoneID(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\n%s\nZoneID(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.api.calls); diff != "" {
+				t.Errorf("\n%s\nZoneID(...): -want API calls, +got API calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAccountID(t *testing.T) {
+	api := &fakeAccountAPI{accounts: []cloudflare.Account{{ID: "first"}, {ID: "second"}}}
+	c := New(time.Minute)
+
+	for _, scope := range []string{"a", "a", "b"} {
+		id, err := c.AccountID(context.Background(), api, scope)
+		if err != nil {
+			t.Fatalf("AccountID(...): unexpected error: %v", err)
+		}
+		if id != "first" {
+			t.Errorf("AccountID(...): want first, got %s", id)
+		}
+	}
+	if api.calls != 2 {
+		t.Errorf("AccountID(...): lookups should be cached per scope: want 2 API calls, got %d", api.calls)
+	}
+
+	c.Invalidate("a")
+	if _, err := c.AccountID(context.Background(), api, "a"); err != nil {
+		t.Fatalf("AccountID(...): unexpected error: %v", err)
+	}
+	if api.calls != 3 {
+		t.Errorf("AccountID(...): an invalidated scope should be looked up again: want 3 API calls, got %d", api.calls)
+	}
+}
+
+func TestScope(t *testing.T) {
+	a, _ := cloudflare.NewWithAPIToken("token-a")
+	b, _ := cloudflare.NewWithAPIToken("token-b")
+
+	if Scope(a) == "" || Scope(a) == Scope(b) {
+		t.Errorf("Scope(...): different credentials should have different, non-empty scopes")
+	}
+	if Scope(&fakeAccountAPI{}) != "" {
+		t.Errorf("Scope(...): clients that are not a *cloudflare.API should not be scoped")
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// Metadata is the Kubernetes metadata of a managed resource that is written
+// to Cloudflare.
+type Metadata struct {
+	// Tags are key:value tags, sorted.
+	Tags []string
+
+	// Comment is the comment of the resource, if one is propagated.
+	Comment *string
+}
+
+// PropagatedMetadata returns the metadata of the supplied object selected
+// by the supplied propagation settings, or nil if propagation is disabled.
+func PropagatedMetadata(p *v1alpha1.MetadataPropagation, o metav1.Object) *Metadata {
+	if p == nil {
+		return nil
+	}
+	md := &Metadata{Tags: []string{}}
+	for _, k := range p.Labels {
+		if v, ok := o.GetLabels()[k]; ok {
+			md.Tags = append(md.Tags, k+":"+v)
+		}
+	}
+	for _, k := range p.Annotations {
+		if v, ok := o.GetAnnotations()[k]; ok {
+			md.Tags = append(md.Tags, k+":"+v)
+		}
+	}
+	sort.Strings(md.Tags)
+	if p.CommentAnnotation != nil {
+		c := o.GetAnnotations()[*p.CommentAnnotation]
+		md.Comment = &c
+	}
+	return md
+}
+
+// WithTag returns the metadata plus the supplied tag, which is written even
+// if nothing else is propagated.
+func (m *Metadata) WithTag(tag string) *Metadata {
+	out := &Metadata{Tags: []string{tag}}
+	if m != nil {
+		for _, t := range m.Tags {
+			if t != tag {
+				out.Tags = append(out.Tags, t)
+			}
+		}
+		out.Comment = m.Comment
+	}
+	sort.Strings(out.Tags)
+	return out
+}
+
+// MergeTags returns the supplied tags plus the propagated ones, without
+// duplicates. The supplied tags are returned unchanged if nothing is
+// propagated.
+func (m *Metadata) MergeTags(tags []string) []string {
+	if m == nil || len(m.Tags) == 0 {
+		return tags
+	}
+	out := append([]string{}, tags...)
+	seen := map[string]bool{}
+	for _, t := range tags {
+		seen[t] = true
+	}
+	for _, t := range m.Tags {
+		if !seen[t] {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// UpToDate returns true if the supplied tags and comment observed in
+// Cloudflare match the propagated metadata. Anything is up to date when
+// nothing is propagated.
+func (m *Metadata) UpToDate(tags []string, comment string) bool {
+	if m == nil {
+		return true
+	}
+	if m.Comment != nil && *m.Comment != comment {
+		return false
+	}
+	observed := append([]string{}, tags...)
+	sort.Strings(observed)
+	if len(observed) != len(m.Tags) {
+		return false
+	}
+	for i := range observed {
+		if observed[i] != m.Tags[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+func TestPropagatedMetadata(t *testing.T) {
+	o := &metav1.ObjectMeta{
+		Labels:      map[string]string{"team": "web", "env": "prod", "ignored": "x"},
+		Annotations: map[string]string{"owner": "alice", "example.org/description": "Frontend"},
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      *v1alpha1.MetadataPropagation
+		want   *Metadata
+	}{
+		"Disabled": {
+			reason: "Nothing should be propagated without propagation settings",
+		},
+		"Selected": {
+			reason: "Only the selected labels and annotations should be propagated, as sorted tags",
+			p: &v1alpha1.MetadataPropagation{
+				Labels:            []string{"team", "env", "missing"},
+				Annotations:       []string{"owner"},
+				CommentAnnotation: ptr.To("example.org/description"),
+			},
+			want: &Metadata{
+				Tags:    []string{"env:prod", "owner:alice", "team:web"},
+				Comment: ptr.To("Frontend"),
+			},
+		},
+		"MissingComment": {
+			reason: "A missing comment annotation should propagate an empty comment",
+			p:      &v1alpha1.MetadataPropagation{CommentAnnotation: ptr.To("missing")},
+			want:   &Metadata{Tags: []string{}, Comment: ptr.To("")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := PropagatedMetadata(tc.p, o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nPropagatedMetadata(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMetadataMergeTags(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		md     *Metadata
+		tags   []string
+		want   []string
+	}{
+		"Disabled": {
+			reason: "Tags should be unchanged when nothing is propagated",
+			tags:   []string{"a"},
+			want:   []string{"a"},
+		},
+		"Merged": {
+			reason: "Propagated tags should be appended without duplicates",
+			md:     &Metadata{Tags: []string{"a", "team:web"}},
+			tags:   []string{"a"},
+			want:   []string{"a", "team:web"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.md.MergeTags(tc.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nMergeTags(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMetadataWithTag(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		md     *Metadata
+		want   *Metadata
+	}{
+		"NothingPropagated": {
+			reason: "The tag should be written even when nothing is propagated",
+			want:   &Metadata{Tags: []string{"visibility:internal"}},
+		},
+		"Propagated": {
+			reason: "The tag should be added to the propagated metadata without duplicates",
+			md:     &Metadata{Tags: []string{"team:web", "visibility:internal"}, Comment: ptr.To("Frontend")},
+			want:   &Metadata{Tags: []string{"team:web", "visibility:internal"}, Comment: ptr.To("Frontend")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.md.WithTag("visibility:internal")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nWithTag(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMetadataUpToDate(t *testing.T) {
+	md := &Metadata{Tags: []string{"env:prod", "team:web"}, Comment: ptr.To("Frontend")}
+
+	cases := map[string]struct {
+		reason  string
+		md      *Metadata
+		tags    []string
+		comment string
+		want    bool
+	}{
+		"Disabled": {
+			reason: "Anything should be up to date when nothing is propagated",
+			tags:   []string{"other"},
+			want:   true,
+		},
+		"Matching": {
+			reason:  "Tags should be compared regardless of order",
+			md:      md,
+			tags:    []string{"team:web", "env:prod"},
+			comment: "Frontend",
+			want:    true,
+		},
+		"ExtraTag": {
+			reason:  "Tags added outside of Kubernetes should be removed",
+			md:      md,
+			tags:    []string{"team:web", "env:prod", "other"},
+			comment: "Frontend",
+		},
+		"Comment": {
+			reason: "A differing comment should not be up to date",
+			md:     md,
+			tags:   []string{"team:web", "env:prod"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.md.UpToDate(tc.tags, tc.comment)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
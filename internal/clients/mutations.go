@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+// DefaultMaxInFlightMutations is the default number of mutating Cloudflare
+// API requests that may be in flight at once across all controllers.
+const DefaultMaxInFlightMutations = 10
+
+// mutationSlots bounds the number of mutating requests in flight. A nil
+// channel disables the limit.
+var mutationSlots = make(chan struct{}, DefaultMaxInFlightMutations)
+
+// SetMaxInFlightMutations sets the number of mutating Cloudflare API
+// requests that may be in flight at once across all controllers. A value
+// of zero or less disables the limit. It must be called before any
+// controllers are started.
+func SetMaxInFlightMutations(n int) {
+	if n <= 0 {
+		mutationSlots = nil
+		return
+	}
+	mutationSlots = make(chan struct{}, n)
+}
+
+// isMutation returns true for request methods that change state at
+// Cloudflare.
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// mutationLimiter is an http.RoundTripper that holds mutating requests
+// until an in-flight slot is free, so that a large apply (e.g. thousands
+// of Records created at once) is spread out rather than sent as a spike.
+// Reads are never held.
+type mutationLimiter struct {
+	slots chan struct{}
+	next  http.RoundTripper
+}
+
+func (l *mutationLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if l.slots == nil || !isMutation(req.Method) {
+		return l.next.RoundTrip(req)
+	}
+
+	start := time.Now()
+	select {
+	case l.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-l.slots }()
+	metrics.ObserveMutationWait(time.Since(start))
+
+	return l.next.RoundTrip(req)
+}
+
+// limitMutations returns a copy of the supplied client whose mutating
+// requests share the global in-flight limit.
+func limitMutations(hc *http.Client) *http.Client {
+	next := hc.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	lhc := *hc
+	lhc.Transport = &mutationLimiter{slots: mutationSlots, next: next}
+	return &lhc
+}
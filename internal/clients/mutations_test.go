@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type roundTripperFn func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripperFn) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestMutationLimiter(t *testing.T) {
+	ok := roundTripperFn(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	cases := map[string]struct {
+		reason string
+		method string
+		held   int
+		ctx    func() context.Context
+		want   error
+	}{
+		"ReadNotHeld": {
+			reason: "Reads should be sent even when every slot is held",
+			method: http.MethodGet,
+			held:   1,
+			ctx:    context.Background,
+		},
+		"MutationSent": {
+			reason: "Mutations should be sent when a slot is free",
+			method: http.MethodPost,
+			ctx:    context.Background,
+		},
+		"MutationHeld": {
+			reason: "Mutations should wait for a slot and give up when the request is cancelled",
+			method: http.MethodDelete,
+			held:   1,
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			want: context.Canceled,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &mutationLimiter{slots: make(chan struct{}, 1), next: ok}
+			for i := 0; i < tc.held; i++ {
+				l.slots <- struct{}{}
+			}
+
+			req, _ := http.NewRequestWithContext(tc.ctx(), tc.method, "https://api.cloudflare.com/client/v4/zones", nil)
+			_, err := l.RoundTrip(req)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.held, len(l.slots)); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want slots held, +got slots held:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
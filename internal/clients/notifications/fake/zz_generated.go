@@ -0,0 +1,246 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"sync"
+)
+
+// ClientMock is a mock implementation of notifications.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked notifications.Client
+//		mockedClient := &ClientMock{
+//			CreateNotificationPolicyFunc: func(ctx context.Context, accountID string, policy cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error) {
+//				panic("mock out the CreateNotificationPolicy method")
+//			},
+//			DeleteNotificationPolicyFunc: func(ctx context.Context, accountID string, policyID string) (cloudflare.SaveResponse, error) {
+//				panic("mock out the DeleteNotificationPolicy method")
+//			},
+//			ListNotificationPoliciesFunc: func(ctx context.Context, accountID string) (cloudflare.NotificationPoliciesResponse, error) {
+//				panic("mock out the ListNotificationPolicies method")
+//			},
+//			UpdateNotificationPolicyFunc: func(ctx context.Context, accountID string, policy *cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error) {
+//				panic("mock out the UpdateNotificationPolicy method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires notifications.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// CreateNotificationPolicyFunc mocks the CreateNotificationPolicy method.
+	CreateNotificationPolicyFunc func(ctx context.Context, accountID string, policy cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error)
+
+	// DeleteNotificationPolicyFunc mocks the DeleteNotificationPolicy method.
+	DeleteNotificationPolicyFunc func(ctx context.Context, accountID string, policyID string) (cloudflare.SaveResponse, error)
+
+	// ListNotificationPoliciesFunc mocks the ListNotificationPolicies method.
+	ListNotificationPoliciesFunc func(ctx context.Context, accountID string) (cloudflare.NotificationPoliciesResponse, error)
+
+	// UpdateNotificationPolicyFunc mocks the UpdateNotificationPolicy method.
+	UpdateNotificationPolicyFunc func(ctx context.Context, accountID string, policy *cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateNotificationPolicy holds details about calls to the CreateNotificationPolicy method.
+		CreateNotificationPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountID is the accountID argument value.
+			AccountID string
+			// Policy is the policy argument value.
+			Policy cloudflare.NotificationPolicy
+		}
+		// DeleteNotificationPolicy holds details about calls to the DeleteNotificationPolicy method.
+		DeleteNotificationPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountID is the accountID argument value.
+			AccountID string
+			// PolicyID is the policyID argument value.
+			PolicyID string
+		}
+		// ListNotificationPolicies holds details about calls to the ListNotificationPolicies method.
+		ListNotificationPolicies []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountID is the accountID argument value.
+			AccountID string
+		}
+		// UpdateNotificationPolicy holds details about calls to the UpdateNotificationPolicy method.
+		UpdateNotificationPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountID is the accountID argument value.
+			AccountID string
+			// Policy is the policy argument value.
+			Policy *cloudflare.NotificationPolicy
+		}
+	}
+	lockCreateNotificationPolicy sync.RWMutex
+	lockDeleteNotificationPolicy sync.RWMutex
+	lockListNotificationPolicies sync.RWMutex
+	lockUpdateNotificationPolicy sync.RWMutex
+}
+
+// CreateNotificationPolicy calls CreateNotificationPolicyFunc.
+func (mock *ClientMock) CreateNotificationPolicy(ctx context.Context, accountID string, policy cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error) {
+	if mock.CreateNotificationPolicyFunc == nil {
+		panic("ClientMock.CreateNotificationPolicyFunc: method is nil but Client.CreateNotificationPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		AccountID string
+		Policy    cloudflare.NotificationPolicy
+	}{
+		Ctx:       ctx,
+		AccountID: accountID,
+		Policy:    policy,
+	}
+	mock.lockCreateNotificationPolicy.Lock()
+	mock.calls.CreateNotificationPolicy = append(mock.calls.CreateNotificationPolicy, callInfo)
+	mock.lockCreateNotificationPolicy.Unlock()
+	return mock.CreateNotificationPolicyFunc(ctx, accountID, policy)
+}
+
+// CreateNotificationPolicyCalls gets all the calls that were made to CreateNotificationPolicy.
+// Check the length with:
+//
+//	len(mockedClient.CreateNotificationPolicyCalls())
+func (mock *ClientMock) CreateNotificationPolicyCalls() []struct {
+	Ctx       context.Context
+	AccountID string
+	Policy    cloudflare.NotificationPolicy
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AccountID string
+		Policy    cloudflare.NotificationPolicy
+	}
+	mock.lockCreateNotificationPolicy.RLock()
+	calls = mock.calls.CreateNotificationPolicy
+	mock.lockCreateNotificationPolicy.RUnlock()
+	return calls
+}
+
+// DeleteNotificationPolicy calls DeleteNotificationPolicyFunc.
+func (mock *ClientMock) DeleteNotificationPolicy(ctx context.Context, accountID string, policyID string) (cloudflare.SaveResponse, error) {
+	if mock.DeleteNotificationPolicyFunc == nil {
+		panic("ClientMock.DeleteNotificationPolicyFunc: method is nil but Client.DeleteNotificationPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		AccountID string
+		PolicyID  string
+	}{
+		Ctx:       ctx,
+		AccountID: accountID,
+		PolicyID:  policyID,
+	}
+	mock.lockDeleteNotificationPolicy.Lock()
+	mock.calls.DeleteNotificationPolicy = append(mock.calls.DeleteNotificationPolicy, callInfo)
+	mock.lockDeleteNotificationPolicy.Unlock()
+	return mock.DeleteNotificationPolicyFunc(ctx, accountID, policyID)
+}
+
+// DeleteNotificationPolicyCalls gets all the calls that were made to DeleteNotificationPolicy.
+// Check the length with:
+//
+//	len(mockedClient.DeleteNotificationPolicyCalls())
+func (mock *ClientMock) DeleteNotificationPolicyCalls() []struct {
+	Ctx       context.Context
+	AccountID string
+	PolicyID  string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AccountID string
+		PolicyID  string
+	}
+	mock.lockDeleteNotificationPolicy.RLock()
+	calls = mock.calls.DeleteNotificationPolicy
+	mock.lockDeleteNotificationPolicy.RUnlock()
+	return calls
+}
+
+// ListNotificationPolicies calls ListNotificationPoliciesFunc.
+func (mock *ClientMock) ListNotificationPolicies(ctx context.Context, accountID string) (cloudflare.NotificationPoliciesResponse, error) {
+	if mock.ListNotificationPoliciesFunc == nil {
+		panic("ClientMock.ListNotificationPoliciesFunc: method is nil but Client.ListNotificationPolicies was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		AccountID string
+	}{
+		Ctx:       ctx,
+		AccountID: accountID,
+	}
+	mock.lockListNotificationPolicies.Lock()
+	mock.calls.ListNotificationPolicies = append(mock.calls.ListNotificationPolicies, callInfo)
+	mock.lockListNotificationPolicies.Unlock()
+	return mock.ListNotificationPoliciesFunc(ctx, accountID)
+}
+
+// ListNotificationPoliciesCalls gets all the calls that were made to ListNotificationPolicies.
+// Check the length with:
+//
+//	len(mockedClient.ListNotificationPoliciesCalls())
+func (mock *ClientMock) ListNotificationPoliciesCalls() []struct {
+	Ctx       context.Context
+	AccountID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AccountID string
+	}
+	mock.lockListNotificationPolicies.RLock()
+	calls = mock.calls.ListNotificationPolicies
+	mock.lockListNotificationPolicies.RUnlock()
+	return calls
+}
+
+// UpdateNotificationPolicy calls UpdateNotificationPolicyFunc.
+func (mock *ClientMock) UpdateNotificationPolicy(ctx context.Context, accountID string, policy *cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error) {
+	if mock.UpdateNotificationPolicyFunc == nil {
+		panic("ClientMock.UpdateNotificationPolicyFunc: method is nil but Client.UpdateNotificationPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		AccountID string
+		Policy    *cloudflare.NotificationPolicy
+	}{
+		Ctx:       ctx,
+		AccountID: accountID,
+		Policy:    policy,
+	}
+	mock.lockUpdateNotificationPolicy.Lock()
+	mock.calls.UpdateNotificationPolicy = append(mock.calls.UpdateNotificationPolicy, callInfo)
+	mock.lockUpdateNotificationPolicy.Unlock()
+	return mock.UpdateNotificationPolicyFunc(ctx, accountID, policy)
+}
+
+// UpdateNotificationPolicyCalls gets all the calls that were made to UpdateNotificationPolicy.
+// Check the length with:
+//
+//	len(mockedClient.UpdateNotificationPolicyCalls())
+func (mock *ClientMock) UpdateNotificationPolicyCalls() []struct {
+	Ctx       context.Context
+	AccountID string
+	Policy    *cloudflare.NotificationPolicy
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AccountID string
+		Policy    *cloudflare.NotificationPolicy
+	}
+	mock.lockUpdateNotificationPolicy.RLock()
+	calls = mock.calls.UpdateNotificationPolicy
+	mock.lockUpdateNotificationPolicy.RUnlock()
+	return calls
+}
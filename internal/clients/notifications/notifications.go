@@ -0,0 +1,207 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notifications manages the Cloudflare notification policies the
+// provider creates on behalf of other managed resources, such as the expiry
+// alert of a CertificatePack.
+package notifications
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+)
+
+// Delivery mechanisms of a notification policy.
+const (
+	MechanismEmail     = "email"
+	MechanismWebhooks  = "webhooks"
+	MechanismPagerDuty = "pagerduty"
+)
+
+const (
+	errListPolicies  = "cannot list notification policies"
+	errCreatePolicy  = "cannot create notification policy"
+	errUpdatePolicy  = "cannot update notification policy"
+	errDeletePolicy  = "cannot delete notification policy"
+	errNoDestination = "notification policy has no destinations"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with notification policies.
+type Client interface {
+	ListNotificationPolicies(ctx context.Context, accountID string) (cloudflare.NotificationPoliciesResponse, error)
+	CreateNotificationPolicy(ctx context.Context, accountID string, policy cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error)
+	UpdateNotificationPolicy(ctx context.Context, accountID string, policy *cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error)
+	DeleteNotificationPolicy(ctx context.Context, accountID, policyID string) (cloudflare.SaveResponse, error)
+}
+
+// A Policy is a notification policy managed for a managed resource.
+type Policy struct {
+	// AccountID of the account owning the policy.
+	AccountID string
+
+	// Owner identifies the managed resource the policy is managed for,
+	// e.g. certificatepack/example.
+	Owner string
+
+	Name      string
+	AlertType string
+	Enabled   bool
+	Filters   map[string][]string
+
+	Emails    []string
+	Webhooks  []string
+	PagerDuty []string
+}
+
+// ManagedDescription returns the description marking the notification
+// policy managed for the supplied owner.
+func ManagedDescription(owner string) string {
+	return "managed-by: crossplane " + owner
+}
+
+// Get returns the notification policy managed for the supplied owner in
+// the supplied account, if there is one.
+func Get(ctx context.Context, client Client, accountID, owner string) (*cloudflare.NotificationPolicy, error) {
+	res, err := client.ListNotificationPolicies(ctx, accountID)
+	if err != nil {
+		return nil, errors.Wrap(err, errListPolicies)
+	}
+	d := ManagedDescription(owner)
+	for i := range res.Result {
+		if res.Result[i].Description == d {
+			return &res.Result[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Ensure creates the supplied policy, or updates the existing policy
+// managed for its owner if it differs, and returns its ID.
+func Ensure(ctx context.Context, client Client, p Policy) (string, error) {
+	if len(p.Emails)+len(p.Webhooks)+len(p.PagerDuty) == 0 {
+		return "", errors.New(errNoDestination)
+	}
+	existing, err := Get(ctx, client, p.AccountID, p.Owner)
+	if err != nil {
+		return "", err
+	}
+
+	np := generatePolicy(p)
+	if existing == nil {
+		res, err := client.CreateNotificationPolicy(ctx, p.AccountID, np)
+		return res.Result.ID, errors.Wrap(err, errCreatePolicy)
+	}
+	if UpToDate(p, *existing) {
+		return existing.ID, nil
+	}
+	np.ID = existing.ID
+	_, err = client.UpdateNotificationPolicy(ctx, p.AccountID, &np)
+	return existing.ID, errors.Wrap(err, errUpdatePolicy)
+}
+
+// Delete deletes the notification policy managed for the supplied owner,
+// if there is one.
+func Delete(ctx context.Context, client Client, accountID, owner string) error {
+	existing, err := Get(ctx, client, accountID, owner)
+	if err != nil || existing == nil {
+		return err
+	}
+	_, err = client.DeleteNotificationPolicy(ctx, accountID, existing.ID)
+	if isNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeletePolicy)
+}
+
+// UpToDate returns whether the supplied notification policy matches the
+// desired policy.
+func UpToDate(p Policy, np cloudflare.NotificationPolicy) bool {
+	want := generatePolicy(p)
+	if np.Name != want.Name || np.AlertType != want.AlertType || np.Enabled != want.Enabled {
+		return false
+	}
+	if len(np.Filters) != len(want.Filters) {
+		return false
+	}
+	for k, v := range want.Filters {
+		if !sameIDs(v, np.Filters[k]) {
+			return false
+		}
+	}
+	for _, m := range []string{MechanismEmail, MechanismWebhooks, MechanismPagerDuty} {
+		if !sameIDs(mechanismIDs(want.Mechanisms[m]), mechanismIDs(np.Mechanisms[m])) {
+			return false
+		}
+	}
+	return true
+}
+
+func generatePolicy(p Policy) cloudflare.NotificationPolicy {
+	np := cloudflare.NotificationPolicy{
+		Name:        p.Name,
+		Description: ManagedDescription(p.Owner),
+		Enabled:     p.Enabled,
+		AlertType:   p.AlertType,
+		Mechanisms:  map[string]cloudflare.NotificationMechanismIntegrations{},
+		Conditions:  map[string]interface{}{},
+		Filters:     p.Filters,
+	}
+	if np.Filters == nil {
+		np.Filters = map[string][]string{}
+	}
+	for m, ids := range map[string][]string{MechanismEmail: p.Emails, MechanismWebhooks: p.Webhooks, MechanismPagerDuty: p.PagerDuty} {
+		if len(ids) == 0 {
+			continue
+		}
+		in := make(cloudflare.NotificationMechanismIntegrations, 0, len(ids))
+		for _, id := range ids {
+			in = append(in, cloudflare.NotificationMechanismData{ID: id})
+		}
+		np.Mechanisms[m] = in
+	}
+	return np
+}
+
+func mechanismIDs(in cloudflare.NotificationMechanismIntegrations) []string {
+	ids := make([]string, 0, len(in))
+	for _, d := range in {
+		ids = append(ids, d.ID)
+	}
+	return ids
+}
+
+// sameIDs returns whether the supplied lists hold the same IDs, in any
+// order.
+func sameIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+func isNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients/notifications/fake"
+)
+
+func testPolicy() Policy {
+	return Policy{
+		AccountID: "account",
+		Owner:     "certificatepack/example",
+		Name:      "Certificate Pack example",
+		AlertType: "dedicated_ssl_certificate_event_type",
+		Enabled:   true,
+		Filters:   map[string][]string{"zones": {"zone"}},
+		Emails:    []string{"a@example.com", "b@example.com"},
+	}
+}
+
+func listing(policies ...cloudflare.NotificationPolicy) func(context.Context, string) (cloudflare.NotificationPoliciesResponse, error) {
+	return func(context.Context, string) (cloudflare.NotificationPoliciesResponse, error) {
+		return cloudflare.NotificationPoliciesResponse{Result: policies}, nil
+	}
+}
+
+func TestUpToDate(t *testing.T) {
+	current := generatePolicy(testPolicy())
+
+	cases := map[string]struct {
+		reason string
+		policy cloudflare.NotificationPolicy
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "A policy matching the desired policy should be up to date",
+			policy: current,
+			want:   true,
+		},
+		"Reordered": {
+			reason: "A policy alerting the same destinations in another order should be up to date",
+			policy: func() cloudflare.NotificationPolicy {
+				p := generatePolicy(testPolicy())
+				p.Mechanisms[MechanismEmail] = cloudflare.NotificationMechanismIntegrations{{ID: "b@example.com"}, {ID: "a@example.com"}}
+				return p
+			}(),
+			want: true,
+		},
+		"Disabled": {
+			reason: "A disabled policy that should be enabled should be outdated",
+			policy: func() cloudflare.NotificationPolicy {
+				p := generatePolicy(testPolicy())
+				p.Enabled = false
+				return p
+			}(),
+		},
+		"OtherDestination": {
+			reason: "A policy alerting other destinations should be outdated",
+			policy: func() cloudflare.NotificationPolicy {
+				p := generatePolicy(testPolicy())
+				p.Mechanisms[MechanismWebhooks] = cloudflare.NotificationMechanismIntegrations{{ID: "hook"}}
+				return p
+			}(),
+		},
+		"OtherZone": {
+			reason: "A policy filtering other zones should be outdated",
+			policy: func() cloudflare.NotificationPolicy {
+				p := generatePolicy(testPolicy())
+				p.Filters = map[string][]string{"zones": {"other"}}
+				return p
+			}(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, UpToDate(testPolicy(), tc.policy)); diff != "" {
+				t.Errorf("\n%s\nUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnsure(t *testing.T) {
+	errBoom := errors.New("boom")
+	managed := generatePolicy(testPolicy())
+	managed.ID = "existing"
+
+	type want struct {
+		id      string
+		created bool
+		updated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		policy Policy
+		mock   *fake.ClientMock
+		want   want
+	}{
+		"Create": {
+			reason: "A policy that does not exist should be created",
+			policy: testPolicy(),
+			mock: &fake.ClientMock{
+				ListNotificationPoliciesFunc: listing(cloudflare.NotificationPolicy{ID: "other", Description: "unmanaged"}),
+				CreateNotificationPolicyFunc: func(_ context.Context, _ string, p cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error) {
+					return cloudflare.SaveResponse{Result: cloudflare.NotificationResource{ID: "created"}}, nil
+				},
+			},
+			want: want{id: "created", created: true},
+		},
+		"UpToDate": {
+			reason: "A policy that is up to date should be left alone",
+			policy: testPolicy(),
+			mock:   &fake.ClientMock{ListNotificationPoliciesFunc: listing(managed)},
+			want:   want{id: "existing"},
+		},
+		"Update": {
+			reason: "A policy that is outdated should be updated in place",
+			policy: func() Policy {
+				p := testPolicy()
+				p.PagerDuty = []string{"service"}
+				return p
+			}(),
+			mock: &fake.ClientMock{ListNotificationPoliciesFunc: listing(managed)},
+			want: want{id: "existing", updated: true},
+		},
+		"NoDestination": {
+			reason: "A policy without destinations should not be created",
+			policy: func() Policy {
+				p := testPolicy()
+				p.Emails = nil
+				return p
+			}(),
+			mock: &fake.ClientMock{},
+			want: want{err: errors.New(errNoDestination)},
+		},
+		"ListError": {
+			reason: "Errors listing policies should be returned",
+			policy: testPolicy(),
+			mock: &fake.ClientMock{
+				ListNotificationPoliciesFunc: func(context.Context, string) (cloudflare.NotificationPoliciesResponse, error) {
+					return cloudflare.NotificationPoliciesResponse{}, errBoom
+				},
+			},
+			want: want{err: errors.Wrap(errBoom, errListPolicies)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.mock.CreateNotificationPolicyFunc == nil {
+				tc.mock.CreateNotificationPolicyFunc = func(context.Context, string, cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error) {
+					return cloudflare.SaveResponse{}, nil
+				}
+			}
+			tc.mock.UpdateNotificationPolicyFunc = func(_ context.Context, _ string, p *cloudflare.NotificationPolicy) (cloudflare.SaveResponse, error) {
+				if p.ID != "existing" {
+					t.Errorf("\n%s\nEnsure(...): the existing policy should be updated, got %q", tc.reason, p.ID)
+				}
+				return cloudflare.SaveResponse{}, nil
+			}
+
+			id, err := Ensure(context.Background(), tc.mock, tc.policy)
+			got := want{
+				id:      id,
+				created: len(tc.mock.CreateNotificationPolicyCalls()) > 0,
+				updated: len(tc.mock.UpdateNotificationPolicyCalls()) > 0,
+				err:     err,
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nEnsure(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+	managed := generatePolicy(testPolicy())
+	managed.ID = "existing"
+
+	cases := map[string]struct {
+		reason  string
+		mock    *fake.ClientMock
+		deleted string
+		want    error
+	}{
+		"Deleted": {
+			reason:  "The policy managed for the owner should be deleted",
+			mock:    &fake.ClientMock{ListNotificationPoliciesFunc: listing(cloudflare.NotificationPolicy{ID: "other"}, managed)},
+			deleted: "existing",
+		},
+		"NotFound": {
+			reason: "Nothing should be deleted when no policy is managed for the owner",
+			mock:   &fake.ClientMock{ListNotificationPoliciesFunc: listing(cloudflare.NotificationPolicy{ID: "other"})},
+		},
+		"DeleteError": {
+			reason: "Errors deleting the policy should be returned",
+			mock: &fake.ClientMock{
+				ListNotificationPoliciesFunc: listing(managed),
+				DeleteNotificationPolicyFunc: func(context.Context, string, string) (cloudflare.SaveResponse, error) {
+					return cloudflare.SaveResponse{}, errBoom
+				},
+			},
+			deleted: "existing",
+			want:    errors.Wrap(errBoom, errDeletePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.mock.DeleteNotificationPolicyFunc == nil {
+				tc.mock.DeleteNotificationPolicyFunc = func(context.Context, string, string) (cloudflare.SaveResponse, error) {
+					return cloudflare.SaveResponse{}, nil
+				}
+			}
+
+			err := Delete(context.Background(), tc.mock, "account", "certificatepack/example")
+			deleted := ""
+			for _, call := range tc.mock.DeleteNotificationPolicyCalls() {
+				deleted = call.PolicyID
+			}
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.deleted, deleted); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/originssl/v1alpha1"
+)
+
+const (
+	errParseCSR       = "cannot parse certificate signing request"
+	errNoHostnames    = "certificate signing request has no DNS names or common name"
+	errIPAddressesCSR = "the Origin CA cannot issue certificates for IP addresses"
+
+	requestTypeRSA         = "origin-rsa"
+	defaultRequestValidity = 5475
+)
+
+// requestValidities are the validities, in days, the Origin CA supports.
+var requestValidities = []int{7, 30, 90, 365, 730, 1095, 5475}
+
+// IssueParameters returns the parameters used to issue a certificate for
+// the supplied PEM encoded CSR on behalf of an OriginIssuer or
+// ClusterOriginIssuer. A requested duration is rounded up to the nearest
+// validity the Origin CA supports.
+func IssueParameters(spec v1alpha1.OriginIssuerSpec, csrPEM []byte, duration *time.Duration) (v1alpha1.CertificateParameters, error) {
+	b, _ := pem.Decode(csrPEM)
+	if b == nil {
+		return v1alpha1.CertificateParameters{}, errors.New(errDecodePEM)
+	}
+	csr, err := x509.ParseCertificateRequest(b.Bytes)
+	if err != nil {
+		return v1alpha1.CertificateParameters{}, errors.Wrap(err, errParseCSR)
+	}
+	if len(csr.IPAddresses) > 0 {
+		return v1alpha1.CertificateParameters{}, errors.New(errIPAddressesCSR)
+	}
+
+	hostnames := csr.DNSNames
+	if len(hostnames) == 0 && csr.Subject.CommonName != "" {
+		hostnames = []string{csr.Subject.CommonName}
+	}
+	if len(hostnames) == 0 {
+		return v1alpha1.CertificateParameters{}, errors.New(errNoHostnames)
+	}
+
+	requestType := spec.RequestType
+	if requestType == nil {
+		requestType = ptr.To(requestTypeRSA)
+		if csr.PublicKeyAlgorithm == x509.ECDSA {
+			requestType = ptr.To(requestTypeECC)
+		}
+	}
+
+	validity := defaultRequestValidity
+	if spec.RequestValidity != nil {
+		validity = *spec.RequestValidity
+	}
+	if duration != nil {
+		validity = roundValidity(*duration)
+	}
+
+	return v1alpha1.CertificateParameters{
+		Hostnames:       hostnames,
+		RequestType:     requestType,
+		RequestValidity: ptr.To(validity),
+		CSR:             ptr.To(string(csrPEM)),
+	}, nil
+}
+
+// roundValidity returns the shortest supported validity covering the
+// supplied duration, or the longest supported validity if none does.
+func roundValidity(d time.Duration) int {
+	days := int((d + 24*time.Hour - 1) / (24 * time.Hour))
+	for _, v := range requestValidities {
+		if v >= days {
+			return v
+		}
+	}
+	return requestValidities[len(requestValidities)-1]
+}
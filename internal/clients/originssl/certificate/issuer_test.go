@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/originssl/v1alpha1"
+)
+
+func TestIssueParameters(t *testing.T) {
+	_, ecc, err := GenerateKey(v1alpha1.CertificateParameters{Hostnames: []string{"example.com", "*.example.com"}, RequestType: ptr.To(requestTypeECC)})
+	if err != nil {
+		t.Fatalf("GenerateKey(...): %v", err)
+	}
+
+	type args struct {
+		spec     v1alpha1.OriginIssuerSpec
+		csr      []byte
+		duration *time.Duration
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   v1alpha1.CertificateParameters
+		err    error
+	}{
+		"Defaults": {
+			reason: "Hostnames and request type should follow the CSR, with the longest validity by default",
+			args:   args{csr: ecc},
+			want: v1alpha1.CertificateParameters{
+				Hostnames:       []string{"example.com", "*.example.com"},
+				RequestType:     ptr.To(requestTypeECC),
+				RequestValidity: ptr.To(5475),
+			},
+		},
+		"IssuerSettings": {
+			reason: "The request type and validity of the issuer should be used",
+			args:   args{spec: v1alpha1.OriginIssuerSpec{RequestType: ptr.To(requestTypeRSA), RequestValidity: ptr.To(365)}, csr: ecc},
+			want: v1alpha1.CertificateParameters{
+				Hostnames:       []string{"example.com", "*.example.com"},
+				RequestType:     ptr.To(requestTypeRSA),
+				RequestValidity: ptr.To(365),
+			},
+		},
+		"Duration": {
+			reason: "A requested duration should take precedence over the issuer and be rounded up to a supported validity",
+			args:   args{spec: v1alpha1.OriginIssuerSpec{RequestValidity: ptr.To(365)}, csr: ecc, duration: ptr.To(2000 * time.Hour)},
+			want: v1alpha1.CertificateParameters{
+				Hostnames:       []string{"example.com", "*.example.com"},
+				RequestType:     ptr.To(requestTypeECC),
+				RequestValidity: ptr.To(90),
+			},
+		},
+		"InvalidCSR": {
+			reason: "A request that is not a PEM CSR should be rejected",
+			args:   args{csr: []byte("not a csr")},
+			err:    errors.New(errDecodePEM),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IssueParameters(tc.args.spec, tc.args.csr, tc.args.duration)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nIssueParameters(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(v1alpha1.CertificateParameters{}, "CSR")); diff != "" {
+				t.Errorf("\n%s\nIssueParameters(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRoundValidity(t *testing.T) {
+	cases := map[string]struct {
+		d    time.Duration
+		want int
+	}{
+		"Exact":    {d: 90 * 24 * time.Hour, want: 90},
+		"Partial":  {d: 91 * 24 * time.Hour, want: 365},
+		"Short":    {d: time.Hour, want: 7},
+		"TooLong":  {d: 6000 * 24 * time.Hour, want: 5475},
+		"Default":  {d: 2160 * time.Hour, want: 90},
+		"OneDayUp": {d: 7*24*time.Hour + time.Minute, want: 30},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, roundValidity(tc.d)); diff != "" {
+				t.Errorf("roundValidity(%s): -want, +got:\n%s\n", tc.d, diff)
+			}
+		})
+	}
+}
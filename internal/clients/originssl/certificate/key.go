@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+
+	"github.com/rossigee/provider-cloudflare/apis/originssl/v1alpha1"
+)
+
+const (
+	errGenerateKey   = "cannot generate private key"
+	errMarshalKey    = "cannot marshal private key"
+	errCreateCSR     = "cannot create certificate signing request"
+	errDecodePEM     = "cannot decode PEM block"
+	errParseCert     = "cannot parse certificate"
+	errParseKey      = "cannot parse private key"
+	errUnsupportedPK = "unsupported private key type"
+
+	requestTypeECC = "origin-ecc"
+
+	rsaKeyBits = 2048
+)
+
+// GenerateKey generates a private key suitable for the requested
+// certificate type, and a CSR for the requested hostnames signed by it.
+// Both are returned PEM encoded.
+func GenerateKey(params v1alpha1.CertificateParameters) (keyPEM, csrPEM []byte, err error) {
+	var key crypto.Signer
+	if params.RequestType != nil && *params.RequestType == requestTypeECC {
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	} else {
+		key, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGenerateKey)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errMarshalKey)
+	}
+
+	tmpl := &x509.CertificateRequest{DNSNames: params.Hostnames}
+	if len(params.Hostnames) > 0 {
+		tmpl.Subject = pkix.Name{CommonName: params.Hostnames[0]}
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, tmpl, key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errCreateCSR)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), nil
+}
+
+func parseCertificatePEM(in []byte) (*x509.Certificate, error) {
+	b, _ := pem.Decode(in)
+	if b == nil {
+		return nil, errors.New(errDecodePEM)
+	}
+	cert, err := x509.ParseCertificate(b.Bytes)
+	return cert, errors.Wrap(err, errParseCert)
+}
+
+func parsePrivateKeyPEM(in []byte) (crypto.PrivateKey, error) {
+	b, _ := pem.Decode(in)
+	if b == nil {
+		return nil, errors.New(errDecodePEM)
+	}
+	switch b.Type {
+	case "RSA PRIVATE KEY":
+		k, err := x509.ParsePKCS1PrivateKey(b.Bytes)
+		return k, errors.Wrap(err, errParseKey)
+	case "EC PRIVATE KEY":
+		k, err := x509.ParseECPrivateKey(b.Bytes)
+		return k, errors.Wrap(err, errParseKey)
+	case "PRIVATE KEY":
+		k, err := x509.ParsePKCS8PrivateKey(b.Bytes)
+		return k, errors.Wrap(err, errParseKey)
+	}
+	return nil, errors.New(errUnsupportedPK)
+}
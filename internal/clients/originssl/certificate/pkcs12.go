@@ -0,0 +1,318 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/sha1" //nolint:gosec // Only used for the PKCS#12 local key ID.
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"hash"
+	"unicode/utf16"
+
+	"github.com/pkg/errors"
+)
+
+// The keystore is protected the way OpenSSL 3 does by default: the key is
+// encrypted with PBES2 (PBKDF2-HMAC-SHA256 and AES-256-CBC) and the whole
+// keystore is integrity protected with an HMAC-SHA256 MAC.
+const pkcs12Iterations = 2048
+
+var (
+	oidData                 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidCertBag              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidShroudedKeyBag       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertTypeX509         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidLocalKeyID           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+	oidPBES2                = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA256       = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES256CBC            = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidSHA256               = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	pkcs12MACKeyDerivation  = byte(3)
+	pkcs12SaltLength        = 16
+	pkcs12EncryptionKeySize = 32
+)
+
+type pfxPdu struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int
+}
+
+type digestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type safeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue
+	Attributes []pkcs12Attribute `asn1:"set"`
+}
+
+type pkcs12Attribute struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+type certBag struct {
+	ID   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt       []byte
+	Iterations int
+	PRF        pkix.AlgorithmIdentifier
+}
+
+// EncodePKCS12 encodes the supplied PEM certificate and private key as a
+// PKCS#12 keystore protected by the supplied password.
+//
+// Salts and IVs are derived from the password and keystore contents rather
+// than generated randomly, so encoding the same inputs always produces the
+// same keystore. This lets callers re-encode on every reconcile without
+// rewriting the Secret holding it.
+func EncodePKCS12(certPEM, keyPEM []byte, password string) ([]byte, error) {
+	cert, err := parseCertificatePEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	key, err := parsePrivateKeyPEM(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalKey)
+	}
+
+	seed := func(label string, n int) []byte {
+		m := hmac.New(sha256.New, []byte(password))
+		m.Write([]byte(label))
+		m.Write(cert.Raw)
+		m.Write(pkcs8)
+		return m.Sum(nil)[:n]
+	}
+
+	localKeyID := sha1.Sum(cert.Raw) //nolint:gosec // Not used for security.
+	attrs, err := localKeyIDAttributes(localKeyID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	cb, err := asn1.Marshal(certBag{ID: oidCertTypeX509, Data: cert.Raw})
+	if err != nil {
+		return nil, err
+	}
+	ek, err := encryptPrivateKey(pkcs8, password, seed("salt", pkcs12SaltLength), seed("iv", aes.BlockSize))
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := dataContentInfo([]safeBag{{ID: oidCertBag, Value: explicit(cb), Attributes: attrs}})
+	if err != nil {
+		return nil, err
+	}
+	keys, err := dataContentInfo([]safeBag{{ID: oidShroudedKeyBag, Value: explicit(ek), Attributes: attrs}})
+	if err != nil {
+		return nil, err
+	}
+	authSafe, err := asn1.Marshal([]contentInfo{certs, keys})
+	if err != nil {
+		return nil, err
+	}
+
+	macSalt := seed("mac", pkcs12SaltLength)
+	macKey := pkcs12KDF(sha256.New, bmpString(password), macSalt, pkcs12MACKeyDerivation, pkcs12Iterations, sha256.Size)
+	m := hmac.New(sha256.New, macKey)
+	m.Write(authSafe)
+
+	authSafeContent, err := asn1.Marshal(authSafe)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(pfxPdu{
+		Version:  3,
+		AuthSafe: contentInfo{ContentType: oidData, Content: explicit(authSafeContent)},
+		MacData: macData{
+			Mac: digestInfo{
+				Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue},
+				Digest:    m.Sum(nil),
+			},
+			MacSalt:    macSalt,
+			Iterations: pkcs12Iterations,
+		},
+	})
+}
+
+func explicit(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+}
+
+func localKeyIDAttributes(id []byte) ([]pkcs12Attribute, error) {
+	v, err := asn1.Marshal(id)
+	if err != nil {
+		return nil, err
+	}
+	return []pkcs12Attribute{{
+		ID:    oidLocalKeyID,
+		Value: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: v},
+	}}, nil
+}
+
+func dataContentInfo(bags []safeBag) (contentInfo, error) {
+	sc, err := asn1.Marshal(bags)
+	if err != nil {
+		return contentInfo{}, err
+	}
+	data, err := asn1.Marshal(sc)
+	if err != nil {
+		return contentInfo{}, err
+	}
+	return contentInfo{ContentType: oidData, Content: explicit(data)}, nil
+}
+
+func encryptPrivateKey(pkcs8 []byte, password string, salt, iv []byte) ([]byte, error) {
+	key, err := pbkdf2.Key(sha256.New, password, salt, pkcs12Iterations, pkcs12EncryptionKeySize)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	pad := aes.BlockSize - len(pkcs8)%aes.BlockSize
+	plain := append(append([]byte{}, pkcs8...), make([]byte, pad)...)
+	for i := len(pkcs8); i < len(plain); i++ {
+		plain[i] = byte(pad)
+	}
+	encrypted := make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, plain)
+
+	kdf, err := asn1.Marshal(pbkdf2Params{
+		Salt:       salt,
+		Iterations: pkcs12Iterations,
+		PRF:        pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ivParam, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	params, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdf}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParam}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm:     pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: encrypted,
+	})
+}
+
+// bmpString encodes a password as PKCS#12 expects for key derivation: as
+// big endian UTF-16 with a trailing NUL.
+func bmpString(s string) []byte {
+	out := []byte{}
+	for _, r := range utf16.Encode([]rune(s)) {
+		out = append(out, byte(r>>8), byte(r))
+	}
+	return append(out, 0, 0)
+}
+
+// pkcs12KDF derives key material as described in RFC 7292 appendix B.2.
+func pkcs12KDF(h func() hash.Hash, password, salt []byte, id byte, iterations, size int) []byte {
+	u := h().Size()
+	v := h().BlockSize()
+
+	d := make([]byte, v)
+	for i := range d {
+		d[i] = id
+	}
+	fill := func(in []byte) []byte {
+		if len(in) == 0 {
+			return nil
+		}
+		out := make([]byte, v*((len(in)+v-1)/v))
+		for i := range out {
+			out[i] = in[i%len(in)]
+		}
+		return out
+	}
+	i := append(fill(salt), fill(password)...)
+
+	var out []byte
+	for len(out) < size {
+		a := h()
+		a.Write(d)
+		a.Write(i)
+		sum := a.Sum(nil)
+		for r := 1; r < iterations; r++ {
+			a = h()
+			a.Write(sum)
+			sum = a.Sum(nil)
+		}
+		out = append(out, sum...)
+
+		b := make([]byte, v)
+		for j := range b {
+			b[j] = sum[j%u]
+		}
+		// Each v byte block of I becomes (I_j + B + 1) mod 2^(8v).
+		for j := 0; j < len(i); j += v {
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				carry += int(i[j+k]) + int(b[k])
+				i[j+k] = byte(carry)
+				carry >>= 8
+			}
+		}
+	}
+	return out[:size]
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"crypto/sha1" //nolint:gosec // The published test vectors use SHA-1.
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPKCS12KDF(t *testing.T) {
+	salt, _ := hex.DecodeString("0A58CF64530D823F")
+
+	cases := map[string]struct {
+		reason string
+		id     byte
+		size   int
+		want   string
+	}{
+		"EncryptionKey": {
+			reason: "Key material should match the published PKCS#12 test vector",
+			id:     1,
+			size:   24,
+			want:   "8AAAE6297B6CB04642AB5B077851284EB7128F1A2A7FBCA3",
+		},
+		"IV": {
+			reason: "IV material should match the published PKCS#12 test vector",
+			id:     2,
+			size:   8,
+			want:   "79993DFE048D3B76",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := pkcs12KDF(sha1.New, bmpString("smeg"), salt, tc.id, 1, tc.size)
+			if diff := cmp.Diff(tc.want, strings.ToUpper(hex.EncodeToString(got))); diff != "" {
+				t.Errorf("\n%s\npkcs12KDF(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
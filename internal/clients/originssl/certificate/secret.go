@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/rossigee/provider-cloudflare/apis/originssl/v1alpha1"
+)
+
+const (
+	// ConnectionDetailExpiresOn is the connection detail holding the
+	// RFC 3339 expiry of the certificate.
+	ConnectionDetailExpiresOn = "expiresOn"
+
+	// DefaultPKCS12Key is the Secret key a PKCS#12 keystore is written to
+	// by default.
+	DefaultPKCS12Key = "keystore.p12"
+
+	errNoPrivateKey = "private key is unknown; it is only available if the provider generated it (forProvider.csr is unset) and it was written to the Secret when the certificate was created"
+)
+
+// ConnectionDetails returns the connection details of a certificate: the
+// PEM encoded certificate and its expiry, plus the private key if one was
+// generated by the provider.
+func ConnectionDetails(obs v1alpha1.CertificateObservation, keyPEM []byte) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if obs.Certificate != "" {
+		cd[corev1.TLSCertKey] = []byte(obs.Certificate)
+	}
+	if obs.ExpiresOn != nil {
+		cd[ConnectionDetailExpiresOn] = []byte(obs.ExpiresOn.UTC().Format(time.RFC3339))
+	}
+	if len(keyPEM) > 0 {
+		cd[corev1.TLSPrivateKeyKey] = keyPEM
+	}
+	return cd
+}
+
+// SecretData renders the data of a Secret described by the supplied
+// template. The private key is only known when the certificate is
+// created, so it is carried over from the current data of the Secret
+// when the connection details do not include it.
+func SecretData(t v1alpha1.CertificateSecretTemplate, current map[string][]byte, cd managed.ConnectionDetails, password string) (map[string][]byte, error) {
+	data := map[string][]byte{}
+	for _, k := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, ConnectionDetailExpiresOn} {
+		if v, ok := cd[k]; ok {
+			data[k] = v
+			continue
+		}
+		if v, ok := current[k]; ok {
+			data[k] = v
+		}
+	}
+
+	hasKey := len(data[corev1.TLSPrivateKeyKey]) > 0
+	if (SecretType(t) == corev1.SecretTypeTLS || t.PKCS12 != nil) && !hasKey {
+		return nil, errors.New(errNoPrivateKey)
+	}
+
+	if t.PKCS12 != nil && len(data[corev1.TLSCertKey]) > 0 {
+		p12, err := EncodePKCS12(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey], password)
+		if err != nil {
+			return nil, err
+		}
+		data[PKCS12Key(*t.PKCS12)] = p12
+	}
+	return data, nil
+}
+
+// SecretType returns the type of the Secret described by the supplied
+// template.
+func SecretType(t v1alpha1.CertificateSecretTemplate) corev1.SecretType {
+	if t.Type == nil {
+		return corev1.SecretTypeTLS
+	}
+	return corev1.SecretType(*t.Type)
+}
+
+// PKCS12Key returns the Secret key the supplied keystore is written to.
+func PKCS12Key(k v1alpha1.PKCS12Keystore) string {
+	if k.Key == nil {
+		return DefaultPKCS12Key
+	}
+	return *k.Key
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/originssl/v1alpha1"
+)
+
+// selfSigned returns a PEM certificate and private key for the supplied
+// request type, standing in for one issued by the Origin CA.
+func selfSigned(t *testing.T, requestType string) (certPEM, keyPEM []byte) {
+	t.Helper()
+	keyPEM, _, err := GenerateKey(v1alpha1.CertificateParameters{Hostnames: []string{"example.com"}, RequestType: ptr.To(requestType)})
+	if err != nil {
+		t.Fatalf("GenerateKey(...): %v", err)
+	}
+	k, err := parsePrivateKeyPEM(keyPEM)
+	if err != nil {
+		t.Fatalf("parsePrivateKeyPEM(...): %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, k.(crypto.Signer).Public(), k)
+	if err != nil {
+		t.Fatalf("CreateCertificate(...): %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), keyPEM
+}
+
+func TestSecretData(t *testing.T) {
+	certPEM, keyPEM := selfSigned(t, "origin-ecc")
+	keystore, err := EncodePKCS12(certPEM, keyPEM, "s3cret")
+	if err != nil {
+		t.Fatalf("EncodePKCS12(...): %v", err)
+	}
+
+	pkcs12 := &v1alpha1.PKCS12Keystore{PasswordSecretRef: rtv1.SecretKeySelector{Key: "password"}}
+
+	type args struct {
+		t       v1alpha1.CertificateSecretTemplate
+		current map[string][]byte
+		cd      managed.ConnectionDetails
+	}
+	type want struct {
+		keys     []string
+		keystore []byte
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Created": {
+			reason: "The certificate and generated key should be written when the certificate is created",
+			args: args{
+				cd: managed.ConnectionDetails{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM, ConnectionDetailExpiresOn: []byte("2030-01-01T00:00:00Z")},
+			},
+			want: want{keys: []string{ConnectionDetailExpiresOn, corev1.TLSCertKey, corev1.TLSPrivateKeyKey}},
+		},
+		"CarriesOverKey": {
+			reason: "The private key should be carried over from the current Secret, since it is only known at creation",
+			args: args{
+				current: map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM},
+				cd:      managed.ConnectionDetails{corev1.TLSCertKey: certPEM},
+			},
+			want: want{keys: []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey}},
+		},
+		"NoKey": {
+			reason: "A kubernetes.io/tls Secret cannot be written without a private key",
+			args: args{
+				cd: managed.ConnectionDetails{corev1.TLSCertKey: certPEM},
+			},
+			want: want{err: errors.New(errNoPrivateKey)},
+		},
+		"OpaqueWithoutKey": {
+			reason: "An Opaque Secret may hold just the certificate of a user supplied CSR",
+			args: args{
+				t:  v1alpha1.CertificateSecretTemplate{Type: ptr.To("Opaque")},
+				cd: managed.ConnectionDetails{corev1.TLSCertKey: certPEM},
+			},
+			want: want{keys: []string{corev1.TLSCertKey}},
+		},
+		"PKCS12": {
+			reason: "A PKCS#12 keystore should be written when requested",
+			args: args{
+				t:  v1alpha1.CertificateSecretTemplate{PKCS12: pkcs12},
+				cd: managed.ConnectionDetails{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM},
+			},
+			want: want{keys: []string{DefaultPKCS12Key, corev1.TLSCertKey, corev1.TLSPrivateKeyKey}, keystore: keystore},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := SecretData(tc.args.t, tc.args.current, tc.args.cd, "s3cret")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSecretData(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			keys := []string{}
+			for k := range got {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.keys, keys); diff != "" {
+					t.Errorf("\n%s\nSecretData(...): -want keys, +got keys:\n%s\n", tc.reason, diff)
+				}
+			}
+			if tc.want.keystore != nil {
+				if diff := cmp.Diff(tc.want.keystore, got[DefaultPKCS12Key]); diff != "" {
+					t.Errorf("\n%s\nSecretData(...): -want keystore, +got keystore:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
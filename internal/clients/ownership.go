@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+const (
+	// OwnerTagName is the name of the tag recording the cluster and
+	// managed resource that own a resource in Cloudflare. Its value is
+	// <cluster ID>/<managed resource UID>.
+	OwnerTagName = "crossplane-owner"
+
+	errOwnedElsewhere = "resource is owned by cluster %q; set takeOwnership to manage it from this cluster"
+)
+
+// OwnerTag returns the tag recording that the supplied object of the
+// supplied cluster owns a resource.
+func OwnerTag(o *v1alpha1.Ownership, obj metav1.Object) string {
+	return OwnerTagName + ":" + o.ClusterID + "/" + string(obj.GetUID())
+}
+
+// Owner returns the ID of the cluster the supplied tags record as the owner
+// of a resource, or an empty string if they record none.
+func Owner(tags []string) string {
+	for _, t := range tags {
+		v, ok := strings.CutPrefix(t, OwnerTagName+":")
+		if !ok {
+			continue
+		}
+		cluster, _, _ := strings.Cut(v, "/")
+		return cluster
+	}
+	return ""
+}
+
+// CheckOwnership returns an error if ownership is tracked and the supplied
+// tags record that a resource is owned by another cluster, unless the
+// managed resource takes ownership of it. Resources owned by no cluster
+// may be adopted.
+func CheckOwnership(o *v1alpha1.Ownership, tags []string, take *bool) error {
+	if o == nil || (take != nil && *take) {
+		return nil
+	}
+	if owner := Owner(tags); owner != "" && owner != o.ClusterID {
+		return errors.Errorf(errOwnedElsewhere, owner)
+	}
+	return nil
+}
+
+// WithOwner returns the metadata plus the owner tag of the supplied object
+// if ownership is tracked, or the metadata unchanged if it is not.
+func (m *Metadata) WithOwner(o *v1alpha1.Ownership, obj metav1.Object) *Metadata {
+	if o == nil {
+		return m
+	}
+	out := &Metadata{Tags: []string{OwnerTag(o, obj)}}
+	if m != nil {
+		out.Tags = append(out.Tags, m.Tags...)
+		out.Comment = m.Comment
+	}
+	sort.Strings(out.Tags)
+	return out
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+func TestCheckOwnership(t *testing.T) {
+	o := &v1alpha1.Ownership{ClusterID: "this"}
+
+	cases := map[string]struct {
+		reason string
+		o      *v1alpha1.Ownership
+		tags   []string
+		take   *bool
+		want   error
+	}{
+		"NotTracked": {
+			reason: "Any resource may be managed when ownership is not tracked",
+			tags:   []string{"crossplane-owner:other/uid"},
+		},
+		"Unowned": {
+			reason: "A resource owned by no cluster may be adopted",
+			o:      o,
+			tags:   []string{"team:web"},
+		},
+		"OwnedHere": {
+			reason: "A resource owned by this cluster may be managed",
+			o:      o,
+			tags:   []string{"crossplane-owner:this/uid"},
+		},
+		"OwnedElsewhere": {
+			reason: "A resource owned by another cluster may not be managed",
+			o:      o,
+			tags:   []string{"team:web", "crossplane-owner:other/uid"},
+			want:   errors.Errorf(errOwnedElsewhere, "other"),
+		},
+		"TakeOwnership": {
+			reason: "A resource owned by another cluster may be managed when taking ownership of it",
+			o:      o,
+			tags:   []string{"crossplane-owner:other/uid"},
+			take:   ptr.To(true),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckOwnership(tc.o, tc.tags, tc.take)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheckOwnership(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMetadataWithOwner(t *testing.T) {
+	obj := &metav1.ObjectMeta{UID: "uid"}
+
+	cases := map[string]struct {
+		reason string
+		md     *Metadata
+		o      *v1alpha1.Ownership
+		want   *Metadata
+	}{
+		"NotTracked": {
+			reason: "Metadata should be unchanged when ownership is not tracked",
+			md:     &Metadata{Tags: []string{"team:web"}},
+			want:   &Metadata{Tags: []string{"team:web"}},
+		},
+		"NothingPropagated": {
+			reason: "The owner tag should be written even when nothing is propagated",
+			o:      &v1alpha1.Ownership{ClusterID: "this"},
+			want:   &Metadata{Tags: []string{"crossplane-owner:this/uid"}},
+		},
+		"Propagated": {
+			reason: "The owner tag should be added to the propagated metadata",
+			md:     &Metadata{Tags: []string{"team:web"}, Comment: ptr.To("Frontend")},
+			o:      &v1alpha1.Ownership{ClusterID: "this"},
+			want:   &Metadata{Tags: []string{"crossplane-owner:this/uid", "team:web"}, Comment: ptr.To("Frontend")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.md.WithOwner(tc.o, obj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nWithOwner(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
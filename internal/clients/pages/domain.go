@@ -0,0 +1,225 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pages contains the clients for Cloudflare Pages resources.
+package pages
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+
+	"github.com/rossigee/provider-cloudflare/apis/pages/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/records"
+)
+
+// Statuses of a Pages domain.
+const (
+	StatusActive = "active"
+)
+
+const (
+	errGetDomain       = "cannot get Pages domain"
+	errAddDomain       = "cannot add Pages domain"
+	errDeleteDomain    = "cannot delete Pages domain"
+	errGetProject      = "cannot get Pages project"
+	errListRecords     = "cannot list DNS records"
+	errCreateRecord    = "cannot create DNS record"
+	errUpdateRecord    = "cannot update DNS record"
+	errDeleteRecord    = "cannot delete DNS record"
+	errDomainNotFound  = "Pages domain not found"
+	errNoZone          = "domain is not in a zone of the account, so its DNS record cannot be managed"
+	errRecordExistsFmt = "a CNAME record for %s already points at %s rather than %s"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with Pages domains and the DNS records they need.
+type Client interface {
+	clients.DNSAPI
+	GetPagesDomain(ctx context.Context, params cloudflare.PagesDomainParameters) (cloudflare.PagesDomain, error)
+	PagesAddDomain(ctx context.Context, params cloudflare.PagesDomainParameters) (cloudflare.PagesDomain, error)
+	PagesDeleteDomain(ctx context.Context, params cloudflare.PagesDomainParameters) error
+	GetPagesProject(ctx context.Context, rc *cloudflare.ResourceContainer, projectName string) (cloudflare.PagesProject, error)
+}
+
+// NewClient returns a new Cloudflare API client for working with Pages
+// domains.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// ManagedComment returns the comment marking the DNS record managed for
+// the named PagesDomain.
+func ManagedComment(name string) string {
+	return "managed-by: crossplane pages-domain/" + name
+}
+
+// ManagesDNSRecord returns whether the supplied parameters manage the DNS
+// record of the domain.
+func ManagesDNSRecord(p v1alpha1.PagesDomainParameters) bool {
+	return p.ManageDNSRecord != nil && *p.ManageDNSRecord
+}
+
+func domainParams(p v1alpha1.PagesDomainParameters) cloudflare.PagesDomainParameters {
+	return cloudflare.PagesDomainParameters{AccountID: p.AccountID, ProjectName: p.ProjectName, DomainName: p.Domain}
+}
+
+// GetDomain returns the domain of a Pages project described by the
+// supplied parameters.
+func GetDomain(ctx context.Context, client Client, p v1alpha1.PagesDomainParameters) (cloudflare.PagesDomain, error) {
+	d, err := client.GetPagesDomain(ctx, domainParams(p))
+	if err != nil {
+		if isNotFound(err) {
+			return cloudflare.PagesDomain{}, clients.NewNotFoundError(errDomainNotFound)
+		}
+		return cloudflare.PagesDomain{}, errors.Wrap(err, errGetDomain)
+	}
+	return d, nil
+}
+
+// AddDomain attaches the domain described by the supplied parameters to
+// its Pages project.
+func AddDomain(ctx context.Context, client Client, p v1alpha1.PagesDomainParameters) (cloudflare.PagesDomain, error) {
+	d, err := client.PagesAddDomain(ctx, domainParams(p))
+	return d, errors.Wrap(err, errAddDomain)
+}
+
+// DeleteDomain detaches the domain described by the supplied parameters
+// from its Pages project.
+func DeleteDomain(ctx context.Context, client Client, p v1alpha1.PagesDomainParameters) error {
+	err := client.PagesDeleteDomain(ctx, domainParams(p))
+	if isNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteDomain)
+}
+
+// GetManagedRecord returns the DNS record managed for the named
+// PagesDomain in the supplied zone, if there is one.
+func GetManagedRecord(ctx context.Context, client Client, zoneID, name string) (*cloudflare.DNSRecord, error) {
+	recs, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{
+		Type:    "CNAME",
+		Comment: ManagedComment(name),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListRecords)
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	return &recs[0], nil
+}
+
+// EnsureDNSRecord creates the CNAME record pointing the domain at the
+// pages.dev subdomain of its project, in the zone of the domain, unless
+// it exists. An existing CNAME for the domain is adopted by marking it as
+// managed if it already points at the project, and is otherwise left
+// alone and reported as a conflict.
+func EnsureDNSRecord(ctx context.Context, client Client, p v1alpha1.PagesDomainParameters, zoneID, name string) (cloudflare.DNSRecord, error) {
+	if zoneID == "" {
+		return cloudflare.DNSRecord{}, errors.New(errNoZone)
+	}
+	rec, err := GetManagedRecord(ctx, client, zoneID, name)
+	if err != nil {
+		return cloudflare.DNSRecord{}, err
+	}
+	if rec != nil {
+		return *rec, nil
+	}
+
+	project, err := client.GetPagesProject(ctx, cloudflare.AccountIdentifier(p.AccountID), p.ProjectName)
+	if err != nil {
+		return cloudflare.DNSRecord{}, errors.Wrap(err, errGetProject)
+	}
+
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	existing, _, err := client.ListDNSRecords(ctx, rc, cloudflare.ListDNSRecordsParams{Type: "CNAME", Name: p.Domain})
+	if err != nil {
+		return cloudflare.DNSRecord{}, errors.Wrap(err, errListRecords)
+	}
+	if len(existing) > 0 {
+		if existing[0].Content != project.SubDomain {
+			return cloudflare.DNSRecord{}, errors.Errorf(errRecordExistsFmt, p.Domain, existing[0].Content, project.SubDomain)
+		}
+		comment := ManagedComment(name)
+		adopted, err := client.UpdateDNSRecord(ctx, rc, cloudflare.UpdateDNSRecordParams{
+			ID:      existing[0].ID,
+			Type:    existing[0].Type,
+			Name:    existing[0].Name,
+			Content: existing[0].Content,
+			TTL:     existing[0].TTL,
+			Proxied: existing[0].Proxied,
+			Comment: &comment,
+		})
+		return adopted, errors.Wrap(err, errUpdateRecord)
+	}
+
+	proxied := true
+	created, err := client.CreateDNSRecord(ctx, rc, cloudflare.CreateDNSRecordParams{
+		Type:    "CNAME",
+		Name:    p.Domain,
+		Content: project.SubDomain,
+		Proxied: &proxied,
+		TTL:     1,
+		Comment: ManagedComment(name),
+	})
+	return created, errors.Wrap(err, errCreateRecord)
+}
+
+// RemoveDNSRecord deletes the DNS record managed for the named
+// PagesDomain, if there is one.
+func RemoveDNSRecord(ctx context.Context, client Client, zoneID, name string) error {
+	if zoneID == "" {
+		return nil
+	}
+	rec, err := GetManagedRecord(ctx, client, zoneID, name)
+	if err != nil || rec == nil {
+		return err
+	}
+	err = client.DeleteDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), rec.ID)
+	if err != nil && !records.IsRecordNotFound(err) {
+		return errors.Wrap(err, errDeleteRecord)
+	}
+	return nil
+}
+
+// GenerateObservation creates an observation of a Pages domain.
+func GenerateObservation(in cloudflare.PagesDomain) v1alpha1.PagesDomainObservation {
+	return v1alpha1.PagesDomainObservation{
+		ID:                 in.ID,
+		Status:             in.Status,
+		VerificationStatus: in.VerificationData.Status,
+		ValidationStatus:   in.ValidationData.Status,
+		ValidationMethod:   in.ValidationData.Method,
+		ZoneID:             in.ZoneTag,
+	}
+}
+
+// IsActive returns whether the domain has been verified and serves the
+// project.
+func IsActive(o v1alpha1.PagesDomainObservation) bool {
+	return o.Status == StatusActive
+}
+
+func isNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
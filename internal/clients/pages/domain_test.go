@@ -0,0 +1,255 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/pages/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/pages/fake"
+)
+
+func params() v1alpha1.PagesDomainParameters {
+	return v1alpha1.PagesDomainParameters{
+		AccountID:       "acc",
+		ProjectName:     "site",
+		Domain:          "www.example.com",
+		ManageDNSRecord: ptr.To(true),
+	}
+}
+
+func TestGetDomain(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason   string
+		err      error
+		notFound bool
+		want     error
+	}{
+		"Found": {
+			reason: "The domain of the project should be returned",
+		},
+		"NotFound": {
+			reason:   "A domain that is not attached should be reported as not found",
+			err:      &cloudflare.NotFoundError{},
+			notFound: true,
+		},
+		"Error": {
+			reason: "Other errors getting the domain should be returned",
+			err:    errBoom,
+			want:   errors.Wrap(errBoom, errGetDomain),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := cloudflare.PagesDomain{ID: "d1", Name: "www.example.com", Status: "pending"}
+			c := &fake.ClientMock{GetPagesDomainFunc: func(ctx context.Context, p cloudflare.PagesDomainParameters) (cloudflare.PagesDomain, error) {
+				if p.AccountID != "acc" || p.ProjectName != "site" || p.DomainName != "www.example.com" {
+					return cloudflare.PagesDomain{}, errors.Errorf("unexpected domain %s/%s/%s", p.AccountID, p.ProjectName, p.DomainName)
+				}
+				if tc.err != nil {
+					return cloudflare.PagesDomain{}, tc.err
+				}
+				return d, nil
+			}}
+			got, err := GetDomain(context.Background(), c, params())
+			if tc.notFound {
+				if !clients.IsNotFound(err) {
+					t.Errorf("\n%s\nGetDomain(...): want not found error, got %v", tc.reason, err)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetDomain(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if tc.want == nil {
+				if diff := cmp.Diff(d, got); diff != "" {
+					t.Errorf("\n%s\nGetDomain(...): -want, +got:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestEnsureDNSRecord(t *testing.T) {
+	errBoom := errors.New("boom")
+	comment := ManagedComment("example")
+
+	type want struct {
+		rec     cloudflare.DNSRecord
+		created bool
+		adopted bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		zoneID   string
+		managed  []cloudflare.DNSRecord
+		existing []cloudflare.DNSRecord
+		create   error
+		want     want
+	}{
+		"NoZone": {
+			reason: "A domain outside the zones of the account should be reported",
+			want:   want{err: errors.New(errNoZone)},
+		},
+		"AlreadyManaged": {
+			reason:  "The record already managed for the domain should be returned",
+			zoneID:  "zone",
+			managed: []cloudflare.DNSRecord{{ID: "r1", Name: "www.example.com", Content: "site.pages.dev"}},
+			want:    want{rec: cloudflare.DNSRecord{ID: "r1", Name: "www.example.com", Content: "site.pages.dev"}},
+		},
+		"Created": {
+			reason: "A CNAME to the pages.dev subdomain of the project should be created when there is none",
+			zoneID: "zone",
+			want:   want{rec: cloudflare.DNSRecord{ID: "new"}, created: true},
+		},
+		"Adopted": {
+			reason:   "An existing CNAME already pointing at the project should be marked as managed",
+			zoneID:   "zone",
+			existing: []cloudflare.DNSRecord{{ID: "r2", Type: "CNAME", Name: "www.example.com", Content: "site.pages.dev"}},
+			want:     want{rec: cloudflare.DNSRecord{ID: "r2"}, adopted: true},
+		},
+		"Conflict": {
+			reason:   "An existing CNAME pointing elsewhere should not be overwritten",
+			zoneID:   "zone",
+			existing: []cloudflare.DNSRecord{{ID: "r2", Type: "CNAME", Name: "www.example.com", Content: "elsewhere.example.net"}},
+			want:     want{err: errors.Errorf(errRecordExistsFmt, "www.example.com", "elsewhere.example.net", "site.pages.dev")},
+		},
+		"CreateError": {
+			reason: "Errors creating the record should be returned",
+			zoneID: "zone",
+			create: errBoom,
+			want:   want{created: true, err: errors.Wrap(errBoom, errCreateRecord)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created, adopted := false, false
+			c := &fake.ClientMock{
+				ListDNSRecordsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+					if p.Comment == comment {
+						return tc.managed, nil, nil
+					}
+					return tc.existing, nil, nil
+				},
+				GetPagesProjectFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, projectName string) (cloudflare.PagesProject, error) {
+					return cloudflare.PagesProject{Name: projectName, SubDomain: projectName + ".pages.dev"}, nil
+				},
+				CreateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+					created = true
+					if p.Type != "CNAME" || p.Name != "www.example.com" || p.Content != "site.pages.dev" || p.Comment != comment {
+						return cloudflare.DNSRecord{}, errors.Errorf("unexpected record %+v", p)
+					}
+					return cloudflare.DNSRecord{ID: "new"}, tc.create
+				},
+				UpdateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+					adopted = true
+					if p.ID != "r2" || p.Content != "site.pages.dev" || ptr.Deref(p.Comment, "") != comment {
+						return cloudflare.DNSRecord{}, errors.Errorf("unexpected record %+v", p)
+					}
+					return cloudflare.DNSRecord{ID: p.ID}, nil
+				},
+			}
+			got, err := EnsureDNSRecord(context.Background(), c, params(), tc.zoneID, "example")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nEnsureDNSRecord(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.rec, got); diff != "" {
+					t.Errorf("\n%s\nEnsureDNSRecord(...): -want, +got:\n%s\n", tc.reason, diff)
+				}
+			}
+			if created != tc.want.created {
+				t.Errorf("\n%s\nEnsureDNSRecord(...): want created %t, got %t", tc.reason, tc.want.created, created)
+			}
+			if adopted != tc.want.adopted {
+				t.Errorf("\n%s\nEnsureDNSRecord(...): want adopted %t, got %t", tc.reason, tc.want.adopted, adopted)
+			}
+		})
+	}
+}
+
+func TestRemoveDNSRecord(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason  string
+		zoneID  string
+		managed []cloudflare.DNSRecord
+		err     error
+		deleted string
+		want    error
+	}{
+		"NoZone": {
+			reason: "Nothing should be deleted for a domain outside the zones of the account",
+		},
+		"NoRecord": {
+			reason: "Nothing should be deleted when no record is managed",
+			zoneID: "zone",
+		},
+		"Deleted": {
+			reason:  "The managed record should be deleted",
+			zoneID:  "zone",
+			managed: []cloudflare.DNSRecord{{ID: "r1"}},
+			deleted: "r1",
+		},
+		"Error": {
+			reason:  "Errors deleting the record should be returned",
+			zoneID:  "zone",
+			managed: []cloudflare.DNSRecord{{ID: "r1"}},
+			err:     errBoom,
+			deleted: "r1",
+			want:    errors.Wrap(errBoom, errDeleteRecord),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := ""
+			c := &fake.ClientMock{
+				ListDNSRecordsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+					return tc.managed, nil, nil
+				},
+				DeleteDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+					deleted = recordID
+					return tc.err
+				},
+			}
+			err := RemoveDNSRecord(context.Background(), c, tc.zoneID, "example")
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRemoveDNSRecord(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if deleted != tc.deleted {
+				t.Errorf("\n%s\nRemoveDNSRecord(...): want deleted %q, got %q", tc.reason, tc.deleted, deleted)
+			}
+		})
+	}
+}
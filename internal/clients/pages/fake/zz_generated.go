@@ -0,0 +1,514 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"sync"
+)
+
+// ClientMock is a mock implementation of pages.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked pages.Client
+//		mockedClient := &ClientMock{
+//			CreateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+//				panic("mock out the CreateDNSRecord method")
+//			},
+//			DeleteDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+//				panic("mock out the DeleteDNSRecord method")
+//			},
+//			GetDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+//				panic("mock out the GetDNSRecord method")
+//			},
+//			GetPagesDomainFunc: func(ctx context.Context, params cloudflare.PagesDomainParameters) (cloudflare.PagesDomain, error) {
+//				panic("mock out the GetPagesDomain method")
+//			},
+//			GetPagesProjectFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, projectName string) (cloudflare.PagesProject, error) {
+//				panic("mock out the GetPagesProject method")
+//			},
+//			ListDNSRecordsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+//				panic("mock out the ListDNSRecords method")
+//			},
+//			PagesAddDomainFunc: func(ctx context.Context, params cloudflare.PagesDomainParameters) (cloudflare.PagesDomain, error) {
+//				panic("mock out the PagesAddDomain method")
+//			},
+//			PagesDeleteDomainFunc: func(ctx context.Context, params cloudflare.PagesDomainParameters) error {
+//				panic("mock out the PagesDeleteDomain method")
+//			},
+//			UpdateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+//				panic("mock out the UpdateDNSRecord method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires pages.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// CreateDNSRecordFunc mocks the CreateDNSRecord method.
+	CreateDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
+
+	// DeleteDNSRecordFunc mocks the DeleteDNSRecord method.
+	DeleteDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+
+	// GetDNSRecordFunc mocks the GetDNSRecord method.
+	GetDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
+
+	// GetPagesDomainFunc mocks the GetPagesDomain method.
+	GetPagesDomainFunc func(ctx context.Context, params cloudflare.PagesDomainParameters) (cloudflare.PagesDomain, error)
+
+	// GetPagesProjectFunc mocks the GetPagesProject method.
+	GetPagesProjectFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, projectName string) (cloudflare.PagesProject, error)
+
+	// ListDNSRecordsFunc mocks the ListDNSRecords method.
+	ListDNSRecordsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
+
+	// PagesAddDomainFunc mocks the PagesAddDomain method.
+	PagesAddDomainFunc func(ctx context.Context, params cloudflare.PagesDomainParameters) (cloudflare.PagesDomain, error)
+
+	// PagesDeleteDomainFunc mocks the PagesDeleteDomain method.
+	PagesDeleteDomainFunc func(ctx context.Context, params cloudflare.PagesDomainParameters) error
+
+	// UpdateDNSRecordFunc mocks the UpdateDNSRecord method.
+	UpdateDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateDNSRecord holds details about calls to the CreateDNSRecord method.
+		CreateDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateDNSRecordParams
+		}
+		// DeleteDNSRecord holds details about calls to the DeleteDNSRecord method.
+		DeleteDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// RecordID is the recordID argument value.
+			RecordID string
+		}
+		// GetDNSRecord holds details about calls to the GetDNSRecord method.
+		GetDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// RecordID is the recordID argument value.
+			RecordID string
+		}
+		// GetPagesDomain holds details about calls to the GetPagesDomain method.
+		GetPagesDomain []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params cloudflare.PagesDomainParameters
+		}
+		// GetPagesProject holds details about calls to the GetPagesProject method.
+		GetPagesProject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ProjectName is the projectName argument value.
+			ProjectName string
+		}
+		// ListDNSRecords holds details about calls to the ListDNSRecords method.
+		ListDNSRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListDNSRecordsParams
+		}
+		// PagesAddDomain holds details about calls to the PagesAddDomain method.
+		PagesAddDomain []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params cloudflare.PagesDomainParameters
+		}
+		// PagesDeleteDomain holds details about calls to the PagesDeleteDomain method.
+		PagesDeleteDomain []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params cloudflare.PagesDomainParameters
+		}
+		// UpdateDNSRecord holds details about calls to the UpdateDNSRecord method.
+		UpdateDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.UpdateDNSRecordParams
+		}
+	}
+	lockCreateDNSRecord   sync.RWMutex
+	lockDeleteDNSRecord   sync.RWMutex
+	lockGetDNSRecord      sync.RWMutex
+	lockGetPagesDomain    sync.RWMutex
+	lockGetPagesProject   sync.RWMutex
+	lockListDNSRecords    sync.RWMutex
+	lockPagesAddDomain    sync.RWMutex
+	lockPagesDeleteDomain sync.RWMutex
+	lockUpdateDNSRecord   sync.RWMutex
+}
+
+// CreateDNSRecord calls CreateDNSRecordFunc.
+func (mock *ClientMock) CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	if mock.CreateDNSRecordFunc == nil {
+		panic("ClientMock.CreateDNSRecordFunc: method is nil but Client.CreateDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateDNSRecordParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateDNSRecord.Lock()
+	mock.calls.CreateDNSRecord = append(mock.calls.CreateDNSRecord, callInfo)
+	mock.lockCreateDNSRecord.Unlock()
+	return mock.CreateDNSRecordFunc(ctx, rc, params)
+}
+
+// CreateDNSRecordCalls gets all the calls that were made to CreateDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.CreateDNSRecordCalls())
+func (mock *ClientMock) CreateDNSRecordCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateDNSRecordParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateDNSRecordParams
+	}
+	mock.lockCreateDNSRecord.RLock()
+	calls = mock.calls.CreateDNSRecord
+	mock.lockCreateDNSRecord.RUnlock()
+	return calls
+}
+
+// DeleteDNSRecord calls DeleteDNSRecordFunc.
+func (mock *ClientMock) DeleteDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+	if mock.DeleteDNSRecordFunc == nil {
+		panic("ClientMock.DeleteDNSRecordFunc: method is nil but Client.DeleteDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}{
+		Ctx:      ctx,
+		Rc:       rc,
+		RecordID: recordID,
+	}
+	mock.lockDeleteDNSRecord.Lock()
+	mock.calls.DeleteDNSRecord = append(mock.calls.DeleteDNSRecord, callInfo)
+	mock.lockDeleteDNSRecord.Unlock()
+	return mock.DeleteDNSRecordFunc(ctx, rc, recordID)
+}
+
+// DeleteDNSRecordCalls gets all the calls that were made to DeleteDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.DeleteDNSRecordCalls())
+func (mock *ClientMock) DeleteDNSRecordCalls() []struct {
+	Ctx      context.Context
+	Rc       *cloudflare.ResourceContainer
+	RecordID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}
+	mock.lockDeleteDNSRecord.RLock()
+	calls = mock.calls.DeleteDNSRecord
+	mock.lockDeleteDNSRecord.RUnlock()
+	return calls
+}
+
+// GetDNSRecord calls GetDNSRecordFunc.
+func (mock *ClientMock) GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+	if mock.GetDNSRecordFunc == nil {
+		panic("ClientMock.GetDNSRecordFunc: method is nil but Client.GetDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}{
+		Ctx:      ctx,
+		Rc:       rc,
+		RecordID: recordID,
+	}
+	mock.lockGetDNSRecord.Lock()
+	mock.calls.GetDNSRecord = append(mock.calls.GetDNSRecord, callInfo)
+	mock.lockGetDNSRecord.Unlock()
+	return mock.GetDNSRecordFunc(ctx, rc, recordID)
+}
+
+// GetDNSRecordCalls gets all the calls that were made to GetDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.GetDNSRecordCalls())
+func (mock *ClientMock) GetDNSRecordCalls() []struct {
+	Ctx      context.Context
+	Rc       *cloudflare.ResourceContainer
+	RecordID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}
+	mock.lockGetDNSRecord.RLock()
+	calls = mock.calls.GetDNSRecord
+	mock.lockGetDNSRecord.RUnlock()
+	return calls
+}
+
+// GetPagesDomain calls GetPagesDomainFunc.
+func (mock *ClientMock) GetPagesDomain(ctx context.Context, params cloudflare.PagesDomainParameters) (cloudflare.PagesDomain, error) {
+	if mock.GetPagesDomainFunc == nil {
+		panic("ClientMock.GetPagesDomainFunc: method is nil but Client.GetPagesDomain was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params cloudflare.PagesDomainParameters
+	}{
+		Ctx:    ctx,
+		Params: params,
+	}
+	mock.lockGetPagesDomain.Lock()
+	mock.calls.GetPagesDomain = append(mock.calls.GetPagesDomain, callInfo)
+	mock.lockGetPagesDomain.Unlock()
+	return mock.GetPagesDomainFunc(ctx, params)
+}
+
+// GetPagesDomainCalls gets all the calls that were made to GetPagesDomain.
+// Check the length with:
+//
+//	len(mockedClient.GetPagesDomainCalls())
+func (mock *ClientMock) GetPagesDomainCalls() []struct {
+	Ctx    context.Context
+	Params cloudflare.PagesDomainParameters
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params cloudflare.PagesDomainParameters
+	}
+	mock.lockGetPagesDomain.RLock()
+	calls = mock.calls.GetPagesDomain
+	mock.lockGetPagesDomain.RUnlock()
+	return calls
+}
+
+// GetPagesProject calls GetPagesProjectFunc.
+func (mock *ClientMock) GetPagesProject(ctx context.Context, rc *cloudflare.ResourceContainer, projectName string) (cloudflare.PagesProject, error) {
+	if mock.GetPagesProjectFunc == nil {
+		panic("ClientMock.GetPagesProjectFunc: method is nil but Client.GetPagesProject was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Rc          *cloudflare.ResourceContainer
+		ProjectName string
+	}{
+		Ctx:         ctx,
+		Rc:          rc,
+		ProjectName: projectName,
+	}
+	mock.lockGetPagesProject.Lock()
+	mock.calls.GetPagesProject = append(mock.calls.GetPagesProject, callInfo)
+	mock.lockGetPagesProject.Unlock()
+	return mock.GetPagesProjectFunc(ctx, rc, projectName)
+}
+
+// GetPagesProjectCalls gets all the calls that were made to GetPagesProject.
+// Check the length with:
+//
+//	len(mockedClient.GetPagesProjectCalls())
+func (mock *ClientMock) GetPagesProjectCalls() []struct {
+	Ctx         context.Context
+	Rc          *cloudflare.ResourceContainer
+	ProjectName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Rc          *cloudflare.ResourceContainer
+		ProjectName string
+	}
+	mock.lockGetPagesProject.RLock()
+	calls = mock.calls.GetPagesProject
+	mock.lockGetPagesProject.RUnlock()
+	return calls
+}
+
+// ListDNSRecords calls ListDNSRecordsFunc.
+func (mock *ClientMock) ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+	if mock.ListDNSRecordsFunc == nil {
+		panic("ClientMock.ListDNSRecordsFunc: method is nil but Client.ListDNSRecords was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListDNSRecords.Lock()
+	mock.calls.ListDNSRecords = append(mock.calls.ListDNSRecords, callInfo)
+	mock.lockListDNSRecords.Unlock()
+	return mock.ListDNSRecordsFunc(ctx, rc, params)
+}
+
+// ListDNSRecordsCalls gets all the calls that were made to ListDNSRecords.
+// Check the length with:
+//
+//	len(mockedClient.ListDNSRecordsCalls())
+func (mock *ClientMock) ListDNSRecordsCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListDNSRecordsParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}
+	mock.lockListDNSRecords.RLock()
+	calls = mock.calls.ListDNSRecords
+	mock.lockListDNSRecords.RUnlock()
+	return calls
+}
+
+// PagesAddDomain calls PagesAddDomainFunc.
+func (mock *ClientMock) PagesAddDomain(ctx context.Context, params cloudflare.PagesDomainParameters) (cloudflare.PagesDomain, error) {
+	if mock.PagesAddDomainFunc == nil {
+		panic("ClientMock.PagesAddDomainFunc: method is nil but Client.PagesAddDomain was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params cloudflare.PagesDomainParameters
+	}{
+		Ctx:    ctx,
+		Params: params,
+	}
+	mock.lockPagesAddDomain.Lock()
+	mock.calls.PagesAddDomain = append(mock.calls.PagesAddDomain, callInfo)
+	mock.lockPagesAddDomain.Unlock()
+	return mock.PagesAddDomainFunc(ctx, params)
+}
+
+// PagesAddDomainCalls gets all the calls that were made to PagesAddDomain.
+// Check the length with:
+//
+//	len(mockedClient.PagesAddDomainCalls())
+func (mock *ClientMock) PagesAddDomainCalls() []struct {
+	Ctx    context.Context
+	Params cloudflare.PagesDomainParameters
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params cloudflare.PagesDomainParameters
+	}
+	mock.lockPagesAddDomain.RLock()
+	calls = mock.calls.PagesAddDomain
+	mock.lockPagesAddDomain.RUnlock()
+	return calls
+}
+
+// PagesDeleteDomain calls PagesDeleteDomainFunc.
+func (mock *ClientMock) PagesDeleteDomain(ctx context.Context, params cloudflare.PagesDomainParameters) error {
+	if mock.PagesDeleteDomainFunc == nil {
+		panic("ClientMock.PagesDeleteDomainFunc: method is nil but Client.PagesDeleteDomain was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params cloudflare.PagesDomainParameters
+	}{
+		Ctx:    ctx,
+		Params: params,
+	}
+	mock.lockPagesDeleteDomain.Lock()
+	mock.calls.PagesDeleteDomain = append(mock.calls.PagesDeleteDomain, callInfo)
+	mock.lockPagesDeleteDomain.Unlock()
+	return mock.PagesDeleteDomainFunc(ctx, params)
+}
+
+// PagesDeleteDomainCalls gets all the calls that were made to PagesDeleteDomain.
+// Check the length with:
+//
+//	len(mockedClient.PagesDeleteDomainCalls())
+func (mock *ClientMock) PagesDeleteDomainCalls() []struct {
+	Ctx    context.Context
+	Params cloudflare.PagesDomainParameters
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params cloudflare.PagesDomainParameters
+	}
+	mock.lockPagesDeleteDomain.RLock()
+	calls = mock.calls.PagesDeleteDomain
+	mock.lockPagesDeleteDomain.RUnlock()
+	return calls
+}
+
+// UpdateDNSRecord calls UpdateDNSRecordFunc.
+func (mock *ClientMock) UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	if mock.UpdateDNSRecordFunc == nil {
+		panic("ClientMock.UpdateDNSRecordFunc: method is nil but Client.UpdateDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateDNSRecordParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockUpdateDNSRecord.Lock()
+	mock.calls.UpdateDNSRecord = append(mock.calls.UpdateDNSRecord, callInfo)
+	mock.lockUpdateDNSRecord.Unlock()
+	return mock.UpdateDNSRecordFunc(ctx, rc, params)
+}
+
+// UpdateDNSRecordCalls gets all the calls that were made to UpdateDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.UpdateDNSRecordCalls())
+func (mock *ClientMock) UpdateDNSRecordCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.UpdateDNSRecordParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateDNSRecordParams
+	}
+	mock.lockUpdateDNSRecord.RLock()
+	calls = mock.calls.UpdateDNSRecord
+	mock.lockUpdateDNSRecord.RUnlock()
+	return calls
+}
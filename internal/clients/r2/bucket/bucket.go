@@ -18,22 +18,26 @@ package bucket
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/rossigee/provider-cloudflare/apis/r2/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/lookup"
 )
 
 // R2BucketAPI defines the interface for R2 Bucket operations
-type R2BucketAPI interface {
-	Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error)
-	CreateR2Bucket(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateR2BucketParameters) (cloudflare.R2Bucket, error)
-	GetR2Bucket(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) (cloudflare.R2Bucket, error)
-	DeleteR2Bucket(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) error
-	ListR2Buckets(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListR2BucketsParams) ([]cloudflare.R2Bucket, error)
-}
+type R2BucketAPI = clients.R2API
 
 const (
 	errCreateBucket = "cannot create R2 bucket"
@@ -41,12 +45,33 @@ const (
 	errGetBucket    = "cannot get R2 bucket"
 	errDeleteBucket = "cannot delete R2 bucket"
 	errListBuckets  = "cannot list R2 buckets"
+	errListObjects  = "cannot list R2 bucket objects"
+	errDeleteObject = "cannot delete R2 bucket object"
+	errGetUsage     = "cannot get R2 bucket usage"
+	errGetSippy     = "cannot get R2 bucket Sippy configuration"
+	errPutSippy     = "cannot configure R2 bucket Sippy"
+	errDeleteSippy  = "cannot disable R2 bucket Sippy"
+
+	// Cloudflare returns this code when deleting a bucket that still
+	// contains objects.
+	errCodeBucketNotEmpty = "10008"
+
+	objectsPageSize = 1000
+
+	// headerStorageClass carries the default storage class when updating
+	// a bucket.
+	headerStorageClass = "cf-r2-storage-class"
 )
 
+// ErrBucketNotEmpty is returned when a bucket cannot be deleted because it
+// still contains objects.
+var ErrBucketNotEmpty = errors.New("bucket is not empty; delete its objects or set forceDestroy to true")
+
 // BucketClient provides operations for R2 Buckets.
 type BucketClient struct {
 	client    R2BucketAPI
 	accountID string
+	scope     string
 }
 
 // NewClient creates a new R2 Bucket client.
@@ -54,6 +79,7 @@ func NewClient(client R2BucketAPI) *BucketClient {
 	return &BucketClient{
 		client:    client,
 		accountID: "", // Account ID will be retrieved when needed
+		scope:     lookup.Scope(client),
 	}
 }
 
@@ -63,19 +89,15 @@ func (c *BucketClient) getAccountID(ctx context.Context) (string, error) {
 		return c.accountID, nil
 	}
 	
-	// Get account ID from Cloudflare API by listing accounts
-	// Most users have access to only one account, so we'll use the first one
-	accounts, _, err := c.client.Accounts(ctx, cloudflare.AccountsListParams{})
+	// Most users have access to only one account, so we'll use the first
+	// one. The lookup is shared with other clients using the same
+	// credentials.
+	accountID, err := lookup.Default.AccountID(ctx, c.client, c.scope)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to list accounts")
+		return "", err
 	}
-	
-	if len(accounts) == 0 {
-		return "", errors.New("no accounts found")
-	}
-	
-	// Use the first account (most common case for users)
-	c.accountID = accounts[0].ID
+
+	c.accountID = accountID
 	return c.accountID, nil
 }
 
@@ -121,27 +143,286 @@ func (c *BucketClient) Create(ctx context.Context, params v1alpha1.BucketParamet
 		return nil, errors.Wrap(err, errCreateBucket)
 	}
 
+	// The create endpoint does not accept a storage class, so apply it
+	// straight away rather than leave the bucket on the default until the
+	// next reconcile.
+	if params.StorageClass != nil {
+		return c.Update(ctx, bucket.Name, params)
+	}
+
 	obs := convertToObservation(bucket)
 	return &obs, nil
 }
 
+// r2BucketDetails is a bucket as returned by the R2 bucket endpoint,
+// including the default storage class that cloudflare.R2Bucket omits.
+type r2BucketDetails struct {
+	cloudflare.R2Bucket
+	StorageClass string `json:"storage_class"`
+}
+
+func bucketEndpoint(accountID, bucketName string) string {
+	return fmt.Sprintf("/accounts/%s/r2/buckets/%s", accountID, bucketName)
+}
+
 // Get retrieves an R2 Bucket.
 func (c *BucketClient) Get(ctx context.Context, bucketName string) (*v1alpha1.BucketObservation, error) {
 	accountID, err := c.getAccountID(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get account ID")
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
 
-	bucket, err := c.client.GetR2Bucket(ctx, rc, bucketName)
+	res, err := c.client.Raw(ctx, http.MethodGet, bucketEndpoint(accountID, bucketName), nil, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetBucket)
 	}
 
-	obs := convertToObservation(bucket)
+	var bucket r2BucketDetails
+	if err := json.Unmarshal(res.Result, &bucket); err != nil {
+		return nil, errors.Wrap(err, errGetBucket)
+	}
+
+	obs := convertToObservation(bucket.R2Bucket)
+	obs.StorageClass = bucket.StorageClass
 	return &obs, nil
 }
 
+// r2BucketUsage is the usage of a bucket as returned by the R2 usage
+// endpoint, which reports sizes and counts as strings.
+type r2BucketUsage struct {
+	PayloadSize  json.Number `json:"payloadSize"`
+	MetadataSize json.Number `json:"metadataSize"`
+	ObjectCount  json.Number `json:"objectCount"`
+}
+
+// Usage retrieves the object count and size of an R2 Bucket.
+func (c *BucketClient) Usage(ctx context.Context, bucketName string) (*v1alpha1.BucketUsage, error) {
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account ID")
+	}
+
+	res, err := c.client.Raw(ctx, http.MethodGet, bucketEndpoint(accountID, bucketName)+"/usage", nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetUsage)
+	}
+
+	var u r2BucketUsage
+	if err := json.Unmarshal(res.Result, &u); err != nil {
+		return nil, errors.Wrap(err, errGetUsage)
+	}
+
+	usage := &v1alpha1.BucketUsage{}
+	for _, f := range []struct {
+		in  json.Number
+		out *int64
+	}{
+		{u.ObjectCount, &usage.ObjectCount},
+		{u.PayloadSize, &usage.PayloadSize},
+		{u.MetadataSize, &usage.MetadataSize},
+	} {
+		if f.in == "" {
+			continue
+		}
+		if *f.out, err = f.in.Int64(); err != nil {
+			return nil, errors.Wrap(err, errGetUsage)
+		}
+	}
+	return usage, nil
+}
+
+// SippyCredentials are the credentials Sippy reads the source bucket and
+// writes to the R2 bucket with. Only those of the source provider are set.
+type SippyCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	ClientEmail     string
+	PrivateKey      string
+
+	R2AccessKeyID     string
+	R2SecretAccessKey string
+}
+
+// Hash returns a hash of the credentials, so that rotated credentials can be
+// detected without storing them.
+func (c SippyCredentials) Hash() string {
+	h := sha256.New()
+	for _, v := range []string{c.AccessKeyID, c.SecretAccessKey, c.ClientEmail, c.PrivateKey, c.R2AccessKeyID, c.R2SecretAccessKey} {
+		// Separate the fields so that moving characters between them
+		// changes the hash.
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// r2Sippy is the Sippy configuration of a bucket as returned by the R2 Sippy
+// endpoint, which omits credentials.
+type r2Sippy struct {
+	Enabled bool `json:"enabled"`
+	Source  struct {
+		Provider string `json:"provider"`
+		Bucket   string `json:"bucket"`
+		Region   string `json:"region"`
+	} `json:"source"`
+}
+
+// r2SippySource is the source of a Sippy configuration request.
+type r2SippySource struct {
+	Provider        string `json:"provider"`
+	Bucket          string `json:"bucket"`
+	Region          string `json:"region,omitempty"`
+	AccessKeyID     string `json:"accessKeyId,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+	ClientEmail     string `json:"clientEmail,omitempty"`
+	PrivateKey      string `json:"privateKey,omitempty"`
+}
+
+// r2SippyDestination is the destination of a Sippy configuration request.
+type r2SippyDestination struct {
+	Provider        string `json:"provider"`
+	AccessKeyID     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+}
+
+// r2SippyRequest configures Sippy for a bucket.
+type r2SippyRequest struct {
+	Source      r2SippySource      `json:"source"`
+	Destination r2SippyDestination `json:"destination"`
+}
+
+func sippyEndpoint(accountID, bucketName string) string {
+	return bucketEndpoint(accountID, bucketName) + "/sippy"
+}
+
+// GetSippy retrieves the Sippy configuration of an R2 Bucket.
+func (c *BucketClient) GetSippy(ctx context.Context, bucketName string) (*v1alpha1.BucketSippyObservation, error) {
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account ID")
+	}
+
+	res, err := c.client.Raw(ctx, http.MethodGet, sippyEndpoint(accountID, bucketName), nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetSippy)
+	}
+
+	var sp r2Sippy
+	if err := json.Unmarshal(res.Result, &sp); err != nil {
+		return nil, errors.Wrap(err, errGetSippy)
+	}
+
+	obs := &v1alpha1.BucketSippyObservation{Enabled: sp.Enabled}
+	if sp.Enabled {
+		obs.SourceProvider = strings.ToUpper(sp.Source.Provider)
+		obs.SourceBucket = sp.Source.Bucket
+		obs.SourceRegion = sp.Source.Region
+	}
+	return obs, nil
+}
+
+// PutSippy enables Sippy for an R2 Bucket, or reconfigures it if it is
+// already enabled.
+func (c *BucketClient) PutSippy(ctx context.Context, bucketName string, sp v1alpha1.BucketSippy, creds SippyCredentials) error {
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get account ID")
+	}
+
+	req := r2SippyRequest{
+		Source: r2SippySource{
+			Provider: strings.ToLower(string(sp.Source.Provider)),
+			Bucket:   sp.Source.Bucket,
+		},
+		Destination: r2SippyDestination{
+			Provider:        "r2",
+			AccessKeyID:     creds.R2AccessKeyID,
+			SecretAccessKey: creds.R2SecretAccessKey,
+		},
+	}
+	switch sp.Source.Provider {
+	case v1alpha1.SippyProviderAWS:
+		if sp.Source.Region != nil {
+			req.Source.Region = *sp.Source.Region
+		}
+		req.Source.AccessKeyID = creds.AccessKeyID
+		req.Source.SecretAccessKey = creds.SecretAccessKey
+	case v1alpha1.SippyProviderGCS:
+		req.Source.ClientEmail = creds.ClientEmail
+		req.Source.PrivateKey = creds.PrivateKey
+	}
+
+	if _, err := c.client.Raw(ctx, http.MethodPut, sippyEndpoint(accountID, bucketName), req, nil); err != nil {
+		return errors.Wrap(err, errPutSippy)
+	}
+	return nil
+}
+
+// DeleteSippy disables Sippy for an R2 Bucket.
+func (c *BucketClient) DeleteSippy(ctx context.Context, bucketName string) error {
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get account ID")
+	}
+
+	if _, err := c.client.Raw(ctx, http.MethodDelete, sippyEndpoint(accountID, bucketName), nil, nil); err != nil {
+		return errors.Wrap(err, errDeleteSippy)
+	}
+	return nil
+}
+
+// SippyUpToDate returns true if the observed Sippy configuration of a bucket
+// matches its parameters. credentialsHash is the hash of the credentials
+// the parameters currently select.
+func SippyUpToDate(sp *v1alpha1.BucketSippy, obs *v1alpha1.BucketSippyObservation, credentialsHash string) bool {
+	if sp == nil {
+		return true
+	}
+	if obs == nil {
+		return false
+	}
+	if sp.Enabled != nil && !*sp.Enabled {
+		return !obs.Enabled
+	}
+	region := ""
+	if sp.Source.Provider == v1alpha1.SippyProviderAWS && sp.Source.Region != nil {
+		region = *sp.Source.Region
+	}
+	return obs.Enabled &&
+		obs.SourceProvider == string(sp.Source.Provider) &&
+		obs.SourceBucket == sp.Source.Bucket &&
+		obs.SourceRegion == region &&
+		obs.CredentialsHash == credentialsHash
+}
+
+// UsageDue returns true if the usage of a bucket should be refreshed,
+// given its parameters and the usage last observed.
+func UsageDue(params v1alpha1.BucketParameters, last *v1alpha1.BucketUsage, now time.Time) bool {
+	if params.UsageRefreshInterval == nil {
+		return false
+	}
+	return last == nil || now.Sub(last.ObservedAt.Time) >= params.UsageRefreshInterval.Duration
+}
+
+// Update updates the mutable attributes of an R2 Bucket. Only the default
+// storage class can be changed once a bucket has been created.
+func (c *BucketClient) Update(ctx context.Context, bucketName string, params v1alpha1.BucketParameters) (*v1alpha1.BucketObservation, error) {
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account ID")
+	}
+
+	if params.StorageClass != nil {
+		h := http.Header{}
+		h.Set(headerStorageClass, *params.StorageClass)
+		if _, err := c.client.Raw(ctx, http.MethodPatch, bucketEndpoint(accountID, bucketName), nil, h); err != nil {
+			return nil, errors.Wrap(err, errUpdateBucket)
+		}
+	}
+
+	return c.Get(ctx, bucketName)
+}
+
 // Delete removes an R2 Bucket.
 func (c *BucketClient) Delete(ctx context.Context, bucketName string) error {
 	accountID, err := c.getAccountID(ctx)
@@ -151,6 +432,9 @@ func (c *BucketClient) Delete(ctx context.Context, bucketName string) error {
 	rc := cloudflare.AccountIdentifier(accountID)
 
 	err = c.client.DeleteR2Bucket(ctx, rc, bucketName)
+	if IsBucketNotEmpty(err) {
+		return ErrBucketNotEmpty
+	}
 	if err != nil && !IsBucketNotFound(err) {
 		return errors.Wrap(err, errDeleteBucket)
 	}
@@ -158,6 +442,82 @@ func (c *BucketClient) Delete(ctx context.Context, bucketName string) error {
 	return nil
 }
 
+// r2Object is an object as returned by the R2 object listing endpoint.
+type r2Object struct {
+	Key string `json:"key"`
+}
+
+// listObjects returns one page of object keys in a bucket, and the cursor
+// of the next page if there is one.
+func (c *BucketClient) listObjects(ctx context.Context, accountID, bucketName, cursor string, limit int) ([]string, string, error) {
+	q := url.Values{"per_page": []string{fmt.Sprint(limit)}}
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+	endpoint := fmt.Sprintf("/accounts/%s/r2/buckets/%s/objects?%s", accountID, bucketName, q.Encode())
+
+	res, err := c.client.Raw(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errListObjects)
+	}
+
+	objects := []r2Object{}
+	if err := json.Unmarshal(res.Result, &objects); err != nil {
+		return nil, "", errors.Wrap(err, errListObjects)
+	}
+
+	keys := make([]string, len(objects))
+	for i, o := range objects {
+		keys[i] = o.Key
+	}
+
+	next := ""
+	if res.ResultInfo != nil && len(keys) > 0 {
+		next = res.ResultInfo.Cursor
+	}
+	return keys, next, nil
+}
+
+// IsEmpty returns true if the bucket contains no objects.
+func (c *BucketClient) IsEmpty(ctx context.Context, bucketName string) (bool, error) {
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get account ID")
+	}
+
+	keys, _, err := c.listObjects(ctx, accountID, bucketName, "", 1)
+	if err != nil {
+		return false, err
+	}
+	return len(keys) == 0, nil
+}
+
+// Empty deletes every object in the bucket.
+func (c *BucketClient) Empty(ctx context.Context, bucketName string) error {
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get account ID")
+	}
+
+	// Objects are deleted as we go, so always list from the start rather
+	// than following the cursor past a page we have just emptied.
+	for {
+		keys, _, err := c.listObjects(ctx, accountID, bucketName, "", objectsPageSize)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		for _, k := range keys {
+			endpoint := fmt.Sprintf("/accounts/%s/r2/buckets/%s/objects/%s", accountID, bucketName, url.PathEscape(k))
+			if _, err := c.client.Raw(ctx, http.MethodDelete, endpoint, nil, nil); err != nil && !IsBucketNotFound(err) {
+				return errors.Wrapf(err, "%s %q", errDeleteObject, k)
+			}
+		}
+	}
+}
+
 // List retrieves all R2 Buckets.
 func (c *BucketClient) List(ctx context.Context) ([]v1alpha1.BucketObservation, error) {
 	accountID, err := c.getAccountID(ctx)
@@ -181,9 +541,15 @@ func (c *BucketClient) List(ctx context.Context) ([]v1alpha1.BucketObservation,
 
 // IsUpToDate checks if the R2 Bucket is up to date.
 func (c *BucketClient) IsUpToDate(ctx context.Context, params v1alpha1.BucketParameters, obs v1alpha1.BucketObservation) (bool, error) {
-	// R2 buckets don't have many updatable properties
-	// Main check is if the bucket exists with the correct name
-	return obs.Name == params.Name, nil
+	// The location of a bucket cannot change once it has been created, so
+	// only the name and default storage class are compared.
+	if obs.Name != params.Name {
+		return false, nil
+	}
+	if params.StorageClass != nil && *params.StorageClass != obs.StorageClass {
+		return false, nil
+	}
+	return true, nil
 }
 
 // IsBucketNotFound returns true if the error indicates the bucket was not found
@@ -194,4 +560,15 @@ func IsBucketNotFound(err error) bool {
 	return err.Error() == "bucket not found" ||
 		err.Error() == "404" ||
 		err.Error() == "Not found"
+}
+
+// IsBucketNotEmpty returns true if the error indicates the bucket could not
+// be deleted because it still contains objects.
+func IsBucketNotEmpty(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, ErrBucketNotEmpty) ||
+		strings.Contains(err.Error(), errCodeBucketNotEmpty) ||
+		strings.Contains(strings.ToLower(err.Error()), "not empty")
 }
\ No newline at end of file
@@ -18,6 +18,9 @@ package bucket
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,9 +39,9 @@ import (
 type MockR2BucketAPI struct {
 	MockAccounts        func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error)
 	MockCreateR2Bucket  func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateR2BucketParameters) (cloudflare.R2Bucket, error)
-	MockGetR2Bucket     func(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) (cloudflare.R2Bucket, error)
 	MockDeleteR2Bucket  func(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) error
 	MockListR2Buckets   func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListR2BucketsParams) ([]cloudflare.R2Bucket, error)
+	MockRaw             func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
 }
 
 func (m *MockR2BucketAPI) Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
@@ -55,13 +58,6 @@ func (m *MockR2BucketAPI) CreateR2Bucket(ctx context.Context, rc *cloudflare.Res
 	return cloudflare.R2Bucket{}, nil
 }
 
-func (m *MockR2BucketAPI) GetR2Bucket(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) (cloudflare.R2Bucket, error) {
-	if m.MockGetR2Bucket != nil {
-		return m.MockGetR2Bucket(ctx, rc, bucketName)
-	}
-	return cloudflare.R2Bucket{}, nil
-}
-
 func (m *MockR2BucketAPI) DeleteR2Bucket(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) error {
 	if m.MockDeleteR2Bucket != nil {
 		return m.MockDeleteR2Bucket(ctx, rc, bucketName)
@@ -76,6 +72,13 @@ func (m *MockR2BucketAPI) ListR2Buckets(ctx context.Context, rc *cloudflare.Reso
 	return []cloudflare.R2Bucket{}, nil
 }
 
+func (m *MockR2BucketAPI) Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if m.MockRaw != nil {
+		return m.MockRaw(ctx, method, endpoint, data, headers)
+	}
+	return cloudflare.RawResponse{Result: []byte("[]")}, nil
+}
+
 func TestGetAccountID(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -385,22 +388,11 @@ func TestGet(t *testing.T) {
 							{ID: "test-account-id", Name: "Test Account"},
 						}, cloudflare.ResultInfo{}, nil
 					},
-					MockGetR2Bucket: func(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) (cloudflare.R2Bucket, error) {
-						if rc.Identifier != "test-account-id" {
-							return cloudflare.R2Bucket{}, errors.New("wrong account ID")
-						}
-						if rc.Type != cloudflare.AccountType {
-							return cloudflare.R2Bucket{}, errors.New("wrong resource type")
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						if method != http.MethodGet || endpoint != "/accounts/test-account-id/r2/buckets/test-bucket" {
+							return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
 						}
-						if bucketName != "test-bucket" {
-							return cloudflare.R2Bucket{}, errors.New("wrong bucket name")
-						}
-						creationDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-						return cloudflare.R2Bucket{
-							Name:         bucketName,
-							Location:     "ENAM",
-							CreationDate: &creationDate,
-						}, nil
+						return cloudflare.RawResponse{Result: []byte(`{"name":"test-bucket","location":"ENAM","creation_date":"2024-01-01T00:00:00Z","storage_class":"Standard"}`)}, nil
 					},
 				},
 			},
@@ -412,6 +404,7 @@ func TestGet(t *testing.T) {
 				obs: &v1alpha1.BucketObservation{
 					Name:         "test-bucket",
 					Location:     "ENAM",
+					StorageClass: "Standard",
 					CreationDate: &metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
 				},
 				err: nil,
@@ -426,11 +419,8 @@ func TestGet(t *testing.T) {
 							{ID: "test-account-id", Name: "Test Account"},
 						}, cloudflare.ResultInfo{}, nil
 					},
-					MockGetR2Bucket: func(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) (cloudflare.R2Bucket, error) {
-						return cloudflare.R2Bucket{
-							Name:     bucketName,
-							Location: "auto",
-						}, nil
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`{"name":"minimal-bucket","location":"auto"}`)}, nil
 					},
 				},
 			},
@@ -473,8 +463,8 @@ func TestGet(t *testing.T) {
 							{ID: "test-account-id", Name: "Test Account"},
 						}, cloudflare.ResultInfo{}, nil
 					},
-					MockGetR2Bucket: func(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) (cloudflare.R2Bucket, error) {
-						return cloudflare.R2Bucket{}, errBoom
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{}, errBoom
 					},
 				},
 			},
@@ -504,6 +494,85 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs     *v1alpha1.BucketObservation
+		patched string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.BucketParameters
+		patch  error
+		want   want
+	}{
+		"SetsStorageClass": {
+			reason: "Update should patch the default storage class and return the refreshed bucket",
+			params: v1alpha1.BucketParameters{Name: "test-bucket", StorageClass: ptr.To("InfrequentAccess")},
+			want: want{
+				obs:     &v1alpha1.BucketObservation{Name: "test-bucket", Location: "ENAM", StorageClass: "InfrequentAccess"},
+				patched: "InfrequentAccess",
+			},
+		},
+		"NoStorageClass": {
+			reason: "Update should not patch the bucket when no storage class is specified",
+			params: v1alpha1.BucketParameters{Name: "test-bucket"},
+			want: want{
+				obs: &v1alpha1.BucketObservation{Name: "test-bucket", Location: "ENAM", StorageClass: "Standard"},
+			},
+		},
+		"PatchError": {
+			reason: "Update should return wrapped error when the patch fails",
+			params: v1alpha1.BucketParameters{Name: "test-bucket", StorageClass: ptr.To("InfrequentAccess")},
+			patch:  errBoom,
+			want: want{
+				patched: "InfrequentAccess",
+				err:     errors.Wrap(errBoom, errUpdateBucket),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			storageClass := "Standard"
+			patched := ""
+			client := NewClient(&MockR2BucketAPI{
+				MockAccounts: func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+					return []cloudflare.Account{{ID: "test-account-id"}}, cloudflare.ResultInfo{}, nil
+				},
+				MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					if endpoint != "/accounts/test-account-id/r2/buckets/test-bucket" {
+						return cloudflare.RawResponse{}, errors.Errorf("unexpected endpoint %s", endpoint)
+					}
+					if method == http.MethodPatch {
+						patched = headers.Get("cf-r2-storage-class")
+						if tc.patch != nil {
+							return cloudflare.RawResponse{}, tc.patch
+						}
+						storageClass = patched
+						return cloudflare.RawResponse{}, nil
+					}
+					return cloudflare.RawResponse{Result: []byte(`{"name":"test-bucket","location":"ENAM","storage_class":"` + storageClass + `"}`)}, nil
+				},
+			})
+			got, err := client.Update(context.Background(), "test-bucket", tc.params)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.patched, patched); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want storage class header, +got storage class header:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	errBoom := errors.New("boom")
 	bucketName := "test-bucket"
@@ -580,6 +649,28 @@ func TestDelete(t *testing.T) {
 				err: nil,
 			},
 		},
+		"DeleteR2BucketNotEmpty": {
+			reason: "Delete should explain that a bucket cannot be deleted while it contains objects",
+			fields: fields{
+				client: &MockR2BucketAPI{
+					MockAccounts: func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+						return []cloudflare.Account{
+							{ID: "test-account-id", Name: "Test Account"},
+						}, cloudflare.ResultInfo{}, nil
+					},
+					MockDeleteR2Bucket: func(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) error {
+						return errors.New("The bucket you tried to delete is not empty (10008)")
+					},
+				},
+			},
+			args: args{
+				ctx:        context.Background(),
+				bucketName: bucketName,
+			},
+			want: want{
+				err: ErrBucketNotEmpty,
+			},
+		},
 		"DeleteR2BucketAccountError": {
 			reason: "Delete should return wrapped error when account lookup fails",
 			fields: fields{
@@ -633,6 +724,176 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestEmpty(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	accounts := func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+		return []cloudflare.Account{{ID: "test-account-id"}}, cloudflare.ResultInfo{}, nil
+	}
+
+	type want struct {
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		objects []string
+		delErr  error
+		want    want
+	}{
+		"AlreadyEmpty": {
+			reason: "Empty should not delete anything when the bucket has no objects",
+			want:   want{},
+		},
+		"DeletesAllObjects": {
+			reason:  "Empty should delete every listed object, escaping keys",
+			objects: []string{"a.txt", "dir/b.txt"},
+			want:    want{deleted: []string{"a.txt", "dir%2Fb.txt"}},
+		},
+		"DeleteError": {
+			reason:  "Empty should return errors deleting objects",
+			objects: []string{"a.txt"},
+			delErr:  errBoom,
+			want: want{
+				deleted: []string{"a.txt"},
+				err:     errors.Wrapf(errBoom, "%s %q", errDeleteObject, "a.txt"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			remaining := append([]string{}, tc.objects...)
+			var deleted []string
+			client := NewClient(&MockR2BucketAPI{
+				MockAccounts: accounts,
+				MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					prefix := "/accounts/test-account-id/r2/buckets/test-bucket/objects"
+					if method == http.MethodGet && strings.HasPrefix(endpoint, prefix+"?") {
+						objs := []r2Object{}
+						for _, k := range remaining {
+							objs = append(objs, r2Object{Key: k})
+						}
+						b, _ := json.Marshal(objs)
+						return cloudflare.RawResponse{Result: b}, nil
+					}
+					if method == http.MethodDelete && strings.HasPrefix(endpoint, prefix+"/") {
+						deleted = append(deleted, strings.TrimPrefix(endpoint, prefix+"/"))
+						if tc.delErr != nil {
+							return cloudflare.RawResponse{}, tc.delErr
+						}
+						remaining = remaining[1:]
+						return cloudflare.RawResponse{}, nil
+					}
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+				},
+			})
+
+			err := client.Empty(context.Background(), "test-bucket")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nEmpty(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("\n%s\nEmpty(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUsage(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	accounts := func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+		return []cloudflare.Account{{ID: "test-account-id"}}, cloudflare.ResultInfo{}, nil
+	}
+
+	type want struct {
+		usage *v1alpha1.BucketUsage
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		raw    func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+		want   want
+	}{
+		"Success": {
+			reason: "Usage should parse the counts and sizes reported as strings",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				if method != http.MethodGet || endpoint != "/accounts/test-account-id/r2/buckets/test-bucket/usage" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+				}
+				return cloudflare.RawResponse{Result: []byte(`{"end":"2024-01-01T00:00:00Z","payloadSize":"1048576","metadataSize":"256","objectCount":"42","uploadCount":"0"}`)}, nil
+			},
+			want: want{usage: &v1alpha1.BucketUsage{ObjectCount: 42, PayloadSize: 1048576, MetadataSize: 256}},
+		},
+		"APIError": {
+			reason: "Usage should return errors from the API",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, errBoom
+			},
+			want: want{err: errors.Wrap(errBoom, errGetUsage)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := NewClient(&MockR2BucketAPI{MockAccounts: accounts, MockRaw: tc.raw})
+			got, err := client.Usage(context.Background(), "test-bucket")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUsage(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.usage, got); diff != "" {
+				t.Errorf("\n%s\nUsage(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUsageDue(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	hourly := v1alpha1.BucketParameters{UsageRefreshInterval: &metav1.Duration{Duration: time.Hour}}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.BucketParameters
+		last   *v1alpha1.BucketUsage
+		want   bool
+	}{
+		"Disabled": {
+			reason: "Usage should not be refreshed when no interval is set",
+			params: v1alpha1.BucketParameters{},
+			want:   false,
+		},
+		"NeverObserved": {
+			reason: "Usage should be refreshed when it has not been observed",
+			params: hourly,
+			want:   true,
+		},
+		"Fresh": {
+			reason: "Usage should not be refreshed within the interval",
+			params: hourly,
+			last:   &v1alpha1.BucketUsage{ObservedAt: metav1.NewTime(now.Add(-30 * time.Minute))},
+			want:   false,
+		},
+		"Stale": {
+			reason: "Usage should be refreshed once the interval has passed",
+			params: hourly,
+			last:   &v1alpha1.BucketUsage{ObservedAt: metav1.NewTime(now.Add(-time.Hour))},
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := UsageDue(tc.params, tc.last, now); got != tc.want {
+				t.Errorf("\n%s\nUsageDue(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
 func TestList(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -827,6 +1088,28 @@ func TestIsUpToDate(t *testing.T) {
 				err:      nil,
 			},
 		},
+		"IsUpToDateFalseStorageClass": {
+			reason: "IsUpToDate should return false when the default storage class differs",
+			fields: fields{
+				client: &MockR2BucketAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.BucketParameters{
+					Name:         "test-bucket",
+					StorageClass: ptr.To("InfrequentAccess"),
+				},
+				obs: v1alpha1.BucketObservation{
+					Name:         "test-bucket",
+					Location:     "ENAM",
+					StorageClass: "Standard",
+				},
+			},
+			want: want{
+				upToDate: false,
+				err:      nil,
+			},
+		},
 		"IsUpToDateFalse": {
 			reason: "IsUpToDate should return false when bucket names don't match",
 			fields: fields{
@@ -1077,4 +1360,195 @@ func TestIsBucketNotFound(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestGetSippy(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	accounts := func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+		return []cloudflare.Account{{ID: "test-account-id"}}, cloudflare.ResultInfo{}, nil
+	}
+
+	type want struct {
+		obs *v1alpha1.BucketSippyObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		raw    func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+		want   want
+	}{
+		"Enabled": {
+			reason: "GetSippy should report the source of an enabled migration",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				if method != http.MethodGet || endpoint != "/accounts/test-account-id/r2/buckets/test-bucket/sippy" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+				}
+				return cloudflare.RawResponse{Result: []byte(`{"enabled":true,"source":{"provider":"aws","bucket":"legacy","region":"us-east-1"},"destination":{"provider":"r2","account":"test-account-id","bucket":"test-bucket","accessKeyId":"r2-key"}}`)}, nil
+			},
+			want: want{obs: &v1alpha1.BucketSippyObservation{Enabled: true, SourceProvider: "AWS", SourceBucket: "legacy", SourceRegion: "us-east-1"}},
+		},
+		"Disabled": {
+			reason: "GetSippy should report a disabled migration",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{Result: []byte(`{"enabled":false}`)}, nil
+			},
+			want: want{obs: &v1alpha1.BucketSippyObservation{}},
+		},
+		"APIError": {
+			reason: "GetSippy should return errors from the API",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, errBoom
+			},
+			want: want{err: errors.Wrap(errBoom, errGetSippy)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := NewClient(&MockR2BucketAPI{MockAccounts: accounts, MockRaw: tc.raw})
+			got, err := client.GetSippy(context.Background(), "test-bucket")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetSippy(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nGetSippy(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPutSippy(t *testing.T) {
+	accounts := func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+		return []cloudflare.Account{{ID: "test-account-id"}}, cloudflare.ResultInfo{}, nil
+	}
+
+	cases := map[string]struct {
+		reason string
+		sippy  v1alpha1.BucketSippy
+		creds  SippyCredentials
+		want   string
+	}{
+		"AWS": {
+			reason: "PutSippy should send the region and access key of an AWS source",
+			sippy: v1alpha1.BucketSippy{
+				Source: v1alpha1.SippySource{Provider: v1alpha1.SippyProviderAWS, Bucket: "legacy", Region: ptr.To("us-east-1")},
+			},
+			creds: SippyCredentials{AccessKeyID: "aws-key", SecretAccessKey: "aws-secret", R2AccessKeyID: "r2-key", R2SecretAccessKey: "r2-secret"},
+			want:  `{"source":{"provider":"aws","bucket":"legacy","region":"us-east-1","accessKeyId":"aws-key","secretAccessKey":"aws-secret"},"destination":{"provider":"r2","accessKeyId":"r2-key","secretAccessKey":"r2-secret"}}`,
+		},
+		"GCS": {
+			reason: "PutSippy should send the service account of a GCS source",
+			sippy: v1alpha1.BucketSippy{
+				Source: v1alpha1.SippySource{Provider: v1alpha1.SippyProviderGCS, Bucket: "legacy"},
+			},
+			creds: SippyCredentials{ClientEmail: "sa@example.iam.gserviceaccount.com", PrivateKey: "pem", R2AccessKeyID: "r2-key", R2SecretAccessKey: "r2-secret"},
+			want:  `{"source":{"provider":"gcs","bucket":"legacy","clientEmail":"sa@example.iam.gserviceaccount.com","privateKey":"pem"},"destination":{"provider":"r2","accessKeyId":"r2-key","secretAccessKey":"r2-secret"}}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			client := NewClient(&MockR2BucketAPI{
+				MockAccounts: accounts,
+				MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					if method != http.MethodPut || endpoint != "/accounts/test-account-id/r2/buckets/test-bucket/sippy" {
+						return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+					}
+					b, err := json.Marshal(data)
+					got = string(b)
+					return cloudflare.RawResponse{}, err
+				},
+			})
+			if err := client.PutSippy(context.Background(), "test-bucket", tc.sippy, tc.creds); err != nil {
+				t.Fatalf("\n%s\nPutSippy(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nPutSippy(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSippyUpToDate(t *testing.T) {
+	aws := &v1alpha1.BucketSippy{
+		Source: v1alpha1.SippySource{Provider: v1alpha1.SippyProviderAWS, Bucket: "legacy", Region: ptr.To("us-east-1")},
+	}
+	enabled := &v1alpha1.BucketSippyObservation{Enabled: true, SourceProvider: "AWS", SourceBucket: "legacy", SourceRegion: "us-east-1", CredentialsHash: "hash"}
+
+	cases := map[string]struct {
+		reason string
+		sippy  *v1alpha1.BucketSippy
+		obs    *v1alpha1.BucketSippyObservation
+		hash   string
+		want   bool
+	}{
+		"Unmanaged": {
+			reason: "Sippy should be up to date when it is not managed",
+			obs:    enabled,
+			want:   true,
+		},
+		"UpToDate": {
+			reason: "Sippy should be up to date when the source and credentials match",
+			sippy:  aws,
+			obs:    enabled,
+			hash:   "hash",
+			want:   true,
+		},
+		"NotEnabled": {
+			reason: "Sippy should not be up to date when it has not been enabled",
+			sippy:  aws,
+			obs:    &v1alpha1.BucketSippyObservation{},
+			hash:   "hash",
+			want:   false,
+		},
+		"SourceChanged": {
+			reason: "Sippy should not be up to date when the source bucket changed",
+			sippy: &v1alpha1.BucketSippy{
+				Source: v1alpha1.SippySource{Provider: v1alpha1.SippyProviderAWS, Bucket: "other", Region: ptr.To("us-east-1")},
+			},
+			obs:  enabled,
+			hash: "hash",
+			want: false,
+		},
+		"CredentialsRotated": {
+			reason: "Sippy should not be up to date when its credentials have been rotated",
+			sippy:  aws,
+			obs:    enabled,
+			hash:   "rotated",
+			want:   false,
+		},
+		"Disabled": {
+			reason: "Sippy should be up to date when it is disabled as desired",
+			sippy:  &v1alpha1.BucketSippy{Enabled: ptr.To(false)},
+			obs:    &v1alpha1.BucketSippyObservation{},
+			want:   true,
+		},
+		"PendingDisable": {
+			reason: "Sippy should not be up to date while it is still enabled but desired disabled",
+			sippy:  &v1alpha1.BucketSippy{Enabled: ptr.To(false)},
+			obs:    enabled,
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := SippyUpToDate(tc.sippy, tc.obs, tc.hash); got != tc.want {
+				t.Errorf("\n%s\nSippyUpToDate(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSippyCredentialsHash(t *testing.T) {
+	a := SippyCredentials{AccessKeyID: "ab", SecretAccessKey: "c"}
+	b := SippyCredentials{AccessKeyID: "a", SecretAccessKey: "bc"}
+	if a.Hash() == b.Hash() {
+		t.Errorf("Hash(...): credentials differing only in where fields split should not share a hash")
+	}
+	if a.Hash() != (SippyCredentials{AccessKeyID: "ab", SecretAccessKey: "c"}).Hash() {
+		t.Errorf("Hash(...): equal credentials should share a hash")
+	}
+}
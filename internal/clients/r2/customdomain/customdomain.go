@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package customdomain manages the custom domains R2 buckets are served
+// from.
+package customdomain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/r2/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/lookup"
+)
+
+// CustomDomainAPI defines the interface for R2 custom domain operations.
+// cloudflare-go does not support R2 custom domains, so they are managed
+// through raw requests.
+type CustomDomainAPI interface {
+	Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error)
+	Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+}
+
+const (
+	// StatusActive is the ownership and SSL status of a domain that
+	// serves its bucket.
+	StatusActive = "active"
+
+	errCreateDomain = "cannot create R2 custom domain"
+	errUpdateDomain = "cannot update R2 custom domain"
+	errGetDomain    = "cannot get R2 custom domain"
+	errDeleteDomain = "cannot delete R2 custom domain"
+	errAccountID    = "failed to get account ID"
+)
+
+// CustomDomainClient provides operations for R2 custom domains.
+type CustomDomainClient struct {
+	client CustomDomainAPI
+	scope  string
+}
+
+// NewClient creates a new R2 custom domain client.
+func NewClient(client CustomDomainAPI) *CustomDomainClient {
+	return &CustomDomainClient{client: client, scope: lookup.Scope(client)}
+}
+
+// r2CustomDomain is a custom domain as returned by the R2 custom domain
+// endpoints.
+type r2CustomDomain struct {
+	Domain   string `json:"domain"`
+	Enabled  bool   `json:"enabled"`
+	MinTLS   string `json:"minTLS,omitempty"`
+	ZoneID   string `json:"zoneId,omitempty"`
+	ZoneName string `json:"zoneName,omitempty"`
+	Status   struct {
+		Ownership string `json:"ownership"`
+		SSL       string `json:"ssl"`
+	} `json:"status"`
+}
+
+// r2CustomDomainRequest is the body of requests attaching or updating a
+// custom domain.
+type r2CustomDomainRequest struct {
+	Domain  string  `json:"domain,omitempty"`
+	ZoneID  string  `json:"zoneId,omitempty"`
+	Enabled bool    `json:"enabled"`
+	MinTLS  *string `json:"minTLS,omitempty"`
+}
+
+func domainsEndpoint(accountID, bucket string) string {
+	return fmt.Sprintf("/accounts/%s/r2/buckets/%s/domains/custom", accountID, bucket)
+}
+
+func domainEndpoint(accountID, bucket, domain string) string {
+	return domainsEndpoint(accountID, bucket) + "/" + domain
+}
+
+// Get retrieves the custom domain of a bucket.
+func (c *CustomDomainClient) Get(ctx context.Context, bucket, domain string) (*v1alpha1.R2CustomDomainObservation, error) {
+	accountID, err := lookup.Default.AccountID(ctx, c.client, c.scope)
+	if err != nil {
+		return nil, errors.Wrap(err, errAccountID)
+	}
+
+	res, err := c.client.Raw(ctx, http.MethodGet, domainEndpoint(accountID, bucket, domain), nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetDomain)
+	}
+
+	var d r2CustomDomain
+	if err := json.Unmarshal(res.Result, &d); err != nil {
+		return nil, errors.Wrap(err, errGetDomain)
+	}
+
+	obs := generateObservation(d)
+	return &obs, nil
+}
+
+// Create attaches a custom domain to a bucket.
+func (c *CustomDomainClient) Create(ctx context.Context, bucket string, params v1alpha1.R2CustomDomainParameters) error {
+	accountID, err := lookup.Default.AccountID(ctx, c.client, c.scope)
+	if err != nil {
+		return errors.Wrap(err, errAccountID)
+	}
+
+	req := r2CustomDomainRequest{
+		Domain:  params.Domain,
+		ZoneID:  ptr.Deref(params.Zone, ""),
+		Enabled: ptr.Deref(params.Enabled, true),
+		MinTLS:  params.MinTLS,
+	}
+	_, err = c.client.Raw(ctx, http.MethodPost, domainsEndpoint(accountID, bucket), req, nil)
+	return errors.Wrap(err, errCreateDomain)
+}
+
+// Update changes whether a custom domain is enabled and its minimum TLS
+// version.
+func (c *CustomDomainClient) Update(ctx context.Context, bucket string, params v1alpha1.R2CustomDomainParameters) error {
+	accountID, err := lookup.Default.AccountID(ctx, c.client, c.scope)
+	if err != nil {
+		return errors.Wrap(err, errAccountID)
+	}
+
+	req := r2CustomDomainRequest{
+		Enabled: ptr.Deref(params.Enabled, true),
+		MinTLS:  params.MinTLS,
+	}
+	_, err = c.client.Raw(ctx, http.MethodPut, domainEndpoint(accountID, bucket, params.Domain), req, nil)
+	return errors.Wrap(err, errUpdateDomain)
+}
+
+// Delete detaches a custom domain from a bucket.
+func (c *CustomDomainClient) Delete(ctx context.Context, bucket, domain string) error {
+	accountID, err := lookup.Default.AccountID(ctx, c.client, c.scope)
+	if err != nil {
+		return errors.Wrap(err, errAccountID)
+	}
+
+	_, err = c.client.Raw(ctx, http.MethodDelete, domainEndpoint(accountID, bucket, domain), nil, nil)
+	if err != nil && !IsCustomDomainNotFound(err) {
+		return errors.Wrap(err, errDeleteDomain)
+	}
+	return nil
+}
+
+// generateObservation creates an observation of an R2 custom domain.
+func generateObservation(d r2CustomDomain) v1alpha1.R2CustomDomainObservation {
+	return v1alpha1.R2CustomDomainObservation{
+		Domain:          d.Domain,
+		Enabled:         d.Enabled,
+		MinTLS:          d.MinTLS,
+		ZoneName:        d.ZoneName,
+		OwnershipStatus: d.Status.Ownership,
+		SSLStatus:       d.Status.SSL,
+	}
+}
+
+// IsUpToDate returns true if the custom domain is enabled as desired and
+// uses the desired minimum TLS version.
+func IsUpToDate(params v1alpha1.R2CustomDomainParameters, obs v1alpha1.R2CustomDomainObservation) bool {
+	if ptr.Deref(params.Enabled, true) != obs.Enabled {
+		return false
+	}
+	return params.MinTLS == nil || *params.MinTLS == obs.MinTLS
+}
+
+// IsActive returns true if the custom domain serves its bucket.
+func IsActive(obs v1alpha1.R2CustomDomainObservation) bool {
+	return obs.OwnershipStatus == StatusActive && obs.SSLStatus == StatusActive
+}
+
+// IsCustomDomainNotFound returns true if the error indicates the custom
+// domain was not found.
+func IsCustomDomainNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
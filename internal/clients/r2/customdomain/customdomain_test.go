@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customdomain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/r2/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/fake"
+)
+
+// withAccount returns an API whose raw requests are served by raw, in the
+// only account of the token.
+func withAccount(raw func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)) *fake.R2APIMock {
+	return &fake.R2APIMock{
+		AccountsFunc: func(context.Context, cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+			return []cloudflare.Account{{ID: "account-id"}}, cloudflare.ResultInfo{}, nil
+		},
+		RawFunc: raw,
+	}
+}
+
+func TestGet(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs *v1alpha1.R2CustomDomainObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		raw    func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+		want   want
+	}{
+		"Error": {
+			reason: "Errors getting the custom domain should be returned",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, errBoom
+			},
+			want: want{err: errors.Wrap(errBoom, errGetDomain)},
+		},
+		"Success": {
+			reason: "The TLS settings and status of the custom domain should be observed",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				if method != http.MethodGet || endpoint != "/accounts/account-id/r2/buckets/assets/domains/custom/assets.example.com" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+				}
+				return cloudflare.RawResponse{Result: json.RawMessage(`{
+					"domain": "assets.example.com",
+					"enabled": true,
+					"minTLS": "1.2",
+					"zoneId": "zone-id",
+					"zoneName": "example.com",
+					"status": {"ownership": "active", "ssl": "pending"}
+				}`)}, nil
+			},
+			want: want{obs: &v1alpha1.R2CustomDomainObservation{
+				Domain:          "assets.example.com",
+				Enabled:         true,
+				MinTLS:          "1.2",
+				ZoneName:        "example.com",
+				OwnershipStatus: "active",
+				SSLStatus:       "pending",
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(withAccount(tc.raw))
+			got, err := c.Get(context.Background(), "assets", "assets.example.com")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var got interface{}
+	c := NewClient(withAccount(func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+		if method != http.MethodPost || endpoint != "/accounts/account-id/r2/buckets/assets/domains/custom" {
+			return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+		}
+		got = data
+		return cloudflare.RawResponse{}, nil
+	}))
+
+	params := v1alpha1.R2CustomDomainParameters{Domain: "assets.example.com", Zone: ptr.To("zone-id"), MinTLS: ptr.To("1.3")}
+	if err := c.Create(context.Background(), "assets", params); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+
+	want := r2CustomDomainRequest{Domain: "assets.example.com", ZoneID: "zone-id", Enabled: true, MinTLS: ptr.To("1.3")}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Create(...): -want request, +got request:\n%s\n", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.R2CustomDomainParameters
+		obs    v1alpha1.R2CustomDomainObservation
+		want   bool
+	}{
+		"EnabledByDefault": {
+			reason: "A domain should be enabled when enabled is unset",
+			params: v1alpha1.R2CustomDomainParameters{},
+			obs:    v1alpha1.R2CustomDomainObservation{Enabled: false},
+			want:   false,
+		},
+		"MinTLSChanged": {
+			reason: "A domain with another minimum TLS version should not be up to date",
+			params: v1alpha1.R2CustomDomainParameters{MinTLS: ptr.To("1.3")},
+			obs:    v1alpha1.R2CustomDomainObservation{Enabled: true, MinTLS: "1.0"},
+			want:   false,
+		},
+		"UpToDate": {
+			reason: "An unset minimum TLS version should be left alone",
+			params: v1alpha1.R2CustomDomainParameters{Enabled: ptr.To(true)},
+			obs:    v1alpha1.R2CustomDomainObservation{Enabled: true, MinTLS: "1.0"},
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.params, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rawjson compares and normalizes the free-form JSON fields of
+// managed resources.
+//
+// Free-form JSON is specified as a runtime.RawExtension, so that it can be
+// written as structured YAML rather than an escaped string. Such fields are
+// marked
+//
+//	// +kubebuilder:pruning:PreserveUnknownFields
+//	// +optional
+//	Value *runtime.RawExtension `json:"value,omitempty"`
+//
+// and constrained with CEL on the enclosing type where needed. Cloudflare
+// returns JSON with its own key order and formatting, and often with
+// defaulted keys, so such fields must be compared with IsUpToDate rather
+// than byte for byte.
+package rawjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	errDecode = "cannot decode JSON"
+	errEncode = "cannot encode JSON"
+)
+
+// FromExtension returns the JSON encoding of the supplied extension, or nil
+// if it is unset.
+func FromExtension(e *runtime.RawExtension) ([]byte, error) {
+	if e == nil {
+		return nil, nil
+	}
+	if e.Raw != nil {
+		return e.Raw, nil
+	}
+	if e.Object == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(e.Object)
+	return b, errors.Wrap(err, errEncode)
+}
+
+// Normalize returns the compact encoding of the supplied JSON with object
+// keys sorted. Numbers are preserved exactly as written.
+func Normalize(raw []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	b, err := json.Marshal(v)
+	return b, errors.Wrap(err, errEncode)
+}
+
+// Equal returns true if the supplied JSON documents are semantically
+// equal, regardless of key order, whitespace or how numbers are written.
+func Equal(a, b []byte) (bool, error) {
+	x, y, err := decodeBoth(a, b)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(x, y), nil
+}
+
+// IsUpToDate returns true if every value in the desired JSON document is
+// present and equal in the observed one. Keys the observed document has in
+// addition, typically defaults filled in by Cloudflare, are ignored. Arrays
+// must have the same length and are compared element by element.
+func IsUpToDate(desired, observed []byte) (bool, error) {
+	x, y, err := decodeBoth(desired, observed)
+	if err != nil {
+		return false, err
+	}
+	return contains(y, x), nil
+}
+
+func decodeBoth(a, b []byte) (any, any, error) {
+	var x, y any
+	if err := json.Unmarshal(a, &x); err != nil {
+		return nil, nil, errors.Wrap(err, errDecode)
+	}
+	if err := json.Unmarshal(b, &y); err != nil {
+		return nil, nil, errors.Wrap(err, errDecode)
+	}
+	return x, y, nil
+}
+
+// contains returns true if want is a subset of got.
+func contains(got, want any) bool {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, wv := range w {
+			gv, ok := g[k]
+			if !ok || !contains(gv, wv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !contains(g[i], w[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(got, want)
+	}
+}
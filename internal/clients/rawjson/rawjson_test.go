@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rawjson
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		in     string
+		want   string
+	}{
+		"SortsKeys": {
+			reason: "Object keys should be sorted and whitespace removed",
+			in:     `{ "b": [1, {"d": true, "c": null}], "a": "x" }`,
+			want:   `{"a":"x","b":[1,{"c":null,"d":true}]}`,
+		},
+		"PreservesNumbers": {
+			reason: "Numbers should be preserved exactly as written",
+			in:     `{"big": 12345678901234567890, "f": 1.50}`,
+			want:   `{"big":12345678901234567890,"f":1.50}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Normalize([]byte(tc.in))
+			if err != nil {
+				t.Fatalf("\n%s\nNormalize(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("\n%s\nNormalize(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFromExtension(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		in     *runtime.RawExtension
+		want   string
+	}{
+		"Nil": {
+			reason: "An unset extension should have no encoding",
+		},
+		"Raw": {
+			reason: "The raw encoding should be returned as is",
+			in:     &runtime.RawExtension{Raw: []byte(`{"a":1}`)},
+			want:   `{"a":1}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FromExtension(tc.in)
+			if err != nil {
+				t.Fatalf("\n%s\nFromExtension(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("\n%s\nFromExtension(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		desired  string
+		observed string
+		want     bool
+		equal    bool
+	}{
+		"KeyOrder": {
+			reason:   "Documents differing only in key order and formatting should be equal",
+			desired:  `{"a":1,"b":{"c":[1,2]}}`,
+			observed: `{"b": {"c": [1, 2.0]}, "a": 1.0}`,
+			want:     true,
+			equal:    true,
+		},
+		"Defaults": {
+			reason:   "Keys only present in the observed document should be ignored",
+			desired:  `{"a":1}`,
+			observed: `{"a":1,"enabled":true}`,
+			want:     true,
+		},
+		"Changed": {
+			reason:   "A differing value should not be up to date",
+			desired:  `{"a":{"b":"x"}}`,
+			observed: `{"a":{"b":"y"}}`,
+		},
+		"Missing": {
+			reason:   "A desired key missing from the observed document should not be up to date",
+			desired:  `{"a":1,"b":null}`,
+			observed: `{"a":1}`,
+		},
+		"ArrayLength": {
+			reason:   "Arrays of differing length should not be up to date",
+			desired:  `[1]`,
+			observed: `[1,2]`,
+		},
+		"ArrayOrder": {
+			reason:   "Array order should be significant",
+			desired:  `[1,2]`,
+			observed: `[2,1]`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate([]byte(tc.desired), []byte(tc.observed))
+			if err != nil {
+				t.Fatalf("\n%s\nIsUpToDate(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			eq, err := Equal([]byte(tc.desired), []byte(tc.observed))
+			if err != nil {
+				t.Fatalf("\n%s\nEqual(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.equal, eq); diff != "" {
+				t.Errorf("\n%s\nEqual(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
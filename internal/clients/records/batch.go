@@ -0,0 +1,331 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	errBatchRequest  = "cannot execute DNS record batch"
+	errBatchDecode   = "cannot decode DNS record batch response"
+	errBatchMismatch = "DNS record batch response does not match request"
+
+	// DefaultBatchWindow is how long the Batcher waits for further
+	// operations on a zone before flushing them.
+	DefaultBatchWindow = 250 * time.Millisecond
+
+	// DefaultBatchSize is the maximum number of operations sent in a
+	// single batch request.
+	DefaultBatchSize = 100
+
+	batchFlushTimeout = 2 * time.Minute
+)
+
+// RawClient is implemented by Cloudflare API clients that can issue
+// requests against endpoints not wrapped by cloudflare-go, such as the
+// DNS record batch endpoint.
+type RawClient interface {
+	Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+}
+
+// batchRecord is a DNS record as accepted by the batch endpoint.
+type batchRecord struct {
+	Type     string      `json:"type"`
+	Name     string      `json:"name"`
+	Content  string      `json:"content,omitempty"`
+	Data     interface{} `json:"data,omitempty"`
+	Priority *uint16     `json:"priority,omitempty"`
+	TTL      int         `json:"ttl,omitempty"`
+	Proxied  *bool       `json:"proxied,omitempty"`
+	Comment  string      `json:"comment,omitempty"`
+	Tags     []string    `json:"tags,omitempty"`
+}
+
+type batchID struct {
+	ID string `json:"id"`
+}
+
+type batchRequest struct {
+	Deletes []batchID     `json:"deletes,omitempty"`
+	Posts   []batchRecord `json:"posts,omitempty"`
+}
+
+type batchResult struct {
+	Deletes []cloudflare.DNSRecord `json:"deletes"`
+	Posts   []cloudflare.DNSRecord `json:"posts"`
+}
+
+// batchOp is a single queued create or delete.
+type batchOp struct {
+	create   *cloudflare.CreateDNSRecordParams
+	deleteID string
+	done     chan opResult
+}
+
+type opResult struct {
+	record cloudflare.DNSRecord
+	err    error
+}
+
+// batchKey identifies the operations that may be sent in one batch: those
+// for the same zone issued through the same ProviderConfig.
+type batchKey struct {
+	scope  string
+	zoneID string
+}
+
+// pendingBatch holds the operations queued for one zone and scope.
+type pendingBatch struct {
+	client Client
+	ops    []*batchOp
+
+	// values is the context of the operation that opened the batch, whose
+	// values, such as the controller its requests are rate limited as,
+	// apply to the requests flushing it.
+	values context.Context
+}
+
+// remove removes the supplied operation from the batch, returning false if
+// it was not queued in it.
+func (pb *pendingBatch) remove(op *batchOp) bool {
+	for i := range pb.ops {
+		if pb.ops[i] == op {
+			pb.ops = append(pb.ops[:i], pb.ops[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// A Batcher coalesces DNS record creations and deletions issued for the
+// same zone within a short window into a single call to the
+// POST /zones/:id/dns_records/batch endpoint. Callers block until the
+// batch containing their operation has been flushed.
+//
+// Batches are keyed by zone and by a scope identifying the ProviderConfig
+// and credentials of the caller, so an operation is only ever sent with a
+// client built from its own ProviderConfig, subject to its rate limit and
+// request policy. Single-operation batches, clients that cannot issue raw
+// requests, and batches rejected by the API fall back to individual calls
+// so that errors are attributed to the right record.
+//
+// An operation whose context is cancelled before its batch is flushed is
+// dropped from the batch. Once the batch is being flushed the operation is
+// sent regardless, so its caller waits for the result rather than losing
+// track of a record that was created.
+type Batcher struct {
+	window  time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	pending map[batchKey]*pendingBatch
+}
+
+// NewBatcher returns a Batcher that flushes after the supplied window or
+// once maxSize operations are queued for a zone.
+func NewBatcher(window time.Duration, maxSize int) *Batcher {
+	if maxSize < 1 {
+		maxSize = 1
+	}
+	return &Batcher{
+		window:  window,
+		maxSize: maxSize,
+		pending: map[batchKey]*pendingBatch{},
+	}
+}
+
+// CreateDNSRecord queues a DNS record creation and waits for its result.
+// The scope identifies the ProviderConfig and credentials the client was
+// built from; operations are only batched with others of the same scope.
+func (b *Batcher) CreateDNSRecord(ctx context.Context, client Client, scope, zoneID string, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	r := b.submit(ctx, client, batchKey{scope: scope, zoneID: zoneID}, &batchOp{create: &params, done: make(chan opResult, 1)})
+	return r.record, r.err
+}
+
+// DeleteDNSRecord queues a DNS record deletion and waits for its result.
+// The scope is that of CreateDNSRecord.
+func (b *Batcher) DeleteDNSRecord(ctx context.Context, client Client, scope, zoneID, recordID string) error {
+	r := b.submit(ctx, client, batchKey{scope: scope, zoneID: zoneID}, &batchOp{deleteID: recordID, done: make(chan opResult, 1)})
+	return r.err
+}
+
+func (b *Batcher) submit(ctx context.Context, client Client, key batchKey, op *batchOp) opResult {
+	b.mu.Lock()
+	pb, ok := b.pending[key]
+	if !ok {
+		pb = &pendingBatch{client: client, values: ctx}
+		b.pending[key] = pb
+		time.AfterFunc(b.window, func() { b.flushPending(key, pb) })
+	}
+	pb.ops = append(pb.ops, op)
+	if len(pb.ops) >= b.maxSize {
+		delete(b.pending, key)
+		go b.flush(key.zoneID, pb)
+	}
+	b.mu.Unlock()
+
+	select {
+	case r := <-op.done:
+		return r
+	case <-ctx.Done():
+	}
+
+	// Drop the operation if its batch has not been flushed yet. Otherwise
+	// it is being sent, and a record it creates would be lost if its
+	// result were not returned.
+	b.mu.Lock()
+	dropped := b.pending[key] == pb && pb.remove(op)
+	b.mu.Unlock()
+	if dropped {
+		return opResult{err: ctx.Err()}
+	}
+	return <-op.done
+}
+
+// flushPending flushes the supplied batch if it is still pending.
+func (b *Batcher) flushPending(key batchKey, pb *pendingBatch) {
+	b.mu.Lock()
+	if b.pending[key] != pb {
+		// Already flushed because it reached the maximum size.
+		b.mu.Unlock()
+		return
+	}
+	delete(b.pending, key)
+	b.mu.Unlock()
+
+	b.flush(key.zoneID, pb)
+}
+
+func (b *Batcher) flush(zoneID string, pb *pendingBatch) {
+	if len(pb.ops) == 0 {
+		// Every operation was cancelled before the batch was flushed.
+		return
+	}
+
+	// The batch is flushed on behalf of every operation in it, so it is not
+	// cancelled with the context of the operation that opened it.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(pb.values), batchFlushTimeout)
+	defer cancel()
+
+	rc, ok := pb.client.(RawClient)
+	if len(pb.ops) == 1 || !ok {
+		flushIndividually(ctx, pb.client, zoneID, pb.ops)
+		return
+	}
+
+	err := flushBatch(ctx, rc, zoneID, pb.ops)
+	switch {
+	case err == nil:
+	case isRejected(err):
+		// The batch endpoint is atomic, so a single invalid record
+		// fails every operation. Retry individually so each caller
+		// gets its own result.
+		flushIndividually(ctx, pb.client, zoneID, pb.ops)
+	default:
+		// The batch may have been applied even though its response was
+		// lost, so retrying it could create its records twice. Each
+		// caller's next Observe finds out whether it was.
+		failAll(pb.ops, err)
+	}
+}
+
+// isRejected returns true if the supplied error reports that Cloudflare
+// refused a request without applying it. Rate limited requests are not
+// considered rejected, so that they are not retried one by one.
+func isRejected(err error) bool {
+	var cfErr *cloudflare.Error
+	return errors.As(err, &cfErr) && cfErr.ClientError() && !cfErr.ClientRateLimited()
+}
+
+func flushIndividually(ctx context.Context, client Client, zoneID string, ops []*batchOp) {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	for _, op := range ops {
+		if op.create != nil {
+			rec, err := client.CreateDNSRecord(ctx, rc, *op.create)
+			op.done <- opResult{record: rec, err: err}
+			continue
+		}
+		op.done <- opResult{err: client.DeleteDNSRecord(ctx, rc, op.deleteID)}
+	}
+}
+
+func failAll(ops []*batchOp, err error) {
+	for _, op := range ops {
+		op.done <- opResult{err: err}
+	}
+}
+
+// flushBatch sends the operations in a single batch request. It only
+// returns an error if the request itself failed, in which case no
+// operation has been sent its result.
+func flushBatch(ctx context.Context, client RawClient, zoneID string, ops []*batchOp) error {
+	req := batchRequest{}
+	var creates, deletes []*batchOp
+	for _, op := range ops {
+		if op.create != nil {
+			req.Posts = append(req.Posts, batchRecord{
+				Type:     op.create.Type,
+				Name:     op.create.Name,
+				Content:  op.create.Content,
+				Data:     op.create.Data,
+				Priority: op.create.Priority,
+				TTL:      op.create.TTL,
+				Proxied:  op.create.Proxied,
+				Comment:  op.create.Comment,
+				Tags:     op.create.Tags,
+			})
+			creates = append(creates, op)
+			continue
+		}
+		req.Deletes = append(req.Deletes, batchID{ID: op.deleteID})
+		deletes = append(deletes, op)
+	}
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/dns_records/batch", zoneID), req, nil)
+	if err != nil {
+		return errors.Wrap(err, errBatchRequest)
+	}
+
+	// The batch was applied, so from here on errors must not trigger a
+	// retry that would create the records a second time.
+	out := batchResult{}
+	if err := json.Unmarshal(res.Result, &out); err != nil {
+		failAll(ops, errors.Wrap(err, errBatchDecode))
+		return nil
+	}
+	if len(out.Posts) != len(creates) {
+		failAll(ops, errors.New(errBatchMismatch))
+		return nil
+	}
+
+	for i, op := range creates {
+		op.done <- opResult{record: out.Posts[i]}
+	}
+	for _, op := range deletes {
+		op.done <- opResult{}
+	}
+	return nil
+}
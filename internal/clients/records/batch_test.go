@@ -0,0 +1,247 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients/records/fake"
+)
+
+func TestBatcher(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		names      []string
+		err        error
+		rawCalls   int32
+		individual int32
+	}
+
+	cases := map[string]struct {
+		reason  string
+		creates []string
+		raw     func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+		create  func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
+		want    want
+	}{
+		"SingleOperationIsNotBatched": {
+			reason:  "A lone operation should be sent with the regular create call",
+			creates: []string{"a.example.com"},
+			want: want{
+				names:      []string{"a.example.com"},
+				individual: 1,
+			},
+		},
+		"OperationsAreBatched": {
+			reason:  "Concurrent operations in the same zone should be sent as one batch request",
+			creates: []string{"a.example.com", "b.example.com", "c.example.com"},
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				if method != http.MethodPost || endpoint != "/zones/zone-id/dns_records/batch" {
+					return cloudflare.RawResponse{}, errors.New("unexpected request")
+				}
+				req := data.(batchRequest)
+				out := batchResult{}
+				for _, p := range req.Posts {
+					out.Posts = append(out.Posts, cloudflare.DNSRecord{ID: p.Name, Name: p.Name})
+				}
+				b, _ := json.Marshal(out)
+				return cloudflare.RawResponse{Result: b}, nil
+			},
+			want: want{
+				names:    []string{"a.example.com", "b.example.com", "c.example.com"},
+				rawCalls: 1,
+			},
+		},
+		"RejectedBatchFallsBack": {
+			reason:  "A rejected batch should be retried as individual calls so each caller gets its own result",
+			creates: []string{"a.example.com", "b.example.com"},
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, &cloudflare.Error{StatusCode: http.StatusBadRequest}
+			},
+			want: want{
+				names:      []string{"a.example.com", "b.example.com"},
+				rawCalls:   1,
+				individual: 2,
+			},
+		},
+		"FailedBatchIsNotRetried": {
+			reason:  "A batch that may have been applied should not be retried, so that its records are not created twice",
+			creates: []string{"a.example.com", "b.example.com"},
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, errBoom
+			},
+			want: want{
+				names:    []string{"", ""},
+				err:      errors.Wrap(errBoom, errBatchRequest),
+				rawCalls: 1,
+			},
+		},
+		"ServerErrorIsNotRetried": {
+			reason:  "A batch that failed with a server error may have been applied, so it should not be retried",
+			creates: []string{"a.example.com", "b.example.com"},
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, &cloudflare.Error{StatusCode: http.StatusBadGateway}
+			},
+			want: want{
+				names:    []string{"", ""},
+				err:      errors.Wrap(&cloudflare.Error{StatusCode: http.StatusBadGateway}, errBatchRequest),
+				rawCalls: 1,
+			},
+		},
+		"IndividualErrorsAreReturned": {
+			reason:  "Errors from individual calls should be returned to the caller",
+			creates: []string{"a.example.com"},
+			create: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+				return cloudflare.DNSRecord{}, errBoom
+			},
+			want: want{
+				names:      []string{""},
+				err:        errBoom,
+				individual: 1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var rawCalls, individual int32
+			client := fake.MockClient{
+				MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					atomic.AddInt32(&rawCalls, 1)
+					return tc.raw(ctx, method, endpoint, data, headers)
+				},
+				MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+					atomic.AddInt32(&individual, 1)
+					if tc.create != nil {
+						return tc.create(ctx, rc, params)
+					}
+					return cloudflare.DNSRecord{ID: params.Name, Name: params.Name}, nil
+				},
+			}
+
+			b := NewBatcher(50*time.Millisecond, DefaultBatchSize)
+			names := make([]string, len(tc.creates))
+			errs := make([]error, len(tc.creates))
+			var wg sync.WaitGroup
+			for i, n := range tc.creates {
+				wg.Add(1)
+				go func(i int, n string) {
+					defer wg.Done()
+					rec, err := b.CreateDNSRecord(context.Background(), client, "scope", "zone-id", cloudflare.CreateDNSRecordParams{Type: "A", Name: n})
+					names[i] = rec.Name
+					errs[i] = err
+				}(i, n)
+			}
+			wg.Wait()
+
+			if diff := cmp.Diff(tc.want.names, names); diff != "" {
+				t.Errorf("\n%s\nCreateDNSRecord(...): -want names, +got names:\n%s\n", tc.reason, diff)
+			}
+			for _, err := range errs {
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("\n%s\nCreateDNSRecord(...): -want error, +got error:\n%s\n", tc.reason, diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.rawCalls, rawCalls); diff != "" {
+				t.Errorf("\n%s\nCreateDNSRecord(...): -want batch calls, +got batch calls:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.individual, individual); diff != "" {
+				t.Errorf("\n%s\nCreateDNSRecord(...): -want individual calls, +got individual calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBatcherCancelled(t *testing.T) {
+	var individual int32
+	client := fake.MockClient{
+		MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+			atomic.AddInt32(&individual, 1)
+			return cloudflare.DNSRecord{ID: params.Name, Name: params.Name}, nil
+		},
+	}
+
+	b := NewBatcher(50*time.Millisecond, DefaultBatchSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := b.CreateDNSRecord(ctx, client, "scope", "zone-id", cloudflare.CreateDNSRecordParams{Type: "A", Name: "a.example.com"})
+	if diff := cmp.Diff(context.Canceled, err, test.EquateErrors()); diff != "" {
+		t.Errorf("CreateDNSRecord(...): -want error, +got error:\n%s\n", diff)
+	}
+
+	// Wait for the batch window to pass; the cancelled creation must not
+	// be sent after its caller gave up on it.
+	time.Sleep(100 * time.Millisecond)
+	if got := atomic.LoadInt32(&individual); got != 0 {
+		t.Errorf("CreateDNSRecord(...): a cancelled creation was sent %d times", got)
+	}
+}
+
+func TestBatcherScopes(t *testing.T) {
+	var rawCalls int32
+	var mu sync.Mutex
+	sentBy := map[string]string{}
+	clientFor := func(scope string) fake.MockClient {
+		return fake.MockClient{
+			MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				atomic.AddInt32(&rawCalls, 1)
+				return cloudflare.RawResponse{}, errors.New("unexpected batch")
+			},
+			MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+				mu.Lock()
+				sentBy[params.Name] = scope
+				mu.Unlock()
+				return cloudflare.DNSRecord{ID: params.Name, Name: params.Name}, nil
+			},
+		}
+	}
+
+	b := NewBatcher(50*time.Millisecond, DefaultBatchSize)
+	var wg sync.WaitGroup
+	for _, scope := range []string{"first", "second"} {
+		wg.Add(1)
+		go func(scope string) {
+			defer wg.Done()
+			if _, err := b.CreateDNSRecord(context.Background(), clientFor(scope), scope, "zone-id", cloudflare.CreateDNSRecordParams{Type: "A", Name: scope + ".example.com"}); err != nil {
+				t.Errorf("CreateDNSRecord(...): unexpected error: %v", err)
+			}
+		}(scope)
+	}
+	wg.Wait()
+
+	want := map[string]string{"first.example.com": "first", "second.example.com": "second"}
+	if diff := cmp.Diff(want, sentBy); diff != "" {
+		t.Errorf("CreateDNSRecord(...): operations of different scopes should be sent with their own client: -want, +got:\n%s\n", diff)
+	}
+	if got := atomic.LoadInt32(&rawCalls); got != 0 {
+		t.Errorf("CreateDNSRecord(...): operations of different scopes should not be batched together, got %d batch calls", got)
+	}
+}
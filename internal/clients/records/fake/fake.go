@@ -18,16 +18,20 @@ package fake
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 )
 
 // A MockClient acts as a testable representation of the Cloudflare API.
 type MockClient struct {
-	MockCreateDNSRecord func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
-	MockUpdateDNSRecord func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
-	MockGetDNSRecord    func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
-	MockDeleteDNSRecord func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+	MockCreateDNSRecord  func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
+	MockUpdateDNSRecord  func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
+	MockGetDNSRecord     func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
+	MockDeleteDNSRecord  func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+	MockListDNSRecords   func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
+	MockRaw              func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+	MockListZonesContext func(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error)
 }
 
 // CreateDNSRecord mocks the CreateDNSRecord method of the Cloudflare API.
@@ -61,3 +65,27 @@ func (m MockClient) DeleteDNSRecord(ctx context.Context, rc *cloudflare.Resource
 	}
 	return nil
 }
+
+// ListDNSRecords mocks the ListDNSRecords method of the Cloudflare API.
+func (m MockClient) ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+	if m.MockListDNSRecords != nil {
+		return m.MockListDNSRecords(ctx, rc, params)
+	}
+	return nil, nil, nil
+}
+
+// Raw mocks the Raw method of the Cloudflare API.
+func (m MockClient) Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if m.MockRaw != nil {
+		return m.MockRaw(ctx, method, endpoint, data, headers)
+	}
+	return cloudflare.RawResponse{}, nil
+}
+
+// ListZonesContext mocks the ListZonesContext method of the Cloudflare API.
+func (m MockClient) ListZonesContext(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error) {
+	if m.MockListZonesContext != nil {
+		return m.MockListZonesContext(ctx, opts...)
+	}
+	return cloudflare.ZonesResponse{}, nil
+}
@@ -18,12 +18,19 @@ package records
 
 import (
 	"context"
+	"encoding/json"
+	"net"
 	"net/http"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 )
@@ -31,15 +38,38 @@ import (
 const (
 	// Cloudflare returns this code when a record isnt found.
 	errRecordNotFound = "81044"
+
+	// Cloudflare returns this code when creating a record identical to
+	// one that already exists.
+	errRecordIdentical = "81058"
+
+	// ConnectionDetailID is the connection detail holding the ID of a
+	// record.
+	ConnectionDetailID = "id"
+
+	// ConnectionDetailFQDN is the connection detail holding the fully
+	// qualified name of a record.
+	ConnectionDetailFQDN = "fqdn"
+
+	// ConnectionDetailZoneID is the connection detail holding the ID of
+	// the zone of a record.
+	ConnectionDetailZoneID = "zoneId"
+
+	// InternalTag is the tag of records that are only meant to be resolved
+	// by internal clients.
+	InternalTag = "visibility:internal"
+
+	errInternalProxied   = "internal records cannot be proxied"
+	errProxiedPrivateFmt = "refusing to proxy private address %s; set allowProxiedPrivateTarget to proxy it anyway"
+	errListRecords       = "cannot list DNS records"
+	errNoIdenticalRecord = "cannot find the identical DNS record"
 )
 
 // Client is a Cloudflare API client that implements methods for working
 // with DNS Records.
 type Client interface {
-	CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
-	UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
-	GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
-	DeleteDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+	clients.DNSAPI
+	ListZonesContext(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error)
 }
 
 // NewClient returns a new Cloudflare API client for working with DNS Records.
@@ -53,6 +83,48 @@ func IsRecordNotFound(err error) bool {
 	return strings.Contains(err.Error(), errRecordNotFound)
 }
 
+// IsIdenticalRecord returns true if the passed error indicates a Record
+// could not be created because an identical one already exists.
+func IsIdenticalRecord(err error) bool {
+	return err != nil && strings.Contains(err.Error(), errRecordIdentical)
+}
+
+// FindIdentical returns the existing record of a zone that is identical to
+// the one described by the supplied parameters, i.e. that has the same
+// type, name and content or data.
+func FindIdentical(ctx context.Context, client Client, zoneID string, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	recs, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{
+		Type: params.Type,
+		Name: params.Name,
+	})
+	if err != nil {
+		return cloudflare.DNSRecord{}, errors.Wrap(err, errListRecords)
+	}
+
+	for _, r := range recs {
+		if !strings.EqualFold(r.Name, params.Name) {
+			continue
+		}
+		// Cloudflare derives the content of records described by
+		// structured data, so they are compared by that data.
+		if params.Data != nil {
+			if !dataUpToDate(toMap(params.Data), r.Data) {
+				continue
+			}
+		} else if r.Content != params.Content {
+			continue
+		}
+		return r, nil
+	}
+	return cloudflare.DNSRecord{}, errors.New(errNoIdenticalRecord)
+}
+
+// toMap returns the supplied record data as a map, or nil if it is not one.
+func toMap(data interface{}) map[string]interface{} {
+	m, _ := data.(map[string]interface{})
+	return m
+}
+
 // GenerateObservation creates an observation of a cloudflare Record.
 func GenerateObservation(in cloudflare.DNSRecord) v1alpha1.RecordObservation {
 	return v1alpha1.RecordObservation{
@@ -65,6 +137,99 @@ func GenerateObservation(in cloudflare.DNSRecord) v1alpha1.RecordObservation {
 	}
 }
 
+// ConnectionDetails returns the connection details of a record: its ID,
+// fully qualified name and the ID of its zone.
+func ConnectionDetails(in cloudflare.DNSRecord, zoneID string) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		ConnectionDetailID:     []byte(in.ID),
+		ConnectionDetailFQDN:   []byte(in.Name),
+		ConnectionDetailZoneID: []byte(zoneID),
+	}
+}
+
+// IsInternal returns true if the supplied parameters describe a record
+// that is only meant to be resolved by internal clients.
+func IsInternal(spec *v1alpha1.RecordParameters) bool {
+	return spec.Internal != nil && *spec.Internal
+}
+
+// Proxied returns whether a record described by the supplied parameters
+// should be proxied, or nil if they leave it to Cloudflare. Internal
+// records are never proxied.
+func Proxied(spec *v1alpha1.RecordParameters) *bool {
+	if IsInternal(spec) {
+		f := false
+		return &f
+	}
+	return spec.Proxied
+}
+
+// ValidateExposure returns an error if a record described by the supplied
+// parameters would expose an internal target through Cloudflare's proxy:
+// an internal record that is proxied, or an A or AAAA record proxying a
+// private address that is not explicitly allowed.
+func ValidateExposure(spec *v1alpha1.RecordParameters) error {
+	if spec.Proxied == nil || !*spec.Proxied {
+		return nil
+	}
+	if IsInternal(spec) {
+		return errors.New(errInternalProxied)
+	}
+	if spec.AllowProxiedPrivateTarget != nil && *spec.AllowProxiedPrivateTarget {
+		return nil
+	}
+	if spec.Type == nil || (*spec.Type != "A" && *spec.Type != "AAAA") {
+		return nil
+	}
+	if ip := net.ParseIP(spec.Content); ip != nil && ip.IsPrivate() {
+		return errors.Errorf(errProxiedPrivateFmt, spec.Content)
+	}
+	return nil
+}
+
+// IgnoresChanges returns true if the supplied parameters ignore changes
+// made outside of the provider to the named field.
+func IgnoresChanges(spec *v1alpha1.RecordParameters, field v1alpha1.RecordField) bool {
+	for _, f := range spec.IgnoreChanges {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// WithIgnoredChanges returns the supplied parameters with the fields whose
+// changes they ignore set to their values on the remote resource, so that
+// those fields are neither compared nor reverted. The supplied parameters
+// are not modified.
+func WithIgnoredChanges(spec *v1alpha1.RecordParameters, o cloudflare.DNSRecord) *v1alpha1.RecordParameters {
+	if spec == nil || len(spec.IgnoreChanges) == 0 {
+		return spec
+	}
+
+	p := spec.DeepCopy()
+	if IgnoresChanges(spec, v1alpha1.RecordFieldContent) {
+		p.Content = o.Content
+		p.LOC = nil
+		p.CERT = nil
+	}
+	if IgnoresChanges(spec, v1alpha1.RecordFieldTTL) {
+		ttl := int64(o.TTL)
+		p.TTL = &ttl
+	}
+	if IgnoresChanges(spec, v1alpha1.RecordFieldProxied) {
+		p.Proxied = o.Proxied
+	}
+	if IgnoresChanges(spec, v1alpha1.RecordFieldPriority) {
+		p.Priority = nil
+		if o.Priority != nil {
+			pri := int32(*o.Priority)
+			p.Priority = &pri
+		}
+	}
+	return p
+}
+
 // LateInitialize initializes RecordParameters based on the remote resource.
 func LateInitialize(spec *v1alpha1.RecordParameters, o cloudflare.DNSRecord) bool {
 	if spec == nil {
@@ -72,7 +237,8 @@ func LateInitialize(spec *v1alpha1.RecordParameters, o cloudflare.DNSRecord) boo
 	}
 
 	li := false
-	if spec.Proxied == nil && o.Proxied != nil {
+	// Internal records are never proxied, whatever Cloudflare reports.
+	if spec.Proxied == nil && o.Proxied != nil && !IsInternal(spec) {
 		spec.Proxied = o.Proxied
 		li = true
 	}
@@ -106,7 +272,17 @@ func UpToDate(spec *v1alpha1.RecordParameters, o cloudflare.DNSRecord) bool { //
 		return false
 	}
 
-	if spec.Content != o.Content {
+	// LOC and CERT records described by structured data are compared by
+	// that data, since Cloudflare derives their content from it.
+	data, err := Data(spec)
+	if err != nil {
+		return false
+	}
+	if data != nil {
+		if !dataUpToDate(data, o.Data) {
+			return false
+		}
+	} else if spec.Content != o.Content {
 		return false
 	}
 
@@ -114,7 +290,7 @@ func UpToDate(spec *v1alpha1.RecordParameters, o cloudflare.DNSRecord) bool { //
 		return false
 	}
 
-	if spec.Proxied != nil && o.Proxied != nil && *spec.Proxied != *o.Proxied {
+	if p := Proxied(spec); p != nil && o.Proxied != nil && *p != *o.Proxied {
 		return false
 	}
 
@@ -125,8 +301,9 @@ func UpToDate(spec *v1alpha1.RecordParameters, o cloudflare.DNSRecord) bool { //
 	return true
 }
 
-// UpdateRecord updates mutable values on a DNS Record.
-func UpdateRecord(ctx context.Context, client Client, zoneID, recordID string, spec *v1alpha1.RecordParameters) error {
+// UpdateRecord updates mutable values on a DNS Record. The tags and comment
+// of the record are replaced by the supplied metadata, if any.
+func UpdateRecord(ctx context.Context, client Client, zoneID, recordID string, spec *v1alpha1.RecordParameters, md *clients.Metadata) error {
 	rc := cloudflare.ZoneIdentifier(zoneID)
 
 	params := cloudflare.UpdateDNSRecordParams{
@@ -136,12 +313,21 @@ func UpdateRecord(ctx context.Context, client Client, zoneID, recordID string, s
 		Content: spec.Content,
 	}
 
+	data, err := Data(spec)
+	if err != nil {
+		return err
+	}
+	if data != nil {
+		params.Data = data
+		params.Content = ""
+	}
+
 	if spec.TTL != nil {
 		params.TTL = int(*spec.TTL)
 	}
 
-	if spec.Proxied != nil {
-		params.Proxied = spec.Proxied
+	if p := Proxied(spec); p != nil {
+		params.Proxied = p
 	}
 
 	if spec.Priority != nil {
@@ -149,6 +335,85 @@ func UpdateRecord(ctx context.Context, client Client, zoneID, recordID string, s
 		params.Priority = &priority
 	}
 
-	_, err := client.UpdateDNSRecord(ctx, rc, params)
+	if md != nil {
+		params.Tags = md.Tags
+		params.Comment = md.Comment
+	}
+
+	_, err = client.UpdateDNSRecord(ctx, rc, params)
 	return err
 }
+
+// Data returns the structured data of a LOC or CERT record described by the
+// supplied parameters, or nil if the record is described by its content.
+func Data(spec *v1alpha1.RecordParameters) (map[string]interface{}, error) {
+	switch {
+	case spec.LOC != nil:
+		return locData(spec.LOC)
+	case spec.CERT != nil:
+		return map[string]interface{}{
+			"type":        spec.CERT.Type,
+			"key_tag":     spec.CERT.KeyTag,
+			"algorithm":   spec.CERT.Algorithm,
+			"certificate": spec.CERT.Certificate,
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func locData(l *v1alpha1.LOCRecordData) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"lat_degrees":    l.LatDegrees,
+		"lat_minutes":    l.LatMinutes,
+		"lat_direction":  l.LatDirection,
+		"long_degrees":   l.LongDegrees,
+		"long_minutes":   l.LongMinutes,
+		"long_direction": l.LongDirection,
+	}
+	for key, v := range map[string]*string{
+		"lat_seconds":    l.LatSeconds,
+		"long_seconds":   l.LongSeconds,
+		"altitude":       l.Altitude,
+		"size":           l.Size,
+		"precision_horz": l.PrecisionHorz,
+		"precision_vert": l.PrecisionVert,
+	} {
+		if v == nil {
+			continue
+		}
+		f, err := strconv.ParseFloat(*v, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid LOC %s", key)
+		}
+		data[key] = f
+	}
+	return data, nil
+}
+
+// dataUpToDate returns true if the observed record data matches every field
+// of the desired data. Fields Cloudflare omits are treated as zero.
+func dataUpToDate(desired map[string]interface{}, observed interface{}) bool {
+	// Round trip the desired data through JSON so that it is represented
+	// the same way as the observed data.
+	b, err := json.Marshal(desired)
+	if err != nil {
+		return false
+	}
+	want := map[string]interface{}{}
+	if err := json.Unmarshal(b, &want); err != nil {
+		return false
+	}
+	got, _ := observed.(map[string]interface{})
+
+	for k, v := range want {
+		o, ok := got[k]
+		if !ok {
+			o = reflect.Zero(reflect.TypeOf(v)).Interface()
+		}
+		if !reflect.DeepEqual(v, o) {
+			return false
+		}
+	}
+	return true
+}
@@ -17,13 +17,19 @@ limitations under the License.
 package records
 
 import (
+	"context"
 	"testing"
 
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
 	"github.com/cloudflare/cloudflare-go"
 
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/records/fake"
 
 	"k8s.io/utils/ptr"
 )
@@ -161,6 +167,26 @@ func TestUpToDate(t *testing.T) {
 				o: false,
 			},
 		},
+		"UpToDateInternalProxied": {
+			reason: "UpToDate should return false if an internal record is proxied",
+			args: args{
+				rp: &v1alpha1.RecordParameters{
+					Type:     ptr.To("A"),
+					Name:     "foo",
+					Content:  "10.0.0.1",
+					Internal: ptr.To(true),
+				},
+				r: cloudflare.DNSRecord{
+					Type:    "A",
+					Name:    "foo",
+					Content: "10.0.0.1",
+					Proxied: ptr.To(true),
+				},
+			},
+			want: want{
+				o: false,
+			},
+		},
 		"UpToDateIdentical": {
 			reason: "UpToDate should return true if the spec matches the record",
 			args: args{
@@ -183,6 +209,73 @@ func TestUpToDate(t *testing.T) {
 				o: true,
 			},
 		},
+		"UpToDateLOCData": {
+			reason: "UpToDate should compare LOC records by their data rather than the derived content",
+			args: args{
+				rp: &v1alpha1.RecordParameters{
+					Type: ptr.To("LOC"),
+					Name: "foo",
+					LOC: &v1alpha1.LOCRecordData{
+						LatDegrees:    51,
+						LatMinutes:    30,
+						LatSeconds:    ptr.To("12.748"),
+						LatDirection:  "N",
+						LongDegrees:   0,
+						LongMinutes:   7,
+						LongSeconds:   ptr.To("39.611"),
+						LongDirection: "W",
+						Altitude:      ptr.To("11"),
+					},
+				},
+				r: cloudflare.DNSRecord{
+					Type:    "LOC",
+					Name:    "foo",
+					Content: "51 30 12.748 N 0 7 39.611 W 11.00m 0.00m 0.00m 0.00m",
+					Data: map[string]interface{}{
+						"lat_degrees":    float64(51),
+						"lat_minutes":    float64(30),
+						"lat_seconds":    12.748,
+						"lat_direction":  "N",
+						"long_minutes":   float64(7),
+						"long_seconds":   39.611,
+						"long_direction": "W",
+						"altitude":       float64(11),
+						"size":           float64(0),
+					},
+				},
+			},
+			want: want{
+				o: true,
+			},
+		},
+		"UpToDateLOCDataDifferent": {
+			reason: "UpToDate should return false if the LOC data does not match the record",
+			args: args{
+				rp: &v1alpha1.RecordParameters{
+					Type: ptr.To("LOC"),
+					Name: "foo",
+					LOC: &v1alpha1.LOCRecordData{
+						LatDegrees:    51,
+						LatDirection:  "N",
+						LongDegrees:   1,
+						LongDirection: "W",
+					},
+				},
+				r: cloudflare.DNSRecord{
+					Type: "LOC",
+					Name: "foo",
+					Data: map[string]interface{}{
+						"lat_degrees":    float64(51),
+						"lat_direction":  "N",
+						"long_degrees":   float64(0),
+						"long_direction": "W",
+					},
+				},
+			},
+			want: want{
+				o: false,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -194,3 +287,269 @@ func TestUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestWithIgnoredChanges(t *testing.T) {
+	loc := &v1alpha1.LOCRecordData{LatDegrees: 51}
+	observed := cloudflare.DNSRecord{
+		Content:  "192.0.2.2",
+		TTL:      300,
+		Proxied:  ptr.To(true),
+		Priority: ptr.To[uint16](20),
+	}
+
+	cases := map[string]struct {
+		reason string
+		spec   *v1alpha1.RecordParameters
+		want   *v1alpha1.RecordParameters
+	}{
+		"Nil": {
+			reason: "Nil parameters should be returned unchanged",
+		},
+		"NoneIgnored": {
+			reason: "Parameters that ignore no changes should be returned unchanged",
+			spec:   &v1alpha1.RecordParameters{Content: "192.0.2.1", TTL: ptr.To[int64](600)},
+			want:   &v1alpha1.RecordParameters{Content: "192.0.2.1", TTL: ptr.To[int64](600)},
+		},
+		"Content": {
+			reason: "Ignored content, and the data it is derived from, should take the observed content",
+			spec: &v1alpha1.RecordParameters{
+				Content:       "192.0.2.1",
+				LOC:           loc,
+				TTL:           ptr.To[int64](600),
+				IgnoreChanges: []v1alpha1.RecordField{v1alpha1.RecordFieldContent},
+			},
+			want: &v1alpha1.RecordParameters{
+				Content:       "192.0.2.2",
+				TTL:           ptr.To[int64](600),
+				IgnoreChanges: []v1alpha1.RecordField{v1alpha1.RecordFieldContent},
+			},
+		},
+		"All": {
+			reason: "Every ignored field should take its observed value",
+			spec: &v1alpha1.RecordParameters{
+				Content:  "192.0.2.1",
+				TTL:      ptr.To[int64](600),
+				Proxied:  ptr.To(false),
+				Priority: ptr.To[int32](10),
+				IgnoreChanges: []v1alpha1.RecordField{
+					v1alpha1.RecordFieldTTL,
+					v1alpha1.RecordFieldProxied,
+					v1alpha1.RecordFieldPriority,
+				},
+			},
+			want: &v1alpha1.RecordParameters{
+				Content:  "192.0.2.1",
+				TTL:      ptr.To[int64](300),
+				Proxied:  ptr.To(true),
+				Priority: ptr.To[int32](20),
+				IgnoreChanges: []v1alpha1.RecordField{
+					v1alpha1.RecordFieldTTL,
+					v1alpha1.RecordFieldProxied,
+					v1alpha1.RecordFieldPriority,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := tc.spec.DeepCopy()
+			got := WithIgnoredChanges(tc.spec, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nWithIgnoredChanges(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(in, tc.spec); diff != "" {
+				t.Errorf("\n%s\nWithIgnoredChanges(...): modified the supplied parameters: -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestData(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		spec   *v1alpha1.RecordParameters
+		want   map[string]interface{}
+		err    bool
+	}{
+		"Content": {
+			reason: "Records described by content should have no data",
+			spec:   &v1alpha1.RecordParameters{Type: ptr.To("A"), Content: "127.0.0.1"},
+		},
+		"LOC": {
+			reason: "LOC data should be converted to the Cloudflare representation",
+			spec: &v1alpha1.RecordParameters{
+				Type: ptr.To("LOC"),
+				LOC: &v1alpha1.LOCRecordData{
+					LatDegrees:    37,
+					LatMinutes:    46,
+					LatSeconds:    ptr.To("46"),
+					LatDirection:  "N",
+					LongDegrees:   122,
+					LongMinutes:   23,
+					LongSeconds:   ptr.To("35"),
+					LongDirection: "W",
+					Altitude:      ptr.To("-2.5"),
+					PrecisionHorz: ptr.To("10"),
+				},
+			},
+			want: map[string]interface{}{
+				"lat_degrees":    int32(37),
+				"lat_minutes":    int32(46),
+				"lat_seconds":    float64(46),
+				"lat_direction":  "N",
+				"long_degrees":   int32(122),
+				"long_minutes":   int32(23),
+				"long_seconds":   float64(35),
+				"long_direction": "W",
+				"altitude":       -2.5,
+				"precision_horz": float64(10),
+			},
+		},
+		"LOCInvalid": {
+			reason: "Invalid LOC decimals should return an error",
+			spec: &v1alpha1.RecordParameters{
+				Type: ptr.To("LOC"),
+				LOC:  &v1alpha1.LOCRecordData{LatDirection: "N", LongDirection: "E", Size: ptr.To("big")},
+			},
+			err: true,
+		},
+		"CERT": {
+			reason: "CERT data should be converted to the Cloudflare representation",
+			spec: &v1alpha1.RecordParameters{
+				Type: ptr.To("CERT"),
+				CERT: &v1alpha1.CERTRecordData{Type: 1, KeyTag: 12345, Algorithm: 8, Certificate: "MIIB"},
+			},
+			want: map[string]interface{}{
+				"type":        int32(1),
+				"key_tag":     int32(12345),
+				"algorithm":   int32(8),
+				"certificate": "MIIB",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Data(tc.spec)
+			if (err != nil) != tc.err {
+				t.Fatalf("\n%s\nData(...): want error %t, got %v", tc.reason, tc.err, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nData(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateExposure(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		spec   *v1alpha1.RecordParameters
+		want   error
+	}{
+		"NotProxied": {
+			reason: "Records that are not proxied should be valid whatever their target",
+			spec:   &v1alpha1.RecordParameters{Type: ptr.To("A"), Content: "10.0.0.1", Internal: ptr.To(true)},
+		},
+		"ProxiedPublic": {
+			reason: "Proxied records of public addresses should be valid",
+			spec:   &v1alpha1.RecordParameters{Type: ptr.To("A"), Content: "203.0.113.1", Proxied: ptr.To(true)},
+		},
+		"InternalProxied": {
+			reason: "Internal records should not be proxied",
+			spec:   &v1alpha1.RecordParameters{Type: ptr.To("CNAME"), Content: "app.internal", Proxied: ptr.To(true), Internal: ptr.To(true)},
+			want:   errors.New(errInternalProxied),
+		},
+		"ProxiedPrivateIPv4": {
+			reason: "Proxied records of RFC 1918 addresses should be rejected",
+			spec:   &v1alpha1.RecordParameters{Type: ptr.To("A"), Content: "192.168.1.10", Proxied: ptr.To(true)},
+			want:   errors.Errorf(errProxiedPrivateFmt, "192.168.1.10"),
+		},
+		"ProxiedPrivateIPv6": {
+			reason: "Proxied records of unique local IPv6 addresses should be rejected",
+			spec:   &v1alpha1.RecordParameters{Type: ptr.To("AAAA"), Content: "fd00::1", Proxied: ptr.To(true)},
+			want:   errors.Errorf(errProxiedPrivateFmt, "fd00::1"),
+		},
+		"ProxiedPrivateAllowed": {
+			reason: "Proxied records of private addresses should be valid when explicitly allowed",
+			spec:   &v1alpha1.RecordParameters{Type: ptr.To("A"), Content: "10.0.0.1", Proxied: ptr.To(true), AllowProxiedPrivateTarget: ptr.To(true)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateExposure(tc.spec)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateExposure(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFindIdentical(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	srv := map[string]interface{}{"priority": 10, "weight": 5, "port": 5060, "target": "sip.example.com"}
+
+	type want struct {
+		id  string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		params cloudflare.CreateDNSRecordParams
+		recs   []cloudflare.DNSRecord
+		err    error
+		want   want
+	}{
+		"Content": {
+			reason: "The record with the same name and content should be found",
+			params: cloudflare.CreateDNSRecordParams{Type: "A", Name: "www.example.com", Content: "192.0.2.1"},
+			recs: []cloudflare.DNSRecord{
+				{ID: "other", Type: "A", Name: "www.example.com", Content: "192.0.2.2"},
+				{ID: "identical", Type: "A", Name: "WWW.example.com", Content: "192.0.2.1"},
+			},
+			want: want{id: "identical"},
+		},
+		"Data": {
+			reason: "Records described by data should be compared by their data rather than their derived content",
+			params: cloudflare.CreateDNSRecordParams{Type: "SRV", Name: "_sip._udp.example.com", Data: srv},
+			recs: []cloudflare.DNSRecord{
+				{ID: "other", Type: "SRV", Name: "_sip._udp.example.com", Content: "5 5060 sip.example.com", Data: map[string]interface{}{"priority": 20.0, "weight": 5.0, "port": 5060.0, "target": "sip.example.com"}},
+				{ID: "identical", Type: "SRV", Name: "_sip._udp.example.com", Content: "5 5060 sip.example.com", Data: map[string]interface{}{"priority": 10.0, "weight": 5.0, "port": 5060.0, "target": "sip.example.com"}},
+			},
+			want: want{id: "identical"},
+		},
+		"NotFound": {
+			reason: "An error should be returned if no identical record exists",
+			params: cloudflare.CreateDNSRecordParams{Type: "A", Name: "www.example.com", Content: "192.0.2.1"},
+			recs:   []cloudflare.DNSRecord{{ID: "other", Type: "A", Name: "www.example.com", Content: "192.0.2.2"}},
+			want:   want{err: errors.New(errNoIdenticalRecord)},
+		},
+		"ListError": {
+			reason: "Errors listing records should be returned",
+			params: cloudflare.CreateDNSRecordParams{Type: "A", Name: "www.example.com", Content: "192.0.2.1"},
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errListRecords)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := fake.MockClient{
+				MockListDNSRecords: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+					return tc.recs, nil, tc.err
+				},
+			}
+			got, err := FindIdentical(context.Background(), client, "zone", tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nFindIdentical(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, got.ID); diff != "" {
+				t.Errorf("\n%s\nFindIdentical(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rossigee/provider-cloudflare/apis/registrar/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	errGetDomain    = "cannot get registrar domain"
+	errUpdateDomain = "cannot update registrar domain"
+	errParseDomain  = "cannot parse registrar domain"
+
+	errDomainNotFound = "registrar domain not found"
+)
+
+// Client is a Cloudflare API client that implements methods for working
+// with Registrar domains. The auto-renew, privacy and nameserver settings
+// are not modelled by cloudflare-go, so domains are read and written
+// through the raw API.
+type Client = clients.RawAPI
+
+// NewClient returns a new Cloudflare API client for working with Registrar
+// domains.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// Domain is a Registrar domain as returned by the API, including the
+// settings cloudflare-go does not model.
+type Domain struct {
+	cloudflare.RegistrarDomain
+	AutoRenew   bool     `json:"auto_renew"`
+	Privacy     bool     `json:"privacy"`
+	NameServers []string `json:"name_servers,omitempty"`
+}
+
+func domainEndpoint(accountID, domain string) string {
+	return fmt.Sprintf("/accounts/%s/registrar/domains/%s", accountID, domain)
+}
+
+// Get returns the Registrar domain with the supplied name.
+func Get(ctx context.Context, client Client, accountID, domain string) (Domain, error) {
+	res, err := client.Raw(ctx, http.MethodGet, domainEndpoint(accountID, domain), nil, nil)
+	if err != nil {
+		if IsDomainNotFound(err) {
+			return Domain{}, clients.NewNotFoundError(errDomainNotFound)
+		}
+		return Domain{}, errors.Wrap(err, errGetDomain)
+	}
+	d := Domain{}
+	return d, errors.Wrap(json.Unmarshal(res.Result, &d), errParseDomain)
+}
+
+// Update updates the supplied Registrar domain to match the parameters.
+// The API replaces every setting, so settings the parameters leave unset
+// are sent as currently observed.
+func Update(ctx context.Context, client Client, p v1alpha1.RegistrarDomainParameters, current Domain) error {
+	cfg := cloudflare.RegistrarDomainConfiguration{
+		NameServers: current.NameServers,
+		Privacy:     current.Privacy,
+		Locked:      current.Locked,
+		AutoRenew:   current.AutoRenew,
+	}
+	if p.NameServers != nil {
+		cfg.NameServers = p.NameServers
+	}
+	if p.Privacy != nil {
+		cfg.Privacy = *p.Privacy
+	}
+	if p.Locked != nil {
+		cfg.Locked = *p.Locked
+	}
+	if p.AutoRenew != nil {
+		cfg.AutoRenew = *p.AutoRenew
+	}
+	_, err := client.Raw(ctx, http.MethodPut, domainEndpoint(p.AccountID, p.Domain), cfg, nil)
+	return errors.Wrap(err, errUpdateDomain)
+}
+
+// IsDomainNotFound returns true if the supplied error indicates the
+// Registrar domain was not found.
+func IsDomainNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
+
+// GenerateObservation creates an observation of a Registrar domain.
+func GenerateObservation(in Domain) v1alpha1.RegistrarDomainObservation {
+	o := v1alpha1.RegistrarDomainObservation{
+		ID:               in.ID,
+		CurrentRegistrar: in.CurrentRegistrar,
+		RegistryStatuses: in.RegistryStatuses,
+		AutoRenew:        in.AutoRenew,
+		Locked:           in.Locked,
+		Privacy:          in.Privacy,
+		NameServers:      in.NameServers,
+	}
+	if !in.ExpiresAt.IsZero() {
+		o.ExpiresAt = &metav1.Time{Time: in.ExpiresAt}
+	}
+	if !in.CreatedAt.IsZero() {
+		o.CreatedAt = &metav1.Time{Time: in.CreatedAt}
+	}
+	if !in.UpdatedAt.IsZero() {
+		o.UpdatedAt = &metav1.Time{Time: in.UpdatedAt}
+	}
+	if c := in.RegistrantContact; c != (cloudflare.RegistrantContact{}) {
+		o.Registrant = &v1alpha1.RegistrantObservation{
+			Organization: c.Organization,
+			Email:        c.Email,
+			Country:      c.Country,
+		}
+	}
+	return o
+}
+
+// UpToDate checks whether the supplied domain matches the parameters.
+// Settings that are unset are left as they are.
+func UpToDate(p v1alpha1.RegistrarDomainParameters, d Domain) bool {
+	if p.AutoRenew != nil && *p.AutoRenew != d.AutoRenew {
+		return false
+	}
+	if p.Locked != nil && *p.Locked != d.Locked {
+		return false
+	}
+	if p.Privacy != nil && *p.Privacy != d.Privacy {
+		return false
+	}
+	return p.NameServers == nil || sameNameServers(p.NameServers, d.NameServers)
+}
+
+// sameNameServers compares nameservers regardless of order, case or a
+// trailing dot.
+func sameNameServers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x, y := normalize(a), normalize(b)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func normalize(ns []string) []string {
+	out := make([]string, len(ns))
+	for i, n := range ns {
+		out[i] = strings.TrimSuffix(strings.ToLower(n), ".")
+	}
+	sort.Strings(out)
+	return out
+}
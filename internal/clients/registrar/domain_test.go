@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrar
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/registrar/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/fake"
+)
+
+func TestGet(t *testing.T) {
+	errBoom := errors.New("boom")
+	expires := time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		domain Domain
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		raw    func(method, endpoint string) (cloudflare.RawResponse, error)
+		want   want
+	}{
+		"Success": {
+			reason: "The domain, including settings cloudflare-go does not model, should be parsed",
+			raw: func(method, endpoint string) (cloudflare.RawResponse, error) {
+				if method != http.MethodGet || endpoint != "/accounts/acc/registrar/domains/example.com" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected %s %s", method, endpoint)
+				}
+				return cloudflare.RawResponse{Result: []byte(`{"id":"example.com","locked":true,"expires_at":"2027-03-01T00:00:00Z","auto_renew":true,"privacy":true,"name_servers":["ns1.example.net"]}`)}, nil
+			},
+			want: want{domain: Domain{
+				RegistrarDomain: cloudflare.RegistrarDomain{ID: "example.com", Locked: true, ExpiresAt: expires},
+				AutoRenew:       true,
+				Privacy:         true,
+				NameServers:     []string{"ns1.example.net"},
+			}},
+		},
+		"NotFound": {
+			reason: "A domain that is not registered should be reported as not found",
+			raw: func(method, endpoint string) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, &cloudflare.NotFoundError{}
+			},
+			want: want{err: clients.NewNotFoundError(errDomainNotFound)},
+		},
+		"Error": {
+			reason: "Other errors should be wrapped",
+			raw: func(method, endpoint string) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, errBoom
+			},
+			want: want{err: errors.Wrap(errBoom, errGetDomain)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &fake.RawAPIMock{
+				RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					return tc.raw(method, endpoint)
+				},
+			}
+			got, err := Get(context.Background(), client, "acc", "example.com")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.domain, got); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	current := Domain{
+		RegistrarDomain: cloudflare.RegistrarDomain{Locked: true},
+		AutoRenew:       true,
+		Privacy:         true,
+		NameServers:     []string{"ns1.example.net", "ns2.example.net"},
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.RegistrarDomainParameters
+		want   string
+	}{
+		"KeepsUnset": {
+			reason: "Settings that are unset should be sent as currently observed",
+			params: v1alpha1.RegistrarDomainParameters{AccountID: "acc", Domain: "example.com", AutoRenew: ptr.To(false)},
+			want:   `{"name_servers":["ns1.example.net","ns2.example.net"],"privacy":true,"locked":true,"auto_renew":false}`,
+		},
+		"All": {
+			reason: "Every specified setting should be sent",
+			params: v1alpha1.RegistrarDomainParameters{
+				AccountID:   "acc",
+				Domain:      "example.com",
+				AutoRenew:   ptr.To(true),
+				Locked:      ptr.To(false),
+				Privacy:     ptr.To(false),
+				NameServers: []string{"ns.example.org"},
+			},
+			want: `{"name_servers":["ns.example.org"],"privacy":false,"locked":false,"auto_renew":true}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			client := &fake.RawAPIMock{
+				RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					if method != http.MethodPut || endpoint != "/accounts/acc/registrar/domains/example.com" {
+						return cloudflare.RawResponse{}, errors.Errorf("unexpected %s %s", method, endpoint)
+					}
+					b, err := json.Marshal(data)
+					got = string(b)
+					return cloudflare.RawResponse{}, err
+				},
+			}
+			if err := Update(context.Background(), client, tc.params, current); err != nil {
+				t.Fatalf("\n%s\nUpdate(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want body, +got body:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpToDate(t *testing.T) {
+	d := Domain{
+		RegistrarDomain: cloudflare.RegistrarDomain{Locked: true},
+		AutoRenew:       true,
+		NameServers:     []string{"NS2.example.net.", "ns1.example.net"},
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.RegistrarDomainParameters
+		want   bool
+	}{
+		"Unset": {
+			reason: "A domain should be up to date when no settings are specified",
+			want:   true,
+		},
+		"Matching": {
+			reason: "Nameservers should be compared regardless of order, case or a trailing dot",
+			params: v1alpha1.RegistrarDomainParameters{
+				AutoRenew:   ptr.To(true),
+				Locked:      ptr.To(true),
+				NameServers: []string{"ns1.example.net", "ns2.example.net"},
+			},
+			want: true,
+		},
+		"AutoRenew": {
+			reason: "A domain should be outdated when auto-renew differs",
+			params: v1alpha1.RegistrarDomainParameters{AutoRenew: ptr.To(false)},
+			want:   false,
+		},
+		"NameServers": {
+			reason: "A domain should be outdated when its nameservers differ",
+			params: v1alpha1.RegistrarDomainParameters{NameServers: []string{"ns1.example.net"}},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := UpToDate(tc.params, d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"golang.org/x/time/rate"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+// Defaults of cloudflare-go, which apply to request policies that leave
+// them unset.
+const (
+	defaultRetries       = 3
+	defaultMinRetryDelay = time.Second
+	defaultMaxRetryDelay = 30 * time.Second
+)
+
+// rateLimiters are shared by every client of a ProviderConfig, since a
+// client is created for each reconcile.
+var rateLimiters = struct {
+	sync.Mutex
+	m map[string]*fairLimiter
+}{m: map[string]*fairLimiter{}}
+
+// rateLimiterFor returns the rate limiter of the named ProviderConfig,
+// updating its limit if it changed.
+func rateLimiterFor(pc string, rps int) *fairLimiter {
+	rateLimiters.Lock()
+	defer rateLimiters.Unlock()
+	l, ok := rateLimiters.m[pc]
+	if !ok {
+		l = newFairLimiter(rps)
+		rateLimiters.m[pc] = l
+	}
+	if l.Limit() != rate.Limit(rps) {
+		l.SetLimit(rate.Limit(rps))
+	}
+	return l
+}
+
+// retryPolicy returns the retry policy of the supplied request policy,
+// filling in cloudflare-go's defaults.
+func retryPolicy(p *v1alpha1.RequestPolicy) cloudflare.RetryPolicy {
+	rp := cloudflare.RetryPolicy{
+		MaxRetries:    defaultRetries,
+		MinRetryDelay: defaultMinRetryDelay,
+		MaxRetryDelay: defaultMaxRetryDelay,
+	}
+	if p.Retries != nil {
+		rp.MaxRetries = *p.Retries
+	}
+	if b := p.RetryBackoff; b != nil {
+		if b.Min != nil {
+			rp.MinRetryDelay = wholeSeconds(b.Min.Duration)
+		}
+		if b.Max != nil {
+			rp.MaxRetryDelay = wholeSeconds(b.Max.Duration)
+		}
+	}
+	return rp
+}
+
+// wholeSeconds rounds the supplied delay up to whole seconds, the
+// resolution of cloudflare-go's retry policy, so that a sub-second delay
+// is not truncated to no delay at all.
+func wholeSeconds(d time.Duration) time.Duration {
+	if r := d % time.Second; r > 0 {
+		d += time.Second - r
+	}
+	return d
+}
+
+// requestPolicyOptions returns the cloudflare-go options of the request
+// policy of the supplied config.
+func requestPolicyOptions(c Config) []cloudflare.Option {
+	p := c.RequestPolicy
+	if p == nil {
+		return nil
+	}
+	rp := retryPolicy(p)
+	opts := []cloudflare.Option{
+		cloudflare.UsingRetryPolicy(rp.MaxRetries, int(rp.MinRetryDelay/time.Second), int(rp.MaxRetryDelay/time.Second)),
+	}
+	if p.RateLimit != nil {
+		// The limit is enforced across clients by the shared limiter, so
+		// that of each client must not be lower.
+		opts = append(opts, cloudflare.UsingRateLimit(float64(*p.RateLimit)))
+	}
+	return opts
+}
+
+// policyRoundTripper applies the call timeout and rate limit of a
+// ProviderConfig's request policy to each request. Requests wait for the
+// rate limit in turn with those of other controllers, as the controller of
+// their context.
+type policyRoundTripper struct {
+	timeout *time.Duration
+	limiter *fairLimiter
+	next    http.RoundTripper
+}
+
+func (p *policyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if p.limiter != nil {
+		if err := p.limiter.Wait(req.Context(), ControllerFromContext(req.Context())); err != nil {
+			return nil, err
+		}
+	}
+	if p.timeout != nil {
+		req = req.WithContext(metrics.ContextWithCallTimeout(req.Context(), *p.timeout))
+	}
+	return p.next.RoundTrip(req)
+}
+
+// applyRequestPolicy returns a copy of the supplied client that applies
+// the request policy of the supplied config, or the client itself if it has
+// none.
+func applyRequestPolicy(c Config, hc *http.Client) *http.Client {
+	p := c.RequestPolicy
+	if p == nil || (p.Timeout == nil && p.RateLimit == nil) {
+		return hc
+	}
+	next := hc.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	rt := &policyRoundTripper{next: next}
+	if p.Timeout != nil {
+		rt.timeout = &p.Timeout.Duration
+	}
+	if p.RateLimit != nil {
+		rt.limiter = rateLimiterFor(c.ProviderConfigName, *p.RateLimit)
+	}
+	phc := *hc
+	phc.Transport = rt
+	return &phc
+}
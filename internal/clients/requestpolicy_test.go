@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		policy *v1alpha1.RequestPolicy
+		want   cloudflare.RetryPolicy
+	}{
+		"Defaults": {
+			reason: "A policy that does not tune retries should use the defaults of cloudflare-go",
+			policy: &v1alpha1.RequestPolicy{},
+			want:   cloudflare.RetryPolicy{MaxRetries: 3, MinRetryDelay: time.Second, MaxRetryDelay: 30 * time.Second},
+		},
+		"Tuned": {
+			reason: "A policy's retries and backoff should be used",
+			policy: &v1alpha1.RequestPolicy{
+				Retries: ptr.To(0),
+				RetryBackoff: &v1alpha1.RetryBackoff{
+					Min: &metav1.Duration{Duration: 2 * time.Second},
+					Max: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			want: cloudflare.RetryPolicy{MaxRetries: 0, MinRetryDelay: 2 * time.Second, MaxRetryDelay: time.Minute},
+		},
+		"SubSecond": {
+			reason: "Delays should be rounded up to whole seconds rather than truncated to no delay",
+			policy: &v1alpha1.RequestPolicy{
+				RetryBackoff: &v1alpha1.RetryBackoff{
+					Min: &metav1.Duration{Duration: 500 * time.Millisecond},
+					Max: &metav1.Duration{Duration: 2500 * time.Millisecond},
+				},
+			},
+			want: cloudflare.RetryPolicy{MaxRetries: 3, MinRetryDelay: time.Second, MaxRetryDelay: 3 * time.Second},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, retryPolicy(tc.policy)); diff != "" {
+				t.Errorf("\n%s\nretryPolicy(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestApplyRequestPolicy(t *testing.T) {
+	hc := &http.Client{}
+
+	cases := map[string]struct {
+		reason  string
+		config  Config
+		want    *policyRoundTripper
+		wrapped bool
+	}{
+		"NoPolicy": {
+			reason: "Clients of ProviderConfigs without a request policy should not be wrapped",
+			config: Config{},
+		},
+		"RetriesOnly": {
+			reason: "Clients of request policies that only tune retries should not be wrapped",
+			config: Config{RequestPolicy: &v1alpha1.RequestPolicy{Retries: ptr.To(1)}},
+		},
+		"Timeout": {
+			reason:  "Clients of request policies that set a timeout should apply it",
+			config:  Config{RequestPolicy: &v1alpha1.RequestPolicy{Timeout: &metav1.Duration{Duration: time.Minute}}},
+			want:    &policyRoundTripper{timeout: ptr.To(time.Minute)},
+			wrapped: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := applyRequestPolicy(tc.config, hc)
+			if diff := cmp.Diff(tc.wrapped, got != hc); diff != "" {
+				t.Errorf("\n%s\napplyRequestPolicy(...): -want wrapped, +got wrapped:\n%s\n", tc.reason, diff)
+			}
+			if !tc.wrapped {
+				return
+			}
+			rt, _ := got.Transport.(*policyRoundTripper)
+			if diff := cmp.Diff(tc.want.timeout, rt.timeout); diff != "" {
+				t.Errorf("\n%s\napplyRequestPolicy(...): -want timeout, +got timeout:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRateLimiterFor(t *testing.T) {
+	a := rateLimiterFor("test-shared", 4)
+	b := rateLimiterFor("test-shared", 8)
+	if a != b {
+		t.Errorf("rateLimiterFor(...): clients of the same ProviderConfig should share a rate limiter")
+	}
+	if diff := cmp.Diff(float64(8), float64(b.Limit())); diff != "" {
+		t.Errorf("rateLimiterFor(...): a changed rate limit should be applied: -want, +got:\n%s\n", diff)
+	}
+	if rateLimiterFor("test-other", 4) == a {
+		t.Errorf("rateLimiterFor(...): clients of different ProviderConfigs should not share a rate limiter")
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloudflare/cloudflare-go"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1alpha1"
+)
+
+const (
+	// refPrefix prefixes the refs of rules contributed to a shared
+	// entrypoint by a Ruleset.
+	refPrefix = "crossplane:"
+
+	// DefaultPriority is the priority of Rulesets sharing an entrypoint
+	// that do not specify one.
+	DefaultPriority = 100
+)
+
+// entrypointLocks serializes changes to each shared entrypoint, so that
+// Rulesets reconciled concurrently do not overwrite each other's rules.
+var entrypointLocks sync.Map
+
+// LockEntrypoint locks the entrypoint ruleset the supplied parameters
+// contribute to, and returns a function that unlocks it.
+func LockEntrypoint(params v1alpha1.RulesetParameters) func() {
+	scope := ""
+	switch {
+	case params.Zone != nil:
+		scope = "zones/" + *params.Zone
+	case params.Account != nil:
+		scope = "accounts/" + *params.Account
+	}
+	mu, _ := entrypointLocks.LoadOrStore(scope+"/"+params.Phase, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// Priority returns the priority of the supplied parameters' rules in a
+// shared entrypoint.
+func Priority(params v1alpha1.RulesetParameters) int32 {
+	if params.SharedEntrypoint == nil || params.SharedEntrypoint.Priority == nil {
+		return DefaultPriority
+	}
+	return *params.SharedEntrypoint.Priority
+}
+
+// ownerRef is the ref of the i-th rule a Ruleset contributes to a shared
+// entrypoint.
+func ownerRef(owner string, priority int32, i int) string {
+	return fmt.Sprintf("%s%d:%s:%d", refPrefix, priority, owner, i)
+}
+
+// contribution identifies a rule contributed to a shared entrypoint.
+type contribution struct {
+	priority int
+	owner    string
+	index    int
+}
+
+// parseRef returns the contribution a rule's ref identifies, or false if
+// the rule is not managed by a Ruleset.
+func parseRef(ref string) (contribution, bool) {
+	if !strings.HasPrefix(ref, refPrefix) {
+		return contribution{}, false
+	}
+	parts := strings.Split(strings.TrimPrefix(ref, refPrefix), ":")
+	if len(parts) != 3 {
+		return contribution{}, false
+	}
+	priority, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return contribution{}, false
+	}
+	index, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return contribution{}, false
+	}
+	return contribution{priority: priority, owner: parts[1], index: index}, true
+}
+
+// DesiredRules returns the rules the supplied parameters contribute to a
+// shared entrypoint on behalf of the named owner.
+func DesiredRules(owner string, params v1alpha1.RulesetParameters) []cloudflare.RulesetRule {
+	rules := convertRulesToCloudflare(params.Rules)
+	for i := range rules {
+		rules[i].Ref = ownerRef(owner, Priority(params), i)
+	}
+	return rules
+}
+
+// Owned returns the rules of an entrypoint contributed by the named owner.
+func Owned(rules []cloudflare.RulesetRule, owner string) []cloudflare.RulesetRule {
+	var out []cloudflare.RulesetRule
+	for _, r := range rules {
+		if c, ok := parseRef(r.Ref); ok && c.owner == owner {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// Merge returns the rules of an entrypoint with those contributed by the
+// named owner replaced by the desired rules. Rules not managed by a Ruleset
+// keep their order and are evaluated first, followed by the contributed
+// rules ordered by priority, owner and position.
+func Merge(current []cloudflare.RulesetRule, owner string, desired []cloudflare.RulesetRule) []cloudflare.RulesetRule {
+	ids := map[string]string{}
+	var unmanaged, managed []cloudflare.RulesetRule
+	for _, r := range current {
+		c, ok := parseRef(r.Ref)
+		switch {
+		case !ok:
+			unmanaged = append(unmanaged, r)
+		case c.owner == owner:
+			ids[r.Ref] = r.ID
+		default:
+			managed = append(managed, r)
+		}
+	}
+
+	// Keep the IDs of rules whose refs are unchanged, so that Cloudflare
+	// updates them rather than replacing them.
+	for _, r := range desired {
+		if r.ID == "" {
+			r.ID = ids[r.Ref]
+		}
+		managed = append(managed, r)
+	}
+
+	sort.SliceStable(managed, func(i, j int) bool {
+		a, _ := parseRef(managed[i].Ref)
+		b, _ := parseRef(managed[j].Ref)
+		if a.priority != b.priority {
+			return a.priority < b.priority
+		}
+		if a.owner != b.owner {
+			return a.owner < b.owner
+		}
+		return a.index < b.index
+	})
+
+	return append(unmanaged, managed...)
+}
+
+// EntrypointUpToDate returns true if the rules of an entrypoint include
+// the desired rules of the named owner, in the order Merge would put them.
+func EntrypointUpToDate(current []cloudflare.RulesetRule, owner string, desired []cloudflare.RulesetRule) bool {
+	merged := Merge(current, owner, desired)
+	if len(merged) != len(current) {
+		return false
+	}
+	for i := range merged {
+		if merged[i].Ref != current[i].Ref || !ruleUpToDate(merged[i], current[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ruleUpToDate returns true if the observed rule matches the desired rule.
+func ruleUpToDate(desired, observed cloudflare.RulesetRule) bool {
+	enabled := func(r cloudflare.RulesetRule) bool { return r.Enabled == nil || *r.Enabled }
+	return desired.Action == observed.Action &&
+		desired.Expression == observed.Expression &&
+		desired.Description == observed.Description &&
+		enabled(desired) == enabled(observed)
+}
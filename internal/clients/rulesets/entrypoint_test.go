@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMerge(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		current []cloudflare.RulesetRule
+		desired []cloudflare.RulesetRule
+		want    []cloudflare.RulesetRule
+	}{
+		"Order": {
+			reason: "Unmanaged rules should come first, followed by contributed rules ordered by priority, owner and position",
+			current: []cloudflare.RulesetRule{
+				{ID: "c", Ref: "crossplane:100:team-c:0"},
+				{ID: "manual"},
+				{ID: "b", Ref: "crossplane:50:team-b:0"},
+			},
+			desired: []cloudflare.RulesetRule{
+				{Ref: "crossplane:100:team-a:0"},
+				{Ref: "crossplane:100:team-a:1"},
+			},
+			want: []cloudflare.RulesetRule{
+				{ID: "manual"},
+				{ID: "b", Ref: "crossplane:50:team-b:0"},
+				{Ref: "crossplane:100:team-a:0"},
+				{Ref: "crossplane:100:team-a:1"},
+				{ID: "c", Ref: "crossplane:100:team-c:0"},
+			},
+		},
+		"Replace": {
+			reason: "Rules previously contributed by the owner should be replaced, keeping the IDs of those with unchanged refs",
+			current: []cloudflare.RulesetRule{
+				{ID: "a0", Ref: "crossplane:100:team-a:0", Expression: "old"},
+				{ID: "a1", Ref: "crossplane:100:team-a:1"},
+			},
+			desired: []cloudflare.RulesetRule{
+				{Ref: "crossplane:100:team-a:0", Expression: "new"},
+			},
+			want: []cloudflare.RulesetRule{
+				{ID: "a0", Ref: "crossplane:100:team-a:0", Expression: "new"},
+			},
+		},
+		"Remove": {
+			reason: "Removing every rule of the owner should leave the rules of others",
+			current: []cloudflare.RulesetRule{
+				{ID: "a0", Ref: "crossplane:100:team-a:0"},
+				{ID: "manual"},
+			},
+			want: []cloudflare.RulesetRule{
+				{ID: "manual"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Merge(tc.current, "team-a", tc.desired)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nMerge(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
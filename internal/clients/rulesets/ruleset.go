@@ -32,6 +32,9 @@ const (
 	errGetRuleset    = "failed to get ruleset"
 	errUpdateRuleset = "failed to update ruleset"
 	errDeleteRuleset = "failed to delete ruleset"
+
+	errGetEntrypoint    = "failed to get entrypoint ruleset"
+	errUpdateEntrypoint = "failed to update entrypoint ruleset"
 )
 
 // Client interface for Cloudflare Ruleset operations
@@ -40,6 +43,8 @@ type Client interface {
 	GetRuleset(ctx context.Context, rulesetID string, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error)
 	UpdateRuleset(ctx context.Context, rulesetID string, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error)
 	DeleteRuleset(ctx context.Context, rulesetID string, params v1alpha1.RulesetParameters) error
+	GetEntrypoint(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error)
+	UpdateEntrypoint(ctx context.Context, params v1alpha1.RulesetParameters, rules []cloudflare.RulesetRule) (*cloudflare.Ruleset, error)
 }
 
 // NewClient creates a new Cloudflare Ruleset client
@@ -151,6 +156,54 @@ func (c *client) DeleteRuleset(ctx context.Context, rulesetID string, params v1a
 	return nil
 }
 
+// GetEntrypoint retrieves the entrypoint ruleset of a phase
+func (c *client) GetEntrypoint(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	var rc *cloudflare.ResourceContainer
+	if params.Zone != nil {
+		rc = cloudflare.ZoneIdentifier(*params.Zone)
+	} else if params.Account != nil {
+		rc = cloudflare.AccountIdentifier(*params.Account)
+	} else {
+		return nil, errors.New("either zone or account must be specified")
+	}
+
+	ruleset, err := c.api.GetEntrypointRuleset(ctx, rc, params.Phase)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetEntrypoint)
+	}
+
+	return &ruleset, nil
+}
+
+// UpdateEntrypoint replaces the rules of the entrypoint ruleset of a phase,
+// creating it if it does not exist
+func (c *client) UpdateEntrypoint(ctx context.Context, params v1alpha1.RulesetParameters, rules []cloudflare.RulesetRule) (*cloudflare.Ruleset, error) {
+	var rc *cloudflare.ResourceContainer
+	if params.Zone != nil {
+		rc = cloudflare.ZoneIdentifier(*params.Zone)
+	} else if params.Account != nil {
+		rc = cloudflare.AccountIdentifier(*params.Account)
+	} else {
+		return nil, errors.New("either zone or account must be specified")
+	}
+
+	// An empty list of rules must be sent rather than omitted to remove
+	// the last rule.
+	if rules == nil {
+		rules = []cloudflare.RulesetRule{}
+	}
+
+	ruleset, err := c.api.UpdateEntrypointRuleset(ctx, rc, cloudflare.UpdateEntrypointRulesetParams{
+		Phase: params.Phase,
+		Rules: rules,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errUpdateEntrypoint)
+	}
+
+	return &ruleset, nil
+}
+
 // IsRulesetNotFound checks if error indicates ruleset not found
 func IsRulesetNotFound(err error) bool {
 	if err == nil {
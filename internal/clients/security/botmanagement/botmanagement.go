@@ -18,6 +18,9 @@ package botmanagement
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -27,10 +30,22 @@ import (
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 )
 
-// BotManagementAPI defines the interface for Bot Management operations
+// BotManagementAPI defines the interface for Bot Management operations.
+// cloudflare-go does not model all Bot Management settings, such as
+// crawler protection, so they are read and written through raw requests.
 type BotManagementAPI interface {
-	GetBotManagement(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.BotManagement, error)
-	UpdateBotManagement(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateBotManagementParams) (cloudflare.BotManagement, error)
+	Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+}
+
+// settings are the Bot Management settings of a zone, including those
+// cloudflare-go does not model.
+type settings struct {
+	cloudflare.BotManagement
+	CrawlerProtection *string `json:"crawler_protection,omitempty"`
+}
+
+func endpoint(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/bot_management", zoneID)
 }
 
 // CloudflareBotManagementClient is a Cloudflare API client for Bot Management.
@@ -51,12 +66,7 @@ func NewClientFromAPI(api *cloudflare.API) *CloudflareBotManagementClient {
 
 // Get retrieves Bot Management configuration for a zone.
 func (c *CloudflareBotManagementClient) Get(ctx context.Context, zoneID string) (*v1alpha1.BotManagementObservation, error) {
-	rc := &cloudflare.ResourceContainer{
-		Identifier: zoneID,
-		Type:       cloudflare.ZoneType,
-	}
-
-	botManagement, err := c.client.GetBotManagement(ctx, rc)
+	res, err := c.client.Raw(ctx, http.MethodGet, endpoint(zoneID), nil, nil)
 	if err != nil {
 		if isNotFound(err) {
 			return nil, clients.NewNotFoundError("bot management configuration not found")
@@ -64,23 +74,28 @@ func (c *CloudflareBotManagementClient) Get(ctx context.Context, zoneID string)
 		return nil, errors.Wrap(err, "cannot get bot management configuration")
 	}
 
+	botManagement := settings{}
+	if err := json.Unmarshal(res.Result, &botManagement); err != nil {
+		return nil, errors.Wrap(err, "cannot parse bot management configuration")
+	}
+
 	return convertBotManagementToObservation(botManagement), nil
 }
 
 // Update updates Bot Management configuration for a zone.
 func (c *CloudflareBotManagementClient) Update(ctx context.Context, params v1alpha1.BotManagementParameters) (*v1alpha1.BotManagementObservation, error) {
-	rc := &cloudflare.ResourceContainer{
-		Identifier: params.Zone,
-		Type:       cloudflare.ZoneType,
-	}
-
 	updateParams := convertParametersToBotManagement(params)
 	
-	botManagement, err := c.client.UpdateBotManagement(ctx, rc, updateParams)
+	res, err := c.client.Raw(ctx, http.MethodPut, endpoint(params.Zone), updateParams, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot update bot management configuration")
 	}
 
+	botManagement := settings{}
+	if err := json.Unmarshal(res.Result, &botManagement); err != nil {
+		return nil, errors.Wrap(err, "cannot parse bot management configuration")
+	}
+
 	return convertBotManagementToObservation(botManagement), nil
 }
 
@@ -134,13 +149,19 @@ func (c *CloudflareBotManagementClient) IsUpToDate(ctx context.Context, params v
 		*params.AIBotsProtection != *obs.AIBotsProtection {
 		return false, nil
 	}
+
+	if params.CrawlerProtection != nil && obs.CrawlerProtection != nil &&
+		*params.CrawlerProtection != *obs.CrawlerProtection {
+		return false, nil
+	}
 	
 	return true, nil
 }
 
-// convertParametersToBotManagement converts BotManagementParameters to cloudflare.UpdateBotManagementParams.
-func convertParametersToBotManagement(params v1alpha1.BotManagementParameters) cloudflare.UpdateBotManagementParams {
-	updateParams := cloudflare.UpdateBotManagementParams{}
+// convertParametersToBotManagement converts BotManagementParameters to the
+// Bot Management settings to update.
+func convertParametersToBotManagement(params v1alpha1.BotManagementParameters) settings {
+	updateParams := settings{}
 	
 	if params.EnableJS != nil {
 		updateParams.EnableJS = params.EnableJS
@@ -181,12 +202,16 @@ func convertParametersToBotManagement(params v1alpha1.BotManagementParameters) c
 	if params.AIBotsProtection != nil {
 		updateParams.AIBotsProtection = params.AIBotsProtection
 	}
+
+	if params.CrawlerProtection != nil {
+		updateParams.CrawlerProtection = params.CrawlerProtection
+	}
 	
 	return updateParams
 }
 
-// convertBotManagementToObservation converts cloudflare.BotManagement to BotManagementObservation.
-func convertBotManagementToObservation(botManagement cloudflare.BotManagement) *v1alpha1.BotManagementObservation {
+// convertBotManagementToObservation converts Bot Management settings to BotManagementObservation.
+func convertBotManagementToObservation(botManagement settings) *v1alpha1.BotManagementObservation {
 	obs := &v1alpha1.BotManagementObservation{
 		EnableJS:                     botManagement.EnableJS,
 		FightMode:                    botManagement.FightMode,
@@ -199,6 +224,7 @@ func convertBotManagementToObservation(botManagement cloudflare.BotManagement) *
 		AutoUpdateModel:              botManagement.AutoUpdateModel,
 		UsingLatestModel:             botManagement.UsingLatestModel,
 		AIBotsProtection:             botManagement.AIBotsProtection,
+		CrawlerProtection:            botManagement.CrawlerProtection,
 	}
 	
 	return obs
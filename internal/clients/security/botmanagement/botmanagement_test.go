@@ -18,6 +18,9 @@ package botmanagement
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -31,10 +34,42 @@ import (
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 )
 
-// MockBotManagementAPI implements the BotManagementAPI interface for testing
+// MockBotManagementAPI implements the BotManagementAPI interface for testing.
+// Raw requests to the bot_management endpoint are served by
+// MockGetBotManagement and MockUpdateBotManagement unless MockRaw is set.
 type MockBotManagementAPI struct {
 	MockGetBotManagement    func(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.BotManagement, error)
 	MockUpdateBotManagement func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateBotManagementParams) (cloudflare.BotManagement, error)
+	MockRaw                 func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+}
+
+func (m *MockBotManagementAPI) Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if m.MockRaw != nil {
+		return m.MockRaw(ctx, method, endpoint, data, headers)
+	}
+
+	rc := cloudflare.ZoneIdentifier(strings.TrimSuffix(strings.TrimPrefix(endpoint, "/zones/"), "/bot_management"))
+	var bm cloudflare.BotManagement
+	var err error
+	switch method {
+	case http.MethodGet:
+		bm, err = m.GetBotManagement(ctx, rc)
+	case http.MethodPut:
+		params := cloudflare.UpdateBotManagementParams{}
+		b, merr := json.Marshal(data)
+		if merr != nil {
+			return cloudflare.RawResponse{}, merr
+		}
+		if merr := json.Unmarshal(b, &params); merr != nil {
+			return cloudflare.RawResponse{}, merr
+		}
+		bm, err = m.UpdateBotManagement(ctx, rc, params)
+	}
+	if err != nil {
+		return cloudflare.RawResponse{}, err
+	}
+	result, err := json.Marshal(bm)
+	return cloudflare.RawResponse{Result: result}, err
 }
 
 func (m *MockBotManagementAPI) GetBotManagement(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.BotManagement, error) {
@@ -75,6 +110,29 @@ func TestGet(t *testing.T) {
 		args   args
 		want   want
 	}{
+		"GetCrawlerProtection": {
+			reason: "Get should return settings cloudflare-go does not model, such as crawler protection",
+			fields: fields{
+				client: &MockBotManagementAPI{
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						if method != http.MethodGet || endpoint != "/zones/test-zone-id/bot_management" {
+							return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+						}
+						return cloudflare.RawResponse{Result: []byte(`{"ai_bots_protection":"only_on_ad_pages","crawler_protection":"enabled"}`)}, nil
+					},
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				zoneID: zoneID,
+			},
+			want: want{
+				obs: &v1alpha1.BotManagementObservation{
+					AIBotsProtection:  ptr.To("only_on_ad_pages"),
+					CrawlerProtection: ptr.To("enabled"),
+				},
+			},
+		},
 		"GetBotManagementSuccess": {
 			reason: "Get should return Bot Management settings when API call succeeds",
 			fields: fields{
@@ -226,6 +284,37 @@ func TestUpdate(t *testing.T) {
 		args   args
 		want   want
 	}{
+		"UpdateCrawlerProtection": {
+			reason: "Update should send settings cloudflare-go does not model, such as crawler protection",
+			fields: fields{
+				client: &MockBotManagementAPI{
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						if method != http.MethodPut || endpoint != "/zones/test-zone-id/bot_management" {
+							return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+						}
+						body, err := json.Marshal(data)
+						if err != nil {
+							return cloudflare.RawResponse{}, err
+						}
+						return cloudflare.RawResponse{Result: body}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.BotManagementParameters{
+					Zone:              zoneID,
+					AIBotsProtection:  ptr.To("block"),
+					CrawlerProtection: ptr.To("enabled"),
+				},
+			},
+			want: want{
+				obs: &v1alpha1.BotManagementObservation{
+					AIBotsProtection:  ptr.To("block"),
+					CrawlerProtection: ptr.To("enabled"),
+				},
+			},
+		},
 		"UpdateBotManagementSuccess": {
 			reason: "Update should update Bot Management when API call succeeds",
 			fields: fields{
@@ -388,6 +477,27 @@ func TestIsUpToDate(t *testing.T) {
 		args   args
 		want   want
 	}{
+		"CrawlerProtectionChanged": {
+			reason: "IsUpToDate should return false when crawler protection differs",
+			fields: fields{
+				client: &MockBotManagementAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.BotManagementParameters{
+					Zone:              zoneID,
+					AIBotsProtection:  ptr.To("block"),
+					CrawlerProtection: ptr.To("enabled"),
+				},
+				obs: v1alpha1.BotManagementObservation{
+					AIBotsProtection:  ptr.To("block"),
+					CrawlerProtection: ptr.To("disabled"),
+				},
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
 		"IsUpToDateTrue": {
 			reason: "IsUpToDate should return true when all settings match",
 			fields: fields{
@@ -538,7 +648,7 @@ func TestConvertParametersToBotManagement(t *testing.T) {
 	}
 
 	type want struct {
-		updateParams cloudflare.UpdateBotManagementParams
+		updateParams settings
 	}
 
 	cases := map[string]struct {
@@ -564,7 +674,7 @@ func TestConvertParametersToBotManagement(t *testing.T) {
 				},
 			},
 			want: want{
-				updateParams: cloudflare.UpdateBotManagementParams{
+				updateParams: settings{BotManagement: cloudflare.BotManagement{
 					EnableJS:                     ptr.To(true),
 					FightMode:                    ptr.To(true),
 					SBFMDefinitelyAutomated:      ptr.To("block"),
@@ -575,7 +685,7 @@ func TestConvertParametersToBotManagement(t *testing.T) {
 					SuppressSessionScore:         ptr.To(false),
 					AutoUpdateModel:              ptr.To(true),
 					AIBotsProtection:             ptr.To("allow"),
-				},
+				}},
 			},
 		},
 		"ConvertPartialParameters": {
@@ -588,10 +698,10 @@ func TestConvertParametersToBotManagement(t *testing.T) {
 				},
 			},
 			want: want{
-				updateParams: cloudflare.UpdateBotManagementParams{
+				updateParams: settings{BotManagement: cloudflare.BotManagement{
 					EnableJS: ptr.To(false),
 					// Other fields should be nil
-				},
+				}},
 			},
 		},
 		"ConvertEmptyParameters": {
@@ -603,7 +713,7 @@ func TestConvertParametersToBotManagement(t *testing.T) {
 				},
 			},
 			want: want{
-				updateParams: cloudflare.UpdateBotManagementParams{},
+				updateParams: settings{},
 			},
 		},
 	}
@@ -693,7 +803,7 @@ func TestConvertBotManagementToObservation(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := convertBotManagementToObservation(tc.args.botManagement)
+			got := convertBotManagementToObservation(settings{BotManagement: tc.args.botManagement})
 			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
 				t.Errorf("\n%s\nconvertBotManagementToObservation(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
@@ -0,0 +1,191 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securityheader
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/security/v1alpha1"
+)
+
+const (
+	// settingName is the zone setting holding the security headers.
+	settingName = "security_header"
+
+	keyStrictTransportSecurity = "strict_transport_security"
+	keyEnabled                 = "enabled"
+	keyMaxAge                  = "max_age"
+	keyIncludeSubdomains       = "include_subdomains"
+	keyPreload                 = "preload"
+	keyNoSniff                 = "nosniff"
+
+	errGetSecurityHeader    = "cannot get security header settings"
+	errUpdateSecurityHeader = "cannot update security header settings"
+)
+
+// SecurityHeaderAPI defines the interface for zone security header operations.
+type SecurityHeaderAPI interface {
+	GetZoneSetting(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.GetZoneSettingParams) (cloudflare.ZoneSetting, error)
+	UpdateZoneSetting(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateZoneSettingParams) (cloudflare.ZoneSetting, error)
+}
+
+// CloudflareSecurityHeaderClient is a Cloudflare API client for zone
+// security headers.
+type CloudflareSecurityHeaderClient struct {
+	client SecurityHeaderAPI
+}
+
+// NewClient creates a new CloudflareSecurityHeaderClient.
+func NewClient(client SecurityHeaderAPI) *CloudflareSecurityHeaderClient {
+	return &CloudflareSecurityHeaderClient{client: client}
+}
+
+// NewClientFromAPI creates a new CloudflareSecurityHeaderClient from a
+// Cloudflare API instance.
+func NewClientFromAPI(api *cloudflare.API) *CloudflareSecurityHeaderClient {
+	return NewClient(api)
+}
+
+// Get retrieves the security header settings of a zone.
+func (c *CloudflareSecurityHeaderClient) Get(ctx context.Context, zoneID string) (*v1alpha1.SecurityHeaderObservation, error) {
+	s, err := c.client.GetZoneSetting(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.GetZoneSettingParams{Name: settingName})
+	if err != nil {
+		return nil, errors.Wrap(err, errGetSecurityHeader)
+	}
+	return convertSettingToObservation(s), nil
+}
+
+// Update applies the security header settings of a zone. Settings that are
+// not specified keep their current value, as the API replaces the whole
+// strict transport security object.
+func (c *CloudflareSecurityHeaderClient) Update(ctx context.Context, params v1alpha1.SecurityHeaderParameters) (*v1alpha1.SecurityHeaderObservation, error) {
+	current, err := c.Get(ctx, params.Zone)
+	if err != nil {
+		return nil, err
+	}
+	return c.update(ctx, params.Zone, merge(params, *current))
+}
+
+// Reset disables HSTS on a zone, serving a max-age of zero so that browsers
+// which cached the policy expire it.
+func (c *CloudflareSecurityHeaderClient) Reset(ctx context.Context, zoneID string) error {
+	_, err := c.update(ctx, zoneID, v1alpha1.SecurityHeaderParameters{
+		Zone:              zoneID,
+		Enabled:           ptr.To(false),
+		MaxAge:            ptr.To[int64](0),
+		IncludeSubdomains: ptr.To(false),
+		Preload:           ptr.To(false),
+		NoSniff:           ptr.To(false),
+	})
+	return err
+}
+
+func (c *CloudflareSecurityHeaderClient) update(ctx context.Context, zoneID string, params v1alpha1.SecurityHeaderParameters) (*v1alpha1.SecurityHeaderObservation, error) {
+	s, err := c.client.UpdateZoneSetting(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.UpdateZoneSettingParams{
+		Name:  settingName,
+		Value: convertParametersToValue(params),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errUpdateSecurityHeader)
+	}
+	return convertSettingToObservation(s), nil
+}
+
+// IsUpToDate checks if the security header settings are up to date.
+func IsUpToDate(params v1alpha1.SecurityHeaderParameters, obs v1alpha1.SecurityHeaderObservation) bool {
+	return boolUpToDate(params.Enabled, obs.Enabled) &&
+		boolUpToDate(params.IncludeSubdomains, obs.IncludeSubdomains) &&
+		boolUpToDate(params.Preload, obs.Preload) &&
+		boolUpToDate(params.NoSniff, obs.NoSniff) &&
+		(params.MaxAge == nil || ptr.Deref(obs.MaxAge, 0) == *params.MaxAge)
+}
+
+// boolUpToDate treats an unobserved setting as false, which is how the API
+// omits disabled directives.
+func boolUpToDate(desired, observed *bool) bool {
+	return desired == nil || ptr.Deref(observed, false) == *desired
+}
+
+// merge fills the settings that are not specified in params from the
+// current settings.
+func merge(params v1alpha1.SecurityHeaderParameters, current v1alpha1.SecurityHeaderObservation) v1alpha1.SecurityHeaderParameters {
+	if params.Enabled == nil {
+		params.Enabled = current.Enabled
+	}
+	if params.MaxAge == nil {
+		params.MaxAge = current.MaxAge
+	}
+	if params.IncludeSubdomains == nil {
+		params.IncludeSubdomains = current.IncludeSubdomains
+	}
+	if params.Preload == nil {
+		params.Preload = current.Preload
+	}
+	if params.NoSniff == nil {
+		params.NoSniff = current.NoSniff
+	}
+	return params
+}
+
+// convertParametersToValue converts SecurityHeaderParameters to the value of
+// the security_header zone setting.
+func convertParametersToValue(params v1alpha1.SecurityHeaderParameters) map[string]interface{} {
+	sts := map[string]interface{}{
+		keyEnabled:           ptr.Deref(params.Enabled, false),
+		keyMaxAge:            ptr.Deref(params.MaxAge, 0),
+		keyIncludeSubdomains: ptr.Deref(params.IncludeSubdomains, false),
+		keyPreload:           ptr.Deref(params.Preload, false),
+		keyNoSniff:           ptr.Deref(params.NoSniff, false),
+	}
+	return map[string]interface{}{keyStrictTransportSecurity: sts}
+}
+
+// convertSettingToObservation converts the security_header zone setting to a
+// SecurityHeaderObservation.
+func convertSettingToObservation(s cloudflare.ZoneSetting) *v1alpha1.SecurityHeaderObservation {
+	obs := &v1alpha1.SecurityHeaderObservation{}
+	if s.ModifiedOn != "" {
+		obs.ModifiedOn = ptr.To(s.ModifiedOn)
+	}
+
+	value, _ := s.Value.(map[string]interface{})
+	sts, _ := value[keyStrictTransportSecurity].(map[string]interface{})
+	if sts == nil {
+		return obs
+	}
+
+	if v, ok := sts[keyEnabled].(bool); ok {
+		obs.Enabled = ptr.To(v)
+	}
+	if v, ok := sts[keyMaxAge].(float64); ok {
+		obs.MaxAge = ptr.To(int64(v))
+	}
+	if v, ok := sts[keyIncludeSubdomains].(bool); ok {
+		obs.IncludeSubdomains = ptr.To(v)
+	}
+	if v, ok := sts[keyPreload].(bool); ok {
+		obs.Preload = ptr.To(v)
+	}
+	if v, ok := sts[keyNoSniff].(bool); ok {
+		obs.NoSniff = ptr.To(v)
+	}
+	return obs
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securityheader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/security/v1alpha1"
+)
+
+// MockSecurityHeaderAPI implements the SecurityHeaderAPI interface for testing
+type MockSecurityHeaderAPI struct {
+	MockGetZoneSetting    func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.GetZoneSettingParams) (cloudflare.ZoneSetting, error)
+	MockUpdateZoneSetting func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateZoneSettingParams) (cloudflare.ZoneSetting, error)
+}
+
+func (m *MockSecurityHeaderAPI) GetZoneSetting(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.GetZoneSettingParams) (cloudflare.ZoneSetting, error) {
+	if m.MockGetZoneSetting != nil {
+		return m.MockGetZoneSetting(ctx, rc, params)
+	}
+	return cloudflare.ZoneSetting{}, nil
+}
+
+func (m *MockSecurityHeaderAPI) UpdateZoneSetting(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateZoneSettingParams) (cloudflare.ZoneSetting, error) {
+	if m.MockUpdateZoneSetting != nil {
+		return m.MockUpdateZoneSetting(ctx, rc, params)
+	}
+	return cloudflare.ZoneSetting{}, nil
+}
+
+// setting returns a security_header zone setting as decoded from the API.
+func setting(sts map[string]interface{}) cloudflare.ZoneSetting {
+	return cloudflare.ZoneSetting{
+		ID:         settingName,
+		ModifiedOn: "2025-01-01T00:00:00Z",
+		Value:      map[string]interface{}{keyStrictTransportSecurity: sts},
+	}
+}
+
+func TestGet(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs *v1alpha1.SecurityHeaderObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		get    func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.GetZoneSettingParams) (cloudflare.ZoneSetting, error)
+		want   want
+	}{
+		"Success": {
+			reason: "Get should convert the strict transport security settings of the zone",
+			get: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.GetZoneSettingParams) (cloudflare.ZoneSetting, error) {
+				if rc.Identifier != "zone" || params.Name != settingName {
+					return cloudflare.ZoneSetting{}, errors.New("unexpected setting")
+				}
+				return setting(map[string]interface{}{
+					keyEnabled:           true,
+					keyMaxAge:            float64(31536000),
+					keyIncludeSubdomains: true,
+					keyNoSniff:           true,
+				}), nil
+			},
+			want: want{
+				obs: &v1alpha1.SecurityHeaderObservation{
+					Enabled:           ptr.To(true),
+					MaxAge:            ptr.To[int64](31536000),
+					IncludeSubdomains: ptr.To(true),
+					NoSniff:           ptr.To(true),
+					ModifiedOn:        ptr.To("2025-01-01T00:00:00Z"),
+				},
+			},
+		},
+		"Error": {
+			reason: "Errors getting the setting should be returned",
+			get: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.GetZoneSettingParams) (cloudflare.ZoneSetting, error) {
+				return cloudflare.ZoneSetting{}, errBoom
+			},
+			want: want{err: errors.Wrap(errBoom, errGetSecurityHeader)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(&MockSecurityHeaderAPI{MockGetZoneSetting: tc.get})
+			got, err := c.Get(context.Background(), "zone")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	current := setting(map[string]interface{}{
+		keyEnabled:           true,
+		keyMaxAge:            float64(86400),
+		keyIncludeSubdomains: false,
+		keyPreload:           false,
+		keyNoSniff:           true,
+	})
+
+	type want struct {
+		value interface{}
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.SecurityHeaderParameters
+		update error
+		want   want
+	}{
+		"MergesCurrent": {
+			reason: "Settings that are not specified should keep their current value",
+			params: v1alpha1.SecurityHeaderParameters{
+				Zone:              "zone",
+				MaxAge:            ptr.To[int64](31536000),
+				IncludeSubdomains: ptr.To(true),
+				Preload:           ptr.To(true),
+			},
+			want: want{
+				value: map[string]interface{}{keyStrictTransportSecurity: map[string]interface{}{
+					keyEnabled:           true,
+					keyMaxAge:            int64(31536000),
+					keyIncludeSubdomains: true,
+					keyPreload:           true,
+					keyNoSniff:           true,
+				}},
+			},
+		},
+		"Error": {
+			reason: "Errors updating the setting should be returned",
+			params: v1alpha1.SecurityHeaderParameters{Zone: "zone", Enabled: ptr.To(false)},
+			update: errBoom,
+			want: want{
+				value: map[string]interface{}{keyStrictTransportSecurity: map[string]interface{}{
+					keyEnabled:           false,
+					keyMaxAge:            int64(86400),
+					keyIncludeSubdomains: false,
+					keyPreload:           false,
+					keyNoSniff:           true,
+				}},
+				err: errors.Wrap(errBoom, errUpdateSecurityHeader),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var value interface{}
+			c := NewClient(&MockSecurityHeaderAPI{
+				MockGetZoneSetting: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.GetZoneSettingParams) (cloudflare.ZoneSetting, error) {
+					return current, nil
+				},
+				MockUpdateZoneSetting: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateZoneSettingParams) (cloudflare.ZoneSetting, error) {
+					value = params.Value
+					return current, tc.update
+				},
+			})
+			_, err := c.Update(context.Background(), tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.value, value); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want value, +got value:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	obs := v1alpha1.SecurityHeaderObservation{
+		Enabled:           ptr.To(true),
+		MaxAge:            ptr.To[int64](31536000),
+		IncludeSubdomains: ptr.To(true),
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.SecurityHeaderParameters
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "Matching settings should be up to date",
+			params: v1alpha1.SecurityHeaderParameters{Enabled: ptr.To(true), MaxAge: ptr.To[int64](31536000), IncludeSubdomains: ptr.To(true)},
+			want:   true,
+		},
+		"Unspecified": {
+			reason: "Settings that are not specified should be ignored",
+			params: v1alpha1.SecurityHeaderParameters{Enabled: ptr.To(true)},
+			want:   true,
+		},
+		"OmittedFalse": {
+			reason: "Directives omitted by the API should be treated as disabled",
+			params: v1alpha1.SecurityHeaderParameters{Preload: ptr.To(false), NoSniff: ptr.To(false)},
+			want:   true,
+		},
+		"MaxAgeDiffers": {
+			reason: "A different max-age should not be up to date",
+			params: v1alpha1.SecurityHeaderParameters{MaxAge: ptr.To[int64](86400)},
+			want:   false,
+		},
+		"PreloadDiffers": {
+			reason: "Enabling preload should not be up to date",
+			params: v1alpha1.SecurityHeaderParameters{Preload: ptr.To(true)},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.params, obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
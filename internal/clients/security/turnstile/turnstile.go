@@ -18,11 +18,16 @@ package turnstile
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/rossigee/provider-cloudflare/apis/security/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
@@ -31,9 +36,54 @@ import (
 // TurnstileAPI defines the interface for Turnstile operations
 type TurnstileAPI interface {
 	CreateTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error)
-	GetTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) (cloudflare.TurnstileWidget, error)
 	UpdateTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error)
 	DeleteTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) error
+	ListTurnstileWidgets(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListTurnstileWidgetParams) ([]cloudflare.TurnstileWidget, *cloudflare.ResultInfo, error)
+	Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+}
+
+// turnstileWidget is a widget as returned by the widget endpoints,
+// including the settings cloudflare-go does not model.
+type turnstileWidget struct {
+	cloudflare.TurnstileWidget
+	ClearanceLevel string `json:"clearance_level,omitempty"`
+	EphemeralID    bool   `json:"ephemeral_id"`
+}
+
+// turnstileWidgetBody is the request body used to update a widget,
+// including the settings cloudflare-go does not model.
+type turnstileWidgetBody struct {
+	Name           string   `json:"name"`
+	Domains        []string `json:"domains"`
+	Mode           *string  `json:"mode,omitempty"`
+	BotFightMode   *bool    `json:"bot_fight_mode,omitempty"`
+	Region         *string  `json:"region,omitempty"`
+	OffLabel       *bool    `json:"offlabel,omitempty"`
+	ClearanceLevel *string  `json:"clearance_level,omitempty"`
+	EphemeralID    *bool    `json:"ephemeral_id,omitempty"`
+}
+
+// clearanceLevelNone is the clearance level of widgets that do not issue
+// pre-clearance.
+const clearanceLevelNone = "no_clearance"
+
+// modeInvisible is the mode of widgets that are not rendered in pages.
+const modeInvisible = "invisible"
+
+// regionWorld is the region of widgets served globally, which is the
+// region of widgets created without one.
+const regionWorld = "world"
+
+// region returns the supplied widget region, defaulting to world.
+func region(r *string) string {
+	if r == nil || *r == "" {
+		return regionWorld
+	}
+	return *r
+}
+
+func widgetEndpoint(accountID, siteKey string) string {
+	return fmt.Sprintf("/accounts/%s/challenges/widgets/%s", accountID, siteKey)
 }
 
 // CloudflareTurnstileClient is a Cloudflare API client for Turnstile widgets.
@@ -66,17 +116,25 @@ func (c *CloudflareTurnstileClient) Create(ctx context.Context, params v1alpha1.
 		return nil, errors.Wrap(err, "cannot create turnstile widget")
 	}
 
+	// The create endpoint wrapped by cloudflare-go cannot set the newer
+	// widget settings, so apply them straight away.
+	if hasExtendedSettings(params) {
+		obs, err := c.Update(ctx, widget.SiteKey, params)
+		if err != nil {
+			return nil, err
+		}
+		if obs.Secret == nil || *obs.Secret == "" {
+			obs.Secret = &widget.Secret
+		}
+		return obs, nil
+	}
+
 	return convertTurnstileToObservation(widget), nil
 }
 
 // Get retrieves a Turnstile widget by site key.
 func (c *CloudflareTurnstileClient) Get(ctx context.Context, accountID, siteKey string) (*v1alpha1.TurnstileObservation, error) {
-	rc := &cloudflare.ResourceContainer{
-		Identifier: accountID,
-		Type:       cloudflare.AccountType,
-	}
-
-	widget, err := c.client.GetTurnstileWidget(ctx, rc, siteKey)
+	res, err := c.client.Raw(ctx, http.MethodGet, widgetEndpoint(accountID, siteKey), nil, nil)
 	if err != nil {
 		if isNotFound(err) {
 			return nil, clients.NewNotFoundError("turnstile widget not found")
@@ -84,7 +142,12 @@ func (c *CloudflareTurnstileClient) Get(ctx context.Context, accountID, siteKey
 		return nil, errors.Wrap(err, "cannot get turnstile widget")
 	}
 
-	return convertTurnstileToObservation(widget), nil
+	var widget turnstileWidget
+	if err := json.Unmarshal(res.Result, &widget); err != nil {
+		return nil, errors.Wrap(err, "cannot parse turnstile widget")
+	}
+
+	return convertWidgetToObservation(widget), nil
 }
 
 // Update updates a Turnstile widget.
@@ -94,6 +157,11 @@ func (c *CloudflareTurnstileClient) Update(ctx context.Context, siteKey string,
 		Type:       cloudflare.AccountType,
 	}
 
+	// cloudflare-go cannot update the region of a widget either.
+	if hasExtendedSettings(params) || params.Region != nil {
+		return c.updateExtended(ctx, siteKey, params)
+	}
+
 	updateParams := convertParametersToUpdateTurnstile(siteKey, params)
 	
 	widget, err := c.client.UpdateTurnstileWidget(ctx, rc, updateParams)
@@ -104,6 +172,33 @@ func (c *CloudflareTurnstileClient) Update(ctx context.Context, siteKey string,
 	return convertTurnstileToObservation(widget), nil
 }
 
+// updateExtended updates a Turnstile widget through the raw API, so that
+// settings cloudflare-go does not model are included.
+func (c *CloudflareTurnstileClient) updateExtended(ctx context.Context, siteKey string, params v1alpha1.TurnstileParameters) (*v1alpha1.TurnstileObservation, error) {
+	body := turnstileWidgetBody{
+		Name:           params.Name,
+		Domains:        params.Domains,
+		Mode:           params.Mode,
+		BotFightMode:   params.BotFightMode,
+		Region:         params.Region,
+		OffLabel:       params.OffLabel,
+		ClearanceLevel: params.ClearanceLevel,
+		EphemeralID:    params.EphemeralID,
+	}
+
+	res, err := c.client.Raw(ctx, http.MethodPut, widgetEndpoint(params.AccountID, siteKey), body, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot update turnstile widget")
+	}
+
+	var widget turnstileWidget
+	if err := json.Unmarshal(res.Result, &widget); err != nil {
+		return nil, errors.Wrap(err, "cannot parse turnstile widget")
+	}
+
+	return convertWidgetToObservation(widget), nil
+}
+
 // Delete deletes a Turnstile widget.
 func (c *CloudflareTurnstileClient) Delete(ctx context.Context, accountID, siteKey string) error {
 	rc := &cloudflare.ResourceContainer{
@@ -122,6 +217,50 @@ func (c *CloudflareTurnstileClient) Delete(ctx context.Context, accountID, siteK
 	return nil
 }
 
+// List returns all Turnstile widgets in an account.
+func (c *CloudflareTurnstileClient) List(ctx context.Context, accountID string) ([]v1alpha1.TurnstileObservation, error) {
+	rc := &cloudflare.ResourceContainer{
+		Identifier: accountID,
+		Type:       cloudflare.AccountType,
+	}
+
+	widgets, _, err := c.client.ListTurnstileWidgets(ctx, rc, cloudflare.ListTurnstileWidgetParams{})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list turnstile widgets")
+	}
+
+	obs := make([]v1alpha1.TurnstileObservation, 0, len(widgets))
+	for _, w := range widgets {
+		obs = append(obs, *convertTurnstileToObservation(w))
+	}
+
+	return obs, nil
+}
+
+// FindExisting looks for a widget with the same name and domains as the
+// supplied parameters. It returns nil if there is none, and an error if
+// several widgets match since it cannot tell which one to adopt.
+func (c *CloudflareTurnstileClient) FindExisting(ctx context.Context, params v1alpha1.TurnstileParameters) (*v1alpha1.TurnstileObservation, error) {
+	widgets, err := c.List(ctx, params.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *v1alpha1.TurnstileObservation
+	for i := range widgets {
+		w := widgets[i]
+		if w.Name == nil || *w.Name != params.Name || !equalStringSlices(params.Domains, w.Domains) {
+			continue
+		}
+		if found != nil {
+			return nil, errors.Errorf("found multiple turnstile widgets named %q with the same domains", params.Name)
+		}
+		found = &w
+	}
+
+	return found, nil
+}
+
 // IsUpToDate checks if the Turnstile widget is up to date.
 func (c *CloudflareTurnstileClient) IsUpToDate(ctx context.Context, params v1alpha1.TurnstileParameters, obs v1alpha1.TurnstileObservation) (bool, error) {
 	// Compare configurable parameters
@@ -142,7 +281,8 @@ func (c *CloudflareTurnstileClient) IsUpToDate(ctx context.Context, params v1alp
 		return false, nil
 	}
 
-	if params.Region != nil && obs.Region != nil && *params.Region != *obs.Region {
+	// Widgets the API reports without a region are served globally.
+	if params.Region != nil && region(params.Region) != region(obs.Region) {
 		return false, nil
 	}
 
@@ -150,9 +290,48 @@ func (c *CloudflareTurnstileClient) IsUpToDate(ctx context.Context, params v1alp
 		return false, nil
 	}
 
+	if params.ClearanceLevel != nil && (obs.ClearanceLevel == nil || *params.ClearanceLevel != *obs.ClearanceLevel) {
+		return false, nil
+	}
+
+	if params.EphemeralID != nil && (obs.EphemeralID == nil || *params.EphemeralID != *obs.EphemeralID) {
+		return false, nil
+	}
+
 	return true, nil
 }
 
+// ModeTransitionAllowed returns true if the mode of a widget may be changed
+// from the supplied mode to the other. Widgets are rendered in pages in
+// managed and non-interactive mode, but not in invisible mode, so changes
+// to or from invisible require client integrations to change and are only
+// allowed when a migration is explicitly allowed.
+func ModeTransitionAllowed(from, to string, allowMigration *bool) bool {
+	if from == to || ptr.Deref(allowMigration, false) {
+		return true
+	}
+	return from != modeInvisible && to != modeInvisible
+}
+
+// RecordModeChange carries the mode change history of the previous
+// observation of a widget over to the current one, and records a change if
+// the mode differs between them.
+func RecordModeChange(prev, cur *v1alpha1.TurnstileObservation, now metav1.Time) {
+	cur.PreviousMode = prev.PreviousMode
+	cur.ModeChangedAt = prev.ModeChangedAt
+	if prev.Mode == nil || cur.Mode == nil || *prev.Mode == *cur.Mode {
+		return
+	}
+	cur.PreviousMode = ptr.To(*prev.Mode)
+	cur.ModeChangedAt = &now
+}
+
+// hasExtendedSettings returns true if the parameters set any widget
+// settings that cloudflare-go does not model.
+func hasExtendedSettings(params v1alpha1.TurnstileParameters) bool {
+	return params.ClearanceLevel != nil || params.EphemeralID != nil
+}
+
 // convertParametersToCreateTurnstile converts TurnstileParameters to cloudflare.CreateTurnstileWidgetParams.
 func convertParametersToCreateTurnstile(params v1alpha1.TurnstileParameters) cloudflare.CreateTurnstileWidgetParams {
 	createParams := cloudflare.CreateTurnstileWidgetParams{
@@ -227,12 +406,29 @@ func convertTurnstileToObservation(widget cloudflare.TurnstileWidget) *v1alpha1.
 	return obs
 }
 
+// convertWidgetToObservation converts a raw widget, including the settings
+// cloudflare-go does not model, to TurnstileObservation.
+func convertWidgetToObservation(widget turnstileWidget) *v1alpha1.TurnstileObservation {
+	obs := convertTurnstileToObservation(widget.TurnstileWidget)
+	obs.EphemeralID = &widget.EphemeralID
+	if widget.ClearanceLevel != "" {
+		obs.ClearanceLevel = &widget.ClearanceLevel
+	}
+	obs.PreClearance = ptr.To(widget.ClearanceLevel != "" && widget.ClearanceLevel != clearanceLevelNone)
+	return obs
+}
+
 // isNotFound checks if an error indicates that the turnstile widget was not found.
 func isNotFound(err error) bool {
 	if err == nil {
 		return false
 	}
 
+	var nf *cloudflare.NotFoundError
+	if errors.As(err, &nf) {
+		return true
+	}
+
 	errStr := strings.ToLower(err.Error())
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "resource not found") ||
@@ -240,22 +436,19 @@ func isNotFound(err error) bool {
 		strings.Contains(errStr, "does not exist")
 }
 
-// equalStringSlices compares two string slices for equality (order doesn't matter).
+// equalStringSlices compares two string slices as sets of domains, so
+// order, case and duplicates do not matter.
 func equalStringSlices(a, b []string) bool {
-	if len(a) != len(b) {
-		return false
-	}
-
-	aMap := make(map[string]bool)
-	for _, s := range a {
-		aMap[s] = true
-	}
+	return slices.Equal(normalizeDomains(a), normalizeDomains(b))
+}
 
-	for _, s := range b {
-		if !aMap[s] {
-			return false
-		}
+// normalizeDomains returns the supplied domains lower-cased, without a
+// trailing dot, sorted and without duplicates.
+func normalizeDomains(domains []string) []string {
+	out := make([]string, 0, len(domains))
+	for _, d := range domains {
+		out = append(out, strings.TrimSuffix(strings.ToLower(strings.TrimSpace(d)), "."))
 	}
-
-	return true
-}
\ No newline at end of file
+	slices.Sort(out)
+	return slices.Compact(out)
+}
@@ -18,6 +18,9 @@ package turnstile
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -36,9 +39,10 @@ import (
 // MockTurnstileAPI implements the TurnstileAPI interface for testing
 type MockTurnstileAPI struct {
 	MockCreateTurnstileWidget func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error)
-	MockGetTurnstileWidget    func(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) (cloudflare.TurnstileWidget, error)
 	MockUpdateTurnstileWidget func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error)
 	MockDeleteTurnstileWidget func(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) error
+	MockListTurnstileWidgets  func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListTurnstileWidgetParams) ([]cloudflare.TurnstileWidget, *cloudflare.ResultInfo, error)
+	MockRaw                   func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
 }
 
 func (m *MockTurnstileAPI) CreateTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error) {
@@ -48,13 +52,6 @@ func (m *MockTurnstileAPI) CreateTurnstileWidget(ctx context.Context, rc *cloudf
 	return cloudflare.TurnstileWidget{}, nil
 }
 
-func (m *MockTurnstileAPI) GetTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) (cloudflare.TurnstileWidget, error) {
-	if m.MockGetTurnstileWidget != nil {
-		return m.MockGetTurnstileWidget(ctx, rc, siteKey)
-	}
-	return cloudflare.TurnstileWidget{}, nil
-}
-
 func (m *MockTurnstileAPI) UpdateTurnstileWidget(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error) {
 	if m.MockUpdateTurnstileWidget != nil {
 		return m.MockUpdateTurnstileWidget(ctx, rc, params)
@@ -69,6 +66,20 @@ func (m *MockTurnstileAPI) DeleteTurnstileWidget(ctx context.Context, rc *cloudf
 	return nil
 }
 
+func (m *MockTurnstileAPI) ListTurnstileWidgets(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListTurnstileWidgetParams) ([]cloudflare.TurnstileWidget, *cloudflare.ResultInfo, error) {
+	if m.MockListTurnstileWidgets != nil {
+		return m.MockListTurnstileWidgets(ctx, rc, params)
+	}
+	return []cloudflare.TurnstileWidget{}, &cloudflare.ResultInfo{}, nil
+}
+
+func (m *MockTurnstileAPI) Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if m.MockRaw != nil {
+		return m.MockRaw(ctx, method, endpoint, data, headers)
+	}
+	return cloudflare.RawResponse{Result: []byte("{}")}, nil
+}
+
 func TestCreate(t *testing.T) {
 	errBoom := errors.New("boom")
 	accountID := "test-account-id"
@@ -263,30 +274,24 @@ func TestGet(t *testing.T) {
 			reason: "Get should return Turnstile widget when API call succeeds",
 			fields: fields{
 				client: &MockTurnstileAPI{
-					MockGetTurnstileWidget: func(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) (cloudflare.TurnstileWidget, error) {
-						if rc.Identifier != "test-account-id" {
-							return cloudflare.TurnstileWidget{}, errors.New("wrong account ID")
-						}
-						if rc.Type != cloudflare.AccountType {
-							return cloudflare.TurnstileWidget{}, errors.New("wrong resource type")
-						}
-						if siteKey != "0x4AAAAAAABnPIDROzyCUvwj" {
-							return cloudflare.TurnstileWidget{}, errors.New("wrong site key")
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						if method != http.MethodGet || endpoint != "/accounts/test-account-id/challenges/widgets/0x4AAAAAAABnPIDROzyCUvwj" {
+							return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
 						}
-						createdTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-						modifiedTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
-						return cloudflare.TurnstileWidget{
-							SiteKey:      siteKey,
-							Secret:       "0x4AAAAAAABnPIDROzyCUvwj_secret",
-							Name:         "Test Widget",
-							Domains:      []string{"example.com", "*.example.com"},
-							Mode:         "managed",
-							BotFightMode: false,
-							Region:       "world",
-							OffLabel:     false,
-							CreatedOn:    &createdTime,
-							ModifiedOn:   &modifiedTime,
-						}, nil
+						return cloudflare.RawResponse{Result: []byte(`{
+							"sitekey": "0x4AAAAAAABnPIDROzyCUvwj",
+							"secret": "0x4AAAAAAABnPIDROzyCUvwj_secret",
+							"name": "Test Widget",
+							"domains": ["example.com", "*.example.com"],
+							"mode": "managed",
+							"bot_fight_mode": false,
+							"region": "world",
+							"offlabel": false,
+							"clearance_level": "jschallenge",
+							"ephemeral_id": false,
+							"created_on": "2024-01-01T00:00:00Z",
+							"modified_on": "2024-06-01T12:00:00Z"
+						}`)}, nil
 					},
 				},
 			},
@@ -297,16 +302,19 @@ func TestGet(t *testing.T) {
 			},
 			want: want{
 				obs: &v1alpha1.TurnstileObservation{
-					SiteKey:      ptr.To("0x4AAAAAAABnPIDROzyCUvwj"),
-					Secret:       ptr.To("0x4AAAAAAABnPIDROzyCUvwj_secret"),
-					Name:         ptr.To("Test Widget"),
-					Domains:      []string{"example.com", "*.example.com"},
-					Mode:         ptr.To("managed"),
-					BotFightMode: ptr.To(false),
-					Region:       ptr.To("world"),
-					OffLabel:     ptr.To(false),
-					CreatedOn:    &metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
-					ModifiedOn:   &metav1.Time{Time: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)},
+					SiteKey:        ptr.To("0x4AAAAAAABnPIDROzyCUvwj"),
+					Secret:         ptr.To("0x4AAAAAAABnPIDROzyCUvwj_secret"),
+					Name:           ptr.To("Test Widget"),
+					Domains:        []string{"example.com", "*.example.com"},
+					Mode:           ptr.To("managed"),
+					BotFightMode:   ptr.To(false),
+					Region:         ptr.To("world"),
+					OffLabel:       ptr.To(false),
+					ClearanceLevel: ptr.To("jschallenge"),
+					PreClearance:   ptr.To(true),
+					EphemeralID:    ptr.To(false),
+					CreatedOn:      &metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+					ModifiedOn:     &metav1.Time{Time: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)},
 				},
 				err: nil,
 			},
@@ -315,8 +323,8 @@ func TestGet(t *testing.T) {
 			reason: "Get should return NotFoundError when Turnstile widget is not found",
 			fields: fields{
 				client: &MockTurnstileAPI{
-					MockGetTurnstileWidget: func(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) (cloudflare.TurnstileWidget, error) {
-						return cloudflare.TurnstileWidget{}, errors.New("widget not found")
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{}, errors.New("widget not found")
 					},
 				},
 			},
@@ -334,8 +342,8 @@ func TestGet(t *testing.T) {
 			reason: "Get should return wrapped error when API call fails",
 			fields: fields{
 				client: &MockTurnstileAPI{
-					MockGetTurnstileWidget: func(ctx context.Context, rc *cloudflare.ResourceContainer, siteKey string) (cloudflare.TurnstileWidget, error) {
-						return cloudflare.TurnstileWidget{}, errBoom
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{}, errBoom
 					},
 				},
 			},
@@ -474,6 +482,105 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, "cannot update turnstile widget"),
 			},
 		},
+		"UpdateTurnstileExtendedSettings": {
+			reason: "Update should send the clearance level and ephemeral ID through the raw API",
+			fields: fields{
+				client: &MockTurnstileAPI{
+					MockUpdateTurnstileWidget: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error) {
+						return cloudflare.TurnstileWidget{}, errors.New("unexpected typed update")
+					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						if method != http.MethodPut || endpoint != "/accounts/test-account-id/challenges/widgets/0x4AAAAAAABnPIDROzyCUvwj" {
+							return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+						}
+						body, err := json.Marshal(data)
+						if err != nil {
+							return cloudflare.RawResponse{}, err
+						}
+						want := `{"name":"Updated Widget","domains":["example.com"],"mode":"managed","clearance_level":"managed","ephemeral_id":true}`
+						if string(body) != want {
+							return cloudflare.RawResponse{}, errors.Errorf("unexpected body %s", body)
+						}
+						return cloudflare.RawResponse{Result: []byte(`{"sitekey":"0x4AAAAAAABnPIDROzyCUvwj","name":"Updated Widget","domains":["example.com"],"mode":"managed","clearance_level":"managed","ephemeral_id":true}`)}, nil
+					},
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				siteKey: siteKey,
+				params: v1alpha1.TurnstileParameters{
+					AccountID:      accountID,
+					Name:           "Updated Widget",
+					Domains:        []string{"example.com"},
+					Mode:           ptr.To("managed"),
+					ClearanceLevel: ptr.To("managed"),
+					EphemeralID:    ptr.To(true),
+				},
+			},
+			want: want{
+				obs: &v1alpha1.TurnstileObservation{
+					SiteKey:        ptr.To("0x4AAAAAAABnPIDROzyCUvwj"),
+					Secret:         ptr.To(""),
+					Name:           ptr.To("Updated Widget"),
+					Domains:        []string{"example.com"},
+					Mode:           ptr.To("managed"),
+					BotFightMode:   ptr.To(false),
+					Region:         ptr.To(""),
+					OffLabel:       ptr.To(false),
+					ClearanceLevel: ptr.To("managed"),
+					PreClearance:   ptr.To(true),
+					EphemeralID:    ptr.To(true),
+				},
+				err: nil,
+			},
+		},
+		"UpdateTurnstileRegion": {
+			reason: "Update should send the region through the raw API, since cloudflare-go cannot update it",
+			fields: fields{
+				client: &MockTurnstileAPI{
+					MockUpdateTurnstileWidget: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateTurnstileWidgetParams) (cloudflare.TurnstileWidget, error) {
+						return cloudflare.TurnstileWidget{}, errors.New("unexpected typed update")
+					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						body, err := json.Marshal(data)
+						if err != nil {
+							return cloudflare.RawResponse{}, err
+						}
+						want := `{"name":"Updated Widget","domains":["example.com"],"region":"china"}`
+						if string(body) != want {
+							return cloudflare.RawResponse{}, errors.Errorf("unexpected body %s", body)
+						}
+						return cloudflare.RawResponse{Result: []byte(`{"sitekey":"0x4AAAAAAABnPIDROzyCUvwj","name":"Updated Widget","domains":["example.com"],"region":"china","clearance_level":"no_clearance"}`)}, nil
+					},
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				siteKey: siteKey,
+				params: v1alpha1.TurnstileParameters{
+					AccountID: accountID,
+					Name:      "Updated Widget",
+					Domains:   []string{"example.com"},
+					Region:    ptr.To("china"),
+				},
+			},
+			want: want{
+				obs: &v1alpha1.TurnstileObservation{
+					SiteKey:        ptr.To("0x4AAAAAAABnPIDROzyCUvwj"),
+					Secret:         ptr.To(""),
+					Name:           ptr.To("Updated Widget"),
+					Domains:        []string{"example.com"},
+					Mode:           ptr.To(""),
+					BotFightMode:   ptr.To(false),
+					Region:         ptr.To("china"),
+					OffLabel:       ptr.To(false),
+					ClearanceLevel: ptr.To("no_clearance"),
+					PreClearance:   ptr.To(false),
+					EphemeralID:    ptr.To(false),
+				},
+				err: nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -593,6 +700,100 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestFindExisting(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.TurnstileParameters{
+		AccountID: "test-account-id",
+		Name:      "login",
+		Domains:   []string{"example.com", "www.example.com"},
+	}
+
+	type fields struct {
+		client *MockTurnstileAPI
+	}
+
+	type want struct {
+		siteKey *string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		want   want
+	}{
+		"Found": {
+			reason: "FindExisting should return the widget with the same name and domains, regardless of domain order",
+			fields: fields{
+				client: &MockTurnstileAPI{
+					MockListTurnstileWidgets: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListTurnstileWidgetParams) ([]cloudflare.TurnstileWidget, *cloudflare.ResultInfo, error) {
+						if rc.Identifier != "test-account-id" {
+							return nil, nil, errors.New("wrong account ID")
+						}
+						return []cloudflare.TurnstileWidget{
+							{SiteKey: "other-name", Name: "signup", Domains: []string{"example.com", "www.example.com"}},
+							{SiteKey: "other-domains", Name: "login", Domains: []string{"example.com"}},
+							{SiteKey: "match", Name: "login", Domains: []string{"www.example.com", "example.com"}},
+						}, &cloudflare.ResultInfo{}, nil
+					},
+				},
+			},
+			want: want{siteKey: ptr.To("match")},
+		},
+		"NotFound": {
+			reason: "FindExisting should return nil when no widget matches",
+			fields: fields{
+				client: &MockTurnstileAPI{},
+			},
+			want: want{},
+		},
+		"Ambiguous": {
+			reason: "FindExisting should refuse to pick between several matching widgets",
+			fields: fields{
+				client: &MockTurnstileAPI{
+					MockListTurnstileWidgets: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListTurnstileWidgetParams) ([]cloudflare.TurnstileWidget, *cloudflare.ResultInfo, error) {
+						return []cloudflare.TurnstileWidget{
+							{SiteKey: "a", Name: "login", Domains: []string{"example.com", "www.example.com"}},
+							{SiteKey: "b", Name: "login", Domains: []string{"example.com", "www.example.com"}},
+						}, &cloudflare.ResultInfo{}, nil
+					},
+				},
+			},
+			want: want{err: errors.New(`found multiple turnstile widgets named "login" with the same domains`)},
+		},
+		"ListError": {
+			reason: "FindExisting should return wrapped error when listing fails",
+			fields: fields{
+				client: &MockTurnstileAPI{
+					MockListTurnstileWidgets: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListTurnstileWidgetParams) ([]cloudflare.TurnstileWidget, *cloudflare.ResultInfo, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{err: errors.Wrap(errBoom, "cannot list turnstile widgets")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := NewClient(tc.fields.client)
+			obs, err := client.FindExisting(context.Background(), params)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nFindExisting(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			var siteKey *string
+			if obs != nil {
+				siteKey = obs.SiteKey
+			}
+			if diff := cmp.Diff(tc.want.siteKey, siteKey); diff != "" {
+				t.Errorf("\n%s\nFindExisting(...): -want site key, +got site key:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestIsUpToDate(t *testing.T) {
 	accountID := "test-account-id"
 
@@ -647,6 +848,102 @@ func TestIsUpToDate(t *testing.T) {
 				err:      nil,
 			},
 		},
+		"IsUpToDateWorldRegionUnreported": {
+			reason: "IsUpToDate should treat a widget reported without a region as served from the world region",
+			fields: fields{
+				client: &MockTurnstileAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.TurnstileParameters{
+					AccountID: accountID,
+					Name:      "Test Widget",
+					Domains:   []string{"example.com"},
+					Region:    ptr.To("world"),
+				},
+				obs: v1alpha1.TurnstileObservation{
+					Name:    ptr.To("Test Widget"),
+					Domains: []string{"example.com"},
+					Region:  ptr.To(""),
+				},
+			},
+			want: want{
+				upToDate: true,
+				err:      nil,
+			},
+		},
+		"IsUpToDateFalseRegion": {
+			reason: "IsUpToDate should return false when the widget is served from another region",
+			fields: fields{
+				client: &MockTurnstileAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.TurnstileParameters{
+					AccountID: accountID,
+					Name:      "Test Widget",
+					Domains:   []string{"example.com"},
+					Region:    ptr.To("china"),
+				},
+				obs: v1alpha1.TurnstileObservation{
+					Name:    ptr.To("Test Widget"),
+					Domains: []string{"example.com"},
+					Region:  ptr.To(""),
+				},
+			},
+			want: want{
+				upToDate: false,
+				err:      nil,
+			},
+		},
+		"IsUpToDateFalseClearanceLevel": {
+			reason: "IsUpToDate should return false when the clearance level has drifted",
+			fields: fields{
+				client: &MockTurnstileAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.TurnstileParameters{
+					AccountID:      accountID,
+					Name:           "Test Widget",
+					Domains:        []string{"example.com"},
+					ClearanceLevel: ptr.To("interactive"),
+				},
+				obs: v1alpha1.TurnstileObservation{
+					Name:           ptr.To("Test Widget"),
+					Domains:        []string{"example.com"},
+					ClearanceLevel: ptr.To("no_clearance"),
+				},
+			},
+			want: want{
+				upToDate: false,
+				err:      nil,
+			},
+		},
+		"IsUpToDateFalseEphemeralID": {
+			reason: "IsUpToDate should return false when ephemeral IDs have drifted",
+			fields: fields{
+				client: &MockTurnstileAPI{},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: v1alpha1.TurnstileParameters{
+					AccountID:   accountID,
+					Name:        "Test Widget",
+					Domains:     []string{"example.com"},
+					EphemeralID: ptr.To(true),
+				},
+				obs: v1alpha1.TurnstileObservation{
+					Name:        ptr.To("Test Widget"),
+					Domains:     []string{"example.com"},
+					EphemeralID: ptr.To(false),
+				},
+			},
+			want: want{
+				upToDate: false,
+				err:      nil,
+			},
+		},
 		"IsUpToDateFalseName": {
 			reason: "IsUpToDate should return false when name doesn't match",
 			fields: fields{
@@ -756,6 +1053,20 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+// manyDomains returns n distinct domains, in reverse order if reverse is
+// true.
+func manyDomains(n int, reverse bool) []string {
+	out := make([]string, n)
+	for i := range out {
+		j := i
+		if reverse {
+			j = n - 1 - i
+		}
+		out[j] = fmt.Sprintf("site%d.example.com", i)
+	}
+	return out
+}
+
 func TestEqualStringSlices(t *testing.T) {
 	type args struct {
 		a []string
@@ -821,6 +1132,36 @@ func TestEqualStringSlices(t *testing.T) {
 				equal: false,
 			},
 		},
+		"NotEqualWithDuplicates": {
+			reason: "equalStringSlices should not treat a repeated element as standing in for a missing one",
+			args: args{
+				a: []string{"a", "b"},
+				b: []string{"a", "a"},
+			},
+			want: want{
+				equal: false,
+			},
+		},
+		"EqualIgnoringCaseAndTrailingDot": {
+			reason: "equalStringSlices should compare domains regardless of case and trailing dots",
+			args: args{
+				a: []string{"Example.COM", "www.example.com."},
+				b: []string{"www.example.com", "example.com"},
+			},
+			want: want{
+				equal: true,
+			},
+		},
+		"EqualManyDomains": {
+			reason: "equalStringSlices should compare long lists of domains as sets",
+			args: args{
+				a: manyDomains(150, false),
+				b: manyDomains(150, true),
+			},
+			want: want{
+				equal: true,
+			},
+		},
 		"EqualWithDuplicates": {
 			reason: "equalStringSlices should handle duplicates correctly",
 			args: args{
@@ -930,4 +1271,102 @@ func TestIsNotFound(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestModeTransitionAllowed(t *testing.T) {
+	type args struct {
+		from           string
+		to             string
+		allowMigration *bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"Unchanged": {
+			reason: "Keeping the mode of a widget should always be allowed",
+			args:   args{from: "invisible", to: "invisible"},
+			want:   true,
+		},
+		"VisibleModes": {
+			reason: "Changing between managed and non-interactive mode should be allowed",
+			args:   args{from: "managed", to: "non-interactive"},
+			want:   true,
+		},
+		"ToInvisible": {
+			reason: "Changing to invisible mode should not be allowed unless a migration is allowed",
+			args:   args{from: "managed", to: "invisible"},
+			want:   false,
+		},
+		"FromInvisible": {
+			reason: "Changing from invisible mode should not be allowed unless a migration is allowed",
+			args:   args{from: "invisible", to: "non-interactive", allowMigration: ptr.To(false)},
+			want:   false,
+		},
+		"MigrationAllowed": {
+			reason: "Changing to invisible mode should be allowed when a migration is allowed",
+			args:   args{from: "managed", to: "invisible", allowMigration: ptr.To(true)},
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ModeTransitionAllowed(tc.args.from, tc.args.to, tc.args.allowMigration)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nModeTransitionAllowed(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRecordModeChange(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC))
+	earlier := metav1.NewTime(time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC))
+
+	type args struct {
+		prev *v1alpha1.TurnstileObservation
+		cur  *v1alpha1.TurnstileObservation
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   *v1alpha1.TurnstileObservation
+	}{
+		"FirstObservation": {
+			reason: "The first observation of a widget should not record a mode change",
+			args: args{
+				prev: &v1alpha1.TurnstileObservation{},
+				cur:  &v1alpha1.TurnstileObservation{Mode: ptr.To("managed")},
+			},
+			want: &v1alpha1.TurnstileObservation{Mode: ptr.To("managed")},
+		},
+		"Unchanged": {
+			reason: "The last mode change should be kept while the mode is unchanged",
+			args: args{
+				prev: &v1alpha1.TurnstileObservation{Mode: ptr.To("managed"), PreviousMode: ptr.To("invisible"), ModeChangedAt: &earlier},
+				cur:  &v1alpha1.TurnstileObservation{Mode: ptr.To("managed")},
+			},
+			want: &v1alpha1.TurnstileObservation{Mode: ptr.To("managed"), PreviousMode: ptr.To("invisible"), ModeChangedAt: &earlier},
+		},
+		"Changed": {
+			reason: "A changed mode should be recorded with the time it was observed",
+			args: args{
+				prev: &v1alpha1.TurnstileObservation{Mode: ptr.To("managed"), PreviousMode: ptr.To("invisible"), ModeChangedAt: &earlier},
+				cur:  &v1alpha1.TurnstileObservation{Mode: ptr.To("non-interactive")},
+			},
+			want: &v1alpha1.TurnstileObservation{Mode: ptr.To("non-interactive"), PreviousMode: ptr.To("managed"), ModeChangedAt: &now},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			RecordModeChange(tc.args.prev, tc.args.cur, now)
+			if diff := cmp.Diff(tc.want, tc.args.cur); diff != "" {
+				t.Errorf("\n%s\nRecordModeChange(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -23,11 +23,23 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1alpha1"
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/notifications"
 )
 
+// statusActive is the status of an issued Certificate Pack.
+const statusActive = "active"
+
+// AlertTypeAdvancedCertificate is the notification alert type of events of
+// the advanced certificates of a zone.
+const AlertTypeAdvancedCertificate = "dedicated_ssl_certificate_event_type"
+
 // CertificatePackAPI defines the interface for Certificate Pack operations
 type CertificatePackAPI interface {
 	CertificatePack(ctx context.Context, zoneID, certificatePackID string) (cloudflare.CertificatePack, error)
@@ -215,4 +227,38 @@ func isNotFound(err error) bool {
 		strings.Contains(errStr, "resource not found") ||
 		strings.Contains(errStr, "certificate not found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
+
+// IssuanceCondition returns the Issued condition matching the status of a
+// Certificate Pack. Packs are only issued once their status is active.
+func IssuanceCondition(obs v1alpha1.CertificatePackObservation) xpv1.Condition {
+	status := ptr.Deref(obs.Status, "")
+	if status == statusActive {
+		return pcv1alpha1.Issued()
+	}
+	return pcv1alpha1.PendingIssuance(status)
+}
+
+// ExpiryAlertOwner returns the owner of the notification policy of the
+// expiry alert of the named Certificate Pack.
+func ExpiryAlertOwner(name string) string {
+	return "certificatepack/" + name
+}
+
+// ExpiryAlertPolicy returns the notification policy of the expiry alert of
+// the named Certificate Pack. It alerts on the advanced certificates of the
+// zone of the pack, since alerts cannot be filtered by pack.
+func ExpiryAlertPolicy(name string, p v1alpha1.CertificatePackParameters) notifications.Policy {
+	a := p.ExpiryAlert
+	return notifications.Policy{
+		AccountID: a.AccountID,
+		Owner:     ExpiryAlertOwner(name),
+		Name:      "Certificate Pack " + name,
+		AlertType: AlertTypeAdvancedCertificate,
+		Enabled:   ptr.Deref(a.Enabled, true),
+		Filters:   map[string][]string{"zones": {p.Zone}},
+		Emails:    a.Emails,
+		Webhooks:  a.Webhooks,
+		PagerDuty: a.PagerDuty,
+	}
+}
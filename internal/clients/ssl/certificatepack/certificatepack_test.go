@@ -31,6 +31,7 @@ import (
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/notifications"
 )
 
 // MockCertificatePackAPI implements the CertificatePackAPI interface for testing
@@ -766,4 +767,46 @@ func TestIsNotFound(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestExpiryAlertPolicy(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		alert  v1alpha1.CertificatePackExpiryAlert
+		want   notifications.Policy
+	}{
+		"Defaults": {
+			reason: "An alert that does not disable itself should be enabled, and filter the zone of the pack",
+			alert:  v1alpha1.CertificatePackExpiryAlert{AccountID: "account", Emails: []string{"security@example.com"}},
+			want: notifications.Policy{
+				AccountID: "account",
+				Owner:     "certificatepack/example",
+				Name:      "Certificate Pack example",
+				AlertType: AlertTypeAdvancedCertificate,
+				Enabled:   true,
+				Filters:   map[string][]string{"zones": {"zone"}},
+				Emails:    []string{"security@example.com"},
+			},
+		},
+		"Disabled": {
+			reason: "A disabled alert should keep its policy, but disable it",
+			alert:  v1alpha1.CertificatePackExpiryAlert{Enabled: ptr.To(false), AccountID: "account", PagerDuty: []string{"service"}},
+			want: notifications.Policy{
+				AccountID: "account",
+				Owner:     "certificatepack/example",
+				Name:      "Certificate Pack example",
+				AlertType: AlertTypeAdvancedCertificate,
+				Filters:   map[string][]string{"zones": {"zone"}},
+				PagerDuty: []string{"service"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.CertificatePackParameters{Zone: "zone", ExpiryAlert: &tc.alert}
+			if diff := cmp.Diff(tc.want, ExpiryAlertPolicy("example", p)); diff != "" {
+				t.Errorf("\n%s\nExpiryAlertPolicy(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -23,12 +23,17 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	"github.com/rossigee/provider-cloudflare/apis/sslsaas/v1alpha1"
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 )
 
 const (
 	errCustomHostnameNotFound = "Custom Hostname not found"
+
+	sslStatusActive = "active"
 )
 
 // Client is a Cloudflare SSL for SaaS API client
@@ -147,6 +152,15 @@ func GenerateObservation(hostname cloudflare.CustomHostname) v1alpha1.CustomHost
 	return obs
 }
 
+// IssuanceCondition returns the Issued condition matching the status of the
+// SSL certificate of a Custom Hostname.
+func IssuanceCondition(ssl v1alpha1.CustomHostnameSSLObserved) xpv1.Condition {
+	if ssl.Status == sslStatusActive {
+		return pcv1alpha1.Issued()
+	}
+	return pcv1alpha1.PendingIssuance(ssl.Status)
+}
+
 // ParametersToCustomHostname converts CustomHostnameParameters to cloudflare.CustomHostname
 func ParametersToCustomHostname(params v1alpha1.CustomHostnameParameters) cloudflare.CustomHostname {
 	hostname := cloudflare.CustomHostname{
@@ -19,15 +19,26 @@ package fallbackorigin
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	"github.com/rossigee/provider-cloudflare/apis/sslsaas/v1alpha1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 )
 
 const (
 	errFallbackOriginNotFound = "Fallback Origin not found"
+
+	// Fallback Origin activation states reported by Cloudflare.
+	statusActive             = "active"
+	statusInitializing       = "initializing"
+	statusPendingDeployment  = "pending_deployment"
+	statusPendingDeletion    = "pending_deletion"
+	statusDeploymentTimedOut = "deployment_timed_out"
+	statusDeletionTimedOut   = "deletion_timed_out"
 )
 
 // Client is a Cloudflare SSL for SaaS Fallback Origin API client
@@ -91,6 +102,7 @@ func IsFallbackOriginNotFound(err error) bool {
 // GenerateObservation creates observation data from Fallback Origin
 func GenerateObservation(origin cloudflare.CustomHostnameFallbackOrigin) v1alpha1.FallbackOriginObservation {
 	obs := v1alpha1.FallbackOriginObservation{
+		Origin: origin.Origin,
 		Status: origin.Status,
 		Errors: origin.Errors,
 	}
@@ -98,6 +110,30 @@ func GenerateObservation(origin cloudflare.CustomHostnameFallbackOrigin) v1alpha
 	return obs
 }
 
+// ActivationCondition returns the Ready condition matching the activation
+// status of a Fallback Origin. Only an active Fallback Origin is available;
+// one that is still deploying is reported as creating, and one that timed
+// out is reported as unavailable.
+func ActivationCondition(origin cloudflare.CustomHostnameFallbackOrigin) xpv1.Condition {
+	switch origin.Status {
+	case statusActive:
+		return xpv1.Available()
+	case statusInitializing, statusPendingDeployment:
+		return xpv1.Creating()
+	case statusPendingDeletion:
+		return xpv1.Deleting()
+	case statusDeploymentTimedOut, statusDeletionTimedOut:
+		c := xpv1.Unavailable()
+		c.Message = "fallback origin " + strings.ReplaceAll(origin.Status, "_", " ")
+		if len(origin.Errors) > 0 {
+			c.Message += ": " + strings.Join(origin.Errors, "; ")
+		}
+		return c
+	}
+	// Unknown or empty status, e.g. from older API responses.
+	return xpv1.Available()
+}
+
 // ParametersToFallbackOrigin converts FallbackOriginParameters to cloudflare.CustomHostnameFallbackOrigin
 func ParametersToFallbackOrigin(params v1alpha1.FallbackOriginParameters) cloudflare.CustomHostnameFallbackOrigin {
 	origin := cloudflare.CustomHostnameFallbackOrigin{}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
@@ -679,3 +680,52 @@ func (e *external) Disconnect(ctx context.Context) error {
 	// No persistent connections to clean up
 	return nil
 }
+
+func TestActivationCondition(t *testing.T) {
+	timedOut := xpv1.Unavailable()
+	timedOut.Message = "fallback origin deployment timed out: origin unreachable"
+
+	cases := map[string]struct {
+		reason string
+		origin cloudflare.CustomHostnameFallbackOrigin
+		want   xpv1.Condition
+	}{
+		"Active": {
+			reason: "An active fallback origin should be available",
+			origin: cloudflare.CustomHostnameFallbackOrigin{Status: "active"},
+			want:   xpv1.Available(),
+		},
+		"PendingDeployment": {
+			reason: "A fallback origin pending deployment should be creating",
+			origin: cloudflare.CustomHostnameFallbackOrigin{Status: "pending_deployment"},
+			want:   xpv1.Creating(),
+		},
+		"Initializing": {
+			reason: "An initializing fallback origin should be creating",
+			origin: cloudflare.CustomHostnameFallbackOrigin{Status: "initializing"},
+			want:   xpv1.Creating(),
+		},
+		"PendingDeletion": {
+			reason: "A fallback origin pending deletion should be deleting",
+			origin: cloudflare.CustomHostnameFallbackOrigin{Status: "pending_deletion"},
+			want:   xpv1.Deleting(),
+		},
+		"DeploymentTimedOut": {
+			reason: "A fallback origin that timed out should be unavailable with its errors",
+			origin: cloudflare.CustomHostnameFallbackOrigin{
+				Status: "deployment_timed_out",
+				Errors: []string{"origin unreachable"},
+			},
+			want: timedOut,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ActivationCondition(tc.origin)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\nActivationCondition(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+)
+
+const (
+	// DefaultCompressUploadsOver is the size in bytes over which multipart
+	// uploads, such as Worker scripts, are gzip compressed.
+	DefaultCompressUploadsOver = 1 << 20
+
+	// DefaultMaxInFlightLargeUploads is the default number of large
+	// multipart uploads that may be in flight at once across all
+	// controllers.
+	DefaultMaxInFlightLargeUploads = 2
+)
+
+var (
+	// compressUploadsOver is the size in bytes over which multipart uploads
+	// are large. Zero or less disables compression.
+	compressUploadsOver int64 = DefaultCompressUploadsOver
+
+	// largeUploadSlots bounds the number of large uploads in flight. A nil
+	// channel disables the limit.
+	largeUploadSlots = make(chan struct{}, DefaultMaxInFlightLargeUploads)
+)
+
+// SetCompressUploadsOver sets the size in bytes over which multipart
+// uploads are gzip compressed. A value of zero or less disables
+// compression. It must be called before any controllers are started.
+func SetCompressUploadsOver(n int64) {
+	compressUploadsOver = n
+}
+
+// SetMaxInFlightLargeUploads sets the number of large multipart uploads
+// that may be in flight at once across all controllers. A value of zero or
+// less disables the limit. It must be called before any controllers are
+// started.
+func SetMaxInFlightLargeUploads(n int) {
+	if n <= 0 {
+		largeUploadSlots = nil
+		return
+	}
+	largeUploadSlots = make(chan struct{}, n)
+}
+
+// uploadCompressor is an http.RoundTripper that gzip compresses large
+// multipart uploads as they are sent, rather than compressing them into
+// another buffer first, and bounds how many are in flight so that a burst
+// of Worker script uploads cannot exhaust the provider's memory. Uploads
+// the API refuses to accept compressed are retried uncompressed.
+type uploadCompressor struct {
+	over  int64
+	slots chan struct{}
+	next  http.RoundTripper
+}
+
+// isLargeUpload returns true for multipart requests whose body exceeds the
+// supplied size and can be replayed.
+func isLargeUpload(req *http.Request, over int64) bool {
+	if over <= 0 || req.ContentLength <= over || req.GetBody == nil || req.Header.Get("Content-Encoding") != "" {
+		return false
+	}
+	mt, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	return err == nil && mt == "multipart/form-data"
+}
+
+func (u *uploadCompressor) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isLargeUpload(req, u.over) {
+		return u.next.RoundTrip(req)
+	}
+
+	if u.slots != nil {
+		select {
+		case u.slots <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		defer func() { <-u.slots }()
+	}
+
+	resp, err := u.next.RoundTrip(compressed(req))
+	if err != nil || resp.StatusCode != http.StatusUnsupportedMediaType {
+		return resp, err
+	}
+
+	// The endpoint does not accept compressed bodies, so send it as is.
+	_ = resp.Body.Close()
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	plain := req.Clone(req.Context())
+	plain.Body = body
+	return u.next.RoundTrip(plain)
+}
+
+// compressed returns a copy of the supplied request whose body is gzip
+// compressed while it is sent.
+func compressed(req *http.Request) *http.Request {
+	pr, pw := io.Pipe()
+	go func() {
+		body, err := req.GetBody()
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		defer body.Close() //nolint:errcheck // Closing an in-memory body cannot fail.
+		zw := gzip.NewWriter(pw)
+		if _, err := io.Copy(zw, body); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.CloseWithError(zw.Close())
+	}()
+
+	creq := req.Clone(req.Context())
+	creq.Body = pr
+	creq.GetBody = nil
+	creq.ContentLength = -1
+	creq.Header.Set("Content-Encoding", "gzip")
+	return creq
+}
+
+// compressUploads returns a copy of the supplied client whose large
+// multipart uploads are compressed and share the global in-flight limit.
+func compressUploads(hc *http.Client) *http.Client {
+	next := hc.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	chc := *hc
+	chc.Transport = &uploadCompressor{over: compressUploadsOver, slots: largeUploadSlots, next: next}
+	return &chc
+}
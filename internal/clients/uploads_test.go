@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestUploadCompressor(t *testing.T) {
+	large := bytes.Repeat([]byte("addEventListener('fetch', e => e.respondWith(new Response('ok')))\n"), 64)
+
+	type sent struct {
+		Encoding string
+		Body     string
+	}
+
+	type want struct {
+		sent []sent
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason      string
+		contentType string
+		body        []byte
+		held        int
+		ctx         func() context.Context
+		status      []int
+		want        want
+	}{
+		"SmallUploadUntouched": {
+			reason:      "Uploads under the threshold should be sent as is",
+			contentType: "multipart/form-data; boundary=x",
+			body:        []byte("small"),
+			ctx:         context.Background,
+			want:        want{sent: []sent{{Body: "small"}}},
+		},
+		"LargeJSONUntouched": {
+			reason:      "Large requests that are not multipart uploads should be sent as is",
+			contentType: "application/json",
+			body:        large,
+			ctx:         context.Background,
+			want:        want{sent: []sent{{Body: string(large)}}},
+		},
+		"LargeUploadCompressed": {
+			reason:      "Large multipart uploads should be gzip compressed",
+			contentType: "multipart/form-data; boundary=x",
+			body:        large,
+			ctx:         context.Background,
+			want:        want{sent: []sent{{Encoding: "gzip", Body: string(large)}}},
+		},
+		"CompressionRefused": {
+			reason:      "Large uploads the API refuses to accept compressed should be retried uncompressed",
+			contentType: "multipart/form-data; boundary=x",
+			body:        large,
+			ctx:         context.Background,
+			status:      []int{http.StatusUnsupportedMediaType, http.StatusOK},
+			want:        want{sent: []sent{{Encoding: "gzip", Body: string(large)}, {Body: string(large)}}},
+		},
+		"LargeUploadHeld": {
+			reason:      "Large uploads should wait for a slot and give up when the request is cancelled",
+			contentType: "multipart/form-data; boundary=x",
+			body:        large,
+			held:        1,
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			want: want{err: context.Canceled},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			next := roundTripperFn(func(req *http.Request) (*http.Response, error) {
+				var body io.Reader = req.Body
+				enc := req.Header.Get("Content-Encoding")
+				if enc == "gzip" {
+					zr, err := gzip.NewReader(req.Body)
+					if err != nil {
+						return nil, err
+					}
+					body = zr
+				}
+				b, err := io.ReadAll(body)
+				if err != nil {
+					return nil, err
+				}
+				got.sent = append(got.sent, sent{Encoding: enc, Body: string(b)})
+
+				status := http.StatusOK
+				if len(tc.status) >= len(got.sent) {
+					status = tc.status[len(got.sent)-1]
+				}
+				return &http.Response{StatusCode: status, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+			})
+
+			u := &uploadCompressor{over: 1024, slots: make(chan struct{}, 1), next: next}
+			for i := 0; i < tc.held; i++ {
+				u.slots <- struct{}{}
+			}
+
+			req, _ := http.NewRequestWithContext(tc.ctx(), http.MethodPut, "https://api.cloudflare.com/client/v4/accounts/a/workers/scripts/s", bytes.NewReader(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+			_, got.err = u.RoundTrip(req)
+
+			if diff := cmp.Diff(tc.want.err, got.err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.sent, got.sent); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want sent, +got sent:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
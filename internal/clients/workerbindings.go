@@ -0,0 +1,301 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+)
+
+// Worker binding types that cloudflare-go cannot serialize.
+const (
+	WorkerBrowserBindingType   cloudflare.WorkerBindingType = "browser"
+	WorkerRateLimitBindingType cloudflare.WorkerBindingType = "ratelimit"
+	WorkerAssetsBindingType    cloudflare.WorkerBindingType = "assets"
+)
+
+const (
+	errUploadWorker      = "cannot upload Worker"
+	errGetWorkerBindings = "cannot get Worker bindings"
+)
+
+// A WorkerBrowserBinding binds the Browser Rendering API to a Worker.
+//
+// cloudflare-go only serializes the binding types it defines, so bindings
+// it does not support embed one of its types to be accepted in
+// CreateWorkerParams, and Workers using them are uploaded by the adapter.
+type WorkerBrowserBinding struct {
+	cloudflare.WorkerInheritBinding
+}
+
+// Type returns the type of the binding.
+func (WorkerBrowserBinding) Type() cloudflare.WorkerBindingType {
+	return WorkerBrowserBindingType
+}
+
+// A WorkerRateLimitBinding binds a rate limiter to a Worker.
+type WorkerRateLimitBinding struct {
+	cloudflare.WorkerInheritBinding
+
+	// NamespaceID identifies the rate limiter. Workers sharing a namespace
+	// share its counters.
+	NamespaceID string
+
+	// Limit is the number of requests allowed per Period.
+	Limit int64
+
+	// Period in seconds over which requests are counted.
+	Period int64
+}
+
+// Type returns the type of the binding.
+func (WorkerRateLimitBinding) Type() cloudflare.WorkerBindingType {
+	return WorkerRateLimitBindingType
+}
+
+// A WorkerAssetsBinding links static assets to an uploaded Worker. Unlike
+// other bindings it is uploaded as the assets of the Worker, and only
+// binds them to the Worker when it has a name.
+type WorkerAssetsBinding struct {
+	cloudflare.WorkerInheritBinding
+
+	// JWT is the completion token of the session that uploaded the assets.
+	// The assets of the previous version of the Worker are kept while it
+	// is empty.
+	JWT string
+
+	// HTMLHandling controls how requests for HTML pages are matched to
+	// assets.
+	HTMLHandling string
+
+	// NotFoundHandling controls what is served for requests matching no
+	// asset.
+	NotFoundHandling string
+
+	// RunWorkerFirst invokes the Worker for every request.
+	RunWorkerFirst *bool
+}
+
+// Type returns the type of the binding.
+func (WorkerAssetsBinding) Type() cloudflare.WorkerBindingType {
+	return WorkerAssetsBindingType
+}
+
+// A WorkerScriptBinding is a binding of an uploaded Worker, as reported in
+// its settings.
+type WorkerScriptBinding struct {
+	// Name of the binding.
+	Name string `json:"name"`
+
+	// Type of the binding.
+	Type cloudflare.WorkerBindingType `json:"type"`
+
+	// Text of a plain_text binding. The text of secret_text bindings is
+	// never returned.
+	Text string `json:"text,omitempty"`
+}
+
+type workerAssetsConfig struct {
+	HTMLHandling     string `json:"html_handling,omitempty"`
+	NotFoundHandling string `json:"not_found_handling,omitempty"`
+	RunWorkerFirst   *bool  `json:"run_worker_first,omitempty"`
+}
+
+type workerAssets struct {
+	JWT    string              `json:"jwt,omitempty"`
+	Config *workerAssetsConfig `json:"config,omitempty"`
+}
+
+// hasExtendedBindings returns true if any of the supplied bindings cannot be
+// serialized by cloudflare-go.
+func hasExtendedBindings(bindings map[string]cloudflare.WorkerBinding) bool {
+	for _, b := range bindings {
+		switch b.(type) {
+		case WorkerBrowserBinding, WorkerRateLimitBinding, WorkerAssetsBinding:
+			return true
+		}
+	}
+	return false
+}
+
+// workerBindingMeta returns the metadata of a binding as sent in a Worker
+// upload, for the binding types the provider creates.
+func workerBindingMeta(name string, b cloudflare.WorkerBinding) (map[string]interface{}, error) {
+	m := map[string]interface{}{"name": name, "type": b.Type()}
+	switch b := b.(type) {
+	case WorkerBrowserBinding, WorkerAssetsBinding:
+	case WorkerRateLimitBinding:
+		m["namespace_id"] = b.NamespaceID
+		m["simple"] = map[string]int64{"limit": b.Limit, "period": b.Period}
+	case cloudflare.WorkerKvNamespaceBinding:
+		m["namespace_id"] = b.NamespaceID
+	case cloudflare.WorkerPlainTextBinding:
+		m["text"] = b.Text
+	case cloudflare.WorkerServiceBinding:
+		m["service"] = b.Service
+		if b.Environment != nil {
+			m["environment"] = *b.Environment
+		}
+	case cloudflare.WorkerInheritBinding:
+		if b.OldName != "" {
+			m["old_name"] = b.OldName
+		}
+	default:
+		return nil, errors.Errorf("unsupported binding type %q", b.Type())
+	}
+	return m, nil
+}
+
+// workerForm returns the content type and multipart body of a Worker upload,
+// like cloudflare-go does but serializing the bindings it does not support.
+func workerForm(params cloudflare.CreateWorkerParams) (string, []byte, error) {
+	meta := struct {
+		BodyPart           string                            `json:"body_part,omitempty"`
+		MainModule         string                            `json:"main_module,omitempty"`
+		Bindings           []map[string]interface{}          `json:"bindings"`
+		Logpush            *bool                             `json:"logpush,omitempty"`
+		TailConsumers      *[]cloudflare.WorkersTailConsumer `json:"tail_consumers,omitempty"`
+		CompatibilityDate  string                            `json:"compatibility_date,omitempty"`
+		CompatibilityFlags []string                          `json:"compatibility_flags,omitempty"`
+		Placement          *cloudflare.Placement             `json:"placement,omitempty"`
+		Tags               []string                          `json:"tags"`
+		Assets             *workerAssets                     `json:"assets,omitempty"`
+		KeepAssets         bool                              `json:"keep_assets,omitempty"`
+	}{
+		Bindings:           make([]map[string]interface{}, 0, len(params.Bindings)),
+		Logpush:            params.Logpush,
+		TailConsumers:      params.TailConsumers,
+		CompatibilityDate:  params.CompatibilityDate,
+		CompatibilityFlags: params.CompatibilityFlags,
+		Placement:          params.Placement,
+		Tags:               params.Tags,
+	}
+
+	part := "script"
+	disposition := fmt.Sprintf(`form-data; name="%s"`, part)
+	contentType := "application/javascript"
+	if params.Module {
+		part = "worker.mjs"
+		disposition = fmt.Sprintf(`form-data; name="%s"; filename="%[1]s"`, part)
+		contentType = "application/javascript+module"
+		meta.MainModule = part
+	} else {
+		meta.BodyPart = part
+	}
+
+	for name, b := range params.Bindings {
+		if a, ok := b.(WorkerAssetsBinding); ok {
+			assets := &workerAssets{JWT: a.JWT}
+			if a.HTMLHandling != "" || a.NotFoundHandling != "" || a.RunWorkerFirst != nil {
+				assets.Config = &workerAssetsConfig{HTMLHandling: a.HTMLHandling, NotFoundHandling: a.NotFoundHandling, RunWorkerFirst: a.RunWorkerFirst}
+			}
+			if assets.JWT != "" || assets.Config != nil {
+				meta.Assets = assets
+			}
+			meta.KeepAssets = a.JWT == ""
+			if name == "" {
+				continue
+			}
+		}
+		m, err := workerBindingMeta(name, b)
+		if err != nil {
+			return "", nil, err
+		}
+		meta.Bindings = append(meta.Bindings, m)
+	}
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return "", nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	mpw := multipart.NewWriter(buf)
+	for _, p := range []struct {
+		disposition string
+		contentType string
+		body        []byte
+	}{
+		{`form-data; name="metadata"`, "application/json", metaJSON},
+		{disposition, contentType, []byte(params.Script)},
+	} {
+		hdr := textproto.MIMEHeader{}
+		hdr.Set("content-disposition", p.disposition)
+		hdr.Set("content-type", p.contentType)
+		w, err := mpw.CreatePart(hdr)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, err := w.Write(p.body); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := mpw.Close(); err != nil {
+		return "", nil, err
+	}
+	return mpw.FormDataContentType(), buf.Bytes(), nil
+}
+
+// uploadWorker uploads a Worker with bindings cloudflare-go does not
+// support.
+func uploadWorker(ctx context.Context, api *cloudflare.API, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerParams) (cloudflare.WorkerScriptResponse, error) {
+	contentType, body, err := workerForm(params)
+	if err != nil {
+		return cloudflare.WorkerScriptResponse{}, errors.Wrap(err, errUploadWorker)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s", rc.Identifier, params.ScriptName)
+	if params.DispatchNamespaceName != nil && *params.DispatchNamespaceName != "" {
+		uri = fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s/scripts/%s", rc.Identifier, *params.DispatchNamespaceName, params.ScriptName)
+	}
+
+	h := http.Header{}
+	h.Set("Content-Type", contentType)
+	res, err := api.Raw(ctx, http.MethodPut, uri, body, h)
+	if err != nil {
+		return cloudflare.WorkerScriptResponse{}, err
+	}
+
+	r := cloudflare.WorkerScriptResponse{Response: res.Response, Module: params.Module}
+	if err := json.Unmarshal(res.Result, &r.WorkerScript); err != nil {
+		return cloudflare.WorkerScriptResponse{}, errors.Wrap(err, errUploadWorker)
+	}
+	return r, nil
+}
+
+// getWorkerScriptBindings returns the bindings in the settings of a Worker,
+// which cloudflare-go does not parse.
+func getWorkerScriptBindings(ctx context.Context, api *cloudflare.API, rc *cloudflare.ResourceContainer, scriptName string) ([]WorkerScriptBinding, error) {
+	res, err := api.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/workers/scripts/%s/settings", rc.Identifier, scriptName), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var settings struct {
+		Bindings []WorkerScriptBinding `json:"bindings"`
+	}
+	if err := json.Unmarshal(res.Result, &settings); err != nil {
+		return nil, errors.Wrap(err, errGetWorkerBindings)
+	}
+	return settings.Bindings, nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+)
+
+func TestWorkerForm(t *testing.T) {
+	type want struct {
+		bindings   []map[string]interface{}
+		assets     map[string]interface{}
+		keepAssets bool
+		script     string
+		part       string
+	}
+
+	cases := map[string]struct {
+		reason string
+		params cloudflare.CreateWorkerParams
+		want   want
+	}{
+		"ExtendedBindings": {
+			reason: "Bindings cloudflare-go cannot serialize should be sent alongside the ones it can",
+			params: cloudflare.CreateWorkerParams{
+				ScriptName: "worker",
+				Script:     "export default {}",
+				Module:     true,
+				Bindings: map[string]cloudflare.WorkerBinding{
+					"BROWSER": WorkerBrowserBinding{},
+					"LIMITER": WorkerRateLimitBinding{NamespaceID: "1001", Limit: 100, Period: 60},
+					"KV":      cloudflare.WorkerKvNamespaceBinding{NamespaceID: "kv-id"},
+					"AUTH":    cloudflare.WorkerServiceBinding{Service: "auth", Environment: ptr.To("production")},
+				},
+			},
+			want: want{
+				bindings: []map[string]interface{}{
+					{"name": "AUTH", "type": "service", "service": "auth", "environment": "production"},
+					{"name": "BROWSER", "type": "browser"},
+					{"name": "KV", "type": "kv_namespace", "namespace_id": "kv-id"},
+					{"name": "LIMITER", "type": "ratelimit", "namespace_id": "1001", "simple": map[string]interface{}{"limit": float64(100), "period": float64(60)}},
+				},
+				script: "export default {}",
+				part:   "worker.mjs",
+			},
+		},
+		"Assets": {
+			reason: "Uploaded assets should be linked to the Worker and bound to it by name",
+			params: cloudflare.CreateWorkerParams{
+				ScriptName: "worker",
+				Script:     "export default {}",
+				Module:     true,
+				Bindings: map[string]cloudflare.WorkerBinding{
+					"ASSETS": WorkerAssetsBinding{JWT: "completion", NotFoundHandling: "single-page-application"},
+				},
+			},
+			want: want{
+				bindings: []map[string]interface{}{{"name": "ASSETS", "type": "assets"}},
+				assets:   map[string]interface{}{"jwt": "completion", "config": map[string]interface{}{"not_found_handling": "single-page-application"}},
+				script:   "export default {}",
+				part:     "worker.mjs",
+			},
+		},
+		"KeepAssets": {
+			reason: "Assets without an upload token should be kept from the previous version without binding them",
+			params: cloudflare.CreateWorkerParams{
+				ScriptName: "worker",
+				Script:     "export default {}",
+				Module:     true,
+				Bindings:   map[string]cloudflare.WorkerBinding{"": WorkerAssetsBinding{}},
+			},
+			want: want{
+				bindings:   []map[string]interface{}{},
+				keepAssets: true,
+				script:     "export default {}",
+				part:       "worker.mjs",
+			},
+		},
+		"ServiceWorker": {
+			reason: "Service Worker scripts should be sent as the body part",
+			params: cloudflare.CreateWorkerParams{
+				ScriptName: "worker",
+				Script:     "addEventListener('fetch', () => {})",
+				Bindings:   map[string]cloudflare.WorkerBinding{"BROWSER": WorkerBrowserBinding{}},
+			},
+			want: want{
+				bindings: []map[string]interface{}{{"name": "BROWSER", "type": "browser"}},
+				script:   "addEventListener('fetch', () => {})",
+				part:     "script",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ct, body, err := workerForm(tc.params)
+			if err != nil {
+				t.Fatalf("workerForm(...): %v", err)
+			}
+			_, p, err := mime.ParseMediaType(ct)
+			if err != nil {
+				t.Fatalf("mime.ParseMediaType(...): %v", err)
+			}
+
+			got := want{}
+			r := multipart.NewReader(bytes.NewReader(body), p["boundary"])
+			for {
+				part, err := r.NextPart()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("NextPart(): %v", err)
+				}
+				data, _ := io.ReadAll(part)
+				if part.FormName() == "metadata" {
+					meta := struct {
+						Bindings   []map[string]interface{} `json:"bindings"`
+						Assets     map[string]interface{}   `json:"assets"`
+						KeepAssets bool                     `json:"keep_assets"`
+						MainModule string                   `json:"main_module"`
+						BodyPart   string                   `json:"body_part"`
+					}{}
+					if err := json.Unmarshal(data, &meta); err != nil {
+						t.Fatalf("json.Unmarshal(...): %v", err)
+					}
+					got.bindings = meta.Bindings
+					got.assets = meta.Assets
+					got.keepAssets = meta.KeepAssets
+					continue
+				}
+				got.part = part.FormName()
+				got.script = string(data)
+			}
+
+			sortBindings := cmpopts.SortSlices(func(a, b map[string]interface{}) bool { return a["name"].(string) < b["name"].(string) })
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), sortBindings); diff != "" {
+				t.Errorf("\n%s\nworkerForm(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetWorkerScriptBindings(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/accounts/acc/workers/scripts/worker/settings" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"logpush":false,"bindings":[
+			{"name":"MODE","type":"plain_text","text":"production"},
+			{"name":"TOKEN","type":"secret_text"}
+		]}}`))
+	}))
+	defer ts.Close()
+
+	api, err := cloudflare.NewWithAPIToken("token", cloudflare.BaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("NewWithAPIToken(...): %v", err)
+	}
+
+	got, err := getWorkerScriptBindings(context.Background(), api, cloudflare.AccountIdentifier("acc"), "worker")
+	if err != nil {
+		t.Fatalf("getWorkerScriptBindings(...): %v", err)
+	}
+	want := []WorkerScriptBinding{
+		{Name: "MODE", Type: cloudflare.WorkerPlainTextBindingType, Text: "production"},
+		{Name: "TOKEN", Type: cloudflare.WorkerSecretTextBindingType},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getWorkerScriptBindings(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crontrigger
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errCronFields     = "cron expression %q must have 5 fields"
+	errLoadTimezone   = "cannot load timezone"
+	errTimezoneOffset = "timezone %q has an offset that is not a whole number of minutes"
+	errTimezoneFields = "cron expression %q must list its minutes and hours as plain numbers to be translated from timezone %q"
+	errTimezoneShape  = "cron expression %q cannot be expressed in UTC for timezone %q"
+	errTimezoneDays   = "cron expression %q runs on a different day in UTC than in timezone %q, so its day fields must be *"
+)
+
+const (
+	fieldMinute = iota
+	fieldHour
+	fieldDayOfMonth
+	fieldMonth
+	fieldDayOfWeek
+)
+
+var (
+	months = strings.NewReplacer("jan", "1", "feb", "2", "mar", "3", "apr", "4", "may", "5", "jun", "6",
+		"jul", "7", "aug", "8", "sep", "9", "oct", "10", "nov", "11", "dec", "12")
+	weekdays = strings.NewReplacer("sun", "0", "mon", "1", "tue", "2", "wed", "3", "thu", "4", "fri", "5", "sat", "6")
+)
+
+// fields splits a cron expression into its canonical fields: names of
+// months and weekdays are replaced by their numbers, Sunday is 0, and the
+// elements of lists are deduplicated and sorted.
+func fields(expr string) ([]string, error) {
+	f := strings.Fields(strings.ToLower(expr))
+	if len(f) != 5 {
+		return nil, errors.Errorf(errCronFields, expr)
+	}
+	f[fieldMonth] = months.Replace(f[fieldMonth])
+	f[fieldDayOfWeek] = weekdays.Replace(f[fieldDayOfWeek])
+
+	for i := range f {
+		seen := map[string]bool{}
+		elems := []string{}
+		for _, e := range strings.Split(f[i], ",") {
+			e = strings.TrimSuffix(e, "/1")
+			if i == fieldDayOfWeek && e == "7" {
+				e = "0"
+			}
+			if !seen[e] {
+				seen[e] = true
+				elems = append(elems, e)
+			}
+		}
+		sort.Slice(elems, func(a, b int) bool {
+			na, erra := strconv.Atoi(leading(elems[a]))
+			nb, errb := strconv.Atoi(leading(elems[b]))
+			if erra == nil && errb == nil && na != nb {
+				return na < nb
+			}
+			return elems[a] < elems[b]
+		})
+		f[i] = strings.Join(elems, ",")
+	}
+	return f, nil
+}
+
+// leading returns the leading digits of a list element, so that ranges
+// and steps sort by their first value.
+func leading(e string) string {
+	i := strings.IndexFunc(e, func(r rune) bool { return r < '0' || r > '9' })
+	if i < 0 {
+		return e
+	}
+	return e[:i]
+}
+
+// Canonicalize returns the canonical form of a cron expression, in which
+// equivalent schedules such as "0 9 * * MON,FRI" and "0  9 * * 5,1" are
+// written identically.
+func Canonicalize(expr string) (string, error) {
+	f, err := fields(expr)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(f, " "), nil
+}
+
+// Equal returns true if the supplied cron expressions describe the same
+// schedule. Expressions that cannot be canonicalized are compared as is.
+func Equal(a, b string) bool {
+	ca, erra := Canonicalize(a)
+	cb, errb := Canonicalize(b)
+	if erra != nil || errb != nil {
+		return a == b
+	}
+	return ca == cb
+}
+
+func join(n map[int]bool) string {
+	s := make([]string, 0, len(n))
+	for v := range n {
+		s = append(s, strconv.Itoa(v))
+	}
+	return strings.Join(s, ",")
+}
+
+// ToUTC translates a cron expression in the supplied IANA timezone to the
+// UTC expression Cloudflare runs, using the offset of the timezone at the
+// supplied time. Expressions are only translated when their minutes and
+// hours are lists of numbers, or * for hours, and when the translation
+// does not move a schedule restricted to certain days to another day.
+func ToUTC(expr, timezone string, at time.Time) (string, error) {
+	f, err := fields(expr)
+	if err != nil {
+		return "", err
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", errors.Wrap(err, errLoadTimezone)
+	}
+	_, offset := at.In(loc).Zone()
+	if offset%60 != 0 {
+		return "", errors.Errorf(errTimezoneOffset, timezone)
+	}
+	offset /= 60
+	if offset == 0 {
+		return strings.Join(f, " "), nil
+	}
+
+	hours := []int{}
+	if f[fieldHour] == "*" {
+		for h := 0; h < 24; h++ {
+			hours = append(hours, h)
+		}
+	} else if hours, err = plain(f[fieldHour], expr, timezone); err != nil {
+		return "", err
+	}
+	minutes := []int{-1}
+	if offset%60 != 0 {
+		if minutes, err = plain(f[fieldMinute], expr, timezone); err != nil {
+			return "", err
+		}
+	}
+
+	type slot struct{ minute, hour int }
+	slots := map[slot]bool{}
+	utcMinutes, utcHours, shifts := map[int]bool{}, map[int]bool{}, map[int]bool{}
+	for _, h := range hours {
+		for _, m := range minutes {
+			t := h*60 - offset
+			if m >= 0 {
+				t += m
+			}
+			shift := 0
+			if t < 0 {
+				shift = -1
+			} else if t >= 24*60 {
+				shift = 1
+			}
+			t -= shift * 24 * 60
+			s := slot{minute: -1, hour: t / 60}
+			if m >= 0 {
+				s.minute = t % 60
+				utcMinutes[s.minute] = true
+			}
+			slots[s] = true
+			utcHours[s.hour] = true
+			shifts[shift] = true
+		}
+	}
+
+	// A cron expression runs at every combination of its minutes and
+	// hours, so the translated times must be such a combination.
+	if len(utcMinutes) > 0 && len(slots) != len(utcMinutes)*len(utcHours) {
+		return "", errors.Errorf(errTimezoneShape, expr, timezone)
+	}
+	if f[fieldDayOfMonth] != "*" || f[fieldMonth] != "*" || f[fieldDayOfWeek] != "*" {
+		if shifts[-1] || shifts[1] {
+			return "", errors.Errorf(errTimezoneDays, expr, timezone)
+		}
+	}
+
+	if len(utcMinutes) > 0 {
+		f[fieldMinute] = join(utcMinutes)
+	}
+	if len(utcHours) == 24 {
+		f[fieldHour] = "*"
+	} else {
+		f[fieldHour] = join(utcHours)
+	}
+	return Canonicalize(strings.Join(f, " "))
+}
+
+// plain parses a field of a cron expression that must be a list of plain
+// numbers to be translated between timezones.
+func plain(field, expr, timezone string) ([]int, error) {
+	out := []int{}
+	for _, e := range strings.Split(field, ",") {
+		n, err := strconv.Atoi(e)
+		if err != nil {
+			return nil, errors.Errorf(errTimezoneFields, expr, timezone)
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
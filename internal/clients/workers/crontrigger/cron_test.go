@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crontrigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestCanonicalize(t *testing.T) {
+	type want struct {
+		cron string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		expr   string
+		want   want
+	}{
+		"Unchanged": {
+			reason: "A canonical expression should be returned as is",
+			expr:   "*/5 * * * *",
+			want:   want{cron: "*/5 * * * *"},
+		},
+		"Whitespace": {
+			reason: "Fields should be separated by a single space",
+			expr:   " 0  9 * *\t1 ",
+			want:   want{cron: "0 9 * * 1"},
+		},
+		"Names": {
+			reason: "Month and weekday names should be replaced by their numbers",
+			expr:   "0 9 * JAN,Mar MON-FRI",
+			want:   want{cron: "0 9 * 1,3 1-5"},
+		},
+		"Lists": {
+			reason: "List elements should be deduplicated and sorted numerically",
+			expr:   "30,0,15,0 10,9-11 * * 7,5,sun",
+			want:   want{cron: "0,15,30 9-11,10 * * 0,5"},
+		},
+		"Steps": {
+			reason: "A step of one should be dropped",
+			expr:   "*/1 0-23/1 * * *",
+			want:   want{cron: "* 0-23 * * *"},
+		},
+		"Fields": {
+			reason: "Expressions without five fields should be rejected",
+			expr:   "0 9 * *",
+			want:   want{err: errors.Errorf(errCronFields, "0 9 * *")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Canonicalize(tc.expr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCanonicalize(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cron, got); diff != "" {
+				t.Errorf("\n%s\nCanonicalize(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestToUTC(t *testing.T) {
+	winter := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		expr     string
+		timezone string
+		at       time.Time
+	}
+	type want struct {
+		cron string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"UTC": {
+			reason: "Expressions in UTC should only be canonicalized",
+			args:   args{expr: "0 9 * * MON", timezone: "UTC", at: winter},
+			want:   want{cron: "0 9 * * 1"},
+		},
+		"Winter": {
+			reason: "Hours should be shifted by the winter offset of the timezone",
+			args:   args{expr: "0 9,17 * * 1-5", timezone: "Europe/Berlin", at: winter},
+			want:   want{cron: "0 8,16 * * 1-5"},
+		},
+		"Summer": {
+			reason: "Hours should be shifted by the daylight saving offset of the timezone",
+			args:   args{expr: "0 9,17 * * 1-5", timezone: "Europe/Berlin", at: summer},
+			want:   want{cron: "0 7,15 * * 1-5"},
+		},
+		"HalfHour": {
+			reason: "Minutes should be shifted by offsets that are not whole hours",
+			args:   args{expr: "0,15 9 * * *", timezone: "Asia/Kolkata", at: winter},
+			want:   want{cron: "30,45 3 * * *"},
+		},
+		"Hourly": {
+			reason: "Hourly schedules should keep running every hour",
+			args:   args{expr: "0 * * * *", timezone: "Asia/Kolkata", at: winter},
+			want:   want{cron: "30 * * * *"},
+		},
+		"NextDay": {
+			reason: "Schedules running every day may move to another day in UTC",
+			args:   args{expr: "0 1 * * *", timezone: "Europe/Berlin", at: winter},
+			want:   want{cron: "0 0 * * *"},
+		},
+		"PreviousDay": {
+			reason: "Schedules running every day may move to the previous day in UTC",
+			args:   args{expr: "0 0 * * *", timezone: "Europe/Berlin", at: winter},
+			want:   want{cron: "0 23 * * *"},
+		},
+		"DayShift": {
+			reason: "Schedules restricted to certain days should not move to another day",
+			args:   args{expr: "0 0 * * 1", timezone: "Europe/Berlin", at: winter},
+			want:   want{err: errors.Errorf(errTimezoneDays, "0 0 * * 1", "Europe/Berlin")},
+		},
+		"Ranges": {
+			reason: "Hours given as ranges cannot be translated",
+			args:   args{expr: "0 9-17 * * *", timezone: "Europe/Berlin", at: winter},
+			want:   want{err: errors.Errorf(errTimezoneFields, "0 9-17 * * *", "Europe/Berlin")},
+		},
+		"Shape": {
+			reason: "Translations that are not a combination of minutes and hours should be rejected",
+			args:   args{expr: "0,45 9 * * *", timezone: "Asia/Kolkata", at: winter},
+			want:   want{err: errors.Errorf(errTimezoneShape, "0,45 9 * * *", "Asia/Kolkata")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ToUTC(tc.args.expr, tc.args.timezone, tc.args.at)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nToUTC(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cron, got); diff != "" {
+				t.Errorf("\n%s\nToUTC(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package crontrigger
 
 import (
 	"context"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
@@ -37,12 +38,12 @@ const (
 
 // CronTriggerClient provides operations for Workers Cron Triggers.
 type CronTriggerClient struct {
-	client    clients.ClientInterface
+	client    clients.WorkerCronTriggerAPI
 	accountID string
 }
 
 // NewClient creates a new Workers Cron Trigger client.
-func NewClient(client clients.ClientInterface) *CronTriggerClient {
+func NewClient(client clients.WorkerCronTriggerAPI) *CronTriggerClient {
 	return &CronTriggerClient{
 		client:    client,
 		accountID: "", // Account ID will be retrieved when needed
@@ -83,6 +84,17 @@ func convertToObservation(scriptName string, trigger cloudflare.WorkerCronTrigge
 	return obs
 }
 
+// Schedule returns the UTC cron expression Cloudflare should run for the
+// supplied parameters at the supplied time. Cron triggers always run in
+// UTC, so schedules in another timezone are translated using its offset at
+// that time, and change when the offset does.
+func Schedule(params v1alpha1.CronTriggerParameters, at time.Time) (string, error) {
+	if params.Timezone == nil {
+		return Canonicalize(params.Cron)
+	}
+	return ToUTC(params.Cron, *params.Timezone, at)
+}
+
 // Create creates a new Workers Cron Trigger.
 // Note: Cloudflare API manages cron triggers as a collection for a script,
 // so we update the entire collection to include our new trigger.
@@ -92,6 +104,11 @@ func (c *CronTriggerClient) Create(ctx context.Context, params v1alpha1.CronTrig
 		return nil, errors.Wrap(err, "failed to get account ID")
 	}
 	rc := cloudflare.AccountIdentifier(accountID)
+
+	cron, err := Schedule(params, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateCronTrigger)
+	}
 	
 	// First, get existing triggers to avoid overwriting them
 	listParams := cloudflare.ListWorkerCronTriggersParams{
@@ -105,7 +122,7 @@ func (c *CronTriggerClient) Create(ctx context.Context, params v1alpha1.CronTrig
 	
 	// Add our new trigger to the existing ones
 	allTriggers := append(existingTriggers, cloudflare.WorkerCronTrigger{
-		Cron: params.Cron,
+		Cron: cron,
 	})
 	
 	// Update the entire collection
@@ -121,7 +138,7 @@ func (c *CronTriggerClient) Create(ctx context.Context, params v1alpha1.CronTrig
 	
 	// Find our newly created trigger in the response
 	for _, trigger := range updatedTriggers {
-		if trigger.Cron == params.Cron {
+		if Equal(trigger.Cron, cron) {
 			obs := convertToObservation(params.ScriptName, trigger)
 			return &obs, nil
 		}
@@ -130,9 +147,15 @@ func (c *CronTriggerClient) Create(ctx context.Context, params v1alpha1.CronTrig
 	return nil, errors.New("created cron trigger not found in response")
 }
 
-// Get retrieves a Workers Cron Trigger by finding it in the script's trigger list.
+// Get retrieves a Workers Cron Trigger by finding it in the script's trigger
+// list. Triggers are matched by schedule, so the UTC cron expression must
+// be supplied, in any equivalent form.
 func (c *CronTriggerClient) Get(ctx context.Context, scriptName, cronExpression string) (*v1alpha1.CronTriggerObservation, error) {
-	rc := cloudflare.AccountIdentifier(c.accountID)
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account ID")
+	}
+	rc := cloudflare.AccountIdentifier(accountID)
 	
 	listParams := cloudflare.ListWorkerCronTriggersParams{
 		ScriptName: scriptName,
@@ -144,7 +167,7 @@ func (c *CronTriggerClient) Get(ctx context.Context, scriptName, cronExpression
 	}
 
 	for _, trigger := range triggers {
-		if trigger.Cron == cronExpression {
+		if Equal(trigger.Cron, cronExpression) {
 			obs := convertToObservation(scriptName, trigger)
 			return &obs, nil
 		}
@@ -156,7 +179,16 @@ func (c *CronTriggerClient) Get(ctx context.Context, scriptName, cronExpression
 // Update updates an existing Workers Cron Trigger.
 // Note: Since Cloudflare manages triggers as a collection, we replace the specific trigger.
 func (c *CronTriggerClient) Update(ctx context.Context, oldCron string, params v1alpha1.CronTriggerParameters) (*v1alpha1.CronTriggerObservation, error) {
-	rc := cloudflare.AccountIdentifier(c.accountID)
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account ID")
+	}
+	rc := cloudflare.AccountIdentifier(accountID)
+
+	cron, err := Schedule(params, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, errUpdateCronTrigger)
+	}
 	
 	// Get existing triggers
 	listParams := cloudflare.ListWorkerCronTriggersParams{
@@ -172,9 +204,9 @@ func (c *CronTriggerClient) Update(ctx context.Context, oldCron string, params v
 	var updatedTriggers []cloudflare.WorkerCronTrigger
 	found := false
 	for _, trigger := range existingTriggers {
-		if trigger.Cron == oldCron {
+		if Equal(trigger.Cron, oldCron) {
 			updatedTriggers = append(updatedTriggers, cloudflare.WorkerCronTrigger{
-				Cron: params.Cron,
+				Cron: cron,
 			})
 			found = true
 		} else {
@@ -199,7 +231,7 @@ func (c *CronTriggerClient) Update(ctx context.Context, oldCron string, params v
 	
 	// Find our updated trigger in the response
 	for _, trigger := range resultTriggers {
-		if trigger.Cron == params.Cron {
+		if Equal(trigger.Cron, cron) {
 			obs := convertToObservation(params.ScriptName, trigger)
 			return &obs, nil
 		}
@@ -210,7 +242,11 @@ func (c *CronTriggerClient) Update(ctx context.Context, oldCron string, params v
 
 // Delete removes a Workers Cron Trigger.
 func (c *CronTriggerClient) Delete(ctx context.Context, scriptName, cronExpression string) error {
-	rc := cloudflare.AccountIdentifier(c.accountID)
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get account ID")
+	}
+	rc := cloudflare.AccountIdentifier(accountID)
 	
 	// Get existing triggers
 	listParams := cloudflare.ListWorkerCronTriggersParams{
@@ -226,7 +262,7 @@ func (c *CronTriggerClient) Delete(ctx context.Context, scriptName, cronExpressi
 	var remainingTriggers []cloudflare.WorkerCronTrigger
 	found := false
 	for _, trigger := range existingTriggers {
-		if trigger.Cron != cronExpression {
+		if !Equal(trigger.Cron, cronExpression) {
 			remainingTriggers = append(remainingTriggers, trigger)
 		} else {
 			found = true
@@ -254,7 +290,11 @@ func (c *CronTriggerClient) Delete(ctx context.Context, scriptName, cronExpressi
 
 // List retrieves all Workers Cron Triggers for a script.
 func (c *CronTriggerClient) List(ctx context.Context, scriptName string) ([]v1alpha1.CronTriggerObservation, error) {
-	rc := cloudflare.AccountIdentifier(c.accountID)
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account ID")
+	}
+	rc := cloudflare.AccountIdentifier(accountID)
 	
 	listParams := cloudflare.ListWorkerCronTriggersParams{
 		ScriptName: scriptName,
@@ -273,10 +313,15 @@ func (c *CronTriggerClient) List(ctx context.Context, scriptName string) ([]v1al
 	return observations, nil
 }
 
-// IsUpToDate checks if the Workers Cron Trigger is up to date.
+// IsUpToDate checks if the Workers Cron Trigger is up to date. Schedules
+// are compared in their canonical UTC form, so equivalent expressions such
+// as "0 9 * * MON,FRI" and "0 9 * * 5,1" do not cause an update.
 func (c *CronTriggerClient) IsUpToDate(ctx context.Context, params v1alpha1.CronTriggerParameters, obs v1alpha1.CronTriggerObservation) (bool, error) {
-	// For cron triggers, we only need to compare the cron expression
-	return obs.Cron == params.Cron && obs.ScriptName == params.ScriptName, nil
+	cron, err := Schedule(params, time.Now())
+	if err != nil {
+		return false, err
+	}
+	return Equal(obs.Cron, cron) && obs.ScriptName == params.ScriptName, nil
 }
 
 // IsCronTriggerNotFound returns true if the error indicates the cron trigger was not found
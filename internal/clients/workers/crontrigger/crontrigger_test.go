@@ -25,9 +25,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/fake"
 )
 
 const (
@@ -52,9 +54,9 @@ func TestCreate(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		args        args
-		mockClient  func() clients.ClientInterface
-		want        want
+		args       args
+		mockClient func() clients.WorkerCronTriggerAPI
+		want       want
 	}{
 		"CreateSuccess": {
 			args: args{
@@ -63,18 +65,24 @@ func TestCreate(t *testing.T) {
 					Cron:       testCronExpr,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers").Return([]cloudflare.WorkerCronTrigger{}, nil)
-				client.On("UpdateWorkerCronTriggers").Return([]cloudflare.WorkerCronTrigger{
-					{
-						Cron:       testCronExpr,
-						CreatedOn:  &testTime,
-						ModifiedOn: &testTime,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{}, nil
 					},
-				}, nil)
-				return client
+					UpdateWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{
+							{
+								Cron:       testCronExpr,
+								CreatedOn:  &testTime,
+								ModifiedOn: &testTime,
+							},
+						}, nil
+					},
+				}
 			},
 			want: want{
 				obs: &v1alpha1.CronTriggerObservation{
@@ -92,33 +100,23 @@ func TestCreate(t *testing.T) {
 					Cron:       testCronExpr,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkerCronTriggersParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				).Return([]cloudflare.WorkerCronTrigger{
-					{Cron: "*/10 * * * *"},
-				}, nil)
-				client.On("UpdateWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.UpdateWorkerCronTriggersParams{
-						ScriptName: testScriptName,
-						Crons: []cloudflare.WorkerCronTrigger{
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{
 							{Cron: "*/10 * * * *"},
-							{Cron: testCronExpr},
-						},
+						}, nil
+					},
+					UpdateWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{
+							{Cron: "*/10 * * * *"},
+							{Cron: testCronExpr, CreatedOn: &testTime},
+						}, nil
 					},
-				).Return([]cloudflare.WorkerCronTrigger{
-					{Cron: "*/10 * * * *"},
-					{Cron: testCronExpr, CreatedOn: &testTime},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.CronTriggerObservation{
@@ -135,17 +133,15 @@ func TestCreate(t *testing.T) {
 					Cron:       testCronExpr,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkerCronTriggersParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				).Return([]cloudflare.WorkerCronTrigger{}, errors.New("list error"))
-				return client
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{}, errors.New("list error")
+					},
+				}
 			},
 			want: want{
 				err: errors.New("cannot list workers cron triggers: list error"),
@@ -158,27 +154,18 @@ func TestCreate(t *testing.T) {
 					Cron:       testCronExpr,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkerCronTriggersParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				).Return([]cloudflare.WorkerCronTrigger{}, nil)
-				client.On("UpdateWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.UpdateWorkerCronTriggersParams{
-						ScriptName: testScriptName,
-						Crons: []cloudflare.WorkerCronTrigger{
-							{Cron: testCronExpr},
-						},
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{}, nil
+					},
+					UpdateWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{}, errors.New("update error")
 					},
-				).Return([]cloudflare.WorkerCronTrigger{}, errors.New("update error"))
-				return client
+				}
 			},
 			want: want{
 				err: errors.New("cannot create workers cron trigger: update error"),
@@ -222,7 +209,7 @@ func TestGet(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerCronTriggerAPI
 		want       want
 	}{
 		"GetSuccess": {
@@ -230,26 +217,24 @@ func TestGet(t *testing.T) {
 				scriptName:     testScriptName,
 				cronExpression: testCronExpr,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkerCronTriggersParams{
-						ScriptName: testScriptName,
-					},
-				).Return([]cloudflare.WorkerCronTrigger{
-					{
-						Cron:       testCronExpr,
-						CreatedOn:  &testTime,
-						ModifiedOn: &testTime,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-					{
-						Cron: "*/5 * * * *",
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{
+							{
+								Cron:       testCronExpr,
+								CreatedOn:  &testTime,
+								ModifiedOn: &testTime,
+							},
+							{
+								Cron: "*/5 * * * *",
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.CronTriggerObservation{
@@ -265,19 +250,17 @@ func TestGet(t *testing.T) {
 				scriptName:     testScriptName,
 				cronExpression: testCronExpr,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkerCronTriggersParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{
+							{Cron: "*/5 * * * *"},
+						}, nil
 					},
-				).Return([]cloudflare.WorkerCronTrigger{
-					{Cron: "*/5 * * * *"},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				err: errors.New("cron trigger not found"),
@@ -288,17 +271,15 @@ func TestGet(t *testing.T) {
 				scriptName:     testScriptName,
 				cronExpression: testCronExpr,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkerCronTriggersParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{}, errors.New("list error")
 					},
-				).Return([]cloudflare.WorkerCronTrigger{}, errors.New("list error"))
-				return client
+				}
 			},
 			want: want{
 				err: errors.New("cannot get workers cron trigger: list error"),
@@ -341,7 +322,7 @@ func TestDelete(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerCronTriggerAPI
 		want       want
 	}{
 		"DeleteSuccess": {
@@ -349,32 +330,23 @@ func TestDelete(t *testing.T) {
 				scriptName:     testScriptName,
 				cronExpression: testCronExpr,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkerCronTriggersParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				).Return([]cloudflare.WorkerCronTrigger{
-					{Cron: testCronExpr},
-					{Cron: "*/5 * * * *"},
-				}, nil)
-				client.On("UpdateWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.UpdateWorkerCronTriggersParams{
-						ScriptName: testScriptName,
-						Crons: []cloudflare.WorkerCronTrigger{
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{
+							{Cron: testCronExpr},
 							{Cron: "*/5 * * * *"},
-						},
+						}, nil
 					},
-				).Return([]cloudflare.WorkerCronTrigger{
-					{Cron: "*/5 * * * *"},
-				}, nil)
-				return client
+					UpdateWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{
+							{Cron: "*/5 * * * *"},
+						}, nil
+					},
+				}
 			},
 			want: want{},
 		},
@@ -383,19 +355,17 @@ func TestDelete(t *testing.T) {
 				scriptName:     testScriptName,
 				cronExpression: testCronExpr,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkerCronTriggersParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				).Return([]cloudflare.WorkerCronTrigger{
-					{Cron: "*/5 * * * *"},
-				}, nil)
-				return client
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{
+							{Cron: "*/5 * * * *"},
+						}, nil
+					},
+				}
 			},
 			want: want{}, // Not found is OK for delete
 		},
@@ -404,27 +374,20 @@ func TestDelete(t *testing.T) {
 				scriptName:     testScriptName,
 				cronExpression: testCronExpr,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkerCronTriggersParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerCronTriggerAPI {
+				return &fake.WorkerCronTriggerAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					ListWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{
+							{Cron: testCronExpr},
+						}, nil
 					},
-				).Return([]cloudflare.WorkerCronTrigger{
-					{Cron: testCronExpr},
-				}, nil)
-				client.On("UpdateWorkerCronTriggers", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.UpdateWorkerCronTriggersParams{
-						ScriptName: testScriptName,
-						Crons:      []cloudflare.WorkerCronTrigger{},
+					UpdateWorkerCronTriggersFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerCronTriggersParams) ([]cloudflare.WorkerCronTrigger, error) {
+						return []cloudflare.WorkerCronTrigger{}, errors.New("update error")
 					},
-				).Return([]cloudflare.WorkerCronTrigger{}, errors.New("update error"))
-				return client
+				}
 			},
 			want: want{
 				err: errors.New("cannot delete workers cron trigger: update error"),
@@ -494,6 +457,53 @@ func TestIsUpToDate(t *testing.T) {
 				isUpToDate: false,
 			},
 		},
+		"EquivalentCron": {
+			args: args{
+				params: v1alpha1.CronTriggerParameters{
+					ScriptName: testScriptName,
+					Cron:       "0 9 * * MON,FRI",
+				},
+				obs: v1alpha1.CronTriggerObservation{
+					ScriptName: testScriptName,
+					Cron:       "0 9 * * 5,1",
+				},
+			},
+			want: want{
+				isUpToDate: true,
+			},
+		},
+		"Timezone": {
+			args: args{
+				params: v1alpha1.CronTriggerParameters{
+					ScriptName: testScriptName,
+					Cron:       "0 9 * * *",
+					Timezone:   ptr.To("Asia/Kolkata"),
+				},
+				obs: v1alpha1.CronTriggerObservation{
+					ScriptName: testScriptName,
+					Cron:       "30 3 * * *",
+				},
+			},
+			want: want{
+				isUpToDate: true,
+			},
+		},
+		"TimezoneChanged": {
+			args: args{
+				params: v1alpha1.CronTriggerParameters{
+					ScriptName: testScriptName,
+					Cron:       "0 9 * * *",
+					Timezone:   ptr.To("Asia/Kolkata"),
+				},
+				obs: v1alpha1.CronTriggerObservation{
+					ScriptName: testScriptName,
+					Cron:       "0 9 * * *",
+				},
+			},
+			want: want{
+				isUpToDate: false,
+			},
+		},
 		"ScriptChanged": {
 			args: args{
 				params: v1alpha1.CronTriggerParameters{
@@ -513,7 +523,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(&clients.MockClient{})
+			client := NewClient(&fake.WorkerCronTriggerAPIMock{})
 			isUpToDate, err := client.IsUpToDate(context.Background(), tc.args.params, tc.args.obs)
 
 			if err != nil {
@@ -526,4 +536,4 @@ func TestIsUpToDate(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
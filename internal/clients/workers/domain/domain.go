@@ -27,16 +27,52 @@ import (
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 )
 
+// WorkersDomainAPI defines the interface for Workers Custom Domain operations
+type WorkersDomainAPI interface {
+	AttachWorkersDomain(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error)
+	GetWorkersDomain(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) (cloudflare.WorkersDomain, error)
+	DetachWorkersDomain(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) error
+	ListWorkersDomains(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error)
+	ListCertificatePacks(ctx context.Context, zoneID string) ([]cloudflare.CertificatePack, error)
+}
+
+// Provisioning states of the certificate of a Workers Custom Domain.
+const (
+	CertificatePending = "pending"
+	CertificateActive  = "active"
+	CertificateErrored = "errored"
+)
+
+// certificatePackActive is the status of a certificate pack that is
+// deployed to the edge.
+const certificatePackActive = "active"
+
 // CloudflareDomainClient is a Cloudflare API client for Workers Custom Domains.
 type CloudflareDomainClient struct {
-	client *cloudflare.API
+	client WorkersDomainAPI
 }
 
 // NewClient creates a new CloudflareDomainClient.
-func NewClient(client *cloudflare.API) *CloudflareDomainClient {
+func NewClient(client WorkersDomainAPI) *CloudflareDomainClient {
 	return &CloudflareDomainClient{client: client}
 }
 
+// NewClientFromAPI creates a new CloudflareDomainClient from a Cloudflare API instance.
+// This is a wrapper for compatibility with the controller pattern.
+func NewClientFromAPI(api *cloudflare.API) *CloudflareDomainClient {
+	return NewClient(api)
+}
+
+// Adoption describes an existing attachment that was taken over while
+// creating a Workers Custom Domain.
+type Adoption struct {
+	// PreviousService is the Worker the hostname was attached to before.
+	PreviousService string
+
+	// PreviousEnvironment is the environment the hostname was attached to before.
+	PreviousEnvironment string
+}
+
 // Create attaches a worker to a custom domain.
 func (c *CloudflareDomainClient) Create(ctx context.Context, params v1alpha1.DomainParameters) (*v1alpha1.DomainObservation, error) {
 	rc := &cloudflare.ResourceContainer{
@@ -54,6 +90,68 @@ func (c *CloudflareDomainClient) Create(ctx context.Context, params v1alpha1.Dom
 	return convertDomainToObservation(domain), nil
 }
 
+// CreateOrAdopt attaches a worker to a custom domain. If the hostname is
+// already attached to another service and OverwriteExisting is set, the
+// existing attachment is adopted when it already points at the desired
+// service, or re-pointed otherwise. The returned Adoption is nil when no
+// existing attachment was taken over.
+func (c *CloudflareDomainClient) CreateOrAdopt(ctx context.Context, params v1alpha1.DomainParameters) (*v1alpha1.DomainObservation, *Adoption, error) {
+	obs, err := c.Create(ctx, params)
+	if err == nil {
+		return obs, nil, nil
+	}
+	if params.OverwriteExisting == nil || !*params.OverwriteExisting || !isConflict(err) {
+		return nil, nil, err
+	}
+
+	rc := &cloudflare.ResourceContainer{
+		Identifier: params.AccountID,
+		Type:       cloudflare.AccountType,
+	}
+
+	existing, ferr := c.findByHostname(ctx, rc, params.ZoneID, params.Hostname)
+	if ferr != nil {
+		return nil, nil, ferr
+	}
+	if existing == nil {
+		return nil, nil, err
+	}
+
+	adoption := &Adoption{
+		PreviousService:     existing.Service,
+		PreviousEnvironment: existing.Environment,
+	}
+
+	if existing.Service == params.Service && environmentOrDefault(existing.Environment) == environmentOrDefault(params.Environment) {
+		return convertDomainToObservation(*existing), adoption, nil
+	}
+
+	obs, err = c.Update(ctx, existing.ID, params)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot re-point existing workers domain")
+	}
+	return obs, adoption, nil
+}
+
+// findByHostname returns the existing attachment for a hostname, or nil if
+// there is none.
+func (c *CloudflareDomainClient) findByHostname(ctx context.Context, rc *cloudflare.ResourceContainer, zoneID, hostname string) (*cloudflare.WorkersDomain, error) {
+	domains, err := c.client.ListWorkersDomains(ctx, rc, cloudflare.ListWorkersDomainParams{
+		ZoneID:   zoneID,
+		Hostname: hostname,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list workers domains")
+	}
+
+	for i := range domains {
+		if strings.EqualFold(domains[i].Hostname, hostname) {
+			return &domains[i], nil
+		}
+	}
+	return nil, nil
+}
+
 // Get retrieves a Workers Custom Domain by ID.
 func (c *CloudflareDomainClient) Get(ctx context.Context, accountID, domainID string) (*v1alpha1.DomainObservation, error) {
 	rc := &cloudflare.ResourceContainer{
@@ -72,6 +170,37 @@ func (c *CloudflareDomainClient) Get(ctx context.Context, accountID, domainID st
 	return convertDomainToObservation(domain), nil
 }
 
+// GetOrReadopt retrieves a Workers Custom Domain by ID. When no domain has
+// that ID but its hostname is still attached, e.g. because it was detached
+// and attached again outside the provider, the new attachment is returned
+// instead, provided it points at the desired service or OverwriteExisting
+// permits adopting it.
+func (c *CloudflareDomainClient) GetOrReadopt(ctx context.Context, domainID string, params v1alpha1.DomainParameters) (*v1alpha1.DomainObservation, error) {
+	obs, err := c.Get(ctx, params.AccountID, domainID)
+	if err == nil || !clients.IsNotFound(err) {
+		return obs, err
+	}
+
+	rc := &cloudflare.ResourceContainer{
+		Identifier: params.AccountID,
+		Type:       cloudflare.AccountType,
+	}
+
+	existing, ferr := c.findByHostname(ctx, rc, params.ZoneID, params.Hostname)
+	if ferr != nil {
+		return nil, ferr
+	}
+	if existing == nil {
+		return nil, err
+	}
+
+	sameService := existing.Service == params.Service && environmentOrDefault(existing.Environment) == environmentOrDefault(params.Environment)
+	if !sameService && (params.OverwriteExisting == nil || !*params.OverwriteExisting) {
+		return nil, err
+	}
+	return convertDomainToObservation(*existing), nil
+}
+
 // Update updates a Workers Custom Domain (re-attachment).
 func (c *CloudflareDomainClient) Update(ctx context.Context, domainID string, params v1alpha1.DomainParameters) (*v1alpha1.DomainObservation, error) {
 	// For Workers domains, we need to detach and re-attach to update
@@ -130,13 +259,74 @@ func (c *CloudflareDomainClient) IsUpToDate(ctx context.Context, params v1alpha1
 		return false, nil
 	}
 
-	if obs.Environment != nil && params.Environment != *obs.Environment {
+	// Cloudflare reports no environment for attachments to the default
+	// one, so an unset environment on either side means production.
+	if obs.Environment != nil && environmentOrDefault(params.Environment) != environmentOrDefault(*obs.Environment) {
 		return false, nil
 	}
 
 	return true, nil
 }
 
+// CertificatePackStatus returns the status of the certificate pack covering
+// the supplied hostname, preferring an active one when several do. An empty
+// status is returned when no certificate pack covers the hostname yet.
+func (c *CloudflareDomainClient) CertificatePackStatus(ctx context.Context, zoneID, hostname string) (string, error) {
+	packs, err := c.client.ListCertificatePacks(ctx, zoneID)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot list certificate packs")
+	}
+
+	status := ""
+	for _, p := range packs {
+		if !coversHostname(p.Hosts, hostname) {
+			continue
+		}
+		if p.Status == certificatePackActive {
+			return p.Status, nil
+		}
+		if status == "" {
+			status = p.Status
+		}
+	}
+	return status, nil
+}
+
+// CertificateStatus maps the status of a certificate pack to whether the
+// certificate of a Workers Custom Domain is pending, active or errored.
+func CertificateStatus(packStatus string) string {
+	switch {
+	case packStatus == certificatePackActive:
+		return CertificateActive
+	case strings.HasSuffix(packStatus, "_timed_out"),
+		packStatus == "expired",
+		packStatus == "deleted",
+		packStatus == "inactive",
+		packStatus == "deactivating",
+		packStatus == "pending_deletion":
+		return CertificateErrored
+	}
+	return CertificatePending
+}
+
+// coversHostname returns true if any of the supplied certificate hosts,
+// which may be wildcards, covers the hostname.
+func coversHostname(hosts []string, hostname string) bool {
+	for _, h := range hosts {
+		if strings.EqualFold(h, hostname) {
+			return true
+		}
+		parent, ok := strings.CutPrefix(h, "*.")
+		if !ok {
+			continue
+		}
+		if _, rest, found := strings.Cut(hostname, "."); found && strings.EqualFold(rest, parent) {
+			return true
+		}
+	}
+	return false
+}
+
 // convertParametersToAttachDomain converts DomainParameters to cloudflare.AttachWorkersDomainParams.
 func convertParametersToAttachDomain(params v1alpha1.DomainParameters) cloudflare.AttachWorkersDomainParams {
 	return cloudflare.AttachWorkersDomainParams{
@@ -161,6 +351,29 @@ func convertDomainToObservation(domain cloudflare.WorkersDomain) *v1alpha1.Domai
 	return obs
 }
 
+// environmentOrDefault returns the environment Cloudflare uses when none is
+// specified.
+func environmentOrDefault(env string) string {
+	if env == "" {
+		return "production"
+	}
+	return env
+}
+
+// isConflict checks if an error indicates that the hostname is already
+// attached to another service.
+func isConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	errStr := strings.ToLower(err.Error())
+	return strings.Contains(errStr, "already attached") ||
+		strings.Contains(errStr, "already in use") ||
+		strings.Contains(errStr, "already exists") ||
+		strings.Contains(errStr, "conflict")
+}
+
 // isNotFound checks if an error indicates that the workers domain was not found.
 func isNotFound(err error) bool {
 	if err == nil {
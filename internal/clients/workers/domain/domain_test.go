@@ -0,0 +1,580 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+// MockWorkersDomainAPI implements the WorkersDomainAPI interface for testing
+type MockWorkersDomainAPI struct {
+	MockAttachWorkersDomain  func(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error)
+	MockGetWorkersDomain     func(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) (cloudflare.WorkersDomain, error)
+	MockDetachWorkersDomain  func(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) error
+	MockListWorkersDomains   func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error)
+	MockListCertificatePacks func(ctx context.Context, zoneID string) ([]cloudflare.CertificatePack, error)
+}
+
+func (m *MockWorkersDomainAPI) AttachWorkersDomain(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error) {
+	if m.MockAttachWorkersDomain != nil {
+		return m.MockAttachWorkersDomain(ctx, rc, domain)
+	}
+	return cloudflare.WorkersDomain{}, nil
+}
+
+func (m *MockWorkersDomainAPI) GetWorkersDomain(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) (cloudflare.WorkersDomain, error) {
+	if m.MockGetWorkersDomain != nil {
+		return m.MockGetWorkersDomain(ctx, rc, domainID)
+	}
+	return cloudflare.WorkersDomain{}, nil
+}
+
+func (m *MockWorkersDomainAPI) DetachWorkersDomain(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) error {
+	if m.MockDetachWorkersDomain != nil {
+		return m.MockDetachWorkersDomain(ctx, rc, domainID)
+	}
+	return nil
+}
+
+func (m *MockWorkersDomainAPI) ListWorkersDomains(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error) {
+	if m.MockListWorkersDomains != nil {
+		return m.MockListWorkersDomains(ctx, rc, params)
+	}
+	return []cloudflare.WorkersDomain{}, nil
+}
+
+func (m *MockWorkersDomainAPI) ListCertificatePacks(ctx context.Context, zoneID string) ([]cloudflare.CertificatePack, error) {
+	if m.MockListCertificatePacks != nil {
+		return m.MockListCertificatePacks(ctx, zoneID)
+	}
+	return []cloudflare.CertificatePack{}, nil
+}
+
+func TestCreateOrAdopt(t *testing.T) {
+	errConflict := errors.New("hostname is already attached to another worker")
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.DomainParameters{
+		AccountID:   "test-account-id",
+		ZoneID:      "test-zone-id",
+		Hostname:    "api.example.com",
+		Service:     "new-worker",
+		Environment: "production",
+	}
+
+	withOverwrite := params
+	withOverwrite.OverwriteExisting = ptr.To(true)
+
+	existing := cloudflare.WorkersDomain{
+		ID:          "existing-id",
+		ZoneID:      "test-zone-id",
+		Hostname:    "api.example.com",
+		Service:     "old-worker",
+		Environment: "production",
+	}
+
+	type fields struct {
+		client *MockWorkersDomainAPI
+	}
+
+	type args struct {
+		params v1alpha1.DomainParameters
+	}
+
+	type want struct {
+		id       *string
+		adoption *Adoption
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"AttachSuccess": {
+			reason: "CreateOrAdopt should return the new attachment without an adoption when attaching succeeds",
+			fields: fields{
+				client: &MockWorkersDomainAPI{
+					MockAttachWorkersDomain: func(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error) {
+						return cloudflare.WorkersDomain{ID: "new-id", Hostname: domain.Hostname, Service: domain.Service}, nil
+					},
+				},
+			},
+			args: args{params: withOverwrite},
+			want: want{id: ptr.To("new-id")},
+		},
+		"ConflictWithoutOverwrite": {
+			reason: "CreateOrAdopt should return the conflict error when overwriteExisting is not set",
+			fields: fields{
+				client: &MockWorkersDomainAPI{
+					MockAttachWorkersDomain: func(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error) {
+						return cloudflare.WorkersDomain{}, errConflict
+					},
+				},
+			},
+			args: args{params: params},
+			want: want{err: errors.Wrap(errConflict, "cannot attach workers domain")},
+		},
+		"ConflictRepointsExisting": {
+			reason: "CreateOrAdopt should detach and re-attach an existing attachment pointing at another service",
+			fields: fields{
+				client: &MockWorkersDomainAPI{
+					MockAttachWorkersDomain: func() func(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error) {
+						detached := false
+						return func(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error) {
+							if !detached {
+								detached = true
+								return cloudflare.WorkersDomain{}, errConflict
+							}
+							return cloudflare.WorkersDomain{ID: "repointed-id", Hostname: domain.Hostname, Service: domain.Service}, nil
+						}
+					}(),
+					MockListWorkersDomains: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error) {
+						if p.Hostname != "api.example.com" {
+							return nil, errors.New("wrong hostname filter")
+						}
+						return []cloudflare.WorkersDomain{existing}, nil
+					},
+					MockDetachWorkersDomain: func(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) error {
+						if domainID != "existing-id" {
+							return errors.New("wrong domain ID")
+						}
+						return nil
+					},
+				},
+			},
+			args: args{params: withOverwrite},
+			want: want{
+				id:       ptr.To("repointed-id"),
+				adoption: &Adoption{PreviousService: "old-worker", PreviousEnvironment: "production"},
+			},
+		},
+		"ConflictAdoptsMatchingService": {
+			reason: "CreateOrAdopt should adopt an existing attachment already pointing at the desired service",
+			fields: fields{
+				client: &MockWorkersDomainAPI{
+					MockAttachWorkersDomain: func(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error) {
+						return cloudflare.WorkersDomain{}, errConflict
+					},
+					MockListWorkersDomains: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error) {
+						d := existing
+						d.Service = "new-worker"
+						d.Environment = ""
+						return []cloudflare.WorkersDomain{d}, nil
+					},
+					MockDetachWorkersDomain: func(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) error {
+						return errors.New("should not detach")
+					},
+				},
+			},
+			args: args{params: withOverwrite},
+			want: want{
+				id:       ptr.To("existing-id"),
+				adoption: &Adoption{PreviousService: "new-worker"},
+			},
+		},
+		"ConflictListError": {
+			reason: "CreateOrAdopt should return an error when existing attachments cannot be listed",
+			fields: fields{
+				client: &MockWorkersDomainAPI{
+					MockAttachWorkersDomain: func(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error) {
+						return cloudflare.WorkersDomain{}, errConflict
+					},
+					MockListWorkersDomains: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			args: args{params: withOverwrite},
+			want: want{err: errors.Wrap(errBoom, "cannot list workers domains")},
+		},
+		"ConflictNoExisting": {
+			reason: "CreateOrAdopt should return the original error when no existing attachment is found",
+			fields: fields{
+				client: &MockWorkersDomainAPI{
+					MockAttachWorkersDomain: func(ctx context.Context, rc *cloudflare.ResourceContainer, domain cloudflare.AttachWorkersDomainParams) (cloudflare.WorkersDomain, error) {
+						return cloudflare.WorkersDomain{}, errConflict
+					},
+				},
+			},
+			args: args{params: withOverwrite},
+			want: want{err: errors.Wrap(errConflict, "cannot attach workers domain")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(tc.fields.client)
+			obs, adoption, err := c.CreateOrAdopt(context.Background(), tc.args.params)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreateOrAdopt(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.adoption, adoption); diff != "" {
+				t.Errorf("\n%s\nCreateOrAdopt(...): -want adoption, +got adoption:\n%s\n", tc.reason, diff)
+			}
+			var id *string
+			if obs != nil {
+				id = obs.ID
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\n%s\nCreateOrAdopt(...): -want id, +got id:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetOrReadopt(t *testing.T) {
+	errNotFound := errors.New("workers domain not found")
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.DomainParameters{
+		AccountID: "test-account-id",
+		ZoneID:    "test-zone-id",
+		Hostname:  "api.example.com",
+		Service:   "api-worker",
+	}
+
+	withOverwrite := params
+	withOverwrite.OverwriteExisting = ptr.To(true)
+
+	recreated := cloudflare.WorkersDomain{
+		ID:       "recreated-id",
+		ZoneID:   "test-zone-id",
+		Hostname: "API.example.com",
+		Service:  "api-worker",
+	}
+
+	getNotFound := func(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) (cloudflare.WorkersDomain, error) {
+		return cloudflare.WorkersDomain{}, errNotFound
+	}
+
+	type want struct {
+		id       *string
+		notFound bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client *MockWorkersDomainAPI
+		params v1alpha1.DomainParameters
+		want   want
+	}{
+		"Found": {
+			reason: "GetOrReadopt should return the domain with the supplied ID without listing domains",
+			client: &MockWorkersDomainAPI{
+				MockGetWorkersDomain: func(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) (cloudflare.WorkersDomain, error) {
+					return cloudflare.WorkersDomain{ID: domainID}, nil
+				},
+				MockListWorkersDomains: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error) {
+					return nil, errors.New("should not list")
+				},
+			},
+			params: params,
+			want:   want{id: ptr.To("stored-id")},
+		},
+		"GetError": {
+			reason: "GetOrReadopt should return errors other than not found",
+			client: &MockWorkersDomainAPI{
+				MockGetWorkersDomain: func(ctx context.Context, rc *cloudflare.ResourceContainer, domainID string) (cloudflare.WorkersDomain, error) {
+					return cloudflare.WorkersDomain{}, errBoom
+				},
+			},
+			params: params,
+			want:   want{err: errors.Wrap(errBoom, "cannot get workers domain")},
+		},
+		"Readopted": {
+			reason: "GetOrReadopt should return the attachment of the hostname when the stored ID is not found",
+			client: &MockWorkersDomainAPI{
+				MockGetWorkersDomain: getNotFound,
+				MockListWorkersDomains: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error) {
+					if p.Hostname != "api.example.com" || p.ZoneID != "test-zone-id" {
+						return nil, errors.New("wrong filter")
+					}
+					return []cloudflare.WorkersDomain{recreated}, nil
+				},
+			},
+			params: params,
+			want:   want{id: ptr.To("recreated-id")},
+		},
+		"OtherServiceWithoutOverwrite": {
+			reason: "GetOrReadopt should not adopt an attachment to another service unless overwriteExisting is set",
+			client: &MockWorkersDomainAPI{
+				MockGetWorkersDomain: getNotFound,
+				MockListWorkersDomains: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error) {
+					d := recreated
+					d.Service = "other-worker"
+					return []cloudflare.WorkersDomain{d}, nil
+				},
+			},
+			params: params,
+			want:   want{notFound: true},
+		},
+		"OtherServiceWithOverwrite": {
+			reason: "GetOrReadopt should adopt an attachment to another service when overwriteExisting is set",
+			client: &MockWorkersDomainAPI{
+				MockGetWorkersDomain: getNotFound,
+				MockListWorkersDomains: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error) {
+					d := recreated
+					d.Service = "other-worker"
+					return []cloudflare.WorkersDomain{d}, nil
+				},
+			},
+			params: withOverwrite,
+			want:   want{id: ptr.To("recreated-id")},
+		},
+		"NoAttachment": {
+			reason: "GetOrReadopt should report the domain as not found when the hostname is not attached",
+			client: &MockWorkersDomainAPI{
+				MockGetWorkersDomain: getNotFound,
+			},
+			params: params,
+			want:   want{notFound: true},
+		},
+		"ListError": {
+			reason: "GetOrReadopt should return an error when attachments cannot be listed",
+			client: &MockWorkersDomainAPI{
+				MockGetWorkersDomain: getNotFound,
+				MockListWorkersDomains: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListWorkersDomainParams) ([]cloudflare.WorkersDomain, error) {
+					return nil, errBoom
+				},
+			},
+			params: params,
+			want:   want{err: errors.Wrap(errBoom, "cannot list workers domains")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(tc.client)
+			obs, err := c.GetOrReadopt(context.Background(), "stored-id", tc.params)
+
+			if tc.want.notFound {
+				if !clients.IsNotFound(err) {
+					t.Errorf("\n%s\nGetOrReadopt(...): want not found error, got: %v", tc.reason, err)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetOrReadopt(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			var id *string
+			if obs != nil {
+				id = obs.ID
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\n%s\nGetOrReadopt(...): -want id, +got id:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	params := v1alpha1.DomainParameters{
+		AccountID:   "test-account-id",
+		ZoneID:      "test-zone-id",
+		Hostname:    "api.example.com",
+		Service:     "worker",
+		Environment: "production",
+	}
+
+	withEnvironment := func(env string) v1alpha1.DomainParameters {
+		p := params
+		p.Environment = env
+		return p
+	}
+
+	observe := func(env string) v1alpha1.DomainObservation {
+		return v1alpha1.DomainObservation{
+			ZoneID:      ptr.To("test-zone-id"),
+			Hostname:    ptr.To("api.example.com"),
+			Service:     ptr.To("worker"),
+			Environment: ptr.To(env),
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.DomainParameters
+		obs    v1alpha1.DomainObservation
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "An attachment matching the parameters should be up to date",
+			params: params,
+			obs:    observe("production"),
+			want:   true,
+		},
+		"ObservedDefaultEnvironment": {
+			reason: "An attachment reported without an environment should match production",
+			params: params,
+			obs:    observe(""),
+			want:   true,
+		},
+		"DesiredDefaultEnvironment": {
+			reason: "Parameters without an environment should match a production attachment",
+			params: withEnvironment(""),
+			obs:    observe("production"),
+			want:   true,
+		},
+		"BothDefaultEnvironment": {
+			reason: "Parameters and an attachment without an environment should match",
+			params: withEnvironment(""),
+			obs:    observe(""),
+			want:   true,
+		},
+		"EnvironmentChanged": {
+			reason: "An attachment to another environment should not be up to date",
+			params: withEnvironment("staging"),
+			obs:    observe(""),
+			want:   false,
+		},
+		"UnobservedEnvironment": {
+			reason: "An attachment whose environment was not observed should not be compared",
+			params: withEnvironment("staging"),
+			obs: v1alpha1.DomainObservation{
+				ZoneID:   ptr.To("test-zone-id"),
+				Hostname: ptr.To("api.example.com"),
+				Service:  ptr.To("worker"),
+			},
+			want: true,
+		},
+		"ServiceChanged": {
+			reason: "An attachment to another service should not be up to date",
+			params: params,
+			obs: func() v1alpha1.DomainObservation {
+				o := observe("production")
+				o.Service = ptr.To("other")
+				return o
+			}(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(&MockWorkersDomainAPI{})
+			got, err := c.IsUpToDate(context.Background(), tc.params, tc.obs)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCertificatePackStatus(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		status string
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		packs  []cloudflare.CertificatePack
+		err    error
+		want   want
+	}{
+		"NoPack": {
+			reason: "No status should be returned when no certificate pack covers the hostname",
+			packs: []cloudflare.CertificatePack{
+				{Hosts: []string{"example.com", "www.example.com"}, Status: "active"},
+			},
+		},
+		"ExactHost": {
+			reason: "The status of a certificate pack listing the hostname should be returned",
+			packs: []cloudflare.CertificatePack{
+				{Hosts: []string{"api.example.com"}, Status: "pending_validation"},
+			},
+			want: want{status: "pending_validation"},
+		},
+		"WildcardHost": {
+			reason: "A wildcard certificate pack should cover hostnames one label below it",
+			packs: []cloudflare.CertificatePack{
+				{Hosts: []string{"example.com", "*.example.com"}, Status: "active"},
+			},
+			want: want{status: "active"},
+		},
+		"PreferActive": {
+			reason: "An active certificate pack should be preferred over others covering the hostname",
+			packs: []cloudflare.CertificatePack{
+				{Hosts: []string{"api.example.com"}, Status: "validation_timed_out"},
+				{Hosts: []string{"*.example.com"}, Status: "active"},
+			},
+			want: want{status: "active"},
+		},
+		"Error": {
+			reason: "Errors listing certificate packs should be returned",
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, "cannot list certificate packs")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(&MockWorkersDomainAPI{
+				MockListCertificatePacks: func(ctx context.Context, zoneID string) ([]cloudflare.CertificatePack, error) {
+					return tc.packs, tc.err
+				},
+			})
+			got, err := c.CertificatePackStatus(context.Background(), "test-zone-id", "api.example.com")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCertificatePackStatus(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.status, got); diff != "" {
+				t.Errorf("\n%s\nCertificatePackStatus(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCertificateStatus(t *testing.T) {
+	cases := map[string]struct {
+		packStatus string
+		want       string
+	}{
+		"Active":            {packStatus: "active", want: CertificateActive},
+		"PendingValidation": {packStatus: "pending_validation", want: CertificatePending},
+		"NotRequested":      {packStatus: "", want: CertificatePending},
+		"TimedOut":          {packStatus: "issuance_timed_out", want: CertificateErrored},
+		"Expired":           {packStatus: "expired", want: CertificateErrored},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, CertificateStatus(tc.packStatus)); diff != "" {
+				t.Errorf("CertificateStatus(%q): -want, +got:\n%s\n", tc.packStatus, diff)
+			}
+		})
+	}
+}
@@ -36,12 +36,12 @@ const (
 
 // KVNamespaceClient provides operations for Workers KV Namespaces.
 type KVNamespaceClient struct {
-	client    clients.ClientInterface
+	client    clients.WorkersKVAPI
 	accountID string
 }
 
 // NewClient creates a new Workers KV Namespace client.
-func NewClient(client clients.ClientInterface) *KVNamespaceClient {
+func NewClient(client clients.WorkersKVAPI) *KVNamespaceClient {
 	return &KVNamespaceClient{
 		client:    client,
 		accountID: "", // Account ID will be retrieved when needed
@@ -26,11 +26,12 @@ import (
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/fake"
 )
 
 const (
-	testAccountID     = "test-account-id"
-	testNamespaceID   = "test-namespace-id"
+	testAccountID      = "test-account-id"
+	testNamespaceID    = "test-namespace-id"
 	testNamespaceTitle = "Test KV Namespace"
 )
 
@@ -44,9 +45,9 @@ func TestCreate(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		args        args
-		mockClient  func() clients.ClientInterface
-		want        want
+		args       args
+		mockClient func() clients.WorkersKVAPI
+		want       want
 	}{
 		"CreateSuccess": {
 			args: args{
@@ -54,22 +55,20 @@ func TestCreate(t *testing.T) {
 					Title: testNamespaceTitle,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("CreateWorkersKVNamespace", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.CreateWorkersKVNamespaceParams{
-						Title: testNamespaceTitle,
+			mockClient: func() clients.WorkersKVAPI {
+				return &fake.WorkersKVAPIMock{
+					CreateWorkersKVNamespaceFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkersKVNamespaceParams) (cloudflare.WorkersKVNamespaceResponse, error) {
+						return cloudflare.WorkersKVNamespaceResponse{
+							Result: cloudflare.WorkersKVNamespace{
+								ID:    testNamespaceID,
+								Title: testNamespaceTitle,
+							},
+						}, nil
 					},
-				).Return(cloudflare.WorkersKVNamespaceResponse{
-					Result: cloudflare.WorkersKVNamespace{
-						ID:    testNamespaceID,
-						Title: testNamespaceTitle,
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.KVNamespaceObservation{
@@ -84,17 +83,15 @@ func TestCreate(t *testing.T) {
 					Title: testNamespaceTitle,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("CreateWorkersKVNamespace", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.CreateWorkersKVNamespaceParams{
-						Title: testNamespaceTitle,
+			mockClient: func() clients.WorkersKVAPI {
+				return &fake.WorkersKVAPIMock{
+					CreateWorkersKVNamespaceFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkersKVNamespaceParams) (cloudflare.WorkersKVNamespaceResponse, error) {
+						return cloudflare.WorkersKVNamespaceResponse{}, errors.New("api error")
 					},
-				).Return(cloudflare.WorkersKVNamespaceResponse{}, errors.New("api error"))
-				return client
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+				}
 			},
 			want: want{
 				err: errors.New("cannot create workers kv namespace: api error"),
@@ -137,31 +134,31 @@ func TestGet(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkersKVAPI
 		want       want
 	}{
 		"GetSuccess": {
 			args: args{
 				namespaceID: testNamespaceID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkersKVNamespaces", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkersKVNamespacesParams{},
-				).Return([]cloudflare.WorkersKVNamespace{
-					{
-						ID:    testNamespaceID,
-						Title: testNamespaceTitle,
+			mockClient: func() clients.WorkersKVAPI {
+				return &fake.WorkersKVAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-					{
-						ID:    "other-namespace-id",
-						Title: "Other Namespace",
+					ListWorkersKVNamespacesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVNamespacesParams) ([]cloudflare.WorkersKVNamespace, *cloudflare.ResultInfo, error) {
+						return []cloudflare.WorkersKVNamespace{
+							{
+								ID:    testNamespaceID,
+								Title: testNamespaceTitle,
+							},
+							{
+								ID:    "other-namespace-id",
+								Title: "Other Namespace",
+							},
+						}, &cloudflare.ResultInfo{}, nil
 					},
-				}, &cloudflare.ResultInfo{}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.KVNamespaceObservation{
@@ -174,20 +171,20 @@ func TestGet(t *testing.T) {
 			args: args{
 				namespaceID: testNamespaceID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkersKVNamespaces", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkersKVNamespacesParams{},
-				).Return([]cloudflare.WorkersKVNamespace{
-					{
-						ID:    "other-namespace-id",
-						Title: "Other Namespace",
+			mockClient: func() clients.WorkersKVAPI {
+				return &fake.WorkersKVAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				}, &cloudflare.ResultInfo{}, nil)
-				return client
+					ListWorkersKVNamespacesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVNamespacesParams) ([]cloudflare.WorkersKVNamespace, *cloudflare.ResultInfo, error) {
+						return []cloudflare.WorkersKVNamespace{
+							{
+								ID:    "other-namespace-id",
+								Title: "Other Namespace",
+							},
+						}, &cloudflare.ResultInfo{}, nil
+					},
+				}
 			},
 			want: want{
 				err: clients.NewNotFoundError("kv namespace not found"),
@@ -197,15 +194,15 @@ func TestGet(t *testing.T) {
 			args: args{
 				namespaceID: testNamespaceID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("ListWorkersKVNamespaces", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkersKVNamespacesParams{},
-				).Return([]cloudflare.WorkersKVNamespace{}, &cloudflare.ResultInfo{}, errors.New("list error"))
-				return client
+			mockClient: func() clients.WorkersKVAPI {
+				return &fake.WorkersKVAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					ListWorkersKVNamespacesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVNamespacesParams) ([]cloudflare.WorkersKVNamespace, *cloudflare.ResultInfo, error) {
+						return []cloudflare.WorkersKVNamespace{}, &cloudflare.ResultInfo{}, errors.New("list error")
+					},
+				}
 			},
 			want: want{
 				err: errors.New("cannot get workers kv namespace: list error"),
@@ -249,7 +246,7 @@ func TestUpdate(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkersKVAPI
 		want       want
 	}{
 		"UpdateSuccess": {
@@ -259,29 +256,23 @@ func TestUpdate(t *testing.T) {
 					Title: "Updated Title",
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("UpdateWorkersKVNamespace", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.UpdateWorkersKVNamespaceParams{
-						NamespaceID: testNamespaceID,
-						Title:       "Updated Title",
+			mockClient: func() clients.WorkersKVAPI {
+				return &fake.WorkersKVAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				).Return(cloudflare.Response{}, nil)
-				// Mock the Get call after update
-				client.On("ListWorkersKVNamespaces", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.ListWorkersKVNamespacesParams{},
-				).Return([]cloudflare.WorkersKVNamespace{
-					{
-						ID:    testNamespaceID,
-						Title: "Updated Title",
+					ListWorkersKVNamespacesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVNamespacesParams) ([]cloudflare.WorkersKVNamespace, *cloudflare.ResultInfo, error) {
+						return []cloudflare.WorkersKVNamespace{
+							{
+								ID:    testNamespaceID,
+								Title: "Updated Title",
+							},
+						}, &cloudflare.ResultInfo{}, nil
 					},
-				}, &cloudflare.ResultInfo{}, nil)
-				return client
+					UpdateWorkersKVNamespaceFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkersKVNamespaceParams) (cloudflare.Response, error) {
+						return cloudflare.Response{}, nil
+					},
+				}
 			},
 			want: want{
 				obs: &v1alpha1.KVNamespaceObservation{
@@ -297,18 +288,15 @@ func TestUpdate(t *testing.T) {
 					Title: "Updated Title",
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("UpdateWorkersKVNamespace", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.UpdateWorkersKVNamespaceParams{
-						NamespaceID: testNamespaceID,
-						Title:       "Updated Title",
+			mockClient: func() clients.WorkersKVAPI {
+				return &fake.WorkersKVAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					UpdateWorkersKVNamespaceFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkersKVNamespaceParams) (cloudflare.Response, error) {
+						return cloudflare.Response{}, errors.New("update error")
 					},
-				).Return(cloudflare.Response{}, errors.New("update error"))
-				return client
+				}
 			},
 			want: want{
 				err: errors.New("cannot update workers kv namespace: update error"),
@@ -350,22 +338,22 @@ func TestDelete(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkersKVAPI
 		want       want
 	}{
 		"DeleteSuccess": {
 			args: args{
 				namespaceID: testNamespaceID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("DeleteWorkersKVNamespace", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testNamespaceID,
-				).Return(cloudflare.Response{}, nil)
-				return client
+			mockClient: func() clients.WorkersKVAPI {
+				return &fake.WorkersKVAPIMock{
+					DeleteWorkersKVNamespaceFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, namespaceID string) (cloudflare.Response, error) {
+						return cloudflare.Response{}, nil
+					},
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+				}
 			},
 			want: want{},
 		},
@@ -373,15 +361,15 @@ func TestDelete(t *testing.T) {
 			args: args{
 				namespaceID: testNamespaceID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("DeleteWorkersKVNamespace", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testNamespaceID,
-				).Return(cloudflare.Response{}, errors.New("delete error"))
-				return client
+			mockClient: func() clients.WorkersKVAPI {
+				return &fake.WorkersKVAPIMock{
+					DeleteWorkersKVNamespaceFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, namespaceID string) (cloudflare.Response, error) {
+						return cloudflare.Response{}, errors.New("delete error")
+					},
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+				}
 			},
 			want: want{
 				err: errors.New("cannot delete workers kv namespace: delete error"),
@@ -453,7 +441,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(&clients.MockClient{})
+			client := NewClient(&fake.WorkersKVAPIMock{})
 			isUpToDate, err := client.IsUpToDate(context.Background(), tc.args.params, tc.args.obs)
 
 			if err != nil {
@@ -466,4 +454,4 @@ func TestIsUpToDate(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
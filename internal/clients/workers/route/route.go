@@ -37,11 +37,11 @@ const (
 
 // RouteClient provides operations for Worker Routes.
 type RouteClient struct {
-	client clients.ClientInterface
+	client clients.WorkerRouteAPI
 }
 
 // NewClient creates a new Worker Route client.
-func NewClient(client clients.ClientInterface) *RouteClient {
+func NewClient(client clients.WorkerRouteAPI) *RouteClient {
 	return &RouteClient{
 		client: client,
 	}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/fake"
 )
 
 const (
@@ -47,7 +48,7 @@ func TestCreate(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerRouteAPI
 		want       want
 	}{
 		"CreateSuccessWithScript": {
@@ -58,23 +59,18 @@ func TestCreate(t *testing.T) {
 					Script:  &script,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("CreateWorkerRoute", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.CreateWorkerRouteParams{
-						Pattern: "example.com/*",
-						Script:  "test-script",
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					CreateWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error) {
+						return cloudflare.WorkerRouteResponse{
+							WorkerRoute: cloudflare.WorkerRoute{
+								ID:         testRouteID,
+								Pattern:    "example.com/*",
+								ScriptName: "test-script",
+							},
+						}, nil
 					},
-				).Return(cloudflare.WorkerRouteResponse{
-					WorkerRoute: cloudflare.WorkerRoute{
-						ID:         testRouteID,
-						Pattern:    "example.com/*",
-						ScriptName: "test-script",
-					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.RouteObservation{},
@@ -87,22 +83,17 @@ func TestCreate(t *testing.T) {
 					Pattern: "example.com/*",
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("CreateWorkerRoute", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.CreateWorkerRouteParams{
-						Pattern: "example.com/*",
-						Script:  "",
-					},
-				).Return(cloudflare.WorkerRouteResponse{
-					WorkerRoute: cloudflare.WorkerRoute{
-						ID:      testRouteID,
-						Pattern: "example.com/*",
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					CreateWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error) {
+						return cloudflare.WorkerRouteResponse{
+							WorkerRoute: cloudflare.WorkerRoute{
+								ID:      testRouteID,
+								Pattern: "example.com/*",
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.RouteObservation{},
@@ -116,17 +107,12 @@ func TestCreate(t *testing.T) {
 					Script:  &script,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("CreateWorkerRoute", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.CreateWorkerRouteParams{
-						Pattern: "example.com/*",
-						Script:  "test-script",
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					CreateWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error) {
+						return cloudflare.WorkerRouteResponse{}, errors.New("api error")
 					},
-				).Return(nil, errors.New("api error"))
-				return client
+				}
 			},
 			want: want{
 				err: errors.New("cannot create worker route: api error"),
@@ -170,7 +156,7 @@ func TestGet(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerRouteAPI
 		want       want
 	}{
 		"GetSuccess": {
@@ -178,27 +164,25 @@ func TestGet(t *testing.T) {
 				zoneID:  testZoneID,
 				routeID: testRouteID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("ListWorkerRoutes", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.ListWorkerRoutesParams{},
-				).Return(cloudflare.WorkerRoutesResponse{
-					Routes: []cloudflare.WorkerRoute{
-						{
-							ID:         testRouteID,
-							Pattern:    "example.com/*",
-							ScriptName: "test-script",
-						},
-						{
-							ID:         "other-route-id",
-							Pattern:    "other.com/*",
-							ScriptName: "other-script",
-						},
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					ListWorkerRoutesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+						return cloudflare.WorkerRoutesResponse{
+							Routes: []cloudflare.WorkerRoute{
+								{
+									ID:         testRouteID,
+									Pattern:    "example.com/*",
+									ScriptName: "test-script",
+								},
+								{
+									ID:         "other-route-id",
+									Pattern:    "other.com/*",
+									ScriptName: "other-script",
+								},
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.RouteObservation{},
@@ -209,22 +193,20 @@ func TestGet(t *testing.T) {
 				zoneID:  testZoneID,
 				routeID: "nonexistent-route-id",
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("ListWorkerRoutes", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.ListWorkerRoutesParams{},
-				).Return(cloudflare.WorkerRoutesResponse{
-					Routes: []cloudflare.WorkerRoute{
-						{
-							ID:         testRouteID,
-							Pattern:    "example.com/*",
-							ScriptName: "test-script",
-						},
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					ListWorkerRoutesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+						return cloudflare.WorkerRoutesResponse{
+							Routes: []cloudflare.WorkerRoute{
+								{
+									ID:         testRouteID,
+									Pattern:    "example.com/*",
+									ScriptName: "test-script",
+								},
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				err: clients.NewNotFoundError("worker route not found"),
@@ -235,14 +217,12 @@ func TestGet(t *testing.T) {
 				zoneID:  testZoneID,
 				routeID: testRouteID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("ListWorkerRoutes", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.ListWorkerRoutesParams{},
-				).Return(nil, errors.New("list error"))
-				return client
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					ListWorkerRoutesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+						return cloudflare.WorkerRoutesResponse{}, errors.New("list error")
+					},
+				}
 			},
 			want: want{
 				err: errors.New("cannot get worker route: list error"),
@@ -289,7 +269,7 @@ func TestUpdate(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerRouteAPI
 		want       want
 	}{
 		"UpdateSuccess": {
@@ -301,32 +281,23 @@ func TestUpdate(t *testing.T) {
 					Script:  &script,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("UpdateWorkerRoute", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.UpdateWorkerRouteParams{
-						ID:      testRouteID,
-						Pattern: "updated.example.com/*",
-						Script:  "updated-script",
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					ListWorkerRoutesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+						return cloudflare.WorkerRoutesResponse{
+							Routes: []cloudflare.WorkerRoute{
+								{
+									ID:         testRouteID,
+									Pattern:    "updated.example.com/*",
+									ScriptName: "updated-script",
+								},
+							},
+						}, nil
 					},
-				).Return(cloudflare.WorkerRouteResponse{}, nil)
-				// Mock the Get call that happens after update
-				client.On("ListWorkerRoutes", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.ListWorkerRoutesParams{},
-				).Return(cloudflare.WorkerRoutesResponse{
-					Routes: []cloudflare.WorkerRoute{
-						{
-							ID:         testRouteID,
-							Pattern:    "updated.example.com/*",
-							ScriptName: "updated-script",
-						},
+					UpdateWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error) {
+						return cloudflare.WorkerRouteResponse{}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.RouteObservation{},
@@ -341,18 +312,12 @@ func TestUpdate(t *testing.T) {
 					Script:  &script,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("UpdateWorkerRoute", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.UpdateWorkerRouteParams{
-						ID:      testRouteID,
-						Pattern: "updated.example.com/*",
-						Script:  "updated-script",
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					UpdateWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkerRouteParams) (cloudflare.WorkerRouteResponse, error) {
+						return cloudflare.WorkerRouteResponse{}, errors.New("update error")
 					},
-				).Return(nil, errors.New("update error"))
-				return client
+				}
 			},
 			want: want{
 				err: errors.New("cannot update worker route: update error"),
@@ -395,7 +360,7 @@ func TestDelete(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerRouteAPI
 		want       want
 	}{
 		"DeleteSuccess": {
@@ -403,14 +368,12 @@ func TestDelete(t *testing.T) {
 				zoneID:  testZoneID,
 				routeID: testRouteID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("DeleteWorkerRoute", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					testRouteID,
-				).Return(cloudflare.WorkerRouteResponse{}, nil)
-				return client
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					DeleteWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, routeID string) (cloudflare.WorkerRouteResponse, error) {
+						return cloudflare.WorkerRouteResponse{}, nil
+					},
+				}
 			},
 			want: want{
 				err: nil,
@@ -421,14 +384,12 @@ func TestDelete(t *testing.T) {
 				zoneID:  testZoneID,
 				routeID: testRouteID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("DeleteWorkerRoute", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					testRouteID,
-				).Return(nil, errors.New("delete error"))
-				return client
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					DeleteWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, routeID string) (cloudflare.WorkerRouteResponse, error) {
+						return cloudflare.WorkerRouteResponse{}, errors.New("delete error")
+					},
+				}
 			},
 			want: want{
 				err: errors.New("cannot delete worker route: delete error"),
@@ -439,14 +400,12 @@ func TestDelete(t *testing.T) {
 				zoneID:  testZoneID,
 				routeID: testRouteID,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("DeleteWorkerRoute", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					testRouteID,
-				).Return(nil, errors.New("Worker Route not found"))
-				return client
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					DeleteWorkerRouteFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, routeID string) (cloudflare.WorkerRouteResponse, error) {
+						return cloudflare.WorkerRouteResponse{}, errors.New("Worker Route not found")
+					},
+				}
 			},
 			want: want{
 				err: nil, // Not found errors should be ignored
@@ -490,7 +449,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerRouteAPI
 		want       want
 	}{
 		"UpToDateMatching": {
@@ -502,22 +461,20 @@ func TestIsUpToDate(t *testing.T) {
 					Script:  &script1,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("ListWorkerRoutes", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.ListWorkerRoutesParams{},
-				).Return(cloudflare.WorkerRoutesResponse{
-					Routes: []cloudflare.WorkerRoute{
-						{
-							ID:         testRouteID,
-							Pattern:    "example.com/*",
-							ScriptName: "test-script",
-						},
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					ListWorkerRoutesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+						return cloudflare.WorkerRoutesResponse{
+							Routes: []cloudflare.WorkerRoute{
+								{
+									ID:         testRouteID,
+									Pattern:    "example.com/*",
+									ScriptName: "test-script",
+								},
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				upToDate: true,
@@ -532,22 +489,20 @@ func TestIsUpToDate(t *testing.T) {
 					Script:  &script1,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("ListWorkerRoutes", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.ListWorkerRoutesParams{},
-				).Return(cloudflare.WorkerRoutesResponse{
-					Routes: []cloudflare.WorkerRoute{
-						{
-							ID:         testRouteID,
-							Pattern:    "example.com/*",
-							ScriptName: "test-script",
-						},
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					ListWorkerRoutesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+						return cloudflare.WorkerRoutesResponse{
+							Routes: []cloudflare.WorkerRoute{
+								{
+									ID:         testRouteID,
+									Pattern:    "example.com/*",
+									ScriptName: "test-script",
+								},
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				upToDate: false,
@@ -562,22 +517,20 @@ func TestIsUpToDate(t *testing.T) {
 					Script:  &script2,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("ListWorkerRoutes", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.ListWorkerRoutesParams{},
-				).Return(cloudflare.WorkerRoutesResponse{
-					Routes: []cloudflare.WorkerRoute{
-						{
-							ID:         testRouteID,
-							Pattern:    "example.com/*",
-							ScriptName: "test-script",
-						},
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					ListWorkerRoutesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+						return cloudflare.WorkerRoutesResponse{
+							Routes: []cloudflare.WorkerRoute{
+								{
+									ID:         testRouteID,
+									Pattern:    "example.com/*",
+									ScriptName: "test-script",
+								},
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				upToDate: false,
@@ -592,22 +545,20 @@ func TestIsUpToDate(t *testing.T) {
 					Script:  &script1,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("ListWorkerRoutes", 
-					context.Background(), 
-					cloudflare.ZoneIdentifier(testZoneID),
-					cloudflare.ListWorkerRoutesParams{},
-				).Return(cloudflare.WorkerRoutesResponse{
-					Routes: []cloudflare.WorkerRoute{
-						{
-							ID:         testRouteID,
-							Pattern:    "example.com/*",
-							ScriptName: "test-script",
-						},
+			mockClient: func() clients.WorkerRouteAPI {
+				return &fake.WorkerRouteAPIMock{
+					ListWorkerRoutesFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkerRoutesParams) (cloudflare.WorkerRoutesResponse, error) {
+						return cloudflare.WorkerRoutesResponse{
+							Routes: []cloudflare.WorkerRoute{
+								{
+									ID:         testRouteID,
+									Pattern:    "example.com/*",
+									ScriptName: "test-script",
+								},
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				upToDate: false,
@@ -682,4 +633,4 @@ func TestIsRouteNotFound(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package script
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+const (
+	errAssetsSession    = "cannot create worker assets upload session"
+	errAssetsUpload     = "cannot upload worker assets"
+	errAssetsIncomplete = "worker assets upload did not complete"
+)
+
+// An Asset is a static asset served by a Worker.
+type Asset struct {
+	// Path the asset is served at, e.g. /index.html.
+	Path string
+
+	// Data is the content of the asset.
+	Data []byte
+}
+
+// Hash returns the hash identifying the content of the asset in an upload
+// session. Like Wrangler it covers the base64 encoded content and the
+// extension of the asset, so that the same content is served with the same
+// content type, and is truncated to the 32 hex characters the API expects.
+func (a Asset) Hash() string {
+	sum := sha256.Sum256([]byte(base64.StdEncoding.EncodeToString(a.Data) + strings.TrimPrefix(path.Ext(a.Path), ".")))
+	return hex.EncodeToString(sum[:])[:32]
+}
+
+// contentType returns the content type the asset is uploaded with.
+func (a Asset) contentType() string {
+	if t := mime.TypeByExtension(path.Ext(a.Path)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+type assetsManifestEntry struct {
+	Hash string `json:"hash"`
+	Size int    `json:"size"`
+}
+
+// manifest returns the manifest of the supplied assets, keyed by the path
+// they are served at.
+func manifest(assets []Asset) map[string]assetsManifestEntry {
+	m := make(map[string]assetsManifestEntry, len(assets))
+	for _, a := range assets {
+		m[a.Path] = assetsManifestEntry{Hash: a.Hash(), Size: len(a.Data)}
+	}
+	return m
+}
+
+// ManifestHash returns a hash of the paths and contents of the supplied
+// assets, which changes whenever any of them does.
+func ManifestHash(assets []Asset) string {
+	m := manifest(assets)
+	paths := make([]string, 0, len(m))
+	for p := range m {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	h := sha256.New()
+	for _, p := range paths {
+		fmt.Fprintf(h, "%s\x00%s\n", p, m[p].Hash)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// AssetsSource returns a description of where the supplied assets are read
+// from. Assets are uploaded again whenever it changes.
+func AssetsSource(a *v1alpha1.WorkerAssets) string {
+	src := ptr.Deref(a.URL, "")
+	if a.Image != nil {
+		src = *a.Image
+		if a.Layer != nil {
+			src += "#" + *a.Layer
+		}
+	}
+	if d := strings.Trim(ptr.Deref(a.Directory, ""), "/"); d != "" {
+		src += "//" + d
+	}
+	return src
+}
+
+// AssetsObservation returns the observation of the supplied assets, read
+// from their source with the supplied manifest hash.
+func AssetsObservation(a *v1alpha1.WorkerAssets, manifestHash string, files int) *v1alpha1.WorkerAssetsObservation {
+	return &v1alpha1.WorkerAssetsObservation{
+		Source:           AssetsSource(a),
+		ManifestHash:     manifestHash,
+		Files:            int32(files), //nolint:gosec // The number of assets is bounded by maxAssets.
+		Binding:          a.Binding,
+		HTMLHandling:     a.HTMLHandling,
+		NotFoundHandling: a.NotFoundHandling,
+		RunWorkerFirst:   a.RunWorkerFirst,
+	}
+}
+
+// AssetsUpToDate returns true if the observed assets were uploaded from the
+// desired source, with the desired configuration.
+func AssetsUpToDate(desired *v1alpha1.WorkerAssets, observed *v1alpha1.WorkerAssetsObservation) bool {
+	if desired == nil || observed == nil {
+		return desired == nil && observed == nil
+	}
+	return observed.Source == AssetsSource(desired) &&
+		ptr.Equal(observed.Binding, desired.Binding) &&
+		ptr.Equal(observed.HTMLHandling, desired.HTMLHandling) &&
+		ptr.Equal(observed.NotFoundHandling, desired.NotFoundHandling) &&
+		ptr.Equal(observed.RunWorkerFirst, desired.RunWorkerFirst)
+}
+
+// AssetsClient reads the static assets of Workers from their source and
+// uploads them in assets upload sessions.
+type AssetsClient struct {
+	client    DeploymentsAPI
+	hc        *http.Client
+	baseURL   string
+	accountID string
+}
+
+// NewAssetsClient creates a new Worker assets client. Assets are uploaded
+// to the API at the supplied base URL using the supplied HTTP client, which
+// also fetches them from their source.
+func NewAssetsClient(client DeploymentsAPI, hc *http.Client, baseURL, accountID string) *AssetsClient {
+	return &AssetsClient{client: client, hc: hc, baseURL: baseURL, accountID: accountID}
+}
+
+type assetsUploadSession struct {
+	JWT     string     `json:"jwt"`
+	Buckets [][]string `json:"buckets"`
+}
+
+type assetsUploadResponse struct {
+	Success bool `json:"success"`
+	Errors  []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+	Result struct {
+		JWT string `json:"jwt"`
+	} `json:"result"`
+}
+
+func (c *AssetsClient) sessionEndpoint(scriptName string, dispatchNamespace *string) string {
+	if dispatchNamespace != nil && *dispatchNamespace != "" {
+		return fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s/scripts/%s/assets-upload-session", c.accountID, *dispatchNamespace, scriptName)
+	}
+	return fmt.Sprintf("/accounts/%s/workers/scripts/%s/assets-upload-session", c.accountID, scriptName)
+}
+
+// Upload uploads the supplied assets of a Worker and returns the token that
+// links them to the next upload of the Worker. Only assets the API does not
+// already hold are uploaded.
+func (c *AssetsClient) Upload(ctx context.Context, scriptName string, dispatchNamespace *string, assets []Asset) (string, error) {
+	body := struct {
+		Manifest map[string]assetsManifestEntry `json:"manifest"`
+	}{Manifest: manifest(assets)}
+	res, err := c.client.Raw(ctx, http.MethodPost, c.sessionEndpoint(scriptName, dispatchNamespace), body, nil)
+	if err != nil {
+		return "", errors.Wrap(err, errAssetsSession)
+	}
+	var s assetsUploadSession
+	if err := json.Unmarshal(res.Result, &s); err != nil {
+		return "", errors.Wrap(err, errAssetsSession)
+	}
+
+	// The session token completes the upload when every asset is held.
+	if len(s.Buckets) == 0 {
+		return s.JWT, nil
+	}
+
+	byHash := make(map[string]Asset, len(assets))
+	for _, a := range assets {
+		byHash[a.Hash()] = a
+	}
+
+	completion := ""
+	for _, bucket := range s.Buckets {
+		jwt, err := c.uploadBucket(ctx, s.JWT, bucket, byHash)
+		if err != nil {
+			return "", errors.Wrap(err, errAssetsUpload)
+		}
+		if jwt != "" {
+			completion = jwt
+		}
+	}
+	if completion == "" {
+		return "", errors.New(errAssetsIncomplete)
+	}
+	return completion, nil
+}
+
+// uploadBucket uploads the assets with the supplied hashes, authenticated
+// by the token of their upload session rather than the provider's
+// credentials. It returns the completion token once all buckets of the
+// session have been uploaded.
+func (c *AssetsClient) uploadBucket(ctx context.Context, token string, hashes []string, byHash map[string]Asset) (string, error) {
+	buf := &bytes.Buffer{}
+	mpw := multipart.NewWriter(buf)
+	for _, h := range hashes {
+		a, ok := byHash[h]
+		if !ok {
+			return "", errors.Errorf("no asset has hash %q", h)
+		}
+		hdr := textproto.MIMEHeader{}
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"; filename="%[1]s"`, h))
+		hdr.Set("content-type", a.contentType())
+		w, err := mpw.CreatePart(hdr)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write([]byte(base64.StdEncoding.EncodeToString(a.Data))); err != nil {
+			return "", err
+		}
+	}
+	if err := mpw.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/accounts/%s/workers/assets/upload?base64=true", c.baseURL, c.accountID), buf)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", mpw.FormDataContentType())
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close() //nolint:errcheck // Only the body is read.
+
+	var r assetsUploadResponse
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return "", errors.Wrapf(err, "unexpected response %s", resp.Status)
+	}
+	if resp.StatusCode >= http.StatusBadRequest || !r.Success {
+		msgs := make([]string, 0, len(r.Errors))
+		for _, e := range r.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return "", errors.Errorf("%s: %s", resp.Status, strings.Join(msgs, "; "))
+	}
+	return r.Result.JWT, nil
+}
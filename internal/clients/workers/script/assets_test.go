@@ -0,0 +1,394 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package script
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+type tarEntry struct {
+	name     string
+	body     string
+	typeflag byte
+}
+
+func archive(t *testing.T, compress bool, entries ...tarEntry) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	var w io.Writer = buf
+	var zw *gzip.Writer
+	if compress {
+		zw = gzip.NewWriter(buf)
+		w = zw
+	}
+	tw := tar.NewWriter(w)
+	for _, e := range entries {
+		tf := e.typeflag
+		if tf == 0 {
+			tf = tar.TypeReg
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: e.name, Typeflag: tf, Mode: 0o644, Size: int64(len(e.body))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(e.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestReadAssets(t *testing.T) {
+	entries := []tarEntry{
+		{name: "dist/", typeflag: tar.TypeDir},
+		{name: "dist/index.html", body: "old"},
+		{name: "./dist/index.html", body: "<h1>hi</h1>"},
+		{name: "dist/css/site.css", body: "body{}"},
+		{name: "dist/.wh.removed.js"},
+		{name: "README.md", body: "readme"},
+	}
+
+	type want struct {
+		assets []Asset
+		err    bool
+	}
+
+	cases := map[string]struct {
+		reason   string
+		compress bool
+		dir      string
+		entries  []tarEntry
+		want     want
+	}{
+		"Directory": {
+			reason:  "Files within the directory should be served at their path within it, replaced by later entries",
+			dir:     "/dist/",
+			entries: entries,
+			want: want{assets: []Asset{
+				{Path: "/css/site.css", Data: []byte("body{}")},
+				{Path: "/index.html", Data: []byte("<h1>hi</h1>")},
+			}},
+		},
+		"Root": {
+			reason:   "All files of a gzip compressed archive should be read when no directory is set",
+			compress: true,
+			entries:  entries,
+			want: want{assets: []Asset{
+				{Path: "/README.md", Data: []byte("readme")},
+				{Path: "/dist/css/site.css", Data: []byte("body{}")},
+				{Path: "/dist/index.html", Data: []byte("<h1>hi</h1>")},
+			}},
+		},
+		"Empty": {
+			reason:  "An archive without files in the directory should be rejected",
+			dir:     "public",
+			entries: entries,
+			want:    want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ReadAssets(bytes.NewReader(archive(t, tc.compress, tc.entries...)), tc.dir)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nReadAssets(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.assets, got); diff != "" {
+				t.Errorf("\n%s\nReadAssets(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAssetsUpToDate(t *testing.T) {
+	desired := &v1alpha1.WorkerAssets{URL: ptr.To("https://example.com/site.tar.gz"), Directory: ptr.To("dist"), NotFoundHandling: ptr.To("404-page")}
+
+	cases := map[string]struct {
+		reason   string
+		desired  *v1alpha1.WorkerAssets
+		observed *v1alpha1.WorkerAssetsObservation
+		want     bool
+	}{
+		"NoAssets": {
+			reason: "A Worker without assets should be up to date",
+			want:   true,
+		},
+		"NotUploaded": {
+			reason:  "Assets that were never uploaded should not be up to date",
+			desired: desired,
+			want:    false,
+		},
+		"Removed": {
+			reason:   "Assets that are no longer desired should not be up to date",
+			observed: AssetsObservation(desired, "hash", 2),
+			want:     false,
+		},
+		"Unchanged": {
+			reason:   "Assets uploaded from the desired source with the desired configuration should be up to date",
+			desired:  desired,
+			observed: AssetsObservation(desired, "hash", 2),
+			want:     true,
+		},
+		"SourceChanged": {
+			reason:   "Assets uploaded from another source should not be up to date",
+			desired:  desired,
+			observed: AssetsObservation(&v1alpha1.WorkerAssets{URL: desired.URL, NotFoundHandling: desired.NotFoundHandling}, "hash", 2),
+			want:     false,
+		},
+		"ConfigChanged": {
+			reason:   "Assets uploaded with another configuration should not be up to date",
+			desired:  desired,
+			observed: AssetsObservation(&v1alpha1.WorkerAssets{URL: desired.URL, Directory: desired.Directory}, "hash", 2),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AssetsUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nAssetsUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpload(t *testing.T) {
+	assets := []Asset{
+		{Path: "/index.html", Data: []byte("<h1>hi</h1>")},
+		{Path: "/site.css", Data: []byte("body{}")},
+	}
+	index, css := assets[0].Hash(), assets[1].Hash()
+
+	type want struct {
+		jwt      string
+		uploaded map[string]string
+		err      bool
+	}
+
+	cases := map[string]struct {
+		reason  string
+		buckets [][]string
+		want    want
+	}{
+		"AllHeld": {
+			reason: "The session token should complete the upload when the API holds every asset",
+			want:   want{jwt: "session", uploaded: map[string]string{}},
+		},
+		"Buckets": {
+			reason:  "Each bucket should be uploaded with the session token, returning the completion token",
+			buckets: [][]string{{index}, {css}},
+			want: want{jwt: "complete", uploaded: map[string]string{
+				index: base64.StdEncoding.EncodeToString(assets[0].Data),
+				css:   base64.StdEncoding.EncodeToString(assets[1].Data),
+			}},
+		},
+		"UnknownHash": {
+			reason:  "A bucket holding an asset that was not offered should be an error",
+			buckets: [][]string{{"unknown"}},
+			want:    want{uploaded: map[string]string{}, err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			uploaded := map[string]string{}
+			remaining := len(tc.buckets)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/accounts/account-id/workers/assets/upload" || r.URL.Query().Get("base64") != "true" || r.Header.Get("Authorization") != "Bearer session" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+				if err := r.ParseMultipartForm(1 << 20); err != nil {
+					t.Fatal(err)
+				}
+				for h, fhs := range r.MultipartForm.File {
+					f, _ := fhs[0].Open()
+					data, _ := io.ReadAll(f)
+					uploaded[h] = string(data)
+				}
+				remaining--
+				jwt := ""
+				if remaining == 0 {
+					jwt = "complete"
+				}
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "result": map[string]string{"jwt": jwt}})
+			}))
+			defer srv.Close()
+
+			api := rawFn(func(_ context.Context, method, endpoint string, data interface{}, _ http.Header) (cloudflare.RawResponse, error) {
+				if method != http.MethodPost || endpoint != "/accounts/account-id/workers/scripts/site/assets-upload-session" {
+					t.Errorf("unexpected request %s %s", method, endpoint)
+				}
+				body, _ := json.Marshal(data)
+				want := fmt.Sprintf(`{"manifest":{"/index.html":{"hash":"%s","size":11},"/site.css":{"hash":"%s","size":6}}}`, index, css)
+				if diff := cmp.Diff(want, string(body)); diff != "" {
+					t.Errorf("session: -want, +got:\n%s", diff)
+				}
+				res, _ := json.Marshal(assetsUploadSession{JWT: "session", Buckets: tc.buckets})
+				return cloudflare.RawResponse{Result: res}, nil
+			})
+
+			c := NewAssetsClient(api, srv.Client(), srv.URL, "account-id")
+			jwt, err := c.Upload(context.Background(), "site", nil, assets)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nUpload(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.jwt, jwt); diff != "" {
+				t.Errorf("\n%s\nUpload(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.uploaded, uploaded); diff != "" {
+				t.Errorf("\n%s\nUpload(...): uploaded -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFetchImage(t *testing.T) {
+	base := archive(t, true, tarEntry{name: "etc/os-release", body: "scratch"})
+	site := archive(t, true, tarEntry{name: "site/index.html", body: "<h1>hi</h1>"})
+	digest := func(b []byte) string {
+		sum := sha256.Sum256(b)
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
+	blobs := map[string][]byte{digest(base): base, digest(site): site}
+
+	var srv *httptest.Server
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if r.URL.Query().Get("scope") != "repository:example/site:pull" {
+				t.Errorf("unexpected token scope %q", r.URL.Query().Get("scope"))
+			}
+			_, _ = w.Write([]byte(`{"token":"anonymous"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer anonymous" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, srv.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch p := strings.TrimPrefix(r.URL.Path, "/v2/example/site/"); {
+		case p == "manifests/v1":
+			_, _ = fmt.Fprintf(w, `{"manifests":[{"digest":"sha256:attestation","platform":{"os":"unknown"}},{"digest":"sha256:image","platform":{"os":"linux"}}]}`)
+		case p == "manifests/sha256:image":
+			_, _ = fmt.Fprintf(w, `{"layers":[{"digest":"%s"},{"digest":"%s"}]}`, digest(base), digest(site))
+		case strings.HasPrefix(p, "blobs/"):
+			_, _ = w.Write(blobs[strings.TrimPrefix(p, "blobs/")])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	registry := strings.TrimPrefix(srv.URL, "https://")
+
+	type want struct {
+		assets []Asset
+		err    bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		assets v1alpha1.WorkerAssets
+		want   want
+	}{
+		"LastLayer": {
+			reason: "Assets should be read from the last layer of the image by default",
+			assets: v1alpha1.WorkerAssets{Image: ptr.To(registry + "/example/site:v1"), Directory: ptr.To("site")},
+			want:   want{assets: []Asset{{Path: "/index.html", Data: []byte("<h1>hi</h1>")}}},
+		},
+		"Layer": {
+			reason: "Assets should be read from the layer with the supplied digest",
+			assets: v1alpha1.WorkerAssets{Image: ptr.To(registry + "/example/site:v1"), Layer: ptr.To(digest(base))},
+			want:   want{assets: []Asset{{Path: "/etc/os-release", Data: []byte("scratch")}}},
+		},
+		"NoSuchLayer": {
+			reason: "A layer the image does not have should be an error",
+			assets: v1alpha1.WorkerAssets{Image: ptr.To(registry + "/example/site:v1"), Layer: ptr.To("sha256:" + strings.Repeat("0", 64))},
+			want:   want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewAssetsClient(nil, srv.Client(), "", "account-id")
+			got, err := c.Fetch(context.Background(), tc.assets)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nFetch(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.assets, got); diff != "" {
+				t.Errorf("\n%s\nFetch(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParseImageRef(t *testing.T) {
+	cases := map[string]struct {
+		ref  string
+		want imageRef
+	}{
+		"DockerHubLibrary": {
+			ref:  "nginx",
+			want: imageRef{registry: dockerHub, repository: "library/nginx", reference: "latest"},
+		},
+		"DockerHubTag": {
+			ref:  "docker.io/example/site:v1",
+			want: imageRef{registry: dockerHub, repository: "example/site", reference: "v1"},
+		},
+		"RegistryPortDigest": {
+			ref:  "localhost:5000/site@sha256:abc",
+			want: imageRef{registry: "localhost:5000", repository: "site", reference: "sha256:abc"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseImageRef(tc.ref)
+			if err != nil {
+				t.Fatalf("parseImageRef(%q): %v", tc.ref, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(imageRef{})); diff != "" {
+				t.Errorf("parseImageRef(%q): -want, +got:\n%s\n", tc.ref, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package script
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+const (
+	errFetchAssets   = "cannot fetch worker assets"
+	errReadAssets    = "cannot read worker assets archive"
+	errPullImage     = "cannot pull worker assets image"
+	errImageRef      = "invalid image reference %q"
+	errNoAssets      = "worker assets archive holds no files"
+	errTooManyAssets = "worker assets archive holds more than %d files"
+	errAssetTooLarge = "worker asset %q is larger than %d bytes"
+	errAssetsTooBig  = "worker assets are larger than %d bytes in total"
+	errLayerNotFound = "image has no layer %q"
+	errLayerDigest   = "image layer does not match its digest %q"
+
+	// Limits of the assets of a Worker.
+	maxAssets      = 20000
+	maxAssetSize   = 25 << 20
+	maxAssetsTotal = 512 << 20
+
+	dockerHub         = "registry-1.docker.io"
+	mediaTypeManifest = "application/vnd.oci.image.manifest.v1+json, application/vnd.oci.image.index.v1+json, " +
+		"application/vnd.docker.distribution.manifest.v2+json, application/vnd.docker.distribution.manifest.list.v2+json"
+)
+
+// Fetch reads the supplied assets from the image layer or archive URL they
+// are sourced from.
+func (c *AssetsClient) Fetch(ctx context.Context, a v1alpha1.WorkerAssets) ([]Asset, error) {
+	dir := ptr.Deref(a.Directory, "")
+	if a.Image != nil {
+		assets, err := c.pullLayer(ctx, *a.Image, a.Layer, dir)
+		return assets, errors.Wrap(err, errPullImage)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ptr.Deref(a.URL, ""), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchAssets)
+	}
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, errFetchAssets)
+	}
+	defer resp.Body.Close() //nolint:errcheck // Only the body is read.
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("%s: unexpected response %s", errFetchAssets, resp.Status)
+	}
+	return ReadAssets(resp.Body, dir)
+}
+
+// ReadAssets reads the regular files of a tar archive, optionally gzip
+// compressed, as assets served at their path within the supplied directory
+// of the archive. Later entries replace earlier ones with the same path, as
+// they do when the archive is an image layer.
+func ReadAssets(r io.Reader, dir string) ([]Asset, error) {
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, errors.Wrap(err, errReadAssets)
+		}
+		defer zr.Close() //nolint:errcheck // Only the archive is read.
+		r = zr
+	} else {
+		r = br
+	}
+
+	prefix := path.Clean("/"+dir) + "/"
+	if prefix == "//" {
+		prefix = "/"
+	}
+
+	files := map[string][]byte{}
+	total := 0
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errReadAssets)
+		}
+		name := path.Clean("/" + h.Name)
+		if h.Typeflag != tar.TypeReg || !strings.HasPrefix(name, prefix) || strings.HasPrefix(path.Base(name), ".wh.") {
+			continue
+		}
+		if h.Size > maxAssetSize {
+			return nil, errors.Errorf(errAssetTooLarge, name, maxAssetSize)
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxAssetSize+1))
+		if err != nil {
+			return nil, errors.Wrap(err, errReadAssets)
+		}
+		p := "/" + strings.TrimPrefix(name, prefix)
+		total += len(data) - len(files[p])
+		if total > maxAssetsTotal {
+			return nil, errors.Errorf(errAssetsTooBig, maxAssetsTotal)
+		}
+		files[p] = data
+		if len(files) > maxAssets {
+			return nil, errors.Errorf(errTooManyAssets, maxAssets)
+		}
+	}
+	if len(files) == 0 {
+		return nil, errors.New(errNoAssets)
+	}
+
+	assets := make([]Asset, 0, len(files))
+	for p, data := range files {
+		assets = append(assets, Asset{Path: p, Data: data})
+	}
+	sort.Slice(assets, func(i, j int) bool { return assets[i].Path < assets[j].Path })
+	return assets, nil
+}
+
+// An imageRef refers to an image in an OCI registry.
+type imageRef struct {
+	registry   string
+	repository string
+	reference  string
+}
+
+// parseImageRef parses references such as ghcr.io/example/site:v1 or
+// nginx@sha256:<digest>. Images without a registry are pulled from Docker
+// Hub, and images without a tag or digest are pulled by their latest tag.
+func parseImageRef(s string) (imageRef, error) {
+	ref := imageRef{reference: "latest"}
+	name := s
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.reference = name[:i], name[i+1:]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.reference = name[:i], name[i+1:]
+	}
+
+	ref.registry = dockerHub
+	if i := strings.Index(name, "/"); i >= 0 && (strings.ContainsAny(name[:i], ".:") || name[:i] == "localhost") {
+		ref.registry, name = name[:i], name[i+1:]
+	}
+	if ref.registry == "docker.io" {
+		ref.registry = dockerHub
+	}
+	if ref.registry == dockerHub && !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+	ref.repository = name
+
+	if name == "" || ref.reference == "" {
+		return imageRef{}, errors.Errorf(errImageRef, s)
+	}
+	return ref, nil
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Platform  *struct {
+		OS string `json:"os"`
+	} `json:"platform,omitempty"`
+}
+
+type ociManifest struct {
+	Manifests []ociDescriptor `json:"manifests"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+// A registry pulls from an OCI registry, anonymously authenticating with
+// a bearer token when the registry asks for one.
+type registry struct {
+	hc    *http.Client
+	ref   imageRef
+	token string
+}
+
+func (r *registry) get(ctx context.Context, endpoint, accept string) (*http.Response, error) {
+	resp, err := r.do(ctx, endpoint, accept)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || r.token != "" {
+		return resp, err
+	}
+	challenge := resp.Header.Get("WWW-Authenticate")
+	_ = resp.Body.Close()
+	if err := r.authenticate(ctx, challenge); err != nil {
+		return nil, err
+	}
+	return r.do(ctx, endpoint, accept)
+}
+
+func (r *registry) do(ctx context.Context, endpoint, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s/v2/%s/%s", r.ref.registry, r.ref.repository, endpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+	return r.hc.Do(req)
+}
+
+// authenticate fetches an anonymous pull token as described by the supplied
+// WWW-Authenticate challenge.
+func (r *registry) authenticate(ctx context.Context, challenge string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return errors.Errorf("unsupported registry authentication %q", scheme)
+	}
+	p := map[string]string{}
+	for _, kv := range strings.Split(params, ",") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(kv), "="); ok {
+			p[k] = strings.Trim(v, `"`)
+		}
+	}
+	u, err := url.Parse(p["realm"])
+	if err != nil || p["realm"] == "" {
+		return errors.Errorf("invalid registry authentication realm %q", p["realm"])
+	}
+	q := u.Query()
+	if s := p["service"]; s != "" {
+		q.Set("service", s)
+	}
+	q.Set("scope", "repository:"+r.ref.repository+":pull")
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck // Only the body is read.
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("cannot get registry token: unexpected response %s", resp.Status)
+	}
+	var t struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return errors.Wrap(err, "cannot get registry token")
+	}
+	r.token = t.Token
+	if r.token == "" {
+		r.token = t.AccessToken
+	}
+	return nil
+}
+
+func (r *registry) manifest(ctx context.Context, reference string) (*ociManifest, error) {
+	resp, err := r.get(ctx, "manifests/"+reference, mediaTypeManifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck // Only the body is read.
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("cannot get manifest %q: unexpected response %s", reference, resp.Status)
+	}
+	m := &ociManifest{}
+	return m, errors.Wrapf(json.NewDecoder(resp.Body).Decode(m), "cannot decode manifest %q", reference)
+}
+
+// pullLayer reads the assets within the supplied directory of a layer of
+// an image, by default its last. Assets are platform independent, so the
+// first image of a multi-platform index is used.
+func (c *AssetsClient) pullLayer(ctx context.Context, image string, layer *string, dir string) ([]Asset, error) {
+	ref, err := parseImageRef(image)
+	if err != nil {
+		return nil, err
+	}
+	r := &registry{hc: c.hc, ref: ref}
+
+	m, err := r.manifest(ctx, ref.reference)
+	if err != nil {
+		return nil, err
+	}
+	if len(m.Manifests) > 0 {
+		d := m.Manifests[0]
+		for _, md := range m.Manifests {
+			// Attestations are listed with an unknown platform.
+			if md.Platform == nil || md.Platform.OS != "unknown" {
+				d = md
+				break
+			}
+		}
+		if m, err = r.manifest(ctx, d.Digest); err != nil {
+			return nil, err
+		}
+	}
+	if len(m.Layers) == 0 {
+		return nil, errors.New("image has no layers")
+	}
+
+	digest := m.Layers[len(m.Layers)-1].Digest
+	if layer != nil {
+		digest = ""
+		for _, l := range m.Layers {
+			if l.Digest == *layer {
+				digest = l.Digest
+			}
+		}
+		if digest == "" {
+			return nil, errors.Errorf(errLayerNotFound, *layer)
+		}
+	}
+
+	resp, err := r.get(ctx, "blobs/"+digest, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck // Only the body is read.
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("cannot get layer %q: unexpected response %s", digest, resp.Status)
+	}
+
+	h := sha256.New()
+	body := io.TeeReader(resp.Body, h)
+	assets, err := ReadAssets(body, dir)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		return nil, errors.Wrap(err, errReadAssets)
+	}
+	if "sha256:"+hex.EncodeToString(h.Sum(nil)) != digest {
+		return nil, errors.Errorf(errLayerDigest, digest)
+	}
+	return assets, nil
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package script
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	errListZones        = "cannot list zones"
+	errListRoutes       = "cannot list worker routes"
+	errListDomains      = "cannot list worker custom domains"
+	errListSchedules    = "cannot list worker cron triggers"
+	errListQueues       = "cannot list queues"
+	errDetachRoute      = "cannot detach worker route"
+	errDetachDomain     = "cannot detach worker custom domain"
+	errDetachSchedules  = "cannot detach worker cron triggers"
+	errDetachConsumer   = "cannot detach queue consumer"
+	errUnknownDependent = "unknown worker dependent kind"
+)
+
+// DependentKind is the kind of a resource that depends on a Worker.
+type DependentKind string
+
+// Kinds of resources that depend on a Worker.
+const (
+	DependentRoute         DependentKind = "Route"
+	DependentCustomDomain  DependentKind = "CustomDomain"
+	DependentCronTrigger   DependentKind = "CronTrigger"
+	DependentQueueConsumer DependentKind = "QueueConsumer"
+)
+
+// A Dependent is a resource that routes traffic or events to a Worker, and
+// would be left pointing at nothing were the Worker deleted.
+type Dependent struct {
+	Kind DependentKind
+
+	// Name describes the dependent, e.g. the pattern of a route or the
+	// hostname of a custom domain.
+	Name string
+
+	// ZoneID is the zone of a route.
+	ZoneID string
+
+	// ID of the dependent, or of the queue of a queue consumer.
+	ID string
+
+	// ConsumerID is the ID of a queue consumer.
+	ConsumerID string
+}
+
+// String describes the dependent for humans.
+func (d Dependent) String() string {
+	return fmt.Sprintf("%s %s", d.Kind, d.Name)
+}
+
+// DependentsAPI is the subset of the Cloudflare API used to find and
+// detach the resources that depend on a Worker.
+type DependentsAPI interface {
+	DeploymentsAPI
+	ListZonesContext(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error)
+}
+
+// DependentsClient finds and detaches the routes, custom domains, cron
+// triggers and queue consumers of a Worker.
+type DependentsClient struct {
+	client    DependentsAPI
+	accountID string
+}
+
+// NewDependentsClient creates a new Worker dependents client.
+func NewDependentsClient(client DependentsAPI, accountID string) *DependentsClient {
+	return &DependentsClient{client: client, accountID: accountID}
+}
+
+type workerRoute struct {
+	ID      string `json:"id"`
+	Pattern string `json:"pattern"`
+	Script  string `json:"script"`
+}
+
+type workerDomain struct {
+	ID       string `json:"id"`
+	Hostname string `json:"hostname"`
+	Service  string `json:"service"`
+}
+
+type workerSchedule struct {
+	Cron string `json:"cron"`
+}
+
+type workerSchedules struct {
+	Schedules []workerSchedule `json:"schedules"`
+}
+
+type queueConsumer struct {
+	ConsumerID string `json:"consumer_id"`
+	Script     string `json:"script"`
+	Service    string `json:"service"`
+}
+
+type queue struct {
+	ID        string          `json:"queue_id"`
+	Name      string          `json:"queue_name"`
+	Consumers []queueConsumer `json:"consumers"`
+}
+
+// List returns the resources that depend on the named Worker. Routes are
+// found by listing those of every zone of the account.
+func (c *DependentsClient) List(ctx context.Context, scriptName string) ([]Dependent, error) {
+	out, err := c.routes(ctx, scriptName)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/workers/domains?service=%s", c.accountID, url.QueryEscape(scriptName)), nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errListDomains)
+	}
+	var domains []workerDomain
+	if err := json.Unmarshal(res.Result, &domains); err != nil {
+		return nil, errors.Wrap(err, errListDomains)
+	}
+	for _, d := range domains {
+		// The service filter is not honoured by every API version.
+		if d.Service == scriptName {
+			out = append(out, Dependent{Kind: DependentCustomDomain, Name: d.Hostname, ID: d.ID})
+		}
+	}
+
+	res, err = c.client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/workers/scripts/%s/schedules", c.accountID, scriptName), nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errListSchedules)
+	}
+	var s workerSchedules
+	if err := json.Unmarshal(res.Result, &s); err != nil {
+		return nil, errors.Wrap(err, errListSchedules)
+	}
+	for _, sc := range s.Schedules {
+		out = append(out, Dependent{Kind: DependentCronTrigger, Name: sc.Cron})
+	}
+
+	res, err = c.client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/queues", c.accountID), nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errListQueues)
+	}
+	var queues []queue
+	if err := json.Unmarshal(res.Result, &queues); err != nil {
+		return nil, errors.Wrap(err, errListQueues)
+	}
+	for _, q := range queues {
+		for _, qc := range q.Consumers {
+			// Older consumers name their Worker as a service.
+			if qc.Script == scriptName || qc.Service == scriptName {
+				out = append(out, Dependent{Kind: DependentQueueConsumer, Name: q.Name, ID: q.ID, ConsumerID: qc.ConsumerID})
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func (c *DependentsClient) routes(ctx context.Context, scriptName string) ([]Dependent, error) {
+	zones, err := c.client.ListZonesContext(ctx, cloudflare.WithZoneFilters("", c.accountID, ""))
+	if err != nil {
+		return nil, errors.Wrap(err, errListZones)
+	}
+	var out []Dependent
+	for _, z := range zones.Result {
+		res, err := c.client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/workers/routes", z.ID), nil, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, errListRoutes)
+		}
+		var routes []workerRoute
+		if err := json.Unmarshal(res.Result, &routes); err != nil {
+			return nil, errors.Wrap(err, errListRoutes)
+		}
+		for _, r := range routes {
+			if r.Script == scriptName {
+				out = append(out, Dependent{Kind: DependentRoute, Name: r.Pattern, ZoneID: z.ID, ID: r.ID})
+			}
+		}
+	}
+	return out, nil
+}
+
+// Detach removes the supplied dependent of the named Worker. Routes and
+// custom domains are deleted, as they cannot exist without a Worker, while
+// cron triggers are cleared and queue consumers removed from their queue.
+func (c *DependentsClient) Detach(ctx context.Context, scriptName string, d Dependent) error {
+	switch d.Kind {
+	case DependentRoute:
+		_, err := c.client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/workers/routes/%s", d.ZoneID, d.ID), nil, nil)
+		return errors.Wrap(resourceGone(err), errDetachRoute)
+	case DependentCustomDomain:
+		_, err := c.client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/workers/domains/%s", c.accountID, d.ID), nil, nil)
+		return errors.Wrap(resourceGone(err), errDetachDomain)
+	case DependentCronTrigger:
+		_, err := c.client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/workers/scripts/%s/schedules", c.accountID, scriptName), []workerSchedule{}, nil)
+		return errors.Wrap(err, errDetachSchedules)
+	case DependentQueueConsumer:
+		_, err := c.client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/queues/%s/consumers/%s", c.accountID, d.ID, d.ConsumerID), nil, nil)
+		return errors.Wrap(resourceGone(err), errDetachConsumer)
+	}
+	return errors.Errorf("%s: %s", errUnknownDependent, d.Kind)
+}
+
+// resourceGone treats a dependent that no longer exists as detached.
+func resourceGone(err error) error {
+	var nf *cloudflare.NotFoundError
+	if errors.As(err, &nf) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package script
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type fakeDependentsAPI struct {
+	rawFn
+	zones []cloudflare.Zone
+}
+
+func (f fakeDependentsAPI) ListZonesContext(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error) {
+	return cloudflare.ZonesResponse{Result: f.zones}, nil
+}
+
+func TestListDependents(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		deps []Dependent
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		responses map[string]string
+		err       error
+		want      want
+	}{
+		"None": {
+			reason: "A Worker nothing depends on should have no dependents",
+			responses: map[string]string{
+				"/zones/z1/workers/routes": `[{"id":"r1","pattern":"example.com/*","script":"other"}]`,
+				"/accounts/" + testAccountID + "/workers/domains?service=" + testScriptName: `[]`,
+				"/accounts/" + testAccountID + "/workers/scripts/" + testScriptName + "/schedules": `{"schedules":[]}`,
+				"/accounts/" + testAccountID + "/queues": `[]`,
+			},
+			want: want{},
+		},
+		"All": {
+			reason: "Routes, custom domains, cron triggers and queue consumers of the Worker should be returned",
+			responses: map[string]string{
+				"/zones/z1/workers/routes": `[{"id":"r1","pattern":"example.com/*","script":"` + testScriptName + `"},{"id":"r2","pattern":"example.com/other/*","script":"other"}]`,
+				"/accounts/" + testAccountID + "/workers/domains?service=" + testScriptName: `[{"id":"d1","hostname":"api.example.com","service":"` + testScriptName + `"},{"id":"d2","hostname":"www.example.com","service":"other"}]`,
+				"/accounts/" + testAccountID + "/workers/scripts/" + testScriptName + "/schedules": `{"schedules":[{"cron":"*/5 * * * *"}]}`,
+				"/accounts/" + testAccountID + "/queues": `[{"queue_id":"q1","queue_name":"jobs","consumers":[{"consumer_id":"c1","script":"` + testScriptName + `"},{"consumer_id":"c2","script":"other"}]},{"queue_id":"q2","queue_name":"legacy","consumers":[{"consumer_id":"c3","service":"` + testScriptName + `"}]}]`,
+			},
+			want: want{deps: []Dependent{
+				{Kind: DependentRoute, Name: "example.com/*", ZoneID: "z1", ID: "r1"},
+				{Kind: DependentCustomDomain, Name: "api.example.com", ID: "d1"},
+				{Kind: DependentCronTrigger, Name: "*/5 * * * *"},
+				{Kind: DependentQueueConsumer, Name: "jobs", ID: "q1", ConsumerID: "c1"},
+				{Kind: DependentQueueConsumer, Name: "legacy", ID: "q2", ConsumerID: "c3"},
+			}},
+		},
+		"Error": {
+			reason: "Errors listing routes should be returned",
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errListRoutes)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewDependentsClient(fakeDependentsAPI{
+				zones: []cloudflare.Zone{{ID: "z1"}},
+				rawFn: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					if tc.err != nil {
+						return cloudflare.RawResponse{}, tc.err
+					}
+					res, ok := tc.responses[endpoint]
+					if !ok {
+						return cloudflare.RawResponse{}, errors.Errorf("unexpected endpoint %s", endpoint)
+					}
+					return cloudflare.RawResponse{Result: json.RawMessage(res)}, nil
+				},
+			}, testAccountID)
+			got, err := c.List(context.Background(), testScriptName)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nList(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deps, got); diff != "" {
+				t.Errorf("\n%s\nList(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDetachDependent(t *testing.T) {
+	type call struct {
+		method   string
+		endpoint string
+	}
+
+	cases := map[string]struct {
+		reason string
+		dep    Dependent
+		err    error
+		want   call
+		result error
+	}{
+		"Route": {
+			reason: "Routes should be deleted from their zone",
+			dep:    Dependent{Kind: DependentRoute, ZoneID: "z1", ID: "r1"},
+			want:   call{method: http.MethodDelete, endpoint: "/zones/z1/workers/routes/r1"},
+		},
+		"CustomDomain": {
+			reason: "Custom domains should be deleted",
+			dep:    Dependent{Kind: DependentCustomDomain, ID: "d1"},
+			want:   call{method: http.MethodDelete, endpoint: "/accounts/" + testAccountID + "/workers/domains/d1"},
+		},
+		"CronTrigger": {
+			reason: "Cron triggers should be cleared",
+			dep:    Dependent{Kind: DependentCronTrigger, Name: "*/5 * * * *"},
+			want:   call{method: http.MethodPut, endpoint: "/accounts/" + testAccountID + "/workers/scripts/" + testScriptName + "/schedules"},
+		},
+		"QueueConsumer": {
+			reason: "Queue consumers should be removed from their queue",
+			dep:    Dependent{Kind: DependentQueueConsumer, ID: "q1", ConsumerID: "c1"},
+			want:   call{method: http.MethodDelete, endpoint: "/accounts/" + testAccountID + "/queues/q1/consumers/c1"},
+		},
+		"AlreadyGone": {
+			reason: "A dependent that no longer exists should be treated as detached",
+			dep:    Dependent{Kind: DependentRoute, ZoneID: "z1", ID: "r1"},
+			err:    &cloudflare.NotFoundError{},
+			want:   call{method: http.MethodDelete, endpoint: "/zones/z1/workers/routes/r1"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got call
+			c := NewDependentsClient(fakeDependentsAPI{
+				rawFn: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					got = call{method: method, endpoint: endpoint}
+					return cloudflare.RawResponse{}, tc.err
+				},
+			}, testAccountID)
+			err := c.Detach(context.Background(), testScriptName, tc.dep)
+			if diff := cmp.Diff(tc.result, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDetach(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(call{})); diff != "" {
+				t.Errorf("\n%s\nDetach(...): -want call, +got call:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package script
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+
+	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+const (
+	errListVersions    = "cannot list worker versions"
+	errListDeployments = "cannot list worker deployments"
+	errCreateDeploy    = "cannot create worker deployment"
+	errNoVersions      = "worker has no versions"
+
+	deploymentStrategyPercentage = "percentage"
+)
+
+// DeploymentsAPI is the subset of the Cloudflare API used to manage the
+// versions and deployments of a Worker, which cloudflare-go does not wrap.
+type DeploymentsAPI interface {
+	Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+}
+
+// DeploymentClient provides operations for Worker versions, deployments
+// and workers.dev availability.
+type DeploymentClient struct {
+	client    DeploymentsAPI
+	accountID string
+}
+
+// NewDeploymentClient creates a new Worker deployment client.
+func NewDeploymentClient(client DeploymentsAPI, accountID string) *DeploymentClient {
+	return &DeploymentClient{client: client, accountID: accountID}
+}
+
+type workerVersion struct {
+	ID string `json:"id"`
+}
+
+type workerVersions struct {
+	Items []workerVersion `json:"items"`
+}
+
+type deploymentVersion struct {
+	VersionID  string  `json:"version_id"`
+	Percentage float64 `json:"percentage"`
+}
+
+type workerDeployment struct {
+	ID       string              `json:"id,omitempty"`
+	Strategy string              `json:"strategy"`
+	Versions []deploymentVersion `json:"versions"`
+}
+
+type workerDeployments struct {
+	Deployments []workerDeployment `json:"deployments"`
+}
+
+func (c *DeploymentClient) endpoint(scriptName, path string) string {
+	return fmt.Sprintf("/accounts/%s/workers/scripts/%s/%s", c.accountID, scriptName, path)
+}
+
+// LatestVersion returns the ID of the most recently uploaded version of a
+// Worker.
+func (c *DeploymentClient) LatestVersion(ctx context.Context, scriptName string) (string, error) {
+	res, err := c.client.Raw(ctx, http.MethodGet, c.endpoint(scriptName, "versions"), nil, nil)
+	if err != nil {
+		return "", errors.Wrap(err, errListVersions)
+	}
+	var v workerVersions
+	if err := json.Unmarshal(res.Result, &v); err != nil {
+		return "", errors.Wrap(err, errListVersions)
+	}
+	// Versions are listed most recent first.
+	if len(v.Items) == 0 {
+		return "", errors.New(errNoVersions)
+	}
+	return v.Items[0].ID, nil
+}
+
+// Current returns the deployment currently serving a Worker, or nil if it
+// has never been deployed.
+func (c *DeploymentClient) Current(ctx context.Context, scriptName string) (*v1alpha1.ScriptDeployment, error) {
+	res, err := c.client.Raw(ctx, http.MethodGet, c.endpoint(scriptName, "deployments"), nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errListDeployments)
+	}
+	var d workerDeployments
+	if err := json.Unmarshal(res.Result, &d); err != nil {
+		return nil, errors.Wrap(err, errListDeployments)
+	}
+	// Deployments are listed most recent first.
+	if len(d.Deployments) == 0 {
+		return nil, nil
+	}
+	dep := &v1alpha1.ScriptDeployment{ID: d.Deployments[0].ID}
+	for _, v := range d.Deployments[0].Versions {
+		dep.Versions = append(dep.Versions, v1alpha1.DeploymentVersion{VersionID: v.VersionID, Percentage: int32(math.Round(v.Percentage))})
+	}
+	return dep, nil
+}
+
+// Deploy creates a deployment serving the supplied versions.
+func (c *DeploymentClient) Deploy(ctx context.Context, scriptName string, versions []v1alpha1.DeploymentVersion) error {
+	body := workerDeployment{Strategy: deploymentStrategyPercentage}
+	for _, v := range versions {
+		body.Versions = append(body.Versions, deploymentVersion{VersionID: v.VersionID, Percentage: float64(v.Percentage)})
+	}
+	_, err := c.client.Raw(ctx, http.MethodPost, c.endpoint(scriptName, "deployments"), body, nil)
+	return errors.Wrap(err, errCreateDeploy)
+}
+
+// DesiredDeployment returns the versions that should serve a Worker given
+// its parameters and the ID of its most recently uploaded version.
+func DesiredDeployment(params v1alpha1.ScriptParameters, latestVersionID string) []v1alpha1.DeploymentVersion {
+	switch {
+	case params.RollbackToVersion != nil:
+		return []v1alpha1.DeploymentVersion{{VersionID: *params.RollbackToVersion, Percentage: 100}}
+	case params.GradualRollout != nil:
+		g := params.GradualRollout
+		out := []v1alpha1.DeploymentVersion{}
+		if g.Percentage > 0 {
+			out = append(out, v1alpha1.DeploymentVersion{VersionID: latestVersionID, Percentage: g.Percentage})
+		}
+		if g.Percentage < 100 {
+			out = append(out, v1alpha1.DeploymentVersion{VersionID: g.PreviousVersionID, Percentage: 100 - g.Percentage})
+		}
+		return out
+	default:
+		return []v1alpha1.DeploymentVersion{{VersionID: latestVersionID, Percentage: 100}}
+	}
+}
+
+// DeploymentUpToDate returns true if the supplied deployment serves exactly
+// the desired versions, in any order.
+func DeploymentUpToDate(desired []v1alpha1.DeploymentVersion, current *v1alpha1.ScriptDeployment) bool {
+	if current == nil || len(current.Versions) != len(desired) {
+		return false
+	}
+	want := map[string]int32{}
+	for _, v := range desired {
+		want[v.VersionID] = v.Percentage
+	}
+	for _, v := range current.Versions {
+		if p, ok := want[v.VersionID]; !ok || p != v.Percentage {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package script
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+)
+
+type rawFn func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+
+func (fn rawFn) Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	return fn(ctx, method, endpoint, data, headers)
+}
+
+func TestDesiredDeployment(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.ScriptParameters
+		want   []v1alpha1.DeploymentVersion
+	}{
+		"Latest": {
+			reason: "The latest version should serve all traffic by default",
+			params: v1alpha1.ScriptParameters{},
+			want:   []v1alpha1.DeploymentVersion{{VersionID: "v3", Percentage: 100}},
+		},
+		"Rollback": {
+			reason: "The rollback version should serve all traffic when set",
+			params: v1alpha1.ScriptParameters{RollbackToVersion: ptr.To("v1")},
+			want:   []v1alpha1.DeploymentVersion{{VersionID: "v1", Percentage: 100}},
+		},
+		"Gradual": {
+			reason: "Traffic should be split between the latest and previous version",
+			params: v1alpha1.ScriptParameters{GradualRollout: &v1alpha1.GradualRollout{PreviousVersionID: "v2", Percentage: 10}},
+			want: []v1alpha1.DeploymentVersion{
+				{VersionID: "v3", Percentage: 10},
+				{VersionID: "v2", Percentage: 90},
+			},
+		},
+		"GradualComplete": {
+			reason: "A version receiving no traffic should be left out of the deployment",
+			params: v1alpha1.ScriptParameters{GradualRollout: &v1alpha1.GradualRollout{PreviousVersionID: "v2", Percentage: 100}},
+			want:   []v1alpha1.DeploymentVersion{{VersionID: "v3", Percentage: 100}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DesiredDeployment(tc.params, "v3")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDesiredDeployment(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeploymentUpToDate(t *testing.T) {
+	desired := []v1alpha1.DeploymentVersion{{VersionID: "v3", Percentage: 10}, {VersionID: "v2", Percentage: 90}}
+
+	cases := map[string]struct {
+		reason  string
+		current *v1alpha1.ScriptDeployment
+		want    bool
+	}{
+		"NeverDeployed": {
+			reason: "A Worker without a deployment should not be up to date",
+			want:   false,
+		},
+		"SameVersionsAnyOrder": {
+			reason: "A deployment serving the desired versions in any order should be up to date",
+			current: &v1alpha1.ScriptDeployment{Versions: []v1alpha1.DeploymentVersion{
+				{VersionID: "v2", Percentage: 90},
+				{VersionID: "v3", Percentage: 10},
+			}},
+			want: true,
+		},
+		"DifferentSplit": {
+			reason: "A deployment with a different traffic split should not be up to date",
+			current: &v1alpha1.ScriptDeployment{Versions: []v1alpha1.DeploymentVersion{
+				{VersionID: "v3", Percentage: 50},
+				{VersionID: "v2", Percentage: 50},
+			}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DeploymentUpToDate(desired, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDeploymentUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCurrent(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		dep *v1alpha1.ScriptDeployment
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		result string
+		err    error
+		want   want
+	}{
+		"MostRecent": {
+			reason: "The most recent deployment should be returned",
+			result: `{"deployments":[{"id":"d2","strategy":"percentage","versions":[{"version_id":"v2","percentage":66.7},{"version_id":"v1","percentage":33.3}]},{"id":"d1","strategy":"percentage","versions":[{"version_id":"v1","percentage":100}]}]}`,
+			want: want{dep: &v1alpha1.ScriptDeployment{ID: "d2", Versions: []v1alpha1.DeploymentVersion{
+				{VersionID: "v2", Percentage: 67},
+				{VersionID: "v1", Percentage: 33},
+			}}},
+		},
+		"None": {
+			reason: "No deployment should be returned for a Worker that has never been deployed",
+			result: `{"deployments":[]}`,
+			want:   want{},
+		},
+		"Error": {
+			reason: "Errors listing deployments should be returned",
+			err:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errListDeployments)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewDeploymentClient(rawFn(func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				if endpoint != "/accounts/"+testAccountID+"/workers/scripts/"+testScriptName+"/deployments" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected endpoint %s", endpoint)
+				}
+				return cloudflare.RawResponse{Result: json.RawMessage(tc.result)}, tc.err
+			}), testAccountID)
+			got, err := c.Current(context.Background(), testScriptName)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCurrent(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.dep, got); diff != "" {
+				t.Errorf("\n%s\nCurrent(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeploy(t *testing.T) {
+	var got interface{}
+	c := NewDeploymentClient(rawFn(func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+		if method != http.MethodPost {
+			return cloudflare.RawResponse{}, errors.Errorf("unexpected method %s", method)
+		}
+		got = data
+		return cloudflare.RawResponse{}, nil
+	}), testAccountID)
+
+	err := c.Deploy(context.Background(), testScriptName, []v1alpha1.DeploymentVersion{{VersionID: "v1", Percentage: 100}})
+	if err != nil {
+		t.Fatalf("Deploy(...): %v", err)
+	}
+	want := workerDeployment{Strategy: "percentage", Versions: []deploymentVersion{{VersionID: "v1", Percentage: 100}}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Deploy(...): -want body, +got body:\n%s\n", diff)
+	}
+}
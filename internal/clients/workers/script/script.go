@@ -18,7 +18,9 @@ package script
 
 import (
 	"context"
+	"maps"
 	"math"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -26,9 +28,11 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/rawjson"
 )
 
 const (
@@ -38,6 +42,7 @@ const (
 	errDeleteScript      = "cannot delete worker script"
 	errListScripts       = "cannot list worker scripts"
 	errGetScriptSettings = "cannot get worker script settings"
+	errDuplicateBinding  = "binding names must be unique, %q is used more than once"
 	
 	// Cache TTL for API responses within the same reconcile cycle
 	cacheTimeout = 30 * time.Second
@@ -53,6 +58,7 @@ type scriptCache struct {
 	workerData           map[string]*cachedWorkerData
 	scriptContent        map[string]*cachedScriptContent
 	scriptSettings       map[string]*cachedScriptSettings
+	scriptBindings       map[string]*cachedScriptBindings
 }
 
 type cachedWorkerData struct {
@@ -70,15 +76,20 @@ type cachedScriptSettings struct {
 	timestamp time.Time
 }
 
+type cachedScriptBindings struct {
+	bindings  []clients.WorkerScriptBinding
+	timestamp time.Time
+}
+
 // ScriptClient provides operations for Worker Scripts.
 type ScriptClient struct {
-	client    clients.ClientInterface
+	client    clients.WorkerScriptAPI
 	accountID string
 	cache     *scriptCache
 }
 
 // NewClient creates a new Worker Script client.
-func NewClient(client clients.ClientInterface) *ScriptClient {
+func NewClient(client clients.WorkerScriptAPI) *ScriptClient {
 	return &ScriptClient{
 		client:    client,
 		accountID: "", // Account ID will be retrieved when needed
@@ -86,6 +97,7 @@ func NewClient(client clients.ClientInterface) *ScriptClient {
 			workerData:     make(map[string]*cachedWorkerData),
 			scriptContent:  make(map[string]*cachedScriptContent),
 			scriptSettings: make(map[string]*cachedScriptSettings),
+			scriptBindings: make(map[string]*cachedScriptBindings),
 		},
 	}
 }
@@ -170,6 +182,27 @@ func (c *ScriptClient) setScriptSettingsInCache(scriptName string, settings clou
 	}
 }
 
+func (c *ScriptClient) getScriptBindingsFromCache(scriptName string) ([]clients.WorkerScriptBinding, bool) {
+	c.cache.mu.RLock()
+	defer c.cache.mu.RUnlock()
+
+	cached, exists := c.cache.scriptBindings[scriptName]
+	if !exists || time.Since(cached.timestamp) > cacheTimeout {
+		return nil, false
+	}
+	return cached.bindings, true
+}
+
+func (c *ScriptClient) setScriptBindingsInCache(scriptName string, bindings []clients.WorkerScriptBinding) {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	c.cache.scriptBindings[scriptName] = &cachedScriptBindings{
+		bindings:  bindings,
+		timestamp: time.Now(),
+	}
+}
+
 // isRateLimitError checks if an error is due to rate limiting
 func isRateLimitError(err error) bool {
 	if err == nil {
@@ -219,6 +252,23 @@ func (c *ScriptClient) retryWithBackoff(ctx context.Context, operation func() er
 	return errors.Wrap(lastErr, "max retries exceeded")
 }
 
+// validateBindings returns an error if two bindings, including the binding
+// of the Worker's assets, share a name, which the upload would otherwise
+// silently collapse into one.
+func validateBindings(params v1alpha1.ScriptParameters) error {
+	seen := make(map[string]bool, len(params.Bindings)+1)
+	for _, b := range params.Bindings {
+		if seen[b.Name] {
+			return errors.Errorf(errDuplicateBinding, b.Name)
+		}
+		seen[b.Name] = true
+	}
+	if a := params.Assets; a != nil && a.Binding != nil && seen[*a.Binding] {
+		return errors.Errorf(errDuplicateBinding, *a.Binding)
+	}
+	return nil
+}
+
 // convertToCloudflareBindings converts Crossplane bindings to cloudflare-go bindings.
 func convertToCloudflareBindings(bindings []v1alpha1.WorkerBinding) map[string]cloudflare.WorkerBinding {
 	cfBindings := make(map[string]cloudflare.WorkerBinding)
@@ -241,9 +291,26 @@ func convertToCloudflareBindings(bindings []v1alpha1.WorkerBinding) map[string]c
 				}
 			}
 		case "json_data":
-			if binding.JSON != nil {
+			if data := bindingJSON(binding); data != nil {
 				cfBindings[binding.Name] = cloudflare.WorkerInheritBinding{
-					OldName: *binding.JSON,
+					OldName: string(data),
+				}
+			}
+		case "service":
+			if binding.Service != nil {
+				cfBindings[binding.Name] = cloudflare.WorkerServiceBinding{
+					Service:     *binding.Service,
+					Environment: binding.Environment,
+				}
+			}
+		case "browser":
+			cfBindings[binding.Name] = clients.WorkerBrowserBinding{}
+		case "ratelimit":
+			if binding.NamespaceID != nil && binding.RateLimit != nil {
+				cfBindings[binding.Name] = clients.WorkerRateLimitBinding{
+					NamespaceID: *binding.NamespaceID,
+					Limit:       binding.RateLimit.Limit,
+					Period:      binding.RateLimit.Period,
 				}
 			}
 		}
@@ -252,8 +319,25 @@ func convertToCloudflareBindings(bindings []v1alpha1.WorkerBinding) map[string]c
 	return cfBindings
 }
 
+// bindingJSON returns the data of a JSON data binding, which may be
+// specified either as a string or as a structured value. Valid JSON is
+// normalized so that key order does not matter.
+func bindingJSON(b v1alpha1.WorkerBinding) []byte {
+	var data []byte
+	switch {
+	case b.JSON != nil:
+		data = []byte(*b.JSON)
+	case b.Value != nil:
+		data, _ = rawjson.FromExtension(b.Value)
+	}
+	if n, err := rawjson.Normalize(data); err == nil {
+		return n
+	}
+	return data
+}
+
 // convertToCloudflareConsumers converts Crossplane tail consumers to cloudflare-go consumers.
-func convertToCloudflareConsumers(consumers []v1alpha1.TailConsumer) *[]cloudflare.WorkersTailConsumer {
+func convertToCloudflareConsumers(consumers []v1alpha1.ScriptTailConsumer) *[]cloudflare.WorkersTailConsumer {
 	if len(consumers) == 0 {
 		return nil
 	}
@@ -270,8 +354,11 @@ func convertToCloudflareConsumers(consumers []v1alpha1.TailConsumer) *[]cloudfla
 	return &cfConsumers
 }
 
-// convertToCloudflareParams converts Crossplane parameters to cloudflare-go parameters.
-func convertToCloudflareParams(params v1alpha1.ScriptParameters) cloudflare.CreateWorkerParams {
+// convertToCloudflareParams converts Crossplane parameters to cloudflare-go
+// parameters. Assets are linked to the Worker by the supplied completion
+// token of their upload session, or kept from its previous version when
+// the token is empty.
+func convertToCloudflareParams(params v1alpha1.ScriptParameters, assetsJWT string) cloudflare.CreateWorkerParams {
 	createParams := cloudflare.CreateWorkerParams{
 		ScriptName: params.ScriptName,
 		Script:     params.Script,
@@ -310,6 +397,15 @@ func convertToCloudflareParams(params v1alpha1.ScriptParameters) cloudflare.Crea
 		createParams.DispatchNamespaceName = params.DispatchNamespace
 	}
 
+	if a := params.Assets; a != nil {
+		createParams.Bindings[ptr.Deref(a.Binding, "")] = clients.WorkerAssetsBinding{
+			JWT:              assetsJWT,
+			HTMLHandling:     ptr.Deref(a.HTMLHandling, ""),
+			NotFoundHandling: ptr.Deref(a.NotFoundHandling, ""),
+			RunWorkerFirst:   a.RunWorkerFirst,
+		}
+	}
+
 	return createParams
 }
 
@@ -353,9 +449,13 @@ func convertToObservation(metadata cloudflare.WorkerMetaData, script *cloudflare
 	return obs
 }
 
-// Create creates a new Worker script.
-func (c *ScriptClient) Create(ctx context.Context, params v1alpha1.ScriptParameters) (*v1alpha1.ScriptObservation, error) {
-	createParams := convertToCloudflareParams(params)
+// Create creates a new Worker script, linking it to the assets uploaded in
+// the session that returned the supplied token.
+func (c *ScriptClient) Create(ctx context.Context, params v1alpha1.ScriptParameters, assetsJWT string) (*v1alpha1.ScriptObservation, error) {
+	if err := validateBindings(params); err != nil {
+		return nil, errors.Wrap(err, errCreateScript)
+	}
+	createParams := convertToCloudflareParams(params, assetsJWT)
 	
 	accountID, err := c.getAccountID(ctx)
 	if err != nil {
@@ -402,6 +502,9 @@ func (c *ScriptClient) Get(ctx context.Context, scriptName string) (*v1alpha1.Sc
 				UsageModel:     cachedWorkerData.UsageModel,
 			}
 			obs := convertToObservation(cachedSettings.WorkerMetaData, &workerScript)
+			if err := c.observeBindings(ctx, scriptName, &obs); err != nil {
+				return nil, err
+			}
 			return &obs, nil
 		}
 	}
@@ -451,12 +554,69 @@ func (c *ScriptClient) Get(ctx context.Context, scriptName string) (*v1alpha1.Sc
 		UsageModel:     scriptResp.UsageModel,
 	}
 	obs := convertToObservation(settingsResp.WorkerMetaData, &workerScript)
+	if err := c.observeBindings(ctx, scriptName, &obs); err != nil {
+		return nil, err
+	}
 	return &obs, nil
 }
 
-// Update updates an existing Worker script.
-func (c *ScriptClient) Update(ctx context.Context, params v1alpha1.ScriptParameters) (*v1alpha1.ScriptObservation, error) {
-	createParams := convertToCloudflareParams(params)
+// observeBindings adds the plain text variables and the names of the
+// secrets bound to the Worker, as reported in its settings, to the supplied
+// observation.
+func (c *ScriptClient) observeBindings(ctx context.Context, scriptName string, obs *v1alpha1.ScriptObservation) error {
+	bindings, ok := c.getScriptBindingsFromCache(scriptName)
+	if !ok {
+		accountID, err := c.getAccountID(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to get account ID")
+		}
+		rc := cloudflare.AccountIdentifier(accountID)
+
+		err = c.retryWithBackoff(ctx, func() error {
+			bindings, err = c.client.GetWorkersScriptBindings(ctx, rc, scriptName)
+			return err
+		})
+		if err != nil {
+			return errors.Wrap(err, errGetScriptSettings)
+		}
+		c.setScriptBindingsInCache(scriptName, bindings)
+	}
+
+	for _, b := range bindings {
+		switch b.Type {
+		case cloudflare.WorkerPlainTextBindingType:
+			if obs.Vars == nil {
+				obs.Vars = make(map[string]string)
+			}
+			obs.Vars[b.Name] = b.Text
+		case cloudflare.WorkerSecretTextBindingType:
+			obs.Secrets = append(obs.Secrets, b.Name)
+		}
+	}
+	sort.Strings(obs.Secrets)
+	return nil
+}
+
+// desiredVars returns the plain text variables the supplied bindings bind
+// to a Worker, by name.
+func desiredVars(bindings []v1alpha1.WorkerBinding) map[string]string {
+	vars := make(map[string]string)
+	for _, b := range bindings {
+		if b.Type == "text_blob" && b.Text != nil {
+			vars[b.Name] = *b.Text
+		}
+	}
+	return vars
+}
+
+// Update updates an existing Worker script, linking it to the assets
+// uploaded in the session that returned the supplied token, or keeping its
+// current assets when the token is empty.
+func (c *ScriptClient) Update(ctx context.Context, params v1alpha1.ScriptParameters, assetsJWT string) (*v1alpha1.ScriptObservation, error) {
+	if err := validateBindings(params); err != nil {
+		return nil, errors.Wrap(err, errUpdateScript)
+	}
+	createParams := convertToCloudflareParams(params, assetsJWT)
 	
 	accountID, err := c.getAccountID(ctx)
 	if err != nil {
@@ -551,6 +711,12 @@ func (c *ScriptClient) IsUpToDate(ctx context.Context, params v1alpha1.ScriptPar
 		return false, nil
 	}
 
+	// Compare plain text variables, which may have been changed or added
+	// outside of Crossplane, e.g. with wrangler.
+	if !maps.Equal(desiredVars(params.Bindings), obs.Vars) {
+		return false, nil
+	}
+
 	// Try to get settings from cache first
 	var settingsResp cloudflare.WorkerScriptSettingsResponse
 	if cachedSettings, ok := c.getScriptSettingsFromCache(params.ScriptName); ok {
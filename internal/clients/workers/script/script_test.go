@@ -25,16 +25,20 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/fake"
 )
 
 const (
-	testAccountID = "test-account-id"
+	testAccountID  = "test-account-id"
 	testScriptName = "test-script"
-	testScript = `
+	testScript     = `
 		addEventListener('fetch', event => {
 			event.respondWith(new Response('Hello World!'))
 		})
@@ -42,7 +46,7 @@ const (
 )
 
 var (
-	testTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	testTime     = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	testMetaTime = metav1.Time{Time: testTime}
 )
 
@@ -56,9 +60,9 @@ func TestCreate(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		args        args
-		mockClient  func() clients.ClientInterface
-		want        want
+		args       args
+		mockClient func() clients.WorkerScriptAPI
+		want       want
 	}{
 		"CreateSuccess": {
 			args: args{
@@ -69,39 +73,32 @@ func TestCreate(t *testing.T) {
 					Logpush:    ptr.To(true),
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("UploadWorker", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.CreateWorkerParams{
-						ScriptName: testScriptName,
-						Script:     testScript,
-						Module:     false,
-						Logpush:    ptr.To(true),
-						Bindings:   map[string]cloudflare.WorkerBinding{},
-						Tags:       []string{},
-					},
-				).Return(cloudflare.WorkerScriptResponse{
-					WorkerScript: cloudflare.WorkerScript{
-						WorkerMetaData: cloudflare.WorkerMetaData{
-							ID:         "test-id",
-							ETAG:       "test-etag",
-							Size:       1024,
-							CreatedOn:  testTime,
-							ModifiedOn: testTime,
-						},
-						Script:     testScript,
-						UsageModel: "standard",
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					UploadWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerParams) (cloudflare.WorkerScriptResponse, error) {
+						return cloudflare.WorkerScriptResponse{
+							WorkerScript: cloudflare.WorkerScript{
+								WorkerMetaData: cloudflare.WorkerMetaData{
+									ID:         "test-id",
+									ETAG:       "test-etag",
+									Size:       1024,
+									CreatedOn:  testTime,
+									ModifiedOn: testTime,
+								},
+								Script:     testScript,
+								UsageModel: "standard",
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.ScriptObservation{
 					ID:         "test-id",
-					ETAG:       "test-etag", 
+					ETAG:       "test-etag",
 					Size:       1024,
 					CreatedOn:  &testMetaTime,
 					ModifiedOn: &testMetaTime,
@@ -128,35 +125,23 @@ func TestCreate(t *testing.T) {
 					},
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("UploadWorker", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.CreateWorkerParams{
-						ScriptName: testScriptName,
-						Script:     testScript,
-						Bindings: map[string]cloudflare.WorkerBinding{
-							"MY_KV": cloudflare.WorkerKvNamespaceBinding{
-								NamespaceID: "test-namespace-id",
-							},
-							"MY_TEXT": cloudflare.WorkerPlainTextBinding{
-								Text: "Hello World",
-							},
-						},
-						Tags: []string{},
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				).Return(cloudflare.WorkerScriptResponse{
-					WorkerScript: cloudflare.WorkerScript{
-						WorkerMetaData: cloudflare.WorkerMetaData{
-							ID:   "test-id",
-							Size: 1024,
-						},
-						Script: testScript,
+					UploadWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerParams) (cloudflare.WorkerScriptResponse, error) {
+						return cloudflare.WorkerScriptResponse{
+							WorkerScript: cloudflare.WorkerScript{
+								WorkerMetaData: cloudflare.WorkerMetaData{
+									ID:   "test-id",
+									Size: 1024,
+								},
+								Script: testScript,
+							},
+						}, nil
 					},
-				}, nil)
-				return client
+				}
 			},
 			want: want{
 				obs: &v1alpha1.ScriptObservation{
@@ -165,6 +150,28 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"CreateDuplicateBinding": {
+			args: args{
+				params: v1alpha1.ScriptParameters{
+					ScriptName: testScriptName,
+					Script:     testScript,
+					Bindings: []v1alpha1.WorkerBinding{
+						{Type: "kv_namespace", Name: "CACHE", NamespaceID: ptr.To("first-namespace-id")},
+						{Type: "kv_namespace", Name: "CACHE", NamespaceID: ptr.To("second-namespace-id")},
+					},
+				},
+			},
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return "test-account-id"
+					},
+				}
+			},
+			want: want{
+				err: errors.New(`cannot create worker script: binding names must be unique, "CACHE" is used more than once`),
+			},
+		},
 		"CreateError": {
 			args: args{
 				params: v1alpha1.ScriptParameters{
@@ -172,20 +179,15 @@ func TestCreate(t *testing.T) {
 					Script:     testScript,
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("UploadWorker", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.CreateWorkerParams{
-						ScriptName: testScriptName,
-						Script:     testScript,
-						Bindings:   map[string]cloudflare.WorkerBinding{},
-						Tags:       []string{},
-					},
-				).Return(cloudflare.WorkerScriptResponse{}, errors.New("api error"))
-				return client
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					UploadWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkerParams) (cloudflare.WorkerScriptResponse, error) {
+						return cloudflare.WorkerScriptResponse{}, errors.New("api error")
+					},
+				}
 			},
 			want: want{
 				err: errors.New("cannot create worker script: api error"),
@@ -196,7 +198,7 @@ func TestCreate(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			client := NewClient(tc.mockClient())
-			obs, err := client.Create(context.Background(), tc.args.params)
+			obs, err := client.Create(context.Background(), tc.args.params, "")
 
 			if tc.want.err != nil {
 				if err == nil || err.Error() != tc.want.err.Error() {
@@ -228,40 +230,41 @@ func TestGet(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerScriptAPI
 		want       want
 	}{
 		"GetSuccess": {
 			args: args{
 				scriptName: testScriptName,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("GetWorker", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testScriptName,
-				).Return(cloudflare.WorkerScriptResponse{
-					WorkerScript: cloudflare.WorkerScript{
-						Script:     testScript,
-						UsageModel: "standard",
-					},
-				}, nil)
-				client.On("GetWorkersScriptSettings", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testScriptName,
-				).Return(cloudflare.WorkerScriptSettingsResponse{
-					WorkerMetaData: cloudflare.WorkerMetaData{
-						ID:         "test-id",
-						ETAG:       "test-etag",
-						Size:       1024,
-						CreatedOn:  testTime,
-						ModifiedOn: testTime,
-					},
-				}, nil)
-				return client
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					GetWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptResponse, error) {
+						return cloudflare.WorkerScriptResponse{
+							WorkerScript: cloudflare.WorkerScript{
+								Script:     testScript,
+								UsageModel: "standard",
+							},
+						}, nil
+					},
+					GetWorkersScriptSettingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+						return cloudflare.WorkerScriptSettingsResponse{
+							WorkerMetaData: cloudflare.WorkerMetaData{
+								ID:         "test-id",
+								ETAG:       "test-etag",
+								Size:       1024,
+								CreatedOn:  testTime,
+								ModifiedOn: testTime,
+							},
+						}, nil
+					},
+					GetWorkersScriptBindingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) ([]clients.WorkerScriptBinding, error) {
+						return nil, nil
+					},
+				}
 			},
 			want: want{
 				obs: &v1alpha1.ScriptObservation{
@@ -274,19 +277,52 @@ func TestGet(t *testing.T) {
 				},
 			},
 		},
+		"GetBindings": {
+			args: args{
+				scriptName: testScriptName,
+			},
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					GetWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptResponse, error) {
+						return cloudflare.WorkerScriptResponse{WorkerScript: cloudflare.WorkerScript{WorkerMetaData: cloudflare.WorkerMetaData{ID: scriptName}}}, nil
+					},
+					GetWorkersScriptSettingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+						return cloudflare.WorkerScriptSettingsResponse{WorkerMetaData: cloudflare.WorkerMetaData{ID: scriptName}}, nil
+					},
+					GetWorkersScriptBindingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) ([]clients.WorkerScriptBinding, error) {
+						return []clients.WorkerScriptBinding{
+							{Name: "MODE", Type: cloudflare.WorkerPlainTextBindingType, Text: "production"},
+							{Name: "TOKEN", Type: cloudflare.WorkerSecretTextBindingType},
+							{Name: "API_KEY", Type: cloudflare.WorkerSecretTextBindingType},
+							{Name: "CACHE", Type: cloudflare.WorkerKvNamespaceBindingType},
+						}, nil
+					},
+				}
+			},
+			want: want{
+				obs: &v1alpha1.ScriptObservation{
+					ID:      testScriptName,
+					Vars:    map[string]string{"MODE": "production"},
+					Secrets: []string{"API_KEY", "TOKEN"},
+				},
+			},
+		},
 		"GetError": {
 			args: args{
 				scriptName: testScriptName,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("GetWorker", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testScriptName,
-				).Return(cloudflare.WorkerScriptResponse{}, errors.New("not found"))
-				return client
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					GetWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptResponse, error) {
+						return cloudflare.WorkerScriptResponse{}, errors.New("not found")
+					},
+				}
 			},
 			want: want{
 				err: errors.New("cannot get worker script: not found"),
@@ -329,24 +365,22 @@ func TestDelete(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerScriptAPI
 		want       want
 	}{
 		"DeleteSuccess": {
 			args: args{
 				scriptName: testScriptName,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("DeleteWorker", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.DeleteWorkerParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					DeleteWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.DeleteWorkerParams) error {
+						return nil
+					},
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				).Return(nil)
-				return client
+				}
 			},
 			want: want{},
 		},
@@ -355,18 +389,15 @@ func TestDelete(t *testing.T) {
 				scriptName:        testScriptName,
 				dispatchNamespace: ptr.To("test-namespace"),
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("DeleteWorker", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.DeleteWorkerParams{
-						ScriptName:        testScriptName,
-						DispatchNamespace: ptr.To("test-namespace"),
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					DeleteWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.DeleteWorkerParams) error {
+						return nil
+					},
+					GetAccountIDFunc: func() string {
+						return testAccountID
 					},
-				).Return(nil)
-				return client
+				}
 			},
 			want: want{},
 		},
@@ -374,17 +405,15 @@ func TestDelete(t *testing.T) {
 			args: args{
 				scriptName: testScriptName,
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("DeleteWorker", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					cloudflare.DeleteWorkerParams{
-						ScriptName: testScriptName,
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					DeleteWorkerFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.DeleteWorkerParams) error {
+						return errors.New("delete failed")
 					},
-				).Return(errors.New("delete failed"))
-				return client
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+				}
 			},
 			want: want{
 				err: errors.New("cannot delete worker script: delete failed"),
@@ -423,39 +452,37 @@ func TestIsUpToDate(t *testing.T) {
 
 	cases := map[string]struct {
 		args       args
-		mockClient func() clients.ClientInterface
+		mockClient func() clients.WorkerScriptAPI
 		want       want
 	}{
 		"UpToDate": {
 			args: args{
 				params: v1alpha1.ScriptParameters{
 					ScriptName:        testScriptName,
-					Script:           testScript,
-					Logpush:          ptr.To(true),
+					Script:            testScript,
+					Logpush:           ptr.To(true),
 					CompatibilityDate: ptr.To("2023-01-01"),
 				},
 				obs: v1alpha1.ScriptObservation{
 					ID: "test-id",
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("GetWorkersScriptContent", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testScriptName,
-				).Return(testScript, nil)
-				client.On("GetWorkersScriptSettings", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testScriptName,
-				).Return(cloudflare.WorkerScriptSettingsResponse{
-					WorkerMetaData: cloudflare.WorkerMetaData{
-						Logpush: ptr.To(true),
-					},
-				}, nil)
-				return client
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					GetWorkersScriptContentFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (string, error) {
+						return testScript, nil
+					},
+					GetWorkersScriptSettingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+						return cloudflare.WorkerScriptSettingsResponse{
+							WorkerMetaData: cloudflare.WorkerMetaData{
+								Logpush: ptr.To(true),
+							},
+						}, nil
+					},
+				}
 			},
 			want: want{
 				isUpToDate: true,
@@ -471,15 +498,68 @@ func TestIsUpToDate(t *testing.T) {
 					ID: "test-id",
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("GetWorkersScriptContent", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testScriptName,
-				).Return("different script content", nil)
-				return client
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					GetWorkersScriptContentFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (string, error) {
+						return "different script content", nil
+					},
+				}
+			},
+			want: want{
+				isUpToDate: false,
+			},
+		},
+		"VarsChanged": {
+			args: args{
+				params: v1alpha1.ScriptParameters{
+					ScriptName: testScriptName,
+					Script:     testScript,
+					Bindings: []v1alpha1.WorkerBinding{
+						{Type: "text_blob", Name: "MODE", Text: ptr.To("production")},
+					},
+				},
+				obs: v1alpha1.ScriptObservation{
+					ID:   "test-id",
+					Vars: map[string]string{"MODE": "staging"},
+				},
+			},
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					GetWorkersScriptContentFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (string, error) {
+						return testScript, nil
+					},
+				}
+			},
+			want: want{
+				isUpToDate: false,
+			},
+		},
+		"VarAdded": {
+			args: args{
+				params: v1alpha1.ScriptParameters{
+					ScriptName: testScriptName,
+					Script:     testScript,
+				},
+				obs: v1alpha1.ScriptObservation{
+					ID:   "test-id",
+					Vars: map[string]string{"DEBUG": "true"},
+				},
+			},
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					GetWorkersScriptContentFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (string, error) {
+						return testScript, nil
+					},
+				}
 			},
 			want: want{
 				isUpToDate: false,
@@ -496,24 +576,22 @@ func TestIsUpToDate(t *testing.T) {
 					ID: "test-id",
 				},
 			},
-			mockClient: func() clients.ClientInterface {
-				client := clients.NewMockClient()
-				client.On("GetAccountID").Return(testAccountID)
-				client.On("GetWorkersScriptContent", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testScriptName,
-				).Return(testScript, nil)
-				client.On("GetWorkersScriptSettings", 
-					context.Background(), 
-					cloudflare.AccountIdentifier(testAccountID),
-					testScriptName,
-				).Return(cloudflare.WorkerScriptSettingsResponse{
-					WorkerMetaData: cloudflare.WorkerMetaData{
-						Logpush: ptr.To(false), // Different from desired
-					},
-				}, nil)
-				return client
+			mockClient: func() clients.WorkerScriptAPI {
+				return &fake.WorkerScriptAPIMock{
+					GetAccountIDFunc: func() string {
+						return testAccountID
+					},
+					GetWorkersScriptContentFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (string, error) {
+						return testScript, nil
+					},
+					GetWorkersScriptSettingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+						return cloudflare.WorkerScriptSettingsResponse{
+							WorkerMetaData: cloudflare.WorkerMetaData{
+								Logpush: ptr.To(false), // Different from desired
+							},
+						}, nil
+					},
+				}
 			},
 			want: want{
 				isUpToDate: false,
@@ -543,4 +621,93 @@ func TestIsUpToDate(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestBindingJSON(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		binding v1alpha1.WorkerBinding
+		want    string
+	}{
+		"String": {
+			reason:  "JSON specified as a string should be normalized",
+			binding: v1alpha1.WorkerBinding{Type: "json_data", Name: "CONFIG", JSON: ptr.To(`{ "b": 2, "a": 1 }`)},
+			want:    `{"a":1,"b":2}`,
+		},
+		"Value": {
+			reason:  "JSON specified as a structured value should be normalized",
+			binding: v1alpha1.WorkerBinding{Type: "json_data", Name: "CONFIG", Value: &runtime.RawExtension{Raw: []byte(`{"b":2,"a":1}`)}},
+			want:    `{"a":1,"b":2}`,
+		},
+		"Invalid": {
+			reason:  "A string that is not valid JSON should be passed through unchanged",
+			binding: v1alpha1.WorkerBinding{Type: "json_data", Name: "CONFIG", JSON: ptr.To(`{a:1}`)},
+			want:    `{a:1}`,
+		},
+		"Unset": {
+			reason:  "A binding without data should have none",
+			binding: v1alpha1.WorkerBinding{Type: "json_data", Name: "CONFIG"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := bindingJSON(tc.binding)
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("\n%s\nbindingJSON(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConvertToCloudflareBindings(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		bindings []v1alpha1.WorkerBinding
+		want     map[string]cloudflare.WorkerBinding
+	}{
+		"Service": {
+			reason: "Service bindings should call the resolved Worker in the requested environment",
+			bindings: []v1alpha1.WorkerBinding{
+				{Type: "service", Name: "AUTH", Service: ptr.To("auth-worker"), Environment: ptr.To("staging")},
+			},
+			want: map[string]cloudflare.WorkerBinding{
+				"AUTH": cloudflare.WorkerServiceBinding{Service: "auth-worker", Environment: ptr.To("staging")},
+			},
+		},
+		"UnresolvedService": {
+			reason: "Service bindings whose Worker is not resolved yet should be skipped",
+			bindings: []v1alpha1.WorkerBinding{
+				{Type: "service", Name: "AUTH", ServiceRef: &xpv1.Reference{Name: "auth"}},
+			},
+			want: map[string]cloudflare.WorkerBinding{},
+		},
+		"Browser": {
+			reason: "Browser bindings should bind the Browser Rendering API",
+			bindings: []v1alpha1.WorkerBinding{
+				{Type: "browser", Name: "BROWSER"},
+			},
+			want: map[string]cloudflare.WorkerBinding{
+				"BROWSER": clients.WorkerBrowserBinding{},
+			},
+		},
+		"RateLimit": {
+			reason: "Rate limit bindings should bind the rate limiter with its limit",
+			bindings: []v1alpha1.WorkerBinding{
+				{Type: "ratelimit", Name: "LIMITER", NamespaceID: ptr.To("1001"), RateLimit: &v1alpha1.WorkerRateLimit{Limit: 100, Period: 60}},
+			},
+			want: map[string]cloudflare.WorkerBinding{
+				"LIMITER": clients.WorkerRateLimitBinding{NamespaceID: "1001", Limit: 100, Period: 60},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := convertToCloudflareBindings(tc.bindings)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nconvertToCloudflareBindings(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
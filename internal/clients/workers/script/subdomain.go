@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package script
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errGetSubdomain = "cannot get worker workers.dev subdomain"
+	errSetSubdomain = "cannot update worker workers.dev subdomain"
+)
+
+type scriptSubdomain struct {
+	Enabled         bool `json:"enabled"`
+	PreviewsEnabled bool `json:"previews_enabled"`
+}
+
+// WorkersDev returns whether a Worker is served on its workers.dev
+// subdomain.
+func (c *DeploymentClient) WorkersDev(ctx context.Context, scriptName string) (bool, error) {
+	res, err := c.client.Raw(ctx, http.MethodGet, c.endpoint(scriptName, "subdomain"), nil, nil)
+	if err != nil {
+		return false, errors.Wrap(err, errGetSubdomain)
+	}
+	var s scriptSubdomain
+	if err := json.Unmarshal(res.Result, &s); err != nil {
+		return false, errors.Wrap(err, errGetSubdomain)
+	}
+	return s.Enabled, nil
+}
+
+// SetWorkersDev enables or disables a Worker on its workers.dev subdomain,
+// along with its preview URLs.
+func (c *DeploymentClient) SetWorkersDev(ctx context.Context, scriptName string, enabled bool) error {
+	body := scriptSubdomain{Enabled: enabled, PreviewsEnabled: enabled}
+	_, err := c.client.Raw(ctx, http.MethodPost, c.endpoint(scriptName, "subdomain"), body, nil)
+	return errors.Wrap(err, errSetSubdomain)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package script
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestWorkersDev(t *testing.T) {
+	c := NewDeploymentClient(rawFn(func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+		if method != http.MethodGet || endpoint != "/accounts/"+testAccountID+"/workers/scripts/"+testScriptName+"/subdomain" {
+			return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+		}
+		return cloudflare.RawResponse{Result: []byte(`{"enabled":true,"previews_enabled":false}`)}, nil
+	}), testAccountID)
+
+	got, err := c.WorkersDev(context.Background(), testScriptName)
+	if err != nil {
+		t.Fatalf("WorkersDev(...): %v", err)
+	}
+	if !got {
+		t.Errorf("WorkersDev(...): want true, got false")
+	}
+}
+
+func TestSetWorkersDev(t *testing.T) {
+	var got interface{}
+	c := NewDeploymentClient(rawFn(func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+		if method != http.MethodPost {
+			return cloudflare.RawResponse{}, errors.Errorf("unexpected method %s", method)
+		}
+		got = data
+		return cloudflare.RawResponse{}, nil
+	}), testAccountID)
+
+	if err := c.SetWorkersDev(context.Background(), testScriptName, false); err != nil {
+		t.Fatalf("SetWorkersDev(...): %v", err)
+	}
+	want := scriptSubdomain{Enabled: false, PreviewsEnabled: false}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SetWorkersDev(...): -want body, +got body:\n%s\n", diff)
+	}
+}
@@ -18,6 +18,8 @@ package subdomain
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -72,6 +74,17 @@ func (c *CloudflareSubdomainClient) Update(ctx context.Context, params v1alpha1.
 	return convertSubdomainToObservation(subdomain), nil
 }
 
+// Delete deletes the Workers Subdomain of an account, which disables the
+// workers.dev routes of all of its Workers. A subdomain that does not exist
+// is not an error.
+func (c *CloudflareSubdomainClient) Delete(ctx context.Context, accountID string) error {
+	_, err := c.client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/workers/subdomain", accountID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return errors.Wrap(err, "cannot delete workers subdomain")
+	}
+	return nil
+}
+
 // IsUpToDate checks if the Workers Subdomain configuration is up to date.
 func (c *CloudflareSubdomainClient) IsUpToDate(ctx context.Context, params v1alpha1.SubdomainParameters, obs v1alpha1.SubdomainObservation) (bool, error) {
 	// Compare configurable parameters
@@ -104,6 +117,11 @@ func isNotFound(err error) bool {
 		return false
 	}
 
+	var nf *cloudflare.NotFoundError
+	if errors.As(err, &nf) {
+		return true
+	}
+
 	errStr := strings.ToLower(err.Error())
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "resource not found") ||
@@ -0,0 +1,153 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"net/http"
+	"sync"
+)
+
+// ClientMock is a mock implementation of tailconsumer.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked tailconsumer.Client
+//		mockedClient := &ClientMock{
+//			GetWorkersScriptSettingsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+//				panic("mock out the GetWorkersScriptSettings method")
+//			},
+//			RawFunc: func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+//				panic("mock out the Raw method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires tailconsumer.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// GetWorkersScriptSettingsFunc mocks the GetWorkersScriptSettings method.
+	GetWorkersScriptSettingsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error)
+
+	// RawFunc mocks the Raw method.
+	RawFunc func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetWorkersScriptSettings holds details about calls to the GetWorkersScriptSettings method.
+		GetWorkersScriptSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ScriptName is the scriptName argument value.
+			ScriptName string
+		}
+		// Raw holds details about calls to the Raw method.
+		Raw []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Method is the method argument value.
+			Method string
+			// Endpoint is the endpoint argument value.
+			Endpoint string
+			// Data is the data argument value.
+			Data interface{}
+			// Headers is the headers argument value.
+			Headers http.Header
+		}
+	}
+	lockGetWorkersScriptSettings sync.RWMutex
+	lockRaw                      sync.RWMutex
+}
+
+// GetWorkersScriptSettings calls GetWorkersScriptSettingsFunc.
+func (mock *ClientMock) GetWorkersScriptSettings(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+	if mock.GetWorkersScriptSettingsFunc == nil {
+		panic("ClientMock.GetWorkersScriptSettingsFunc: method is nil but Client.GetWorkersScriptSettings was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}{
+		Ctx:        ctx,
+		Rc:         rc,
+		ScriptName: scriptName,
+	}
+	mock.lockGetWorkersScriptSettings.Lock()
+	mock.calls.GetWorkersScriptSettings = append(mock.calls.GetWorkersScriptSettings, callInfo)
+	mock.lockGetWorkersScriptSettings.Unlock()
+	return mock.GetWorkersScriptSettingsFunc(ctx, rc, scriptName)
+}
+
+// GetWorkersScriptSettingsCalls gets all the calls that were made to GetWorkersScriptSettings.
+// Check the length with:
+//
+//	len(mockedClient.GetWorkersScriptSettingsCalls())
+func (mock *ClientMock) GetWorkersScriptSettingsCalls() []struct {
+	Ctx        context.Context
+	Rc         *cloudflare.ResourceContainer
+	ScriptName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Rc         *cloudflare.ResourceContainer
+		ScriptName string
+	}
+	mock.lockGetWorkersScriptSettings.RLock()
+	calls = mock.calls.GetWorkersScriptSettings
+	mock.lockGetWorkersScriptSettings.RUnlock()
+	return calls
+}
+
+// Raw calls RawFunc.
+func (mock *ClientMock) Raw(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if mock.RawFunc == nil {
+		panic("ClientMock.RawFunc: method is nil but Client.Raw was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}{
+		Ctx:      ctx,
+		Method:   method,
+		Endpoint: endpoint,
+		Data:     data,
+		Headers:  headers,
+	}
+	mock.lockRaw.Lock()
+	mock.calls.Raw = append(mock.calls.Raw, callInfo)
+	mock.lockRaw.Unlock()
+	return mock.RawFunc(ctx, method, endpoint, data, headers)
+}
+
+// RawCalls gets all the calls that were made to Raw.
+// Check the length with:
+//
+//	len(mockedClient.RawCalls())
+func (mock *ClientMock) RawCalls() []struct {
+	Ctx      context.Context
+	Method   string
+	Endpoint string
+	Data     interface{}
+	Headers  http.Header
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}
+	mock.lockRaw.RLock()
+	calls = mock.calls.Raw
+	mock.lockRaw.RUnlock()
+	return calls
+}
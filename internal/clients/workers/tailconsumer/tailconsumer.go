@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tailconsumer manages the tail consumers of Workers, which receive
+// the tail events of a producer Worker.
+package tailconsumer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	errGetSettings    = "cannot get Worker settings"
+	errUpdateSettings = "cannot update Worker tail consumers"
+	errNoProducer     = "producer Worker %q does not exist"
+	errNoConsumer     = "consumer Worker %q does not exist"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with the tail consumers of Workers. cloudflare-go cannot update only the
+// tail consumers of a Worker, so they are updated through raw requests.
+type Client interface {
+	clients.RawAPI
+	GetWorkersScriptSettings(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error)
+}
+
+// NewClient returns a new Cloudflare API client for working with tail
+// consumers.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// Get returns the tail consumers of the supplied Worker, or an error
+// satisfying clients.IsNotFound if the Worker does not exist.
+func Get(ctx context.Context, c Client, accountID, script string) ([]cloudflare.WorkersTailConsumer, error) {
+	s, err := c.GetWorkersScriptSettings(ctx, cloudflare.AccountIdentifier(accountID), script)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetSettings)
+	}
+	if s.TailConsumers == nil {
+		return nil, nil
+	}
+	return *s.TailConsumers, nil
+}
+
+// Exists returns true if the supplied Worker exists.
+func Exists(ctx context.Context, c Client, accountID, script string) (bool, error) {
+	_, err := Get(ctx, c, accountID, script)
+	if clients.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Services returns the names of the supplied tail consumers.
+func Services(consumers []cloudflare.WorkersTailConsumer) []string {
+	out := make([]string, 0, len(consumers))
+	for _, tc := range consumers {
+		out = append(out, tc.Service)
+	}
+	return out
+}
+
+// Attached returns true if the named consumer is one of the supplied tail
+// consumers.
+func Attached(consumers []cloudflare.WorkersTailConsumer, consumer string) bool {
+	for _, tc := range consumers {
+		if tc.Service == consumer {
+			return true
+		}
+	}
+	return false
+}
+
+// Attach the consumer Worker to the producer Worker, keeping the
+// producer's other tail consumers. Both Workers must exist.
+func Attach(ctx context.Context, c Client, accountID, producer, consumer string) error {
+	consumers, err := Get(ctx, c, accountID, producer)
+	if clients.IsNotFound(err) {
+		return errors.Errorf(errNoProducer, producer)
+	}
+	if err != nil {
+		return err
+	}
+	if Attached(consumers, consumer) {
+		return nil
+	}
+
+	ok, err := Exists(ctx, c, accountID, consumer)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf(errNoConsumer, consumer)
+	}
+
+	return update(ctx, c, accountID, producer, append(consumers, cloudflare.WorkersTailConsumer{Service: consumer}))
+}
+
+// Detach the consumer Worker from the producer Worker, keeping the
+// producer's other tail consumers. Detaching from a Worker that no longer
+// exists succeeds.
+func Detach(ctx context.Context, c Client, accountID, producer, consumer string) error {
+	consumers, err := Get(ctx, c, accountID, producer)
+	if clients.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !Attached(consumers, consumer) {
+		return nil
+	}
+
+	keep := make([]cloudflare.WorkersTailConsumer, 0, len(consumers)-1)
+	for _, tc := range consumers {
+		if tc.Service != consumer {
+			keep = append(keep, tc)
+		}
+	}
+	return update(ctx, c, accountID, producer, keep)
+}
+
+// update replaces the tail consumers of a Worker, leaving its other
+// settings unchanged.
+func update(ctx context.Context, c Client, accountID, script string, consumers []cloudflare.WorkersTailConsumer) error {
+	body := struct {
+		TailConsumers []cloudflare.WorkersTailConsumer `json:"tail_consumers"`
+	}{TailConsumers: consumers}
+	_, err := c.Raw(ctx, http.MethodPatch, fmt.Sprintf("/accounts/%s/workers/scripts/%s/script-settings", accountID, script), body, nil)
+	return errors.Wrap(err, errUpdateSettings)
+}
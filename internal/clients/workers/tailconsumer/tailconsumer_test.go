@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tailconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients/workers/tailconsumer/fake"
+)
+
+// workers returns a mock GetWorkersScriptSettings that serves the tail
+// consumers of the supplied Workers, and reports any others as not found.
+func workers(w map[string][]string) func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+	return func(ctx context.Context, rc *cloudflare.ResourceContainer, scriptName string) (cloudflare.WorkerScriptSettingsResponse, error) {
+		services, ok := w[scriptName]
+		if !ok {
+			return cloudflare.WorkerScriptSettingsResponse{}, errors.New("workers.api.error.script_not_found: script does not exist (10007)")
+		}
+		tcs := make([]cloudflare.WorkersTailConsumer, 0, len(services))
+		for _, s := range services {
+			tcs = append(tcs, cloudflare.WorkersTailConsumer{Service: s})
+		}
+		r := cloudflare.WorkerScriptSettingsResponse{}
+		r.TailConsumers = &tcs
+		return r, nil
+	}
+}
+
+// updated records the tail consumers sent in a mock Raw request.
+type updated struct {
+	Endpoint      string
+	TailConsumers []cloudflare.WorkersTailConsumer `json:"tail_consumers"`
+}
+
+func record(u **updated) func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	return func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return cloudflare.RawResponse{}, err
+		}
+		got := &updated{Endpoint: method + " " + endpoint}
+		if err := json.Unmarshal(b, got); err != nil {
+			return cloudflare.RawResponse{}, err
+		}
+		*u = got
+		return cloudflare.RawResponse{}, nil
+	}
+}
+
+func TestAttach(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		updated *updated
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		workers map[string][]string
+		raw     error
+		want    want
+	}{
+		"Attaches": {
+			reason:  "The consumer should be added to the producer's existing tail consumers",
+			workers: map[string][]string{"gateway": {"audit"}, "log-drain": nil},
+			want: want{updated: &updated{
+				Endpoint:      "PATCH /accounts/acct/workers/scripts/gateway/script-settings",
+				TailConsumers: []cloudflare.WorkersTailConsumer{{Service: "audit"}, {Service: "log-drain"}},
+			}},
+		},
+		"AlreadyAttached": {
+			reason:  "Nothing should be updated if the consumer is already attached",
+			workers: map[string][]string{"gateway": {"log-drain"}, "log-drain": nil},
+			want:    want{},
+		},
+		"NoProducer": {
+			reason:  "Attaching to a producer that does not exist should fail",
+			workers: map[string][]string{"log-drain": nil},
+			want:    want{err: errors.Errorf(errNoProducer, "gateway")},
+		},
+		"NoConsumer": {
+			reason:  "Attaching a consumer that does not exist should fail",
+			workers: map[string][]string{"gateway": nil},
+			want:    want{err: errors.Errorf(errNoConsumer, "log-drain")},
+		},
+		"UpdateError": {
+			reason:  "Errors updating the producer should be returned",
+			workers: map[string][]string{"gateway": nil, "log-drain": nil},
+			raw:     errBoom,
+			want:    want{err: errors.Wrap(errBoom, errUpdateSettings)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *updated
+			client := &fake.ClientMock{GetWorkersScriptSettingsFunc: workers(tc.workers), RawFunc: record(&got)}
+			if tc.raw != nil {
+				client.RawFunc = func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					return cloudflare.RawResponse{}, tc.raw
+				}
+			}
+
+			err := Attach(context.Background(), client, "acct", "gateway", "log-drain")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAttach(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, got); diff != "" {
+				t.Errorf("\n%s\nAttach(...): -want update, +got update:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDetach(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		workers map[string][]string
+		want    *updated
+	}{
+		"Detaches": {
+			reason:  "Only the consumer should be removed from the producer's tail consumers",
+			workers: map[string][]string{"gateway": {"audit", "log-drain"}},
+			want: &updated{
+				Endpoint:      "PATCH /accounts/acct/workers/scripts/gateway/script-settings",
+				TailConsumers: []cloudflare.WorkersTailConsumer{{Service: "audit"}},
+			},
+		},
+		"NotAttached": {
+			reason:  "Nothing should be updated if the consumer is not attached",
+			workers: map[string][]string{"gateway": {"audit"}},
+		},
+		"NoProducer": {
+			reason:  "Detaching from a producer that no longer exists should succeed",
+			workers: map[string][]string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *updated
+			client := &fake.ClientMock{GetWorkersScriptSettingsFunc: workers(tc.workers), RawFunc: record(&got)}
+
+			err := Detach(context.Background(), client, "acct", "gateway", "log-drain")
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDetach(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDetach(...): -want update, +got update:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
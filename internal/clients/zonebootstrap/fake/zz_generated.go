@@ -0,0 +1,358 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"sync"
+)
+
+// ClientMock is a mock implementation of zonebootstrap.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked zonebootstrap.Client
+//		mockedClient := &ClientMock{
+//			CreateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+//				panic("mock out the CreateDNSRecord method")
+//			},
+//			DeleteDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+//				panic("mock out the DeleteDNSRecord method")
+//			},
+//			GetDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+//				panic("mock out the GetDNSRecord method")
+//			},
+//			ListDNSRecordsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+//				panic("mock out the ListDNSRecords method")
+//			},
+//			UpdateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+//				panic("mock out the UpdateDNSRecord method")
+//			},
+//			ZoneDetailsFunc: func(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+//				panic("mock out the ZoneDetails method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires zonebootstrap.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// CreateDNSRecordFunc mocks the CreateDNSRecord method.
+	CreateDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
+
+	// DeleteDNSRecordFunc mocks the DeleteDNSRecord method.
+	DeleteDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+
+	// GetDNSRecordFunc mocks the GetDNSRecord method.
+	GetDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
+
+	// ListDNSRecordsFunc mocks the ListDNSRecords method.
+	ListDNSRecordsFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
+
+	// UpdateDNSRecordFunc mocks the UpdateDNSRecord method.
+	UpdateDNSRecordFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
+
+	// ZoneDetailsFunc mocks the ZoneDetails method.
+	ZoneDetailsFunc func(ctx context.Context, zoneID string) (cloudflare.Zone, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateDNSRecord holds details about calls to the CreateDNSRecord method.
+		CreateDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateDNSRecordParams
+		}
+		// DeleteDNSRecord holds details about calls to the DeleteDNSRecord method.
+		DeleteDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// RecordID is the recordID argument value.
+			RecordID string
+		}
+		// GetDNSRecord holds details about calls to the GetDNSRecord method.
+		GetDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// RecordID is the recordID argument value.
+			RecordID string
+		}
+		// ListDNSRecords holds details about calls to the ListDNSRecords method.
+		ListDNSRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.ListDNSRecordsParams
+		}
+		// UpdateDNSRecord holds details about calls to the UpdateDNSRecord method.
+		UpdateDNSRecord []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.UpdateDNSRecordParams
+		}
+		// ZoneDetails holds details about calls to the ZoneDetails method.
+		ZoneDetails []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+		}
+	}
+	lockCreateDNSRecord sync.RWMutex
+	lockDeleteDNSRecord sync.RWMutex
+	lockGetDNSRecord    sync.RWMutex
+	lockListDNSRecords  sync.RWMutex
+	lockUpdateDNSRecord sync.RWMutex
+	lockZoneDetails     sync.RWMutex
+}
+
+// CreateDNSRecord calls CreateDNSRecordFunc.
+func (mock *ClientMock) CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	if mock.CreateDNSRecordFunc == nil {
+		panic("ClientMock.CreateDNSRecordFunc: method is nil but Client.CreateDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateDNSRecordParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateDNSRecord.Lock()
+	mock.calls.CreateDNSRecord = append(mock.calls.CreateDNSRecord, callInfo)
+	mock.lockCreateDNSRecord.Unlock()
+	return mock.CreateDNSRecordFunc(ctx, rc, params)
+}
+
+// CreateDNSRecordCalls gets all the calls that were made to CreateDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.CreateDNSRecordCalls())
+func (mock *ClientMock) CreateDNSRecordCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateDNSRecordParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateDNSRecordParams
+	}
+	mock.lockCreateDNSRecord.RLock()
+	calls = mock.calls.CreateDNSRecord
+	mock.lockCreateDNSRecord.RUnlock()
+	return calls
+}
+
+// DeleteDNSRecord calls DeleteDNSRecordFunc.
+func (mock *ClientMock) DeleteDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+	if mock.DeleteDNSRecordFunc == nil {
+		panic("ClientMock.DeleteDNSRecordFunc: method is nil but Client.DeleteDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}{
+		Ctx:      ctx,
+		Rc:       rc,
+		RecordID: recordID,
+	}
+	mock.lockDeleteDNSRecord.Lock()
+	mock.calls.DeleteDNSRecord = append(mock.calls.DeleteDNSRecord, callInfo)
+	mock.lockDeleteDNSRecord.Unlock()
+	return mock.DeleteDNSRecordFunc(ctx, rc, recordID)
+}
+
+// DeleteDNSRecordCalls gets all the calls that were made to DeleteDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.DeleteDNSRecordCalls())
+func (mock *ClientMock) DeleteDNSRecordCalls() []struct {
+	Ctx      context.Context
+	Rc       *cloudflare.ResourceContainer
+	RecordID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}
+	mock.lockDeleteDNSRecord.RLock()
+	calls = mock.calls.DeleteDNSRecord
+	mock.lockDeleteDNSRecord.RUnlock()
+	return calls
+}
+
+// GetDNSRecord calls GetDNSRecordFunc.
+func (mock *ClientMock) GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+	if mock.GetDNSRecordFunc == nil {
+		panic("ClientMock.GetDNSRecordFunc: method is nil but Client.GetDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}{
+		Ctx:      ctx,
+		Rc:       rc,
+		RecordID: recordID,
+	}
+	mock.lockGetDNSRecord.Lock()
+	mock.calls.GetDNSRecord = append(mock.calls.GetDNSRecord, callInfo)
+	mock.lockGetDNSRecord.Unlock()
+	return mock.GetDNSRecordFunc(ctx, rc, recordID)
+}
+
+// GetDNSRecordCalls gets all the calls that were made to GetDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.GetDNSRecordCalls())
+func (mock *ClientMock) GetDNSRecordCalls() []struct {
+	Ctx      context.Context
+	Rc       *cloudflare.ResourceContainer
+	RecordID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Rc       *cloudflare.ResourceContainer
+		RecordID string
+	}
+	mock.lockGetDNSRecord.RLock()
+	calls = mock.calls.GetDNSRecord
+	mock.lockGetDNSRecord.RUnlock()
+	return calls
+}
+
+// ListDNSRecords calls ListDNSRecordsFunc.
+func (mock *ClientMock) ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+	if mock.ListDNSRecordsFunc == nil {
+		panic("ClientMock.ListDNSRecordsFunc: method is nil but Client.ListDNSRecords was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockListDNSRecords.Lock()
+	mock.calls.ListDNSRecords = append(mock.calls.ListDNSRecords, callInfo)
+	mock.lockListDNSRecords.Unlock()
+	return mock.ListDNSRecordsFunc(ctx, rc, params)
+}
+
+// ListDNSRecordsCalls gets all the calls that were made to ListDNSRecords.
+// Check the length with:
+//
+//	len(mockedClient.ListDNSRecordsCalls())
+func (mock *ClientMock) ListDNSRecordsCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.ListDNSRecordsParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.ListDNSRecordsParams
+	}
+	mock.lockListDNSRecords.RLock()
+	calls = mock.calls.ListDNSRecords
+	mock.lockListDNSRecords.RUnlock()
+	return calls
+}
+
+// UpdateDNSRecord calls UpdateDNSRecordFunc.
+func (mock *ClientMock) UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	if mock.UpdateDNSRecordFunc == nil {
+		panic("ClientMock.UpdateDNSRecordFunc: method is nil but Client.UpdateDNSRecord was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateDNSRecordParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockUpdateDNSRecord.Lock()
+	mock.calls.UpdateDNSRecord = append(mock.calls.UpdateDNSRecord, callInfo)
+	mock.lockUpdateDNSRecord.Unlock()
+	return mock.UpdateDNSRecordFunc(ctx, rc, params)
+}
+
+// UpdateDNSRecordCalls gets all the calls that were made to UpdateDNSRecord.
+// Check the length with:
+//
+//	len(mockedClient.UpdateDNSRecordCalls())
+func (mock *ClientMock) UpdateDNSRecordCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.UpdateDNSRecordParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateDNSRecordParams
+	}
+	mock.lockUpdateDNSRecord.RLock()
+	calls = mock.calls.UpdateDNSRecord
+	mock.lockUpdateDNSRecord.RUnlock()
+	return calls
+}
+
+// ZoneDetails calls ZoneDetailsFunc.
+func (mock *ClientMock) ZoneDetails(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+	if mock.ZoneDetailsFunc == nil {
+		panic("ClientMock.ZoneDetailsFunc: method is nil but Client.ZoneDetails was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneID string
+	}{
+		Ctx:    ctx,
+		ZoneID: zoneID,
+	}
+	mock.lockZoneDetails.Lock()
+	mock.calls.ZoneDetails = append(mock.calls.ZoneDetails, callInfo)
+	mock.lockZoneDetails.Unlock()
+	return mock.ZoneDetailsFunc(ctx, zoneID)
+}
+
+// ZoneDetailsCalls gets all the calls that were made to ZoneDetails.
+// Check the length with:
+//
+//	len(mockedClient.ZoneDetailsCalls())
+func (mock *ClientMock) ZoneDetailsCalls() []struct {
+	Ctx    context.Context
+	ZoneID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneID string
+	}
+	mock.lockZoneDetails.RLock()
+	calls = mock.calls.ZoneDetails
+	mock.lockZoneDetails.RUnlock()
+	return calls
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package zonebootstrap manages the canonical set of DNS Records a
+// ZoneBootstrap publishes for a zone.
+package zonebootstrap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/records"
+)
+
+const (
+	errGetZone      = "cannot get zone"
+	errListRecords  = "cannot list DNS records"
+	errCreateRecord = "cannot create DNS record"
+	errUpdateRecord = "cannot update DNS record"
+	errDeleteRecord = "cannot delete DNS record"
+
+	typeA     = "A"
+	typeAAAA  = "AAAA"
+	typeCNAME = "CNAME"
+	typeCAA   = "CAA"
+
+	tagIssue     = "issue"
+	tagIssueWild = "issuewild"
+	tagIODEF     = "iodef"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with the DNS Records managed by a ZoneBootstrap.
+type Client interface {
+	clients.DNSAPI
+	ZoneDetails(ctx context.Context, zoneID string) (cloudflare.Zone, error)
+}
+
+// NewClient returns a new Cloudflare API client for working with the DNS
+// Records managed by a ZoneBootstrap.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// ManagedComment returns the DNS record comment used to mark records as
+// owned by the named ZoneBootstrap.
+func ManagedComment(name string) string {
+	return "managed-by: crossplane zonebootstrap/" + name
+}
+
+// Domain returns the name of the supplied zone.
+func Domain(ctx context.Context, client Client, zoneID string) (string, error) {
+	z, err := client.ZoneDetails(ctx, zoneID)
+	return z.Name, errors.Wrap(err, errGetZone)
+}
+
+// Render returns the records described by the supplied parameters for the
+// supplied domain, ordered by type, name and content.
+func Render(p v1alpha1.ZoneBootstrapParameters, domain string) []v1alpha1.BootstrapRecord {
+	proxied := p.Proxied == nil || *p.Proxied
+	out := []v1alpha1.BootstrapRecord{}
+
+	if p.Origin.Hostname != nil {
+		out = append(out, v1alpha1.BootstrapRecord{Type: typeCNAME, Name: domain, Content: *p.Origin.Hostname, Proxied: proxied})
+	}
+	for _, ip := range p.Origin.IPv4 {
+		out = append(out, v1alpha1.BootstrapRecord{Type: typeA, Name: domain, Content: ip, Proxied: proxied})
+	}
+	for _, ip := range p.Origin.IPv6 {
+		out = append(out, v1alpha1.BootstrapRecord{Type: typeAAAA, Name: domain, Content: ip, Proxied: proxied})
+	}
+	if p.WWW == nil || *p.WWW {
+		out = append(out, v1alpha1.BootstrapRecord{Type: typeCNAME, Name: "www." + domain, Content: domain, Proxied: proxied})
+	}
+	if p.CAA != nil {
+		for _, ca := range p.CAA.Issuers {
+			out = append(out, v1alpha1.BootstrapRecord{Type: typeCAA, Name: domain, Content: caa(tagIssue, ca)})
+		}
+		for _, ca := range p.CAA.WildcardIssuers {
+			out = append(out, v1alpha1.BootstrapRecord{Type: typeCAA, Name: domain, Content: caa(tagIssueWild, ca)})
+		}
+		if p.CAA.IODEF != nil {
+			out = append(out, v1alpha1.BootstrapRecord{Type: typeCAA, Name: domain, Content: caa(tagIODEF, *p.CAA.IODEF)})
+		}
+	}
+
+	sortRecords(out)
+	return out
+}
+
+// caa renders the content of a CAA record, as Cloudflare returns it.
+func caa(tag, value string) string {
+	return fmt.Sprintf("0 %s %q", tag, value)
+}
+
+// caaData returns the structured data Cloudflare requires to create a CAA
+// record with the supplied content.
+func caaData(content string) map[string]interface{} {
+	parts := strings.SplitN(content, " ", 3)
+	return map[string]interface{}{
+		"flags": 0,
+		"tag":   parts[1],
+		"value": strings.Trim(parts[2], `"`),
+	}
+}
+
+func sortRecords(r []v1alpha1.BootstrapRecord) {
+	sort.Slice(r, func(i, j int) bool {
+		if r[i].Type != r[j].Type {
+			return r[i].Type < r[j].Type
+		}
+		if r[i].Name != r[j].Name {
+			return r[i].Name < r[j].Name
+		}
+		return r[i].Content < r[j].Content
+	})
+}
+
+// key identifies a record in a set, ignoring case and trailing dots.
+func key(typ, name, content string) string {
+	norm := func(s string) string { return strings.ToLower(strings.TrimSuffix(s, ".")) }
+	return typ + " " + norm(name) + " " + norm(content)
+}
+
+func ttl(p v1alpha1.ZoneBootstrapParameters, proxied bool) int {
+	if proxied || p.TTL == nil {
+		return 1
+	}
+	return int(*p.TTL)
+}
+
+// ListManaged returns the DNS Records owned by the named ZoneBootstrap.
+func ListManaged(ctx context.Context, client Client, zoneID, name string) ([]cloudflare.DNSRecord, error) {
+	recs, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{
+		Comment: ManagedComment(name),
+	})
+	return recs, errors.Wrap(err, errListRecords)
+}
+
+// GenerateObservation creates an observation of the supplied DNS Records.
+func GenerateObservation(domain string, in []cloudflare.DNSRecord) v1alpha1.ZoneBootstrapObservation {
+	o := v1alpha1.ZoneBootstrapObservation{Domain: domain}
+	for _, r := range in {
+		o.Records = append(o.Records, v1alpha1.BootstrapRecord{
+			ID:      r.ID,
+			Type:    r.Type,
+			Name:    r.Name,
+			Content: r.Content,
+			Proxied: r.Proxied != nil && *r.Proxied,
+		})
+	}
+	sortRecords(o.Records)
+	return o
+}
+
+// UpToDate checks whether the supplied records are exactly the set
+// rendered from the parameters, proxied and with the TTL they describe.
+func UpToDate(p v1alpha1.ZoneBootstrapParameters, domain string, actual []cloudflare.DNSRecord) bool {
+	desired := Render(p, domain)
+	if len(desired) != len(actual) {
+		return false
+	}
+	byKey := map[string]cloudflare.DNSRecord{}
+	for _, r := range actual {
+		byKey[key(r.Type, r.Name, r.Content)] = r
+	}
+	for _, d := range desired {
+		r, ok := byKey[key(d.Type, d.Name, d.Content)]
+		if !ok || !current(p, d, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// current returns true if an existing record is proxied and has the TTL
+// of the desired record.
+func current(p v1alpha1.ZoneBootstrapParameters, d v1alpha1.BootstrapRecord, r cloudflare.DNSRecord) bool {
+	proxied := r.Proxied != nil && *r.Proxied
+	return proxied == d.Proxied && r.TTL == ttl(p, d.Proxied)
+}
+
+// Apply reconciles the DNS Records owned by the named ZoneBootstrap with
+// the rendered parameters, treating them as a set. Records that are no
+// longer rendered are deleted first, so that for example an apex CNAME can
+// be replaced by A records. An existing unowned record identical to a
+// rendered one is adopted rather than duplicated.
+func Apply(ctx context.Context, client Client, zoneID, domain, name string, p v1alpha1.ZoneBootstrapParameters) error {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	comment := ManagedComment(name)
+
+	owned, err := ListManaged(ctx, client, zoneID, name)
+	if err != nil {
+		return err
+	}
+	byKey := map[string]cloudflare.DNSRecord{}
+	for _, r := range owned {
+		byKey[key(r.Type, r.Name, r.Content)] = r
+	}
+
+	desired := Render(p, domain)
+	stale := map[string]cloudflare.DNSRecord{}
+	for k, r := range byKey {
+		stale[k] = r
+	}
+	for _, d := range desired {
+		delete(stale, key(d.Type, d.Name, d.Content))
+	}
+	if err := Delete(ctx, client, zoneID, mapValues(stale)); err != nil {
+		return err
+	}
+
+	for _, d := range desired {
+		existing, ok := byKey[key(d.Type, d.Name, d.Content)]
+		if !ok {
+			existing, ok, err = findAdoptable(ctx, client, zoneID, d)
+			if err != nil {
+				return err
+			}
+		}
+		if !ok {
+			params := cloudflare.CreateDNSRecordParams{
+				Type:    d.Type,
+				Name:    d.Name,
+				Content: d.Content,
+				TTL:     ttl(p, d.Proxied),
+				Proxied: &d.Proxied,
+				Comment: comment,
+			}
+			if d.Type == typeCAA {
+				params.Content = ""
+				params.Data = caaData(d.Content)
+				params.Proxied = nil
+			}
+			if _, err := client.CreateDNSRecord(ctx, rc, params); err != nil {
+				return errors.Wrap(err, errCreateRecord)
+			}
+			continue
+		}
+		if current(p, d, existing) && existing.Comment == comment {
+			continue
+		}
+		params := cloudflare.UpdateDNSRecordParams{
+			ID:      existing.ID,
+			Type:    d.Type,
+			Name:    d.Name,
+			Content: d.Content,
+			TTL:     ttl(p, d.Proxied),
+			Proxied: &d.Proxied,
+			Comment: &comment,
+		}
+		if d.Type == typeCAA {
+			params.Content = ""
+			params.Data = caaData(d.Content)
+			params.Proxied = nil
+		}
+		if _, err := client.UpdateDNSRecord(ctx, rc, params); err != nil {
+			return errors.Wrap(err, errUpdateRecord)
+		}
+	}
+	return nil
+}
+
+// findAdoptable looks for an existing record identical to the desired one.
+func findAdoptable(ctx context.Context, client Client, zoneID string, d v1alpha1.BootstrapRecord) (cloudflare.DNSRecord, bool, error) {
+	recs, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{
+		Type: d.Type,
+		Name: d.Name,
+	})
+	if err != nil {
+		return cloudflare.DNSRecord{}, false, errors.Wrap(err, errListRecords)
+	}
+	for _, r := range recs {
+		if key(r.Type, r.Name, r.Content) == key(d.Type, d.Name, d.Content) {
+			return r, true, nil
+		}
+	}
+	return cloudflare.DNSRecord{}, false, nil
+}
+
+// Delete deletes the supplied DNS Records, ignoring any that no longer
+// exist.
+func Delete(ctx context.Context, client Client, zoneID string, recs []cloudflare.DNSRecord) error {
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	for _, r := range recs {
+		if err := client.DeleteDNSRecord(ctx, rc, r.ID); err != nil && !records.IsRecordNotFound(err) {
+			return errors.Wrap(err, errDeleteRecord)
+		}
+	}
+	return nil
+}
+
+func mapValues(m map[string]cloudflare.DNSRecord) []cloudflare.DNSRecord {
+	out := make([]cloudflare.DNSRecord, 0, len(m))
+	for _, r := range m {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zonebootstrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/zonebootstrap/fake"
+)
+
+func TestRender(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.ZoneBootstrapParameters
+		want   []v1alpha1.BootstrapRecord
+	}{
+		"Hostname": {
+			reason: "A hostname origin should be published as a flattened apex CNAME with a www CNAME",
+			params: v1alpha1.ZoneBootstrapParameters{
+				Origin: v1alpha1.BootstrapOrigin{Hostname: ptr.To("origin.example.net")},
+			},
+			want: []v1alpha1.BootstrapRecord{
+				{Type: "CNAME", Name: "example.com", Content: "origin.example.net", Proxied: true},
+				{Type: "CNAME", Name: "www.example.com", Content: "example.com", Proxied: true},
+			},
+		},
+		"Addresses": {
+			reason: "Address origins should be published as apex A and AAAA records, with CAA records",
+			params: v1alpha1.ZoneBootstrapParameters{
+				Origin:  v1alpha1.BootstrapOrigin{IPv4: []string{"192.0.2.1", "192.0.2.2"}, IPv6: []string{"2001:db8::1"}},
+				WWW:     ptr.To(false),
+				Proxied: ptr.To(false),
+				CAA: &v1alpha1.CAAPolicy{
+					Issuers:         []string{"letsencrypt.org"},
+					WildcardIssuers: []string{"pki.goog"},
+					IODEF:           ptr.To("mailto:security@example.com"),
+				},
+			},
+			want: []v1alpha1.BootstrapRecord{
+				{Type: "A", Name: "example.com", Content: "192.0.2.1"},
+				{Type: "A", Name: "example.com", Content: "192.0.2.2"},
+				{Type: "AAAA", Name: "example.com", Content: "2001:db8::1"},
+				{Type: "CAA", Name: "example.com", Content: `0 iodef "mailto:security@example.com"`},
+				{Type: "CAA", Name: "example.com", Content: `0 issue "letsencrypt.org"`},
+				{Type: "CAA", Name: "example.com", Content: `0 issuewild "pki.goog"`},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Render(tc.params, "example.com")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nRender(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	errBoom := errors.New("boom")
+	comment := ManagedComment("bootstrap")
+
+	params := v1alpha1.ZoneBootstrapParameters{
+		Origin: v1alpha1.BootstrapOrigin{IPv4: []string{"192.0.2.1"}},
+		CAA:    &v1alpha1.CAAPolicy{Issuers: []string{"letsencrypt.org"}},
+	}
+
+	type want struct {
+		created []string
+		updated []string
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		list   func(params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, error)
+		create error
+		want   want
+	}{
+		"CreatesMissing": {
+			reason: "Rendered records that do not exist should be created",
+			want:   want{created: []string{"A example.com", "CAA example.com", "CNAME www.example.com"}},
+		},
+		"AdoptsIdentical": {
+			reason: "An existing unowned record identical to a rendered one should be adopted",
+			list: func(p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, error) {
+				if p.Type == "A" && p.Name == "example.com" {
+					return []cloudflare.DNSRecord{
+						{ID: "other", Type: "A", Name: "example.com", Content: "192.0.2.9"},
+						{ID: "apex", Type: "A", Name: "example.com", Content: "192.0.2.1", Proxied: ptr.To(true), TTL: 1},
+					}, nil
+				}
+				return nil, nil
+			},
+			want: want{created: []string{"CAA example.com", "CNAME www.example.com"}, updated: []string{"apex"}},
+		},
+		"LeavesUpToDate": {
+			reason: "Owned records that are up to date should not be touched",
+			list: func(p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, error) {
+				if p.Comment == comment {
+					return []cloudflare.DNSRecord{
+						{ID: "apex", Type: "A", Name: "example.com", Content: "192.0.2.1", Proxied: ptr.To(true), TTL: 1, Comment: comment},
+						{ID: "caa", Type: "CAA", Name: "example.com", Content: `0 issue "letsencrypt.org"`, Proxied: ptr.To(false), TTL: 1, Comment: comment},
+						{ID: "www", Type: "CNAME", Name: "www.example.com", Content: "example.com", Proxied: ptr.To(true), TTL: 1, Comment: comment},
+					}, nil
+				}
+				return nil, errors.New("unexpected lookup")
+			},
+			want: want{},
+		},
+		"ReplacesStale": {
+			reason: "Owned records that are no longer rendered should be deleted before others are created",
+			list: func(p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, error) {
+				if p.Comment == comment {
+					return []cloudflare.DNSRecord{
+						{ID: "cname", Type: "CNAME", Name: "example.com", Content: "origin.example.net", Proxied: ptr.To(true), TTL: 1, Comment: comment},
+						{ID: "caa", Type: "CAA", Name: "example.com", Content: `0 issue "letsencrypt.org"`, Proxied: ptr.To(false), TTL: 1, Comment: comment},
+						{ID: "www", Type: "CNAME", Name: "www.example.com", Content: "example.com", Proxied: ptr.To(false), TTL: 1, Comment: comment},
+					}, nil
+				}
+				return nil, nil
+			},
+			want: want{deleted: []string{"cname"}, created: []string{"A example.com"}, updated: []string{"www"}},
+		},
+		"CreateError": {
+			reason: "Errors creating records should be returned",
+			create: errBoom,
+			want:   want{created: []string{"A example.com"}, err: errors.Wrap(errBoom, errCreateRecord)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			client := &fake.ClientMock{
+				ListDNSRecordsFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+					if tc.list == nil {
+						return nil, &cloudflare.ResultInfo{}, nil
+					}
+					recs, err := tc.list(p)
+					return recs, &cloudflare.ResultInfo{}, err
+				},
+				CreateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+					got.created = append(got.created, p.Type+" "+p.Name)
+					if p.Comment != comment {
+						return cloudflare.DNSRecord{}, errors.New("record created without ownership comment")
+					}
+					if p.Type == "CAA" && p.Data == nil {
+						return cloudflare.DNSRecord{}, errors.New("CAA record created without data")
+					}
+					return cloudflare.DNSRecord{}, tc.create
+				},
+				UpdateDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+					got.updated = append(got.updated, p.ID)
+					return cloudflare.DNSRecord{}, nil
+				},
+				DeleteDNSRecordFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error {
+					if len(got.created) > 0 {
+						return errors.New("record deleted after records were created")
+					}
+					got.deleted = append(got.deleted, recordID)
+					return nil
+				},
+			}
+
+			err := Apply(context.Background(), client, "zone-id", "example.com", "bootstrap", params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, got.created); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want created, +got created:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, got.updated); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want updated, +got updated:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, got.deleted); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package custompage manages the custom pages of a zone or account.
+package custompage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	// StateDefault is the state of a page that serves Cloudflare's
+	// default content.
+	StateDefault = "default"
+
+	// StateCustomized is the state of a page that serves custom content.
+	StateCustomized = "customized"
+
+	accessPrefix = "access_"
+
+	errGetPage    = "cannot get custom page"
+	errUpdatePage = "cannot update custom page"
+	errCreatePage = "cannot create custom page"
+	errDeletePage = "cannot delete custom page"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with custom pages.
+type Client interface {
+	CustomPage(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string) (cloudflare.CustomPage, error)
+	UpdateCustomPage(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string, pageParameters cloudflare.CustomPageParameters) (cloudflare.CustomPage, error)
+	GetAccessCustomPage(ctx context.Context, rc *cloudflare.ResourceContainer, id string) (cloudflare.AccessCustomPage, error)
+	CreateAccessCustomPage(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessCustomPageParams) (cloudflare.AccessCustomPage, error)
+	UpdateAccessCustomPage(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateAccessCustomPageParams) (cloudflare.AccessCustomPage, error)
+	DeleteAccessCustomPage(ctx context.Context, rc *cloudflare.ResourceContainer, id string) error
+}
+
+// NewClient returns a new Cloudflare API client for working with custom
+// pages.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// IsAccessPage returns true if the page is served by Cloudflare Access.
+func IsAccessPage(page string) bool {
+	return strings.HasPrefix(page, accessPrefix)
+}
+
+// IsPageNotFound returns true if the error indicates the page was not found.
+func IsPageNotFound(err error) bool {
+	var nf *cloudflare.NotFoundError
+	return errors.As(err, &nf)
+}
+
+// Hash returns the SHA-256 hash of the HTML of a page, as recorded in its
+// observation.
+func Hash(html string) string {
+	sum := sha256.Sum256([]byte(html))
+	return hex.EncodeToString(sum[:])
+}
+
+// Get returns an observation of a zone or account page. Access pages are
+// looked up by the supplied ID, other pages by their name.
+func Get(ctx context.Context, client Client, params v1alpha1.CustomPageParameters, id string) (v1alpha1.CustomPageObservation, error) {
+	if IsAccessPage(params.Page) {
+		p, err := client.GetAccessCustomPage(ctx, cloudflare.AccountIdentifier(ptr.Deref(params.AccountID, "")), id)
+		if err != nil {
+			return v1alpha1.CustomPageObservation{}, errors.Wrap(err, errGetPage)
+		}
+		return GenerateAccessObservation(p), nil
+	}
+
+	p, err := client.CustomPage(ctx, options(params), params.Page)
+	if err != nil {
+		return v1alpha1.CustomPageObservation{}, errors.Wrap(err, errGetPage)
+	}
+	return GenerateObservation(p), nil
+}
+
+// Publish customizes a zone or account page with the supplied content,
+// returning the ID of the page. The content of access pages is their HTML
+// and that of other pages the URL they are fetched from.
+func Publish(ctx context.Context, client Client, name string, params v1alpha1.CustomPageParameters, id, content string) (string, error) {
+	if !IsAccessPage(params.Page) {
+		_, err := client.UpdateCustomPage(ctx, options(params), params.Page, cloudflare.CustomPageParameters{URL: content, State: StateCustomized})
+		return params.Page, errors.Wrap(err, errUpdatePage)
+	}
+
+	rc := cloudflare.AccountIdentifier(ptr.Deref(params.AccountID, ""))
+	if id == "" {
+		p, err := client.CreateAccessCustomPage(ctx, rc, cloudflare.CreateAccessCustomPageParams{
+			Name:       name,
+			Type:       accessType(params.Page),
+			CustomHTML: content,
+		})
+		return p.UID, errors.Wrap(err, errCreatePage)
+	}
+	_, err := client.UpdateAccessCustomPage(ctx, rc, cloudflare.UpdateAccessCustomPageParams{
+		UID:        id,
+		Name:       name,
+		Type:       accessType(params.Page),
+		CustomHTML: content,
+	})
+	return id, errors.Wrap(err, errUpdatePage)
+}
+
+// Reset deletes an access page, or returns any other page to Cloudflare's
+// default content.
+func Reset(ctx context.Context, client Client, params v1alpha1.CustomPageParameters, id string) error {
+	if IsAccessPage(params.Page) {
+		err := client.DeleteAccessCustomPage(ctx, cloudflare.AccountIdentifier(ptr.Deref(params.AccountID, "")), id)
+		if IsPageNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errDeletePage)
+	}
+	_, err := client.UpdateCustomPage(ctx, options(params), params.Page, cloudflare.CustomPageParameters{URL: nil, State: StateDefault})
+	return errors.Wrap(err, errDeletePage)
+}
+
+// IsUpToDate returns true if the page is customized with the supplied
+// content.
+func IsUpToDate(params v1alpha1.CustomPageParameters, obs v1alpha1.CustomPageObservation, content string) bool {
+	if obs.State != StateCustomized {
+		return false
+	}
+	if IsAccessPage(params.Page) {
+		return ptr.Deref(obs.ContentHash, "") == Hash(content)
+	}
+	return ptr.Deref(obs.URL, "") == content
+}
+
+// GenerateObservation creates an observation of a zone or account page.
+func GenerateObservation(in cloudflare.CustomPage) v1alpha1.CustomPageObservation {
+	obs := v1alpha1.CustomPageObservation{
+		State:          in.State,
+		RequiredTokens: in.RequiredTokens,
+	}
+	if u, ok := in.URL.(string); ok && u != "" {
+		obs.URL = ptr.To(u)
+	}
+	if !in.ModifiedOn.IsZero() {
+		obs.ModifiedOn = &metav1.Time{Time: in.ModifiedOn}
+	}
+	return obs
+}
+
+// GenerateAccessObservation creates an observation of an access page. An
+// access page that exists is always customized.
+func GenerateAccessObservation(in cloudflare.AccessCustomPage) v1alpha1.CustomPageObservation {
+	obs := v1alpha1.CustomPageObservation{
+		State:    StateCustomized,
+		AppCount: ptr.To(in.AppCount),
+	}
+	if in.CustomHTML != "" {
+		obs.ContentHash = ptr.To(Hash(in.CustomHTML))
+	}
+	return obs
+}
+
+// options returns the options addressing the account or zone of a page.
+func options(params v1alpha1.CustomPageParameters) *cloudflare.CustomPageOptions {
+	if params.AccountID != nil {
+		return &cloudflare.CustomPageOptions{AccountID: *params.AccountID}
+	}
+	return &cloudflare.CustomPageOptions{ZoneID: ptr.Deref(params.Zone, "")}
+}
+
+// accessType returns the Access type of an access page.
+func accessType(page string) cloudflare.AccessCustomPageType {
+	return cloudflare.AccessCustomPageType(strings.TrimPrefix(page, accessPrefix))
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custompage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/zones/custompage/fake"
+)
+
+func TestPublish(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		params  v1alpha1.CustomPageParameters
+		id      string
+		content string
+	}
+
+	type want struct {
+		id  string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client Client
+		args   args
+		want   want
+	}{
+		"ZonePage": {
+			reason: "Zone pages should be customized with their URL",
+			client: &fake.ClientMock{
+				UpdateCustomPageFunc: func(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string, p cloudflare.CustomPageParameters) (cloudflare.CustomPage, error) {
+					want := cloudflare.CustomPageParameters{URL: "https://example.com/block.html", State: StateCustomized}
+					if options.ZoneID != "zone-id" || customPageID != "waf_block" || !cmp.Equal(want, p) {
+						return cloudflare.CustomPage{}, errors.New("unexpected update")
+					}
+					return cloudflare.CustomPage{}, nil
+				},
+			},
+			args: args{
+				params:  v1alpha1.CustomPageParameters{Page: "waf_block", Zone: ptr.To("zone-id")},
+				content: "https://example.com/block.html",
+			},
+			want: want{id: "waf_block"},
+		},
+		"ZonePageError": {
+			reason: "Errors customizing zone pages should be returned",
+			client: &fake.ClientMock{
+				UpdateCustomPageFunc: func(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string, p cloudflare.CustomPageParameters) (cloudflare.CustomPage, error) {
+					return cloudflare.CustomPage{}, errBoom
+				},
+			},
+			args: args{
+				params: v1alpha1.CustomPageParameters{Page: "waf_block", Zone: ptr.To("zone-id")},
+			},
+			want: want{id: "waf_block", err: errors.Wrap(errBoom, errUpdatePage)},
+		},
+		"CreateAccessPage": {
+			reason: "Access pages without an ID should be created on the account",
+			client: &fake.ClientMock{
+				CreateAccessCustomPageFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.CreateAccessCustomPageParams) (cloudflare.AccessCustomPage, error) {
+					want := cloudflare.CreateAccessCustomPageParams{Name: "denied", Type: cloudflare.IdentityDenied, CustomHTML: "<html/>"}
+					if rc.Identifier != "account-id" || !cmp.Equal(want, p) {
+						return cloudflare.AccessCustomPage{}, errors.New("unexpected create")
+					}
+					return cloudflare.AccessCustomPage{UID: "uid"}, nil
+				},
+			},
+			args: args{
+				params:  v1alpha1.CustomPageParameters{Page: "access_identity_denied", AccountID: ptr.To("account-id")},
+				content: "<html/>",
+			},
+			want: want{id: "uid"},
+		},
+		"UpdateAccessPage": {
+			reason: "Access pages with an ID should be updated",
+			client: &fake.ClientMock{
+				UpdateAccessCustomPageFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, p cloudflare.UpdateAccessCustomPageParams) (cloudflare.AccessCustomPage, error) {
+					if p.UID != "uid" || p.Type != cloudflare.Forbidden {
+						return cloudflare.AccessCustomPage{}, errors.New("unexpected update")
+					}
+					return cloudflare.AccessCustomPage{}, nil
+				},
+			},
+			args: args{
+				params:  v1alpha1.CustomPageParameters{Page: "access_forbidden", AccountID: ptr.To("account-id")},
+				id:      "uid",
+				content: "<html/>",
+			},
+			want: want{id: "uid"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := Publish(context.Background(), tc.client, "denied", tc.args.params, tc.args.id, tc.args.content)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPublish(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\n%s\nPublish(...): -want id, +got id:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params  v1alpha1.CustomPageParameters
+		obs     v1alpha1.CustomPageObservation
+		content string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"Default": {
+			reason: "A page serving the default content should not be up to date",
+			args: args{
+				params:  v1alpha1.CustomPageParameters{Page: "waf_block"},
+				obs:     v1alpha1.CustomPageObservation{State: StateDefault},
+				content: "https://example.com/block.html",
+			},
+			want: false,
+		},
+		"URLChanged": {
+			reason: "A page fetched from another URL should not be up to date",
+			args: args{
+				params:  v1alpha1.CustomPageParameters{Page: "waf_block"},
+				obs:     v1alpha1.CustomPageObservation{State: StateCustomized, URL: ptr.To("https://example.com/old.html")},
+				content: "https://example.com/block.html",
+			},
+			want: false,
+		},
+		"URLUpToDate": {
+			reason: "A page fetched from the desired URL should be up to date",
+			args: args{
+				params:  v1alpha1.CustomPageParameters{Page: "waf_block"},
+				obs:     v1alpha1.CustomPageObservation{State: StateCustomized, URL: ptr.To("https://example.com/block.html")},
+				content: "https://example.com/block.html",
+			},
+			want: true,
+		},
+		"ContentChanged": {
+			reason: "An access page whose HTML changed should not be up to date",
+			args: args{
+				params:  v1alpha1.CustomPageParameters{Page: "access_forbidden"},
+				obs:     v1alpha1.CustomPageObservation{State: StateCustomized, ContentHash: ptr.To(Hash("<html>old</html>"))},
+				content: "<html>new</html>",
+			},
+			want: false,
+		},
+		"ContentUpToDate": {
+			reason: "An access page serving the desired HTML should be up to date",
+			args: args{
+				params:  v1alpha1.CustomPageParameters{Page: "access_forbidden"},
+				obs:     GenerateAccessObservation(cloudflare.AccessCustomPage{CustomHTML: "<html/>"}),
+				content: "<html/>",
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.args.params, tc.args.obs, tc.args.content)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"sync"
+)
+
+// ClientMock is a mock implementation of custompage.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked custompage.Client
+//		mockedClient := &ClientMock{
+//			CreateAccessCustomPageFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessCustomPageParams) (cloudflare.AccessCustomPage, error) {
+//				panic("mock out the CreateAccessCustomPage method")
+//			},
+//			CustomPageFunc: func(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string) (cloudflare.CustomPage, error) {
+//				panic("mock out the CustomPage method")
+//			},
+//			DeleteAccessCustomPageFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, id string) error {
+//				panic("mock out the DeleteAccessCustomPage method")
+//			},
+//			GetAccessCustomPageFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, id string) (cloudflare.AccessCustomPage, error) {
+//				panic("mock out the GetAccessCustomPage method")
+//			},
+//			UpdateAccessCustomPageFunc: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateAccessCustomPageParams) (cloudflare.AccessCustomPage, error) {
+//				panic("mock out the UpdateAccessCustomPage method")
+//			},
+//			UpdateCustomPageFunc: func(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string, pageParameters cloudflare.CustomPageParameters) (cloudflare.CustomPage, error) {
+//				panic("mock out the UpdateCustomPage method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires custompage.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// CreateAccessCustomPageFunc mocks the CreateAccessCustomPage method.
+	CreateAccessCustomPageFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessCustomPageParams) (cloudflare.AccessCustomPage, error)
+
+	// CustomPageFunc mocks the CustomPage method.
+	CustomPageFunc func(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string) (cloudflare.CustomPage, error)
+
+	// DeleteAccessCustomPageFunc mocks the DeleteAccessCustomPage method.
+	DeleteAccessCustomPageFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, id string) error
+
+	// GetAccessCustomPageFunc mocks the GetAccessCustomPage method.
+	GetAccessCustomPageFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, id string) (cloudflare.AccessCustomPage, error)
+
+	// UpdateAccessCustomPageFunc mocks the UpdateAccessCustomPage method.
+	UpdateAccessCustomPageFunc func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateAccessCustomPageParams) (cloudflare.AccessCustomPage, error)
+
+	// UpdateCustomPageFunc mocks the UpdateCustomPage method.
+	UpdateCustomPageFunc func(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string, pageParameters cloudflare.CustomPageParameters) (cloudflare.CustomPage, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateAccessCustomPage holds details about calls to the CreateAccessCustomPage method.
+		CreateAccessCustomPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.CreateAccessCustomPageParams
+		}
+		// CustomPage holds details about calls to the CustomPage method.
+		CustomPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Options is the options argument value.
+			Options *cloudflare.CustomPageOptions
+			// CustomPageID is the customPageID argument value.
+			CustomPageID string
+		}
+		// DeleteAccessCustomPage holds details about calls to the DeleteAccessCustomPage method.
+		DeleteAccessCustomPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ID is the id argument value.
+			ID string
+		}
+		// GetAccessCustomPage holds details about calls to the GetAccessCustomPage method.
+		GetAccessCustomPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateAccessCustomPage holds details about calls to the UpdateAccessCustomPage method.
+		UpdateAccessCustomPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rc is the rc argument value.
+			Rc *cloudflare.ResourceContainer
+			// Params is the params argument value.
+			Params cloudflare.UpdateAccessCustomPageParams
+		}
+		// UpdateCustomPage holds details about calls to the UpdateCustomPage method.
+		UpdateCustomPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Options is the options argument value.
+			Options *cloudflare.CustomPageOptions
+			// CustomPageID is the customPageID argument value.
+			CustomPageID string
+			// PageParameters is the pageParameters argument value.
+			PageParameters cloudflare.CustomPageParameters
+		}
+	}
+	lockCreateAccessCustomPage sync.RWMutex
+	lockCustomPage             sync.RWMutex
+	lockDeleteAccessCustomPage sync.RWMutex
+	lockGetAccessCustomPage    sync.RWMutex
+	lockUpdateAccessCustomPage sync.RWMutex
+	lockUpdateCustomPage       sync.RWMutex
+}
+
+// CreateAccessCustomPage calls CreateAccessCustomPageFunc.
+func (mock *ClientMock) CreateAccessCustomPage(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateAccessCustomPageParams) (cloudflare.AccessCustomPage, error) {
+	if mock.CreateAccessCustomPageFunc == nil {
+		panic("ClientMock.CreateAccessCustomPageFunc: method is nil but Client.CreateAccessCustomPage was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateAccessCustomPageParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockCreateAccessCustomPage.Lock()
+	mock.calls.CreateAccessCustomPage = append(mock.calls.CreateAccessCustomPage, callInfo)
+	mock.lockCreateAccessCustomPage.Unlock()
+	return mock.CreateAccessCustomPageFunc(ctx, rc, params)
+}
+
+// CreateAccessCustomPageCalls gets all the calls that were made to CreateAccessCustomPage.
+// Check the length with:
+//
+//	len(mockedClient.CreateAccessCustomPageCalls())
+func (mock *ClientMock) CreateAccessCustomPageCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.CreateAccessCustomPageParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.CreateAccessCustomPageParams
+	}
+	mock.lockCreateAccessCustomPage.RLock()
+	calls = mock.calls.CreateAccessCustomPage
+	mock.lockCreateAccessCustomPage.RUnlock()
+	return calls
+}
+
+// CustomPage calls CustomPageFunc.
+func (mock *ClientMock) CustomPage(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string) (cloudflare.CustomPage, error) {
+	if mock.CustomPageFunc == nil {
+		panic("ClientMock.CustomPageFunc: method is nil but Client.CustomPage was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Options      *cloudflare.CustomPageOptions
+		CustomPageID string
+	}{
+		Ctx:          ctx,
+		Options:      options,
+		CustomPageID: customPageID,
+	}
+	mock.lockCustomPage.Lock()
+	mock.calls.CustomPage = append(mock.calls.CustomPage, callInfo)
+	mock.lockCustomPage.Unlock()
+	return mock.CustomPageFunc(ctx, options, customPageID)
+}
+
+// CustomPageCalls gets all the calls that were made to CustomPage.
+// Check the length with:
+//
+//	len(mockedClient.CustomPageCalls())
+func (mock *ClientMock) CustomPageCalls() []struct {
+	Ctx          context.Context
+	Options      *cloudflare.CustomPageOptions
+	CustomPageID string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Options      *cloudflare.CustomPageOptions
+		CustomPageID string
+	}
+	mock.lockCustomPage.RLock()
+	calls = mock.calls.CustomPage
+	mock.lockCustomPage.RUnlock()
+	return calls
+}
+
+// DeleteAccessCustomPage calls DeleteAccessCustomPageFunc.
+func (mock *ClientMock) DeleteAccessCustomPage(ctx context.Context, rc *cloudflare.ResourceContainer, id string) error {
+	if mock.DeleteAccessCustomPageFunc == nil {
+		panic("ClientMock.DeleteAccessCustomPageFunc: method is nil but Client.DeleteAccessCustomPage was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+		ID  string
+	}{
+		Ctx: ctx,
+		Rc:  rc,
+		ID:  id,
+	}
+	mock.lockDeleteAccessCustomPage.Lock()
+	mock.calls.DeleteAccessCustomPage = append(mock.calls.DeleteAccessCustomPage, callInfo)
+	mock.lockDeleteAccessCustomPage.Unlock()
+	return mock.DeleteAccessCustomPageFunc(ctx, rc, id)
+}
+
+// DeleteAccessCustomPageCalls gets all the calls that were made to DeleteAccessCustomPage.
+// Check the length with:
+//
+//	len(mockedClient.DeleteAccessCustomPageCalls())
+func (mock *ClientMock) DeleteAccessCustomPageCalls() []struct {
+	Ctx context.Context
+	Rc  *cloudflare.ResourceContainer
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+		ID  string
+	}
+	mock.lockDeleteAccessCustomPage.RLock()
+	calls = mock.calls.DeleteAccessCustomPage
+	mock.lockDeleteAccessCustomPage.RUnlock()
+	return calls
+}
+
+// GetAccessCustomPage calls GetAccessCustomPageFunc.
+func (mock *ClientMock) GetAccessCustomPage(ctx context.Context, rc *cloudflare.ResourceContainer, id string) (cloudflare.AccessCustomPage, error) {
+	if mock.GetAccessCustomPageFunc == nil {
+		panic("ClientMock.GetAccessCustomPageFunc: method is nil but Client.GetAccessCustomPage was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+		ID  string
+	}{
+		Ctx: ctx,
+		Rc:  rc,
+		ID:  id,
+	}
+	mock.lockGetAccessCustomPage.Lock()
+	mock.calls.GetAccessCustomPage = append(mock.calls.GetAccessCustomPage, callInfo)
+	mock.lockGetAccessCustomPage.Unlock()
+	return mock.GetAccessCustomPageFunc(ctx, rc, id)
+}
+
+// GetAccessCustomPageCalls gets all the calls that were made to GetAccessCustomPage.
+// Check the length with:
+//
+//	len(mockedClient.GetAccessCustomPageCalls())
+func (mock *ClientMock) GetAccessCustomPageCalls() []struct {
+	Ctx context.Context
+	Rc  *cloudflare.ResourceContainer
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Rc  *cloudflare.ResourceContainer
+		ID  string
+	}
+	mock.lockGetAccessCustomPage.RLock()
+	calls = mock.calls.GetAccessCustomPage
+	mock.lockGetAccessCustomPage.RUnlock()
+	return calls
+}
+
+// UpdateAccessCustomPage calls UpdateAccessCustomPageFunc.
+func (mock *ClientMock) UpdateAccessCustomPage(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateAccessCustomPageParams) (cloudflare.AccessCustomPage, error) {
+	if mock.UpdateAccessCustomPageFunc == nil {
+		panic("ClientMock.UpdateAccessCustomPageFunc: method is nil but Client.UpdateAccessCustomPage was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateAccessCustomPageParams
+	}{
+		Ctx:    ctx,
+		Rc:     rc,
+		Params: params,
+	}
+	mock.lockUpdateAccessCustomPage.Lock()
+	mock.calls.UpdateAccessCustomPage = append(mock.calls.UpdateAccessCustomPage, callInfo)
+	mock.lockUpdateAccessCustomPage.Unlock()
+	return mock.UpdateAccessCustomPageFunc(ctx, rc, params)
+}
+
+// UpdateAccessCustomPageCalls gets all the calls that were made to UpdateAccessCustomPage.
+// Check the length with:
+//
+//	len(mockedClient.UpdateAccessCustomPageCalls())
+func (mock *ClientMock) UpdateAccessCustomPageCalls() []struct {
+	Ctx    context.Context
+	Rc     *cloudflare.ResourceContainer
+	Params cloudflare.UpdateAccessCustomPageParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Rc     *cloudflare.ResourceContainer
+		Params cloudflare.UpdateAccessCustomPageParams
+	}
+	mock.lockUpdateAccessCustomPage.RLock()
+	calls = mock.calls.UpdateAccessCustomPage
+	mock.lockUpdateAccessCustomPage.RUnlock()
+	return calls
+}
+
+// UpdateCustomPage calls UpdateCustomPageFunc.
+func (mock *ClientMock) UpdateCustomPage(ctx context.Context, options *cloudflare.CustomPageOptions, customPageID string, pageParameters cloudflare.CustomPageParameters) (cloudflare.CustomPage, error) {
+	if mock.UpdateCustomPageFunc == nil {
+		panic("ClientMock.UpdateCustomPageFunc: method is nil but Client.UpdateCustomPage was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		Options        *cloudflare.CustomPageOptions
+		CustomPageID   string
+		PageParameters cloudflare.CustomPageParameters
+	}{
+		Ctx:            ctx,
+		Options:        options,
+		CustomPageID:   customPageID,
+		PageParameters: pageParameters,
+	}
+	mock.lockUpdateCustomPage.Lock()
+	mock.calls.UpdateCustomPage = append(mock.calls.UpdateCustomPage, callInfo)
+	mock.lockUpdateCustomPage.Unlock()
+	return mock.UpdateCustomPageFunc(ctx, options, customPageID, pageParameters)
+}
+
+// UpdateCustomPageCalls gets all the calls that were made to UpdateCustomPage.
+// Check the length with:
+//
+//	len(mockedClient.UpdateCustomPageCalls())
+func (mock *ClientMock) UpdateCustomPageCalls() []struct {
+	Ctx            context.Context
+	Options        *cloudflare.CustomPageOptions
+	CustomPageID   string
+	PageParameters cloudflare.CustomPageParameters
+} {
+	var calls []struct {
+		Ctx            context.Context
+		Options        *cloudflare.CustomPageOptions
+		CustomPageID   string
+		PageParameters cloudflare.CustomPageParameters
+	}
+	mock.lockUpdateCustomPage.RLock()
+	calls = mock.calls.UpdateCustomPage
+	mock.lockUpdateCustomPage.RUnlock()
+	return calls
+}
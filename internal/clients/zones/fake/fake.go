@@ -18,6 +18,7 @@ package fake
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 )
@@ -32,6 +33,8 @@ type MockClient struct {
 	MockZoneIDByName       func(zoneName string) (string, error)
 	MockZoneSetPlan        func(ctx context.Context, zoneID string, planType string) error
 	MockZoneSettings       func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error)
+	MockRaw                func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+	MockListDNSRecords     func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
 }
 
 // CreateZone mocks the CreateZone method of the Cloudflare API.
@@ -73,3 +76,13 @@ func (m MockClient) ZoneSetPlan(ctx context.Context, zoneID string, planType str
 func (m MockClient) ZoneSettings(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
 	return m.MockZoneSettings(ctx, zoneID)
 }
+
+// Raw mocks the Raw method of the Cloudflare API.
+func (m MockClient) Raw(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	return m.MockRaw(ctx, method, endpoint, data, headers)
+}
+
+// ListDNSRecords mocks the ListDNSRecords method of the Cloudflare API.
+func (m MockClient) ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+	return m.MockListDNSRecords(ctx, rc, params)
+}
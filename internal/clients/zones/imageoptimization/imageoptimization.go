@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package imageoptimization manages the image optimization settings of a
+// zone.
+package imageoptimization
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	settingPolish = "polish"
+	settingWebP   = "webp"
+	settingMirage = "mirage"
+
+	// valueOff is the value of each setting when it is disabled, which is
+	// also its default.
+	valueOff = "off"
+
+	errGetSettings    = "cannot get image optimization settings"
+	errUpdateSettings = "cannot update image optimization settings"
+)
+
+// Client is a Cloudflare API client that implements methods for working
+// with the image optimization settings of a zone.
+type Client = clients.ZoneSettingsAPI
+
+// NewClient returns a new Cloudflare API client for working with the image
+// optimization settings of a zone.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// Get returns the image optimization settings of a zone.
+func Get(ctx context.Context, client Client, zoneID string) (v1alpha1.ImageOptimizationObservation, error) {
+	res, err := client.ZoneSettings(ctx, zoneID)
+	if err != nil {
+		return v1alpha1.ImageOptimizationObservation{}, errors.Wrap(err, errGetSettings)
+	}
+	return GenerateObservation(res.Result), nil
+}
+
+// Update applies the image optimization settings that are specified.
+func Update(ctx context.Context, client Client, zoneID string, params v1alpha1.ImageOptimizationParameters) error {
+	cs := settings(params)
+	if len(cs) == 0 {
+		return nil
+	}
+	_, err := client.UpdateZoneSettings(ctx, zoneID, cs)
+	return errors.Wrap(err, errUpdateSettings)
+}
+
+// Reset turns off the image optimization settings that are specified,
+// returning them to their defaults.
+func Reset(ctx context.Context, client Client, zoneID string, params v1alpha1.ImageOptimizationParameters) error {
+	off := v1alpha1.ImageOptimizationParameters{}
+	if params.Polish != nil {
+		off.Polish = ptr.To(valueOff)
+	}
+	if params.WebP != nil {
+		off.WebP = ptr.To(valueOff)
+	}
+	if params.Mirage != nil {
+		off.Mirage = ptr.To(valueOff)
+	}
+	return Update(ctx, client, zoneID, off)
+}
+
+// IsReset returns true if the image optimization settings that are
+// specified are all turned off.
+func IsReset(params v1alpha1.ImageOptimizationParameters, obs v1alpha1.ImageOptimizationObservation) bool {
+	off := func(desired, observed *string) bool {
+		return desired == nil || ptr.Deref(observed, valueOff) == valueOff
+	}
+	return off(params.Polish, obs.Polish) && off(params.WebP, obs.WebP) && off(params.Mirage, obs.Mirage)
+}
+
+// IsUpToDate returns true if the image optimization settings that are
+// specified match those of the zone.
+func IsUpToDate(params v1alpha1.ImageOptimizationParameters, obs v1alpha1.ImageOptimizationObservation) bool {
+	upToDate := func(desired, observed *string) bool {
+		return desired == nil || ptr.Deref(observed, "") == *desired
+	}
+	return upToDate(params.Polish, obs.Polish) && upToDate(params.WebP, obs.WebP) && upToDate(params.Mirage, obs.Mirage)
+}
+
+// GenerateObservation creates an observation of the image optimization
+// settings among the supplied zone settings.
+func GenerateObservation(cs []cloudflare.ZoneSetting) v1alpha1.ImageOptimizationObservation {
+	obs := v1alpha1.ImageOptimizationObservation{}
+	for _, s := range cs {
+		v, ok := s.Value.(string)
+		if !ok {
+			continue
+		}
+		switch s.ID {
+		case settingPolish:
+			obs.Polish = ptr.To(v)
+		case settingWebP:
+			obs.WebP = ptr.To(v)
+		case settingMirage:
+			obs.Mirage = ptr.To(v)
+		}
+	}
+	return obs
+}
+
+// settings returns the zone settings that are specified.
+func settings(params v1alpha1.ImageOptimizationParameters) []cloudflare.ZoneSetting {
+	cs := []cloudflare.ZoneSetting{}
+	if params.Polish != nil {
+		cs = append(cs, cloudflare.ZoneSetting{ID: settingPolish, Value: *params.Polish})
+	}
+	if params.WebP != nil {
+		cs = append(cs, cloudflare.ZoneSetting{ID: settingWebP, Value: *params.WebP})
+	}
+	if params.Mirage != nil {
+		cs = append(cs, cloudflare.ZoneSetting{ID: settingMirage, Value: *params.Mirage})
+	}
+	return cs
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageoptimization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/fake"
+)
+
+func TestGet(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs v1alpha1.ImageOptimizationObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client Client
+		want   want
+	}{
+		"Error": {
+			reason: "Errors getting the zone settings should be returned",
+			client: &fake.ZoneSettingsAPIMock{
+				ZoneSettingsFunc: func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetSettings)},
+		},
+		"Success": {
+			reason: "Only the image optimization settings should be observed",
+			client: &fake.ZoneSettingsAPIMock{
+				ZoneSettingsFunc: func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+					return &cloudflare.ZoneSettingResponse{Result: []cloudflare.ZoneSetting{
+						{ID: "polish", Value: "lossy"},
+						{ID: "webp", Value: "on"},
+						{ID: "mirage", Value: "off"},
+						{ID: "brotli", Value: "on"},
+					}}, nil
+				},
+			},
+			want: want{obs: v1alpha1.ImageOptimizationObservation{
+				Polish: ptr.To("lossy"),
+				WebP:   ptr.To("on"),
+				Mirage: ptr.To("off"),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Get(context.Background(), tc.client, "zone-id")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateAndReset(t *testing.T) {
+	params := v1alpha1.ImageOptimizationParameters{Polish: ptr.To("lossless"), WebP: ptr.To("on")}
+
+	cases := map[string]struct {
+		reason string
+		fn     func(ctx context.Context, c Client) error
+		want   []cloudflare.ZoneSetting
+	}{
+		"Update": {
+			reason: "Only the settings that are specified should be updated",
+			fn: func(ctx context.Context, c Client) error {
+				return Update(ctx, c, "zone-id", params)
+			},
+			want: []cloudflare.ZoneSetting{{ID: "polish", Value: "lossless"}, {ID: "webp", Value: "on"}},
+		},
+		"Reset": {
+			reason: "Only the settings that are specified should be turned off",
+			fn: func(ctx context.Context, c Client) error {
+				return Reset(ctx, c, "zone-id", params)
+			},
+			want: []cloudflare.ZoneSetting{{ID: "polish", Value: "off"}, {ID: "webp", Value: "off"}},
+		},
+		"Nothing": {
+			reason: "The API should not be called when no settings are specified",
+			fn: func(ctx context.Context, c Client) error {
+				return Update(ctx, c, "zone-id", v1alpha1.ImageOptimizationParameters{})
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []cloudflare.ZoneSetting
+			c := &fake.ZoneSettingsAPIMock{
+				UpdateZoneSettingsFunc: func(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error) {
+					got = cs
+					return &cloudflare.ZoneSettingResponse{}, nil
+				},
+			}
+			if err := tc.fn(context.Background(), c); err != nil {
+				t.Fatalf("\n%s\nunexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\n-want settings, +got settings:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	obs := v1alpha1.ImageOptimizationObservation{Polish: ptr.To("lossy"), WebP: ptr.To("off"), Mirage: ptr.To("on")}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.ImageOptimizationParameters
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "Settings matching the zone should be up to date",
+			params: v1alpha1.ImageOptimizationParameters{Polish: ptr.To("lossy"), Mirage: ptr.To("on")},
+			want:   true,
+		},
+		"NotUpToDate": {
+			reason: "A setting differing from the zone should not be up to date",
+			params: v1alpha1.ImageOptimizationParameters{Polish: ptr.To("lossy"), WebP: ptr.To("on")},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.params, obs)); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
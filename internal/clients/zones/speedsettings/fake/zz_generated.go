@@ -0,0 +1,203 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package fake
+
+import (
+	"context"
+	"github.com/cloudflare/cloudflare-go"
+	"net/http"
+	"sync"
+)
+
+// ClientMock is a mock implementation of speedsettings.Client.
+//
+//	func TestSomethingThatUsesClient(t *testing.T) {
+//
+//		// make and configure a mocked speedsettings.Client
+//		mockedClient := &ClientMock{
+//			RawFunc: func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+//				panic("mock out the Raw method")
+//			},
+//			UpdateZoneSettingsFunc: func(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error) {
+//				panic("mock out the UpdateZoneSettings method")
+//			},
+//			ZoneSettingsFunc: func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+//				panic("mock out the ZoneSettings method")
+//			},
+//		}
+//
+//		// use mockedClient in code that requires speedsettings.Client
+//		// and then make assertions.
+//
+//	}
+type ClientMock struct {
+	// RawFunc mocks the Raw method.
+	RawFunc func(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+
+	// UpdateZoneSettingsFunc mocks the UpdateZoneSettings method.
+	UpdateZoneSettingsFunc func(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error)
+
+	// ZoneSettingsFunc mocks the ZoneSettings method.
+	ZoneSettingsFunc func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Raw holds details about calls to the Raw method.
+		Raw []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Method is the method argument value.
+			Method string
+			// Endpoint is the endpoint argument value.
+			Endpoint string
+			// Data is the data argument value.
+			Data interface{}
+			// Headers is the headers argument value.
+			Headers http.Header
+		}
+		// UpdateZoneSettings holds details about calls to the UpdateZoneSettings method.
+		UpdateZoneSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+			// Cs is the cs argument value.
+			Cs []cloudflare.ZoneSetting
+		}
+		// ZoneSettings holds details about calls to the ZoneSettings method.
+		ZoneSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneID is the zoneID argument value.
+			ZoneID string
+		}
+	}
+	lockRaw                sync.RWMutex
+	lockUpdateZoneSettings sync.RWMutex
+	lockZoneSettings       sync.RWMutex
+}
+
+// Raw calls RawFunc.
+func (mock *ClientMock) Raw(ctx context.Context, method string, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+	if mock.RawFunc == nil {
+		panic("ClientMock.RawFunc: method is nil but Client.Raw was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}{
+		Ctx:      ctx,
+		Method:   method,
+		Endpoint: endpoint,
+		Data:     data,
+		Headers:  headers,
+	}
+	mock.lockRaw.Lock()
+	mock.calls.Raw = append(mock.calls.Raw, callInfo)
+	mock.lockRaw.Unlock()
+	return mock.RawFunc(ctx, method, endpoint, data, headers)
+}
+
+// RawCalls gets all the calls that were made to Raw.
+// Check the length with:
+//
+//	len(mockedClient.RawCalls())
+func (mock *ClientMock) RawCalls() []struct {
+	Ctx      context.Context
+	Method   string
+	Endpoint string
+	Data     interface{}
+	Headers  http.Header
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Method   string
+		Endpoint string
+		Data     interface{}
+		Headers  http.Header
+	}
+	mock.lockRaw.RLock()
+	calls = mock.calls.Raw
+	mock.lockRaw.RUnlock()
+	return calls
+}
+
+// UpdateZoneSettings calls UpdateZoneSettingsFunc.
+func (mock *ClientMock) UpdateZoneSettings(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error) {
+	if mock.UpdateZoneSettingsFunc == nil {
+		panic("ClientMock.UpdateZoneSettingsFunc: method is nil but Client.UpdateZoneSettings was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneID string
+		Cs     []cloudflare.ZoneSetting
+	}{
+		Ctx:    ctx,
+		ZoneID: zoneID,
+		Cs:     cs,
+	}
+	mock.lockUpdateZoneSettings.Lock()
+	mock.calls.UpdateZoneSettings = append(mock.calls.UpdateZoneSettings, callInfo)
+	mock.lockUpdateZoneSettings.Unlock()
+	return mock.UpdateZoneSettingsFunc(ctx, zoneID, cs)
+}
+
+// UpdateZoneSettingsCalls gets all the calls that were made to UpdateZoneSettings.
+// Check the length with:
+//
+//	len(mockedClient.UpdateZoneSettingsCalls())
+func (mock *ClientMock) UpdateZoneSettingsCalls() []struct {
+	Ctx    context.Context
+	ZoneID string
+	Cs     []cloudflare.ZoneSetting
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneID string
+		Cs     []cloudflare.ZoneSetting
+	}
+	mock.lockUpdateZoneSettings.RLock()
+	calls = mock.calls.UpdateZoneSettings
+	mock.lockUpdateZoneSettings.RUnlock()
+	return calls
+}
+
+// ZoneSettings calls ZoneSettingsFunc.
+func (mock *ClientMock) ZoneSettings(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+	if mock.ZoneSettingsFunc == nil {
+		panic("ClientMock.ZoneSettingsFunc: method is nil but Client.ZoneSettings was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneID string
+	}{
+		Ctx:    ctx,
+		ZoneID: zoneID,
+	}
+	mock.lockZoneSettings.Lock()
+	mock.calls.ZoneSettings = append(mock.calls.ZoneSettings, callInfo)
+	mock.lockZoneSettings.Unlock()
+	return mock.ZoneSettingsFunc(ctx, zoneID)
+}
+
+// ZoneSettingsCalls gets all the calls that were made to ZoneSettings.
+// Check the length with:
+//
+//	len(mockedClient.ZoneSettingsCalls())
+func (mock *ClientMock) ZoneSettingsCalls() []struct {
+	Ctx    context.Context
+	ZoneID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneID string
+	}
+	mock.lockZoneSettings.RLock()
+	calls = mock.calls.ZoneSettings
+	mock.lockZoneSettings.RUnlock()
+	return calls
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package speedsettings manages the Crawler Hints, Early Hints, Rocket
+// Loader and HTTP/2 prioritization settings of a zone.
+package speedsettings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	settingEarlyHints       = "early_hints"
+	settingRocketLoader     = "rocket_loader"
+	settingH2Prioritization = "h2_prioritization"
+
+	// featureCrawlerHints is the cache product flag that enables Crawler
+	// Hints. Unlike the other speed settings it is not a zone setting.
+	featureCrawlerHints = "crawlhints_enabled"
+
+	// valueOn and valueOff are the values of each setting when it is
+	// enabled and disabled. Off is also the default of each setting.
+	valueOn  = "on"
+	valueOff = "off"
+
+	errGetSettings      = "cannot get speed settings"
+	errUpdateSettings   = "cannot update speed settings"
+	errGetCrawlerHints  = "cannot get crawler hints"
+	errSetCrawlerHints  = "cannot update crawler hints"
+	errCrawlerHintsFlag = "cannot parse cache product flags"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -rm -skip-ensure -pkg fake -out fake/zz_generated.go . Client
+
+// Client is a Cloudflare API client that implements methods for working
+// with the speed settings of a zone.
+type Client interface {
+	clients.RawAPI
+	clients.ZoneSettingsAPI
+}
+
+// NewClient returns a new Cloudflare API client for working with the speed
+// settings of a zone.
+func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// Get returns the speed settings of a zone. Crawler Hints are only read
+// when they are managed, as they are not a zone setting.
+func Get(ctx context.Context, client Client, zoneID string, params v1alpha1.SpeedSettingsParameters) (v1alpha1.SpeedSettingsObservation, error) {
+	res, err := client.ZoneSettings(ctx, zoneID)
+	if err != nil {
+		return v1alpha1.SpeedSettingsObservation{}, errors.Wrap(err, errGetSettings)
+	}
+	obs := GenerateObservation(res.Result)
+
+	if params.CrawlerHints != nil {
+		ch, err := getCrawlerHints(ctx, client, zoneID)
+		if err != nil {
+			return v1alpha1.SpeedSettingsObservation{}, err
+		}
+		obs.CrawlerHints = ptr.To(ch)
+	}
+	return obs, nil
+}
+
+// Update applies the speed settings that are specified.
+func Update(ctx context.Context, client Client, zoneID string, params v1alpha1.SpeedSettingsParameters) error {
+	if cs := settings(params); len(cs) > 0 {
+		if _, err := client.UpdateZoneSettings(ctx, zoneID, cs); err != nil {
+			return errors.Wrap(err, errUpdateSettings)
+		}
+	}
+	if params.CrawlerHints != nil {
+		return setCrawlerHints(ctx, client, zoneID, *params.CrawlerHints == valueOn)
+	}
+	return nil
+}
+
+// Reset turns off the speed settings that are specified, returning them to
+// their defaults.
+func Reset(ctx context.Context, client Client, zoneID string, params v1alpha1.SpeedSettingsParameters) error {
+	off := v1alpha1.SpeedSettingsParameters{}
+	if params.CrawlerHints != nil {
+		off.CrawlerHints = ptr.To(valueOff)
+	}
+	if params.EarlyHints != nil {
+		off.EarlyHints = ptr.To(valueOff)
+	}
+	if params.RocketLoader != nil {
+		off.RocketLoader = ptr.To(valueOff)
+	}
+	if params.H2Prioritization != nil {
+		off.H2Prioritization = ptr.To(valueOff)
+	}
+	return Update(ctx, client, zoneID, off)
+}
+
+// IsReset returns true if the speed settings that are specified are all
+// turned off.
+func IsReset(params v1alpha1.SpeedSettingsParameters, obs v1alpha1.SpeedSettingsObservation) bool {
+	off := func(desired, observed *string) bool {
+		return desired == nil || ptr.Deref(observed, valueOff) == valueOff
+	}
+	return off(params.CrawlerHints, obs.CrawlerHints) &&
+		off(params.EarlyHints, obs.EarlyHints) &&
+		off(params.RocketLoader, obs.RocketLoader) &&
+		off(params.H2Prioritization, obs.H2Prioritization)
+}
+
+// IsUpToDate returns true if the speed settings that are specified match
+// those of the zone.
+func IsUpToDate(params v1alpha1.SpeedSettingsParameters, obs v1alpha1.SpeedSettingsObservation) bool {
+	upToDate := func(desired, observed *string) bool {
+		return desired == nil || ptr.Deref(observed, "") == *desired
+	}
+	return upToDate(params.CrawlerHints, obs.CrawlerHints) &&
+		upToDate(params.EarlyHints, obs.EarlyHints) &&
+		upToDate(params.RocketLoader, obs.RocketLoader) &&
+		upToDate(params.H2Prioritization, obs.H2Prioritization)
+}
+
+// GenerateObservation creates an observation of the speed settings among
+// the supplied zone settings.
+func GenerateObservation(cs []cloudflare.ZoneSetting) v1alpha1.SpeedSettingsObservation {
+	obs := v1alpha1.SpeedSettingsObservation{}
+	for _, s := range cs {
+		v, ok := s.Value.(string)
+		if !ok {
+			continue
+		}
+		switch s.ID {
+		case settingEarlyHints:
+			obs.EarlyHints = ptr.To(v)
+		case settingRocketLoader:
+			obs.RocketLoader = ptr.To(v)
+		case settingH2Prioritization:
+			obs.H2Prioritization = ptr.To(v)
+		}
+	}
+	return obs
+}
+
+// settings returns the zone settings that are specified.
+func settings(params v1alpha1.SpeedSettingsParameters) []cloudflare.ZoneSetting {
+	cs := []cloudflare.ZoneSetting{}
+	if params.EarlyHints != nil {
+		cs = append(cs, cloudflare.ZoneSetting{ID: settingEarlyHints, Value: *params.EarlyHints})
+	}
+	if params.RocketLoader != nil {
+		cs = append(cs, cloudflare.ZoneSetting{ID: settingRocketLoader, Value: *params.RocketLoader})
+	}
+	if params.H2Prioritization != nil {
+		cs = append(cs, cloudflare.ZoneSetting{ID: settingH2Prioritization, Value: *params.H2Prioritization})
+	}
+	return cs
+}
+
+func crawlerHintsEndpoint(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/flags/products/cache/changes", zoneID)
+}
+
+// getCrawlerHints returns whether Crawler Hints are on or off for a zone.
+func getCrawlerHints(ctx context.Context, client Client, zoneID string) (string, error) {
+	res, err := client.Raw(ctx, http.MethodGet, crawlerHintsEndpoint(zoneID), nil, nil)
+	if err != nil {
+		return "", errors.Wrap(err, errGetCrawlerHints)
+	}
+	flags := map[string]json.RawMessage{}
+	if err := json.Unmarshal(res.Result, &flags); err != nil {
+		return "", errors.Wrap(err, errCrawlerHintsFlag)
+	}
+	enabled := false
+	if f, ok := flags[featureCrawlerHints]; ok {
+		if err := json.Unmarshal(f, &enabled); err != nil {
+			return "", errors.Wrap(err, errCrawlerHintsFlag)
+		}
+	}
+	if enabled {
+		return valueOn, nil
+	}
+	return valueOff, nil
+}
+
+// setCrawlerHints turns Crawler Hints on or off for a zone.
+func setCrawlerHints(ctx context.Context, client Client, zoneID string, enabled bool) error {
+	body := struct {
+		Feature string `json:"feature"`
+		Value   bool   `json:"value"`
+	}{Feature: featureCrawlerHints, Value: enabled}
+	_, err := client.Raw(ctx, http.MethodPut, crawlerHintsEndpoint(zoneID), body, nil)
+	return errors.Wrap(err, errSetCrawlerHints)
+}
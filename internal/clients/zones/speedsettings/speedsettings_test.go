@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package speedsettings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/zones/speedsettings/fake"
+)
+
+func settingsFn(cs ...cloudflare.ZoneSetting) func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+	return func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+		return &cloudflare.ZoneSettingResponse{Result: cs}, nil
+	}
+}
+
+func TestGet(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		client Client
+		params v1alpha1.SpeedSettingsParameters
+	}
+
+	type want struct {
+		obs v1alpha1.SpeedSettingsObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Error": {
+			reason: "Errors getting the zone settings should be returned",
+			args: args{
+				client: &fake.ClientMock{
+					ZoneSettingsFunc: func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetSettings)},
+		},
+		"Success": {
+			reason: "Only the speed settings should be observed, without Crawler Hints unless they are managed",
+			args: args{
+				client: &fake.ClientMock{
+					ZoneSettingsFunc: settingsFn(
+						cloudflare.ZoneSetting{ID: "early_hints", Value: "on"},
+						cloudflare.ZoneSetting{ID: "rocket_loader", Value: "off"},
+						cloudflare.ZoneSetting{ID: "h2_prioritization", Value: "custom"},
+						cloudflare.ZoneSetting{ID: "brotli", Value: "on"},
+					),
+				},
+				params: v1alpha1.SpeedSettingsParameters{EarlyHints: ptr.To("on")},
+			},
+			want: want{obs: v1alpha1.SpeedSettingsObservation{
+				EarlyHints:       ptr.To("on"),
+				RocketLoader:     ptr.To("off"),
+				H2Prioritization: ptr.To("custom"),
+			}},
+		},
+		"CrawlerHints": {
+			reason: "Crawler Hints should be observed from the cache product flags when they are managed",
+			args: args{
+				client: &fake.ClientMock{
+					ZoneSettingsFunc: settingsFn(),
+					RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						if method != http.MethodGet || endpoint != "/zones/zone-id/flags/products/cache/changes" {
+							return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+						}
+						return cloudflare.RawResponse{Result: json.RawMessage(`{"crawlhints_enabled":true}`)}, nil
+					},
+				},
+				params: v1alpha1.SpeedSettingsParameters{CrawlerHints: ptr.To("on")},
+			},
+			want: want{obs: v1alpha1.SpeedSettingsObservation{CrawlerHints: ptr.To("on")}},
+		},
+		"CrawlerHintsUnset": {
+			reason: "Crawler Hints should be observed as off when the flag has never been set",
+			args: args{
+				client: &fake.ClientMock{
+					ZoneSettingsFunc: settingsFn(),
+					RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: json.RawMessage(`{}`)}, nil
+					},
+				},
+				params: v1alpha1.SpeedSettingsParameters{CrawlerHints: ptr.To("on")},
+			},
+			want: want{obs: v1alpha1.SpeedSettingsObservation{CrawlerHints: ptr.To("off")}},
+		},
+		"CrawlerHintsError": {
+			reason: "Errors getting Crawler Hints should be returned",
+			args: args{
+				client: &fake.ClientMock{
+					ZoneSettingsFunc: settingsFn(),
+					RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{}, errBoom
+					},
+				},
+				params: v1alpha1.SpeedSettingsParameters{CrawlerHints: ptr.To("on")},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetCrawlerHints)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Get(context.Background(), tc.args.client, "zone-id", tc.args.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateAndReset(t *testing.T) {
+	params := v1alpha1.SpeedSettingsParameters{CrawlerHints: ptr.To("on"), RocketLoader: ptr.To("on"), H2Prioritization: ptr.To("custom")}
+
+	type want struct {
+		settings []cloudflare.ZoneSetting
+		flag     string
+	}
+
+	cases := map[string]struct {
+		reason string
+		fn     func(ctx context.Context, c Client) error
+		want   want
+	}{
+		"Update": {
+			reason: "Only the settings that are specified should be updated",
+			fn: func(ctx context.Context, c Client) error {
+				return Update(ctx, c, "zone-id", params)
+			},
+			want: want{
+				settings: []cloudflare.ZoneSetting{{ID: "rocket_loader", Value: "on"}, {ID: "h2_prioritization", Value: "custom"}},
+				flag:     `{"feature":"crawlhints_enabled","value":true}`,
+			},
+		},
+		"Reset": {
+			reason: "Only the settings that are specified should be turned off",
+			fn: func(ctx context.Context, c Client) error {
+				return Reset(ctx, c, "zone-id", params)
+			},
+			want: want{
+				settings: []cloudflare.ZoneSetting{{ID: "rocket_loader", Value: "off"}, {ID: "h2_prioritization", Value: "off"}},
+				flag:     `{"feature":"crawlhints_enabled","value":false}`,
+			},
+		},
+		"Nothing": {
+			reason: "The API should not be called when no settings are specified",
+			fn: func(ctx context.Context, c Client) error {
+				return Update(ctx, c, "zone-id", v1alpha1.SpeedSettingsParameters{})
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &fake.ClientMock{
+				UpdateZoneSettingsFunc: func(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error) {
+					got.settings = cs
+					return &cloudflare.ZoneSettingResponse{}, nil
+				},
+				RawFunc: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+					b, _ := json.Marshal(data)
+					got.flag = string(b)
+					return cloudflare.RawResponse{}, nil
+				},
+			}
+			if err := tc.fn(context.Background(), c); err != nil {
+				t.Fatalf("\n%s\nunexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\n-want settings, +got settings:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	obs := v1alpha1.SpeedSettingsObservation{CrawlerHints: ptr.To("on"), EarlyHints: ptr.To("off"), RocketLoader: ptr.To("on")}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.SpeedSettingsParameters
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "Settings matching the zone should be up to date",
+			params: v1alpha1.SpeedSettingsParameters{CrawlerHints: ptr.To("on"), RocketLoader: ptr.To("on")},
+			want:   true,
+		},
+		"NotUpToDate": {
+			reason: "A setting differing from the zone should not be up to date",
+			params: v1alpha1.SpeedSettingsParameters{RocketLoader: ptr.To("on"), EarlyHints: ptr.To("on")},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.params, obs)); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsReset(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.SpeedSettingsParameters
+		obs    v1alpha1.SpeedSettingsObservation
+		want   bool
+	}{
+		"Reset": {
+			reason: "Managed settings that are off or unreported should be reset",
+			params: v1alpha1.SpeedSettingsParameters{RocketLoader: ptr.To("on"), EarlyHints: ptr.To("on")},
+			obs:    v1alpha1.SpeedSettingsObservation{RocketLoader: ptr.To("off"), H2Prioritization: ptr.To("on")},
+			want:   true,
+		},
+		"NotReset": {
+			reason: "A managed setting that is still on should not be reset",
+			params: v1alpha1.SpeedSettingsParameters{H2Prioritization: ptr.To("custom")},
+			obs:    v1alpha1.SpeedSettingsObservation{H2Prioritization: ptr.To("custom")},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsReset(tc.params, tc.obs)); diff != "" {
+				t.Errorf("\n%s\nIsReset(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zones
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+)
+
+const (
+	errGetSettingsTemplate   = "cannot get zone settings template %q"
+	errSettingsTemplateCycle = "zone settings template %q inherits from itself"
+)
+
+// InheritSettings returns the supplied settings, with each setting they do
+// not set taken from the inherited settings. Settings are inherited whole,
+// so a zone setting e.g. minify.css replaces the inherited minify setting.
+func InheritSettings(inherited, zs *v1alpha1.ZoneSettings) *v1alpha1.ZoneSettings {
+	sm := zoneToSettingsMap(inherited)
+	for k, v := range zoneToSettingsMap(zs) {
+		sm[k] = v
+	}
+	out := &v1alpha1.ZoneSettings{}
+	settingsMapToZone(sm, out)
+	return out
+}
+
+// InheritedSettings returns the settings inherited through the referenced
+// ZoneSettingsTemplate, including those it inherits from the templates it
+// references in turn. It returns nil if no template is referenced.
+func InheritedSettings(ctx context.Context, kube client.Reader, ref *xpv1.Reference) (*v1alpha1.ZoneSettings, error) {
+	var chain []*v1alpha1.ZoneSettingsTemplate
+	seen := map[string]bool{}
+	for ref != nil {
+		if seen[ref.Name] {
+			return nil, errors.Errorf(errSettingsTemplateCycle, ref.Name)
+		}
+		seen[ref.Name] = true
+
+		t := &v1alpha1.ZoneSettingsTemplate{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name}, t); err != nil {
+			return nil, errors.Wrapf(err, errGetSettingsTemplate, ref.Name)
+		}
+		chain = append(chain, t)
+		ref = t.Spec.SettingsTemplateRef
+	}
+	if len(chain) == 0 {
+		return nil, nil
+	}
+
+	// Templates further down the chain override those they inherit from.
+	zs := &v1alpha1.ZoneSettings{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		zs = InheritSettings(zs, &chain[i].Spec.Settings)
+	}
+	return zs, nil
+}
+
+// WithInheritedSettings returns the supplied parameters with the supplied
+// inherited settings merged into their settings. The parameters are
+// returned as is if nothing is inherited.
+func WithInheritedSettings(spec *v1alpha1.ZoneParameters, inherited *v1alpha1.ZoneSettings) *v1alpha1.ZoneParameters {
+	if inherited == nil {
+		return spec
+	}
+	out := spec.DeepCopy()
+	out.Settings = *InheritSettings(inherited, &spec.Settings)
+	return out
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zones
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+)
+
+func TestInheritSettings(t *testing.T) {
+	type args struct {
+		inherited *v1alpha1.ZoneSettings
+		zs        *v1alpha1.ZoneSettings
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   *v1alpha1.ZoneSettings
+	}{
+		"NothingInherited": {
+			reason: "Settings should be returned as is when nothing is inherited",
+			args: args{
+				inherited: &v1alpha1.ZoneSettings{},
+				zs:        &v1alpha1.ZoneSettings{SSL: ptr.To("full")},
+			},
+			want: &v1alpha1.ZoneSettings{SSL: ptr.To("full")},
+		},
+		"InheritUnset": {
+			reason: "Settings that are not set should be inherited",
+			args: args{
+				inherited: &v1alpha1.ZoneSettings{SSL: ptr.To("strict"), BrowserCacheTTL: ptr.To[int64](14400)},
+				zs:        &v1alpha1.ZoneSettings{CacheLevel: ptr.To("aggressive")},
+			},
+			want: &v1alpha1.ZoneSettings{SSL: ptr.To("strict"), BrowserCacheTTL: ptr.To[int64](14400), CacheLevel: ptr.To("aggressive")},
+		},
+		"Override": {
+			reason: "Settings that are set should override inherited ones",
+			args: args{
+				inherited: &v1alpha1.ZoneSettings{SSL: ptr.To("strict"), MinTLSVersion: ptr.To("1.2")},
+				zs:        &v1alpha1.ZoneSettings{SSL: ptr.To("full")},
+			},
+			want: &v1alpha1.ZoneSettings{SSL: ptr.To("full"), MinTLSVersion: ptr.To("1.2")},
+		},
+		"OverrideWhole": {
+			reason: "Nested settings should be overridden as a whole",
+			args: args{
+				inherited: &v1alpha1.ZoneSettings{Minify: &v1alpha1.MinifySettings{CSS: ptr.To("on"), JS: ptr.To("on")}},
+				zs:        &v1alpha1.ZoneSettings{Minify: &v1alpha1.MinifySettings{CSS: ptr.To("off")}},
+			},
+			want: &v1alpha1.ZoneSettings{Minify: &v1alpha1.MinifySettings{CSS: ptr.To("off")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := InheritSettings(tc.args.inherited, tc.args.zs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nInheritSettings(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInheritedSettings(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	templates := func(ts ...v1alpha1.ZoneSettingsTemplate) test.MockGetFn {
+		return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			for _, t := range ts {
+				if t.GetName() == key.Name {
+					t.DeepCopyInto(obj.(*v1alpha1.ZoneSettingsTemplate))
+					return nil
+				}
+			}
+			return errBoom
+		}
+	}
+	template := func(name string, parent string, zs v1alpha1.ZoneSettings) v1alpha1.ZoneSettingsTemplate {
+		t := v1alpha1.ZoneSettingsTemplate{Spec: v1alpha1.ZoneSettingsTemplateSpec{Settings: zs}}
+		t.SetName(name)
+		if parent != "" {
+			t.Spec.SettingsTemplateRef = &xpv1.Reference{Name: parent}
+		}
+		return t
+	}
+
+	type want struct {
+		zs  *v1alpha1.ZoneSettings
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		get    test.MockGetFn
+		ref    *xpv1.Reference
+		want   want
+	}{
+		"NoTemplate": {
+			reason: "Nothing should be inherited when no template is referenced",
+			want:   want{},
+		},
+		"GetError": {
+			reason: "Errors getting a template should be returned",
+			get:    templates(),
+			ref:    &xpv1.Reference{Name: "org"},
+			want: want{
+				err: errors.Wrapf(errBoom, errGetSettingsTemplate, "org"),
+			},
+		},
+		"Tiered": {
+			reason: "Templates should override the templates they inherit from",
+			get: templates(
+				template("org", "", v1alpha1.ZoneSettings{SSL: ptr.To("strict"), MinTLSVersion: ptr.To("1.2")}),
+				template("team", "org", v1alpha1.ZoneSettings{SSL: ptr.To("full"), CacheLevel: ptr.To("aggressive")}),
+			),
+			ref: &xpv1.Reference{Name: "team"},
+			want: want{
+				zs: &v1alpha1.ZoneSettings{SSL: ptr.To("full"), MinTLSVersion: ptr.To("1.2"), CacheLevel: ptr.To("aggressive")},
+			},
+		},
+		"Cycle": {
+			reason: "Templates inheriting from themselves should be reported",
+			get: templates(
+				template("a", "b", v1alpha1.ZoneSettings{}),
+				template("b", "a", v1alpha1.ZoneSettings{}),
+			),
+			ref: &xpv1.Reference{Name: "a"},
+			want: want{
+				err: errors.Errorf(errSettingsTemplateCycle, "a"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := InheritedSettings(context.Background(), &test.MockClient{MockGet: tc.get}, tc.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInheritedSettings(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.zs, got); diff != "" {
+				t.Errorf("\n%s\nInheritedSettings(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeInherited(t *testing.T) {
+	spec := &v1alpha1.ZoneParameters{
+		AccountID: ptr.To("acc"),
+		Paused:    ptr.To(false),
+		PlanID:    ptr.To("free"),
+		Settings:  v1alpha1.ZoneSettings{CacheLevel: ptr.To("aggressive")},
+	}
+	observed := &v1alpha1.ZoneSettings{
+		SSL:        ptr.To("strict"),
+		CacheLevel: ptr.To("aggressive"),
+		Brotli:     ptr.To("on"),
+	}
+	inherited := &v1alpha1.ZoneSettings{SSL: ptr.To("strict")}
+
+	want := &v1alpha1.ZoneParameters{
+		AccountID: ptr.To("acc"),
+		Paused:    ptr.To(false),
+		PlanID:    ptr.To("free"),
+		Settings:  v1alpha1.ZoneSettings{CacheLevel: ptr.To("aggressive"), Brotli: ptr.To("on")},
+	}
+
+	if li := LateInitializeInherited(spec, cloudflare.Zone{}, observed, inherited); !li {
+		t.Errorf("\nSettings not inherited should be late initialized\nLateInitializeInherited(...): want true, got false\n")
+	}
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("\nInherited settings should not be late initialized\nLateInitializeInherited(...): -want, +got:\n%s\n", diff)
+	}
+}
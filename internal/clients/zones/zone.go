@@ -18,7 +18,11 @@ package zones
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
@@ -27,16 +31,48 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/cloudflare/cloudflare-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 )
 
 const (
-	errLoadSettings   = "error loading settings"
-	errUpdateZone     = "error updating zone"
-	errSetPlan        = "error setting plan"
-	errUpdateSettings = "error updating settings"
+	// ConnectionDetailZoneID is the connection detail holding the ID of
+	// a zone.
+	ConnectionDetailZoneID = "zoneId"
+
+	// ConnectionDetailName is the connection detail holding the name of
+	// a zone.
+	ConnectionDetailName = "name"
+
+	// ConnectionDetailNameServers is the connection detail holding the
+	// comma separated name servers a zone is delegated to.
+	ConnectionDetailNameServers = "nameServers"
+
+	// ConnectionDetailNameServerPrefix prefixes the connection details
+	// holding each name server of a zone, e.g. nameServer0.
+	ConnectionDetailNameServerPrefix = "nameServer"
+)
+
+const (
+	errLoadSettings         = "error loading settings"
+	errUpdateZone           = "error updating zone"
+	errSetPlan              = "error setting plan"
+	errUpdateSettings       = "error updating settings"
+	errEntitlements         = "error loading entitlements"
+	errZoneQuota            = "error loading account zone quota"
+	errListJumpStartRecords = "error listing jump start records"
+
+	// Keys of the zone entitlements surfaced as fields of their own.
+	entitlementPageRules         = "page_rules"
+	entitlementRateLimitingRules = "rate_limiting.max_rules"
+	entitlementWorkerRoutes      = "workers.routes"
+
+	// Key of the account entitlement limiting how many zones it may hold.
+	entitlementAccountZones = "zones.max_count"
 
 	// Hardcoded string in cloudflare-go library.
 	// It is used to detect a 'not found' zone
@@ -113,6 +149,17 @@ const (
 	cfsWebSockets                               = "websockets"
 )
 
+// Security levels of a zone.
+const (
+	// SecurityLevelUnderAttack is the security level of a zone in Under
+	// Attack Mode.
+	SecurityLevelUnderAttack = "under_attack"
+
+	// securityLevelDefault is the security level a zone leaving Under
+	// Attack Mode is restored to when no other level is desired.
+	securityLevelDefault = "medium"
+)
+
 // toMinifySettings converts an interface from the Cloudflare API
 // into a MinifySettings type.
 func toMinifySettings(in interface{}) *v1alpha1.MinifySettings {
@@ -200,6 +247,119 @@ func toSecurityHeaderSettings(in interface{}) *v1alpha1.SecurityHeaderSettings {
 	return nil
 }
 
+// dedicatedSettings are the zone settings that may also be managed by a
+// resource of their own, i.e. an ImageOptimization or a SpeedSettings. They
+// are not late initialized, so that a Zone only manages them when they are
+// set in its spec.
+var dedicatedSettings = map[string]bool{
+	cfsMirage:       true,
+	cfsPolish:       true,
+	cfsRocketLoader: true,
+	cfsWebP:         true,
+}
+
+// Groups of settings sharing a remediation policy.
+const (
+	groupSecurity    = "security"
+	groupTLS         = "tls"
+	groupCaching     = "caching"
+	groupPerformance = "performance"
+	groupNetwork     = "network"
+)
+
+// settingGroups maps settings to the group of their remediation policy.
+// Settings that are not listed use the default policy.
+var settingGroups = map[string]string{
+	cfsAdvancedDDOS:            groupSecurity,
+	cfsBrowserCheck:            groupSecurity,
+	cfsChallengeTTL:            groupSecurity,
+	cfsEmailObfuscation:        groupSecurity,
+	cfsHotlinkProtection:       groupSecurity,
+	cfsPrivacyPass:             groupSecurity,
+	cfsSecurityHeader:          groupSecurity,
+	cfsSecurityLevel:           groupSecurity,
+	cfsServerSideExclude:       groupSecurity,
+	cfsWAF:                     groupSecurity,
+	cfsAlwaysUseHTTPS:          groupTLS,
+	cfsAutomaticHTTPSRewrites:  groupTLS,
+	cfsCiphers:                 groupTLS,
+	cfsMinTLSVersion:           groupTLS,
+	cfsOpportunisticEncryption: groupTLS,
+	cfsSSL:                     groupTLS,
+	cfsTLS13:                   groupTLS,
+	cfsTLSClientAuth:           groupTLS,
+	cfsAlwaysOnline:            groupCaching,
+	cfsBrowserCacheTTL:         groupCaching,
+	cfsCacheLevel:              groupCaching,
+	cfsDevelopmentMode:         groupCaching,
+	cfsEdgeCacheTTL:            groupCaching,
+	cfsSortQueryStringForCache: groupCaching,
+	cfsBrotli:                  groupPerformance,
+	cfsHTTP2:                   groupPerformance,
+	cfsHTTP3:                   groupPerformance,
+	cfsMinify:                  groupPerformance,
+	cfsMirage:                  groupPerformance,
+	cfsPolish:                  groupPerformance,
+	cfsPrefetchPreload:         groupPerformance,
+	cfsResponseBuffering:       groupPerformance,
+	cfsRocketLoader:            groupPerformance,
+	cfsWebP:                    groupPerformance,
+	cfsZeroRTT:                 groupPerformance,
+	cfsCnameFlattening:         groupNetwork,
+	cfsIPGeolocation:           groupNetwork,
+	cfsIPv6:                    groupNetwork,
+	cfsMaxUpload:               groupNetwork,
+	cfsOpportunisticOnion:      groupNetwork,
+	cfsOrangeToOrange:          groupNetwork,
+	cfsPseudoIPv4:              groupNetwork,
+	cfsTrueClientIPHeader:      groupNetwork,
+	cfsVisitorIP:               groupNetwork,
+	cfsWebSockets:              groupNetwork,
+}
+
+// Remediation returns the remediation policy of the supplied setting.
+// Settings are enforced unless a policy says otherwise.
+func Remediation(p *v1alpha1.ZoneSettingsRemediationPolicy, setting string) string {
+	if p == nil {
+		return v1alpha1.RemediationEnforce
+	}
+
+	var gp *string
+	switch settingGroups[setting] {
+	case groupSecurity:
+		gp = p.Security
+	case groupTLS:
+		gp = p.TLS
+	case groupCaching:
+		gp = p.Caching
+	case groupPerformance:
+		gp = p.Performance
+	case groupNetwork:
+		gp = p.Network
+	}
+
+	switch {
+	case gp != nil:
+		return *gp
+	case p.Default != nil:
+		return *p.Default
+	default:
+		return v1alpha1.RemediationEnforce
+	}
+}
+
+// withRemediation returns the settings of the supplied map whose
+// remediation policy is the supplied one.
+func withRemediation(sm ZoneSettingsMap, p *v1alpha1.ZoneSettingsRemediationPolicy, policy string) ZoneSettingsMap {
+	out := ZoneSettingsMap{}
+	for k, v := range sm {
+		if Remediation(p, k) == policy {
+			out[k] = v
+		}
+	}
+	return out
+}
+
 // ZoneSettingsMap contains pairs of keys and values
 // that represent settings on a Zone.
 type ZoneSettingsMap map[string]interface{}
@@ -213,16 +373,7 @@ func IsZoneNotFound(err error) bool {
 
 // Client is a Cloudflare API client that implements methods for working
 // with Zones.
-type Client interface {
-	CreateZone(ctx context.Context, name string, jumpstart bool, account cloudflare.Account, zoneType string) (cloudflare.Zone, error)
-	DeleteZone(ctx context.Context, zoneID string) (cloudflare.ZoneID, error)
-	EditZone(ctx context.Context, zoneID string, zoneOpts cloudflare.ZoneOptions) (cloudflare.Zone, error)
-	UpdateZoneSettings(ctx context.Context, zoneID string, cs []cloudflare.ZoneSetting) (*cloudflare.ZoneSettingResponse, error)
-	ZoneDetails(ctx context.Context, zoneID string) (cloudflare.Zone, error)
-	ZoneIDByName(zoneName string) (string, error)
-	ZoneSetPlan(ctx context.Context, zoneID string, planType string) error
-	ZoneSettings(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error)
-}
+type Client = clients.ZoneAPI
 
 // NewClient returns a new Cloudflare API client for working with Zones.
 func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
@@ -251,9 +402,182 @@ func GenerateObservation(in cloudflare.Zone) v1alpha1.ZoneObservation {
 	}
 }
 
+// ConnectionDetails returns the connection details of a zone: its ID, name
+// and the name servers it should be delegated to, which are its vanity
+// name servers if it has any.
+func ConnectionDetails(in cloudflare.Zone) managed.ConnectionDetails {
+	ns := in.NameServers
+	if len(in.VanityNS) > 0 {
+		ns = in.VanityNS
+	}
+	cd := managed.ConnectionDetails{
+		ConnectionDetailZoneID:      []byte(in.ID),
+		ConnectionDetailName:        []byte(in.Name),
+		ConnectionDetailNameServers: []byte(strings.Join(ns, ",")),
+	}
+	for i, n := range ns {
+		cd[ConnectionDetailNameServerPrefix+strconv.Itoa(i)] = []byte(n)
+	}
+	return cd
+}
+
+// JumpStartRecords returns the DNS records of the supplied zone, sorted by
+// name and type. Called once a zone was created with jump start, these are
+// the records Cloudflare imported from its previous nameservers.
+func JumpStartRecords(ctx context.Context, client Client, zoneID string) ([]v1alpha1.JumpStartRecord, error) {
+	recs, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{})
+	if err != nil {
+		return nil, errors.Wrap(err, errListJumpStartRecords)
+	}
+	out := make([]v1alpha1.JumpStartRecord, 0, len(recs))
+	for _, r := range recs {
+		jr := v1alpha1.JumpStartRecord{
+			ID:      r.ID,
+			Type:    r.Type,
+			Name:    r.Name,
+			Content: r.Content,
+			TTL:     r.TTL,
+			Proxied: ptr.Deref(r.Proxied, false),
+		}
+		if r.Priority != nil {
+			jr.Priority = ptr.To(int32(*r.Priority))
+		}
+		out = append(out, jr)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].Type < out[j].Type
+	})
+	return out, nil
+}
+
+// entitlement is a feature allocated to a zone by its plan, as returned by
+// the zone entitlements endpoint.
+type entitlement struct {
+	Feature struct {
+		Key string `json:"key"`
+	} `json:"feature"`
+	Allocation struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	} `json:"allocation"`
+}
+
+// Entitlements loads the features available on the plan of the supplied
+// zone. The Page Rule quota of the zone is used when its entitlements do
+// not include one.
+func Entitlements(ctx context.Context, client Client, z cloudflare.Zone) (*v1alpha1.ZoneEntitlements, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/entitlements", z.ID), nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errEntitlements)
+	}
+	var ents []entitlement
+	if err := json.Unmarshal(res.Result, &ents); err != nil {
+		return nil, errors.Wrap(err, errEntitlements)
+	}
+
+	out := &v1alpha1.ZoneEntitlements{}
+	if len(ents) > 0 {
+		out.Features = make(map[string]string, len(ents))
+	}
+	for _, e := range ents {
+		v := strings.Trim(string(e.Allocation.Value), `"`)
+		out.Features[e.Feature.Key] = v
+
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch e.Feature.Key {
+		case entitlementPageRules:
+			out.PageRules = &n
+		case entitlementRateLimitingRules:
+			out.RateLimitingRules = &n
+		case entitlementWorkerRoutes:
+			out.WorkerRoutes = &n
+		}
+	}
+
+	if out.PageRules == nil {
+		n := int64(z.Meta.PageRuleQuota)
+		out.PageRules = &n
+	}
+	ratelimiting := out.RateLimitingRules != nil && *out.RateLimitingRules > 0
+	out.RateLimiting = &ratelimiting
+
+	return out, nil
+}
+
+// A ZoneQuota is the number of zones an account may hold, and the number
+// it holds.
+type ZoneQuota struct {
+	Limit int64
+	Used  int64
+}
+
+// Remaining returns the number of zones that may still be added to the
+// account.
+func (q ZoneQuota) Remaining() int64 {
+	if q.Used >= q.Limit {
+		return 0
+	}
+	return q.Limit - q.Used
+}
+
+// AccountZoneQuota loads the zone quota of the supplied account. No quota
+// is returned when the entitlements of the account do not limit its zones.
+func AccountZoneQuota(ctx context.Context, client Client, accountID string) (*ZoneQuota, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/entitlements", accountID), nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errZoneQuota)
+	}
+	var ents []entitlement
+	if err := json.Unmarshal(res.Result, &ents); err != nil {
+		return nil, errors.Wrap(err, errZoneQuota)
+	}
+
+	var q *ZoneQuota
+	for _, e := range ents {
+		if e.Feature.Key != entitlementAccountZones {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.Trim(string(e.Allocation.Value), `"`), 10, 64)
+		if err != nil {
+			continue
+		}
+		q = &ZoneQuota{Limit: n}
+	}
+	if q == nil {
+		return nil, nil
+	}
+
+	// Only the total of the result info is of interest, so ask for as
+	// small a page of zones as possible.
+	res, err = client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones?account.id=%s&per_page=5", accountID), nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errZoneQuota)
+	}
+	if res.ResultInfo == nil {
+		return nil, errors.Errorf("%s: response has no result info", errZoneQuota)
+	}
+	q.Used = int64(res.ResultInfo.Total)
+
+	return q, nil
+}
+
 // LateInitialize initializes ZoneParameters based on the remote resource
 func LateInitialize(spec *v1alpha1.ZoneParameters, z cloudflare.Zone,
 	ozs *v1alpha1.ZoneSettings) bool {
+	return LateInitializeInherited(spec, z, ozs, nil)
+}
+
+// LateInitializeInherited initializes ZoneParameters based on the remote
+// resource, except for the supplied inherited settings. Those are left
+// unset so that the zone keeps following its settings template.
+func LateInitializeInherited(spec *v1alpha1.ZoneParameters, z cloudflare.Zone,
+	ozs, inherited *v1alpha1.ZoneSettings) bool {
 
 	if spec == nil {
 		return false
@@ -281,6 +605,11 @@ func LateInitialize(spec *v1alpha1.ZoneParameters, z cloudflare.Zone,
 	// Settings, so we can work out which fields need initialising.
 	desired := zoneToSettingsMap(&spec.Settings)
 	observed := zoneToSettingsMap(ozs)
+	if inherited != nil {
+		for k := range zoneToSettingsMap(inherited) {
+			delete(observed, k)
+		}
+	}
 
 	if LateInitializeSettings(observed, desired, &spec.Settings) {
 		li = true
@@ -373,6 +702,9 @@ func LateInitializeSettings(observed, desired ZoneSettingsMap, initOn *v1alpha1.
 		// If our local value is nil (i.e. unset), then init it
 		// and set our late init state to true.
 		if _, ok := desired[k]; !ok {
+			if dedicatedSettings[k] {
+				continue
+			}
 			desired[k] = v
 			li = true
 		} else {
@@ -642,13 +974,36 @@ func zoneToSettingsMap(zs *v1alpha1.ZoneSettings) ZoneSettingsMap {
 	return sm
 }
 
+// DesiredSettings returns the desired settings of the supplied zone, whose
+// security level is overridden while its Under Attack Mode is set. Leaving
+// Under Attack Mode restores the desired security level, or the default
+// level when none is desired and the supplied observed settings are still
+// under attack.
+func DesiredSettings(spec *v1alpha1.ZoneParameters, ozs *v1alpha1.ZoneSettings) *v1alpha1.ZoneSettings {
+	ds := spec.Settings.DeepCopy()
+	if spec.UnderAttackMode == nil {
+		return ds
+	}
+
+	switch {
+	case *spec.UnderAttackMode:
+		ds.SecurityLevel = ptr.To(SecurityLevelUnderAttack)
+	case ptr.Deref(ds.SecurityLevel, "") == SecurityLevelUnderAttack:
+		ds.SecurityLevel = ptr.To(securityLevelDefault)
+	case ds.SecurityLevel == nil && ozs != nil && ptr.Deref(ozs.SecurityLevel, "") == SecurityLevelUnderAttack:
+		ds.SecurityLevel = ptr.To(securityLevelDefault)
+	}
+	return ds
+}
+
 // GetChangedSettings builds a map of only the settings whose
-// values need to be updated.
-func GetChangedSettings(czs, dzs *v1alpha1.ZoneSettings) []cloudflare.ZoneSetting {
+// values need to be updated. Settings whose remediation policy is not
+// Enforce are never updated.
+func GetChangedSettings(czs, dzs *v1alpha1.ZoneSettings, p *v1alpha1.ZoneSettingsRemediationPolicy) []cloudflare.ZoneSetting {
 	out := []cloudflare.ZoneSetting{}
 
 	current := zoneToSettingsMap(czs)
-	desired := zoneToSettingsMap(dzs)
+	desired := withRemediation(zoneToSettingsMap(dzs), p, v1alpha1.RemediationEnforce)
 
 	for k, nv := range desired {
 		cv := current[k]
@@ -666,6 +1021,23 @@ func GetChangedSettings(czs, dzs *v1alpha1.ZoneSettings) []cloudflare.ZoneSettin
 	return out
 }
 
+// DriftedSettings returns the sorted IDs of the settings whose remediation
+// policy is Alert and whose observed values differ from their desired
+// values.
+func DriftedSettings(spec *v1alpha1.ZoneParameters, ozs *v1alpha1.ZoneSettings) []string {
+	current := zoneToSettingsMap(ozs)
+	desired := withRemediation(zoneToSettingsMap(DesiredSettings(spec, ozs)), spec.RemediationPolicy, v1alpha1.RemediationAlert)
+
+	var out []string
+	for k, v := range desired {
+		if !cmp.Equal(current[k], v) {
+			out = append(out, k)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
 // UpToDate checks if the remote resource is up to date with the
 // requested resource parameters.
 func UpToDate(spec *v1alpha1.ZoneParameters, z cloudflare.Zone, ozs *v1alpha1.ZoneSettings) bool { //nolint:gocyclo
@@ -705,7 +1077,11 @@ func UpToDate(spec *v1alpha1.ZoneParameters, z cloudflare.Zone, ozs *v1alpha1.Zo
 	// Have a look at https://pkg.go.dev/github.com/google/go-cmp@v0.5.4/cmp/cmpopts
 	// to see if what you're looking for is supported by the cmp library
 	// before implementing here.
-	if !cmp.Equal(*ozs, spec.Settings) {
+	// Only settings that are enforced are compared, so that drift of the
+	// others never triggers an update.
+	p := spec.RemediationPolicy
+	if !cmp.Equal(withRemediation(zoneToSettingsMap(ozs), p, v1alpha1.RemediationEnforce),
+		withRemediation(zoneToSettingsMap(DesiredSettings(spec, ozs)), p, v1alpha1.RemediationEnforce)) {
 		return false
 	}
 	return true
@@ -762,7 +1138,7 @@ func UpdateZone(ctx context.Context, client Client, zoneID string, spec v1alpha1
 
 	// See if any settings were updated, otherwise return
 	// update is complete.
-	cs := GetChangedSettings(&curSettings, &spec.Settings)
+	cs := GetChangedSettings(&curSettings, DesiredSettings(&spec, &curSettings), spec.RemediationPolicy)
 	if len(cs) < 1 {
 		return nil
 	}
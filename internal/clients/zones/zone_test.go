@@ -18,6 +18,7 @@ package zones
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -27,6 +28,7 @@ import (
 
 	"k8s.io/utils/ptr"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
@@ -91,6 +93,43 @@ func TestLateInitialize(t *testing.T) {
 				},
 			},
 		},
+		"DedicatedSettingsNotLateInit": {
+			reason: "LateInit should not take over settings which may be managed by an ImageOptimization or SpeedSettings",
+			args: args{
+				zp: &v1alpha1.ZoneParameters{
+					AccountID: ptr.To("beef"),
+					Paused:    ptr.To(false),
+					PlanID:    ptr.To("dead"),
+					Settings: v1alpha1.ZoneSettings{
+						// This setting is managed by the Zone, as it is set
+						Mirage: ptr.To("off"),
+					},
+				},
+				z: cloudflare.Zone{
+					Account: cloudflare.Account{ID: "beef"},
+					Plan: cloudflare.ZonePlan{
+						ZonePlanCommon: cloudflare.ZonePlanCommon{ID: "dead"},
+					},
+				},
+				czs: &v1alpha1.ZoneSettings{
+					Mirage:       ptr.To("on"),
+					Polish:       ptr.To("lossy"),
+					RocketLoader: ptr.To("on"),
+					WebP:         ptr.To("on"),
+				},
+			},
+			want: want{
+				o: false,
+				zp: &v1alpha1.ZoneParameters{
+					AccountID: ptr.To("beef"),
+					Paused:    ptr.To(false),
+					PlanID:    ptr.To("dead"),
+					Settings: v1alpha1.ZoneSettings{
+						Mirage: ptr.To("off"),
+					},
+				},
+			},
+		},
 		"SuccessSettings": {
 			reason: "LateInit should update settings from a Zone",
 			args: args{
@@ -451,6 +490,28 @@ func TestUpToDate(t *testing.T) {
 				o: false,
 			},
 		},
+		"SettingsNotEnforced": {
+			reason: "UpToDate should return true if only settings that are not enforced are different",
+			args: args{
+				zp: &v1alpha1.ZoneParameters{
+					Settings: v1alpha1.ZoneSettings{
+						SecurityLevel: ptr.To("medium"),
+						Brotli:        ptr.To("on"),
+					},
+					RemediationPolicy: &v1alpha1.ZoneSettingsRemediationPolicy{
+						Security:    ptr.To(v1alpha1.RemediationAlert),
+						Performance: ptr.To(v1alpha1.RemediationIgnore),
+					},
+				},
+				ozs: &v1alpha1.ZoneSettings{
+					SecurityLevel: ptr.To("under_attack"),
+					Brotli:        ptr.To("off"),
+				},
+			},
+			want: want{
+				o: true,
+			},
+		},
 		"VanityNSTrue": {
 			reason: "UpToDate should return true if VanityNS field matches in any order",
 			args: args{
@@ -903,6 +964,135 @@ func TestLoadSettingsForZone(t *testing.T) {
 	}
 }
 
+func TestEntitlements(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		o   *v1alpha1.ZoneEntitlements
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		raw    func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+		zone   cloudflare.Zone
+		want   want
+	}{
+		"ErrorLookupEntitlements": {
+			reason: "Entitlements should return an error when the API call returns an error",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, errBoom
+			},
+			zone: cloudflare.Zone{ID: "abcd"},
+			want: want{err: errors.Wrap(errBoom, errEntitlements)},
+		},
+		"Entitlements": {
+			reason: "Entitlements should surface known entitlements as fields and all of them as features",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				if method != http.MethodGet || endpoint != "/zones/abcd/entitlements" {
+					return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+				}
+				return cloudflare.RawResponse{Result: []byte(`[
+					{"feature": {"key": "page_rules"}, "allocation": {"type": "max_count", "value": 20}},
+					{"feature": {"key": "rate_limiting.max_rules"}, "allocation": {"type": "max_count", "value": 5}},
+					{"feature": {"key": "workers.routes"}, "allocation": {"type": "max_count", "value": 1000}},
+					{"feature": {"key": "image_resizing"}, "allocation": {"type": "boolean", "value": true}}
+				]`)}, nil
+			},
+			zone: cloudflare.Zone{ID: "abcd", Meta: cloudflare.ZoneMeta{PageRuleQuota: 3}},
+			want: want{o: &v1alpha1.ZoneEntitlements{
+				PageRules:         ptr.To[int64](20),
+				RateLimiting:      ptr.To(true),
+				RateLimitingRules: ptr.To[int64](5),
+				WorkerRoutes:      ptr.To[int64](1000),
+				Features: map[string]string{
+					"page_rules":              "20",
+					"rate_limiting.max_rules": "5",
+					"workers.routes":          "1000",
+					"image_resizing":          "true",
+				},
+			}},
+		},
+		"NoEntitlements": {
+			reason: "Entitlements should fall back to the Page Rule quota of the zone and report rate limiting as unavailable",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+			},
+			zone: cloudflare.Zone{ID: "abcd", Meta: cloudflare.ZoneMeta{PageRuleQuota: 3}},
+			want: want{o: &v1alpha1.ZoneEntitlements{
+				PageRules:    ptr.To[int64](3),
+				RateLimiting: ptr.To(false),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Entitlements(context.Background(), fake.MockClient{MockRaw: tc.raw}, tc.zone)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nEntitlements(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\nEntitlements(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAccountZoneQuota(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		o   *ZoneQuota
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		raw    func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error)
+		want   want
+	}{
+		"ErrorLookupEntitlements": {
+			reason: "AccountZoneQuota should return an error when the API call returns an error",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{}, errBoom
+			},
+			want: want{err: errors.Wrap(errBoom, errZoneQuota)},
+		},
+		"Unlimited": {
+			reason: "AccountZoneQuota should return no quota when the account entitlements do not limit its zones",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				return cloudflare.RawResponse{Result: []byte(`[{"feature": {"key": "workers.routes"}, "allocation": {"type": "max_count", "value": 1000}}]`)}, nil
+			},
+		},
+		"Quota": {
+			reason: "AccountZoneQuota should return the zone limit of the account and the number of zones it holds",
+			raw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+				switch endpoint {
+				case "/accounts/acct/entitlements":
+					return cloudflare.RawResponse{Result: []byte(`[{"feature": {"key": "zones.max_count"}, "allocation": {"type": "max_count", "value": 10}}]`)}, nil
+				case "/zones?account.id=acct&per_page=5":
+					return cloudflare.RawResponse{Result: []byte(`[]`), ResultInfo: &cloudflare.ResultInfo{Total: 7}}, nil
+				}
+				return cloudflare.RawResponse{}, errors.Errorf("unexpected request %s %s", method, endpoint)
+			},
+			want: want{o: &ZoneQuota{Limit: 10, Used: 7}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := AccountZoneQuota(context.Background(), fake.MockClient{MockRaw: tc.raw}, "acct")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAccountZoneQuota(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\nAccountZoneQuota(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestSecurityHeaderSettingsToMap(t *testing.T) {
 	type args struct {
 		settings *v1alpha1.SecurityHeaderSettings
@@ -1064,3 +1254,188 @@ func TestMinifySettingsToMap(t *testing.T) {
 		})
 	}
 }
+
+func TestRemediation(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		p       *v1alpha1.ZoneSettingsRemediationPolicy
+		setting string
+		want    string
+	}{
+		"NoPolicy": {
+			reason:  "Settings should be enforced when there is no remediation policy",
+			setting: cfsSecurityLevel,
+			want:    v1alpha1.RemediationEnforce,
+		},
+		"Group": {
+			reason:  "The policy of the group of a setting should be used",
+			p:       &v1alpha1.ZoneSettingsRemediationPolicy{Default: ptr.To(v1alpha1.RemediationIgnore), Security: ptr.To(v1alpha1.RemediationAlert)},
+			setting: cfsSecurityLevel,
+			want:    v1alpha1.RemediationAlert,
+		},
+		"Default": {
+			reason:  "The default policy should be used when the group of a setting has none",
+			p:       &v1alpha1.ZoneSettingsRemediationPolicy{Default: ptr.To(v1alpha1.RemediationIgnore), Security: ptr.To(v1alpha1.RemediationAlert)},
+			setting: cfsSSL,
+			want:    v1alpha1.RemediationIgnore,
+		},
+		"Ungrouped": {
+			reason:  "Settings that belong to no group should use the default policy",
+			p:       &v1alpha1.ZoneSettingsRemediationPolicy{Default: ptr.To(v1alpha1.RemediationAlert), Network: ptr.To(v1alpha1.RemediationIgnore)},
+			setting: cfsMobileRedirect,
+			want:    v1alpha1.RemediationAlert,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Remediation(tc.p, tc.setting)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nRemediation(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDriftedSettings(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		spec   *v1alpha1.ZoneParameters
+		ozs    *v1alpha1.ZoneSettings
+		want   []string
+	}{
+		"Enforced": {
+			reason: "Drift of enforced settings should not be reported",
+			spec:   &v1alpha1.ZoneParameters{Settings: v1alpha1.ZoneSettings{SSL: ptr.To("strict")}},
+			ozs:    &v1alpha1.ZoneSettings{SSL: ptr.To("flexible")},
+		},
+		"Alert": {
+			reason: "Drift of settings whose policy is Alert should be reported",
+			spec: &v1alpha1.ZoneParameters{
+				Settings: v1alpha1.ZoneSettings{
+					SSL:           ptr.To("strict"),
+					SecurityLevel: ptr.To("medium"),
+					WAF:           ptr.To("on"),
+					Brotli:        ptr.To("on"),
+				},
+				RemediationPolicy: &v1alpha1.ZoneSettingsRemediationPolicy{
+					Security:    ptr.To(v1alpha1.RemediationAlert),
+					TLS:         ptr.To(v1alpha1.RemediationAlert),
+					Performance: ptr.To(v1alpha1.RemediationIgnore),
+				},
+			},
+			ozs: &v1alpha1.ZoneSettings{
+				SSL:           ptr.To("flexible"),
+				SecurityLevel: ptr.To("under_attack"),
+				WAF:           ptr.To("on"),
+				Brotli:        ptr.To("off"),
+			},
+			want: []string{cfsSecurityLevel, cfsSSL},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DriftedSettings(tc.spec, tc.ozs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDriftedSettings(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDesiredSettings(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		spec   *v1alpha1.ZoneParameters
+		ozs    *v1alpha1.ZoneSettings
+		want   *string
+	}{
+		"Unset": {
+			reason: "The desired security level should be kept while Under Attack Mode is unset",
+			spec:   &v1alpha1.ZoneParameters{Settings: v1alpha1.ZoneSettings{SecurityLevel: ptr.To("high")}},
+			ozs:    &v1alpha1.ZoneSettings{SecurityLevel: ptr.To(SecurityLevelUnderAttack)},
+			want:   ptr.To("high"),
+		},
+		"UnderAttack": {
+			reason: "The security level should be raised to under_attack in Under Attack Mode",
+			spec:   &v1alpha1.ZoneParameters{UnderAttackMode: ptr.To(true), Settings: v1alpha1.ZoneSettings{SecurityLevel: ptr.To("high")}},
+			ozs:    &v1alpha1.ZoneSettings{SecurityLevel: ptr.To("high")},
+			want:   ptr.To(SecurityLevelUnderAttack),
+		},
+		"Restored": {
+			reason: "The desired security level should be restored when leaving Under Attack Mode",
+			spec:   &v1alpha1.ZoneParameters{UnderAttackMode: ptr.To(false), Settings: v1alpha1.ZoneSettings{SecurityLevel: ptr.To("high")}},
+			ozs:    &v1alpha1.ZoneSettings{SecurityLevel: ptr.To(SecurityLevelUnderAttack)},
+			want:   ptr.To("high"),
+		},
+		"RestoredDefault": {
+			reason: "The default security level should be restored when leaving Under Attack Mode with no level desired",
+			spec:   &v1alpha1.ZoneParameters{UnderAttackMode: ptr.To(false)},
+			ozs:    &v1alpha1.ZoneSettings{SecurityLevel: ptr.To(SecurityLevelUnderAttack)},
+			want:   ptr.To(securityLevelDefault),
+		},
+		"DesiredUnderAttack": {
+			reason: "A desired under_attack security level should be overridden when Under Attack Mode is false",
+			spec:   &v1alpha1.ZoneParameters{UnderAttackMode: ptr.To(false), Settings: v1alpha1.ZoneSettings{SecurityLevel: ptr.To(SecurityLevelUnderAttack)}},
+			ozs:    &v1alpha1.ZoneSettings{SecurityLevel: ptr.To(SecurityLevelUnderAttack)},
+			want:   ptr.To(securityLevelDefault),
+		},
+		"NotUnderAttack": {
+			reason: "No security level should be desired when neither desired nor under attack",
+			spec:   &v1alpha1.ZoneParameters{UnderAttackMode: ptr.To(false)},
+			ozs:    &v1alpha1.ZoneSettings{SecurityLevel: ptr.To("low")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DesiredSettings(tc.spec, tc.ozs)
+			if diff := cmp.Diff(tc.want, got.SecurityLevel); diff != "" {
+				t.Errorf("\n%s\nDesiredSettings(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if tc.spec.Settings.SecurityLevel != nil && got.SecurityLevel == tc.spec.Settings.SecurityLevel {
+				t.Errorf("\n%s\nDesiredSettings(...): want a copy of the desired settings", tc.reason)
+			}
+		})
+	}
+}
+
+func TestConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		zone   cloudflare.Zone
+		want   managed.ConnectionDetails
+	}{
+		"NameServers": {
+			reason: "The name servers assigned to a zone should be published",
+			zone:   cloudflare.Zone{ID: "abcd", Name: "example.com", NameServers: []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"}},
+			want: managed.ConnectionDetails{
+				ConnectionDetailZoneID:                 []byte("abcd"),
+				ConnectionDetailName:                   []byte("example.com"),
+				ConnectionDetailNameServers:            []byte("ada.ns.cloudflare.com,bob.ns.cloudflare.com"),
+				ConnectionDetailNameServerPrefix + "0": []byte("ada.ns.cloudflare.com"),
+				ConnectionDetailNameServerPrefix + "1": []byte("bob.ns.cloudflare.com"),
+			},
+		},
+		"VanityNameServers": {
+			reason: "The vanity name servers of a zone should be published instead of those assigned to it",
+			zone:   cloudflare.Zone{ID: "abcd", Name: "example.com", NameServers: []string{"ada.ns.cloudflare.com"}, VanityNS: []string{"ns1.example.net"}},
+			want: managed.ConnectionDetails{
+				ConnectionDetailZoneID:                 []byte("abcd"),
+				ConnectionDetailName:                   []byte("example.com"),
+				ConnectionDetailNameServers:            []byte("ns1.example.net"),
+				ConnectionDetailNameServerPrefix + "0": []byte("ns1.example.net"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ConnectionDetails(tc.zone)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nConnectionDetails(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
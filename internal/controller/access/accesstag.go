@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/access/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/access"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotAccessTag = "managed resource is not an AccessTag custom resource"
+
+	errTagLookup   = "cannot lookup Access tag"
+	errTagCreation = "cannot create Access tag"
+	errTagDeletion = "cannot delete Access tag"
+)
+
+// SetupAccessTag adds a controller that reconciles AccessTag managed
+// resources.
+func SetupAccessTag(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.AccessTagGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: 5,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AccessTagGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&tagConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (access.Client, error) {
+				return access.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.AccessAppsAndPoliciesWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+		// Tags are identified by spec.forProvider.name.
+		managed.WithInitializers(account.NewDefaulter(mgr.GetClient())),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.AccessTag{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.AccessTagGroupVersionKind)).
+		Complete(r)
+}
+
+// A tagConnector is expected to produce an ExternalClient when its Connect
+// method is called.
+type tagConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (access.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *tagConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.AccessTag); !ok {
+		return nil, errors.New(errNotAccessTag)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tagExternal{client: client}, nil
+}
+
+// A tagExternal observes, then either creates or deletes an Access tag. A
+// tag has nothing but its name, so it is never updated.
+type tagExternal struct {
+	client access.Client
+}
+
+func (e *tagExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AccessTag)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAccessTag)
+	}
+
+	// Tag names are unique within an account, so an existing tag is
+	// adopted.
+	tag, err := access.GetTag(ctx, e.client, cr.Spec.ForProvider.AccountID, cr.Spec.ForProvider.Name)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errTagLookup)
+	}
+
+	cr.Status.AtProvider = access.GenerateTagObservation(tag)
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *tagExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AccessTag)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAccessTag)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	tag, err := access.CreateTag(ctx, e.client, cr.Spec.ForProvider.AccountID, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errTagCreation)
+	}
+
+	cr.Status.AtProvider = access.GenerateTagObservation(tag)
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Name)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *tagExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Observe always reports tags as up to date.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *tagExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.AccessTag)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotAccessTag)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	err := access.DeleteTag(ctx, e.client, cr.Spec.ForProvider.AccountID, cr.Spec.ForProvider.Name)
+	return managed.ExternalDelete{}, errors.Wrap(err, errTagDeletion)
+}
+
+func (e *tagExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/access/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/access"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotAppLauncherSettings = "managed resource is not an AppLauncherSettings custom resource"
+
+	errAppLauncherLookup   = "cannot lookup App Launcher settings"
+	errAppLauncherCreation = "cannot create App Launcher settings"
+	errAppLauncherUpdate   = "cannot update App Launcher settings"
+	errAppLauncherDeletion = "cannot delete App Launcher settings"
+)
+
+// SetupAppLauncherSettings adds a controller that reconciles
+// AppLauncherSettings managed resources.
+func SetupAppLauncherSettings(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.AppLauncherSettingsGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: 5,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AppLauncherSettingsGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&appLauncherConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (access.Client, error) {
+				return access.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.AccessAppsAndPoliciesWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+		// Settings are identified by spec.forProvider.applicationId.
+		managed.WithInitializers(account.NewDefaulter(mgr.GetClient())),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.AppLauncherSettings{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.AppLauncherSettingsGroupVersionKind)).
+		Complete(r)
+}
+
+// An appLauncherConnector is expected to produce an ExternalClient when
+// its Connect method is called.
+type appLauncherConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (access.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *appLauncherConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.AppLauncherSettings); !ok {
+		return nil, errors.New(errNotAppLauncherSettings)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appLauncherExternal{client: client}, nil
+}
+
+// An appLauncherExternal observes, then either applies or resets the App
+// Launcher settings of an Access application.
+type appLauncherExternal struct {
+	client access.Client
+}
+
+func (e *appLauncherExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AppLauncherSettings)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAppLauncherSettings)
+	}
+
+	// The settings exist for as long as the application does, so they are
+	// only considered to exist once they have been applied.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	obs, err := access.GetAppLauncher(ctx, e.client, cr.Spec.ForProvider.AccountID, cr.Spec.ForProvider.ApplicationID)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errAppLauncherLookup)
+	}
+
+	cr.Status.AtProvider = obs
+
+	// Once Delete has reset the settings there is nothing left to delete.
+	if meta.WasDeleted(cr) && access.AppLauncherIsReset(cr.Spec.ForProvider, obs) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: access.AppLauncherUpToDate(cr.Spec.ForProvider, obs),
+	}, nil
+}
+
+func (e *appLauncherExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AppLauncherSettings)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAppLauncherSettings)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	if err := access.UpdateAppLauncher(ctx, e.client, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAppLauncherCreation)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.ApplicationID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *appLauncherExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AppLauncherSettings)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAppLauncherSettings)
+	}
+
+	err := access.UpdateAppLauncher(ctx, e.client, cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errAppLauncherUpdate)
+}
+
+func (e *appLauncherExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.AppLauncherSettings)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotAppLauncherSettings)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	// The settings cannot be deleted, so they are reset instead.
+	err := access.ResetAppLauncher(ctx, e.client, cr.Spec.ForProvider)
+	return managed.ExternalDelete{}, errors.Wrap(err, errAppLauncherDeletion)
+}
+
+func (e *appLauncherExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
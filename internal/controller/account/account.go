@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package account defaults the account of managed resources that target a
+// Cloudflare account from their ProviderConfig.
+package account
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	errNotAccountScoped = "managed resource does not target an account"
+	errGetPC            = "cannot get ProviderConfig"
+	errNoAccountID      = "accountId is not set and the ProviderConfig has no default accountId"
+	errUpdateManaged    = "cannot update managed resource with the default accountId"
+)
+
+// An AccountScoped managed resource targets a single Cloudflare account.
+type AccountScoped interface {
+	resource.Managed
+
+	GetAccountID() string
+	SetAccountID(id string)
+}
+
+// A Defaulter sets the account of a managed resource that does not specify
+// one to the account ID of its ProviderConfig.
+type Defaulter struct {
+	kube client.Client
+}
+
+// NewDefaulter returns a Defaulter that uses the supplied client to read
+// ProviderConfigs and persist the defaulted account.
+func NewDefaulter(c client.Client) *Defaulter {
+	return &Defaulter{kube: c}
+}
+
+// Initialize the account of the supplied managed resource. The account is
+// written to the spec, so that changing the ProviderConfig later does not
+// move existing resources to another account.
+func (d *Defaulter) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(AccountScoped)
+	if !ok {
+		return errors.New(errNotAccountScoped)
+	}
+	if cr.GetAccountID() != "" {
+		return nil
+	}
+
+	ref, err := clients.ProviderConfigReference(ctx, d.kube, mg)
+	if err != nil {
+		return err
+	}
+	if ref == nil {
+		return errors.New(errNoAccountID)
+	}
+
+	pc := &v1alpha1.ProviderConfig{}
+	if err := d.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return errors.Wrap(err, errGetPC)
+	}
+	if pc.Spec.AccountID == nil || *pc.Spec.AccountID == "" {
+		return errors.New(errNoAccountID)
+	}
+
+	cr.SetAccountID(*pc.Spec.AccountID)
+	return errors.Wrap(d.kube.Update(ctx, cr), errUpdateManaged)
+}
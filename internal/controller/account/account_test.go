@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+type accountScoped struct {
+	fake.Managed
+	accountID string
+}
+
+func (m *accountScoped) GetAccountID() string   { return m.accountID }
+func (m *accountScoped) SetAccountID(id string) { m.accountID = id }
+
+func TestInitialize(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		accountID string
+		updated   bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		accountID string
+		pc        *string
+		getErr    error
+		updateErr error
+		want      want
+	}{
+		"AlreadySet": {
+			reason:    "An account that is already set should not be changed",
+			accountID: "explicit",
+			pc:        ptr.To("default"),
+			want:      want{accountID: "explicit"},
+		},
+		"Defaulted": {
+			reason: "An unset account should be defaulted from the ProviderConfig and persisted",
+			pc:     ptr.To("default"),
+			want:   want{accountID: "default", updated: true},
+		},
+		"NoDefault": {
+			reason: "An error should be returned when neither the resource nor the ProviderConfig set an account",
+			want:   want{err: errors.New(errNoAccountID)},
+		},
+		"GetProviderConfigError": {
+			reason: "Errors getting the ProviderConfig should be returned",
+			getErr: errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGetPC)},
+		},
+		"UpdateError": {
+			reason:    "Errors persisting the defaulted account should be returned",
+			pc:        ptr.To("default"),
+			updateErr: errBoom,
+			want:      want{accountID: "default", updated: true, err: errors.Wrap(errBoom, errUpdateManaged)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					if tc.getErr != nil {
+						return tc.getErr
+					}
+					obj.(*v1alpha1.ProviderConfig).Spec.AccountID = tc.pc
+					return nil
+				},
+				MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+					updated = true
+					return tc.updateErr
+				},
+			}
+
+			mg := &accountScoped{accountID: tc.accountID}
+			mg.SetProviderConfigReference(&xpv1.Reference{Name: "example"})
+
+			err := NewDefaulter(kube).Initialize(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.accountID, mg.accountID); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want account, +got account:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want updated, +got updated:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
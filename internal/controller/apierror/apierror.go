@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apierror surfaces errors returned by the Cloudflare API in the
+// status of the managed resources that encountered them.
+package apierror
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// FromError returns the Cloudflare API error wrapped by the supplied error,
+// or nil if it does not wrap one.
+func FromError(err error) *pcv1alpha1.APIError {
+	var cfErr *cloudflare.Error
+	if !errors.As(err, &cfErr) {
+		return nil
+	}
+
+	e := &pcv1alpha1.APIError{
+		Code:      cfErr.StatusCode,
+		Message:   strings.Join(cfErr.ErrorMessages, ", "),
+		RayID:     cfErr.RayID,
+		Timestamp: metav1.Now(),
+	}
+	if len(cfErr.ErrorCodes) > 0 {
+		e.Code = cfErr.ErrorCodes[0]
+	}
+	if e.Message == "" {
+		e.Message = http.StatusText(cfErr.StatusCode)
+	}
+	return e
+}
+
+// NewConnecter wraps the supplied ExternalConnecter so that the clients it
+// produces record Cloudflare API errors in status.atProvider.lastAPIError
+// of managed resources that support it. The error is cleared by the next
+// successful Observe.
+func NewConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+}
+
+// record sets the last API error of the supplied managed resource if err
+// wraps a Cloudflare API error. Other errors leave it untouched.
+func record(mg resource.Managed, err error) {
+	r, ok := mg.(pcv1alpha1.APIErrorRecorder)
+	if !ok {
+		return
+	}
+	if e := FromError(err); e != nil {
+		r.SetLastAPIError(e)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		record(mg, err)
+		return o, err
+	}
+	if r, ok := mg.(pcv1alpha1.APIErrorRecorder); ok {
+		r.SetLastAPIError(nil)
+	}
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	record(mg, err)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	record(mg, err)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := e.ExternalClient.Delete(ctx, mg)
+	record(mg, err)
+	return d, err
+}
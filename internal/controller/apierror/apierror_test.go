@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apierror
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	r2v1alpha1 "github.com/rossigee/provider-cloudflare/apis/r2/v1alpha1"
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+func TestFromError(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   *pcv1alpha1.APIError
+	}{
+		"Nil": {
+			reason: "A nil error should not produce an API error",
+		},
+		"NotAPIError": {
+			reason: "Errors that do not wrap a Cloudflare API error should not produce an API error",
+			err:    errors.New("boom"),
+		},
+		"APIError": {
+			reason: "The first error code, messages and ray ID should be taken from a wrapped Cloudflare API error",
+			err: errors.Wrap(cloudflare.NewRequestError(&cloudflare.Error{
+				StatusCode:    400,
+				ErrorCodes:    []int{1004, 1005},
+				ErrorMessages: []string{"DNS Validation Error", "Invalid TTL"},
+				RayID:         "8a1b2c3d4e5f6789",
+			}), "cannot create DNS record"),
+			want: &pcv1alpha1.APIError{Code: 1004, Message: "DNS Validation Error, Invalid TTL", RayID: "8a1b2c3d4e5f6789"},
+		},
+		"StatusOnly": {
+			reason: "The HTTP status should be used when the response carried no error details",
+			err:    cloudflare.NewServiceError(&cloudflare.Error{StatusCode: 503}),
+			want:   &pcv1alpha1.APIError{Code: 503, Message: "Service Unavailable"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FromError(tc.err)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(pcv1alpha1.APIError{}, "Timestamp")); diff != "" {
+				t.Errorf("\n%s\nFromError(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	apiErr := cloudflare.NewNotFoundError(&cloudflare.Error{StatusCode: 404, ErrorCodes: []int{10006}, ErrorMessages: []string{"bucket not found"}})
+	previous := &pcv1alpha1.APIError{Code: 10008, Message: "bucket not empty"}
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   *pcv1alpha1.APIError
+	}{
+		"Success": {
+			reason: "A successful Observe should clear the last API error",
+		},
+		"APIError": {
+			reason: "An Observe failing with a Cloudflare API error should record it",
+			err:    apiErr,
+			want:   &pcv1alpha1.APIError{Code: 10006, Message: "bucket not found"},
+		},
+		"OtherError": {
+			reason: "An Observe failing with any other error should leave the last API error untouched",
+			err:    errors.New("boom"),
+			want:   previous,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, tc.err
+					},
+				}, nil
+			}))
+
+			mg := &r2v1alpha1.Bucket{}
+			mg.Status.AtProvider.LastAPIError = previous
+			ec, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			_, _ = ec.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, mg.Status.AtProvider.LastAPIError, cmpopts.IgnoreFields(pcv1alpha1.APIError{}, "Timestamp")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want last API error, +got last API error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
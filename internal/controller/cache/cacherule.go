@@ -21,11 +21,13 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -35,7 +37,15 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/cache/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	"github.com/rossigee/provider-cloudflare/internal/clients/cache"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
 	"github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -54,16 +64,17 @@ func SetupCacheRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLi
 	}
 
 	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CacheRuleGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&connector{
 			kube: mgr.GetClient(),
 			newClientFn: func(cfg clients.Config) (cache.CacheRuleClient, error) {
 				return cache.NewCacheRuleClient(cfg, hc)
 			},
-		}),
+		}), mgr.GetClient(), scopes.CacheSettingsWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithPollInterval(5*time.Minute),
 		managed.WithInitializers(),
 	)
@@ -72,6 +83,7 @@ func SetupCacheRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLi
 		Named(name).
 		WithOptions(o).
 		For(&v1alpha1.CacheRule{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.CacheRuleGroupVersionKind)).
 		Complete(r)
 }
 
@@ -137,6 +149,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.AtProvider = cache.GenerateCacheRuleObservation(rule, ruleset)
+	cr.SetConditions(rtv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -22,13 +22,18 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
+	access "github.com/rossigee/provider-cloudflare/internal/controller/access"
 	"github.com/rossigee/provider-cloudflare/internal/controller/cache"
 	"github.com/rossigee/provider-cloudflare/internal/controller/config"
+	data "github.com/rossigee/provider-cloudflare/internal/controller/data"
 	record "github.com/rossigee/provider-cloudflare/internal/controller/dns"
 	emailrouting "github.com/rossigee/provider-cloudflare/internal/controller/emailrouting"
 	loadbalancing "github.com/rossigee/provider-cloudflare/internal/controller/loadbalancing"
+	logpush "github.com/rossigee/provider-cloudflare/internal/controller/logpush"
 	originssl "github.com/rossigee/provider-cloudflare/internal/controller/originssl"
+	pages "github.com/rossigee/provider-cloudflare/internal/controller/pages"
 	r2 "github.com/rossigee/provider-cloudflare/internal/controller/r2"
+	registrar "github.com/rossigee/provider-cloudflare/internal/controller/registrar"
 	rulesets "github.com/rossigee/provider-cloudflare/internal/controller/rulesets"
 	security "github.com/rossigee/provider-cloudflare/internal/controller/security"
 	application "github.com/rossigee/provider-cloudflare/internal/controller/spectrum"
@@ -58,6 +63,11 @@ func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any
 		cache.Setup,
 		r2.Setup,
 		emailrouting.Setup,
+		logpush.Setup,
+		registrar.Setup,
+		data.Setup,
+		access.Setup,
+		pages.Setup,
 	} {
 		if err := setup(mgr, l, wl); err != nil {
 			return err
@@ -70,3 +80,10 @@ func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any
 func SetupMinimal(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
 	return Setup(mgr, l, wl)
 }
+
+// SetupCertManagerIssuer creates the controller that fulfills cert-manager
+// CertificateRequests referencing an OriginIssuer or ClusterOriginIssuer.
+// It watches cert-manager's CRDs, so it is only set up when requested.
+func SetupCertManagerIssuer(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
+	return originssl.SetupCertificateRequest(mgr, l, wl)
+}
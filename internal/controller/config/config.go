@@ -17,9 +17,18 @@ limitations under the License.
 package config
 
 import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
@@ -27,6 +36,14 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/version"
+)
+
+const (
+	groupSuffix = "cloudflare.crossplane.io"
+
+	errGetPC      = "cannot get ProviderConfig"
+	errUpdateInfo = "cannot update ProviderConfig provider info"
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
@@ -48,7 +65,59 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any
 		WithOptions(o).
 		For(&v1alpha1.ProviderConfig{}).
 		Watches(&v1alpha1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}).
-		Complete(providerconfig.NewReconciler(mgr, of,
-			providerconfig.WithLogger(l.WithValues("controller", name)),
-			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+		Complete(&infoReconciler{
+			kube: mgr.GetClient(),
+			info: Info(mgr.GetScheme()),
+			wrapped: providerconfig.NewReconciler(mgr, of,
+				providerconfig.WithLogger(l.WithValues("controller", name)),
+				providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		})
+}
+
+// Info returns the ProviderInfo of this build of the provider, reconciling
+// the Cloudflare API groups registered with the supplied scheme.
+func Info(s *runtime.Scheme) v1alpha1.ProviderInfo {
+	seen := map[string]bool{}
+	groups := []string{}
+	for gvk := range s.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Group, groupSuffix) || seen[gvk.Group] {
+			continue
+		}
+		seen[gvk.Group] = true
+		groups = append(groups, gvk.Group)
+	}
+	sort.Strings(groups)
+	return v1alpha1.ProviderInfo{
+		Version:             version.Version,
+		CloudflareGoVersion: version.CloudflareGo(),
+		GoVersion:           version.Go(),
+		ResourceGroups:      groups,
+	}
+}
+
+// An infoReconciler records the ProviderInfo of the provider in the status
+// of each ProviderConfig, after the wrapped reconciler has accounted for its
+// usage.
+type infoReconciler struct {
+	kube    client.Client
+	info    v1alpha1.ProviderInfo
+	wrapped reconcile.Reconciler
+}
+
+// Reconcile a ProviderConfig.
+func (r *infoReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.wrapped.Reconcile(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	pc := &v1alpha1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return res, errors.Wrap(client.IgnoreNotFound(err), errGetPC)
+	}
+	if pc.GetDeletionTimestamp() != nil || cmp.Equal(pc.Status.ProviderInfo, &r.info) {
+		return res, nil
+	}
+	pc.Status.ProviderInfo = r.info.DeepCopy()
+	return res, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateInfo)
 }
@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+func TestInfo(t *testing.T) {
+	s := runtime.NewScheme()
+	s.AddKnownTypeWithName(schema.GroupVersionKind{Group: "zone.cloudflare.crossplane.io", Version: "v1alpha1", Kind: "Zone"}, &v1alpha1.ProviderConfig{})
+	s.AddKnownTypeWithName(schema.GroupVersionKind{Group: "zone.cloudflare.crossplane.io", Version: "v1alpha1", Kind: "ZoneList"}, &v1alpha1.ProviderConfigList{})
+	s.AddKnownTypeWithName(schema.GroupVersionKind{Group: "dns.cloudflare.crossplane.io", Version: "v1alpha1", Kind: "Record"}, &v1alpha1.ProviderConfig{})
+	s.AddKnownTypeWithName(schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Other"}, &v1alpha1.ProviderConfig{})
+
+	want := []string{"dns.cloudflare.crossplane.io", "zone.cloudflare.crossplane.io"}
+	if diff := cmp.Diff(want, Info(s).ResourceGroups); diff != "" {
+		t.Errorf("\nInfo(...) should report each Cloudflare API group once, sorted: -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestInfoReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	info := v1alpha1.ProviderInfo{Version: "v0.9.1", CloudflareGoVersion: "v0.115.0", ResourceGroups: []string{"zone.cloudflare.crossplane.io"}}
+
+	type args struct {
+		kube    client.Client
+		wrapped reconcile.Reconciler
+	}
+	type want struct {
+		res reconcile.Result
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"WrappedError": {
+			reason: "Errors from the wrapped reconciler should be returned without recording the provider info.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(errors.New("provider config must not be read")),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errors.New("status must not be updated")),
+				},
+				wrapped: reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{Requeue: true}, errBoom
+				}),
+			},
+			want: want{
+				res: reconcile.Result{Requeue: true},
+				err: errBoom,
+			},
+		},
+		"NotFound": {
+			reason: "Deleted ProviderConfigs should be ignored.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "default")),
+				},
+				wrapped: reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{}, nil
+				}),
+			},
+		},
+		"GetError": {
+			reason: "Errors getting the ProviderConfig should be returned.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				wrapped: reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{}, nil
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetPC),
+			},
+		},
+		"UpToDate": {
+			reason: "The status should not be updated when it already records the provider info.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*v1alpha1.ProviderConfig).Status.ProviderInfo = info.DeepCopy()
+						return nil
+					}),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errors.New("status must not be updated")),
+				},
+				wrapped: reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{}, nil
+				}),
+			},
+		},
+		"Recorded": {
+			reason: "The provider info should be recorded in the status of the ProviderConfig.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						if diff := cmp.Diff(&info, obj.(*v1alpha1.ProviderConfig).Status.ProviderInfo); diff != "" {
+							return errors.Errorf("unexpected provider info: %s", diff)
+						}
+						return nil
+					},
+				},
+				wrapped: reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{}, nil
+				}),
+			},
+		},
+		"UpdateError": {
+			reason: "Errors updating the status should be returned.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errBoom),
+				},
+				wrapped: reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{}, nil
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateInfo),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &infoReconciler{kube: tc.args.kube, info: info, wrapped: tc.args.wrapped}
+			res, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.res, res); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package credentials reacts to changes of the Secrets ProviderConfigs read
+// their credentials from.
+package credentials
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// EnqueueRequestsForSecret returns an event handler that enqueues the
+// managed resources of the supplied kind whose ProviderConfig reads its
+// credentials from a Secret that was created or whose data changed.
+//
+// Clients are built from the credentials Secret each time a managed
+// resource is connected, so a rotated API token is used as soon as the
+// resource is next reconciled. Without this, resources that failed with
+// the old token would wait for their error backoff, and healthy resources
+// for their poll interval, before picking it up.
+func EnqueueRequestsForSecret(c client.Reader, gvk schema.GroupVersionKind) handler.EventHandler {
+	enqueue := func(ctx context.Context, s client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		for _, r := range Requests(ctx, c, gvk, s) {
+			q.Add(r)
+		}
+	}
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, e.Object, q)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			if !dataChanged(e.ObjectOld, e.ObjectNew) {
+				return
+			}
+			enqueue(ctx, e.ObjectNew, q)
+		},
+	}
+}
+
+// Requests returns a reconcile request for each managed resource of the
+// supplied kind that uses a ProviderConfig reading its credentials from the
+// supplied Secret. Resources that cannot be looked up are skipped; they
+// will be picked up by their next poll.
+func Requests(ctx context.Context, c client.Reader, gvk schema.GroupVersionKind, s client.Object) []reconcile.Request {
+	pcs := &v1alpha1.ProviderConfigList{}
+	if err := c.List(ctx, pcs); err != nil {
+		return nil
+	}
+
+	var out []reconcile.Request
+	for _, pc := range pcs.Items {
+		if !readsFrom(pc, s) {
+			continue
+		}
+		pcus := &v1alpha1.ProviderConfigUsageList{}
+		if err := c.List(ctx, pcus, client.MatchingLabels{xpv1.LabelKeyProviderName: pc.GetName()}); err != nil {
+			continue
+		}
+		for _, pcu := range pcus.Items {
+			ref := pcu.ResourceReference
+			if ref.APIVersion != gvk.GroupVersion().String() || ref.Kind != gvk.Kind {
+				continue
+			}
+			out = append(out, reconcile.Request{NamespacedName: types.NamespacedName{Name: ref.Name}})
+		}
+	}
+	return out
+}
+
+// readsFrom returns true if the ProviderConfig reads its credentials from
+// the supplied Secret.
+func readsFrom(pc v1alpha1.ProviderConfig, s client.Object) bool {
+	cd := pc.Spec.Credentials
+	if cd.Source != xpv1.CredentialsSourceSecret || cd.SecretRef == nil {
+		return false
+	}
+	return cd.SecretRef.Namespace == s.GetNamespace() && cd.SecretRef.Name == s.GetName()
+}
+
+func dataChanged(oldObj, newObj client.Object) bool {
+	o, ok := oldObj.(*corev1.Secret)
+	if !ok {
+		return true
+	}
+	n, ok := newObj.(*corev1.Secret)
+	if !ok {
+		return true
+	}
+	return !reflect.DeepEqual(o.Data, n.Data) || !reflect.DeepEqual(o.StringData, n.StringData)
+}
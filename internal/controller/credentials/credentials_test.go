@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	dnsv1alpha1 "github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+func providerConfig(name, secretNamespace, secretName string) v1alpha1.ProviderConfig {
+	pc := v1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	pc.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+	pc.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: secretNamespace, Name: secretName},
+		Key:             "credentials",
+	}
+	return pc
+}
+
+func usage(kind, name string) v1alpha1.ProviderConfigUsage {
+	pcu := v1alpha1.ProviderConfigUsage{}
+	pcu.ResourceReference = xpv1.TypedReference{APIVersion: dnsv1alpha1.SchemeGroupVersion.String(), Kind: kind, Name: name}
+	return pcu
+}
+
+func newClient(pcs []v1alpha1.ProviderConfig, usages map[string][]v1alpha1.ProviderConfigUsage) client.Reader {
+	return &test.MockClient{
+		MockList: func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+			switch l := obj.(type) {
+			case *v1alpha1.ProviderConfigList:
+				l.Items = pcs
+			case *v1alpha1.ProviderConfigUsageList:
+				lo := &client.ListOptions{}
+				lo.ApplyOptions(opts)
+				for pc, u := range usages {
+					if lo.LabelSelector.Matches(labels.Set{xpv1.LabelKeyProviderName: pc}) {
+						l.Items = u
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func TestRequests(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "cloudflare-creds"}}
+
+	cases := map[string]struct {
+		reason string
+		pcs    []v1alpha1.ProviderConfig
+		usages map[string][]v1alpha1.ProviderConfigUsage
+		want   []reconcile.Request
+	}{
+		"ReferencedSecret": {
+			reason: "Resources of the watched kind using a ProviderConfig that reads the Secret should be enqueued",
+			pcs: []v1alpha1.ProviderConfig{
+				providerConfig("default", "crossplane-system", "cloudflare-creds"),
+				providerConfig("other", "crossplane-system", "other-creds"),
+			},
+			usages: map[string][]v1alpha1.ProviderConfigUsage{
+				"default": {usage(dnsv1alpha1.RecordKind, "www"), usage(dnsv1alpha1.EmailSecurityPostureKind, "mail")},
+				"other":   {usage(dnsv1alpha1.RecordKind, "api")},
+			},
+			want: []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "www"}}},
+		},
+		"UnreferencedSecret": {
+			reason: "Nothing should be enqueued when no ProviderConfig reads the Secret",
+			pcs: []v1alpha1.ProviderConfig{
+				providerConfig("default", "other-namespace", "cloudflare-creds"),
+			},
+			usages: map[string][]v1alpha1.ProviderConfigUsage{
+				"default": {usage(dnsv1alpha1.RecordKind, "www")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Requests(context.Background(), newClient(tc.pcs, tc.usages), dnsv1alpha1.RecordGroupVersionKind, secret)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nRequests(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnqueueRequestsForSecretUpdate(t *testing.T) {
+	c := newClient(
+		[]v1alpha1.ProviderConfig{providerConfig("default", "crossplane-system", "cloudflare-creds")},
+		map[string][]v1alpha1.ProviderConfigUsage{"default": {usage(dnsv1alpha1.RecordKind, "www")}},
+	)
+	secret := func(token string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "cloudflare-creds"},
+			Data:       map[string][]byte{"credentials": []byte(token)},
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		old    *corev1.Secret
+		new    *corev1.Secret
+		want   int
+	}{
+		"Rotated": {
+			reason: "Resources should be enqueued when the credentials change",
+			old:    secret(`{"token":"old"}`),
+			new:    secret(`{"token":"new"}`),
+			want:   1,
+		},
+		"Unchanged": {
+			reason: "Resources should not be enqueued when only the Secret metadata changes",
+			old:    secret(`{"token":"old"}`),
+			new:    secret(`{"token":"old"}`),
+			want:   0,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer q.ShutDown()
+			EnqueueRequestsForSecret(c, dnsv1alpha1.RecordGroupVersionKind).Update(context.Background(), event.UpdateEvent{ObjectOld: tc.old, ObjectNew: tc.new}, q)
+			if diff := cmp.Diff(tc.want, q.Len()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want queued, +got queued:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/data/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/data"
+)
+
+const (
+	errNotDataResource = "managed resource is not a data custom resource"
+
+	errClientConfig = "error getting client config"
+)
+
+// A refresher is a data resource that is read from Cloudflare at its own
+// interval.
+type refresher interface {
+	GetRefreshInterval() *metav1.Duration
+}
+
+// refreshInterval polls data resources at their refresh interval rather
+// than the controller's poll interval, when one is set.
+func refreshInterval(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	r, ok := mg.(refresher)
+	if !ok || r.GetRefreshInterval() == nil || r.GetRefreshInterval().Duration <= 0 {
+		return pollInterval
+	}
+	return r.GetRefreshInterval().Duration
+}
+
+// A connector is expected to produce an ExternalClient when its Connect
+// method is called.
+type connector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (data.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	switch mg.(type) {
+	case *v1alpha1.AccountDetails, *v1alpha1.ZoneList, *v1alpha1.IPRanges, *v1alpha1.AegisConfig, *v1alpha1.TokenAudit:
+	default:
+		return nil, errors.New(errNotDataResource)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	switch mg.(type) {
+	case *v1alpha1.AccountDetails:
+		return &accountDetailsExternal{client: client}, nil
+	case *v1alpha1.ZoneList:
+		return &zoneListExternal{client: client}, nil
+	case *v1alpha1.AegisConfig:
+		return &aegisConfigExternal{client: client}, nil
+	case *v1alpha1.TokenAudit:
+		return &tokenAuditExternal{client: client}, nil
+	default:
+		return &ipRangesExternal{client: client}, nil
+	}
+}
+
+// observeOnly implements the methods of an ExternalClient that change
+// external resources. Data resources are never written to Cloudflare, so
+// they do nothing: their Observe methods report them as existing and up
+// to date, so that only Delete may be called, which leaves nothing behind.
+type observeOnly struct{}
+
+func (observeOnly) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (observeOnly) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (observeOnly) Delete(_ context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (observeOnly) Disconnect(_ context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package data contains the controllers of the observe-only data
+// resources, which reflect Cloudflare data into their status.
+package data
+
+import (
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// Setup creates all data controllers with the supplied logger and adds
+// them to the supplied manager.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.TypedRateLimiter[any]) error{
+		SetupAccountDetails,
+		SetupZoneList,
+		SetupIPRanges,
+		SetupAegisConfig,
+		SetupTokenAudit,
+	} {
+		if err := setup(mgr, l, rl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/data/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/data"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotTokenAudit = "managed resource is not a TokenAudit custom resource"
+
+	errTokenAuditLookup = "cannot observe API tokens"
+)
+
+// SetupTokenAudit adds a controller that reflects the API tokens of a
+// Cloudflare account or user into the status of TokenAudit managed
+// resources.
+func SetupTokenAudit(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.TokenAuditGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: 5,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TokenAuditGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(observed.NewConnecter(apierror.NewConnecter(&connector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (data.Client, error) {
+				return data.NewClient(cfg, hc)
+			},
+		})), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(time.Hour),
+		managed.WithPollIntervalHook(refreshInterval),
+		managed.WithInitializers(accountTokensOnly(account.NewDefaulter(mgr.GetClient()))),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.TokenAudit{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.TokenAuditGroupVersionKind)).
+		Complete(r)
+}
+
+// accountTokensOnly only runs the supplied initializer for TokenAudits of
+// account tokens, so that user tokens can be audited without an account.
+func accountTokensOnly(i managed.Initializer) managed.Initializer {
+	return managed.InitializerFn(func(ctx context.Context, mg resource.Managed) error {
+		if cr, ok := mg.(*v1alpha1.TokenAudit); ok && cr.Spec.ForProvider.Owner == v1alpha1.TokenOwnerUser {
+			return nil
+		}
+		return i.Initialize(ctx, mg)
+	})
+}
+
+// A tokenAuditExternal reflects the API tokens of a Cloudflare account or
+// user into the status of a TokenAudit.
+type tokenAuditExternal struct {
+	observeOnly
+
+	client data.Client
+}
+
+func (e *tokenAuditExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TokenAudit)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTokenAudit)
+	}
+
+	// Nothing was created in Cloudflare, so there is nothing to delete.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	obs, err := data.Tokens(ctx, e.client, cr.Spec.ForProvider, time.Now())
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errTokenAuditLookup)
+	}
+
+	cr.Status.AtProvider = obs
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
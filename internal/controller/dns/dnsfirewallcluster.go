@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/dnsfirewall"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotDNSFirewallCluster = "managed resource is not a DNSFirewallCluster custom resource"
+
+	errClusterLookup   = "cannot lookup DNS firewall cluster"
+	errClusterCreation = "cannot create DNS firewall cluster"
+	errClusterUpdate   = "cannot update DNS firewall cluster"
+	errClusterDeletion = "cannot delete DNS firewall cluster"
+)
+
+// SetupDNSFirewallCluster adds a controller that reconciles
+// DNSFirewallCluster managed resources.
+func SetupDNSFirewallCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.DNSFirewallClusterGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: 5,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DNSFirewallClusterGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&clusterConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (dnsfirewall.Client, error) {
+				return dnsfirewall.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.DNSFirewallWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), account.NewDefaulter(mgr.GetClient())),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.DNSFirewallCluster{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.DNSFirewallClusterGroupVersionKind)).
+		Complete(r)
+}
+
+// A clusterConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type clusterConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (dnsfirewall.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.DNSFirewallCluster); !ok {
+		return nil, errors.New(errNotDNSFirewallCluster)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterExternal{client: client}, nil
+}
+
+// A clusterExternal observes, then either creates, updates, or deletes a
+// DNS Firewall cluster.
+type clusterExternal struct {
+	client dnsfirewall.Client
+}
+
+func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DNSFirewallCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDNSFirewallCluster)
+	}
+
+	// The external name is the cluster ID assigned on creation.
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cluster, err := dnsfirewall.Get(ctx, e.client, cr.Spec.ForProvider.AccountID, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(clients.IsNotFound, err), errClusterLookup)
+	}
+
+	cr.Status.AtProvider = dnsfirewall.GenerateObservation(cluster)
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: dnsfirewall.UpToDate(cr.Spec.ForProvider, cluster),
+	}, nil
+}
+
+func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DNSFirewallCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDNSFirewallCluster)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	cluster, err := dnsfirewall.Create(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errClusterCreation)
+	}
+
+	cr.Status.AtProvider = dnsfirewall.GenerateObservation(cluster)
+	meta.SetExternalName(cr, cluster.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DNSFirewallCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDNSFirewallCluster)
+	}
+
+	cluster, err := dnsfirewall.Update(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errClusterUpdate)
+	}
+
+	cr.Status.AtProvider = dnsfirewall.GenerateObservation(cluster)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.DNSFirewallCluster)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotDNSFirewallCluster)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	err := dnsfirewall.Delete(ctx, e.client, cr.Spec.ForProvider.AccountID, meta.GetExternalName(cr))
+	return managed.ExternalDelete{}, errors.Wrap(err, errClusterDeletion)
+}
+
+func (e *clusterExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/emailsecurity"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotEmailSecurityPosture = "managed resource is not an EmailSecurityPosture custom resource"
+
+	errPostureLookup   = "cannot lookup email security records"
+	errPostureCreation = "cannot create email security records"
+	errPostureUpdate   = "cannot update email security records"
+	errPostureDeletion = "cannot delete email security records"
+	errPostureNoZone   = "no zone found"
+	errPostureEmpty    = "at least one of spf, dkim or dmarc must be specified"
+)
+
+// SetupEmailSecurityPosture adds a controller that reconciles
+// EmailSecurityPosture managed resources.
+func SetupEmailSecurityPosture(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.EmailSecurityPostureGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: 5,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.EmailSecurityPostureGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&postureConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (emailsecurity.Client, error) {
+				return emailsecurity.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.DNSWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.EmailSecurityPosture{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.EmailSecurityPostureGroupVersionKind)).
+		Complete(r)
+}
+
+// A postureConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type postureConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (emailsecurity.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *postureConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.EmailSecurityPosture); !ok {
+		return nil, errors.New(errNotEmailSecurityPosture)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postureExternal{client: client}, nil
+}
+
+// A postureExternal observes, then either creates, updates, or deletes the
+// DNS Records of an EmailSecurityPosture.
+type postureExternal struct {
+	client emailsecurity.Client
+}
+
+func (e *postureExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EmailSecurityPosture)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEmailSecurityPosture)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalObservation{}, errors.New(errPostureNoZone)
+	}
+
+	recs, err := emailsecurity.ListManaged(ctx, e.client, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errPostureLookup)
+	}
+
+	// The posture exists for as long as any record it owns does.
+	if len(recs) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = emailsecurity.GenerateObservation(recs)
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: emailsecurity.UpToDate(cr.Spec.ForProvider, recs),
+	}, nil
+}
+
+func (e *postureExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.EmailSecurityPosture)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEmailSecurityPosture)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errPostureNoZone), errPostureCreation)
+	}
+
+	if len(emailsecurity.Render(cr.Spec.ForProvider)) == 0 {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errPostureEmpty), errPostureCreation)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	err := emailsecurity.Apply(ctx, e.client, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	return managed.ExternalCreation{}, errors.Wrap(err, errPostureCreation)
+}
+
+func (e *postureExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.EmailSecurityPosture)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEmailSecurityPosture)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errPostureNoZone), errPostureUpdate)
+	}
+
+	err := emailsecurity.Apply(ctx, e.client, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errPostureUpdate)
+}
+
+func (e *postureExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.EmailSecurityPosture)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotEmailSecurityPosture)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errPostureNoZone), errPostureDeletion)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	recs, err := emailsecurity.ListManaged(ctx, e.client, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errPostureDeletion)
+	}
+
+	err = emailsecurity.Delete(ctx, e.client, *cr.Spec.ForProvider.Zone, recs)
+	return managed.ExternalDelete{}, errors.Wrap(err, errPostureDeletion)
+}
+
+func (e *postureExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -18,9 +18,11 @@ package record
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,9 +38,19 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 
 	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/lookup"
 	records "github.com/rossigee/provider-cloudflare/internal/clients/records"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -51,14 +63,18 @@ const (
 	errRecordUpdate   = "cannot update record"
 	errRecordDeletion = "cannot delete record"
 	errRecordNoZone   = "no zone found"
+	errRecordZoneName = "cannot lookup zone by name"
 
-	maxConcurrency = 5
+	// Concurrent reconciles block briefly while their creations and
+	// deletions are coalesced into batch requests, so allow enough of
+	// them to form useful batches.
+	maxConcurrency = 25
 
 	// recordStatusActive = "active"
 )
 
-// Setup adds a controller that reconciles Record managed resources.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+// SetupRecord adds a controller that reconciles Record managed resources.
+func SetupRecord(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
 	name := managed.ControllerName(v1alpha1.RecordGroupKind)
 
 	o := controller.Options{
@@ -67,16 +83,18 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any
 	}
 
 	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.RecordGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&connector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (records.Client, error) {
 				return records.NewClient(cfg, hc)
 			},
-		}),
+			batcher: records.NewBatcher(records.DefaultBatchWindow, records.DefaultBatchSize),
+		}), mgr.GetClient(), scopes.DNSWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithPollInterval(5*time.Minute),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
@@ -86,6 +104,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any
 		Named(name).
 		WithOptions(o).
 		For(&v1alpha1.Record{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.RecordGroupVersionKind)).
 		Complete(r)
 }
 
@@ -94,6 +113,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any
 type connector struct {
 	kube                  client.Client
 	newCloudflareClientFn func(cfg clients.Config) (records.Client, error)
+	batcher               *records.Batcher
 }
 
 // Connect produces a valid configuration for a Cloudflare API
@@ -115,13 +135,79 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	return &external{client: client}, nil
+	return &external{
+		client:      client,
+		batcher:     c.batcher,
+		batchScope:  config.ProviderConfigName + "/" + lookup.Scope(client),
+		propagation: config.MetadataPropagation,
+		ownership:   config.Ownership,
+	}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	client records.Client
+	client      records.Client
+	batcher     *records.Batcher
+	batchScope  string
+	propagation *pcv1alpha1.MetadataPropagation
+	ownership   *pcv1alpha1.Ownership
+}
+
+// metadata returns the tags and comment the supplied Record's DNS Record
+// should have.
+func (e *external) metadata(cr *v1alpha1.Record) *clients.Metadata {
+	md := clients.PropagatedMetadata(e.propagation, cr).WithOwner(e.ownership, cr)
+	if records.IsInternal(&cr.Spec.ForProvider) {
+		md = md.WithTag(records.InternalTag)
+	}
+	return md
+}
+
+// createDNSRecord creates a record, coalescing it with other creations in
+// the same zone when a batcher is configured.
+func (e *external) createDNSRecord(ctx context.Context, zoneID string, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+	if e.batcher != nil {
+		return e.batcher.CreateDNSRecord(ctx, e.client, e.batchScope, zoneID, params)
+	}
+	return e.client.CreateDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), params)
+}
+
+// deleteDNSRecord deletes a record, coalescing it with other deletions in
+// the same zone when a batcher is configured.
+func (e *external) deleteDNSRecord(ctx context.Context, zoneID, recordID string) error {
+	if e.batcher != nil {
+		return e.batcher.DeleteDNSRecord(ctx, e.client, e.batchScope, zoneID, recordID)
+	}
+	return e.client.DeleteDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), recordID)
+}
+
+// adoptDNSRecord returns the existing record identical to the one that could
+// not be created, unless another cluster owns it. The supplied creation
+// error is returned if no identical record can be found.
+func (e *external) adoptDNSRecord(ctx context.Context, cr *v1alpha1.Record, zoneID string, params cloudflare.CreateDNSRecordParams, cerr error) (cloudflare.DNSRecord, error) {
+	rec, err := records.FindIdentical(ctx, e.client, zoneID, params)
+	if err != nil {
+		return cloudflare.DNSRecord{}, cerr
+	}
+	if err := clients.CheckOwnership(e.ownership, rec.Tags, cr.Spec.ForProvider.TakeOwnership); err != nil {
+		return cloudflare.DNSRecord{}, err
+	}
+	return rec, nil
+}
+
+// zoneID returns the ID of the zone a record is managed on, looking it up by
+// name when only a zone name is specified. Lookups are cached, so this does
+// not list zones on every reconcile.
+func (e *external) zoneID(ctx context.Context, p *v1alpha1.RecordParameters) (string, error) {
+	if p.Zone != nil {
+		return *p.Zone, nil
+	}
+	if p.ZoneName == nil {
+		return "", errors.New(errRecordNoZone)
+	}
+	id, err := lookup.Default.ZoneID(ctx, e.client, lookup.Scope(e.client), *p.ZoneName)
+	return id, errors.Wrap(err, errRecordZoneName)
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -136,11 +222,12 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalObservation{}, errors.New(errRecordNoZone)
+	zoneID, err := e.zoneID(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
 	}
 
-	rc := cloudflare.ZoneIdentifier(*cr.Spec.ForProvider.Zone)
+	rc := cloudflare.ZoneIdentifier(zoneID)
 	record, err := e.client.GetDNSRecord(ctx, rc, rid)
 
 	if err != nil {
@@ -148,15 +235,75 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			errors.Wrap(resource.Ignore(records.IsRecordNotFound, err), errRecordLookup)
 	}
 
+	// Refuse to manage, and so overwrite, a record another cluster owns.
+	if err := clients.CheckOwnership(e.ownership, record.Tags, cr.Spec.ForProvider.TakeOwnership); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	cr.Status.AtProvider = records.GenerateObservation(record)
+	if cr.Spec.ForProvider.ZoneName != nil {
+		cr.Status.AtProvider.Zone = *cr.Spec.ForProvider.ZoneName
+	}
 
 	cr.SetConditions(rtv1.Available())
 
-	return managed.ExternalObservation{
+	md := e.metadata(cr)
+	li := records.LateInitialize(&cr.Spec.ForProvider, record)
+
+	// Fields whose changes are ignored are up to date whatever their value.
+	desired := records.WithIgnoredChanges(&cr.Spec.ForProvider, record)
+
+	o := managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceLateInitialized: records.LateInitialize(&cr.Spec.ForProvider, record),
-		ResourceUpToDate:        records.UpToDate(&cr.Spec.ForProvider, record),
-	}, nil
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        records.UpToDate(desired, record) && md.UpToDate(record.Tags, record.Comment),
+		ConnectionDetails:       records.ConnectionDetails(record, zoneID),
+	}
+
+	// The observation of a Record does not mirror its parameters, so its
+	// diff is described here rather than by the drift connecter.
+	if !o.ResourceUpToDate {
+		d := recordDiff(desired, md, record)
+		cr.SetLastUpdateDiff(d)
+		o.Diff = drift.Summary(d)
+	}
+	return o, nil
+}
+
+// recordDiff returns the fields of the supplied parameters and metadata
+// that differ from the supplied DNS record.
+func recordDiff(spec *v1alpha1.RecordParameters, md *clients.Metadata, o cloudflare.DNSRecord) []pcv1alpha1.FieldDiff {
+	var d []pcv1alpha1.FieldDiff
+	add := func(path string, desired, observed any) {
+		if f, ok := drift.Compare(path, desired, observed); ok {
+			d = append(d, f)
+		}
+	}
+
+	add("name", spec.Name, o.Name)
+	if data, err := records.Data(spec); err == nil && data != nil {
+		add("data", data, o.Data)
+	} else {
+		add("content", spec.Content, o.Content)
+	}
+	if spec.TTL != nil {
+		add("ttl", *spec.TTL, o.TTL)
+	}
+	if p := records.Proxied(spec); p != nil && o.Proxied != nil {
+		add("proxied", *p, *o.Proxied)
+	}
+	if spec.Priority != nil && o.Priority != nil {
+		add("priority", *spec.Priority, *o.Priority)
+	}
+	if md != nil {
+		if md.Comment != nil {
+			add("comment", *md.Comment, o.Comment)
+		}
+		tags := append([]string{}, o.Tags...)
+		sort.Strings(tags)
+		add("tags", append([]string{}, md.Tags...), tags)
+	}
+	return d
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -165,9 +312,9 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotRecord)
 	}
 
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalCreation{},
-			errors.Wrap(errors.New(errRecordNoZone), errRecordCreation)
+	zoneID, err := e.zoneID(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRecordCreation)
 	}
 
 	if cr.Spec.ForProvider.TTL == nil {
@@ -193,6 +340,10 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
+	if err := records.ValidateExposure(&cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRecordCreation)
+	}
+
 	cr.SetConditions(rtv1.Creating())
 
 	ttl := int(*cr.Spec.ForProvider.TTL)
@@ -202,13 +353,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		pri = &val
 	}
 
-	rc := cloudflare.ZoneIdentifier(*cr.Spec.ForProvider.Zone)
 	params := cloudflare.CreateDNSRecordParams{
 		Type:    *cr.Spec.ForProvider.Type,
 		Name:    cr.Spec.ForProvider.Name,
 		Content: cr.Spec.ForProvider.Content,
 		TTL:     ttl,
-		Proxied: cr.Spec.ForProvider.Proxied,
+		Proxied: records.Proxied(&cr.Spec.ForProvider),
 	}
 	if pri != nil {
 		params.Priority = pri
@@ -226,9 +376,31 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		params.Priority = nil
 		params.Content = ""
 	}
-	
-	res, err := e.client.CreateDNSRecord(ctx, rc, params)
 
+	// LOC and CERT records may be described by structured data instead of
+	// content.
+	data, err := records.Data(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRecordCreation)
+	}
+	if data != nil {
+		params.Data = data
+		params.Content = ""
+	}
+
+	if md := e.metadata(cr); md != nil {
+		params.Tags = md.Tags
+		if md.Comment != nil {
+			params.Comment = *md.Comment
+		}
+	}
+	
+	res, err := e.createDNSRecord(ctx, zoneID, params)
+	if records.IsIdenticalRecord(err) {
+		// A previous attempt may have created the record without its
+		// ID being recorded, so adopt it rather than fail every retry.
+		res, err = e.adoptDNSRecord(ctx, cr, zoneID, params, err)
+	}
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errRecordCreation)
 	}
@@ -238,7 +410,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	// Update the external name with the ID of the new DNS Record
 	meta.SetExternalName(cr, res.ID)
 
-	return managed.ExternalCreation{}, nil
+	return managed.ExternalCreation{ConnectionDetails: records.ConnectionDetails(res, zoneID)}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -247,8 +419,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotRecord)
 	}
 
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errRecordNoZone), errRecordUpdate)
+	zoneID, err := e.zoneID(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRecordUpdate)
 	}
 
 	rid := meta.GetExternalName(cr)
@@ -258,9 +431,23 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errRecordUpdate)
 	}
 
+	if err := records.ValidateExposure(&cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRecordUpdate)
+	}
+
+	// Leave fields whose changes are ignored as they are on the record.
+	desired := &cr.Spec.ForProvider
+	if len(desired.IgnoreChanges) > 0 {
+		record, err := e.client.GetDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), rid)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRecordUpdate)
+		}
+		desired = records.WithIgnoredChanges(desired, record)
+	}
+
 	return managed.ExternalUpdate{},
 		errors.Wrap(
-			records.UpdateRecord(ctx, e.client, *cr.Spec.ForProvider.Zone, rid, &cr.Spec.ForProvider),
+			records.UpdateRecord(ctx, e.client, zoneID, rid, desired, e.metadata(cr)),
 			errRecordUpdate,
 		)
 }
@@ -271,8 +458,9 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNotRecord)
 	}
 
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalDelete{}, errors.Wrap(errors.New(errRecordNoZone), errRecordDeletion)
+	zoneID, err := e.zoneID(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errRecordDeletion)
 	}
 
 	rid := meta.GetExternalName(cr)
@@ -282,8 +470,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errRecordDeletion)
 	}
 
-	rc := cloudflare.ZoneIdentifier(*cr.Spec.ForProvider.Zone)
-	err := e.client.DeleteDNSRecord(ctx, rc, meta.GetExternalName(cr))
+	err = e.deleteDNSRecord(ctx, zoneID, meta.GetExternalName(cr))
 	return managed.ExternalDelete{}, errors.Wrap(err, errRecordDeletion)
 }
 
@@ -68,6 +68,34 @@ func withZone(zoneID string) recordModifier {
 	return func(r *v1alpha1.Record) { r.Spec.ForProvider.Zone = &zoneID }
 }
 
+func withZoneName(name string) recordModifier {
+	return func(r *v1alpha1.Record) { r.Spec.ForProvider.ZoneName = &name }
+}
+
+func withTakeOwnership(take bool) recordModifier {
+	return func(r *v1alpha1.Record) { r.Spec.ForProvider.TakeOwnership = &take }
+}
+
+func withName(name string) recordModifier {
+	return func(r *v1alpha1.Record) { r.Spec.ForProvider.Name = name }
+}
+
+func withContent(content string) recordModifier {
+	return func(r *v1alpha1.Record) { r.Spec.ForProvider.Content = content }
+}
+
+func withProxiedContent(content string) recordModifier {
+	proxied := true
+	return func(r *v1alpha1.Record) {
+		r.Spec.ForProvider.Content = content
+		r.Spec.ForProvider.Proxied = &proxied
+	}
+}
+
+func withIgnoreChanges(fields ...v1alpha1.RecordField) recordModifier {
+	return func(r *v1alpha1.Record) { r.Spec.ForProvider.IgnoreChanges = fields }
+}
+
 func record(m ...recordModifier) *v1alpha1.Record {
 	cr := &v1alpha1.Record{}
 	for _, f := range m {
@@ -174,7 +202,8 @@ func TestObserve(t *testing.T) {
 	errBoom := errors.New("boom")
 
 	type fields struct {
-		client records.Client
+		client    records.Client
+		ownership *pcv1alpha1.Ownership
 	}
 
 	type args struct {
@@ -253,6 +282,133 @@ func TestObserve(t *testing.T) {
 				err: errors.New(errRecordNoZone),
 			},
 		},
+		"ErrZoneNameLookup": {
+			reason: "We should return an error if the zone cannot be looked up by name",
+			fields: fields{
+				client: &fake.MockClient{
+					MockListZonesContext: func(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error) {
+						return cloudflare.ZonesResponse{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: record(
+					withExternalName("1234beef"),
+					withZoneName("example.com"),
+				),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot list zones"), errRecordZoneName),
+			},
+		},
+		"ZoneName": {
+			reason: "We should look up the zone by name when no zone ID is specified",
+			fields: fields{
+				client: &fake.MockClient{
+					MockListZonesContext: func(ctx context.Context, opts ...cloudflare.ReqOption) (cloudflare.ZonesResponse, error) {
+						return cloudflare.ZonesResponse{Result: []cloudflare.Zone{{ID: "zone-id", Name: "example.com"}}}, nil
+					},
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+						if rc.Identifier != "zone-id" {
+							return cloudflare.DNSRecord{}, errBoom
+						}
+						return cloudflare.DNSRecord{ID: recordID}, nil
+					},
+				},
+			},
+			args: args{
+				mg: record(withExternalName("1234beef"), withZoneName("example.com")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: records.ConnectionDetails(cloudflare.DNSRecord{ID: "1234beef"}, "zone-id"),
+				},
+			},
+		},
+		"ErrOwnedElsewhere": {
+			reason: "We should return an error if the record is owned by another cluster",
+			fields: fields{
+				client: &fake.MockClient{
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+						return cloudflare.DNSRecord{ID: recordID, Tags: []string{"crossplane-owner:other/uid"}}, nil
+					},
+				},
+				ownership: &pcv1alpha1.Ownership{ClusterID: "this"},
+			},
+			args: args{
+				mg: record(withExternalName("1234beef"), withZone("foo.com")),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Errorf("resource is owned by cluster %q; set takeOwnership to manage it from this cluster", "other"),
+			},
+		},
+		"TakeOwnership": {
+			reason: "A record owned by another cluster should be retagged when the Record takes ownership of it",
+			fields: fields{
+				client: &fake.MockClient{
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+						return cloudflare.DNSRecord{ID: recordID, Tags: []string{"crossplane-owner:other/uid"}}, nil
+					},
+				},
+				ownership: &pcv1alpha1.Ownership{ClusterID: "this"},
+			},
+			args: args{
+				mg: record(withExternalName("1234beef"), withZone("foo.com"), withTakeOwnership(true)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: records.ConnectionDetails(cloudflare.DNSRecord{ID: "1234beef"}, "foo.com"),
+					Diff:              `tags: ["crossplane-owner:this/"] (observed ["crossplane-owner:other/uid"])`,
+				},
+			},
+		},
+		"ChangedContent": {
+			reason: "The diff of a record whose content was changed elsewhere should describe its content",
+			fields: fields{
+				client: &fake.MockClient{
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+						return cloudflare.DNSRecord{ID: recordID, Content: "192.0.2.2"}, nil
+					},
+				},
+			},
+			args: args{
+				mg: record(withExternalName("1234beef"), withZone("foo.com"), withContent("192.0.2.1")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: records.ConnectionDetails(cloudflare.DNSRecord{ID: "1234beef"}, "foo.com"),
+					Diff:              "content: 192.0.2.1 (observed 192.0.2.2)",
+				},
+			},
+		},
+		"IgnoredContent": {
+			reason: "A record whose content was changed elsewhere should be up to date when the Record ignores content changes",
+			fields: fields{
+				client: &fake.MockClient{
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+						return cloudflare.DNSRecord{ID: recordID, Content: "192.0.2.2"}, nil
+					},
+				},
+			},
+			args: args{
+				mg: record(withExternalName("1234beef"), withZone("foo.com"), withContent("192.0.2.1"), withIgnoreChanges(v1alpha1.RecordFieldContent)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: records.ConnectionDetails(cloudflare.DNSRecord{ID: "1234beef"}, "foo.com"),
+				},
+			},
+		},
 		"Success": {
 			reason: "We should return ResourceExists: true and no error when a record is found",
 			fields: fields{
@@ -269,8 +425,9 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: records.ConnectionDetails(cloudflare.DNSRecord{ID: "1234beef"}, "foo.com"),
 				},
 				err: nil,
 			},
@@ -279,7 +436,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.fields.client}
+			e := external{client: tc.fields.client, ownership: tc.fields.ownership}
 			got, err := e.Observe(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -293,9 +450,11 @@ func TestObserve(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	errBoom := errors.New("boom")
+	errIdentical := errors.New("An identical record already exists. (81058)")
 
 	type fields struct {
-		client records.Client
+		client    records.Client
+		ownership *pcv1alpha1.Ownership
 	}
 
 	type args struct {
@@ -304,8 +463,9 @@ func TestCreate(t *testing.T) {
 	}
 
 	type want struct {
-		o   managed.ExternalCreation
-		err error
+		o            managed.ExternalCreation
+		externalName string
+		err          error
 	}
 
 	cases := map[string]struct {
@@ -340,9 +500,109 @@ func TestCreate(t *testing.T) {
 					withType("A"),
 				),
 			},
+			want: want{
+				o:            managed.ExternalCreation{},
+				externalName: "1234beef",
+				err:          errors.Wrap(errBoom, errRecordCreation),
+			},
+		},
+		"AdoptIdentical": {
+			reason: "An identical record that already exists should be adopted",
+			fields: fields{
+				client: &fake.MockClient{
+					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+						return cloudflare.DNSRecord{}, errIdentical
+					},
+					MockListDNSRecords: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+						return []cloudflare.DNSRecord{
+							{ID: "other", Type: "A", Name: "www.foo.com", Content: "192.0.2.2"},
+							{ID: "existing", Type: "A", Name: "www.foo.com", Content: "192.0.2.1"},
+						}, nil, nil
+					},
+				},
+			},
+			args: args{
+				mg: record(
+					withName("www.foo.com"),
+					withContent("192.0.2.1"),
+					withZone("foo.com"),
+					withTTL(600),
+					withType("A"),
+				),
+			},
+			want: want{
+				o:            managed.ExternalCreation{ConnectionDetails: records.ConnectionDetails(cloudflare.DNSRecord{ID: "existing", Name: "www.foo.com"}, "foo.com")},
+				externalName: "existing",
+			},
+		},
+		"ErrAdoptNotFound": {
+			reason: "The creation error should be returned if no identical record can be found",
+			fields: fields{
+				client: &fake.MockClient{
+					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+						return cloudflare.DNSRecord{}, errIdentical
+					},
+				},
+			},
+			args: args{
+				mg: record(
+					withName("www.foo.com"),
+					withContent("192.0.2.1"),
+					withZone("foo.com"),
+					withTTL(600),
+					withType("A"),
+				),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrap(errIdentical, errRecordCreation),
+			},
+		},
+		"ErrAdoptOwnedElsewhere": {
+			reason: "An identical record owned by another cluster should not be adopted",
+			fields: fields{
+				client: &fake.MockClient{
+					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+						return cloudflare.DNSRecord{}, errIdentical
+					},
+					MockListDNSRecords: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+						return []cloudflare.DNSRecord{
+							{ID: "existing", Type: "A", Name: "www.foo.com", Content: "192.0.2.1", Tags: []string{"crossplane-owner:other/uid"}},
+						}, nil, nil
+					},
+				},
+				ownership: &pcv1alpha1.Ownership{ClusterID: "this"},
+			},
+			args: args{
+				mg: record(
+					withName("www.foo.com"),
+					withContent("192.0.2.1"),
+					withZone("foo.com"),
+					withTTL(600),
+					withType("A"),
+				),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrap(errors.Errorf("resource is owned by cluster %q; set takeOwnership to manage it from this cluster", "other"), errRecordCreation),
+			},
+		},
+		"ErrRecordCreateProxiedPrivate": {
+			reason: "We should refuse to proxy a private address",
+			fields: fields{
+				client: &fake.MockClient{},
+			},
+			args: args{
+				mg: record(
+					withZone("foo.com"),
+					withTTL(600),
+					withType("A"),
+					withProxiedContent("10.1.2.3"),
+				),
+			},
 			want: want{
 				o:   managed.ExternalCreation{},
-				err: errors.Wrap(errBoom, errRecordCreation),
+				err: errors.Wrap(errors.New("refusing to proxy private address 10.1.2.3; set allowProxiedPrivateTarget to proxy it anyway"), errRecordCreation),
 			},
 		},
 		"ErrRecordCreatePriorityMX": {
@@ -445,7 +705,7 @@ func TestCreate(t *testing.T) {
 				),
 			},
 			want: want{
-				o: managed.ExternalCreation{},
+				o: managed.ExternalCreation{ConnectionDetails: records.ConnectionDetails(cloudflare.DNSRecord{}, "foo.com")},
 				err: nil,
 			},
 		},
@@ -453,7 +713,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.fields.client}
+			e := external{client: tc.fields.client, ownership: tc.fields.ownership}
 			got, err := e.Create(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -461,6 +721,11 @@ func TestCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
+			if cr, ok := tc.args.mg.(*v1alpha1.Record); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+				}
+			}
 		})
 	}
 }
@@ -540,6 +805,57 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errRecordUpdate),
 			},
 		},
+		"IgnoredContent": {
+			reason: "Content changed elsewhere should not be reverted when the Record ignores content changes",
+			fields: fields{
+				client: &fake.MockClient{
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+						return cloudflare.DNSRecord{ID: recordID, Content: "192.0.2.2"}, nil
+					},
+					MockUpdateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+						if params.Content != "192.0.2.2" || params.TTL != 900 {
+							return cloudflare.DNSRecord{}, errBoom
+						}
+						return cloudflare.DNSRecord{}, nil
+					},
+				},
+			},
+			args: args{
+				mg: record(
+					withExternalName("1234beef"),
+					withType("A"),
+					withZone("foo.com"),
+					withContent("192.0.2.1"),
+					withTTL(900),
+					withIgnoreChanges(v1alpha1.RecordFieldContent),
+				),
+			},
+			want: want{
+				o: managed.ExternalUpdate{},
+			},
+		},
+		"ErrIgnoredChangesLookup": {
+			reason: "Errors getting the record to keep its ignored fields should be returned",
+			fields: fields{
+				client: &fake.MockClient{
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+						return cloudflare.DNSRecord{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: record(
+					withExternalName("1234beef"),
+					withType("A"),
+					withZone("foo.com"),
+					withIgnoreChanges(v1alpha1.RecordFieldContent),
+				),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(errBoom, errRecordUpdate),
+			},
+		},
 		"Success": {
 			reason: "We should return no error when a zone is updated",
 			fields: fields{
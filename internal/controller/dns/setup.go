@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// Setup creates all DNS controllers with the supplied logger and adds them to
+// the supplied manager.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	// Setup Record controller
+	if err := SetupRecord(mgr, l, rl); err != nil {
+		return err
+	}
+
+	// Setup EmailSecurityPosture controller
+	if err := SetupEmailSecurityPosture(mgr, l, rl); err != nil {
+		return err
+	}
+
+	// Setup DNSFirewallCluster controller
+	if err := SetupDNSFirewallCluster(mgr, l, rl); err != nil {
+		return err
+	}
+
+	// Setup ZoneBootstrap controller
+	if err := SetupZoneBootstrap(mgr, l, rl); err != nil {
+		return err
+	}
+
+	return nil
+}
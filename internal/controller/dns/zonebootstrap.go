@@ -0,0 +1,235 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/zonebootstrap"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotZoneBootstrap = "managed resource is not a ZoneBootstrap custom resource"
+
+	errBootstrapLookup   = "cannot lookup bootstrap records"
+	errBootstrapCreation = "cannot create bootstrap records"
+	errBootstrapUpdate   = "cannot update bootstrap records"
+	errBootstrapDeletion = "cannot delete bootstrap records"
+	errBootstrapNoZone   = "no zone found"
+)
+
+// SetupZoneBootstrap adds a controller that reconciles ZoneBootstrap
+// managed resources.
+func SetupZoneBootstrap(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.ZoneBootstrapGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: 5,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ZoneBootstrapGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&bootstrapConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (zonebootstrap.Client, error) {
+				return zonebootstrap.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.DNSWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.ZoneBootstrap{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.ZoneBootstrapGroupVersionKind)).
+		Complete(r)
+}
+
+// A bootstrapConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type bootstrapConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (zonebootstrap.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *bootstrapConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.ZoneBootstrap); !ok {
+		return nil, errors.New(errNotZoneBootstrap)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bootstrapExternal{client: client}, nil
+}
+
+// A bootstrapExternal observes, then either creates, updates, or deletes
+// the DNS Records of a ZoneBootstrap.
+type bootstrapExternal struct {
+	client zonebootstrap.Client
+}
+
+// domain returns the name of the zone of a ZoneBootstrap, which is
+// remembered in its status since the zone cannot change.
+func (e *bootstrapExternal) domain(ctx context.Context, cr *v1alpha1.ZoneBootstrap) (string, error) {
+	if cr.Status.AtProvider.Domain != "" {
+		return cr.Status.AtProvider.Domain, nil
+	}
+	return zonebootstrap.Domain(ctx, e.client, *cr.Spec.ForProvider.Zone)
+}
+
+func (e *bootstrapExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ZoneBootstrap)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotZoneBootstrap)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalObservation{}, errors.New(errBootstrapNoZone)
+	}
+
+	domain, err := e.domain(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errBootstrapLookup)
+	}
+
+	recs, err := zonebootstrap.ListManaged(ctx, e.client, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errBootstrapLookup)
+	}
+
+	// The bootstrap exists for as long as any record it owns does.
+	if len(recs) == 0 {
+		cr.Status.AtProvider.Domain = domain
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = zonebootstrap.GenerateObservation(domain, recs)
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: zonebootstrap.UpToDate(cr.Spec.ForProvider, domain, recs),
+	}, nil
+}
+
+func (e *bootstrapExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ZoneBootstrap)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotZoneBootstrap)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errBootstrapNoZone), errBootstrapCreation)
+	}
+
+	domain, err := e.domain(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errBootstrapCreation)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	err = zonebootstrap.Apply(ctx, e.client, *cr.Spec.ForProvider.Zone, domain, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	return managed.ExternalCreation{}, errors.Wrap(err, errBootstrapCreation)
+}
+
+func (e *bootstrapExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ZoneBootstrap)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotZoneBootstrap)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errBootstrapNoZone), errBootstrapUpdate)
+	}
+
+	domain, err := e.domain(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBootstrapUpdate)
+	}
+
+	err = zonebootstrap.Apply(ctx, e.client, *cr.Spec.ForProvider.Zone, domain, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errBootstrapUpdate)
+}
+
+func (e *bootstrapExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ZoneBootstrap)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotZoneBootstrap)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errBootstrapNoZone), errBootstrapDeletion)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	recs, err := zonebootstrap.ListManaged(ctx, e.client, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errBootstrapDeletion)
+	}
+
+	err = zonebootstrap.Delete(ctx, e.client, *cr.Spec.ForProvider.Zone, recs)
+	return managed.ExternalDelete{}, errors.Wrap(err, errBootstrapDeletion)
+}
+
+func (e *bootstrapExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
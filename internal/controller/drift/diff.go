@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+const (
+	// maxDiffs is the number of fields a diff lists at most.
+	maxDiffs = 20
+
+	// maxValueLength is the length values in a diff are truncated to.
+	maxValueLength = 64
+
+	redacted = "(redacted)"
+)
+
+// sensitive lists the lowercased names of the fields that hold a secret
+// whose value must not be surfaced in events or status. Names are matched
+// whole, so that e.g. cacheKey or privateNetwork are not redacted.
+var sensitive = map[string]bool{
+	"secret":       true,
+	"secrets":      true,
+	"password":     true,
+	"token":        true,
+	"apitoken":     true,
+	"apikey":       true,
+	"key":          true,
+	"privatekey":   true,
+	"credentials":  true,
+	"clientsecret": true,
+}
+
+// Diff returns the fields of spec.forProvider of the supplied managed
+// resource whose values differ from the fields at the same path of
+// status.atProvider, sorted by path. Fields that are not observed, and
+// references and selectors, which are resolved into other fields, are not
+// compared. Values of sensitive fields are redacted. Kinds whose
+// status.atProvider does not mirror spec.forProvider describe their diff
+// themselves, using Compare.
+func Diff(mg resource.Managed) []pcv1alpha1.FieldDiff {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return nil
+	}
+	desired := nested(u, "spec", "forProvider")
+	observed := nested(u, "status", "atProvider")
+	if desired == nil || observed == nil {
+		return nil
+	}
+
+	var d []pcv1alpha1.FieldDiff
+	diffFields("", desired, observed, &d)
+	sort.Slice(d, func(i, j int) bool { return d[i].Path < d[j].Path })
+	if len(d) > maxDiffs {
+		d = d[:maxDiffs]
+	}
+	return d
+}
+
+// Compare returns the diff of the field at the supplied path, and whether
+// its desired and observed values differ. Values are formatted, redacted
+// and truncated as they are by Diff.
+func Compare(path string, desired, observed any) (pcv1alpha1.FieldDiff, bool) {
+	ds, os := format(desired), format(observed)
+	if ds == os || reflect.DeepEqual(desired, observed) {
+		return pcv1alpha1.FieldDiff{}, false
+	}
+	if isSensitive(path) {
+		ds, os = redacted, redacted
+	}
+	return pcv1alpha1.FieldDiff{Path: path, Desired: truncate(ds), Observed: truncate(os)}, true
+}
+
+// Summary returns a compact, single line description of the supplied diff.
+func Summary(d []pcv1alpha1.FieldDiff) string {
+	s := make([]string, 0, len(d))
+	for _, f := range d {
+		s = append(s, fmt.Sprintf("%s: %s (observed %s)", f.Path, f.Desired, f.Observed))
+	}
+	return strings.Join(s, "; ")
+}
+
+func nested(u map[string]any, fields ...string) map[string]any {
+	for _, f := range fields {
+		m, ok := u[f].(map[string]any)
+		if !ok {
+			return nil
+		}
+		u = m
+	}
+	return u
+}
+
+func diffFields(prefix string, desired, observed map[string]any, d *[]pcv1alpha1.FieldDiff) {
+	for k, dv := range desired {
+		if strings.HasSuffix(k, "Ref") || strings.HasSuffix(k, "Refs") || strings.HasSuffix(k, "Selector") {
+			continue
+		}
+		ov, ok := observed[k]
+		if !ok || dv == nil {
+			continue
+		}
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+
+		dm, dIsMap := dv.(map[string]any)
+		om, oIsMap := ov.(map[string]any)
+		if dIsMap && oIsMap {
+			diffFields(path, dm, om, d)
+			continue
+		}
+
+		if f, ok := Compare(path, dv, ov); ok {
+			*d = append(*d, f)
+		}
+	}
+}
+
+// format returns the supplied value as it appears in a diff. Strings are
+// not quoted, and numbers of either type format alike.
+func format(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+func truncate(s string) string {
+	r := []rune(s)
+	if len(r) <= maxValueLength {
+		return s
+	}
+	return string(r[:maxValueLength-3]) + "..."
+}
+
+// isSensitive returns true if any field of the supplied path is sensitive.
+func isSensitive(path string) bool {
+	for _, f := range strings.Split(path, ".") {
+		if sensitive[strings.ToLower(f)] {
+			return true
+		}
+	}
+	return false
+}
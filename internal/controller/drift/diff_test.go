@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+)
+
+func TestDiff(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.Record
+		want   []pcv1alpha1.FieldDiff
+	}{
+		"UpToDate": {
+			reason: "No fields should be listed when the observed fields match",
+			mg: &v1alpha1.Record{
+				Spec:   v1alpha1.RecordSpec{ForProvider: v1alpha1.RecordParameters{Zone: ptr.To("abc")}},
+				Status: v1alpha1.RecordStatus{AtProvider: v1alpha1.RecordObservation{Zone: "abc"}},
+			},
+		},
+		"Differs": {
+			reason: "Fields whose observed value differs should be listed",
+			mg: &v1alpha1.Record{
+				Spec:   v1alpha1.RecordSpec{ForProvider: v1alpha1.RecordParameters{Zone: ptr.To("abc"), Name: "www"}},
+				Status: v1alpha1.RecordStatus{AtProvider: v1alpha1.RecordObservation{Zone: "def"}},
+			},
+			want: []pcv1alpha1.FieldDiff{{Path: "zone", Desired: "abc", Observed: "def"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Diff(tc.mg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDiff(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		desired  map[string]any
+		observed map[string]any
+		want     []pcv1alpha1.FieldDiff
+	}{
+		"Nested": {
+			reason:   "Nested fields should be compared and listed by their path",
+			desired:  map[string]any{"settings": map[string]any{"ttl": int64(60), "mode": "on"}},
+			observed: map[string]any{"settings": map[string]any{"ttl": float64(60), "mode": "off"}},
+			want:     []pcv1alpha1.FieldDiff{{Path: "settings.mode", Desired: "on", Observed: "off"}},
+		},
+		"NotObserved": {
+			reason:   "Fields that are not observed should not be listed",
+			desired:  map[string]any{"name": "www"},
+			observed: map[string]any{},
+		},
+		"References": {
+			reason:   "References and selectors should not be compared",
+			desired:  map[string]any{"zoneRef": map[string]any{"name": "a"}, "zoneSelector": "b"},
+			observed: map[string]any{"zoneRef": map[string]any{"name": "c"}, "zoneSelector": "d"},
+		},
+		"Sensitive": {
+			reason:   "Values of sensitive fields should be redacted",
+			desired:  map[string]any{"secrets": map[string]any{"value": "hunter2"}, "apiToken": "a"},
+			observed: map[string]any{"secrets": map[string]any{"value": "hunter3"}, "apiToken": "b"},
+			want: []pcv1alpha1.FieldDiff{
+				{Path: "apiToken", Desired: redacted, Observed: redacted},
+				{Path: "secrets.value", Desired: redacted, Observed: redacted},
+			},
+		},
+		"NotSensitive": {
+			reason:   "Values of fields whose names only contain a sensitive word should not be redacted",
+			desired:  map[string]any{"cacheKey": "a", "keyTag": float64(1), "privateNetwork": true},
+			observed: map[string]any{"cacheKey": "b", "keyTag": float64(2), "privateNetwork": false},
+			want: []pcv1alpha1.FieldDiff{
+				{Path: "cacheKey", Desired: "a", Observed: "b"},
+				{Path: "keyTag", Desired: "1", Observed: "2"},
+				{Path: "privateNetwork", Desired: "true", Observed: "false"},
+			},
+		},
+		"Truncated": {
+			reason:   "Long values should be truncated",
+			desired:  map[string]any{"content": strings.Repeat("a", 100)},
+			observed: map[string]any{"content": "b"},
+			want:     []pcv1alpha1.FieldDiff{{Path: "content", Desired: strings.Repeat("a", maxValueLength-3) + "...", Observed: "b"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []pcv1alpha1.FieldDiff
+			diffFields("", tc.desired, tc.observed, &got)
+			sort.Slice(got, func(i, j int) bool { return got[i].Path < got[j].Path })
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ndiffFields(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		path     string
+		desired  any
+		observed any
+		want     pcv1alpha1.FieldDiff
+		wantOK   bool
+	}{
+		"Equal": {
+			reason:   "Values that format alike should not differ",
+			path:     "ttl",
+			desired:  int64(60),
+			observed: 60,
+		},
+		"Differs": {
+			reason:   "Values that differ should be described",
+			path:     "ttl",
+			desired:  int64(60),
+			observed: 120,
+			want:     pcv1alpha1.FieldDiff{Path: "ttl", Desired: "60", Observed: "120"},
+			wantOK:   true,
+		},
+		"Sensitive": {
+			reason:   "Values of sensitive fields should be redacted",
+			path:     "secrets.value",
+			desired:  "hunter2",
+			observed: "hunter3",
+			want:     pcv1alpha1.FieldDiff{Path: "secrets.value", Desired: redacted, Observed: redacted},
+			wantOK:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := Compare(tc.path, tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nCompare(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if ok != tc.wantOK {
+				t.Errorf("\n%s\nCompare(...): want %t, got %t\n", tc.reason, tc.wantOK, ok)
+			}
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	got := Summary([]pcv1alpha1.FieldDiff{{Path: "ttl", Desired: "60", Observed: "120"}, {Path: "proxied", Desired: "true", Observed: "false"}})
+	want := "ttl: 60 (observed 120); proxied: true (observed false)"
+	if got != want {
+		t.Errorf("Summary(...): want %q, got %q", want, got)
+	}
+}
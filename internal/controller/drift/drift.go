@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drift reports changes made to external resources outside of
+// Crossplane, and why external resources are updated.
+package drift
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+// ReasonExternalDrift is the reason of the event emitted when an external
+// resource was changed outside of Crossplane.
+const ReasonExternalDrift event.Reason = "ExternalDrift"
+
+// ReasonUpdateRequired is the reason of the event emitted when an external
+// resource no longer matches the desired state of its managed resource.
+const ReasonUpdateRequired event.Reason = "UpdateRequired"
+
+const (
+	msgExternalDrift  = "External resource was changed outside of Crossplane and no longer matches the desired state"
+	msgUpdateRequired = "External resource does not match the desired state"
+)
+
+// Drifted returns true if the supplied managed resource was last
+// successfully synced at its current generation, such that an external
+// resource that is no longer up to date must have been changed out of
+// band rather than by an edit to the managed resource's spec.
+func Drifted(mg resource.Managed) bool {
+	c := mg.GetCondition(rtv1.TypeSynced)
+	return c.Status == corev1.ConditionTrue &&
+		c.Reason == rtv1.ReasonReconcileSuccess &&
+		c.ObservedGeneration != 0 &&
+		c.ObservedGeneration == mg.GetGeneration()
+}
+
+// NewConnecter wraps the supplied ExternalConnecter so that the clients it
+// produces emit an ExternalDrift event, and count it in the
+// cloudflare_external_drift_total metric, when they observe that an
+// external resource drifted from its desired state. Whenever an external
+// resource is not up to date the fields that differ are recorded in
+// status.atProvider.lastUpdateDiff and attached to the event, which is an
+// UpdateRequired event unless the resource drifted. Clients that describe
+// the diff themselves, through the ExternalObservation's Diff, are trusted
+// to record it; the fields of all others are compared by Diff.
+func NewConnecter(c managed.ExternalConnecter, r event.Recorder) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c, record: r}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	record event.Recorder
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec, record: c.record}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	record event.Recorder
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil || !o.ResourceExists || o.ResourceUpToDate || meta.WasDeleted(mg) {
+		return o, err
+	}
+
+	// Kinds that describe their own diff also record it themselves.
+	if o.Diff == "" {
+		d := Diff(mg)
+		if r, ok := mg.(pcv1alpha1.UpdateDiffRecorder); ok {
+			r.SetLastUpdateDiff(d)
+		}
+		o.Diff = Summary(d)
+	}
+	if !Drifted(mg) {
+		e.record.Event(mg, event.Normal(ReasonUpdateRequired, withDiff(msgUpdateRequired, o.Diff)))
+		return o, nil
+	}
+	e.record.Event(mg, event.Warning(ReasonExternalDrift, errors.New(withDiff(msgExternalDrift, o.Diff))))
+	metrics.RecordExternalDrift(mg.GetObjectKind().GroupVersionKind().GroupKind().String())
+	return o, nil
+}
+
+func withDiff(msg, diff string) string {
+	if diff == "" {
+		return msg
+	}
+	return msg + ": " + diff
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestObserve(t *testing.T) {
+	synced := func(gen int64) rtv1.Condition {
+		return rtv1.ReconcileSuccess().WithObservedGeneration(gen)
+	}
+
+	cases := map[string]struct {
+		reason     string
+		generation int64
+		conditions []rtv1.Condition
+		obs        managed.ExternalObservation
+		want       []event.Event
+	}{
+		"UpToDate": {
+			reason:     "No event should be emitted when the external resource is up to date",
+			generation: 2,
+			conditions: []rtv1.Condition{synced(2)},
+			obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"SpecChanged": {
+			reason:     "An UpdateRequired event should be emitted when the spec changed since the last successful sync",
+			generation: 3,
+			conditions: []rtv1.Condition{synced(2)},
+			obs:        managed.ExternalObservation{ResourceExists: true},
+			want:       []event.Event{event.Normal(ReasonUpdateRequired, msgUpdateRequired)},
+		},
+		"SyncFailed": {
+			reason:     "An UpdateRequired event should be emitted when the last sync failed",
+			generation: 2,
+			conditions: []rtv1.Condition{rtv1.ReconcileError(errors.New("boom")).WithObservedGeneration(2)},
+			obs:        managed.ExternalObservation{ResourceExists: true},
+			want:       []event.Event{event.Normal(ReasonUpdateRequired, msgUpdateRequired)},
+		},
+		"NotExists": {
+			reason:     "No event should be emitted when the external resource does not exist",
+			generation: 2,
+			conditions: []rtv1.Condition{synced(2)},
+			obs:        managed.ExternalObservation{},
+		},
+		"Drifted": {
+			reason:     "An ExternalDrift event should be emitted when the external resource changed out of band",
+			generation: 2,
+			conditions: []rtv1.Condition{synced(2)},
+			obs:        managed.ExternalObservation{ResourceExists: true},
+			want:       []event.Event{event.Warning(ReasonExternalDrift, errors.New(msgExternalDrift))},
+		},
+		"DriftedWithDiff": {
+			reason:     "The diff of the observation should be attached to the event",
+			generation: 2,
+			conditions: []rtv1.Condition{synced(2)},
+			obs:        managed.ExternalObservation{ResourceExists: true, Diff: "ttl: 60 (observed 120)"},
+			want:       []event.Event{event.Warning(ReasonExternalDrift, errors.New(msgExternalDrift+": ttl: 60 (observed 120)"))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := &recorder{}
+			c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						return tc.obs, nil
+					},
+				}, nil
+			}), rec)
+
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: tc.generation}}
+			mg.SetConditions(tc.conditions...)
+			ec, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			if _, err := ec.Observe(context.Background(), mg); err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, rec.events); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want events, +got events:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveSuppliedDiff(t *testing.T) {
+	want := []pcv1alpha1.FieldDiff{{Path: "ttl", Desired: "60", Observed: "120"}}
+	obs := managed.ExternalObservation{ResourceExists: true, Diff: Summary(want)}
+	c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		return managed.ExternalClientFns{
+			ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+				mg.(*v1alpha1.Record).SetLastUpdateDiff(want)
+				return obs, nil
+			},
+		}, nil
+	}), &recorder{})
+
+	// The zone ID in the spec and zone name in the status would differ were
+	// the fields compared by Diff.
+	mg := &v1alpha1.Record{
+		Spec:   v1alpha1.RecordSpec{ForProvider: v1alpha1.RecordParameters{Zone: ptr.To("abc")}},
+		Status: v1alpha1.RecordStatus{AtProvider: v1alpha1.RecordObservation{Zone: "example.com"}},
+	}
+	ec, err := c.Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	if _, err := ec.Observe(context.Background(), mg); err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(want, mg.Status.AtProvider.LastUpdateDiff); diff != "" {
+		t.Errorf("\nThe diff recorded by the client should be kept\nObserve(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestDrifted(t *testing.T) {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	if Drifted(mg) {
+		t.Errorf("Drifted(...): want false for a resource that was never synced")
+	}
+	mg.SetConditions(rtv1.Condition{Type: rtv1.TypeSynced, Status: corev1.ConditionTrue, Reason: rtv1.ReasonReconcileSuccess, ObservedGeneration: 1})
+	if !Drifted(mg) {
+		t.Errorf("Drifted(...): want true for a resource synced at its current generation")
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package emailrouting
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/emailrouting/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/emailrouting/address"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotDestinationAddress = "managed resource is not a DestinationAddress custom resource"
+	errAddressLookup         = "cannot lookup email routing destination address"
+	errAddressCreation       = "cannot create email routing destination address"
+	errAddressResend         = "cannot resend email routing destination address verification"
+	errAddressDeletion       = "cannot delete email routing destination address"
+
+	// typeVerified indicates whether a destination address was verified,
+	// which it must be before mail is forwarded to it.
+	typeVerified rtv1.ConditionType = "Verified"
+
+	reasonAddressVerified     rtv1.ConditionReason = "Verified"
+	reasonVerificationPending rtv1.ConditionReason = "VerificationPending"
+
+	reasonVerificationResent event.Reason = "VerificationResent"
+)
+
+// SetupDestinationAddress adds a controller that reconciles
+// DestinationAddress managed resources.
+func SetupDestinationAddress(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.DestinationAddressGroupKind.String())
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DestinationAddressGroupVersionKind),
+		// Addresses are immutable, so they are never reported as drifted;
+		// they are only updated to resend their verification email.
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&addressConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (address.Client, error) {
+				return address.NewClient(cfg, hc)
+			},
+			recorder: rec,
+		}), mgr.GetClient(), scopes.EmailRoutingAddressesWrite))), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		// Addresses are identified by spec.forProvider.email.
+		managed.WithInitializers(account.NewDefaulter(mgr.GetClient())))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DestinationAddress{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.DestinationAddressGroupVersionKind)).
+		Complete(r)
+}
+
+// verified returns a condition indicating that a destination address was
+// verified.
+func verified() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeVerified,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonAddressVerified,
+	}
+}
+
+// verificationPending returns a condition indicating that a destination
+// address was not verified yet, so mail forwarded to it is dropped.
+func verificationPending() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeVerified,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonVerificationPending,
+		Message:            "Mail is not forwarded to this address until the link in its verification email is followed; set the " + v1alpha1.AnnotationKeyResendVerification + " annotation to send it again",
+	}
+}
+
+// resendRequested returns true if the verification email of an unverified
+// destination address should be sent again.
+func resendRequested(cr *v1alpha1.DestinationAddress, a cloudflare.EmailRoutingDestinationAddress) bool {
+	v := cr.GetAnnotations()[v1alpha1.AnnotationKeyResendVerification]
+	return v != "" && v != cr.Status.AtProvider.LastVerificationRequest && !address.IsVerified(a)
+}
+
+// An addressConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type addressConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (address.Client, error)
+	recorder              event.Recorder
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *addressConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.DestinationAddress); !ok {
+		return nil, errors.New(errNotDestinationAddress)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &addressExternal{client: client, recorder: c.recorder}, nil
+}
+
+// An addressExternal observes, then either creates or deletes an Email
+// Routing destination address, or resends its verification email.
+type addressExternal struct {
+	client   address.Client
+	recorder event.Recorder
+}
+
+func (e *addressExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DestinationAddress)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDestinationAddress)
+	}
+
+	a, err := address.Get(ctx, e.client, cr.Spec.ForProvider.AccountID, cr.Spec.ForProvider.Email)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errAddressLookup)
+	}
+	if a == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	last := cr.Status.AtProvider.LastVerificationRequest
+	cr.Status.AtProvider = address.GenerateObservation(*a)
+	cr.Status.AtProvider.LastVerificationRequest = last
+
+	if address.IsVerified(*a) {
+		cr.SetConditions(rtv1.Available(), verified())
+	} else {
+		cr.SetConditions(rtv1.Unavailable(), verificationPending())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !resendRequested(cr, *a),
+	}, nil
+}
+
+func (e *addressExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DestinationAddress)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDestinationAddress)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	a, err := address.Create(ctx, e.client, cr.Spec.ForProvider.AccountID, cr.Spec.ForProvider.Email)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAddressCreation)
+	}
+
+	// Creating the address sends its verification email, which satisfies
+	// any outstanding request to resend it.
+	cr.Status.AtProvider = address.GenerateObservation(a)
+	cr.Status.AtProvider.LastVerificationRequest = cr.GetAnnotations()[v1alpha1.AnnotationKeyResendVerification]
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Email)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *addressExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DestinationAddress)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDestinationAddress)
+	}
+
+	current := cloudflare.EmailRoutingDestinationAddress{Tag: cr.Status.AtProvider.Tag, Email: cr.Spec.ForProvider.Email}
+	a, err := address.ResendVerification(ctx, e.client, cr.Spec.ForProvider.AccountID, current)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAddressResend)
+	}
+
+	req := cr.GetAnnotations()[v1alpha1.AnnotationKeyResendVerification]
+	cr.Status.AtProvider = address.GenerateObservation(a)
+	cr.Status.AtProvider.LastVerificationRequest = req
+
+	if e.recorder != nil {
+		e.recorder.Event(cr, event.Normal(reasonVerificationResent, "Verification email sent again to "+a.Email))
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *addressExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.DestinationAddress)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotDestinationAddress)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	err := address.Delete(ctx, e.client, cr.Spec.ForProvider.AccountID, cr.Status.AtProvider.Tag)
+	return managed.ExternalDelete{}, errors.Wrap(err, errAddressDeletion)
+}
+
+func (e *addressExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -19,13 +19,16 @@ package emailrouting
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -35,6 +38,15 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/emailrouting/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	emailroutingruleclient "github.com/rossigee/provider-cloudflare/internal/clients/emailrouting/rule"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -49,20 +61,24 @@ const (
 
 // SetupRule adds a controller that reconciles Rule managed resources.
 func SetupRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(v1alpha1.RuleKind)
+	name := managed.ControllerName(v1alpha1.RuleGroupKind.String())
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.RuleGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&connector{
 			kube:         mgr.GetClient(),
 			newServiceFn: emailroutingruleclient.NewClientFromAPI,
-		}),
+			hc:           hc,
+		}), mgr.GetClient(), scopes.EmailRoutingRulesWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+		managed.WithRecorder(rec))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Rule{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.RuleGroupVersionKind)).
 		Complete(r)
 }
 
@@ -71,6 +87,7 @@ func SetupRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter
 type connector struct {
 	kube         client.Client
 	newServiceFn func(api *cloudflare.API) *emailroutingruleclient.RuleClient
+	hc           *http.Client
 }
 
 // Connect typically produces an ExternalClient by:
@@ -89,7 +106,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	// Create Cloudflare API client using the configuration
-	api, err := clients.NewClient(*config, nil)
+	api, err := clients.NewClient(*config, c.hc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -131,6 +148,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.AtProvider = *obs
+	cr.SetConditions(rtv1.Available())
 
 	upToDate, err := c.service.IsUpToDate(ctx, cr.Spec.ForProvider, *obs)
 	if err != nil {
@@ -0,0 +1,203 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package emailrouting
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/emailrouting/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/emailrouting/settings"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotSettings    = "managed resource is not a Settings custom resource"
+	errSettingsLookup = "cannot lookup email routing settings"
+	errSettingsApply  = "cannot apply email routing settings"
+	errSettingsDelete = "cannot disable email routing"
+)
+
+// SetupSettings adds a controller that reconciles Settings managed
+// resources.
+func SetupSettings(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.SettingsGroupKind.String())
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SettingsGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&settingsConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (settings.Client, error) {
+				return settings.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.EmailRoutingRulesWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Settings{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.SettingsGroupVersionKind)).
+		Complete(r)
+}
+
+// A settingsConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type settingsConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (settings.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *settingsConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.Settings); !ok {
+		return nil, errors.New(errNotSettings)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &settingsExternal{client: client}, nil
+}
+
+// A settingsExternal observes, then either enables or disables Email
+// Routing for a zone.
+type settingsExternal struct {
+	client settings.Client
+}
+
+func (e *settingsExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Settings)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSettings)
+	}
+
+	// Email Routing settings exist for every zone; they are only ours
+	// once we have applied them.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	zoneID := cr.Spec.ForProvider.ZoneID
+	s, err := settings.Get(ctx, e.client, zoneID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSettingsLookup)
+	}
+
+	var required, owned []cloudflare.DNSRecord
+	if settings.ManagesDNSRecords(cr.Spec.ForProvider) {
+		if required, err = settings.Required(ctx, e.client, zoneID); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSettingsLookup)
+		}
+		if owned, err = settings.ListManaged(ctx, e.client, zoneID, cr.GetName()); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSettingsLookup)
+		}
+	}
+
+	// Once deleted, the settings are gone when Email Routing is disabled
+	// and no DNS Records remain.
+	if meta.WasDeleted(cr) && !s.Enabled && len(owned) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = settings.GenerateObservation(s, owned)
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: settings.UpToDate(cr.Spec.ForProvider, s, required, owned),
+	}, nil
+}
+
+func (e *settingsExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Settings)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSettings)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	if err := settings.Apply(ctx, e.client, cr.Spec.ForProvider.ZoneID, cr.GetName(), cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errSettingsApply)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.ZoneID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *settingsExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Settings)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSettings)
+	}
+
+	err := settings.Apply(ctx, e.client, cr.Spec.ForProvider.ZoneID, cr.GetName(), cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errSettingsApply)
+}
+
+func (e *settingsExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Settings)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotSettings)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	// Deleting the settings disables Email Routing, removing the DNS
+	// Records it required if we manage them.
+	p := cr.Spec.ForProvider
+	p.Enabled = new(bool)
+	err := settings.Apply(ctx, e.client, p.ZoneID, cr.GetName(), p)
+	return managed.ExternalDelete{}, errors.Wrap(err, errSettingsDelete)
+}
+
+func (e *settingsExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -26,7 +26,9 @@ import (
 // Setup Email Routing controllers.
 func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
 	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.TypedRateLimiter[any]) error{
+		SetupDestinationAddress,
 		SetupRule,
+		SetupSettings,
 	} {
 		if err := setup(mgr, l, rl); err != nil {
 			return err
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fairshare shares the rate limit of each ProviderConfig fairly
+// between the controllers whose managed resources use it.
+package fairshare
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+// NewConnecter wraps the supplied ExternalConnecter so that the API
+// requests made while connecting, and by the clients it produces, wait for
+// the rate limit of their ProviderConfig as requests of the named
+// controller, e.g. managed/certificatepack.ssl.cloudflare.crossplane.io.
+func NewConnecter(c managed.ExternalConnecter, controller string) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c, controller: controller}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	controller string
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnecter.Connect(clients.ContextWithController(ctx, c.controller), mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec, controller: c.controller}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	controller string
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	return e.ExternalClient.Observe(clients.ContextWithController(ctx, e.controller), mg)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return e.ExternalClient.Create(clients.ContextWithController(ctx, e.controller), mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return e.ExternalClient.Update(clients.ContextWithController(ctx, e.controller), mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return e.ExternalClient.Delete(clients.ContextWithController(ctx, e.controller), mg)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.ExternalClient.Disconnect(clients.ContextWithController(ctx, e.controller))
+}
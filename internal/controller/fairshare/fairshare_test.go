@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairshare
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+func TestConnecter(t *testing.T) {
+	const controller = "managed/certificatepack.ssl.cloudflare.crossplane.io"
+
+	var got []string
+	record := func(ctx context.Context) {
+		got = append(got, clients.ControllerFromContext(ctx))
+	}
+
+	c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		record(ctx)
+		return managed.ExternalClientFns{
+			ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+				record(ctx)
+				return managed.ExternalObservation{}, nil
+			},
+			CreateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+				record(ctx)
+				return managed.ExternalCreation{}, nil
+			},
+			UpdateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+				record(ctx)
+				return managed.ExternalUpdate{}, nil
+			},
+			DeleteFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+				record(ctx)
+				return managed.ExternalDelete{}, nil
+			},
+			DisconnectFn: func(ctx context.Context) error {
+				record(ctx)
+				return nil
+			},
+		}, nil
+	}), controller)
+
+	ctx := context.Background()
+	mg := &fake.Managed{}
+	ec, err := c.Connect(ctx, mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	_, _ = ec.Observe(ctx, mg)
+	_, _ = ec.Create(ctx, mg)
+	_, _ = ec.Update(ctx, mg)
+	_, _ = ec.Delete(ctx, mg)
+	_ = ec.Disconnect(ctx)
+
+	want := []string{controller, controller, controller, controller, controller, controller}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewConnecter(...): requests should be made as the named controller: -want, +got:\n%s\n", diff)
+	}
+}
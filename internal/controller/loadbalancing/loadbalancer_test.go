@@ -48,15 +48,8 @@ import (
 // https://github.com/golang/go/wiki/TestComments
 // https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
 
-type mockTracker struct{}
-
-func (m *mockTracker) Track(ctx context.Context, mg resource.Managed) error {
-	return nil
-}
-
 type loadbalancerModifier func(*v1alpha1.LoadBalancer)
 
-
 func withZone(zone string) loadbalancerModifier {
 	return func(lb *v1alpha1.LoadBalancer) { lb.Spec.ForProvider.Zone = zone }
 }
@@ -84,7 +77,6 @@ func TestConnect(t *testing.T) {
 
 	type fields struct {
 		kube      client.Client
-		usage     resource.Tracker
 		newClient func(cfg clients.Config, hc *http.Client) (loadbalancing.LoadBalancerClient, error)
 	}
 
@@ -109,8 +101,7 @@ func TestConnect(t *testing.T) {
 		"ErrGetConfig": {
 			reason: "Any errors from GetConfig should be wrapped",
 			fields: fields{
-				kube:  mc,
-				usage: &mockTracker{},
+				kube: mc,
 			},
 			args: args{
 				mg: &v1alpha1.LoadBalancer{
@@ -144,7 +135,6 @@ func TestConnect(t *testing.T) {
 						return nil
 					}),
 				},
-				usage: &mockTracker{},
 				newClient: func(cfg clients.Config, hc *http.Client) (loadbalancing.LoadBalancerClient, error) {
 					return &fake.MockLoadBalancerClient{}, nil
 				},
@@ -166,7 +156,7 @@ func TestConnect(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			c := &connector{kube: tc.fields.kube, usage: tc.fields.usage, newServiceFn: tc.fields.newClient}
+			c := &connector{kube: tc.fields.kube, newServiceFn: tc.fields.newClient}
 			_, err := c.Connect(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nc.Connect(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -545,4 +535,4 @@ func TestDelete(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
@@ -22,10 +22,12 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -33,15 +35,20 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1alpha1"
-	apisv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	"github.com/rossigee/provider-cloudflare/internal/clients/loadbalancing"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
 	errNotMonitor          = "managed resource is not a LoadBalancerMonitor custom resource"
-	errTrackMonitorPCUsage = "cannot track ProviderConfig usage"
-	errGetMonitorPC        = "cannot get ProviderConfig"
 	errGetMonitorCreds     = "cannot get credentials"
 	errNewMonitorClient    = "cannot create new Service"
 )
@@ -52,23 +59,23 @@ func SetupMonitor(mgr ctrl.Manager, o controller.Options) error {
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.LoadBalancerMonitorGroupVersionKind),
-		managed.WithExternalConnecter(&monitorConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&monitorConnector{
 			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
 			newServiceFn: loadbalancing.NewMonitorClient,
-		}),
+		}), mgr.GetClient(), scopes.LoadBalancingPoolsWrite))), rec), o.Logger.WithValues("controller", name)), name)),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.LoadBalancerMonitor{}).
+		For(&v1alpha1.LoadBalancerMonitor{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.LoadBalancerMonitorGroupVersionKind)).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
@@ -76,30 +83,18 @@ func SetupMonitor(mgr ctrl.Manager, o controller.Options) error {
 // is called.
 type monitorConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
 	newServiceFn func(cfg clients.Config, httpClient *http.Client) (loadbalancing.MonitorClient, error)
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient from the credentials of the managed
+// resource's ProviderConfig, resolved through any DefaultProviderConfig.
+// Resolving it also tracks the resource's usage of the ProviderConfig.
 func (c *monitorConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*v1alpha1.LoadBalancerMonitor)
+	_, ok := mg.(*v1alpha1.LoadBalancerMonitor)
 	if !ok {
 		return nil, errors.New(errNotMonitor)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackMonitorPCUsage)
-	}
-
-	pc := &apisv1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetMonitorPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
@@ -143,6 +138,7 @@ func (c *monitorExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	}
 
 	cr.Status.AtProvider = loadbalancing.GenerateMonitorObservation(monitor)
+	cr.SetConditions(rtv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
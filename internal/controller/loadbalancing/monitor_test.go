@@ -42,7 +42,6 @@ import (
 
 type monitorModifier func(*v1alpha1.LoadBalancerMonitor)
 
-
 func withMonitorAccount(account string) monitorModifier {
 	return func(monitor *v1alpha1.LoadBalancerMonitor) { monitor.Spec.ForProvider.Account = &account }
 }
@@ -70,7 +69,6 @@ func TestMonitorConnect(t *testing.T) {
 
 	type fields struct {
 		kube      client.Client
-		usage     resource.Tracker
 		newClient func(cfg clients.Config, hc *http.Client) (loadbalancing.MonitorClient, error)
 	}
 
@@ -95,8 +93,7 @@ func TestMonitorConnect(t *testing.T) {
 		"ErrGetConfig": {
 			reason: "Any errors from GetConfig should be wrapped",
 			fields: fields{
-				kube:  mc,
-				usage: &mockTracker{},
+				kube: mc,
 			},
 			args: args{
 				mg: &v1alpha1.LoadBalancerMonitor{
@@ -130,7 +127,6 @@ func TestMonitorConnect(t *testing.T) {
 						return nil
 					}),
 				},
-				usage: &mockTracker{},
 				newClient: func(cfg clients.Config, hc *http.Client) (loadbalancing.MonitorClient, error) {
 					return &fake.MockMonitorClient{}, nil
 				},
@@ -152,7 +148,7 @@ func TestMonitorConnect(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			c := &monitorConnector{kube: tc.fields.kube, usage: tc.fields.usage, newServiceFn: tc.fields.newClient}
+			c := &monitorConnector{kube: tc.fields.kube, newServiceFn: tc.fields.newClient}
 			_, err := c.Connect(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nc.Connect(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -527,4 +523,4 @@ func TestMonitorDelete(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
@@ -42,7 +42,6 @@ import (
 
 type poolModifier func(*v1alpha1.LoadBalancerPool)
 
-
 func withPoolAccount(account string) poolModifier {
 	return func(pool *v1alpha1.LoadBalancerPool) { pool.Spec.ForProvider.Account = &account }
 }
@@ -70,7 +69,6 @@ func TestPoolConnect(t *testing.T) {
 
 	type fields struct {
 		kube      client.Client
-		usage     resource.Tracker
 		newClient func(cfg clients.Config, hc *http.Client) (loadbalancing.PoolClient, error)
 	}
 
@@ -95,8 +93,7 @@ func TestPoolConnect(t *testing.T) {
 		"ErrGetConfig": {
 			reason: "Any errors from GetConfig should be wrapped",
 			fields: fields{
-				kube:  mc,
-				usage: &mockTracker{},
+				kube: mc,
 			},
 			args: args{
 				mg: &v1alpha1.LoadBalancerPool{
@@ -130,7 +127,6 @@ func TestPoolConnect(t *testing.T) {
 						return nil
 					}),
 				},
-				usage: &mockTracker{},
 				newClient: func(cfg clients.Config, hc *http.Client) (loadbalancing.PoolClient, error) {
 					return &fake.MockPoolClient{}, nil
 				},
@@ -152,7 +148,7 @@ func TestPoolConnect(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			c := &poolConnector{kube: tc.fields.kube, usage: tc.fields.usage, newServiceFn: tc.fields.newClient}
+			c := &poolConnector{kube: tc.fields.kube, newServiceFn: tc.fields.newClient}
 			_, err := c.Connect(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nc.Connect(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -527,4 +523,4 @@ func TestPoolDelete(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logpush
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/logpush/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/logpush/job"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/replacement"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotJob = "managed resource is not a Job custom resource"
+
+	errJobClientConfig = "error getting client config"
+
+	errJobID          = "cannot parse logpush job ID"
+	errJobLookup      = "cannot lookup logpush job"
+	errJobCreation    = "cannot create logpush job"
+	errJobUpdate      = "cannot update logpush job"
+	errJobDeletion    = "cannot delete logpush job"
+	errJobReplacement = "cannot replace logpush job"
+	errJobValidation  = "cannot validate logpush job"
+
+	typeDestinationValid   rtv1.ConditionType   = "DestinationValid"
+	reasonValidationPassed rtv1.ConditionReason = "ValidationPassed"
+	reasonValidationFailed rtv1.ConditionReason = "ValidationFailed"
+)
+
+// SetupJob adds a controller that reconciles Logpush Job managed resources.
+func SetupJob(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.JobGroupKind.String())
+
+	o := controller.Options{
+		RateLimiter: nil, // Use default rate limiter
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.JobGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&jobConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
+				return clients.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.LogsWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+		// Jobs are identified by the ID Cloudflare assigns them.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.Job{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.JobGroupVersionKind)).
+		Complete(r)
+}
+
+// destinationInvalid returns a condition indicating that Cloudflare
+// rejected the destination or logpull options of a job.
+func destinationInvalid(f job.ValidationFailure) rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeDestinationValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonValidationFailed,
+		Message:            f.Error(),
+	}
+}
+
+// destinationValid returns a condition indicating that Cloudflare accepted
+// the destination and logpull options of a job.
+func destinationValid() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeDestinationValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonValidationPassed,
+	}
+}
+
+// A jobConnector is expected to produce an ExternalClient when its Connect
+// method is called.
+type jobConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (*cloudflare.API, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *jobConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.Job); !ok {
+		return nil, errors.New(errNotJob)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errJobClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jobExternal{client: job.NewClient(client)}, nil
+}
+
+// jobService is the subset of the Logpush Job client used by jobExternal.
+type jobService interface {
+	Create(ctx context.Context, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error)
+	Get(ctx context.Context, jobID int, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error)
+	Update(ctx context.Context, jobID int, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error)
+	Delete(ctx context.Context, jobID int, params v1alpha1.JobParameters) error
+	IsUpToDate(ctx context.Context, params v1alpha1.JobParameters, obs v1alpha1.JobObservation) (bool, error)
+	Validate(ctx context.Context, params v1alpha1.JobParameters) (*job.ValidationFailure, error)
+}
+
+// A jobExternal observes, then either creates, updates, or deletes a
+// Logpush Job.
+type jobExternal struct {
+	client jobService
+}
+
+func (e *jobExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotJob)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	id, err := job.ParseJobID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJobID)
+	}
+
+	obs, err := e.client.Get(ctx, id, cr.Spec.ForProvider)
+	if err != nil {
+		if job.IsJobNotFound(errors.Cause(err)) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errJobLookup)
+	}
+
+	cr.Status.AtProvider = *obs
+	cr.SetConditions(rtv1.Available())
+
+	// The API rejects changes of a job's kind, so a changed kind either
+	// replaces the job or is left for the RequiresReplacement condition
+	// to report, while the job's other fields are still updated.
+	switch replacement.Decide(cr, replacement.Change{Field: "spec.forProvider.kind", Observed: job.Kind(obs.Kind), Desired: job.Kind(cr.Spec.ForProvider.Kind)}, cr.Spec.ForProvider.AllowRecreate) {
+	case replacement.Replace:
+		if _, err := e.Delete(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errJobReplacement)
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	upToDate, err := e.client.IsUpToDate(ctx, cr.Spec.ForProvider, *obs)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJobLookup)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *jobExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotJob)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	if err := e.validate(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJobCreation)
+	}
+
+	obs, err := e.client.Create(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJobCreation)
+	}
+
+	cr.Status.AtProvider = *obs
+	if obs.ID != nil {
+		meta.SetExternalName(cr, strconv.Itoa(*obs.ID))
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// validate asks Cloudflare to validate the destination and logpull options
+// of the supplied job, and reports the result in its DestinationValid
+// condition. A job that fails validation returns an error, so that it is
+// not created or updated with a destination Cloudflare cannot push to.
+func (e *jobExternal) validate(ctx context.Context, cr *v1alpha1.Job) error {
+	f, err := e.client.Validate(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errJobValidation)
+	}
+	if f != nil {
+		cr.SetConditions(destinationInvalid(*f))
+		return *f
+	}
+	cr.SetConditions(destinationValid())
+	return nil
+}
+
+func (e *jobExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotJob)
+	}
+
+	id, err := job.ParseJobID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errJobID)
+	}
+
+	// Only a changed destination or logpull options are validated again,
+	// so that updates of other fields don't depend on the destination
+	// being reachable.
+	p := cr.Spec.ForProvider
+	if cr.Status.AtProvider.DestinationConf != p.DestinationConf || ptr.Deref(cr.Status.AtProvider.LogpullOptions, "") != ptr.Deref(p.LogpullOptions, "") {
+		if err := e.validate(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errJobUpdate)
+		}
+	}
+
+	obs, err := e.client.Update(ctx, id, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errJobUpdate)
+	}
+
+	cr.Status.AtProvider = *obs
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *jobExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotJob)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	id, err := job.ParseJobID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errJobID)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	return managed.ExternalDelete{}, errors.Wrap(e.client.Delete(ctx, id, cr.Spec.ForProvider), errJobDeletion)
+}
+
+func (e *jobExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logpush
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/logpush/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/logpush/job"
+	"github.com/rossigee/provider-cloudflare/internal/controller/replacement"
+)
+
+// mockJobService implements the jobService interface for testing
+type mockJobService struct {
+	MockGet        func(ctx context.Context, jobID int, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error)
+	MockDelete     func(ctx context.Context, jobID int, params v1alpha1.JobParameters) error
+	MockIsUpToDate func(ctx context.Context, params v1alpha1.JobParameters, obs v1alpha1.JobObservation) (bool, error)
+	MockValidate   func(ctx context.Context, params v1alpha1.JobParameters) (*job.ValidationFailure, error)
+	created        bool
+	updated        bool
+}
+
+func (m *mockJobService) Create(ctx context.Context, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error) {
+	m.created = true
+	return &v1alpha1.JobObservation{}, nil
+}
+
+func (m *mockJobService) Get(ctx context.Context, jobID int, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error) {
+	return m.MockGet(ctx, jobID, params)
+}
+
+func (m *mockJobService) Update(ctx context.Context, jobID int, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error) {
+	m.updated = true
+	return &v1alpha1.JobObservation{}, nil
+}
+
+func (m *mockJobService) Delete(ctx context.Context, jobID int, params v1alpha1.JobParameters) error {
+	if m.MockDelete != nil {
+		return m.MockDelete(ctx, jobID, params)
+	}
+	return nil
+}
+
+func (m *mockJobService) IsUpToDate(ctx context.Context, params v1alpha1.JobParameters, obs v1alpha1.JobObservation) (bool, error) {
+	if m.MockIsUpToDate != nil {
+		return m.MockIsUpToDate(ctx, params, obs)
+	}
+	return true, nil
+}
+
+func (m *mockJobService) Validate(ctx context.Context, params v1alpha1.JobParameters) (*job.ValidationFailure, error) {
+	if m.MockValidate != nil {
+		return m.MockValidate(ctx, params)
+	}
+	return nil, nil
+}
+
+func TestJobObserveKindChange(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	job := func(kind *string, allowRecreate *bool) *v1alpha1.Job {
+		cr := &v1alpha1.Job{Spec: v1alpha1.JobSpec{ForProvider: v1alpha1.JobParameters{
+			Dataset:       "http_requests",
+			Name:          "requests",
+			Kind:          kind,
+			AllowRecreate: allowRecreate,
+		}}}
+		meta.SetExternalName(cr, "123")
+		return cr
+	}
+
+	type want struct {
+		obs         managed.ExternalObservation
+		replacement corev1.ConditionStatus
+		deleted     bool
+		err         error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		cr       *v1alpha1.Job
+		upToDate bool
+		delete   error
+		want     want
+	}{
+		"Unchanged": {
+			reason: "A job whose kind did not change should not require replacement",
+			cr:     job(ptr.To("edge"), nil),
+			want: want{
+				obs:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				replacement: corev1.ConditionUnknown,
+			},
+		},
+		"Blocked": {
+			reason: "A changed kind should be reported rather than updated, while other fields are still compared",
+			cr:     job(nil, nil),
+			want: want{
+				obs:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				replacement: corev1.ConditionTrue,
+			},
+		},
+		"Replace": {
+			reason: "A changed kind should delete the job so that it is created again when recreation is allowed",
+			cr:     job(nil, ptr.To(true)),
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: false},
+				deleted: true,
+			},
+		},
+		"ReplaceError": {
+			reason: "Errors deleting a job to replace it should be returned",
+			cr:     job(nil, ptr.To(true)),
+			delete: errBoom,
+			want: want{
+				deleted: true,
+				err:     errors.Wrap(errors.Wrap(errBoom, errJobDeletion), errJobReplacement),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			e := &jobExternal{client: &mockJobService{
+				MockGet: func(ctx context.Context, jobID int, params v1alpha1.JobParameters) (*v1alpha1.JobObservation, error) {
+					return &v1alpha1.JobObservation{ID: ptr.To(jobID), Kind: ptr.To("edge")}, nil
+				},
+				MockDelete: func(ctx context.Context, jobID int, params v1alpha1.JobParameters) error {
+					deleted = true
+					return tc.delete
+				},
+				MockIsUpToDate: func(ctx context.Context, params v1alpha1.JobParameters, obs v1alpha1.JobObservation) (bool, error) {
+					return params.Kind != nil, nil
+				},
+			}}
+
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+			if tc.want.err == nil && !tc.want.deleted {
+				if diff := cmp.Diff(tc.want.replacement, tc.cr.GetCondition(replacement.TypeRequiresReplacement).Status); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want RequiresReplacement, +got RequiresReplacement:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestJobValidation(t *testing.T) {
+	errBoom := errors.New("boom")
+	invalid := job.ValidationFailure{Field: "destinationConf", Message: "access denied"}
+
+	type want struct {
+		validated bool
+		applied   bool
+		valid     corev1.ConditionStatus
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		update   bool
+		observed string
+		failure  *job.ValidationFailure
+		err      error
+		want     want
+	}{
+		"CreateValid": {
+			reason: "A job whose destination is valid should be created",
+			want:   want{validated: true, applied: true, valid: corev1.ConditionTrue},
+		},
+		"CreateInvalid": {
+			reason:  "A job whose destination is invalid should not be created",
+			failure: &invalid,
+			want:    want{validated: true, valid: corev1.ConditionFalse, err: errors.Wrap(invalid, errJobCreation)},
+		},
+		"CreateError": {
+			reason: "Errors validating a job should be returned",
+			err:    errBoom,
+			want:   want{validated: true, valid: corev1.ConditionUnknown, err: errors.Wrap(errors.Wrap(errBoom, errJobValidation), errJobCreation)},
+		},
+		"UpdateUnchangedDestination": {
+			reason:   "A job whose destination did not change should be updated without validation",
+			update:   true,
+			observed: "s3://bucket/logs",
+			failure:  &invalid,
+			want:     want{applied: true, valid: corev1.ConditionUnknown},
+		},
+		"UpdateChangedDestination": {
+			reason:   "A job whose destination changed to an invalid one should not be updated",
+			update:   true,
+			observed: "s3://old/logs",
+			failure:  &invalid,
+			want:     want{validated: true, valid: corev1.ConditionFalse, err: errors.Wrap(invalid, errJobUpdate)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.Job{Spec: v1alpha1.JobSpec{ForProvider: v1alpha1.JobParameters{DestinationConf: "s3://bucket/logs"}}}
+			cr.Status.AtProvider.DestinationConf = tc.observed
+			validated := false
+			svc := &mockJobService{
+				MockValidate: func(ctx context.Context, params v1alpha1.JobParameters) (*job.ValidationFailure, error) {
+					validated = true
+					return tc.failure, tc.err
+				},
+			}
+			e := &jobExternal{client: svc}
+
+			var err error
+			if tc.update {
+				meta.SetExternalName(cr, "123")
+				_, err = e.Update(context.Background(), cr)
+			} else {
+				_, err = e.Create(context.Background(), cr)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.validated, validated); diff != "" {
+				t.Errorf("\n%s\n-want validated, +got validated:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.applied, svc.created || svc.updated); diff != "" {
+				t.Errorf("\n%s\n-want applied, +got applied:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.valid, cr.GetCondition(typeDestinationValid).Status); diff != "" {
+				t.Errorf("\n%s\n-want DestinationValid, +got DestinationValid:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
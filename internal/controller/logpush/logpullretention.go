@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logpush
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/logpush/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/logpush/retention"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotLogpullRetention = "managed resource is not a LogpullRetention custom resource"
+
+	errRetentionClientConfig = "error getting client config"
+
+	errRetentionLookup = "cannot lookup logpull retention"
+	errRetentionUpdate = "cannot update logpull retention"
+	errRetentionDelete = "cannot disable logpull retention"
+)
+
+// SetupLogpullRetention adds a controller that reconciles LogpullRetention
+// managed resources.
+func SetupLogpullRetention(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.LogpullRetentionGroupKind.String())
+
+	o := controller.Options{
+		RateLimiter: nil, // Use default rate limiter
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.LogpullRetentionGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&retentionConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
+				return clients.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.LogsWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.LogpullRetention{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.LogpullRetentionGroupVersionKind)).
+		Complete(r)
+}
+
+// A retentionConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type retentionConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (*cloudflare.API, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *retentionConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.LogpullRetention); !ok {
+		return nil, errors.New(errNotLogpullRetention)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errRetentionClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &retentionExternal{client: retention.NewClient(client)}, nil
+}
+
+// A retentionExternal observes, then updates a zone's Logpull retention
+// setting to ensure it reflects the managed resource's desired state.
+type retentionExternal struct {
+	client *retention.RetentionClient
+}
+
+func (e *retentionExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LogpullRetention)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLogpullRetention)
+	}
+
+	obs, err := e.client.Get(ctx, cr.Spec.ForProvider.Zone)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRetentionLookup)
+	}
+
+	cr.Status.AtProvider = *obs
+
+	// The setting always exists for a zone. Once we have been deleted and
+	// have disabled retention again, report it gone so the finalizer can
+	// be removed.
+	if meta.WasDeleted(cr) && obs.Enabled != nil && !*obs.Enabled {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: retention.IsUpToDate(cr.Spec.ForProvider, *obs),
+	}, nil
+}
+
+func (e *retentionExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	// The setting always exists, so Observe never reports it missing
+	// unless we are being deleted.
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *retentionExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LogpullRetention)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLogpullRetention)
+	}
+
+	obs, err := e.client.Set(ctx, cr.Spec.ForProvider.Zone, cr.Spec.ForProvider.Enabled)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRetentionUpdate)
+	}
+
+	cr.Status.AtProvider = *obs
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *retentionExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.LogpullRetention)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotLogpullRetention)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	// Retention is off by default, so disabling it restores the zone to
+	// the state it was in before we managed it.
+	_, err := e.client.Set(ctx, cr.Spec.ForProvider.Zone, false)
+	return managed.ExternalDelete{}, errors.Wrap(err, errRetentionDelete)
+}
+
+func (e *retentionExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
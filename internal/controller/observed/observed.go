@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package observed records the generation of a managed resource that its
+// status and conditions were last determined from.
+package observed
+
+import (
+	"context"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// conditionTypes are the types of the conditions set by external clients,
+// rather than by the managed reconciler.
+var conditionTypes = []rtv1.ConditionType{rtv1.TypeReady, pcv1alpha1.TypeIssued}
+
+// NewConnecter wraps the supplied ExternalConnecter so that the clients it
+// produces set status.observedGeneration of managed resources that support
+// it once they were observed, and stamp the conditions they set with the
+// generation they were set at. The managed reconciler only does so for the
+// Synced condition.
+func NewConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+}
+
+// stamp sets the observed generation of the conditions of the supplied
+// managed resource that were set by an external client.
+func stamp(mg resource.Managed) {
+	gen := mg.GetGeneration()
+	for _, t := range conditionTypes {
+		c := mg.GetCondition(t)
+		if c.Reason == "" || c.ObservedGeneration == gen {
+			continue
+		}
+		mg.SetConditions(c.WithObservedGeneration(gen))
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	stamp(mg)
+	if err != nil {
+		return o, err
+	}
+	if r, ok := mg.(resource.ReconciliationObserver); ok && o.ResourceExists {
+		r.SetObservedGeneration(mg.GetGeneration())
+	}
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	stamp(mg)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	stamp(mg)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := e.ExternalClient.Delete(ctx, mg)
+	stamp(mg)
+	return d, err
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package observed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// observedManaged is a managed resource that records its observed
+// generation.
+type observedManaged struct {
+	fake.Managed
+	rtv1.ObservedStatus
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		observedGeneration int64
+		ready              int64
+		issued             int64
+	}
+
+	cases := map[string]struct {
+		reason     string
+		conditions []rtv1.Condition
+		obs        managed.ExternalObservation
+		err        error
+		want       want
+	}{
+		"Exists": {
+			reason:     "The observed generation and the generation of the conditions set by the client should be recorded",
+			conditions: []rtv1.Condition{rtv1.Available(), pcv1alpha1.PendingIssuance("pending_validation")},
+			obs:        managed.ExternalObservation{ResourceExists: true},
+			want:       want{observedGeneration: 3, ready: 3, issued: 3},
+		},
+		"NotExists": {
+			reason: "The observed generation should not be recorded when the resource does not exist",
+			obs:    managed.ExternalObservation{ResourceExists: false},
+			want:   want{},
+		},
+		"Error": {
+			reason:     "Conditions set before an error should still be stamped, but the generation was not observed",
+			conditions: []rtv1.Condition{rtv1.Unavailable()},
+			err:        errBoom,
+			want:       want{ready: 3},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						mg.SetConditions(tc.conditions...)
+						return tc.obs, tc.err
+					},
+				}, nil
+			}))
+
+			mg := &observedManaged{Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 3}}}
+			ec, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			_, _ = ec.Observe(context.Background(), mg)
+
+			got := want{
+				observedGeneration: mg.GetObservedGeneration(),
+				ready:              mg.GetCondition(rtv1.TypeReady).ObservedGeneration,
+				issued:             mg.GetCondition(pcv1alpha1.TypeIssued).ObservedGeneration,
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		return managed.ExternalClientFns{
+			CreateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+				mg.SetConditions(rtv1.Creating())
+				return managed.ExternalCreation{}, nil
+			},
+		}, nil
+	}))
+
+	mg := &observedManaged{Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 2}}}
+	ec, err := c.Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	if _, err := ec.Create(context.Background(), mg); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+	if got := mg.GetCondition(rtv1.TypeReady).ObservedGeneration; got != 2 {
+		t.Errorf("Create(...): want the Creating condition stamped with generation 2, got %d", got)
+	}
+	if got := mg.GetObservedGeneration(); got != 0 {
+		t.Errorf("Create(...): want the observed generation left unset, got %d", got)
+	}
+}
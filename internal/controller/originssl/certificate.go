@@ -18,11 +18,13 @@ package originssl
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -35,34 +37,45 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	originsslv1alpha1 "github.com/rossigee/provider-cloudflare/apis/originssl/v1alpha1"
-	providerv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	certificate "github.com/rossigee/provider-cloudflare/internal/clients/originssl/certificate"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
 	errNotCertificate    = "managed resource is not a Certificate custom resource"
-	errTrackPCUsage      = "cannot track ProviderConfig usage"
-	errGetPC             = "cannot get ProviderConfig"
 	errGetCreds          = "cannot get credentials"
 	errNewCertClient     = "cannot create new Certificate client"
 )
 
 // SetupCertificate adds a controller that reconciles Certificate managed resources.
 func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(originsslv1alpha1.CertificateKind)
+	name := managed.ControllerName(originsslv1alpha1.CertificateGroupKind.String())
 
-	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	cps := []managed.ConnectionPublisher{
+		managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme()),
+		&tlsSecretPublisher{kube: mgr.GetClient()},
+	}
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(originsslv1alpha1.CertificateGroupVersionKind),
-		managed.WithExternalConnecter(&certificateConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&certificateConnector{
 			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
 			newServiceFn: certificate.NewClientFromAPI,
-		}),
+			hc:           hc,
+		}), mgr.GetClient(), scopes.SSLAndCertificatesWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -71,6 +84,7 @@ func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRate
 			RateLimiter: nil, // Use default rate limiter
 		}).
 		For(&originsslv1alpha1.Certificate{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), originsslv1alpha1.CertificateGroupVersionKind)).
 		Complete(r)
 }
 
@@ -78,37 +92,26 @@ func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRate
 // is called.
 type certificateConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
 	newServiceFn func(*cloudflare.API) *certificate.CloudflareOriginCertificateClient
+	hc           *http.Client
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient from the credentials of the managed
+// resource's ProviderConfig, resolved through any DefaultProviderConfig.
+// Resolving it also tracks the resource's usage of the ProviderConfig.
 func (c *certificateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*originsslv1alpha1.Certificate)
+	_, ok := mg.(*originsslv1alpha1.Certificate)
 	if !ok {
 		return nil, errors.New(errNotCertificate)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
-	}
-
-	pc := &providerv1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetCreds)
 	}
 
-	client, err := clients.NewClient(*config, nil)
+	client, err := clients.NewOriginCAClient(*config, c.hc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewCertClient)
 	}
@@ -151,8 +154,9 @@ func (c *certificateExternal) Observe(ctx context.Context, mg resource.Managed)
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: upToDate,
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: certificate.ConnectionDetails(*obs, nil),
 	}, nil
 }
 
@@ -164,7 +168,20 @@ func (c *certificateExternal) Create(ctx context.Context, mg resource.Managed) (
 
 	cr.Status.SetConditions(rtv1.Creating())
 
-	obs, err := c.service.Create(ctx, cr.Spec.ForProvider)
+	// Without a CSR we generate the private key ourselves. Cloudflare never
+	// sees it, so it is only published with the connection details here.
+	params := *cr.Spec.ForProvider.DeepCopy()
+	var key []byte
+	if params.CSR == nil {
+		k, csr, err := certificate.GenerateKey(params)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, "cannot create external resource")
+		}
+		key = k
+		params.CSR = ptr.To(string(csr))
+	}
+
+	obs, err := c.service.Create(ctx, params)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create external resource")
 	}
@@ -172,7 +189,7 @@ func (c *certificateExternal) Create(ctx context.Context, mg resource.Managed) (
 	cr.Status.AtProvider = *obs
 	meta.SetExternalName(cr, obs.ID)
 
-	return managed.ExternalCreation{}, nil
+	return managed.ExternalCreation{ConnectionDetails: certificate.ConnectionDetails(*obs, key)}, nil
 }
 
 func (c *certificateExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -0,0 +1,266 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package originssl
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	originsslv1alpha1 "github.com/rossigee/provider-cloudflare/apis/originssl/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	certificate "github.com/rossigee/provider-cloudflare/internal/clients/originssl/certificate"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errGetCertificateRequest    = "cannot get CertificateRequest"
+	errUpdateCertificateRequest = "cannot update CertificateRequest status"
+	errGetIssuer                = "cannot get issuer"
+	errDecodeRequest            = "cannot decode certificate signing request"
+	errParseDuration            = "cannot parse requested duration"
+	errIssueCertificate         = "cannot issue certificate"
+
+	conditionReady    = "Ready"
+	conditionApproved = "Approved"
+	conditionDenied   = "Denied"
+
+	// Condition reasons cert-manager uses for CertificateRequests.
+	reasonPending = "Pending"
+	reasonFailed  = "Failed"
+	reasonIssued  = "Issued"
+	reasonDenied  = "Denied"
+)
+
+// certificateRequestGroupVersionKind is the kind of cert-manager
+// CertificateRequests. They are handled as unstructured objects so that
+// the provider does not depend on cert-manager.
+var certificateRequestGroupVersionKind = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "CertificateRequest"}
+
+// SetupCertificateRequest adds a controller that fulfills cert-manager
+// CertificateRequests referencing an OriginIssuer or ClusterOriginIssuer.
+func SetupCertificateRequest(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := "certificaterequest." + originsslv1alpha1.CRDGroup
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := &certificateRequestReconciler{
+		kube: mgr.GetClient(),
+		log:  l.WithValues("controller", name),
+		newServiceFn: func(cfg clients.Config) (*certificate.CloudflareOriginCertificateClient, error) {
+			api, err := clients.NewOriginCAClient(cfg, hc)
+			if err != nil {
+				return nil, err
+			}
+			return certificate.NewClientFromAPI(api), nil
+		},
+	}
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(certificateRequestGroupVersionKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(u).
+		Complete(r)
+}
+
+// A certificateRequestReconciler issues the certificates requested by
+// cert-manager CertificateRequests using the Origin CA, acting as an
+// external cert-manager issuer.
+type certificateRequestReconciler struct {
+	kube         client.Client
+	log          logging.Logger
+	newServiceFn func(cfg clients.Config) (*certificate.CloudflareOriginCertificateClient, error)
+}
+
+// Reconcile a cert-manager CertificateRequest.
+func (r *certificateRequestReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	cr := &unstructured.Unstructured{}
+	cr.SetGroupVersionKind(certificateRequestGroupVersionKind)
+	if err := r.kube.Get(ctx, req.NamespacedName, cr); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetCertificateRequest)
+	}
+
+	group, _, _ := unstructured.NestedString(cr.Object, "spec", "issuerRef", "group")
+	kind, _, _ := unstructured.NestedString(cr.Object, "spec", "issuerRef", "kind")
+	name, _, _ := unstructured.NestedString(cr.Object, "spec", "issuerRef", "name")
+	if group != originsslv1alpha1.CRDGroup || (kind != originsslv1alpha1.OriginIssuerKind && kind != originsslv1alpha1.ClusterOriginIssuerKind) {
+		return reconcile.Result{}, nil
+	}
+	if finished(cr) {
+		return reconcile.Result{}, nil
+	}
+
+	if conditionStatus(cr, conditionDenied) == string(metav1.ConditionTrue) {
+		setReady(cr, metav1.ConditionFalse, reasonDenied, "The CertificateRequest was denied by an approval controller")
+		return reconcile.Result{}, errors.Wrap(r.kube.Status().Update(ctx, cr), errUpdateCertificateRequest)
+	}
+	if conditionStatus(cr, conditionApproved) != string(metav1.ConditionTrue) {
+		// Nothing to do until an approval controller approves the request.
+		return reconcile.Result{}, nil
+	}
+
+	spec, err := r.issuerSpec(ctx, kind, name, cr.GetNamespace())
+	if err != nil {
+		return reconcile.Result{}, r.pending(ctx, cr, errors.Wrap(err, errGetIssuer))
+	}
+
+	params, err := issueParameters(cr, spec)
+	if err != nil {
+		// A malformed request will never succeed, so fail it for good.
+		log.Debug("Cannot issue certificate", "error", err)
+		setReady(cr, metav1.ConditionFalse, reasonFailed, err.Error())
+		_ = unstructured.SetNestedField(cr.Object, metav1.Now().UTC().Format(time.RFC3339), "status", "failureTime")
+		return reconcile.Result{}, errors.Wrap(r.kube.Status().Update(ctx, cr), errUpdateCertificateRequest)
+	}
+
+	cfg, err := clients.GetConfigForProviderConfig(ctx, r.kube, spec.ProviderConfigReference.Name)
+	if err != nil {
+		return reconcile.Result{}, r.pending(ctx, cr, err)
+	}
+	svc, err := r.newServiceFn(*cfg)
+	if err != nil {
+		return reconcile.Result{}, r.pending(ctx, cr, err)
+	}
+	obs, err := svc.Create(ctx, params)
+	if err != nil {
+		return reconcile.Result{}, r.pending(ctx, cr, errors.Wrap(err, errIssueCertificate))
+	}
+
+	log.Debug("Issued certificate", "id", obs.ID)
+	_ = unstructured.SetNestedField(cr.Object, base64.StdEncoding.EncodeToString([]byte(obs.Certificate)), "status", "certificate")
+	setReady(cr, metav1.ConditionTrue, reasonIssued, "Certificate issued by the Cloudflare Origin CA")
+	return reconcile.Result{}, errors.Wrap(r.kube.Status().Update(ctx, cr), errUpdateCertificateRequest)
+}
+
+// pending records a transient error on the CertificateRequest and returns
+// it, so that issuance is retried with backoff.
+func (r *certificateRequestReconciler) pending(ctx context.Context, cr *unstructured.Unstructured, err error) error {
+	setReady(cr, metav1.ConditionFalse, reasonPending, err.Error())
+	if uerr := r.kube.Status().Update(ctx, cr); uerr != nil {
+		return errors.Wrap(uerr, errUpdateCertificateRequest)
+	}
+	return err
+}
+
+func (r *certificateRequestReconciler) issuerSpec(ctx context.Context, kind, name, namespace string) (originsslv1alpha1.OriginIssuerSpec, error) {
+	if kind == originsslv1alpha1.ClusterOriginIssuerKind {
+		ci := &originsslv1alpha1.ClusterOriginIssuer{}
+		err := r.kube.Get(ctx, types.NamespacedName{Name: name}, ci)
+		return ci.Spec, err
+	}
+	i := &originsslv1alpha1.OriginIssuer{}
+	err := r.kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, i)
+	return i.Spec, err
+}
+
+func issueParameters(cr *unstructured.Unstructured, spec originsslv1alpha1.OriginIssuerSpec) (originsslv1alpha1.CertificateParameters, error) {
+	request, _, _ := unstructured.NestedString(cr.Object, "spec", "request")
+	csr, err := base64.StdEncoding.DecodeString(request)
+	if err != nil {
+		return originsslv1alpha1.CertificateParameters{}, errors.Wrap(err, errDecodeRequest)
+	}
+
+	var duration *time.Duration
+	if d, ok, _ := unstructured.NestedString(cr.Object, "spec", "duration"); ok && d != "" {
+		pd, err := time.ParseDuration(d)
+		if err != nil {
+			return originsslv1alpha1.CertificateParameters{}, errors.Wrap(err, errParseDuration)
+		}
+		duration = &pd
+	}
+
+	return certificate.IssueParameters(spec, csr, duration)
+}
+
+// finished returns true if the CertificateRequest was already issued or
+// has failed for good.
+func finished(cr *unstructured.Unstructured) bool {
+	if c, _, _ := unstructured.NestedString(cr.Object, "status", "certificate"); c != "" {
+		return true
+	}
+	switch conditionReason(cr, conditionReady) {
+	case reasonFailed, reasonDenied, reasonIssued:
+		return true
+	}
+	return false
+}
+
+func conditions(cr *unstructured.Unstructured) []interface{} {
+	c, _, _ := unstructured.NestedSlice(cr.Object, "status", "conditions")
+	return c
+}
+
+func condition(cr *unstructured.Unstructured, t string) map[string]interface{} {
+	for _, c := range conditions(cr) {
+		if m, ok := c.(map[string]interface{}); ok && m["type"] == t {
+			return m
+		}
+	}
+	return nil
+}
+
+func conditionStatus(cr *unstructured.Unstructured, t string) string {
+	s, _ := condition(cr, t)["status"].(string)
+	return s
+}
+
+func conditionReason(cr *unstructured.Unstructured, t string) string {
+	s, _ := condition(cr, t)["reason"].(string)
+	return s
+}
+
+// setReady sets the Ready condition of a CertificateRequest, keeping its
+// last transition time unless its status changed.
+func setReady(cr *unstructured.Unstructured, status metav1.ConditionStatus, reason, message string) {
+	now := metav1.Now().UTC().Format(time.RFC3339)
+	ready := map[string]interface{}{
+		"type":               conditionReady,
+		"status":             string(status),
+		"reason":             reason,
+		"message":            message,
+		"lastTransitionTime": now,
+	}
+
+	out := []interface{}{}
+	for _, c := range conditions(cr) {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["type"] != conditionReady {
+			out = append(out, c)
+			continue
+		}
+		if m["status"] == string(status) && m["lastTransitionTime"] != nil {
+			ready["lastTransitionTime"] = m["lastTransitionTime"]
+		}
+	}
+	_ = unstructured.SetNestedSlice(cr.Object, append(out, ready), "status", "conditions")
+}
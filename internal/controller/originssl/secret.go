@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package originssl
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	originsslv1alpha1 "github.com/rossigee/provider-cloudflare/apis/originssl/v1alpha1"
+	certificate "github.com/rossigee/provider-cloudflare/internal/clients/originssl/certificate"
+)
+
+const (
+	errGetTLSSecret       = "cannot get certificate secret"
+	errApplyTLSSecret     = "cannot apply certificate secret"
+	errRenderTLSSecret    = "cannot render certificate secret"
+	errGetPKCS12Password  = "cannot get PKCS#12 keystore password"
+	errTLSSecretNotOwned  = "certificate secret exists and is not controlled by this Certificate"
+	errTLSSecretWrongType = "certificate secret exists with a different type; delete it so that it can be recreated"
+)
+
+// A tlsSecretPublisher writes the certificate and private key of a
+// Certificate to the Secret described by its secret template.
+type tlsSecretPublisher struct {
+	kube client.Client
+}
+
+// PublishConnection writes the Secret described by the secret template of
+// the supplied Certificate, if any.
+func (p *tlsSecretPublisher) PublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, cd managed.ConnectionDetails) (bool, error) {
+	cr, ok := so.(*originsslv1alpha1.Certificate)
+	if !ok || cr.Spec.SecretTemplate == nil {
+		return false, nil
+	}
+	t := *cr.Spec.SecretTemplate
+
+	s := &corev1.Secret{}
+	err := p.kube.Get(ctx, types.NamespacedName{Namespace: t.Namespace, Name: t.Name}, s)
+	if resource.IgnoreNotFound(err) != nil {
+		return false, errors.Wrap(err, errGetTLSSecret)
+	}
+	exists := err == nil
+	if exists && !metav1.IsControlledBy(s, cr) {
+		return false, errors.New(errTLSSecretNotOwned)
+	}
+	if exists && s.Type != certificate.SecretType(t) {
+		return false, errors.New(errTLSSecretWrongType)
+	}
+
+	password := ""
+	if t.PKCS12 != nil {
+		ref := t.PKCS12.PasswordSecretRef
+		ps := &corev1.Secret{}
+		if err := p.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, ps); err != nil {
+			return false, errors.Wrap(err, errGetPKCS12Password)
+		}
+		password = string(ps.Data[ref.Key])
+	}
+
+	data, err := certificate.SecretData(t, s.Data, cd, password)
+	if err != nil {
+		return false, errors.Wrap(err, errRenderTLSSecret)
+	}
+
+	desired := s.DeepCopy()
+	desired.SetNamespace(t.Namespace)
+	desired.SetName(t.Name)
+	desired.Type = certificate.SecretType(t)
+	desired.Data = data
+	meta.AddLabels(desired, t.Labels)
+	meta.AddAnnotations(desired, t.Annotations)
+	meta.AddOwnerReference(desired, meta.AsController(meta.TypedReferenceTo(cr, originsslv1alpha1.CertificateGroupVersionKind)))
+
+	if !exists {
+		return true, errors.Wrap(p.kube.Create(ctx, desired), errApplyTLSSecret)
+	}
+	if reflect.DeepEqual(s, desired) {
+		return false, nil
+	}
+	return true, errors.Wrap(p.kube.Update(ctx, desired), errApplyTLSSecret)
+}
+
+// UnpublishConnection is a no-op. The Secret is controlled by the
+// Certificate, so it is garbage collected when the Certificate is deleted.
+func (p *tlsSecretPublisher) UnpublishConnection(_ context.Context, _ resource.ConnectionSecretOwner, _ managed.ConnectionDetails) error {
+	return nil
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pages
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/pages/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/pages"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotPagesDomain = "managed resource is not a PagesDomain custom resource"
+	errClientConfig   = "error getting client config"
+
+	errDomainLookup   = "cannot lookup Pages domain"
+	errDomainCreation = "cannot add Pages domain"
+	errDomainDeletion = "cannot delete Pages domain"
+	errRecordLookup   = "cannot lookup DNS record of Pages domain"
+	errRecordEnsure   = "cannot create DNS record of Pages domain"
+	errRecordDeletion = "cannot delete DNS record of Pages domain"
+)
+
+const (
+	// typeVerified indicates whether Cloudflare has verified a domain and
+	// it serves its Pages project.
+	typeVerified rtv1.ConditionType = "Verified"
+
+	reasonVerified            rtv1.ConditionReason = "Verified"
+	reasonPendingVerification rtv1.ConditionReason = "PendingVerification"
+)
+
+// verified returns a condition indicating that a domain is active.
+func verified() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeVerified,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonVerified,
+	}
+}
+
+// pendingVerification returns a condition indicating that a domain is not
+// active yet, with the statuses reported by Cloudflare.
+func pendingVerification(o v1alpha1.PagesDomainObservation) rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeVerified,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonPendingVerification,
+		Message:            "Domain status is " + o.Status + ", verification status is " + o.VerificationStatus + ", validation status is " + o.ValidationStatus,
+	}
+}
+
+// SetupPagesDomain adds a controller that reconciles PagesDomain managed
+// resources.
+func SetupPagesDomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.PagesDomainGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: 5,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PagesDomainGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&domainConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (pages.Client, error) {
+				return pages.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.PagesWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+		// Domains are identified by spec.forProvider.domain.
+		managed.WithInitializers(account.NewDefaulter(mgr.GetClient())),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.PagesDomain{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.PagesDomainGroupVersionKind)).
+		Complete(r)
+}
+
+// A domainConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type domainConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (pages.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *domainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.PagesDomain); !ok {
+		return nil, errors.New(errNotPagesDomain)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domainExternal{client: client}, nil
+}
+
+// A domainExternal observes, then either adds, updates or deletes a
+// domain of a Pages project and the DNS record it needs. A domain has no
+// settings, so updating it only creates its DNS record.
+type domainExternal struct {
+	client pages.Client
+}
+
+func (e *domainExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PagesDomain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPagesDomain)
+	}
+
+	d, err := pages.GetDomain(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errDomainLookup)
+	}
+
+	obs := pages.GenerateObservation(d)
+	upToDate := true
+	if pages.ManagesDNSRecord(cr.Spec.ForProvider) {
+		rec, err := pages.GetManagedRecord(ctx, e.client, obs.ZoneID, cr.GetName())
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errRecordLookup)
+		}
+		if rec != nil {
+			obs.DNSRecordID = rec.ID
+		}
+		upToDate = rec != nil
+	}
+	obs.APIErrorObservation = cr.Status.AtProvider.APIErrorObservation
+	cr.Status.AtProvider = obs
+
+	// The domain does not serve the project until Cloudflare has verified
+	// that it points at it.
+	if pages.IsActive(obs) {
+		cr.SetConditions(rtv1.Available(), verified())
+	} else {
+		cr.SetConditions(rtv1.Creating(), pendingVerification(obs))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *domainExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PagesDomain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPagesDomain)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	d, err := pages.AddDomain(ctx, e.client, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errDomainCreation)
+	}
+
+	obs := pages.GenerateObservation(d)
+	if pages.ManagesDNSRecord(cr.Spec.ForProvider) {
+		rec, err := pages.EnsureDNSRecord(ctx, e.client, cr.Spec.ForProvider, obs.ZoneID, cr.GetName())
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errRecordEnsure)
+		}
+		obs.DNSRecordID = rec.ID
+	}
+	cr.Status.AtProvider = obs
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *domainExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PagesDomain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPagesDomain)
+	}
+
+	// Observe only reports domains as outdated when their DNS record is
+	// missing.
+	if !pages.ManagesDNSRecord(cr.Spec.ForProvider) {
+		return managed.ExternalUpdate{}, nil
+	}
+	rec, err := pages.EnsureDNSRecord(ctx, e.client, cr.Spec.ForProvider, cr.Status.AtProvider.ZoneID, cr.GetName())
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRecordEnsure)
+	}
+	cr.Status.AtProvider.DNSRecordID = rec.ID
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *domainExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.PagesDomain)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotPagesDomain)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	if err := pages.DeleteDomain(ctx, e.client, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDomainDeletion)
+	}
+
+	if !pages.ManagesDNSRecord(cr.Spec.ForProvider) {
+		return managed.ExternalDelete{}, nil
+	}
+	err := pages.RemoveDNSRecord(ctx, e.client, cr.Status.AtProvider.ZoneID, cr.GetName())
+	return managed.ExternalDelete{}, errors.Wrap(err, errRecordDeletion)
+}
+
+func (e *domainExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
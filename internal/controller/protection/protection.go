@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package protection guards managed resources against accidental deletion
+// of the external resources they represent.
+package protection
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// AnnotationKeyDeletionProtection blocks deletion of the external
+	// resource while set to "true".
+	AnnotationKeyDeletionProtection = "cloudflare.crossplane.io/deletion-protection"
+
+	// TypeDeletionProtected indicates that deletion of the external
+	// resource has been blocked.
+	TypeDeletionProtected rtv1.ConditionType = "DeletionProtected"
+
+	// ReasonDeletionBlocked is the reason a deletion was blocked.
+	ReasonDeletionBlocked rtv1.ConditionReason = "DeletionProtectionEnabled"
+
+	// ReasonDeletionAllowed is the reason a previously blocked deletion is
+	// no longer blocked.
+	ReasonDeletionAllowed rtv1.ConditionReason = "DeletionProtectionDisabled"
+
+	// ReasonDependentsExist is the reason a deletion was blocked by other
+	// resources that still depend on the external resource.
+	ReasonDependentsExist rtv1.ConditionReason = "DependentsExist"
+
+	errDeletionProtected = "deletion protection is enabled; remove the " + AnnotationKeyDeletionProtection + " annotation to delete this resource"
+)
+
+// IsEnabled returns true if deletion protection is enabled for the
+// supplied object.
+func IsEnabled(o metav1.Object) bool {
+	return strings.EqualFold(o.GetAnnotations()[AnnotationKeyDeletionProtection], "true")
+}
+
+// Blocked returns a condition indicating that deletion of the external
+// resource has been blocked by deletion protection.
+func Blocked() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               TypeDeletionProtected,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionBlocked,
+		Message:            errDeletionProtected,
+	}
+}
+
+// BlockedByDependents returns a condition indicating that deletion of the
+// external resource has been blocked by the supplied dependents.
+func BlockedByDependents(dependents []string) rtv1.Condition {
+	return rtv1.Condition{
+		Type:               TypeDeletionProtected,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDependentsExist,
+		Message:            "deletion is blocked by dependents: " + strings.Join(dependents, ", "),
+	}
+}
+
+// Unblocked returns a condition indicating that deletion protection no
+// longer blocks deletion of the external resource.
+func Unblocked() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               TypeDeletionProtected,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionAllowed,
+	}
+}
+
+// NewConnecter wraps the supplied ExternalConnecter so that the clients it
+// produces refuse to delete external resources with deletion protection
+// enabled.
+func NewConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+}
+
+// Observe clears a DeletionProtected condition previously set by the
+// annotation once it has been removed. Conditions set for other reasons are
+// left to the client that set them.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if c := mg.GetCondition(TypeDeletionProtected); !IsEnabled(mg) && c.Status == corev1.ConditionTrue && c.Reason == ReasonDeletionBlocked {
+		mg.SetConditions(Unblocked())
+	}
+	return e.ExternalClient.Observe(ctx, mg)
+}
+
+// Delete returns an error, leaving the external resource and the managed
+// resource's finalizer in place, while deletion protection is enabled.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	if IsEnabled(mg) {
+		mg.SetConditions(Blocked())
+		return managed.ExternalDelete{}, errors.New(errDeletionProtected)
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
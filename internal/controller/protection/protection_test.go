@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		deleted   bool
+		err       error
+		condition corev1.ConditionStatus
+	}
+
+	cases := map[string]struct {
+		reason      string
+		annotations map[string]string
+		want        want
+	}{
+		"NotAnnotated": {
+			reason: "Delete should be passed through when deletion protection is not enabled",
+			want:   want{deleted: true, condition: corev1.ConditionUnknown},
+		},
+		"Disabled": {
+			reason:      "Delete should be passed through when deletion protection is set to false",
+			annotations: map[string]string{AnnotationKeyDeletionProtection: "false"},
+			want:        want{deleted: true, condition: corev1.ConditionUnknown},
+		},
+		"Enabled": {
+			reason:      "Delete should be blocked and a condition set when deletion protection is enabled",
+			annotations: map[string]string{AnnotationKeyDeletionProtection: "true"},
+			want:        want{err: errors.New(errDeletionProtected), condition: corev1.ConditionTrue},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					DeleteFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+						deleted = true
+						return managed.ExternalDelete{}, nil
+					},
+				}, nil
+			}))
+
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			ec, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			_, err = ec.Delete(context.Background(), mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, mg.GetCondition(TypeDeletionProtected).Status); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want condition status, +got condition status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,9 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,7 +40,16 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/r2/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	bucketclient "github.com/rossigee/provider-cloudflare/internal/clients/r2/bucket"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/replacement"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -50,12 +62,47 @@ const (
 	errBucketUpdate   = "cannot update Bucket"
 	errBucketDeletion = "cannot delete Bucket"
 
+	errBucketReplacement = "cannot replace Bucket"
+
+	errGetSippySecret = "cannot get Sippy credentials Secret"
+	errSippySecretKey = "Sippy credentials Secret has no key"
+	errBucketSippy    = "cannot configure Bucket Sippy"
+
 	bucketMaxConcurrency = 5
 )
 
+const (
+	typeMigrating rtv1.ConditionType = "Migrating"
+
+	reasonMigrating         rtv1.ConditionReason = "Migrating"
+	reasonMigrationDisabled rtv1.ConditionReason = "MigrationDisabled"
+)
+
+// migrating indicates that Sippy is migrating objects to a bucket.
+func migrating(o v1alpha1.BucketSippyObservation) rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeMigrating,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonMigrating,
+		Message:            "Migrating objects from " + o.SourceProvider + " bucket " + o.SourceBucket,
+	}
+}
+
+// migrationDisabled indicates that Sippy is not migrating objects to a
+// bucket.
+func migrationDisabled() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeMigrating,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonMigrationDisabled,
+	}
+}
+
 // SetupBucket adds a controller that reconciles Bucket managed resources.
 func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(v1alpha1.BucketKind)
+	name := managed.ControllerName(v1alpha1.BucketGroupKind.String())
 
 	o := controller.Options{
 		RateLimiter: nil, // Use default rate limiter
@@ -63,16 +110,17 @@ func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimit
 	}
 
 	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.BucketGroupVersionKind),
-		managed.WithExternalConnecter(&bucketConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&bucketConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
 				return clients.NewClient(cfg, hc)
 			},
-		}),
+		}), mgr.GetClient(), scopes.WorkersR2StorageWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithPollInterval(5*time.Minute),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
@@ -82,6 +130,7 @@ func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimit
 		Named(name).
 		WithOptions(o).
 		For(&v1alpha1.Bucket{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.BucketGroupVersionKind)).
 		Complete(r)
 }
 
@@ -114,13 +163,97 @@ func (c *bucketConnector) Connect(ctx context.Context, mg resource.Managed) (man
 	// Create the bucket client wrapper
 	bucketClient := bucketclient.NewClient(client)
 
-	return &bucketExternal{client: bucketClient}, nil
+	return &bucketExternal{client: bucketClient, kube: c.kube}, nil
 }
 
 // An bucketExternal observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type bucketExternal struct {
 	client *bucketclient.BucketClient
+	kube   client.Client
+}
+
+// secretValue returns the value of the selected Secret key.
+func (c *bucketExternal) secretValue(ctx context.Context, ref *rtv1.SecretKeySelector) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrap(err, errGetSippySecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf("%s %q", errSippySecretKey, ref.Key)
+	}
+	return string(v), nil
+}
+
+// sippyCredentials resolves the credentials selected by a Sippy
+// configuration.
+func (c *bucketExternal) sippyCredentials(ctx context.Context, sp v1alpha1.BucketSippy) (bucketclient.SippyCredentials, error) {
+	creds := bucketclient.SippyCredentials{}
+	for _, f := range []struct {
+		ref *rtv1.SecretKeySelector
+		out *string
+	}{
+		{sp.Source.AccessKeyIDSecretRef, &creds.AccessKeyID},
+		{sp.Source.SecretAccessKeySecretRef, &creds.SecretAccessKey},
+		{sp.Source.ClientEmailSecretRef, &creds.ClientEmail},
+		{sp.Source.PrivateKeySecretRef, &creds.PrivateKey},
+		{&sp.Destination.AccessKeyIDSecretRef, &creds.R2AccessKeyID},
+		{&sp.Destination.SecretAccessKeySecretRef, &creds.R2SecretAccessKey},
+	} {
+		v, err := c.secretValue(ctx, f.ref)
+		if err != nil {
+			return bucketclient.SippyCredentials{}, err
+		}
+		*f.out = v
+	}
+	return creds, nil
+}
+
+// sippyEnabled returns true if Sippy should migrate objects to the bucket.
+func sippyEnabled(sp *v1alpha1.BucketSippy) bool {
+	return sp != nil && (sp.Enabled == nil || *sp.Enabled)
+}
+
+// updateSippy configures or disables Sippy for a bucket to match its
+// parameters, and returns the resulting configuration.
+func (c *bucketExternal) updateSippy(ctx context.Context, cr *v1alpha1.Bucket, bucketName string) (*v1alpha1.BucketSippyObservation, error) {
+	sp := cr.Spec.ForProvider.Sippy
+	observed := cr.Status.AtProvider.Sippy
+	if sp == nil {
+		return observed, nil
+	}
+
+	if !sippyEnabled(sp) {
+		if observed != nil && !observed.Enabled {
+			return observed, nil
+		}
+		if err := c.client.DeleteSippy(ctx, bucketName); err != nil {
+			return nil, err
+		}
+		return &v1alpha1.BucketSippyObservation{}, nil
+	}
+
+	creds, err := c.sippyCredentials(ctx, *sp)
+	if err != nil {
+		return nil, err
+	}
+	hash := creds.Hash()
+	if bucketclient.SippyUpToDate(sp, observed, hash) {
+		return observed, nil
+	}
+	if err := c.client.PutSippy(ctx, bucketName, *sp, creds); err != nil {
+		return nil, err
+	}
+	obs, err := c.client.GetSippy(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	obs.CredentialsHash = hash
+	return obs, nil
 }
 
 func (c *bucketExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -141,12 +274,69 @@ func (c *bucketExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 			errors.Wrap(resource.Ignore(bucketclient.IsBucketNotFound, err), errBucketLookup)
 	}
 
+	// Usage is costly to compute, so it is only refreshed periodically
+	// rather than on every observation.
+	last := cr.Status.AtProvider.Usage
+	if cr.Spec.ForProvider.UsageRefreshInterval != nil {
+		observation.Usage = last
+	}
+	if bucketclient.UsageDue(cr.Spec.ForProvider, last, time.Now()) {
+		usage, err := c.client.Usage(ctx, bucketName)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errBucketLookup)
+		}
+		usage.ObservedAt = metav1.Now()
+		observation.Usage = usage
+	}
+
+	// The credentials Sippy was configured with cannot be read back, so
+	// a hash of them is kept to detect when they have been rotated.
+	sippyHash := ""
+	if sp := cr.Spec.ForProvider.Sippy; sp != nil {
+		observation.Sippy, err = c.client.GetSippy(ctx, bucketName)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errBucketLookup)
+		}
+		if last := cr.Status.AtProvider.Sippy; last != nil && observation.Sippy.Enabled {
+			observation.Sippy.CredentialsHash = last.CredentialsHash
+		}
+		if sippyEnabled(sp) {
+			creds, err := c.sippyCredentials(ctx, *sp)
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errBucketLookup)
+			}
+			sippyHash = creds.Hash()
+		}
+	}
+
 	cr.Status.AtProvider = *observation
 	cr.SetConditions(rtv1.Available())
+	if s := observation.Sippy; s != nil && s.Enabled {
+		cr.SetConditions(migrating(*s))
+	} else if s != nil {
+		cr.SetConditions(migrationDisabled())
+	}
+
+	// A bucket cannot be renamed, so a changed name either leaves the
+	// bucket alone or replaces it.
+	switch replacement.Decide(cr, replacement.Change{Field: "spec.forProvider.name", Observed: bucketName, Desired: cr.Spec.ForProvider.Name}, cr.Spec.ForProvider.AllowRecreate) {
+	case replacement.Blocked:
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case replacement.Replace:
+		if _, err := c.Delete(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errBucketReplacement)
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	upToDate, err := c.client.IsUpToDate(ctx, cr.Spec.ForProvider, *observation)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errBucketLookup)
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: observation.Name == cr.Spec.ForProvider.Name,
+		ResourceUpToDate: upToDate && bucketclient.SippyUpToDate(cr.Spec.ForProvider.Sippy, observation.Sippy, sippyHash),
 	}, nil
 }
 
@@ -171,13 +361,27 @@ func (c *bucketExternal) Create(ctx context.Context, mg resource.Managed) (manag
 }
 
 func (c *bucketExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	_, ok := mg.(*v1alpha1.Bucket)
+	cr, ok := mg.(*v1alpha1.Bucket)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotBucket)
 	}
 
-	// R2 buckets don't support updates beyond creation parameters
-	// If updates are needed, the bucket would need to be recreated
+	observation, err := c.client.Update(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBucketUpdate)
+	}
+
+	// Sippy is configured once the bucket exists, rather than when it is
+	// created, so that a failure to configure it does not fail creation.
+	sippy, err := c.updateSippy(ctx, cr, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBucketSippy)
+	}
+
+	observation.Usage = cr.Status.AtProvider.Usage
+	observation.Sippy = sippy
+	cr.Status.AtProvider = *observation
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -193,6 +397,19 @@ func (c *bucketExternal) Delete(ctx context.Context, mg resource.Managed) (manag
 		return managed.ExternalDelete{}, nil
 	}
 
+	if cr.Spec.ForProvider.ForceDestroy != nil && *cr.Spec.ForProvider.ForceDestroy {
+		if err := c.client.Empty(ctx, bucketName); err != nil {
+			return managed.ExternalDelete{}, errors.Wrap(err, errBucketDeletion)
+		}
+	} else {
+		// Check first so the reason deletion is blocked is reported
+		// rather than a generic API error on every retry.
+		empty, err := c.client.IsEmpty(ctx, bucketName)
+		if err == nil && !empty {
+			return managed.ExternalDelete{}, errors.Wrap(bucketclient.ErrBucketNotEmpty, errBucketDeletion)
+		}
+	}
+
 	err := c.client.Delete(ctx, bucketName)
 	if err != nil && !bucketclient.IsBucketNotFound(err) {
 		return managed.ExternalDelete{}, errors.Wrap(err, errBucketDeletion)
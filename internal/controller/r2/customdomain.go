@@ -0,0 +1,235 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package r2
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/r2/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	customdomainclient "github.com/rossigee/provider-cloudflare/internal/clients/r2/customdomain"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotCustomDomain = "managed resource is not an R2CustomDomain custom resource"
+
+	errCustomDomainClientConfig = "error getting custom domain client config"
+
+	errCustomDomainLookup   = "cannot lookup R2CustomDomain"
+	errCustomDomainCreation = "cannot create R2CustomDomain"
+	errCustomDomainUpdate   = "cannot update R2CustomDomain"
+	errCustomDomainDeletion = "cannot delete R2CustomDomain"
+	errCustomDomainNoBucket = "no bucket found"
+	errCustomDomainNoZone   = "no zone found"
+)
+
+// SetupR2CustomDomain adds a controller that reconciles R2CustomDomain
+// managed resources.
+func SetupR2CustomDomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.R2CustomDomainGroupKind.String())
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: bucketMaxConcurrency,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.R2CustomDomainGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&customDomainConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
+				return clients.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.WorkersR2StorageWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.R2CustomDomain{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.R2CustomDomainGroupVersionKind)).
+		Complete(r)
+}
+
+// A customDomainConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type customDomainConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (*cloudflare.API, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *customDomainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.R2CustomDomain); !ok {
+		return nil, errors.New(errNotCustomDomain)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errCustomDomainClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customDomainExternal{client: customdomainclient.NewClient(client)}, nil
+}
+
+// A customDomainExternal observes, then either attaches, updates, or
+// detaches the custom domain of an R2 bucket.
+type customDomainExternal struct {
+	client *customdomainclient.CustomDomainClient
+}
+
+func (c *customDomainExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.R2CustomDomain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCustomDomain)
+	}
+
+	// The domain is only considered attached once Create has recorded it
+	// in the external name.
+	domain := meta.GetExternalName(cr)
+	if domain == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if cr.Spec.ForProvider.Bucket == nil {
+		return managed.ExternalObservation{}, errors.New(errCustomDomainNoBucket)
+	}
+
+	observation, err := c.client.Get(ctx, *cr.Spec.ForProvider.Bucket, domain)
+	if err != nil {
+		return managed.ExternalObservation{},
+			errors.Wrap(resource.Ignore(customdomainclient.IsCustomDomainNotFound, err), errCustomDomainLookup)
+	}
+
+	cr.Status.AtProvider = *observation
+
+	// The domain does not serve the bucket until its ownership has been
+	// verified and its certificate issued.
+	if customdomainclient.IsActive(*observation) {
+		cr.SetConditions(rtv1.Available())
+	} else {
+		cr.SetConditions(rtv1.Creating())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: customdomainclient.IsUpToDate(cr.Spec.ForProvider, *observation),
+	}, nil
+}
+
+func (c *customDomainExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.R2CustomDomain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCustomDomain)
+	}
+
+	if cr.Spec.ForProvider.Bucket == nil {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errCustomDomainNoBucket), errCustomDomainCreation)
+	}
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errCustomDomainNoZone), errCustomDomainCreation)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	if err := c.client.Create(ctx, *cr.Spec.ForProvider.Bucket, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCustomDomainCreation)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Domain)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *customDomainExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.R2CustomDomain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCustomDomain)
+	}
+
+	if cr.Spec.ForProvider.Bucket == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errCustomDomainNoBucket), errCustomDomainUpdate)
+	}
+
+	err := c.client.Update(ctx, *cr.Spec.ForProvider.Bucket, cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errCustomDomainUpdate)
+}
+
+func (c *customDomainExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.R2CustomDomain)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotCustomDomain)
+	}
+
+	domain := meta.GetExternalName(cr)
+	if domain == "" {
+		// Nothing to delete if no external name is set
+		return managed.ExternalDelete{}, nil
+	}
+
+	if cr.Spec.ForProvider.Bucket == nil {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errCustomDomainNoBucket), errCustomDomainDeletion)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	err := c.client.Delete(ctx, *cr.Spec.ForProvider.Bucket, domain)
+	return managed.ExternalDelete{}, errors.Wrap(err, errCustomDomainDeletion)
+}
+
+func (c *customDomainExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -31,5 +31,10 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any
 		return err
 	}
 
+	// Setup R2CustomDomain controller
+	if err := SetupR2CustomDomain(mgr, l, rl); err != nil {
+		return err
+	}
+
 	return nil
 }
\ No newline at end of file
@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrar
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/registrar/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/registrar"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotRegistrarDomain = "managed resource is not a RegistrarDomain custom resource"
+
+	errClientConfig = "error getting client config"
+
+	errDomainLookup        = "cannot lookup registrar domain"
+	errDomainUpdate        = "cannot update registrar domain"
+	errDomainNotRegistered = "domain is not registered with Cloudflare Registrar in this account; register or transfer it in the dashboard first"
+)
+
+// SetupRegistrarDomain adds a controller that reconciles RegistrarDomain
+// managed resources.
+func SetupRegistrarDomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.RegistrarDomainGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: 5,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RegistrarDomainGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&domainConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (registrar.Client, error) {
+				return registrar.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.RegistrarDomainsWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+		// Domains are identified by spec.forProvider.domain.
+		managed.WithInitializers(account.NewDefaulter(mgr.GetClient())),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.RegistrarDomain{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.RegistrarDomainGroupVersionKind)).
+		Complete(r)
+}
+
+// A domainConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type domainConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (registrar.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *domainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.RegistrarDomain); !ok {
+		return nil, errors.New(errNotRegistrarDomain)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domainExternal{client: client}, nil
+}
+
+// A domainExternal observes, then updates the settings of a domain
+// registered with Cloudflare Registrar.
+type domainExternal struct {
+	client registrar.Client
+}
+
+func (e *domainExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RegistrarDomain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRegistrarDomain)
+	}
+
+	// Deleting a RegistrarDomain only stops managing the domain; the
+	// registration itself is never cancelled.
+	if meta.WasDeleted(cr) {
+		metrics.DeleteDomainExpiry(cr.Spec.ForProvider.Domain)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	d, err := registrar.Get(ctx, e.client, cr.Spec.ForProvider.AccountID, cr.Spec.ForProvider.Domain)
+	if err != nil {
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errDomainLookup)
+	}
+
+	cr.Status.AtProvider = registrar.GenerateObservation(d)
+	cr.SetConditions(rtv1.Available())
+	if !d.ExpiresAt.IsZero() {
+		metrics.SetDomainExpiry(cr.Spec.ForProvider.Domain, d.ExpiresAt)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: registrar.UpToDate(cr.Spec.ForProvider, d),
+	}, nil
+}
+
+func (e *domainExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if _, ok := mg.(*v1alpha1.RegistrarDomain); !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRegistrarDomain)
+	}
+
+	// Registering a domain involves payment and contact verification that
+	// the API does not offer.
+	return managed.ExternalCreation{}, errors.New(errDomainNotRegistered)
+}
+
+func (e *domainExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RegistrarDomain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRegistrarDomain)
+	}
+
+	d, err := registrar.Get(ctx, e.client, cr.Spec.ForProvider.AccountID, cr.Spec.ForProvider.Domain)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDomainUpdate)
+	}
+
+	err = registrar.Update(ctx, e.client, cr.Spec.ForProvider, d)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errDomainUpdate)
+}
+
+func (e *domainExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	// Observe reports deleted RegistrarDomains as gone, so this is never
+	// called; the registration is left untouched either way.
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *domainExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replacement guards managed resources against accidental
+// replacement of the external resources they represent when an immutable
+// field changes.
+package replacement
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+)
+
+const (
+	// TypeRequiresReplacement indicates that an immutable field changed and
+	// the external resource must be replaced to apply the change.
+	TypeRequiresReplacement rtv1.ConditionType = "RequiresReplacement"
+
+	// ReasonImmutableFieldChanged is the reason a replacement is required.
+	ReasonImmutableFieldChanged rtv1.ConditionReason = "ImmutableFieldChanged"
+
+	// ReasonUpToDate is the reason a previously required replacement is no
+	// longer required.
+	ReasonUpToDate rtv1.ConditionReason = "ImmutableFieldsUpToDate"
+)
+
+// A Decision is what to do about a change of an immutable field.
+type Decision int
+
+// Decisions about a change of an immutable field.
+const (
+	// None means no immutable field changed.
+	None Decision = iota
+
+	// Blocked means an immutable field changed but the external resource
+	// may not be replaced. It must be left alone rather than updated.
+	Blocked
+
+	// Replace means the external resource must be deleted so that it can
+	// be created again with the changed field.
+	Replace
+)
+
+// A Change of an immutable field from its observed to its desired value.
+type Change struct {
+	// Field is the path of the field within the managed resource, e.g.
+	// spec.forProvider.name.
+	Field string
+
+	Observed string
+	Desired  string
+}
+
+// Required returns a condition indicating that the supplied change requires
+// the external resource to be replaced.
+func Required(c Change, message string) rtv1.Condition {
+	return rtv1.Condition{
+		Type:               TypeRequiresReplacement,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonImmutableFieldChanged,
+		Message:            fmt.Sprintf("%s changed from %q to %q; %s", c.Field, c.Observed, c.Desired, message),
+	}
+}
+
+// NotRequired returns a condition indicating that the external resource no
+// longer needs to be replaced.
+func NotRequired() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               TypeRequiresReplacement,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpToDate,
+	}
+}
+
+// Decide what to do about the supplied change of an immutable field of a
+// managed resource. A change is only applied by replacing the external
+// resource when allowRecreate is true and deletion protection is not
+// enabled; otherwise the RequiresReplacement condition is set. The
+// condition is cleared once the change has been reverted or applied.
+func Decide(mg resource.Managed, c Change, allowRecreate *bool) Decision {
+	if c.Observed == c.Desired {
+		if mg.GetCondition(TypeRequiresReplacement).Status == corev1.ConditionTrue {
+			mg.SetConditions(NotRequired())
+		}
+		return None
+	}
+
+	switch {
+	case allowRecreate == nil || !*allowRecreate:
+		mg.SetConditions(Required(c, "set allowRecreate to true to delete and recreate the external resource"))
+		return Blocked
+	case protection.IsEnabled(mg):
+		mg.SetConditions(Required(c, "remove the "+protection.AnnotationKeyDeletionProtection+" annotation to delete and recreate the external resource"))
+		return Blocked
+	}
+	return Replace
+}
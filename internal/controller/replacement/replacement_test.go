@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replacement
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+)
+
+func TestDecide(t *testing.T) {
+	changed := Change{Field: "spec.forProvider.name", Observed: "old", Desired: "new"}
+
+	type want struct {
+		decision  Decision
+		condition corev1.ConditionStatus
+	}
+
+	cases := map[string]struct {
+		reason        string
+		change        Change
+		allowRecreate *bool
+		annotations   map[string]string
+		required      bool
+		want          want
+	}{
+		"Unchanged": {
+			reason: "Nothing should be done when the immutable field did not change",
+			change: Change{Field: "spec.forProvider.name", Observed: "old", Desired: "old"},
+			want:   want{decision: None, condition: corev1.ConditionUnknown},
+		},
+		"Reverted": {
+			reason:   "A previously required replacement should be cleared once the field no longer differs",
+			change:   Change{Field: "spec.forProvider.name", Observed: "old", Desired: "old"},
+			required: true,
+			want:     want{decision: None, condition: corev1.ConditionFalse},
+		},
+		"NotAllowed": {
+			reason: "A change should be blocked and reported when recreation is not allowed",
+			change: changed,
+			want:   want{decision: Blocked, condition: corev1.ConditionTrue},
+		},
+		"AllowedFalse": {
+			reason:        "A change should be blocked when allowRecreate is false",
+			change:        changed,
+			allowRecreate: ptr.To(false),
+			want:          want{decision: Blocked, condition: corev1.ConditionTrue},
+		},
+		"DeletionProtected": {
+			reason:        "A change should be blocked when deletion protection is enabled, even if recreation is allowed",
+			change:        changed,
+			allowRecreate: ptr.To(true),
+			annotations:   map[string]string{protection.AnnotationKeyDeletionProtection: "true"},
+			want:          want{decision: Blocked, condition: corev1.ConditionTrue},
+		},
+		"Allowed": {
+			reason:        "The external resource should be replaced when recreation is allowed",
+			change:        changed,
+			allowRecreate: ptr.To(true),
+			want:          want{decision: Replace, condition: corev1.ConditionUnknown},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			if tc.required {
+				mg.SetConditions(Required(changed, ""))
+			}
+
+			got := Decide(mg, tc.change, tc.allowRecreate)
+			if diff := cmp.Diff(tc.want.decision, got); diff != "" {
+				t.Errorf("\n%s\nDecide(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, mg.GetCondition(TypeRequiresReplacement).Status); diff != "" {
+				t.Errorf("\n%s\nDecide(...): -want condition status, +got condition status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
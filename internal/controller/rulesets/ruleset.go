@@ -20,7 +20,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,7 +38,15 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1alpha1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -49,6 +59,9 @@ const (
 	errRulesetUpdate   = "cannot update ruleset"
 	errRulesetDeletion = "cannot delete ruleset"
 	errRulesetNoScope  = "cannot create ruleset: no zone or account specified"
+
+	errEntrypointLookup = "cannot lookup entrypoint ruleset"
+	errEntrypointUpdate = "cannot update entrypoint ruleset"
 )
 
 const (
@@ -70,16 +83,17 @@ func SetupRuleset(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimi
 	}
 
 	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.RulesetGroupVersionKind),
-		managed.WithExternalConnecter(&rulesetConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&rulesetConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (ruleset.Client, error) {
 				return ruleset.NewClient(cfg, hc)
 			},
-		}),
+		}), mgr.GetClient(), scopes.ZoneWAFWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithPollInterval(5*time.Minute),
 		// Initialize external-name field.
 		managed.WithInitializers(),
@@ -89,6 +103,7 @@ func SetupRuleset(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimi
 		Named(name).
 		WithOptions(o).
 		For(&v1alpha1.Ruleset{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.RulesetGroupVersionKind)).
 		Complete(r)
 }
 
@@ -127,6 +142,61 @@ type rulesetExternal struct {
 	client ruleset.Client
 }
 
+// observeEntrypoint observes the rules a Ruleset contributes to the shared
+// entrypoint of its phase.
+func (e *rulesetExternal) observeEntrypoint(ctx context.Context, cr *v1alpha1.Ruleset) (managed.ExternalObservation, error) {
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rs, err := e.client.GetEntrypoint(ctx, cr.Spec.ForProvider)
+	if ruleset.IsRulesetNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errEntrypointLookup)
+	}
+
+	// The rules of the Ruleset no longer exist if they were removed from
+	// the entrypoint, so they are contributed again.
+	desired := ruleset.DesiredRules(cr.GetName(), cr.Spec.ForProvider)
+	if len(desired) > 0 && len(ruleset.Owned(rs.Rules, cr.GetName())) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = ruleset.GenerateObservation(rs)
+	cr.Status.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ruleset.EntrypointUpToDate(rs.Rules, cr.GetName(), desired),
+	}, nil
+}
+
+// updateEntrypoint replaces the rules a Ruleset contributes to the shared
+// entrypoint of its phase with the supplied rules, leaving those of other
+// Rulesets and any rules managed outside Crossplane in place.
+func (e *rulesetExternal) updateEntrypoint(ctx context.Context, cr *v1alpha1.Ruleset, desired []cloudflare.RulesetRule) (*cloudflare.Ruleset, error) {
+	// The entrypoint is read, merged and written as a whole, so changes
+	// by other Rulesets must not interleave.
+	defer ruleset.LockEntrypoint(cr.Spec.ForProvider)()
+
+	var current []cloudflare.RulesetRule
+	rs, err := e.client.GetEntrypoint(ctx, cr.Spec.ForProvider)
+	if resource.Ignore(ruleset.IsRulesetNotFound, err) != nil {
+		return nil, errors.Wrap(err, errEntrypointLookup)
+	}
+	if err == nil {
+		current = rs.Rules
+	} else if len(desired) == 0 {
+		// Nothing to remove from an entrypoint that does not exist.
+		return &cloudflare.Ruleset{}, nil
+	}
+
+	rs, err = e.client.UpdateEntrypoint(ctx, cr.Spec.ForProvider, ruleset.Merge(current, cr.GetName(), desired))
+	return rs, errors.Wrap(err, errEntrypointUpdate)
+}
+
 func (e *rulesetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
 	cr, ok := mg.(*v1alpha1.Ruleset)
 	if !ok {
@@ -138,6 +208,10 @@ func (e *rulesetExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalObservation{}, errors.New(errRulesetNoScope)
 	}
 
+	if cr.Spec.ForProvider.SharedEntrypoint != nil {
+		return e.observeEntrypoint(ctx, cr)
+	}
+
 	// Ruleset does not exist if we dont have an ID stored in external-name
 	rulesetID := meta.GetExternalName(cr)
 	if rulesetID == "" {
@@ -177,6 +251,16 @@ func (e *rulesetExternal) Create(ctx context.Context, mg resource.Managed) (mana
 
 	cr.SetConditions(rtv1.Creating())
 
+	if cr.Spec.ForProvider.SharedEntrypoint != nil {
+		rs, err := e.updateEntrypoint(ctx, cr, ruleset.DesiredRules(cr.GetName(), cr.Spec.ForProvider))
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errRulesetCreation)
+		}
+		cr.Status.AtProvider = ruleset.GenerateObservation(rs)
+		meta.SetExternalName(cr, rs.ID)
+		return managed.ExternalCreation{}, nil
+	}
+
 	rs, err := e.client.CreateRuleset(ctx, cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errRulesetCreation)
@@ -206,6 +290,15 @@ func (e *rulesetExternal) Update(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalUpdate{}, errors.New(errRulesetUpdate)
 	}
 
+	if cr.Spec.ForProvider.SharedEntrypoint != nil {
+		rs, err := e.updateEntrypoint(ctx, cr, ruleset.DesiredRules(cr.GetName(), cr.Spec.ForProvider))
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRulesetUpdate)
+		}
+		cr.Status.AtProvider = ruleset.GenerateObservation(rs)
+		return managed.ExternalUpdate{}, nil
+	}
+
 	rs, err := e.client.UpdateRuleset(ctx, rulesetID, cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errRulesetUpdate)
@@ -234,6 +327,13 @@ func (e *rulesetExternal) Delete(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalDelete{}, errors.New(errRulesetDeletion)
 	}
 
+	// The entrypoint is shared, so only the rules of the Ruleset are
+	// removed from it.
+	if cr.Spec.ForProvider.SharedEntrypoint != nil {
+		_, err := e.updateEntrypoint(ctx, cr, nil)
+		return managed.ExternalDelete{}, errors.Wrap(err, errRulesetDeletion)
+	}
+
 	err := e.client.DeleteRuleset(ctx, rulesetID, cr.Spec.ForProvider)
 	return managed.ExternalDelete{}, errors.Wrap(err, errRulesetDeletion)
 }
@@ -30,6 +30,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -48,6 +49,9 @@ type mockRulesetClient struct {
 	MockGetRuleset    func(ctx context.Context, rulesetID string, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error)
 	MockUpdateRuleset func(ctx context.Context, rulesetID string, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error)
 	MockDeleteRuleset func(ctx context.Context, rulesetID string, params v1alpha1.RulesetParameters) error
+
+	MockGetEntrypoint    func(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error)
+	MockUpdateEntrypoint func(ctx context.Context, params v1alpha1.RulesetParameters, rules []cloudflare.RulesetRule) (*cloudflare.Ruleset, error)
 }
 
 func (m *mockRulesetClient) CreateRuleset(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error) {
@@ -66,6 +70,14 @@ func (m *mockRulesetClient) DeleteRuleset(ctx context.Context, rulesetID string,
 	return m.MockDeleteRuleset(ctx, rulesetID, params)
 }
 
+func (m *mockRulesetClient) GetEntrypoint(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockGetEntrypoint(ctx, params)
+}
+
+func (m *mockRulesetClient) UpdateEntrypoint(ctx context.Context, params v1alpha1.RulesetParameters, rules []cloudflare.RulesetRule) (*cloudflare.Ruleset, error) {
+	return m.MockUpdateEntrypoint(ctx, params, rules)
+}
+
 type rulesetModifier func(*v1alpha1.Ruleset)
 
 func withZone(zone string) rulesetModifier {
@@ -73,6 +85,14 @@ func withZone(zone string) rulesetModifier {
 }
 
 
+func withSharedEntrypoint(priority int32) rulesetModifier {
+	return func(rs *v1alpha1.Ruleset) {
+		rs.SetName("team-a")
+		rs.Spec.ForProvider.SharedEntrypoint = &v1alpha1.RulesetSharedEntrypoint{Priority: &priority}
+		rs.Spec.ForProvider.Rules = []v1alpha1.RulesetRule{{Action: "block", Expression: "ip.src eq 192.0.2.1"}}
+	}
+}
+
 func withRulesetID(id string) rulesetModifier {
 	return func(rs *v1alpha1.Ruleset) { rs.Status.AtProvider.ID = id }
 }
@@ -284,6 +304,77 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"SharedEntrypointUpToDate": {
+			reason: "Should report that the rules contributed to a shared entrypoint are up to date",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypoint: func(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{ID: "entrypoint", Rules: []cloudflare.RulesetRule{
+							{ID: "manual", Action: "log", Expression: "true"},
+							{ID: "a", Ref: "crossplane:100:team-a:0", Action: "block", Expression: "ip.src eq 192.0.2.1"},
+							{ID: "b", Ref: "crossplane:200:team-b:0", Action: "block", Expression: "ip.src eq 192.0.2.2"},
+						}}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withSharedEntrypoint(100), func(rs *v1alpha1.Ruleset) {
+					meta.SetExternalName(rs, "entrypoint")
+				}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SharedEntrypointRulesRemoved": {
+			reason: "Should report that the rules of a shared entrypoint do not exist when they were removed from it",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypoint: func(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{ID: "entrypoint", Rules: []cloudflare.RulesetRule{
+							{ID: "b", Ref: "crossplane:200:team-b:0", Action: "block", Expression: "ip.src eq 192.0.2.2"},
+						}}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withSharedEntrypoint(100), func(rs *v1alpha1.Ruleset) {
+					meta.SetExternalName(rs, "entrypoint")
+				}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"SharedEntrypointPriorityChanged": {
+			reason: "Should report that the rules of a shared entrypoint are outdated when their priority changed",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypoint: func(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{ID: "entrypoint", Rules: []cloudflare.RulesetRule{
+							{ID: "a", Ref: "crossplane:100:team-a:0", Action: "block", Expression: "ip.src eq 192.0.2.1"},
+							{ID: "b", Ref: "crossplane:200:team-b:0", Action: "block", Expression: "ip.src eq 192.0.2.2"},
+						}}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withSharedEntrypoint(300), func(rs *v1alpha1.Ruleset) {
+					meta.SetExternalName(rs, "entrypoint")
+				}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"NoExternalName": {
 			reason: "Should report that the ruleset does not exist when no external name is set",
 			args: args{
@@ -372,6 +463,35 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errors.New("boom"), errRulesetCreation),
 			},
 		},
+		"SharedEntrypoint": {
+			reason: "Should merge the rules into the shared entrypoint, keeping the rules of others",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypoint: func(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{ID: "entrypoint", Rules: []cloudflare.RulesetRule{
+							{ID: "b", Ref: "crossplane:200:team-b:0", Action: "block", Expression: "ip.src eq 192.0.2.2"},
+							{ID: "manual", Action: "log", Expression: "true"},
+						}}, nil
+					},
+					MockUpdateEntrypoint: func(ctx context.Context, params v1alpha1.RulesetParameters, rules []cloudflare.RulesetRule) (*cloudflare.Ruleset, error) {
+						refs := []string{}
+						for _, r := range rules {
+							refs = append(refs, r.Ref)
+						}
+						if diff := cmp.Diff([]string{"", "crossplane:100:team-a:0", "crossplane:200:team-b:0"}, refs); diff != "" {
+							return nil, errors.Errorf("unexpected rules: %s", diff)
+						}
+						return &cloudflare.Ruleset{ID: "entrypoint", Rules: rules}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withSharedEntrypoint(100)),
+			},
+			want: want{
+				o: managed.ExternalCreation{},
+			},
+		},
 		"Success": {
 			reason: "Should return no error when ruleset is created successfully",
 			fields: fields{
@@ -631,6 +751,31 @@ func TestDelete(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SharedEntrypoint": {
+			reason: "Should only remove the rules of the Ruleset from a shared entrypoint",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypoint: func(ctx context.Context, params v1alpha1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{ID: "entrypoint", Rules: []cloudflare.RulesetRule{
+							{ID: "a", Ref: "crossplane:100:team-a:0", Action: "block", Expression: "ip.src eq 192.0.2.1"},
+							{ID: "b", Ref: "crossplane:200:team-b:0", Action: "block", Expression: "ip.src eq 192.0.2.2"},
+						}}, nil
+					},
+					MockUpdateEntrypoint: func(ctx context.Context, params v1alpha1.RulesetParameters, rules []cloudflare.RulesetRule) (*cloudflare.Ruleset, error) {
+						if len(rules) != 1 || rules[0].ID != "b" {
+							return nil, errors.Errorf("unexpected rules: %v", rules)
+						}
+						return &cloudflare.Ruleset{ID: "entrypoint", Rules: rules}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withSharedEntrypoint(100), func(rs *v1alpha1.Ruleset) {
+					meta.SetExternalName(rs, "entrypoint")
+				}),
+			},
+			want: want{},
+		},
 	}
 
 	for name, tc := range cases {
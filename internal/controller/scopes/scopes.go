@@ -0,0 +1,303 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scopes checks that the credentials of a managed resource grant the
+// permissions its kind needs before any change is made to the external
+// resource, so that a missing permission is reported precisely rather than
+// as an opaque authentication error from the first mutating call.
+package scopes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+// Names of the Cloudflare API token permission groups managed resources
+// need to make changes.
+const (
+	AccessAppsAndPoliciesWrite = "Access: Apps and Policies Write"
+	AccessSSHAuditingWrite     = "Access: SSH Auditing Write"
+	BotManagementWrite         = "Bot Management Write"
+	CacheSettingsWrite         = "Cache Settings Write"
+	DNSFirewallWrite           = "DNS Firewall Write"
+	DNSWrite                   = "DNS Write"
+	EmailRoutingAddressesWrite = "Email Routing Addresses Write"
+	EmailRoutingRulesWrite     = "Email Routing Rules Write"
+	FirewallServicesWrite      = "Firewall Services Write"
+	LoadBalancersWrite         = "Load Balancers Write"
+	LoadBalancingPoolsWrite    = "Load Balancing: Monitors and Pools Write"
+	LogsWrite                  = "Logs Write"
+	PagesWrite                 = "Pages Write"
+	RegistrarDomainsWrite      = "Registrar Domains Write"
+	SSLAndCertificatesWrite    = "SSL and Certificates Write"
+	TransformRulesWrite        = "Transform Rules Write"
+	TurnstileSitesWrite        = "Turnstile Sites Write"
+	WorkersKVStorageWrite      = "Workers KV Storage Write"
+	WorkersR2StorageWrite      = "Workers R2 Storage Write"
+	WorkersRoutesWrite         = "Workers Routes Write"
+	WorkersScriptsWrite        = "Workers Scripts Write"
+	ZoneSettingsWrite          = "Zone Settings Write"
+	ZoneWAFWrite               = "Zone WAF Write"
+	ZoneWrite                  = "Zone Write"
+)
+
+const (
+	// TypeMissingPermissions indicates that the credentials of a resource
+	// lack permissions its kind needs.
+	TypeMissingPermissions rtv1.ConditionType = "MissingPermissions"
+
+	// ReasonPermissionsMissing is the reason changes were not attempted.
+	ReasonPermissionsMissing rtv1.ConditionReason = "PermissionsMissing"
+
+	// ReasonPermissionsGranted is the reason previously missing
+	// permissions are no longer missing.
+	ReasonPermissionsGranted rtv1.ConditionReason = "PermissionsGranted"
+
+	errGetConfig  = "cannot get credentials"
+	errMissingFmt = "credentials lack permissions required to change this resource: %s"
+
+	// grantsTTL is how long the permissions granted to a token are cached.
+	// Tokens are edited rarely, so they are only read again once in a
+	// while to pick up newly granted permissions.
+	grantsTTL = 10 * time.Minute
+)
+
+// Missing returns a condition indicating that the credentials of a resource
+// lack the supplied permissions.
+func Missing(permissions []string) rtv1.Condition {
+	return rtv1.Condition{
+		Type:               TypeMissingPermissions,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPermissionsMissing,
+		Message:            fmt.Sprintf(errMissingFmt, strings.Join(permissions, ", ")),
+	}
+}
+
+// Granted returns a condition indicating that the credentials of a resource
+// no longer lack any permissions.
+func Granted() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               TypeMissingPermissions,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPermissionsGranted,
+	}
+}
+
+// A TokenClient reads the permissions of the API token it authenticates
+// with.
+type TokenClient interface {
+	VerifyAPIToken(ctx context.Context) (cloudflare.APITokenVerifyBody, error)
+	GetAPIToken(ctx context.Context, tokenID string) (cloudflare.APIToken, error)
+}
+
+// Grants returns the names of the permission groups an API token is allowed.
+// Tokens must be allowed to read themselves for their permissions to be
+// known; ok is false when they are not.
+func Grants(ctx context.Context, c TokenClient) (grants map[string]bool, ok bool) {
+	v, err := c.VerifyAPIToken(ctx)
+	if err != nil || v.ID == "" {
+		return nil, false
+	}
+	t, err := c.GetAPIToken(ctx, v.ID)
+	if err != nil {
+		return nil, false
+	}
+
+	grants = map[string]bool{}
+	for _, p := range t.Policies {
+		if p.Effect != "allow" {
+			continue
+		}
+		for _, g := range p.PermissionGroups {
+			grants[strings.ToLower(g.Name)] = true
+		}
+	}
+	return grants, true
+}
+
+// Lacking returns the required permissions that are not granted.
+func Lacking(grants map[string]bool, required []string) []string {
+	var lacking []string
+	for _, r := range required {
+		if !grants[strings.ToLower(r)] {
+			lacking = append(lacking, r)
+		}
+	}
+	return lacking
+}
+
+// A grant is the permissions granted to a token when last read.
+type grant struct {
+	grants map[string]bool
+	known  bool
+	read   time.Time
+}
+
+// A cache of the permissions granted to tokens, shared by all controllers
+// so that each token is read at most once per grantsTTL.
+type cache struct {
+	mu     sync.Mutex
+	tokens map[string]grant
+}
+
+var shared = &cache{tokens: map[string]grant{}}
+
+// hc is the HTTP client used to read token permissions.
+var hc = metrics.NewInstrumentedHTTPClient("scopes")
+
+// grantsFor returns the permissions granted to the supplied config, reading
+// them if they are not cached.
+func (c *cache) grantsFor(ctx context.Context, cfg clients.Config, newClient func(clients.Config) (TokenClient, error), now time.Time) (map[string]bool, bool) {
+	// Global API keys are not scoped, so there is nothing to check. They
+	// take precedence over a token that is also configured.
+	if cfg.AuthMode() != clients.AuthModeAPIToken {
+		return nil, false
+	}
+	sum := sha256.Sum256([]byte(*cfg.Token))
+	key := hex.EncodeToString(sum[:])
+
+	c.mu.Lock()
+	g, cached := c.tokens[key]
+	c.mu.Unlock()
+	if cached && now.Sub(g.read) < grantsTTL {
+		return g.grants, g.known
+	}
+
+	tc, err := newClient(cfg)
+	if err != nil {
+		return nil, false
+	}
+	g = grant{read: now}
+	g.grants, g.known = Grants(ctx, tc)
+
+	c.mu.Lock()
+	c.tokens[key] = g
+	c.mu.Unlock()
+	return g.grants, g.known
+}
+
+func newTokenClient(cfg clients.Config) (TokenClient, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// NewConnecter wraps the supplied ExternalConnecter so that the clients it
+// produces refuse to create, update or delete external resources while the
+// credentials of a managed resource lack any of the required permissions.
+// Permissions are only checked for API tokens that may read themselves.
+func NewConnecter(c managed.ExternalConnecter, kube client.Client, required ...string) managed.ExternalConnecter {
+	return &connecter{
+		ExternalConnecter: c,
+		required:          required,
+		config: func(ctx context.Context, mg resource.Managed) (*clients.Config, error) {
+			return clients.GetConfig(ctx, kube, mg)
+		},
+		newClient: newTokenClient,
+		cache:     shared,
+	}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	required  []string
+	config    func(ctx context.Context, mg resource.Managed) (*clients.Config, error)
+	newClient func(clients.Config) (TokenClient, error)
+	cache     *cache
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec, c: c}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	c *connecter
+}
+
+// check returns an error, and sets the MissingPermissions condition, if the
+// credentials of the supplied managed resource are known to lack any of
+// the required permissions.
+func (e *external) check(ctx context.Context, mg resource.Managed) error {
+	if len(e.c.required) == 0 {
+		return nil
+	}
+	cfg, err := e.c.config(ctx, mg)
+	if err != nil {
+		return errors.Wrap(err, errGetConfig)
+	}
+	grants, known := e.c.cache.grantsFor(ctx, *cfg, e.c.newClient, time.Now())
+	if !known {
+		return nil
+	}
+	if lacking := Lacking(grants, e.c.required); len(lacking) > 0 {
+		mg.SetConditions(Missing(lacking))
+		return errors.Errorf(errMissingFmt, strings.Join(lacking, ", "))
+	}
+	if mg.GetCondition(TypeMissingPermissions).Status == corev1.ConditionTrue {
+		mg.SetConditions(Granted())
+	}
+	return nil
+}
+
+// Create returns an error rather than creating the external resource while
+// the credentials lack required permissions.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if err := e.check(ctx, mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return e.ExternalClient.Create(ctx, mg)
+}
+
+// Update returns an error rather than updating the external resource while
+// the credentials lack required permissions.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if err := e.check(ctx, mg); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+// Delete returns an error rather than deleting the external resource while
+// the credentials lack required permissions.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	if err := e.check(ctx, mg); err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scopes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+type mockTokenClient struct {
+	verify func() (cloudflare.APITokenVerifyBody, error)
+	get    func(tokenID string) (cloudflare.APIToken, error)
+}
+
+func (m *mockTokenClient) VerifyAPIToken(ctx context.Context) (cloudflare.APITokenVerifyBody, error) {
+	return m.verify()
+}
+
+func (m *mockTokenClient) GetAPIToken(ctx context.Context, tokenID string) (cloudflare.APIToken, error) {
+	return m.get(tokenID)
+}
+
+func token(groups ...string) func(string) (cloudflare.APIToken, error) {
+	return func(tokenID string) (cloudflare.APIToken, error) {
+		p := cloudflare.APITokenPolicies{Effect: "allow"}
+		for _, g := range groups {
+			p.PermissionGroups = append(p.PermissionGroups, cloudflare.APITokenPermissionGroups{Name: g})
+		}
+		return cloudflare.APIToken{ID: tokenID, Policies: []cloudflare.APITokenPolicies{
+			p,
+			{Effect: "deny", PermissionGroups: []cloudflare.APITokenPermissionGroups{{Name: ZoneWrite}}},
+		}}, nil
+	}
+}
+
+func verified() (cloudflare.APITokenVerifyBody, error) {
+	return cloudflare.APITokenVerifyBody{ID: "token-id"}, nil
+}
+
+func TestGrants(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		grants map[string]bool
+		ok     bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		client *mockTokenClient
+		want   want
+	}{
+		"VerifyError": {
+			reason: "Grants should be unknown when the token cannot be verified",
+			client: &mockTokenClient{verify: func() (cloudflare.APITokenVerifyBody, error) {
+				return cloudflare.APITokenVerifyBody{}, errBoom
+			}},
+			want: want{},
+		},
+		"CannotReadSelf": {
+			reason: "Grants should be unknown when the token may not read itself",
+			client: &mockTokenClient{verify: verified, get: func(string) (cloudflare.APIToken, error) {
+				return cloudflare.APIToken{}, errBoom
+			}},
+			want: want{},
+		},
+		"Allowed": {
+			reason: "Only permission groups of allow policies should be granted",
+			client: &mockTokenClient{verify: verified, get: token(DNSWrite, "Zone Read")},
+			want:   want{grants: map[string]bool{"dns write": true, "zone read": true}, ok: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			grants, ok := Grants(context.Background(), tc.client)
+			if diff := cmp.Diff(tc.want, want{grants: grants, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nGrants(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		created   bool
+		err       error
+		condition corev1.ConditionStatus
+	}
+
+	cases := map[string]struct {
+		reason   string
+		cfg      clients.Config
+		required []string
+		client   *mockTokenClient
+		missing  bool
+		want     want
+	}{
+		"NothingRequired": {
+			reason: "Create should be passed through when the kind requires no permissions",
+			cfg:    clients.Config{AuthByAPIToken: &clients.AuthByAPIToken{Token: ptr.To("a")}},
+			want:   want{created: true, condition: corev1.ConditionUnknown},
+		},
+		"APIKey": {
+			reason:   "Create should be passed through for global API keys, which are not scoped",
+			cfg:      clients.Config{AuthByAPIKey: &clients.AuthByAPIKey{Key: ptr.To("key"), Email: ptr.To("a@example.com")}},
+			required: []string{DNSWrite},
+			want:     want{created: true, condition: corev1.ConditionUnknown},
+		},
+		"APIKeyAndToken": {
+			reason: "Create should be passed through when a global API key is used in preference to a token",
+			cfg: clients.Config{
+				AuthByAPIKey:   &clients.AuthByAPIKey{Key: ptr.To("key"), Email: ptr.To("a@example.com")},
+				AuthByAPIToken: &clients.AuthByAPIToken{Token: ptr.To("e")},
+			},
+			required: []string{DNSWrite},
+			client:   &mockTokenClient{verify: verified, get: token("zone write")},
+			want:     want{created: true, condition: corev1.ConditionUnknown},
+		},
+		"Unknown": {
+			reason:   "Create should be passed through when the permissions of the token cannot be read",
+			cfg:      clients.Config{AuthByAPIToken: &clients.AuthByAPIToken{Token: ptr.To("b")}},
+			required: []string{DNSWrite},
+			client: &mockTokenClient{verify: verified, get: func(string) (cloudflare.APIToken, error) {
+				return cloudflare.APIToken{}, errors.New("forbidden")
+			}},
+			want: want{created: true, condition: corev1.ConditionUnknown},
+		},
+		"Lacking": {
+			reason:   "Create should be refused, naming the missing permissions, when the token lacks them",
+			cfg:      clients.Config{AuthByAPIToken: &clients.AuthByAPIToken{Token: ptr.To("c")}},
+			required: []string{ZoneWrite, ZoneSettingsWrite, DNSWrite},
+			client:   &mockTokenClient{verify: verified, get: token("dns write")},
+			want: want{
+				err:       errors.Errorf(errMissingFmt, ZoneWrite+", "+ZoneSettingsWrite),
+				condition: corev1.ConditionTrue,
+			},
+		},
+		"NowGranted": {
+			reason:   "A previously set MissingPermissions condition should be cleared once the permissions are granted",
+			cfg:      clients.Config{AuthByAPIToken: &clients.AuthByAPIToken{Token: ptr.To("d")}},
+			required: []string{DNSWrite},
+			client:   &mockTokenClient{verify: verified, get: token(DNSWrite)},
+			missing:  true,
+			want:     want{created: true, condition: corev1.ConditionFalse},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created := false
+			c := &connecter{
+				ExternalConnecter: managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+					return managed.ExternalClientFns{
+						CreateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+							created = true
+							return managed.ExternalCreation{}, nil
+						},
+					}, nil
+				}),
+				required: tc.required,
+				config: func(ctx context.Context, mg resource.Managed) (*clients.Config, error) {
+					return &tc.cfg, nil
+				},
+				newClient: func(clients.Config) (TokenClient, error) { return tc.client, nil },
+				cache:     &cache{tokens: map[string]grant{}},
+			}
+
+			mg := &fake.Managed{}
+			if tc.missing {
+				mg.SetConditions(Missing([]string{DNSWrite}))
+			}
+			ec, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			_, err = ec.Create(context.Background(), mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want created, +got created:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, mg.GetCondition(TypeMissingPermissions).Status); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want condition status, +got condition status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -18,11 +18,15 @@ package security
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -35,40 +39,56 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	securityv1alpha1 "github.com/rossigee/provider-cloudflare/apis/security/v1alpha1"
-	providerv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	botmanagement "github.com/rossigee/provider-cloudflare/internal/clients/security/botmanagement"
 	ratelimit "github.com/rossigee/provider-cloudflare/internal/clients/security/ratelimit"
+	securityheader "github.com/rossigee/provider-cloudflare/internal/clients/security/securityheader"
 	turnstile "github.com/rossigee/provider-cloudflare/internal/clients/security/turnstile"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
 	errNotRateLimit       = "managed resource is not a RateLimit custom resource"
 	errNotBotManagement   = "managed resource is not a BotManagement custom resource"
 	errNotTurnstile       = "managed resource is not a Turnstile custom resource"
-	errTrackPCUsage       = "cannot track ProviderConfig usage"
-	errGetPC              = "cannot get ProviderConfig"
+	errNotSecurityHeader  = "managed resource is not a SecurityHeader custom resource"
 	errGetCreds           = "cannot get credentials"
 	errNewRateLimitClient = "cannot create new RateLimit client"
 	errNewBotMgmtClient   = "cannot create new BotManagement client"
 	errNewTurnstileClient = "cannot create new Turnstile client"
+	errNewSecHeaderClient = "cannot create new SecurityHeader client"
+
+	typeModeMigrationBlocked      rtv1.ConditionType   = "ModeMigrationBlocked"
+	reasonModeMigrationNotAllowed rtv1.ConditionReason = "ModeMigrationNotAllowed"
+	reasonModeUpToDate            rtv1.ConditionReason = "ModeUpToDate"
 )
 
 // SetupRateLimit adds a controller that reconciles RateLimit managed resources.
 func SetupRateLimit(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(securityv1alpha1.RateLimitKind)
+	name := managed.ControllerName(securityv1alpha1.RateLimitGroupKind.String())
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(securityv1alpha1.RateLimitGroupVersionKind),
-		managed.WithExternalConnecter(&rateLimitConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&rateLimitConnector{
 			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
 			newServiceFn: ratelimit.NewClientFromAPI,
-		}),
+			hc:           hc,
+		}), mgr.GetClient(), scopes.ZoneWAFWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -77,6 +97,7 @@ func SetupRateLimit(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLi
 			RateLimiter: nil, // Use default rate limiter
 		}).
 		For(&securityv1alpha1.RateLimit{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), securityv1alpha1.RateLimitGroupVersionKind)).
 		Complete(r)
 }
 
@@ -84,37 +105,26 @@ func SetupRateLimit(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLi
 // is called.
 type rateLimitConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
 	newServiceFn func(*cloudflare.API) *ratelimit.CloudflareRateLimitClient
+	hc           *http.Client
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient from the credentials of the managed
+// resource's ProviderConfig, resolved through any DefaultProviderConfig.
+// Resolving it also tracks the resource's usage of the ProviderConfig.
 func (c *rateLimitConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*securityv1alpha1.RateLimit)
+	_, ok := mg.(*securityv1alpha1.RateLimit)
 	if !ok {
 		return nil, errors.New(errNotRateLimit)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
-	}
-
-	pc := &providerv1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetCreds)
 	}
 
-	client, err := clients.NewClient(*config, nil)
+	client, err := clients.NewClient(*config, c.hc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewRateLimitClient)
 	}
@@ -215,19 +225,21 @@ func (c *rateLimitExternal) Disconnect(ctx context.Context) error {
 
 // SetupBotManagement adds a controller that reconciles BotManagement managed resources.
 func SetupBotManagement(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(securityv1alpha1.BotManagementKind)
+	name := managed.ControllerName(securityv1alpha1.BotManagementGroupKind.String())
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(securityv1alpha1.BotManagementGroupVersionKind),
-		managed.WithExternalConnecter(&botManagementConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&botManagementConnector{
 			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
 			newServiceFn: botmanagement.NewClientFromAPI,
-		}),
+			hc:           hc,
+		}), mgr.GetClient(), scopes.BotManagementWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -236,6 +248,7 @@ func SetupBotManagement(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRa
 			RateLimiter: nil, // Use default rate limiter
 		}).
 		For(&securityv1alpha1.BotManagement{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), securityv1alpha1.BotManagementGroupVersionKind)).
 		Complete(r)
 }
 
@@ -243,37 +256,26 @@ func SetupBotManagement(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRa
 // is called.
 type botManagementConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
 	newServiceFn func(*cloudflare.API) *botmanagement.CloudflareBotManagementClient
+	hc           *http.Client
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient from the credentials of the managed
+// resource's ProviderConfig, resolved through any DefaultProviderConfig.
+// Resolving it also tracks the resource's usage of the ProviderConfig.
 func (c *botManagementConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*securityv1alpha1.BotManagement)
+	_, ok := mg.(*securityv1alpha1.BotManagement)
 	if !ok {
 		return nil, errors.New(errNotBotManagement)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
-	}
-
-	pc := &providerv1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetCreds)
 	}
 
-	client, err := clients.NewClient(*config, nil)
+	client, err := clients.NewClient(*config, c.hc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewBotMgmtClient)
 	}
@@ -376,19 +378,22 @@ func (c *botManagementExternal) Disconnect(ctx context.Context) error {
 
 // SetupTurnstile adds a controller that reconciles Turnstile managed resources.
 func SetupTurnstile(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(securityv1alpha1.TurnstileKind)
+	name := managed.ControllerName(securityv1alpha1.TurnstileGroupKind.String())
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(securityv1alpha1.TurnstileGroupVersionKind),
-		managed.WithExternalConnecter(&turnstileConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&turnstileConnector{
 			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
 			newServiceFn: turnstile.NewClientFromAPI,
-		}),
+			hc:           hc,
+		}), mgr.GetClient(), scopes.TurnstileSitesWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), account.NewDefaulter(mgr.GetClient())),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -397,6 +402,7 @@ func SetupTurnstile(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLi
 			RateLimiter: nil, // Use default rate limiter
 		}).
 		For(&securityv1alpha1.Turnstile{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), securityv1alpha1.TurnstileGroupVersionKind)).
 		Complete(r)
 }
 
@@ -404,37 +410,26 @@ func SetupTurnstile(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLi
 // is called.
 type turnstileConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
 	newServiceFn func(*cloudflare.API) *turnstile.CloudflareTurnstileClient
+	hc           *http.Client
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient from the credentials of the managed
+// resource's ProviderConfig, resolved through any DefaultProviderConfig.
+// Resolving it also tracks the resource's usage of the ProviderConfig.
 func (c *turnstileConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*securityv1alpha1.Turnstile)
+	_, ok := mg.(*securityv1alpha1.Turnstile)
 	if !ok {
 		return nil, errors.New(errNotTurnstile)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
-	}
-
-	pc := &providerv1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetCreds)
 	}
 
-	client, err := clients.NewClient(*config, nil)
+	client, err := clients.NewClient(*config, c.hc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewTurnstileClient)
 	}
@@ -467,11 +462,12 @@ func (c *turnstileExternal) Observe(ctx context.Context, mg resource.Managed) (m
 			errors.Wrap(resource.Ignore(clients.IsNotFound, err), "cannot get external resource")
 	}
 
+	turnstile.RecordModeChange(&cr.Status.AtProvider, obs, metav1.Now())
 	cr.Status.AtProvider = *obs
 
 	cr.Status.SetConditions(rtv1.Available())
 
-	upToDate, err := c.service.IsUpToDate(ctx, cr.Spec.ForProvider, *obs)
+	upToDate, err := c.service.IsUpToDate(ctx, allowedTurnstileParameters(cr), *obs)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, "cannot determine if resource is up to date")
 	}
@@ -490,6 +486,19 @@ func (c *turnstileExternal) Create(ctx context.Context, mg resource.Managed) (ma
 
 	cr.Status.SetConditions(rtv1.Creating())
 
+	// Manage a matching hand-made widget rather than creating a duplicate.
+	if cr.Spec.ForProvider.AdoptExisting != nil && *cr.Spec.ForProvider.AdoptExisting {
+		existing, err := c.service.FindExisting(ctx, cr.Spec.ForProvider)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, "cannot look up existing external resource")
+		}
+		if existing != nil && existing.SiteKey != nil {
+			cr.Status.AtProvider = *existing
+			meta.SetExternalName(cr, *existing.SiteKey)
+			return managed.ExternalCreation{}, nil
+		}
+	}
+
 	obs, err := c.service.Create(ctx, cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create external resource")
@@ -509,16 +518,45 @@ func (c *turnstileExternal) Update(ctx context.Context, mg resource.Managed) (ma
 		return managed.ExternalUpdate{}, errors.New(errNotTurnstile)
 	}
 
-	obs, err := c.service.Update(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	obs, err := c.service.Update(ctx, meta.GetExternalName(cr), allowedTurnstileParameters(cr))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update external resource")
 	}
 
+	turnstile.RecordModeChange(&cr.Status.AtProvider, obs, metav1.Now())
 	cr.Status.AtProvider = *obs
 
 	return managed.ExternalUpdate{}, nil
 }
 
+// allowedTurnstileParameters returns the desired parameters of the supplied
+// widget, except that a change of its mode that is not allowed is left out
+// and reported by the ModeMigrationBlocked condition instead.
+func allowedTurnstileParameters(cr *securityv1alpha1.Turnstile) securityv1alpha1.TurnstileParameters {
+	p := cr.Spec.ForProvider
+	observed := cr.Status.AtProvider.Mode
+	if p.Mode == nil || observed == nil || turnstile.ModeTransitionAllowed(*observed, *p.Mode, p.AllowModeMigration) {
+		if cr.GetCondition(typeModeMigrationBlocked).Status == corev1.ConditionTrue {
+			cr.SetConditions(rtv1.Condition{
+				Type:               typeModeMigrationBlocked,
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: metav1.Now(),
+				Reason:             reasonModeUpToDate,
+			})
+		}
+		return p
+	}
+	cr.SetConditions(rtv1.Condition{
+		Type:               typeModeMigrationBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonModeMigrationNotAllowed,
+		Message:            fmt.Sprintf("mode changed from %q to %q, which changes how the widget is embedded in pages; set allowModeMigration to true to apply it", *observed, *p.Mode),
+	})
+	p.Mode = ptr.To(*observed)
+	return p
+}
+
 func (c *turnstileExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	cr, ok := mg.(*securityv1alpha1.Turnstile)
 	if !ok {
@@ -535,6 +573,152 @@ func (c *turnstileExternal) Disconnect(ctx context.Context) error {
 	return nil
 }
 
+// SetupSecurityHeader adds a controller that reconciles SecurityHeader managed resources.
+func SetupSecurityHeader(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(securityv1alpha1.SecurityHeaderGroupKind.String())
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(securityv1alpha1.SecurityHeaderGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&securityHeaderConnector{
+			kube:         mgr.GetClient(),
+			newServiceFn: securityheader.NewClientFromAPI,
+			hc:           hc,
+		}), mgr.GetClient(), scopes.ZoneSettingsWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: nil, // Use default rate limiter
+		}).
+		For(&securityv1alpha1.SecurityHeader{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), securityv1alpha1.SecurityHeaderGroupVersionKind)).
+		Complete(r)
+}
+
+// A securityHeaderConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type securityHeaderConnector struct {
+	kube         client.Client
+	newServiceFn func(*cloudflare.API) *securityheader.CloudflareSecurityHeaderClient
+	hc           *http.Client
+}
+
+// Connect produces an ExternalClient for the security header settings of
+// the zone referenced by a SecurityHeader.
+func (c *securityHeaderConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*securityv1alpha1.SecurityHeader); !ok {
+		return nil, errors.New(errNotSecurityHeader)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	client, err := clients.NewClient(*config, c.hc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewSecHeaderClient)
+	}
+
+	return &securityHeaderExternal{service: c.newServiceFn(client)}, nil
+}
+
+// A securityHeaderExternal observes, then updates the security header
+// settings of a zone to ensure they reflect the managed resource's desired
+// state.
+type securityHeaderExternal struct {
+	service *securityheader.CloudflareSecurityHeaderClient
+}
+
+func (c *securityHeaderExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*securityv1alpha1.SecurityHeader)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSecurityHeader)
+	}
+
+	// The security header settings exist for as long as the zone does, so
+	// they are only considered to exist once they have been applied.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	obs, err := c.service.Get(ctx, cr.Spec.ForProvider.Zone)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot get external resource")
+	}
+
+	cr.Status.AtProvider = *obs
+
+	// Once HSTS has been disabled by Delete there is nothing left to delete.
+	if meta.WasDeleted(cr) && !ptr.Deref(obs.Enabled, false) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: securityheader.IsUpToDate(cr.Spec.ForProvider, *obs),
+	}, nil
+}
+
+func (c *securityHeaderExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*securityv1alpha1.SecurityHeader)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSecurityHeader)
+	}
+
+	cr.Status.SetConditions(rtv1.Creating())
+
+	obs, err := c.service.Update(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create external resource")
+	}
+
+	cr.Status.AtProvider = *obs
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Zone)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *securityHeaderExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*securityv1alpha1.SecurityHeader)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSecurityHeader)
+	}
+
+	obs, err := c.service.Update(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update external resource")
+	}
+
+	cr.Status.AtProvider = *obs
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *securityHeaderExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*securityv1alpha1.SecurityHeader)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotSecurityHeader)
+	}
+
+	cr.Status.SetConditions(rtv1.Deleting())
+
+	// The settings cannot be deleted, so HSTS is disabled instead.
+	err := c.service.Reset(ctx, cr.Spec.ForProvider.Zone)
+	return managed.ExternalDelete{}, errors.Wrap(err, "cannot delete external resource")
+}
+
+func (c *securityHeaderExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
+
 // Setup adds controllers for Security resources.
 func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
 	if err := SetupRateLimit(mgr, l, rl); err != nil {
@@ -543,5 +727,8 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any
 	if err := SetupBotManagement(mgr, l, rl); err != nil {
 		return err
 	}
-	return SetupTurnstile(mgr, l, rl)
+	if err := SetupTurnstile(mgr, l, rl); err != nil {
+		return err
+	}
+	return SetupSecurityHeader(mgr, l, rl)
 }
\ No newline at end of file
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package settle tolerates external resources that the Cloudflare API does
+// not find for a few seconds after they were created.
+package settle
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	// Window is how long after an external resource was created it is
+	// expected to become visible. Until then it is never reported as
+	// missing, so that it is not created again.
+	Window = 2 * time.Minute
+
+	// attempts is how many times a missing resource is observed again
+	// before giving up until the next reconcile.
+	attempts = 3
+
+	// backoff is the delay before observing a missing resource again,
+	// doubling with each attempt.
+	backoff = time.Second
+
+	errNotVisible = "external resource was created but is not visible yet"
+)
+
+// NewConnecter wraps the supplied ExternalConnecter so that the clients it
+// produces observe an external resource again, a bounded number of times,
+// when it is not found shortly after it was created. A resource that is
+// still not found is reported as an error rather than as missing until the
+// Window has passed.
+func NewConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c, backoff: backoff}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	backoff time.Duration
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec, backoff: c.backoff}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	backoff time.Duration
+}
+
+// settling returns true if the supplied managed resource was created
+// recently, yet the supplied observation did not find it.
+func settling(mg resource.Managed, o managed.ExternalObservation, err error) bool {
+	if meta.WasDeleted(mg) || !meta.ExternalCreateSucceededDuring(mg, Window) {
+		return false
+	}
+	if err != nil {
+		return clients.IsNotFound(err)
+	}
+	return !o.ResourceExists
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	d := e.backoff
+	for i := 0; i < attempts && settling(mg, o, err); i++ {
+		select {
+		case <-ctx.Done():
+			return managed.ExternalObservation{}, ctx.Err()
+		case <-time.After(d):
+		}
+		d *= 2
+		o, err = e.ExternalClient.Observe(ctx, mg)
+	}
+	if settling(mg, o, err) {
+		return managed.ExternalObservation{}, errors.New(errNotVisible)
+	}
+	return o, err
+}
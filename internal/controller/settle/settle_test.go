@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package settle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	errNotFound := errors.New("custom hostname not found")
+
+	type want struct {
+		obs   managed.ExternalObservation
+		err   error
+		calls int
+	}
+
+	cases := map[string]struct {
+		reason  string
+		created time.Time
+		deleted bool
+		results []error
+		exists  []bool
+		want    want
+	}{
+		"NotCreated": {
+			reason: "A resource that was never created should be reported as missing without observing it again",
+			exists: []bool{false},
+			want:   want{calls: 1},
+		},
+		"CreatedLongAgo": {
+			reason:  "A resource created before the window should be reported as missing without observing it again",
+			created: time.Now().Add(-2 * Window),
+			exists:  []bool{false},
+			want:    want{calls: 1},
+		},
+		"Exists": {
+			reason:  "A recently created resource that was found should be observed once",
+			created: time.Now(),
+			exists:  []bool{true},
+			want:    want{obs: managed.ExternalObservation{ResourceExists: true}, calls: 1},
+		},
+		"BecomesVisible": {
+			reason:  "A recently created resource that was not found should be observed again until it is",
+			created: time.Now(),
+			exists:  []bool{false, false, true},
+			want:    want{obs: managed.ExternalObservation{ResourceExists: true}, calls: 3},
+		},
+		"BecomesVisibleAfterNotFoundError": {
+			reason:  "A not found error should be treated like a missing resource",
+			created: time.Now(),
+			results: []error{errNotFound, nil},
+			exists:  []bool{false, true},
+			want:    want{obs: managed.ExternalObservation{ResourceExists: true}, calls: 2},
+		},
+		"NotVisible": {
+			reason:  "A recently created resource that is still not found should be reported as an error rather than as missing",
+			created: time.Now(),
+			exists:  []bool{false, false, false, false},
+			want:    want{err: errors.New(errNotVisible), calls: attempts + 1},
+		},
+		"OtherError": {
+			reason:  "Other errors should be returned without observing the resource again",
+			created: time.Now(),
+			results: []error{errBoom},
+			exists:  []bool{false},
+			want:    want{err: errBoom, calls: 1},
+		},
+		"Deleted": {
+			reason:  "A resource being deleted should be reported as missing without observing it again",
+			created: time.Now(),
+			deleted: true,
+			exists:  []bool{false},
+			want:    want{calls: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			c := &connecter{
+				ExternalConnecter: managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+					return managed.ExternalClientFns{
+						ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+							i := calls
+							calls++
+							var err error
+							if i < len(tc.results) {
+								err = tc.results[i]
+							}
+							return managed.ExternalObservation{ResourceExists: tc.exists[i]}, err
+						},
+					}, nil
+				}),
+			}
+
+			mg := &fake.Managed{}
+			if !tc.created.IsZero() {
+				meta.SetExternalCreateSucceeded(mg, tc.created)
+			}
+			if tc.deleted {
+				now := metav1.Now()
+				mg.SetDeletionTimestamp(&now)
+			}
+
+			ec, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			got, err := ec.Observe(context.Background(), mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,7 +36,17 @@ import (
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/notifications"
 	"github.com/rossigee/provider-cloudflare/internal/clients/ssl/certificatepack"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -44,11 +55,15 @@ const (
 	errGetPCCert          = "cannot get ProviderConfig"
 	errGetCredsCert       = "cannot get credentials"
 	errNewClientCert      = "cannot create new Service"
+
+	errGetExpiryAlert    = "cannot get expiry alert of Certificate Pack"
+	errUpdateExpiryAlert = "cannot update expiry alert of Certificate Pack"
+	errDeleteExpiryAlert = "cannot delete expiry alert of Certificate Pack"
 )
 
 // SetupCertificatePackController adds a controller that reconciles Certificate Pack managed resources.
 func SetupCertificatePackController(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(v1alpha1.CertificatePackKind)
+	name := managed.ControllerName(v1alpha1.CertificatePackGroupKind.String())
 
 	o := controller.Options{
 		RateLimiter: nil, // Use default rate limiter
@@ -56,22 +71,25 @@ func SetupCertificatePackController(mgr ctrl.Manager, l logging.Logger, rl workq
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CertificatePackGroupVersionKind),
-		managed.WithExternalConnecter(&certificatePackConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&certificatePackConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
-				return clients.NewClient(cfg, nil)
+				return clients.NewClient(cfg, hc)
 			},
-		}),
+		}), mgr.GetClient(), scopes.SSLAndCertificatesWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o).
 		For(&v1alpha1.CertificatePack{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.CertificatePackGroupVersionKind)).
 		Complete(r)
 }
 
@@ -105,7 +123,7 @@ func (c *certificatePackConnector) Connect(ctx context.Context, mg resource.Mana
 
 	service := certificatepack.NewClient(cloudflareClient)
 
-	return &certificatePackExternal{service: service}, nil
+	return &certificatePackExternal{service: service, notifications: cloudflareClient}, nil
 }
 
 // An certificatePackExternal observes, then either creates, updates, or deletes an
@@ -114,6 +132,9 @@ type certificatePackExternal struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	service *certificatepack.CloudflareCertificatePackClient
+
+	// notifications manages the expiry alert of the Certificate Pack.
+	notifications notifications.Client
 }
 
 func (c *certificatePackExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -136,16 +157,70 @@ func (c *certificatePackExternal) Observe(ctx context.Context, mg resource.Manag
 		return managed.ExternalObservation{}, errors.Wrap(err, "failed to get Certificate Pack")
 	}
 
+	alert := cr.Status.AtProvider.ExpiryAlert
 	cr.Status.AtProvider = *observation
+	cr.Status.AtProvider.ExpiryAlert = alert
 
-	cr.Status.SetConditions(rtv1.Available())
+	cr.Status.SetConditions(rtv1.Available(), certificatepack.IssuanceCondition(*observation))
+
+	// Certificate packs don't have updatable parameters after creation, so
+	// only their expiry alert can be outdated.
+	upToDate, err := c.observeExpiryAlert(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetExpiryAlert)
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: true, // Certificate packs don't have updatable parameters after creation
+		ResourceUpToDate: upToDate,
 	}, nil
 }
 
+// observeExpiryAlert records the notification policy of the expiry alert of
+// a Certificate Pack, and returns whether it is up to date. A policy that is
+// no longer wanted, or belongs to another account, is outdated.
+func (c *certificatePackExternal) observeExpiryAlert(ctx context.Context, cr *v1alpha1.CertificatePack) (bool, error) {
+	a := cr.Spec.ForProvider.ExpiryAlert
+	o := cr.Status.AtProvider.ExpiryAlert
+	if a == nil {
+		return o == nil, nil
+	}
+	if o != nil && o.AccountID != a.AccountID {
+		return false, nil
+	}
+
+	want := certificatepack.ExpiryAlertPolicy(cr.GetName(), cr.Spec.ForProvider)
+	np, err := notifications.Get(ctx, c.notifications, want.AccountID, want.Owner)
+	if err != nil || np == nil {
+		return false, err
+	}
+	cr.Status.AtProvider.ExpiryAlert = &v1alpha1.CertificatePackExpiryAlertObservation{AccountID: want.AccountID, PolicyID: np.ID}
+	return notifications.UpToDate(want, *np), nil
+}
+
+// updateExpiryAlert creates or updates the notification policy of the
+// expiry alert of a Certificate Pack, deleting one that is no longer wanted
+// or belongs to another account.
+func (c *certificatePackExternal) updateExpiryAlert(ctx context.Context, cr *v1alpha1.CertificatePack) error {
+	a := cr.Spec.ForProvider.ExpiryAlert
+	if o := cr.Status.AtProvider.ExpiryAlert; o != nil && (a == nil || o.AccountID != a.AccountID) {
+		if err := notifications.Delete(ctx, c.notifications, o.AccountID, certificatepack.ExpiryAlertOwner(cr.GetName())); err != nil {
+			return errors.Wrap(err, errDeleteExpiryAlert)
+		}
+		cr.Status.AtProvider.ExpiryAlert = nil
+	}
+	if a == nil {
+		return nil
+	}
+
+	id, err := notifications.Ensure(ctx, c.notifications, certificatepack.ExpiryAlertPolicy(cr.GetName(), cr.Spec.ForProvider))
+	if err != nil {
+		return errors.Wrap(err, errUpdateExpiryAlert)
+	}
+	cr.Status.AtProvider.ExpiryAlert = &v1alpha1.CertificatePackExpiryAlertObservation{AccountID: a.AccountID, PolicyID: id}
+	return nil
+}
+
 func (c *certificatePackExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.CertificatePack)
 	if !ok {
@@ -182,10 +257,12 @@ func (c *certificatePackExternal) Update(ctx context.Context, mg resource.Manage
 			return managed.ExternalUpdate{}, errors.Wrap(err, "failed to restart certificate validation")
 		}
 
+		alert := cr.Status.AtProvider.ExpiryAlert
 		cr.Status.AtProvider = *observation
+		cr.Status.AtProvider.ExpiryAlert = alert
 	}
 
-	return managed.ExternalUpdate{}, nil
+	return managed.ExternalUpdate{}, c.updateExpiryAlert(ctx, cr)
 }
 
 func (c *certificatePackExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -196,6 +273,12 @@ func (c *certificatePackExternal) Delete(ctx context.Context, mg resource.Manage
 
 	cr.Status.SetConditions(rtv1.Deleting())
 
+	// The expiry alert is deleted first, since it cannot be found again
+	// once the Certificate Pack is gone.
+	if err := c.deleteExpiryAlert(ctx, cr); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteExpiryAlert)
+	}
+
 	err := c.service.Delete(ctx, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalDelete{}, errors.Wrap(err, "failed to delete Certificate Pack")
@@ -204,6 +287,22 @@ func (c *certificatePackExternal) Delete(ctx context.Context, mg resource.Manage
 	return managed.ExternalDelete{}, nil
 }
 
+// deleteExpiryAlert deletes the notification policy of the expiry alert of a
+// Certificate Pack, if it has one.
+func (c *certificatePackExternal) deleteExpiryAlert(ctx context.Context, cr *v1alpha1.CertificatePack) error {
+	accountID := ""
+	if a := cr.Spec.ForProvider.ExpiryAlert; a != nil {
+		accountID = a.AccountID
+	}
+	if o := cr.Status.AtProvider.ExpiryAlert; o != nil {
+		accountID = o.AccountID
+	}
+	if accountID == "" {
+		return nil
+	}
+	return notifications.Delete(ctx, c.notifications, accountID, certificatepack.ExpiryAlertOwner(cr.GetName()))
+}
+
 func (c *certificatePackExternal) Disconnect(ctx context.Context) error {
 	// No persistent connections to clean up
 	return nil
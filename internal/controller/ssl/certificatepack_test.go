@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/ssl/v1alpha1"
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+// TestCertificatePackSpans shows that the Cloudflare API calls of a
+// Certificate Pack are traced through the HTTP client it is set up with.
+func TestCertificatePackSpans(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"pack","type":"advanced","hosts":["example.com"],"status":"active"}}`))
+	}))
+	defer srv.Close()
+
+	hc := metrics.NewInstrumentedHTTPClient(managed.ControllerName(v1alpha1.CertificatePackGroupKind.String()))
+	c := &certificatePackConnector{
+		kube: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				switch o := obj.(type) {
+				case *pcv1alpha1.ProviderConfig:
+					o.Spec.Credentials.Source = "Secret"
+					o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{Key: "creds"}
+				case *corev1.Secret:
+					o.Data = map[string][]byte{"creds": []byte("token")}
+				}
+				return nil
+			}),
+		},
+		newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
+			api, err := clients.NewClient(cfg, hc)
+			if err != nil {
+				return nil, err
+			}
+			api.BaseURL = srv.URL
+			return api, nil
+		},
+	}
+
+	cr := &v1alpha1.CertificatePack{
+		Spec: v1alpha1.CertificatePackSpec{
+			ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "example"}},
+			ForProvider:  v1alpha1.CertificatePackParameters{Zone: "zone"},
+		},
+	}
+	meta.SetExternalName(cr, "pack")
+
+	e, err := c.Connect(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+
+	var got []string
+	for _, s := range sr.Ended() {
+		got = append(got, s.Name())
+	}
+	if diff := cmp.Diff([]string{"GET /zones/zone/ssl/certificate_packs/pack"}, got); diff != "" {
+		t.Errorf("Observe(...): the API call should be traced: -want spans, +got spans:\n%s\n", diff)
+	}
+}
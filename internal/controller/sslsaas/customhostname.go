@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,7 +37,16 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/sslsaas/v1alpha1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 	customhostname "github.com/rossigee/provider-cloudflare/internal/clients/sslsaas/customhostname"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	"github.com/rossigee/provider-cloudflare/internal/controller/settle"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -67,16 +77,17 @@ func SetupCustomHostname(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedR
 	}
 
 	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CustomHostnameGroupVersionKind),
-		managed.WithExternalConnecter(&customHostnameConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(settle.NewConnecter(&customHostnameConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (customhostname.Client, error) {
 				return customhostname.NewClient(cfg, hc)
 			},
-		}),
+		})), mgr.GetClient(), scopes.SSLAndCertificatesWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithPollInterval(5*time.Minute),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
@@ -86,6 +97,7 @@ func SetupCustomHostname(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedR
 		Named(name).
 		WithOptions(o).
 		For(&v1alpha1.CustomHostname{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.CustomHostnameGroupVersionKind)).
 		Complete(r)
 }
 
@@ -159,12 +171,13 @@ func (e *customHostnameExternal) Observe(ctx context.Context, mg resource.Manage
 	// Cloudflare can accept traffic for it on any port (in this case
 	// 80/http). 443/https traffic would receive a certificate error
 	// until cr.Status.AtProvider.SSL.Status returns ready as well.
-	// If this is necessary, both statuses can be checked by using a
-	// readinessCheck in a Composition.
+	// If this is necessary, the Issued condition can be checked by using
+	// a readinessCheck in a Composition.
 
 	if cr.Status.AtProvider.Status == customHostnameStatusActive {
 		cr.Status.SetConditions(rtv1.Available())
 	}
+	cr.Status.SetConditions(customhostname.IssuanceCondition(cr.Status.AtProvider.SSL))
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
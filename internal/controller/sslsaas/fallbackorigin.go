@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,7 +37,15 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/sslsaas/v1alpha1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 	fallbackorigin "github.com/rossigee/provider-cloudflare/internal/clients/sslsaas/fallbackorigin"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -60,16 +69,17 @@ func SetupFallbackOrigin(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedR
 	}
 
 	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.FallbackOriginGroupVersionKind),
-		managed.WithExternalConnecter(&fallbackOriginConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&fallbackOriginConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (fallbackorigin.Client, error) {
 				return fallbackorigin.NewClient(cfg, hc)
 			},
-		}),
+		}), mgr.GetClient(), scopes.SSLAndCertificatesWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithPollInterval(5*time.Minute),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
@@ -79,6 +89,7 @@ func SetupFallbackOrigin(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedR
 		Named(name).
 		WithOptions(o).
 		For(&v1alpha1.FallbackOrigin{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.FallbackOriginGroupVersionKind)).
 		Complete(r)
 }
 
@@ -140,7 +151,7 @@ func (e *fallbackOriginExternal) Observe(ctx context.Context, mg resource.Manage
 	}
 
 	cr.Status.AtProvider = fallbackorigin.GenerateObservation(origin)
-	cr.SetConditions(rtv1.Available())
+	cr.SetConditions(fallbackorigin.ActivationCondition(origin))
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
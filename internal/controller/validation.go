@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	firewallv1alpha1 "github.com/rossigee/provider-cloudflare/apis/firewall/v1alpha1"
+	rulesetsv1alpha1 "github.com/rossigee/provider-cloudflare/apis/rulesets/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/expression"
+)
+
+const errUnexpectedObject = "unexpected object type"
+
+// validateExpression returns a field error if the supplied Cloudflare Rules
+// language expression is not well formed.
+func validateExpression(path *field.Path, expr string) *field.Error {
+	if err := expression.Validate(expr); err != nil {
+		return field.Invalid(path, expr, err.Error())
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rulesets-cloudflare-crossplane-io-v1alpha1-ruleset,mutating=false,failurePolicy=fail,groups=rulesets.cloudflare.crossplane.io,resources=rulesets,versions=v1alpha1,name=rulesets.rulesets.cloudflare.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// A rulesetValidator rejects Rulesets with malformed rule expressions.
+type rulesetValidator struct{}
+
+func (v *rulesetValidator) validate(obj runtime.Object) (admission.Warnings, error) {
+	rs, ok := obj.(*rulesetsv1alpha1.Ruleset)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	var errs field.ErrorList
+	rules := field.NewPath("spec", "forProvider", "rules")
+	for i, r := range rs.Spec.ForProvider.Rules {
+		if err := validateExpression(rules.Index(i).Child("expression"), r.Expression); err != nil {
+			errs = append(errs, err)
+		}
+		if r.RateLimit != nil && r.RateLimit.CountingExpression != nil && *r.RateLimit.CountingExpression != "" {
+			if err := validateExpression(rules.Index(i).Child("rateLimit", "countingExpression"), *r.RateLimit.CountingExpression); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(rulesetsv1alpha1.RulesetGroupVersionKind.GroupKind(), rs.GetName(), errs)
+	}
+	return nil, nil
+}
+
+func (v *rulesetValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(obj)
+}
+
+func (v *rulesetValidator) ValidateUpdate(_ context.Context, _, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(obj)
+}
+
+func (v *rulesetValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-firewall-cloudflare-crossplane-io-v1alpha1-filter,mutating=false,failurePolicy=fail,groups=firewall.cloudflare.crossplane.io,resources=filters,versions=v1alpha1,name=filters.firewall.cloudflare.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// A filterValidator rejects firewall Filters with malformed expressions.
+type filterValidator struct{}
+
+func (v *filterValidator) validate(obj runtime.Object) (admission.Warnings, error) {
+	f, ok := obj.(*firewallv1alpha1.Filter)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	if err := validateExpression(field.NewPath("spec", "forProvider", "expression"), f.Spec.ForProvider.Expression); err != nil {
+		return nil, kerrors.NewInvalid(firewallv1alpha1.FilterGroupVersionKind.GroupKind(), f.GetName(), field.ErrorList{err})
+	}
+	return nil, nil
+}
+
+func (v *filterValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(obj)
+}
+
+func (v *filterValidator) ValidateUpdate(_ context.Context, _, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(obj)
+}
+
+func (v *filterValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	firewallv1alpha1 "github.com/rossigee/provider-cloudflare/apis/firewall/v1alpha1"
+	rulesetsv1alpha1 "github.com/rossigee/provider-cloudflare/apis/rulesets/v1alpha1"
+)
+
+func TestValidators(t *testing.T) {
+	ruleset := func(rules ...rulesetsv1alpha1.RulesetRule) runtime.Object {
+		rs := &rulesetsv1alpha1.Ruleset{}
+		rs.Spec.ForProvider.Rules = rules
+		return rs
+	}
+	filter := func(expr string) runtime.Object {
+		f := &firewallv1alpha1.Filter{}
+		f.Spec.ForProvider.Expression = expr
+		return f
+	}
+
+	cases := map[string]struct {
+		reason  string
+		obj     runtime.Object
+		wantErr string
+	}{
+		"ValidRuleset": {
+			reason: "A Ruleset with well formed expressions should be admitted",
+			obj: ruleset(
+				rulesetsv1alpha1.RulesetRule{Action: "block", Expression: `ip.src in {192.0.2.0/24}`},
+				rulesetsv1alpha1.RulesetRule{
+					Action:     "block",
+					Expression: `http.request.uri.path eq "/login"`,
+					RateLimit:  &rulesetsv1alpha1.RulesetRuleRateLimit{CountingExpression: ptr.To(`http.response.code eq 401`)},
+				},
+			),
+		},
+		"InvalidRuleExpression": {
+			reason:  "A Ruleset with a malformed rule expression should be rejected with the position of the error",
+			obj:     ruleset(rulesetsv1alpha1.RulesetRule{Action: "block", Expression: `http.host eq`}),
+			wantErr: `Ruleset.rulesets.cloudflare.crossplane.io "" is invalid: spec.forProvider.rules[0].expression: Invalid value: "http.host eq": line 1, column 13: unexpected end of expression, expected a field, function or value`,
+		},
+		"InvalidCountingExpression": {
+			reason: "A Ruleset with a malformed counting expression should be rejected",
+			obj: ruleset(rulesetsv1alpha1.RulesetRule{
+				Action:     "block",
+				Expression: "ssl",
+				RateLimit:  &rulesetsv1alpha1.RulesetRuleRateLimit{CountingExpression: ptr.To(`(ssl`)},
+			}),
+			wantErr: `Ruleset.rulesets.cloudflare.crossplane.io "" is invalid: spec.forProvider.rules[0].rateLimit.countingExpression: Invalid value: "(ssl": line 1, column 5: expected ")", found end of expression`,
+		},
+		"ValidFilter": {
+			reason: "A Filter with a well formed expression should be admitted",
+			obj:    filter(`not ssl`),
+		},
+		"InvalidFilter": {
+			reason:  "A Filter with a malformed expression should be rejected",
+			obj:     filter(`ssl and or`),
+			wantErr: `Filter.firewall.cloudflare.crossplane.io "" is invalid: spec.forProvider.expression: Invalid value: "ssl and or": line 1, column 9: unexpected "or", expected a field, function or value`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var err error
+			switch tc.obj.(type) {
+			case *rulesetsv1alpha1.Ruleset:
+				_, err = (&rulesetValidator{}).ValidateCreate(context.Background(), tc.obj)
+			case *firewallv1alpha1.Filter:
+				_, err = (&filterValidator{}).ValidateUpdate(context.Background(), nil, tc.obj)
+			}
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tc.wantErr {
+				t.Errorf("\n%s\nValidate(...): want error %q, got %q", tc.reason, tc.wantErr, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	firewallv1alpha1 "github.com/rossigee/provider-cloudflare/apis/firewall/v1alpha1"
+	rulesetsv1alpha1 "github.com/rossigee/provider-cloudflare/apis/rulesets/v1alpha1"
+	workersv1beta1 "github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
+	zonev1beta1 "github.com/rossigee/provider-cloudflare/apis/zone/v1beta1"
+)
+
+// Convertible returns the resources that are served at more than one API
+// version and so need the conversion webhook.
+func Convertible() []client.Object {
+	return []client.Object{
+		&dnsv1beta1.Record{},
+		&zonev1beta1.Zone{},
+		&workersv1beta1.Route{},
+	}
+}
+
+// SetupWebhooks registers the conversion webhook for all resources that are
+// served at more than one API version, and the validating webhooks that
+// check rule expressions, with the supplied manager.
+func SetupWebhooks(mgr ctrl.Manager) error {
+	for _, o := range Convertible() {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(o).Complete(); err != nil {
+			return err
+		}
+	}
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&rulesetsv1alpha1.Ruleset{}).WithValidator(&rulesetValidator{}).Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).For(&firewallv1alpha1.Filter{}).WithValidator(&filterValidator{}).Complete()
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	fuzz "github.com/google/gofuzz"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	webhookconversion "sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/rossigee/provider-cloudflare/apis"
+	dnsv1alpha1 "github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	workersv1alpha1 "github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+	workersv1beta1 "github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
+	zonev1alpha1 "github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	zonev1beta1 "github.com/rossigee/provider-cloudflare/apis/zone/v1beta1"
+)
+
+func TestConvertible(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %v", err)
+	}
+
+	for _, o := range Convertible() {
+		ok, err := webhookconversion.IsConvertible(s, o)
+		if err != nil || !ok {
+			t.Errorf("IsConvertible(%T): want true, got %t, %v", o, ok, err)
+		}
+	}
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	f := fuzz.New().NilChance(0.2).Funcs(
+		// Times are serialised with second precision.
+		func(t *metav1.Time, c fuzz.Continue) { *t = metav1.Unix(c.Int63n(1<<32), 0) },
+	)
+
+	cases := map[string]struct {
+		reason string
+		hub    func() conversion.Hub
+		spoke  func() conversion.Convertible
+	}{
+		"Record": {
+			reason: "A v1alpha1 Record should survive conversion to v1beta1 and back",
+			hub:    func() conversion.Hub { return &dnsv1alpha1.Record{} },
+			spoke:  func() conversion.Convertible { return &dnsv1beta1.Record{} },
+		},
+		"Zone": {
+			reason: "A v1alpha1 Zone should survive conversion to v1beta1 and back",
+			hub:    func() conversion.Hub { return &zonev1alpha1.Zone{} },
+			spoke:  func() conversion.Convertible { return &zonev1beta1.Zone{} },
+		},
+		"Route": {
+			reason: "A v1alpha1 Route should survive conversion to v1beta1 and back",
+			hub:    func() conversion.Hub { return &workersv1alpha1.Route{} },
+			spoke:  func() conversion.Convertible { return &workersv1beta1.Route{} },
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				in := tc.hub()
+				f.Fuzz(in)
+				// Object metadata is not versioned, and type metadata is
+				// set by the caller.
+				v := reflect.ValueOf(in).Elem()
+				v.FieldByName("TypeMeta").SetZero()
+				v.FieldByName("ObjectMeta").SetZero()
+
+				spoke := tc.spoke()
+				if err := spoke.ConvertFrom(in); err != nil {
+					t.Fatalf("\n%s\nConvertFrom(...): %v", tc.reason, err)
+				}
+				out := tc.hub()
+				if err := spoke.ConvertTo(out); err != nil {
+					t.Fatalf("\n%s\nConvertTo(...): %v", tc.reason, err)
+				}
+				if diff := cmp.Diff(in, out); diff != "" {
+					t.Fatalf("\n%s\nConvertTo(ConvertFrom(...)): -want, +got:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
@@ -18,10 +18,12 @@ package workers
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,7 +39,15 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	crontriggerclient "github.com/rossigee/provider-cloudflare/internal/clients/workers/crontrigger"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -63,16 +73,17 @@ func SetupCronTrigger(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRate
 	}
 
 	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CronTriggerGroupVersionKind),
-		managed.WithExternalConnecter(&cronTriggerConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&cronTriggerConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
 				return clients.NewClient(cfg, hc)
 			},
-		}),
+		}), mgr.GetClient(), scopes.WorkersScriptsWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithPollInterval(5*time.Minute),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
@@ -82,6 +93,7 @@ func SetupCronTrigger(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRate
 		Named(name).
 		WithOptions(o).
 		For(&v1alpha1.CronTrigger{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.CronTriggerGroupVersionKind)).
 		Complete(r)
 }
 
@@ -136,25 +148,57 @@ func (c *cronTriggerExternal) Observe(ctx context.Context, mg resource.Managed)
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	// For cron triggers, we identify them by script name + cron expression
+	// For cron triggers, we identify them by script name + cron expression.
+	// The trigger is looked up by its desired schedule, then by the one it
+	// was last observed or created with, so that changing the expression or
+	// timezone updates the trigger rather than adding another.
 	scriptName := cr.Spec.ForProvider.ScriptName
-	cronExpression := cr.Spec.ForProvider.Cron
-	
-	observation, err := c.client.Get(ctx, scriptName, cronExpression)
+	desired, err := crontriggerclient.Schedule(cr.Spec.ForProvider, time.Now())
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCronTriggerLookup)
+	}
+
+	triggers, err := c.client.List(ctx, scriptName)
 	if err != nil {
 		return managed.ExternalObservation{},
 			errors.Wrap(resource.Ignore(crontriggerclient.IsCronTriggerNotFound, err), errCronTriggerLookup)
 	}
 
+	observation := findCronTrigger(triggers, desired, cr.Status.AtProvider.Cron, strings.TrimPrefix(triggerID, scriptName+":"))
+	if observation == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	upToDate, err := c.client.IsUpToDate(ctx, cr.Spec.ForProvider, *observation)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCronTriggerLookup)
+	}
+
 	cr.Status.AtProvider = *observation
 	cr.SetConditions(rtv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: observation.Cron == cronExpression && observation.ScriptName == scriptName,
+		ResourceUpToDate: upToDate,
 	}, nil
 }
 
+// findCronTrigger returns the first of the supplied triggers whose schedule
+// is equivalent to one of the supplied cron expressions, in order.
+func findCronTrigger(triggers []v1alpha1.CronTriggerObservation, crons ...string) *v1alpha1.CronTriggerObservation {
+	for _, cron := range crons {
+		if cron == "" {
+			continue
+		}
+		for i := range triggers {
+			if crontriggerclient.Equal(triggers[i].Cron, cron) {
+				return &triggers[i]
+			}
+		}
+	}
+	return nil
+}
+
 func (c *cronTriggerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.CronTrigger)
 	if !ok {
@@ -169,7 +213,7 @@ func (c *cronTriggerExternal) Create(ctx context.Context, mg resource.Managed) (
 	}
 
 	// Update the external name with a unique ID for this cron trigger
-	meta.SetExternalName(cr, cr.Spec.ForProvider.ScriptName+":"+cr.Spec.ForProvider.Cron)
+	meta.SetExternalName(cr, cr.Spec.ForProvider.ScriptName+":"+observation.Cron)
 	cr.Status.AtProvider = *observation
 
 	return managed.ExternalCreation{}, nil
@@ -204,8 +248,16 @@ func (c *cronTriggerExternal) Delete(ctx context.Context, mg resource.Managed) (
 		return managed.ExternalDelete{}, errors.New(errNotCronTrigger)
 	}
 
+	// Delete the trigger Observe found, which may not yet have the desired
+	// schedule.
 	scriptName := cr.Spec.ForProvider.ScriptName
-	cronExpression := cr.Spec.ForProvider.Cron
+	cronExpression := cr.Status.AtProvider.Cron
+	if cronExpression == "" {
+		var err error
+		if cronExpression, err = crontriggerclient.Schedule(cr.Spec.ForProvider, time.Now()); err != nil {
+			return managed.ExternalDelete{}, errors.Wrap(err, errCronTriggerDeletion)
+		}
+	}
 
 	err := c.client.Delete(ctx, scriptName, cronExpression)
 	if err != nil && !crontriggerclient.IsCronTriggerNotFound(err) {
@@ -18,11 +18,14 @@ package workers
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -38,31 +41,47 @@ import (
 	providerv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	domain "github.com/rossigee/provider-cloudflare/internal/clients/workers/domain"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	"github.com/rossigee/provider-cloudflare/internal/controller/settle"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
 	errNotDomain           = "managed resource is not a Domain custom resource"
-	errTrackPCUsageDomain  = "cannot track ProviderConfig usage"
-	errGetPCDomain         = "cannot get ProviderConfig"
 	errGetCredsDomain      = "cannot get credentials"
 	errNewDomainClient     = "cannot create new Domain client"
+
+	reasonAdoptedDomain   event.Reason = "AdoptedExistingDomain"
+	reasonReadoptedDomain event.Reason = "ReadoptedDomain"
 )
 
 // SetupDomain adds a controller that reconciles Domain managed resources.
 func SetupDomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(workersv1alpha1.DomainKind)
+	name := managed.ControllerName(workersv1alpha1.DomainGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(workersv1alpha1.DomainGroupVersionKind),
-		managed.WithExternalConnecter(&domainConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(settle.NewConnecter(&domainConnector{
 			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
-			newServiceFn: domain.NewClient,
-		}),
+			newServiceFn: domain.NewClientFromAPI,
+			hc:           hc,
+			recorder:     event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		})), mgr.GetClient(), scopes.WorkersScriptsWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), account.NewDefaulter(mgr.GetClient())),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -71,6 +90,7 @@ func SetupDomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimit
 			RateLimiter: nil, // Use default rate limiter
 		}).
 		For(&workersv1alpha1.Domain{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), workersv1alpha1.DomainGroupVersionKind)).
 		Complete(r)
 }
 
@@ -78,49 +98,40 @@ func SetupDomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimit
 // is called.
 type domainConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
 	newServiceFn func(*cloudflare.API) *domain.CloudflareDomainClient
+	hc           *http.Client
+	recorder     event.Recorder
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient from the credentials of the managed
+// resource's ProviderConfig, resolved through any DefaultProviderConfig.
+// Resolving it also tracks the resource's usage of the ProviderConfig.
 func (c *domainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*workersv1alpha1.Domain)
+	_, ok := mg.(*workersv1alpha1.Domain)
 	if !ok {
 		return nil, errors.New(errNotDomain)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsageDomain)
-	}
-
-	pc := &providerv1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPCDomain)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetCredsDomain)
 	}
 
-	client, err := clients.NewClient(*config, nil)
+	client, err := clients.NewClient(*config, c.hc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewDomainClient)
 	}
 
 	// Create the domain client
-	return &domainExternal{service: c.newServiceFn(client)}, nil
+	return &domainExternal{service: c.newServiceFn(client), recorder: c.recorder}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type domainExternal struct {
-	service *domain.CloudflareDomainClient
+	service  *domain.CloudflareDomainClient
+	recorder event.Recorder
 }
 
 func (c *domainExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -135,15 +146,47 @@ func (c *domainExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 		}, nil
 	}
 
-	obs, err := c.service.Get(ctx, cr.Spec.ForProvider.AccountID, meta.GetExternalName(cr))
+	id := meta.GetExternalName(cr)
+	obs, err := c.service.GetOrReadopt(ctx, id, cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalObservation{},
 			errors.Wrap(resource.Ignore(clients.IsNotFound, err), "cannot get external resource")
 	}
 
+	// The hostname was attached again under a new ID, so track that
+	// attachment rather than attaching the hostname once more, which
+	// would conflict with it.
+	readopted := obs.ID != nil && *obs.ID != id
+	if readopted {
+		meta.SetExternalName(cr, *obs.ID)
+		if c.recorder != nil {
+			c.recorder.Event(cr, event.Normal(reasonReadoptedDomain, fmt.Sprintf(
+				"Re-adopted attachment %q for hostname %q as attachment %q no longer exists",
+				*obs.ID, cr.Spec.ForProvider.Hostname, id)))
+		}
+	}
+
+	// Not every token may read the certificate packs of a zone, so keep
+	// the last certificate status observed rather than failing to observe
+	// the domain.
+	obs.CertificateStatus = cr.Status.AtProvider.CertificateStatus
+	if ptr.Deref(obs.ZoneID, "") != "" && ptr.Deref(obs.Hostname, "") != "" {
+		if status, err := c.service.CertificatePackStatus(ctx, *obs.ZoneID, *obs.Hostname); err == nil {
+			obs.CertificateStatus = ptr.To(domain.CertificateStatus(status))
+			setCertificateConditions(cr, status)
+		}
+	}
+
 	cr.Status.AtProvider = *obs
 
-	cr.Status.SetConditions(rtv1.Available())
+	// The domain cannot serve requests over TLS until its certificate is
+	// active, so it is only ready once it is.
+	switch ptr.Deref(obs.CertificateStatus, domain.CertificateActive) {
+	case domain.CertificateActive:
+		cr.Status.SetConditions(rtv1.Available())
+	default:
+		cr.Status.SetConditions(rtv1.Unavailable())
+	}
 
 	upToDate, err := c.service.IsUpToDate(ctx, cr.Spec.ForProvider, *obs)
 	if err != nil {
@@ -151,11 +194,25 @@ func (c *domainExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: upToDate,
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: readopted,
 	}, nil
 }
 
+// setCertificateConditions sets the Issued condition of a domain from the
+// status of the certificate pack covering its hostname.
+func setCertificateConditions(cr *workersv1alpha1.Domain, packStatus string) {
+	if domain.CertificateStatus(packStatus) == domain.CertificateActive {
+		cr.Status.SetConditions(providerv1alpha1.Issued())
+		return
+	}
+	if packStatus == "" {
+		packStatus = "not yet requested"
+	}
+	cr.Status.SetConditions(providerv1alpha1.PendingIssuance(packStatus))
+}
+
 func (c *domainExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*workersv1alpha1.Domain)
 	if !ok {
@@ -164,11 +221,17 @@ func (c *domainExternal) Create(ctx context.Context, mg resource.Managed) (manag
 
 	cr.Status.SetConditions(rtv1.Creating())
 
-	obs, err := c.service.Create(ctx, cr.Spec.ForProvider)
+	obs, adoption, err := c.service.CreateOrAdopt(ctx, cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create external resource")
 	}
 
+	if adoption != nil && c.recorder != nil {
+		c.recorder.Event(cr, event.Normal(reasonAdoptedDomain, fmt.Sprintf(
+			"Adopted existing attachment for hostname %q previously pointing at service %q (environment %q)",
+			cr.Spec.ForProvider.Hostname, adoption.PreviousService, adoption.PreviousEnvironment)))
+	}
+
 	cr.Status.AtProvider = *obs
 	if obs.ID != nil {
 		meta.SetExternalName(cr, *obs.ID)
@@ -18,9 +18,10 @@ package workers
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,16 +34,22 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
-	providerv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	workersv1alpha1 "github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	kvnamespace "github.com/rossigee/provider-cloudflare/internal/clients/workers/kvnamespace"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
 	errNotKVNamespace        = "managed resource is not a KV Namespace custom resource"
-	errTrackPCUsageKV        = "cannot track ProviderConfig usage"
-	errGetPCKV               = "cannot get ProviderConfig"
 	errGetCredsKV            = "cannot get credentials"
 	errNewKVNamespaceClient  = "cannot create new KV Namespace client"
 )
@@ -53,15 +60,17 @@ func SetupKVNamespace(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRate
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(workersv1alpha1.KVNamespaceGroupVersionKind),
-		managed.WithExternalConnecter(&kvConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&kvConnector{
 			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
 			newServiceFn: kvnamespace.NewClient,
-		}),
+			hc:           hc,
+		}), mgr.GetClient(), scopes.WorkersKVStorageWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -70,6 +79,7 @@ func SetupKVNamespace(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRate
 			RateLimiter: nil, // Use default rate limiter
 		}).
 		For(&workersv1alpha1.KVNamespace{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), workersv1alpha1.KVNamespaceGroupVersionKind)).
 		Complete(r)
 }
 
@@ -77,37 +87,26 @@ func SetupKVNamespace(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRate
 // is called.
 type kvConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
-	newServiceFn func(clients.ClientInterface) *kvnamespace.KVNamespaceClient
+	newServiceFn func(clients.WorkersKVAPI) *kvnamespace.KVNamespaceClient
+	hc           *http.Client
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient from the credentials of the managed
+// resource's ProviderConfig, resolved through any DefaultProviderConfig.
+// Resolving it also tracks the resource's usage of the ProviderConfig.
 func (c *kvConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*workersv1alpha1.KVNamespace)
+	_, ok := mg.(*workersv1alpha1.KVNamespace)
 	if !ok {
 		return nil, errors.New(errNotKVNamespace)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsageKV)
-	}
-
-	pc := &providerv1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPCKV)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetCredsKV)
 	}
 
-	client, err := clients.NewClient(*config, nil)
+	client, err := clients.NewClient(*config, c.hc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewKVNamespaceClient)
 	}
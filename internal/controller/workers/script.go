@@ -18,11 +18,13 @@ package workers
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -39,14 +41,28 @@ import (
 	workersv1alpha1 "github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	scriptclient "github.com/rossigee/provider-cloudflare/internal/clients/workers/script"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/replacement"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
-	errNotScript        = "managed resource is not a Script custom resource"
-	errTrackPCUsage     = "cannot track ProviderConfig usage"
-	errGetPC            = "cannot get ProviderConfig"
-	errGetCreds         = "cannot get credentials"
-	errNewScriptClient  = "cannot create new Script client"
+	errNotScript         = "managed resource is not a Script custom resource"
+	errGetCreds          = "cannot get credentials"
+	errNewScriptClient   = "cannot create new Script client"
+	errScriptDeployment  = "cannot reconcile Script deployment"
+	errScriptWorkersDev  = "cannot reconcile Script workers.dev subdomain"
+	errScriptReplacement = "cannot replace Script"
+	errScriptAssets      = "cannot upload Script assets"
+	errScriptDependents  = "cannot reconcile Script dependents"
+	errScriptHasDepends  = "cannot delete Script while resources depend on it; remove them or set dependentsPolicy to Detach"
 )
 
 // SetupScript adds a controller that reconciles Script managed resources.
@@ -55,15 +71,17 @@ func SetupScript(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimit
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(workersv1alpha1.ScriptGroupVersionKind),
-		managed.WithExternalConnecter(&scriptConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&scriptConnector{
 			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
 			newServiceFn: scriptclient.NewClient,
-		}),
+			hc:           hc,
+		}), mgr.GetClient(), scopes.WorkersScriptsWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -75,6 +93,7 @@ func SetupScript(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimit
 			),
 		}).
 		For(&workersv1alpha1.Script{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), workersv1alpha1.ScriptGroupVersionKind)).
 		Complete(r)
 }
 
@@ -82,50 +101,138 @@ func SetupScript(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimit
 // is called.
 type scriptConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
-	newServiceFn func(clients.ClientInterface) *scriptclient.ScriptClient
+	newServiceFn func(clients.WorkerScriptAPI) *scriptclient.ScriptClient
+	hc           *http.Client
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient from the credentials of the managed
+// resource's ProviderConfig, resolved through any DefaultProviderConfig.
+// Resolving it also tracks the resource's usage of the ProviderConfig.
 func (c *scriptConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*workersv1alpha1.Script)
+	_, ok := mg.(*workersv1alpha1.Script)
 	if !ok {
 		return nil, errors.New(errNotScript)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
-	}
-
-	pc := &providerv1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetCreds)
 	}
 
-	client, err := clients.NewClient(*config, nil)
+	client, err := clients.NewClient(*config, c.hc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewScriptClient)
 	}
 
 	// Create the script client wrapper
 	adapter := clients.NewCloudflareAPIAdapter(client)
-	return &scriptExternal{service: c.newServiceFn(adapter)}, nil
+	return &scriptExternal{
+		service:     c.newServiceFn(adapter),
+		deployments: scriptclient.NewDeploymentClient(client, adapter.GetAccountID()),
+		assets:      scriptclient.NewAssetsClient(client, c.hc, client.BaseURL, adapter.GetAccountID()),
+		dependents:  scriptclient.NewDependentsClient(client, adapter.GetAccountID()),
+		propagation: config.MetadataPropagation,
+		ownership:   config.Ownership,
+	}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type scriptExternal struct {
-	service *scriptclient.ScriptClient
+	service     *scriptclient.ScriptClient
+	deployments *scriptclient.DeploymentClient
+	assets      *scriptclient.AssetsClient
+	dependents  *scriptclient.DependentsClient
+	propagation *providerv1alpha1.MetadataPropagation
+	ownership   *providerv1alpha1.Ownership
+}
+
+// parameters returns the parameters the supplied Script is uploaded with,
+// tagged with any propagated metadata and its owner.
+func (c *scriptExternal) parameters(cr *workersv1alpha1.Script) workersv1alpha1.ScriptParameters {
+	p := cr.Spec.ForProvider
+	p.Tags = clients.PropagatedMetadata(c.propagation, cr).WithOwner(c.ownership, cr).MergeTags(p.Tags)
+	return p
+}
+
+// managesDeployment returns true if the deployment of the supplied Script
+// is managed through the versions and deployments API, which is not
+// available for Workers in a dispatch namespace.
+func (c *scriptExternal) managesDeployment(cr *workersv1alpha1.Script) bool {
+	return c.deployments != nil && cr.Spec.ForProvider.DispatchNamespace == nil
+}
+
+// scriptUpToDate returns true if the uploaded script and its assets match
+// the spec. A pinned rollback deliberately serves an older version, so the
+// script is not compared while one is set.
+func (c *scriptExternal) scriptUpToDate(ctx context.Context, cr *workersv1alpha1.Script) (bool, error) {
+	if cr.Spec.ForProvider.RollbackToVersion != nil {
+		return true, nil
+	}
+	if !scriptclient.AssetsUpToDate(cr.Spec.ForProvider.Assets, cr.Status.AtProvider.Assets) {
+		return false, nil
+	}
+	return c.service.IsUpToDate(ctx, cr.Spec.ForProvider, cr.Status.AtProvider)
+}
+
+// uploadAssets uploads the assets of the supplied Script unless they were
+// last uploaded from the same source, as described by the supplied
+// observation. It returns the token linking the uploaded assets to the
+// next upload of the Worker, which is empty when its current assets are to
+// be kept, and the observation of the assets.
+func (c *scriptExternal) uploadAssets(ctx context.Context, cr *workersv1alpha1.Script, last *workersv1alpha1.WorkerAssetsObservation) (string, *workersv1alpha1.WorkerAssetsObservation, error) {
+	a := cr.Spec.ForProvider.Assets
+	if a == nil {
+		return "", nil, nil
+	}
+	if last != nil && last.Source == scriptclient.AssetsSource(a) {
+		return "", scriptclient.AssetsObservation(a, last.ManifestHash, int(last.Files)), nil
+	}
+
+	assets, err := c.assets.Fetch(ctx, *a)
+	if err != nil {
+		return "", nil, err
+	}
+	jwt, err := c.assets.Upload(ctx, cr.Spec.ForProvider.ScriptName, cr.Spec.ForProvider.DispatchNamespace, assets)
+	if err != nil {
+		return "", nil, err
+	}
+	return jwt, scriptclient.AssetsObservation(a, scriptclient.ManifestHash(assets), len(assets)), nil
+}
+
+// releaseDependents detaches the routes, custom domains, cron triggers and
+// queue consumers of the supplied Script when its DependentsPolicy is
+// Detach. Otherwise it returns an error, listing them on the
+// DeletionProtected condition, while any exist.
+func (c *scriptExternal) releaseDependents(ctx context.Context, cr *workersv1alpha1.Script) error {
+	if c.dependents == nil || cr.Spec.ForProvider.DispatchNamespace != nil {
+		return nil
+	}
+	name := meta.GetExternalName(cr)
+	deps, err := c.dependents.List(ctx, name)
+	if err != nil {
+		return errors.Wrap(err, errScriptDependents)
+	}
+	if len(deps) == 0 {
+		return nil
+	}
+
+	if ptr.Deref(cr.Spec.ForProvider.DependentsPolicy, workersv1alpha1.DependentsPolicyBlock) != workersv1alpha1.DependentsPolicyDetach {
+		names := make([]string, 0, len(deps))
+		for _, d := range deps {
+			names = append(names, d.String())
+		}
+		cr.SetConditions(protection.BlockedByDependents(names))
+		return errors.New(errScriptHasDepends)
+	}
+
+	for _, d := range deps {
+		if err := c.dependents.Detach(ctx, name, d); err != nil {
+			return errors.Wrap(err, errScriptDependents)
+		}
+	}
+	return nil
 }
 
 func (c *scriptExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -146,15 +253,60 @@ func (c *scriptExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 			errors.Wrap(resource.Ignore(clients.IsNotFound, err), "cannot get external resource")
 	}
 
+	// The assets last uploaded cannot be read back, so they are carried
+	// over from the previous observation.
+	assets := cr.Status.AtProvider.Assets
 	cr.Status.AtProvider = *obs
+	cr.Status.AtProvider.Assets = assets
 
 	cr.Status.SetConditions(rtv1.Available())
 
-	upToDate, err := c.service.IsUpToDate(ctx, cr.Spec.ForProvider, *obs)
+	// Uploading under a changed name would create a second Worker rather
+	// than rename this one, so it either is left alone or replaced.
+	name := meta.GetExternalName(cr)
+	switch replacement.Decide(cr, replacement.Change{Field: "spec.forProvider.scriptName", Observed: name, Desired: cr.Spec.ForProvider.ScriptName}, cr.Spec.ForProvider.AllowRecreate) {
+	case replacement.Blocked:
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case replacement.Replace:
+		// The Worker is deleted as it would be by Delete, so routes,
+		// custom domains and cron triggers are not left pointing at it.
+		if err := c.releaseDependents(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if err := c.service.Delete(ctx, name, cr.Spec.ForProvider.DispatchNamespace); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errScriptReplacement)
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	upToDate, err := c.scriptUpToDate(ctx, cr)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, "cannot determine if resource is up to date")
 	}
 
+	if c.managesDeployment(cr) {
+		latest, err := c.deployments.LatestVersion(ctx, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errScriptDeployment)
+		}
+		current, err := c.deployments.Current(ctx, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errScriptDeployment)
+		}
+		cr.Status.AtProvider.LatestVersionID = &latest
+		cr.Status.AtProvider.Deployment = current
+		upToDate = upToDate && scriptclient.DeploymentUpToDate(scriptclient.DesiredDeployment(cr.Spec.ForProvider, latest), current)
+
+		if want := cr.Spec.ForProvider.WorkersDev; want != nil {
+			enabled, err := c.deployments.WorkersDev(ctx, meta.GetExternalName(cr))
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errScriptWorkersDev)
+			}
+			cr.Status.AtProvider.WorkersDev = &enabled
+			upToDate = upToDate && enabled == *want
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
 		ResourceUpToDate: upToDate,
@@ -169,14 +321,27 @@ func (c *scriptExternal) Create(ctx context.Context, mg resource.Managed) (manag
 
 	cr.Status.SetConditions(rtv1.Creating())
 
-	obs, err := c.service.Create(ctx, cr.Spec.ForProvider)
+	// A new Worker has no assets to keep, so they are always uploaded.
+	jwt, assets, err := c.uploadAssets(ctx, cr, nil)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errScriptAssets)
+	}
+
+	obs, err := c.service.Create(ctx, c.parameters(cr), jwt)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create external resource")
 	}
 
 	cr.Status.AtProvider = *obs
+	cr.Status.AtProvider.Assets = assets
 	meta.SetExternalName(cr, cr.Spec.ForProvider.ScriptName)
 
+	if c.managesDeployment(cr) && cr.Spec.ForProvider.WorkersDev != nil {
+		if err := c.deployments.SetWorkersDev(ctx, cr.Spec.ForProvider.ScriptName, *cr.Spec.ForProvider.WorkersDev); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errScriptWorkersDev)
+		}
+	}
+
 	return managed.ExternalCreation{}, nil
 }
 
@@ -186,12 +351,54 @@ func (c *scriptExternal) Update(ctx context.Context, mg resource.Managed) (manag
 		return managed.ExternalUpdate{}, errors.New(errNotScript)
 	}
 
-	obs, err := c.service.Update(ctx, cr.Spec.ForProvider)
+	upToDate, err := c.scriptUpToDate(ctx, cr)
 	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update external resource")
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot determine if resource is up to date")
 	}
 
-	cr.Status.AtProvider = *obs
+	if !upToDate {
+		jwt, assets, err := c.uploadAssets(ctx, cr, cr.Status.AtProvider.Assets)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errScriptAssets)
+		}
+		obs, err := c.service.Update(ctx, c.parameters(cr), jwt)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update external resource")
+		}
+		cr.Status.AtProvider = *obs
+		cr.Status.AtProvider.Assets = assets
+	}
+
+	if !c.managesDeployment(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// Uploading a script deploys the new version to all traffic, so the
+	// deployment is reconciled after any upload.
+	name := meta.GetExternalName(cr)
+	latest, err := c.deployments.LatestVersion(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errScriptDeployment)
+	}
+	current, err := c.deployments.Current(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errScriptDeployment)
+	}
+	desired := scriptclient.DesiredDeployment(cr.Spec.ForProvider, latest)
+	if !scriptclient.DeploymentUpToDate(desired, current) {
+		if err := c.deployments.Deploy(ctx, name, desired); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errScriptDeployment)
+		}
+	}
+
+	if want := cr.Spec.ForProvider.WorkersDev; want != nil {
+		observed := cr.Status.AtProvider.WorkersDev
+		if observed == nil || *observed != *want {
+			if err := c.deployments.SetWorkersDev(ctx, name, *want); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errScriptWorkersDev)
+			}
+		}
+	}
 
 	return managed.ExternalUpdate{}, nil
 }
@@ -204,6 +411,10 @@ func (c *scriptExternal) Delete(ctx context.Context, mg resource.Managed) (manag
 
 	cr.Status.SetConditions(rtv1.Deleting())
 
+	if err := c.releaseDependents(ctx, cr); err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
 	err := c.service.Delete(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.DispatchNamespace)
 	return managed.ExternalDelete{}, err
 }
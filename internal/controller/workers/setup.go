@@ -52,6 +52,9 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any
 	if err := SetupSubdomain(mgr, l, rl); err != nil {
 		return err
 	}
+	if err := SetupTailConsumer(mgr, l, rl); err != nil {
+		return err
+	}
 
 	return nil
 }
\ No newline at end of file
@@ -18,10 +18,11 @@ package workers
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,34 +36,44 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	workersv1alpha1 "github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
-	providerv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	subdomain "github.com/rossigee/provider-cloudflare/internal/clients/workers/subdomain"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
 	errNotSubdomain           = "managed resource is not a Subdomain custom resource"
-	errTrackPCUsageSubdomain  = "cannot track ProviderConfig usage"
-	errGetPCSubdomain         = "cannot get ProviderConfig"
 	errGetCredsSubdomain      = "cannot get credentials"
 	errNewSubdomainClient     = "cannot create new Subdomain client"
 )
 
 // SetupSubdomain adds a controller that reconciles Subdomain managed resources.
 func SetupSubdomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(workersv1alpha1.SubdomainKind)
+	name := managed.ControllerName(workersv1alpha1.SubdomainGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(workersv1alpha1.SubdomainGroupVersionKind),
-		managed.WithExternalConnecter(&subdomainConnector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&subdomainConnector{
 			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
 			newServiceFn: subdomain.NewClient,
-		}),
+			hc:           hc,
+		}), mgr.GetClient(), scopes.WorkersScriptsWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), account.NewDefaulter(mgr.GetClient())),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -71,6 +82,7 @@ func SetupSubdomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLi
 			RateLimiter: nil, // Use default rate limiter
 		}).
 		For(&workersv1alpha1.Subdomain{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), workersv1alpha1.SubdomainGroupVersionKind)).
 		Complete(r)
 }
 
@@ -78,37 +90,26 @@ func SetupSubdomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLi
 // is called.
 type subdomainConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
 	newServiceFn func(*cloudflare.API) *subdomain.CloudflareSubdomainClient
+	hc           *http.Client
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient from the credentials of the managed
+// resource's ProviderConfig, resolved through any DefaultProviderConfig.
+// Resolving it also tracks the resource's usage of the ProviderConfig.
 func (c *subdomainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*workersv1alpha1.Subdomain)
+	_, ok := mg.(*workersv1alpha1.Subdomain)
 	if !ok {
 		return nil, errors.New(errNotSubdomain)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsageSubdomain)
-	}
-
-	pc := &providerv1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPCSubdomain)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetCredsSubdomain)
 	}
 
-	client, err := clients.NewClient(*config, nil)
+	client, err := clients.NewClient(*config, c.hc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewSubdomainClient)
 	}
@@ -129,6 +130,12 @@ func (c *subdomainExternal) Observe(ctx context.Context, mg resource.Managed) (m
 		return managed.ExternalObservation{}, errors.New(errNotSubdomain)
 	}
 
+	// A retained subdomain is left as it is, so the Subdomain is gone as
+	// soon as it is deleted.
+	if meta.WasDeleted(cr) && cr.Spec.ForProvider.DeletionBehavior != workersv1alpha1.SubdomainDisable {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
 	// Workers Subdomain is an account-level configuration, it always "exists"
 	// We just need to get the current configuration
 	obs, err := c.service.Get(ctx, cr.Spec.ForProvider.AccountID)
@@ -190,9 +197,8 @@ func (c *subdomainExternal) Update(ctx context.Context, mg resource.Managed) (ma
 }
 
 func (c *subdomainExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	// Workers Subdomain is an account-level configuration, we don't delete it
-	// We could reset it to empty, but that might not be desired
-	// For now, we'll just mark it as deleting but not actually change anything
+	// Observe reports retained subdomains as gone, so only those whose
+	// deletion behavior is Disable are deleted here.
 	cr, ok := mg.(*workersv1alpha1.Subdomain)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotSubdomain)
@@ -200,8 +206,10 @@ func (c *subdomainExternal) Delete(ctx context.Context, mg resource.Managed) (ma
 
 	cr.Status.SetConditions(rtv1.Deleting())
 
-	// Successfully "delete" by doing nothing - the configuration remains
-	return managed.ExternalDelete{}, nil
+	if cr.Spec.ForProvider.DeletionBehavior != workersv1alpha1.SubdomainDisable {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, errors.Wrap(c.service.Delete(ctx, cr.Spec.ForProvider.AccountID), "cannot delete external resource")
 }
 
 func (c *subdomainExternal) Disconnect(ctx context.Context) error {
@@ -0,0 +1,226 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	workersv1alpha1 "github.com/rossigee/provider-cloudflare/apis/workers/v1alpha1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/workers/tailconsumer"
+	"github.com/rossigee/provider-cloudflare/internal/controller/account"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotTailConsumer      = "managed resource is not a TailConsumer custom resource"
+	errTailConsumerLookup   = "cannot lookup tail consumers"
+	errTailConsumerCreation = "cannot attach tail consumer"
+	errTailConsumerDeletion = "cannot detach tail consumer"
+	errTailConsumerNoAcct   = "no account ID found"
+	errTailConsumerNoEnds   = "both producer and consumer Workers must be specified"
+)
+
+// SetupTailConsumer adds a controller that reconciles TailConsumer managed
+// resources.
+func SetupTailConsumer(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(workersv1alpha1.TailConsumerGroupKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(workersv1alpha1.TailConsumerGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&tailConsumerConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (tailconsumer.Client, error) {
+				return tailconsumer.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.WorkersScriptsWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithInitializers(account.NewDefaulter(mgr.GetClient())),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: nil, // Use default rate limiter
+		}).
+		For(&workersv1alpha1.TailConsumer{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), workersv1alpha1.TailConsumerGroupVersionKind)).
+		Complete(r)
+}
+
+// A tailConsumerConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type tailConsumerConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (tailconsumer.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *tailConsumerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*workersv1alpha1.TailConsumer); !ok {
+		return nil, errors.New(errNotTailConsumer)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tailConsumerExternal{client: client}, nil
+}
+
+// A tailConsumerExternal observes, then either attaches or detaches the
+// consumer Worker of a TailConsumer.
+type tailConsumerExternal struct {
+	client tailconsumer.Client
+}
+
+// ends returns the account, producer and consumer of a TailConsumer.
+func ends(cr *workersv1alpha1.TailConsumer) (string, string, string, error) {
+	p := cr.Spec.ForProvider
+	if p.AccountID == "" {
+		return "", "", "", errors.New(errTailConsumerNoAcct)
+	}
+	if p.Producer == nil || p.Consumer == nil || *p.Producer == "" || *p.Consumer == "" {
+		return "", "", "", errors.New(errTailConsumerNoEnds)
+	}
+	return p.AccountID, *p.Producer, *p.Consumer, nil
+}
+
+func (e *tailConsumerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*workersv1alpha1.TailConsumer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTailConsumer)
+	}
+
+	// Nothing has been attached until Create sets the external name.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	acct, producer, consumer, err := ends(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	consumers, err := tailconsumer.Get(ctx, e.client, acct, producer)
+	if clients.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errTailConsumerLookup)
+	}
+
+	cr.Status.AtProvider.Consumers = tailconsumer.Services(consumers)
+	if !tailconsumer.Attached(consumers, consumer) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ok, err = tailconsumer.Exists(ctx, e.client, acct, consumer)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errTailConsumerLookup)
+	}
+	if ok {
+		cr.SetConditions(rtv1.Available())
+	} else {
+		cr.SetConditions(rtv1.Unavailable().WithMessage(fmt.Sprintf("consumer Worker %q no longer exists", consumer)))
+	}
+
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (e *tailConsumerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*workersv1alpha1.TailConsumer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTailConsumer)
+	}
+
+	acct, producer, consumer, err := ends(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errTailConsumerCreation)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	if err := tailconsumer.Attach(ctx, e.client, acct, producer, consumer); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errTailConsumerCreation)
+	}
+
+	meta.SetExternalName(cr, strings.Join([]string{producer, consumer}, "/"))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *tailConsumerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Both ends of a TailConsumer are immutable, so there is nothing to
+	// update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *tailConsumerExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*workersv1alpha1.TailConsumer)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotTailConsumer)
+	}
+
+	acct, producer, consumer, err := ends(cr)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errTailConsumerDeletion)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	err = tailconsumer.Detach(ctx, e.client, acct, producer, consumer)
+	return managed.ExternalDelete{}, errors.Wrap(err, errTailConsumerDeletion)
+}
+
+func (e *tailConsumerExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zone
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/zones/custompage"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotCustomPage = "managed resource is not a CustomPage custom resource"
+
+	errCustomPageLookup   = "cannot lookup custom page"
+	errCustomPageCreation = "cannot create custom page"
+	errCustomPageUpdate   = "cannot update custom page"
+	errCustomPageDeletion = "cannot delete custom page"
+	errCustomPageNoTarget = "no account or zone found"
+	errCustomPageContent  = "cannot get custom page content"
+	errCustomPageNoKey    = "ConfigMap has no key %q"
+	errCustomPageNoSource = "no content source specified"
+)
+
+// SetupCustomPage adds a controller that reconciles CustomPage managed
+// resources.
+func SetupCustomPage(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.CustomPageGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: maxConcurrency,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CustomPageGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&customPageConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (custompage.Client, error) {
+				return custompage.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.ZoneSettingsWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CustomPage{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.CustomPageGroupVersionKind)).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(customPagesForConfigMap(mgr.GetClient()))).
+		Complete(r)
+}
+
+// customPagesForConfigMap returns a function that maps a ConfigMap to the
+// CustomPages reading their content from it, so that changed content is
+// published without waiting for the poll interval.
+func customPagesForConfigMap(c client.Reader) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		l := &v1alpha1.CustomPageList{}
+		if err := c.List(ctx, l); err != nil {
+			return nil
+		}
+		var out []reconcile.Request
+		for _, p := range l.Items {
+			src := p.Spec.ForProvider.ContentFrom
+			if src == nil || src.Namespace != o.GetNamespace() || src.Name != o.GetName() {
+				continue
+			}
+			out = append(out, reconcile.Request{NamespacedName: types.NamespacedName{Name: p.GetName()}})
+		}
+		return out
+	}
+}
+
+// A customPageConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type customPageConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (custompage.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *customPageConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.CustomPage); !ok {
+		return nil, errors.New(errNotCustomPage)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customPageExternal{kube: c.kube, client: client}, nil
+}
+
+// A customPageExternal observes, then either publishes or resets a custom
+// page.
+type customPageExternal struct {
+	kube   client.Reader
+	client custompage.Client
+}
+
+// content returns the content a page is published with: the HTML read from
+// a ConfigMap for access pages, and the URL for other pages.
+func (e *customPageExternal) content(ctx context.Context, p v1alpha1.CustomPageParameters) (string, error) {
+	if p.URL != nil {
+		return *p.URL, nil
+	}
+	src := p.ContentFrom
+	if src == nil {
+		return "", errors.New(errCustomPageNoSource)
+	}
+	cm := &corev1.ConfigMap{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: src.Namespace, Name: src.Name}, cm); err != nil {
+		return "", errors.Wrap(err, errCustomPageContent)
+	}
+	html, ok := cm.Data[src.Key]
+	if !ok {
+		return "", errors.Errorf(errCustomPageNoKey, src.Key)
+	}
+	return html, nil
+}
+
+func hasTarget(p v1alpha1.CustomPageParameters) bool {
+	return p.AccountID != nil || p.Zone != nil
+}
+
+func (e *customPageExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CustomPage)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCustomPage)
+	}
+
+	if !hasTarget(cr.Spec.ForProvider) {
+		return managed.ExternalObservation{}, errors.New(errCustomPageNoTarget)
+	}
+
+	// Zone and account pages always exist, so they are only considered to
+	// exist once they have been published and have an external name.
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	obs, err := custompage.Get(ctx, e.client, cr.Spec.ForProvider, id)
+	if custompage.IsPageNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCustomPageLookup)
+	}
+
+	cr.Status.AtProvider = obs
+
+	// Once Delete has reset the page there is nothing left to delete.
+	if meta.WasDeleted(cr) && obs.State == custompage.StateDefault {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	content, err := e.content(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: custompage.IsUpToDate(cr.Spec.ForProvider, obs, content),
+	}, nil
+}
+
+func (e *customPageExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CustomPage)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCustomPage)
+	}
+
+	if !hasTarget(cr.Spec.ForProvider) {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errCustomPageNoTarget), errCustomPageCreation)
+	}
+
+	content, err := e.content(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCustomPageCreation)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	id, err := custompage.Publish(ctx, e.client, cr.GetName(), cr.Spec.ForProvider, "", content)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCustomPageCreation)
+	}
+
+	meta.SetExternalName(cr, id)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *customPageExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CustomPage)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCustomPage)
+	}
+
+	if !hasTarget(cr.Spec.ForProvider) {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errCustomPageNoTarget), errCustomPageUpdate)
+	}
+
+	content, err := e.content(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCustomPageUpdate)
+	}
+
+	_, err = custompage.Publish(ctx, e.client, cr.GetName(), cr.Spec.ForProvider, meta.GetExternalName(cr), content)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errCustomPageUpdate)
+}
+
+func (e *customPageExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.CustomPage)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotCustomPage)
+	}
+
+	if !hasTarget(cr.Spec.ForProvider) {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errCustomPageNoTarget), errCustomPageDeletion)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	err := custompage.Reset(ctx, e.client, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	return managed.ExternalDelete{}, errors.Wrap(err, errCustomPageDeletion)
+}
+
+func (e *customPageExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zone
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/zones/imageoptimization"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotImageOptimization = "managed resource is not an ImageOptimization custom resource"
+
+	errImageOptimizationLookup   = "cannot lookup image optimization settings"
+	errImageOptimizationCreation = "cannot create image optimization settings"
+	errImageOptimizationUpdate   = "cannot update image optimization settings"
+	errImageOptimizationDeletion = "cannot delete image optimization settings"
+	errImageOptimizationNoZone   = "no zone found"
+)
+
+// SetupImageOptimization adds a controller that reconciles
+// ImageOptimization managed resources.
+func SetupImageOptimization(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.ImageOptimizationGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: maxConcurrency,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ImageOptimizationGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&imageOptimizationConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (imageoptimization.Client, error) {
+				return imageoptimization.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.ZoneSettingsWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.ImageOptimization{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.ImageOptimizationGroupVersionKind)).
+		Complete(r)
+}
+
+// An imageOptimizationConnector is expected to produce an ExternalClient
+// when its Connect method is called.
+type imageOptimizationConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (imageoptimization.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *imageOptimizationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.ImageOptimization); !ok {
+		return nil, errors.New(errNotImageOptimization)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &imageOptimizationExternal{client: client}, nil
+}
+
+// An imageOptimizationExternal observes, then updates the image
+// optimization settings of a zone.
+type imageOptimizationExternal struct {
+	client imageoptimization.Client
+}
+
+func (e *imageOptimizationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ImageOptimization)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotImageOptimization)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalObservation{}, errors.New(errImageOptimizationNoZone)
+	}
+
+	// The settings exist for as long as the zone does, so they are only
+	// considered to exist once they have been applied.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	obs, err := imageoptimization.Get(ctx, e.client, *cr.Spec.ForProvider.Zone)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errImageOptimizationLookup)
+	}
+
+	cr.Status.AtProvider = obs
+
+	// Once Delete has turned the settings off there is nothing left to
+	// delete.
+	if meta.WasDeleted(cr) && imageoptimization.IsReset(cr.Spec.ForProvider, obs) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: imageoptimization.IsUpToDate(cr.Spec.ForProvider, obs),
+	}, nil
+}
+
+func (e *imageOptimizationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ImageOptimization)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotImageOptimization)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errImageOptimizationNoZone), errImageOptimizationCreation)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	if err := imageoptimization.Update(ctx, e.client, *cr.Spec.ForProvider.Zone, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errImageOptimizationCreation)
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.Zone)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *imageOptimizationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ImageOptimization)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotImageOptimization)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errImageOptimizationNoZone), errImageOptimizationUpdate)
+	}
+
+	err := imageoptimization.Update(ctx, e.client, *cr.Spec.ForProvider.Zone, cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errImageOptimizationUpdate)
+}
+
+func (e *imageOptimizationExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ImageOptimization)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotImageOptimization)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errImageOptimizationNoZone), errImageOptimizationDeletion)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	// The settings cannot be deleted, so they are turned off instead.
+	err := imageoptimization.Reset(ctx, e.client, *cr.Spec.ForProvider.Zone, cr.Spec.ForProvider)
+	return managed.ExternalDelete{}, errors.Wrap(err, errImageOptimizationDeletion)
+}
+
+func (e *imageOptimizationExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
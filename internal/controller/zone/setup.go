@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zone
+
+import (
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// Setup creates all Zone controllers with the supplied logger and adds them
+// to the supplied manager.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	// Setup Zone controller
+	if err := SetupZone(mgr, l, rl); err != nil {
+		return err
+	}
+
+	// Setup ImageOptimization controller
+	if err := SetupImageOptimization(mgr, l, rl); err != nil {
+		return err
+	}
+
+	// Setup SpeedSettings controller
+	if err := SetupSpeedSettings(mgr, l, rl); err != nil {
+		return err
+	}
+
+	// Setup CustomPage controller
+	if err := SetupCustomPage(mgr, l, rl); err != nil {
+		return err
+	}
+
+	return nil
+}
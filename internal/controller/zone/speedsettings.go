@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zone
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/zones/speedsettings"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
+)
+
+const (
+	errNotSpeedSettings = "managed resource is not a SpeedSettings custom resource"
+
+	errSpeedSettingsLookup   = "cannot lookup speed settings"
+	errSpeedSettingsCreation = "cannot create speed settings"
+	errSpeedSettingsUpdate   = "cannot update speed settings"
+	errSpeedSettingsDeletion = "cannot delete speed settings"
+	errSpeedSettingsNoZone   = "no zone found"
+)
+
+// SetupSpeedSettings adds a controller that reconciles
+// SpeedSettings managed resources.
+func SetupSpeedSettings(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+	name := managed.ControllerName(v1alpha1.SpeedSettingsGroupKind)
+
+	o := controller.Options{
+		RateLimiter:             nil, // Use default rate limiter
+		MaxConcurrentReconciles: maxConcurrency,
+	}
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SpeedSettingsGroupVersionKind),
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&speedSettingsConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (speedsettings.Client, error) {
+				return speedsettings.NewClient(cfg, hc)
+			},
+		}), mgr.GetClient(), scopes.ZoneSettingsWrite))), rec), l.WithValues("controller", name)), name)),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(rec),
+		managed.WithPollInterval(5*time.Minute),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.SpeedSettings{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.SpeedSettingsGroupVersionKind)).
+		Complete(r)
+}
+
+// A speedSettingsConnector is expected to produce an ExternalClient
+// when its Connect method is called.
+type speedSettingsConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (speedsettings.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *speedSettingsConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.SpeedSettings); !ok {
+		return nil, errors.New(errNotSpeedSettings)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &speedSettingsExternal{client: client}, nil
+}
+
+// A speedSettingsExternal observes, then updates the speed settings of a
+// zone.
+type speedSettingsExternal struct {
+	client speedsettings.Client
+}
+
+func (e *speedSettingsExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SpeedSettings)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSpeedSettings)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalObservation{}, errors.New(errSpeedSettingsNoZone)
+	}
+
+	// The settings exist for as long as the zone does, so they are only
+	// considered to exist once they have been applied.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	obs, err := speedsettings.Get(ctx, e.client, *cr.Spec.ForProvider.Zone, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSpeedSettingsLookup)
+	}
+
+	cr.Status.AtProvider = obs
+
+	// Once Delete has turned the settings off there is nothing left to
+	// delete.
+	if meta.WasDeleted(cr) && speedsettings.IsReset(cr.Spec.ForProvider, obs) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: speedsettings.IsUpToDate(cr.Spec.ForProvider, obs),
+	}, nil
+}
+
+func (e *speedSettingsExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SpeedSettings)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSpeedSettings)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errSpeedSettingsNoZone), errSpeedSettingsCreation)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	if err := speedsettings.Update(ctx, e.client, *cr.Spec.ForProvider.Zone, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errSpeedSettingsCreation)
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.Zone)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *speedSettingsExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SpeedSettings)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSpeedSettings)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errSpeedSettingsNoZone), errSpeedSettingsUpdate)
+	}
+
+	err := speedsettings.Update(ctx, e.client, *cr.Spec.ForProvider.Zone, cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errSpeedSettingsUpdate)
+}
+
+func (e *speedSettingsExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.SpeedSettings)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotSpeedSettings)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errSpeedSettingsNoZone), errSpeedSettingsDeletion)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	// The settings cannot be deleted, so they are turned off instead.
+	err := speedsettings.Reset(ctx, e.client, *cr.Spec.ForProvider.Zone, cr.Spec.ForProvider)
+	return managed.ExternalDelete{}, errors.Wrap(err, errSpeedSettingsDeletion)
+}
+
+func (e *speedSettingsExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -18,15 +18,24 @@ package zone
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -38,7 +47,16 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/zone/v1alpha1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 	zones "github.com/rossigee/provider-cloudflare/internal/clients/zones"
+	"github.com/rossigee/provider-cloudflare/internal/controller/apierror"
+	"github.com/rossigee/provider-cloudflare/internal/controller/credentials"
+	"github.com/rossigee/provider-cloudflare/internal/controller/drift"
+	"github.com/rossigee/provider-cloudflare/internal/controller/fairshare"
+	"github.com/rossigee/provider-cloudflare/internal/controller/observed"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
+	"github.com/rossigee/provider-cloudflare/internal/controller/replacement"
+	"github.com/rossigee/provider-cloudflare/internal/controller/scopes"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/tracing"
 )
 
 const (
@@ -51,14 +69,26 @@ const (
 	errZoneCreation    = "cannot create zone"
 	errZoneUpdate      = "cannot update zone"
 	errZoneDeletion    = "cannot delete zone"
+	errZoneReplacement = "cannot replace zone"
+	errZoneQuota       = "cannot create zone: the zone quota of the account is exhausted"
+	errZoneTemplate    = "cannot resolve zone settings template"
+
+	typeQuotaExceeded    rtv1.ConditionType   = "QuotaExceeded"
+	reasonZoneLimit      rtv1.ConditionReason = "AccountZoneLimitReached"
+	reasonQuotaAvailable rtv1.ConditionReason = "QuotaAvailable"
+
+	reasonSettingsDrift    event.Reason = "SettingsDrift"
+	reasonJumpStartRecords event.Reason = "JumpStartRecords"
+
+	errJumpStartRecords = "cannot report the DNS records imported by jump start"
 
 	maxConcurrency = 5
 
 	zoneStatusActive = "active"
 )
 
-// Setup adds a controller that reconciles Zone managed resources.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+// SetupZone adds a controller that reconciles Zone managed resources.
+func SetupZone(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
 	name := managed.ControllerName(v1alpha1.ZoneGroupKind)
 
 	o := controller.Options{
@@ -67,16 +97,18 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any
 	}
 
 	hc := metrics.NewInstrumentedHTTPClient(name)
+	rec := tracing.NewRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ZoneGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(fairshare.NewConnecter(tracing.NewConnecter(drift.NewConnecter(observed.NewConnecter(protection.NewConnecter(scopes.NewConnecter(apierror.NewConnecter(&connector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (zones.Client, error) {
 				return zones.NewClient(cfg, hc)
 			},
-		}),
+			recorder: rec,
+		}), mgr.GetClient(), scopes.ZoneWrite, scopes.ZoneSettingsWrite))), rec), l.WithValues("controller", name)), name)),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithPollInterval(5*time.Minute),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
@@ -86,14 +118,75 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any
 		Named(name).
 		WithOptions(o).
 		For(&v1alpha1.Zone{}).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), v1alpha1.ZoneGroupVersionKind)).
+		Watches(&v1alpha1.ZoneSettingsTemplate{}, handler.EnqueueRequestsFromMapFunc(inheritingZones(mgr.GetClient()))).
 		Complete(r)
 }
 
+// inheritingZones returns a function that maps a ZoneSettingsTemplate to a
+// reconcile request for each Zone inheriting settings from it, directly or
+// through other templates, so that changes of a template are applied
+// without waiting for the poll interval of its Zones.
+func inheritingZones(c client.Reader) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		ts := &v1alpha1.ZoneSettingsTemplateList{}
+		if err := c.List(ctx, ts); err != nil {
+			return nil
+		}
+		names := map[string]bool{o.GetName(): true}
+		for grown := true; grown; {
+			grown = false
+			for _, t := range ts.Items {
+				if ref := t.Spec.SettingsTemplateRef; ref != nil && names[ref.Name] && !names[t.GetName()] {
+					names[t.GetName()] = true
+					grown = true
+				}
+			}
+		}
+
+		zl := &v1alpha1.ZoneList{}
+		if err := c.List(ctx, zl); err != nil {
+			return nil
+		}
+		var out []reconcile.Request
+		for _, z := range zl.Items {
+			if ref := z.Spec.ForProvider.SettingsTemplateRef; ref != nil && names[ref.Name] {
+				out = append(out, reconcile.Request{NamespacedName: types.NamespacedName{Name: z.GetName()}})
+			}
+		}
+		return out
+	}
+}
+
+// quotaExceeded returns a condition indicating that a zone cannot be
+// created because its account holds as many zones as it may.
+func quotaExceeded(q zones.ZoneQuota) rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeQuotaExceeded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonZoneLimit,
+		Message:            fmt.Sprintf("The account holds %d of the %d zones it may; delete a zone or raise the limit of the account", q.Used, q.Limit),
+	}
+}
+
+// quotaAvailable returns a condition indicating that the account of a zone
+// may hold it.
+func quotaAvailable() rtv1.Condition {
+	return rtv1.Condition{
+		Type:               typeQuotaExceeded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonQuotaAvailable,
+	}
+}
+
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
 	kube                  client.Client
 	newCloudflareClientFn func(cfg clients.Config) (zones.Client, error)
+	recorder              event.Recorder
 }
 
 // Connect produces a valid configuration for a Cloudflare API
@@ -115,13 +208,15 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	return &external{client: client}, nil
+	return &external{kube: c.kube, client: client, recorder: c.recorder}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	client zones.Client
+	kube     client.Reader
+	client   zones.Client
+	recorder event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context,
@@ -144,7 +239,18 @@ func (e *external) Observe(ctx context.Context,
 			errors.Wrap(resource.Ignore(zones.IsZoneNotFound, err), errZoneLookup)
 	}
 
+	entitlements := cr.Status.AtProvider.Entitlements
+	drifted := cr.Status.AtProvider.DriftedSettings
+	imported := cr.Status.AtProvider.JumpStartRecords
 	cr.Status.AtProvider = zones.GenerateObservation(z)
+	cr.Status.AtProvider.JumpStartRecords = imported
+
+	// Not every token may read the entitlements of a zone, so keep the
+	// last ones observed rather than failing to observe the zone.
+	if ent, err := zones.Entitlements(ctx, e.client, z); err == nil {
+		entitlements = ent
+	}
+	cr.Status.AtProvider.Entitlements = entitlements
 
 	if cr.Status.AtProvider.Status == zoneStatusActive {
 		cr.Status.SetConditions(rtv1.Available())
@@ -152,16 +258,49 @@ func (e *external) Observe(ctx context.Context,
 		cr.Status.SetConditions(rtv1.Unavailable())
 	}
 
+	// A Zone cannot be renamed, so a changed name either leaves the Zone
+	// alone or replaces it.
+	switch replacement.Decide(cr, replacement.Change{Field: "spec.forProvider.name", Observed: z.Name, Desired: cr.Spec.ForProvider.Name}, cr.Spec.ForProvider.AllowRecreate) {
+	case replacement.Blocked:
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: zones.ConnectionDetails(z)}, nil
+	case replacement.Replace:
+		if _, err := e.Delete(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errZoneReplacement)
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
 	observedSettings := &v1alpha1.ZoneSettings{}
 	if err := zones.LoadSettingsForZone(ctx, e.client, z.ID, observedSettings); err != nil {
-		return managed.ExternalObservation{ResourceExists: true},
+		return managed.ExternalObservation{ResourceExists: true, ConnectionDetails: zones.ConnectionDetails(z)},
 			errors.Wrap(err, errZoneObservation)
 	}
+	cr.Status.AtProvider.SecurityLevel = ptr.Deref(observedSettings.SecurityLevel, "")
+	cr.Status.AtProvider.UnderAttackMode = cr.Status.AtProvider.SecurityLevel == zones.SecurityLevelUnderAttack
+
+	// Settings inherited from a template are compared with the zone like
+	// its own settings, but never late initialized into its spec, so that
+	// later changes of the template still apply.
+	inherited, err := zones.InheritedSettings(ctx, e.kube, cr.Spec.ForProvider.SettingsTemplateRef)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: true, ConnectionDetails: zones.ConnectionDetails(z)},
+			errors.Wrap(err, errZoneTemplate)
+	}
+	li := zones.LateInitializeInherited(&cr.Spec.ForProvider, z, observedSettings, inherited)
+	desired := zones.WithInheritedSettings(&cr.Spec.ForProvider, inherited)
+
+	// Settings whose remediation policy is Alert are reported when they
+	// start drifting, rather than reverted.
+	cr.Status.AtProvider.DriftedSettings = zones.DriftedSettings(desired, observedSettings)
+	if d := cr.Status.AtProvider.DriftedSettings; len(d) > 0 && !slices.Equal(d, drifted) && e.recorder != nil {
+		e.recorder.Event(cr, event.Warning(reasonSettingsDrift, errors.Errorf("Zone settings changed outside of Crossplane: %s", strings.Join(d, ", "))))
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceLateInitialized: zones.LateInitialize(&cr.Spec.ForProvider, z, observedSettings),
-		ResourceUpToDate:        zones.UpToDate(&cr.Spec.ForProvider, z, observedSettings),
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        zones.UpToDate(desired, z, observedSettings),
+		ConnectionDetails:       zones.ConnectionDetails(z),
 	}, nil
 }
 
@@ -183,6 +322,20 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
+	// Check the zone quota of the account up front, so that an exhausted
+	// quota is reported as such rather than as a failed create. Not every
+	// token may read the entitlements of an account, in which case the
+	// create is attempted regardless.
+	if account.ID != "" {
+		if q, err := zones.AccountZoneQuota(ctx, e.client, account.ID); err == nil && q != nil {
+			metrics.SetAccountZoneQuotaRemaining(account.ID, q.Remaining())
+			if q.Remaining() == 0 {
+				cr.SetConditions(quotaExceeded(*q))
+				return managed.ExternalCreation{}, errors.New(errZoneQuota)
+			}
+		}
+	}
+
 	// This has a default set by CRD, so should not happen,
 	// but we sanity check anyway to avoid a nil pointer
 	// dereference calling CreateZone below.
@@ -202,10 +355,35 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.Status.AtProvider = zones.GenerateObservation(z)
+	if cr.GetCondition(typeQuotaExceeded).Status == corev1.ConditionTrue {
+		cr.SetConditions(quotaAvailable())
+	}
 
 	meta.SetExternalName(cr, z.ID)
 
-	return managed.ExternalCreation{}, nil
+	if cr.Spec.ForProvider.JumpStart {
+		e.reportJumpStart(ctx, cr, z.ID)
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: zones.ConnectionDetails(z)}, nil
+}
+
+// reportJumpStart records the DNS records Cloudflare imported into a newly
+// created zone, which are not managed by Crossplane, so that operators can
+// audit them or adopt them as Records. The zone exists by now, so failing
+// to list them is only reported.
+func (e *external) reportJumpStart(ctx context.Context, cr *v1alpha1.Zone, zoneID string) {
+	recs, err := zones.JumpStartRecords(ctx, e.client, zoneID)
+	if err != nil {
+		if e.recorder != nil {
+			e.recorder.Event(cr, event.Warning(reasonJumpStartRecords, errors.Wrap(err, errJumpStartRecords)))
+		}
+		return
+	}
+	cr.Status.AtProvider.JumpStartRecords = recs
+	if e.recorder != nil {
+		e.recorder.Event(cr, event.Normal(reasonJumpStartRecords, fmt.Sprintf("Cloudflare imported %d DNS records by jump start; they are listed in status.atProvider.jumpStartRecords", len(recs))))
+	}
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -220,12 +398,17 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errZoneUpdate)
 	}
 
+	inherited, err := zones.InheritedSettings(ctx, e.kube, cr.Spec.ForProvider.SettingsTemplateRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errZoneTemplate)
+	}
+
 	return managed.ExternalUpdate{}, errors.Wrap(
 		zones.UpdateZone(
 			ctx,
 			e.client,
 			zid,
-			cr.Spec.ForProvider,
+			*zones.WithInheritedSettings(&cr.Spec.ForProvider, inherited),
 		),
 		errZoneUpdate)
 }
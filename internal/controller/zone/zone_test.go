@@ -42,6 +42,7 @@ import (
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 	zones "github.com/rossigee/provider-cloudflare/internal/clients/zones"
 	"github.com/rossigee/provider-cloudflare/internal/clients/zones/fake"
+	"github.com/rossigee/provider-cloudflare/internal/controller/protection"
 )
 
 type zoneModifier func(*v1alpha1.Zone)
@@ -49,6 +50,14 @@ type zoneModifier func(*v1alpha1.Zone)
 func withAccount(sValue *string) zoneModifier {
 	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.AccountID = sValue }
 }
+func withAllowRecreate(allow *bool) zoneModifier {
+	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.AllowRecreate = allow }
+}
+func withDeletionProtection() zoneModifier {
+	return func(r *v1alpha1.Zone) {
+		meta.AddAnnotations(r, map[string]string{protection.AnnotationKeyDeletionProtection: "true"})
+	}
+}
 func withEdgeCacheTTL(sValue *int64) zoneModifier {
 	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.Settings.EdgeCacheTTL = sValue }
 }
@@ -58,12 +67,21 @@ func withExternalName(zoneID string) zoneModifier {
 func withNS(sValue []string) zoneModifier {
 	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.VanityNameServers = sValue }
 }
+func withJumpStart() zoneModifier {
+	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.JumpStart = true }
+}
+func withName(name string) zoneModifier {
+	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.Name = name }
+}
 func withPaused(paused *bool) zoneModifier {
 	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.Paused = paused }
 }
 func withPlan(sValue *string) zoneModifier {
 	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.PlanID = sValue }
 }
+func withSettingsTemplate(name string) zoneModifier {
+	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.SettingsTemplateRef = &xpv1.Reference{Name: name} }
+}
 func withType(typ *string) zoneModifier {
 	return func(r *v1alpha1.Zone) { r.Spec.ForProvider.Type = typ }
 }
@@ -191,7 +209,15 @@ func TestObserve(t *testing.T) {
 		VanityNS: []string{"ns1.lele.com", "ns2.woowoo.org"},
 	}
 
+	template := test.NewMockGetFn(nil, func(obj client.Object) error {
+		t := obj.(*v1alpha1.ZoneSettingsTemplate)
+		t.Spec.Settings.EdgeCacheTTL = ptr.To[int64](7200)
+		t.Spec.Settings.ZeroRTT = ptr.To("on")
+		return nil
+	})
+
 	type fields struct {
+		kube   client.Reader
 		client zones.Client
 	}
 
@@ -265,6 +291,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+					},
 				},
 			},
 			args: args{
@@ -283,6 +312,7 @@ func TestObserve(t *testing.T) {
 				o: managed.ExternalObservation{
 					ResourceExists:          true,
 					ResourceUpToDate:        false,
+					ConnectionDetails:       zones.ConnectionDetails(cloudflare.Zone{NameServers: []string{"ns1.lele.com", "ns2.woowoo.org"}}),
 					ResourceLateInitialized: false,
 				},
 				err: nil,
@@ -303,6 +333,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+					},
 				},
 			},
 			args: args{
@@ -318,11 +351,99 @@ func TestObserve(t *testing.T) {
 				o: managed.ExternalObservation{
 					ResourceExists:          true,
 					ResourceUpToDate:        false,
+					ConnectionDetails:       zones.ConnectionDetails(cloudflare.Zone{NameServers: []string{"ns1.lele.com", "ns2.woowoo.org"}}),
 					ResourceLateInitialized: true,
 				},
 				err: nil,
 			},
 		},
+		"RenameBlocked": {
+			reason: "We should not attempt to update a Zone whose name changed unless recreation is allowed",
+			fields: fields{
+				client: fake.MockClient{
+					MockZoneDetails: func(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+						return cloudflare.Zone{ID: zoneID, Name: "example.com"}, nil
+					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+					},
+				},
+			},
+			args: args{
+				mg: zone(
+					withExternalName("1234beef"),
+					withName("example.org"),
+					withPaused(ptr.To(true)),
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: zones.ConnectionDetails(cloudflare.Zone{ID: "1234beef", Name: "example.com"}),
+				},
+			},
+		},
+		"RenameReplaced": {
+			reason: "We should delete a Zone whose name changed and report it as not existing when recreation is allowed",
+			fields: fields{
+				client: fake.MockClient{
+					MockZoneDetails: func(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+						return cloudflare.Zone{ID: zoneID, Name: "example.com"}, nil
+					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+					},
+					MockDeleteZone: func(ctx context.Context, zoneID string) (cloudflare.ZoneID, error) {
+						if zoneID != "1234beef" {
+							return cloudflare.ZoneID{}, errBoom
+						}
+						return cloudflare.ZoneID{ID: zoneID}, nil
+					},
+				},
+			},
+			args: args{
+				mg: zone(
+					withExternalName("1234beef"),
+					withName("example.org"),
+					withAllowRecreate(ptr.To(true)),
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RenameProtected": {
+			reason: "We should not delete a Zone whose name changed while deletion protection is enabled, even when recreation is allowed",
+			fields: fields{
+				client: fake.MockClient{
+					MockZoneDetails: func(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+						return cloudflare.Zone{ID: zoneID, Name: "example.com"}, nil
+					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+					},
+					MockDeleteZone: func(ctx context.Context, zoneID string) (cloudflare.ZoneID, error) {
+						return cloudflare.ZoneID{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: zone(
+					withExternalName("1234beef"),
+					withName("example.org"),
+					withAllowRecreate(ptr.To(true)),
+					withDeletionProtection(),
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: zones.ConnectionDetails(cloudflare.Zone{ID: "1234beef", Name: "example.com"}),
+				},
+			},
+		},
 		"Success": {
 			reason: "We should return ResourceLateInitialized: false and ResourceUpToDate: true when resource exactly matches remote",
 			fields: fields{
@@ -338,6 +459,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+					},
 				},
 			},
 			args: args{
@@ -355,16 +479,128 @@ func TestObserve(t *testing.T) {
 				o: managed.ExternalObservation{
 					ResourceExists:          true,
 					ResourceUpToDate:        true,
+					ConnectionDetails:       zones.ConnectionDetails(cloudflare.Zone{NameServers: []string{"ns1.lele.com", "ns2.woowoo.org"}}),
 					ResourceLateInitialized: false,
 				},
 				err: nil,
 			},
 		},
+		"ErrSettingsTemplate": {
+			reason: "We should return any errors resolving the settings template of a Zone",
+			fields: fields{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				client: fake.MockClient{
+					MockZoneDetails: func(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+						return testZone, nil
+					},
+					MockZoneSettings: func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+						return &cloudflare.ZoneSettingResponse{}, nil
+					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+					},
+				},
+			},
+			args: args{
+				mg: zone(
+					withExternalName("1234beef"),
+					withSettingsTemplate("baseline"),
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: zones.ConnectionDetails(cloudflare.Zone{NameServers: []string{"ns1.lele.com", "ns2.woowoo.org"}}),
+				},
+				err: errors.Wrap(errors.Wrapf(errBoom, "cannot get zone settings template %q", "baseline"), errZoneTemplate),
+			},
+		},
+		"SettingsTemplateDrift": {
+			reason: "We should return ResourceUpToDate: false without late initializing settings the Zone inherits from its template when they differ",
+			fields: fields{
+				kube: &test.MockClient{MockGet: template},
+				client: fake.MockClient{
+					MockZoneDetails: func(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+						return testZone, nil
+					},
+					MockZoneSettings: func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+						return &cloudflare.ZoneSettingResponse{
+							Result: []cloudflare.ZoneSetting{
+								{ID: "edge_cache_ttl", Value: 7200, Editable: true},
+								{ID: "0rtt", Value: "off", Editable: true},
+							},
+						}, nil
+					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+					},
+				},
+			},
+			args: args{
+				mg: zone(
+					withExternalName("1234beef"),
+					withPaused(ptr.To(true)),
+					withAccount(ptr.To("a1234")),
+					withPlan(ptr.To("a1235")),
+					withNS([]string{"ns1.lele.com", "ns2.woowoo.org"}),
+					withSettingsTemplate("baseline"),
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ConnectionDetails:       zones.ConnectionDetails(cloudflare.Zone{NameServers: []string{"ns1.lele.com", "ns2.woowoo.org"}}),
+					ResourceLateInitialized: false,
+				},
+			},
+		},
+		"SettingsTemplateOverride": {
+			reason: "We should return ResourceUpToDate: true when settings set on the Zone override those of its template",
+			fields: fields{
+				kube: &test.MockClient{MockGet: template},
+				client: fake.MockClient{
+					MockZoneDetails: func(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+						return testZone, nil
+					},
+					MockZoneSettings: func(ctx context.Context, zoneID string) (*cloudflare.ZoneSettingResponse, error) {
+						return &cloudflare.ZoneSettingResponse{
+							Result: []cloudflare.ZoneSetting{
+								{ID: "edge_cache_ttl", Value: 7200, Editable: true},
+								{ID: "0rtt", Value: "off", Editable: true},
+							},
+						}, nil
+					},
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{Result: []byte(`[]`)}, nil
+					},
+				},
+			},
+			args: args{
+				mg: zone(
+					withExternalName("1234beef"),
+					withPaused(ptr.To(true)),
+					withZeroRTT(ptr.To("off")),
+					withAccount(ptr.To("a1234")),
+					withPlan(ptr.To("a1235")),
+					withNS([]string{"ns1.lele.com", "ns2.woowoo.org"}),
+					withSettingsTemplate("baseline"),
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ConnectionDetails:       zones.ConnectionDetails(cloudflare.Zone{NameServers: []string{"ns1.lele.com", "ns2.woowoo.org"}}),
+					ResourceLateInitialized: false,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.fields.client}
+			e := external{kube: tc.fields.kube, client: tc.fields.client}
 			got, err := e.Observe(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -389,8 +625,9 @@ func TestCreate(t *testing.T) {
 	}
 
 	type want struct {
-		o   managed.ExternalCreation
-		err error
+		o        managed.ExternalCreation
+		imported []v1alpha1.JumpStartRecord
+		err      error
 	}
 
 	cases := map[string]struct {
@@ -425,6 +662,48 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errZoneCreation),
 			},
 		},
+		"ErrQuotaExceeded": {
+			reason: "We should not attempt to create a zone when the zone quota of its account is exhausted",
+			fields: fields{
+				client: fake.MockClient{
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						if endpoint == "/accounts/acct/entitlements" {
+							return cloudflare.RawResponse{Result: []byte(`[{"feature": {"key": "zones.max_count"}, "allocation": {"type": "max_count", "value": 2}}]`)}, nil
+						}
+						return cloudflare.RawResponse{ResultInfo: &cloudflare.ResultInfo{Total: 2}}, nil
+					},
+					MockCreateZone: func(ctx context.Context, name string, jumpstart bool, account cloudflare.Account, zoneType string) (cloudflare.Zone, error) {
+						return cloudflare.Zone{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: zone(withAccount(ptr.To("acct")), withType(ptr.To("full"))),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.New(errZoneQuota),
+			},
+		},
+		"QuotaUnreadable": {
+			reason: "We should create a zone when the zone quota of its account cannot be read",
+			fields: fields{
+				client: fake.MockClient{
+					MockRaw: func(ctx context.Context, method, endpoint string, data interface{}, headers http.Header) (cloudflare.RawResponse, error) {
+						return cloudflare.RawResponse{}, errBoom
+					},
+					MockCreateZone: func(ctx context.Context, name string, jumpstart bool, account cloudflare.Account, zoneType string) (cloudflare.Zone, error) {
+						return cloudflare.Zone{ID: "abcd", Name: name, Type: "full"}, nil
+					},
+				},
+			},
+			args: args{
+				mg: zone(withAccount(ptr.To("acct")), withType(ptr.To("full"))),
+			},
+			want: want{
+				o: managed.ExternalCreation{ConnectionDetails: zones.ConnectionDetails(cloudflare.Zone{ID: "abcd"})},
+			},
+		},
 		"Success": {
 			reason: "We should return ExternalNameAssigned: true and no error when a zone is created",
 			fields: fields{
@@ -444,10 +723,55 @@ func TestCreate(t *testing.T) {
 				mg: zone(withPaused(ptr.To(false)), withType(ptr.To("full"))),
 			},
 			want: want{
-				o: managed.ExternalCreation{},
+				o: managed.ExternalCreation{ConnectionDetails: zones.ConnectionDetails(cloudflare.Zone{ID: "abcd", NameServers: []string{"ns1.lele.com", "ns2.woowoo.org"}})},
 				err: nil,
 			},
 		},
+		"JumpStart": {
+			reason: "The DNS records imported into a zone created with jump start should be reported",
+			fields: fields{
+				client: fake.MockClient{
+					MockCreateZone: func(ctx context.Context, name string, jumpstart bool, account cloudflare.Account, zoneType string) (cloudflare.Zone, error) {
+						return cloudflare.Zone{ID: "abcd", Name: name, Type: "full"}, nil
+					},
+					MockListDNSRecords: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+						return []cloudflare.DNSRecord{
+							{ID: "mx", Type: "MX", Name: "example.com", Content: "mail.example.com", TTL: 3600, Priority: ptr.To[uint16](10)},
+							{ID: "a", Type: "A", Name: "example.com", Content: "192.0.2.1", TTL: 1, Proxied: ptr.To(true)},
+						}, nil, nil
+					},
+				},
+			},
+			args: args{
+				mg: zone(withJumpStart(), withType(ptr.To("full"))),
+			},
+			want: want{
+				o: managed.ExternalCreation{ConnectionDetails: zones.ConnectionDetails(cloudflare.Zone{ID: "abcd"})},
+				imported: []v1alpha1.JumpStartRecord{
+					{ID: "a", Type: "A", Name: "example.com", Content: "192.0.2.1", TTL: 1, Proxied: true},
+					{ID: "mx", Type: "MX", Name: "example.com", Content: "mail.example.com", TTL: 3600, Priority: ptr.To[int32](10)},
+				},
+			},
+		},
+		"JumpStartUnreadable": {
+			reason: "A zone created with jump start should be created even if its imported DNS records cannot be listed",
+			fields: fields{
+				client: fake.MockClient{
+					MockCreateZone: func(ctx context.Context, name string, jumpstart bool, account cloudflare.Account, zoneType string) (cloudflare.Zone, error) {
+						return cloudflare.Zone{ID: "abcd", Name: name, Type: "full"}, nil
+					},
+					MockListDNSRecords: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: zone(withJumpStart(), withType(ptr.To("full"))),
+			},
+			want: want{
+				o: managed.ExternalCreation{ConnectionDetails: zones.ConnectionDetails(cloudflare.Zone{ID: "abcd"})},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -460,6 +784,11 @@ func TestCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
+			if cr, ok := tc.args.mg.(*v1alpha1.Zone); ok {
+				if diff := cmp.Diff(tc.want.imported, cr.Status.AtProvider.JumpStartRecords); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want imported records, +got imported records:\n%s\n", tc.reason, diff)
+				}
+			}
 		})
 	}
 }
@@ -0,0 +1,507 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package expression checks the syntax of Cloudflare Rules language
+// expressions, as used by rulesets and firewall filters, so that invalid
+// expressions can be rejected with a precise position before they are sent
+// to the Cloudflare API.
+//
+// The checker is deliberately lenient about semantics: it does not know
+// which fields or functions exist or what types they have, and accepts
+// any well formed expression.
+package expression
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"unicode"
+)
+
+// An Error is a syntax error at a position in an expression.
+type Error struct {
+	// Line of the error, starting at 1.
+	Line int
+
+	// Column of the error, starting at 1.
+	Column int
+
+	// Message describing the error.
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+type kind int
+
+const (
+	tEOF kind = iota
+	tIdent
+	tString
+	tNumber
+	tIP
+	tList
+	tPunct
+)
+
+type token struct {
+	kind kind
+	text string
+	line int
+	col  int
+}
+
+func (t token) String() string {
+	if t.kind == tEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// comparison operators, in both their English and C-like notations.
+var comparisons = map[string]bool{
+	"eq": true, "ne": true, "lt": true, "le": true, "gt": true, "ge": true,
+	"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+	"contains": true, "matches": true, "~": true, "wildcard": true,
+}
+
+// Validate returns an *Error describing the first syntax error in the
+// supplied expression, or nil if it is well formed.
+func Validate(expr string) error {
+	toks, err := lex(expr)
+	if err != nil {
+		return err
+	}
+	p := &parser{toks: toks}
+	if p.peek().kind == tEOF {
+		return p.errorf(p.peek(), "expression is empty")
+	}
+	if err := p.expr(); err != nil {
+		return err
+	}
+	if t := p.peek(); t.kind != tEOF {
+		return p.errorf(t, "unexpected %s", t)
+	}
+	return nil
+}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) is(texts ...string) bool {
+	t := p.peek()
+	if t.kind != tPunct && t.kind != tIdent {
+		return false
+	}
+	for _, s := range texts {
+		if t.text == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.is(text) {
+		return p.errorf(p.peek(), "expected %q, found %s", text, p.peek())
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return &Error{Line: t.line, Column: t.col, Message: fmt.Sprintf(format, args...)}
+}
+
+// expr parses a logical expression. Operators are left associative;
+// their relative precedence does not affect whether an expression is
+// well formed.
+func (p *parser) expr() error {
+	if err := p.unary(); err != nil {
+		return err
+	}
+	for p.is("and", "&&", "or", "||", "xor", "^^") {
+		p.next()
+		if err := p.unary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parser) unary() error {
+	if p.is("not", "!") {
+		p.next()
+		return p.unary()
+	}
+	if p.is("(") {
+		p.next()
+		if err := p.expr(); err != nil {
+			return err
+		}
+		return p.expect(")")
+	}
+	return p.comparison()
+}
+
+func (p *parser) comparison() error {
+	if err := p.operand(); err != nil {
+		return err
+	}
+	switch {
+	case p.is("strict"):
+		p.next()
+		if err := p.expect("wildcard"); err != nil {
+			return err
+		}
+		return p.operand()
+	case p.is("in"):
+		p.next()
+		return p.set()
+	case p.peek().kind != tString && p.peek().kind != tNumber && comparisons[p.peek().text]:
+		p.next()
+		return p.operand()
+	}
+	return nil
+}
+
+// operand parses a literal, a field or a function call.
+func (p *parser) operand() error {
+	t := p.peek()
+	switch t.kind {
+	case tString, tNumber, tIP:
+		p.next()
+		return nil
+	case tIdent:
+		if isKeyword(t.text) && t.text != "true" && t.text != "false" {
+			return p.errorf(t, "unexpected %s, expected a field, function or value", t)
+		}
+		p.next()
+		if t.text == "true" || t.text == "false" {
+			return nil
+		}
+		if p.is("(") {
+			if err := p.call(); err != nil {
+				return err
+			}
+		}
+		return p.indexes()
+	case tEOF:
+		return p.errorf(t, "unexpected end of expression, expected a field, function or value")
+	}
+	return p.errorf(t, "unexpected %s, expected a field, function or value", t)
+}
+
+func (p *parser) call() error {
+	p.next() // (
+	if p.is(")") {
+		p.next()
+		return nil
+	}
+	for {
+		if err := p.expr(); err != nil {
+			return err
+		}
+		if !p.is(",") {
+			return p.expect(")")
+		}
+		p.next()
+	}
+}
+
+func (p *parser) indexes() error {
+	for p.is("[") {
+		p.next()
+		t := p.next()
+		if t.kind != tString && t.kind != tNumber && !(t.kind == tPunct && t.text == "*") {
+			return p.errorf(t, "unexpected %s, expected an index", t)
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// set parses the right hand side of an in comparison: an inline set of
+// values and ranges, or a reference to a list.
+func (p *parser) set() error {
+	if p.peek().kind == tList {
+		p.next()
+		return nil
+	}
+	open := p.peek()
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.is("}") {
+		t := p.next()
+		switch t.kind {
+		case tString, tNumber, tIP:
+		case tEOF:
+			return p.errorf(open, "unterminated set")
+		default:
+			return p.errorf(t, "unexpected %s in set", t)
+		}
+		if p.is("..") {
+			p.next()
+			if u := p.next(); u.kind != t.kind || t.kind == tString {
+				return p.errorf(u, "invalid range end %s", u)
+			}
+		}
+	}
+	p.next()
+	return nil
+}
+
+func isKeyword(s string) bool {
+	switch s {
+	case "and", "or", "xor", "not", "in", "strict", "true", "false":
+		return true
+	}
+	return comparisons[s]
+}
+
+// lexer splits an expression into tokens, tracking their position.
+type lexer struct {
+	src  []rune
+	pos  int
+	line int
+	col  int
+	toks []token
+}
+
+func lex(expr string) ([]token, error) {
+	l := &lexer{src: []rune(expr), line: 1, col: 1}
+	for {
+		l.skipSpace()
+		if l.pos >= len(l.src) {
+			l.toks = append(l.toks, token{kind: tEOF, line: l.line, col: l.col})
+			return l.toks, nil
+		}
+		if err := l.token(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (l *lexer) peekAt(i int) rune {
+	if l.pos+i >= len(l.src) {
+		return 0
+	}
+	return l.src[l.pos+i]
+}
+
+func (l *lexer) advance(n int) string {
+	s := string(l.src[l.pos : l.pos+n])
+	for _, r := range l.src[l.pos : l.pos+n] {
+		if r == '\n' {
+			l.line++
+			l.col = 1
+			continue
+		}
+		l.col++
+	}
+	l.pos += n
+	return s
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.src) && unicode.IsSpace(l.src[l.pos]) {
+		l.advance(1)
+	}
+}
+
+func (l *lexer) emit(k kind, n int) {
+	t := token{kind: k, line: l.line, col: l.col}
+	t.text = l.advance(n)
+	l.toks = append(l.toks, t)
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return &Error{Line: l.line, Column: l.col, Message: fmt.Sprintf(format, args...)}
+}
+
+func (l *lexer) token() error {
+	r := l.peekAt(0)
+	switch {
+	case r == '"':
+		return l.string()
+	case r == 'r' && (l.peekAt(1) == '"' || l.peekAt(1) == '#'):
+		return l.rawString()
+	case r == '$':
+		n := 1 + l.run(1, isIdentRune)
+		if n == 1 {
+			return l.errorf("expected a list name after %q", "$")
+		}
+		l.emit(tList, n)
+		return nil
+	case isDigit(r) || r == ':':
+		return l.address()
+	case isIdentStart(r):
+		n := l.run(0, isIdentRune)
+		if l.peekAt(n) == ':' && isHex(string(l.src[l.pos:l.pos+n])) {
+			return l.address()
+		}
+		// Fields are dotted identifiers, e.g. http.request.uri.path.
+		for l.peekAt(n) == '.' && isIdentStart(l.peekAt(n+1)) {
+			n += 1 + l.run(n+1, isIdentRune)
+		}
+		l.emit(tIdent, n)
+		return nil
+	}
+
+	for _, op := range []string{"==", "!=", "<=", ">=", "&&", "||", "^^", ".."} {
+		if strings.HasPrefix(string(l.src[l.pos:min(l.pos+2, len(l.src))]), op) {
+			l.emit(tPunct, 2)
+			return nil
+		}
+	}
+	if strings.ContainsRune("()[]{},*<>!~", r) {
+		l.emit(tPunct, 1)
+		return nil
+	}
+	return l.errorf("unexpected character %q", r)
+}
+
+// run returns the number of consecutive runes from offset i satisfying f.
+func (l *lexer) run(i int, f func(rune) bool) int {
+	n := 0
+	for l.pos+i+n < len(l.src) && f(l.src[l.pos+i+n]) {
+		n++
+	}
+	return n
+}
+
+func (l *lexer) string() error {
+	for n := 1; l.pos+n < len(l.src); n++ {
+		switch l.src[l.pos+n] {
+		case '\\':
+			n++
+		case '"':
+			l.emit(tString, n+1)
+			return nil
+		}
+	}
+	return l.errorf("unterminated string")
+}
+
+// rawString lexes r"..." and r#"..."#, where any number of # may be used
+// to allow quotes within the string.
+func (l *lexer) rawString() error {
+	hashes := l.run(1, func(r rune) bool { return r == '#' })
+	if l.peekAt(1+hashes) != '"' {
+		return l.errorf("expected %q to start raw string", `"`)
+	}
+	end := `"` + strings.Repeat("#", hashes)
+	rest := string(l.src[l.pos+2+hashes:])
+	i := strings.Index(rest, end)
+	if i < 0 {
+		return l.errorf("unterminated raw string")
+	}
+	l.emit(tString, 2+hashes+len([]rune(rest[:i]))+len(end))
+	return nil
+}
+
+// address lexes a number, an IPv4 or IPv6 address or a CIDR range. A
+// following .. starts a range and is not part of the address.
+func (l *lexer) address() error {
+	n := 0
+	for l.pos+n < len(l.src) {
+		r := l.src[l.pos+n]
+		if r == '.' && l.peekAt(n+1) == '.' {
+			break
+		}
+		if !isHexRune(r) && r != '.' && r != ':' && r != '/' {
+			break
+		}
+		n++
+	}
+	s := string(l.src[l.pos : l.pos+n])
+	switch {
+	case isNumber(s):
+		l.emit(tNumber, n)
+		return nil
+	case validAddress(s):
+		l.emit(tIP, n)
+		return nil
+	}
+	return l.errorf("invalid number or IP address %q", s)
+}
+
+func validAddress(s string) bool {
+	if strings.Contains(s, "/") {
+		_, _, err := net.ParseCIDR(s)
+		return err == nil
+	}
+	return net.ParseIP(s) != nil
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isDecimal(s string) bool {
+	for _, r := range s {
+		if !isDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isNumber returns true for integers and decimals such as 0.5.
+func isNumber(s string) bool {
+	i, f, ok := strings.Cut(s, ".")
+	return isDecimal(i) && (!ok || isDecimal(f))
+}
+
+func isHexRune(r rune) bool {
+	return isDigit(r) || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !isHexRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isIdentRune(r rune) bool {
+	return isIdentStart(r) || isDigit(r)
+}
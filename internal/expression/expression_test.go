@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		expr   string
+		want   error
+	}{
+		"Comparison": {
+			reason: "A simple comparison should be valid",
+			expr:   `http.host eq "example.com"`,
+		},
+		"Logical": {
+			reason: "Comparisons combined with logical operators in both notations should be valid",
+			expr:   `(http.request.uri.path contains "/admin" && not ip.src in {192.0.2.0/24 2001:db8::/32}) or cf.threat_score gt 10`,
+		},
+		"Functions": {
+			reason: "Function calls, indexes and raw strings should be valid",
+			expr:   `any(lower(http.request.headers.names[*])[*] == "x-api-key") and http.request.uri.path matches r#"^/api/"v\d+"#`,
+		},
+		"SetsAndLists": {
+			reason: "Ranges in sets and list references should be valid",
+			expr:   "tcp.dstport in {80 443 8000..8080}\nand ip.src in $office_ips\nand ssl",
+		},
+		"StrictWildcard": {
+			reason: "The strict wildcard operator should be valid",
+			expr:   `http.host strict wildcard "*.example.com"`,
+		},
+		"Empty": {
+			reason: "An empty expression should be invalid",
+			expr:   "  ",
+			want:   &Error{Line: 1, Column: 3, Message: "expression is empty"},
+		},
+		"MissingValue": {
+			reason: "A comparison without a value should report the position of the missing value",
+			expr:   `http.host eq and ssl`,
+			want:   &Error{Line: 1, Column: 14, Message: `unexpected "and", expected a field, function or value`},
+		},
+		"UnbalancedParentheses": {
+			reason: "A missing closing parenthesis should be reported at the end of the expression",
+			expr:   "(http.host eq \"a\"\n or ssl",
+			want:   &Error{Line: 2, Column: 8, Message: `expected ")", found end of expression`},
+		},
+		"UnterminatedString": {
+			reason: "An unterminated string should be reported at its start",
+			expr:   "ssl and\n  http.host eq \"example.com",
+			want:   &Error{Line: 2, Column: 16, Message: "unterminated string"},
+		},
+		"InvalidAddress": {
+			reason: "An invalid IP address should be reported",
+			expr:   `ip.src eq 192.0.2.300`,
+			want:   &Error{Line: 1, Column: 11, Message: `invalid number or IP address "192.0.2.300"`},
+		},
+		"UnterminatedSet": {
+			reason: "An unterminated set should be reported at its opening brace",
+			expr:   `tcp.dstport in {80 443`,
+			want:   &Error{Line: 1, Column: 16, Message: "unterminated set"},
+		},
+		"TrailingOperator": {
+			reason: "A trailing logical operator should be reported",
+			expr:   `ssl and`,
+			want:   &Error{Line: 1, Column: 8, Message: "unexpected end of expression, expected a field, function or value"},
+		},
+		"UnexpectedCharacter": {
+			reason: "Characters that are not part of the language should be reported",
+			expr:   `http.host eq "a" ; ssl`,
+			want:   &Error{Line: 1, Column: 18, Message: `unexpected character ';'`},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Validate(tc.expr)
+			if diff := cmp.Diff(tc.want, err); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -18,10 +18,16 @@ package metrics
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/rossigee/provider-cloudflare/internal/version"
 )
 
 var (
@@ -55,6 +61,70 @@ var (
 		},
 		[]string{"controller", "event"},
 	)
+	mutationWait = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "cloudflare_mutation_queue_wait_seconds",
+			Help:    "Time mutating Cloudflare API requests spent waiting for an in-flight slot.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+		},
+	)
+	rateLimitWait = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "cloudflare_rate_limit_wait_seconds",
+			Help:    "Time Cloudflare API requests spent waiting for the shared rate limit of their ProviderConfig, by controller.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+		},
+		[]string{"controller"},
+	)
+	rateLimitShed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cloudflare_rate_limit_shed_total",
+			Help: "Total Cloudflare API requests failed without waiting because too many of their controller's requests were waiting for the shared rate limit.",
+		},
+		[]string{"controller"},
+	)
+	externalDrift = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cloudflare_external_drift_total",
+			Help: "Total external resources observed to have been changed outside of Crossplane, by kind.",
+		},
+		[]string{"kind"},
+	)
+	domainExpiry = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cloudflare_registrar_domain_expiry_timestamp_seconds",
+			Help: "Time at which the registration of a Cloudflare Registrar domain expires, in seconds since the epoch.",
+		},
+		[]string{"domain"},
+	)
+	accountZoneQuota = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cloudflare_account_zone_quota_remaining",
+			Help: "Number of zones that may still be added to a Cloudflare account, as last seen when creating a zone.",
+		},
+		[]string{"account"},
+	)
+	lookupCache = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cloudflare_lookup_cache_requests_total",
+			Help: "Total zone and account lookups served by the lookup cache, by kind and result (hit or miss).",
+		},
+		[]string{"kind", "result"},
+	)
+	callTimeoutsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cloudflare_api_call_timeouts_total",
+			Help: "Total Cloudflare API requests abandoned because they exceeded the call timeout.",
+		},
+		[]string{"controller"},
+	)
+	buildInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cloudflare_provider_build_info",
+			Help: "Always 1, labelled with the version of the provider and of the cloudflare-go and Go it was built with.",
+		},
+		[]string{"version", "cloudflare_go_version", "go_version"},
+	)
 )
 
 // Init registers metric types that can be instrumented on
@@ -65,7 +135,68 @@ func init() {
 		reqTotal,
 		reqLatency,
 		reqEventsLatency,
+		mutationWait,
+		rateLimitWait,
+		rateLimitShed,
+		externalDrift,
+		domainExpiry,
+		accountZoneQuota,
+		lookupCache,
+		callTimeoutsTotal,
+		buildInfo,
 	)
+	buildInfo.WithLabelValues(version.Version, version.CloudflareGo(), version.Go()).Set(1)
+}
+
+// ObserveMutationWait records how long a mutating request waited for an
+// in-flight slot before being sent.
+func ObserveMutationWait(d time.Duration) {
+	mutationWait.Observe(d.Seconds())
+}
+
+// ObserveRateLimitWait records how long a request of the named controller
+// waited for a shared rate limit before being sent.
+func ObserveRateLimitWait(controller string, d time.Duration) {
+	rateLimitWait.WithLabelValues(controller).Observe(d.Seconds())
+}
+
+// RecordRateLimitShed counts a request of the named controller that failed
+// because too many of its requests were waiting for a shared rate limit.
+func RecordRateLimitShed(controller string) {
+	rateLimitShed.WithLabelValues(controller).Inc()
+}
+
+// RecordExternalDrift counts an external resource of the supplied kind
+// that was changed outside of Crossplane.
+func RecordExternalDrift(kind string) {
+	externalDrift.WithLabelValues(kind).Inc()
+}
+
+// SetDomainExpiry records when the registration of the supplied domain
+// expires.
+func SetDomainExpiry(domain string, t time.Time) {
+	domainExpiry.WithLabelValues(domain).Set(float64(t.Unix()))
+}
+
+// DeleteDomainExpiry stops reporting the expiry of the supplied domain.
+func DeleteDomainExpiry(domain string) {
+	domainExpiry.DeleteLabelValues(domain)
+}
+
+// SetAccountZoneQuotaRemaining records the number of zones that may still
+// be added to the supplied account.
+func SetAccountZoneQuotaRemaining(account string, n int64) {
+	accountZoneQuota.WithLabelValues(account).Set(float64(n))
+}
+
+// RecordLookupCache counts a lookup of the supplied kind that was a hit or
+// a miss of the lookup cache.
+func RecordLookupCache(kind string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	lookupCache.WithLabelValues(kind, result).Inc()
 }
 
 // NewInstrumentedHTTPClient returns a *http.Client that has
@@ -76,7 +207,10 @@ func NewInstrumentedHTTPClient(n string) *http.Client {
 	return &c
 }
 
-// InstrumentHTTPClient instruments an existing *http.Client.
+// InstrumentHTTPClient instruments an existing *http.Client, and bounds
+// each of its requests by the call timeout of the named controller. Each
+// request is traced as a span named by its method and path when tracing
+// is enabled.
 func InstrumentHTTPClient(hc *http.Client, n string) {
 	l := prometheus.Labels{"controller": n}
 
@@ -109,11 +243,17 @@ func InstrumentHTTPClient(hc *http.Client, n string) {
 		},
 	}
 
-	hc.Transport = promhttp.InstrumentRoundTripperInFlight(rif,
-		promhttp.InstrumentRoundTripperCounter(rt,
-			promhttp.InstrumentRoundTripperTrace(trace,
-				promhttp.InstrumentRoundTripperDuration(rl, http.DefaultTransport),
+	hc.Transport = withCallTimeout(n, otelhttp.NewTransport(
+		promhttp.InstrumentRoundTripperInFlight(rif,
+			promhttp.InstrumentRoundTripperCounter(rt,
+				promhttp.InstrumentRoundTripperTrace(trace,
+					promhttp.InstrumentRoundTripperDuration(rl, http.DefaultTransport),
+				),
 			),
 		),
-	)
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+		otelhttp.WithSpanOptions(oteltrace.WithAttributes(attribute.String("controller", n))),
+	))
 }
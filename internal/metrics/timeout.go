@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultCallTimeout is the default time a single Cloudflare API request
+// may take before it is abandoned.
+const DefaultCallTimeout = 30 * time.Second
+
+var (
+	// callTimeout bounds each API request. Zero or less disables it.
+	callTimeout = DefaultCallTimeout
+
+	// callTimeouts overrides callTimeout per controller.
+	callTimeouts = map[string]time.Duration{}
+)
+
+// SetCallTimeout sets the time a single Cloudflare API request may take
+// before it is abandoned. A value of zero or less disables the timeout. It
+// must be called before any controllers are set up.
+func SetCallTimeout(d time.Duration) {
+	callTimeout = d
+}
+
+// SetControllerCallTimeout overrides the call timeout of the named
+// controller, e.g. managed/script.workers.cloudflare.crossplane.io. It must
+// be called before any controllers are set up.
+func SetControllerCallTimeout(controller string, d time.Duration) {
+	callTimeouts[controller] = d
+}
+
+type callTimeoutKey struct{}
+
+// ContextWithCallTimeout returns a copy of the supplied context whose API
+// requests use the supplied call timeout instead of the global one, e.g.
+// that of a ProviderConfig's request policy. Controllers with their own
+// call timeout ignore it.
+func ContextWithCallTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, callTimeoutKey{}, d)
+}
+
+// timeoutFor returns the call timeout of the named controller.
+func timeoutFor(controller string) time.Duration {
+	if d, ok := callTimeouts[controller]; ok {
+		return d
+	}
+	return callTimeout
+}
+
+// timeoutRoundTripper is an http.RoundTripper that bounds each request by
+// its own deadline, rather than only by the reconcile it was made from, so
+// that a slow endpoint cannot hold a worker for the whole reconcile.
+type timeoutRoundTripper struct {
+	controller string
+	timeout    time.Duration
+
+	// fixed timeouts are those of a controller's override, which are not
+	// replaced by the call timeout of a request's context.
+	fixed bool
+
+	timeouts prometheus.Counter
+	next     http.RoundTripper
+}
+
+func (t *timeoutRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	d := t.timeout
+	if pd, ok := req.Context().Value(callTimeoutKey{}).(time.Duration); ok && !t.fixed {
+		d = pd
+	}
+	if d <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), d)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		// Only count requests abandoned because of our own deadline, not
+		// those whose reconcile was cancelled or timed out first.
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) && req.Context().Err() == nil {
+			t.timeouts.Inc()
+		}
+		cancel()
+		return nil, err
+	}
+
+	// The deadline must also cover reading the body, so it is released
+	// once the caller is done with it.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose releases the context of a request when its response body is
+// closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// withCallTimeout wraps the supplied RoundTripper in the call timeout of
+// the named controller. Requests are wrapped even when the timeout is
+// disabled, so that the call timeout of their context still applies.
+func withCallTimeout(n string, next http.RoundTripper) http.RoundTripper {
+	_, fixed := callTimeouts[n]
+	return &timeoutRoundTripper{controller: n, timeout: timeoutFor(n), fixed: fixed, timeouts: callTimeoutsTotal.WithLabelValues(n), next: next}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type roundTripperFn func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripperFn) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestTimeoutRoundTripper(t *testing.T) {
+	// slow waits for the request to be abandoned.
+	slow := roundTripperFn(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+	fast := roundTripperFn(func(req *http.Request) (*http.Response, error) {
+		if _, ok := req.Context().Deadline(); !ok {
+			return nil, context.Canceled
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	})
+
+	type want struct {
+		err      error
+		timeouts float64
+	}
+
+	cases := map[string]struct {
+		reason  string
+		next    http.RoundTripper
+		ctx     func() (context.Context, context.CancelFunc)
+		timeout time.Duration
+		fixed   bool
+		want    want
+	}{
+		"Success": {
+			reason: "Requests that finish in time should be bounded by a deadline and succeed",
+			next:   fast,
+			ctx:    func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+			want:   want{},
+		},
+		"TimedOut": {
+			reason: "Requests that exceed the call timeout should be abandoned and counted",
+			next:   slow,
+			ctx:    func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+			want:   want{err: context.DeadlineExceeded, timeouts: 1},
+		},
+		"ReconcileCancelled": {
+			reason: "Requests whose reconcile was cancelled should not be counted as timeouts",
+			next:   slow,
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			want: want{err: context.Canceled},
+		},
+		"ContextDisabled": {
+			reason: "Requests whose context disables the call timeout should not be bounded by a deadline",
+			next:   fast,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(ContextWithCallTimeout(context.Background(), 0))
+			},
+			want: want{err: context.Canceled},
+		},
+		"ContextEnabled": {
+			reason: "Requests whose context sets a call timeout should be abandoned after it even if the global timeout is disabled",
+			next:   slow,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(ContextWithCallTimeout(context.Background(), 10*time.Millisecond))
+			},
+			timeout: -1,
+			want:    want{err: context.DeadlineExceeded, timeouts: 1},
+		},
+		"FixedIgnoresContext": {
+			reason: "Controllers with their own call timeout should ignore that of the context",
+			next:   fast,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(ContextWithCallTimeout(context.Background(), 0))
+			},
+			fixed: true,
+			want:  want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := prometheus.NewCounter(prometheus.CounterOpts{Name: "timeouts"})
+			timeout := 10 * time.Millisecond
+			if tc.timeout != 0 {
+				timeout = tc.timeout
+			}
+			rt := &timeoutRoundTripper{timeout: timeout, fixed: tc.fixed, timeouts: c, next: tc.next}
+
+			ctx, cancel := tc.ctx()
+			defer cancel()
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.cloudflare.com/client/v4/zones", nil)
+			resp, err := rt.RoundTrip(req)
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.timeouts, testutil.ToFloat64(c)); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want timeouts, +got timeouts:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTimeoutFor(t *testing.T) {
+	defer func(d time.Duration, o map[string]time.Duration) { callTimeout, callTimeouts = d, o }(callTimeout, callTimeouts)
+	callTimeouts = map[string]time.Duration{}
+
+	SetCallTimeout(time.Minute)
+	SetControllerCallTimeout("managed/script.workers.cloudflare.crossplane.io", 0)
+
+	cases := map[string]struct {
+		reason     string
+		controller string
+		want       time.Duration
+	}{
+		"Default": {
+			reason:     "Controllers without an override should use the global call timeout",
+			controller: "managed/record.dns.cloudflare.crossplane.io",
+			want:       time.Minute,
+		},
+		"Override": {
+			reason:     "Controllers with an override should use it, even if it disables the timeout",
+			controller: "managed/script.workers.cloudflare.crossplane.io",
+			want:       0,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, timeoutFor(tc.controller)); diff != "" {
+				t.Errorf("\n%s\ntimeoutFor(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration rewrites stored Cloudflare resources at the storage
+// version of their CustomResourceDefinition, so that versions which are no
+// longer served can be dropped without stranding resources.
+package migration
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+const (
+	groupSuffix = "cloudflare.crossplane.io"
+
+	// pageSize is the number of objects listed at once.
+	pageSize = 500
+
+	errListCRDs        = "cannot list CustomResourceDefinitions"
+	errNoStorage       = "CustomResourceDefinition has no storage version"
+	errListFmt         = "cannot list %s"
+	errRewriteFmt      = "cannot rewrite %s %s"
+	errUpdateStoredFmt = "cannot update stored versions of CustomResourceDefinition %s"
+)
+
+// A Result reports the migration of the resources of one
+// CustomResourceDefinition.
+type Result struct {
+	// CRD is the name of the CustomResourceDefinition.
+	CRD string
+
+	// StoredVersions are the versions objects were stored at before the
+	// migration.
+	StoredVersions []string
+
+	// StorageVersion is the version objects are now stored at.
+	StorageVersion string
+
+	// Rewritten is the number of objects that were rewritten.
+	Rewritten int
+}
+
+// A Migrator rewrites stored Cloudflare resources at their storage version.
+type Migrator struct {
+	kube   client.Client
+	log    logging.Logger
+	dryRun bool
+}
+
+// An Option configures a Migrator.
+type Option func(*Migrator)
+
+// WithLogger configures the logger of a Migrator.
+func WithLogger(l logging.Logger) Option {
+	return func(m *Migrator) {
+		m.log = l
+	}
+}
+
+// WithDryRun configures a Migrator to count the objects that would be
+// rewritten without writing them.
+func WithDryRun(dryRun bool) Option {
+	return func(m *Migrator) {
+		m.dryRun = dryRun
+	}
+}
+
+// NewMigrator returns a Migrator that uses the supplied client, which must
+// be able to read and write CustomResourceDefinitions.
+func NewMigrator(c client.Client, o ...Option) *Migrator {
+	m := &Migrator{kube: c, log: logging.NewNopLogger()}
+	for _, fn := range o {
+		fn(m)
+	}
+	return m
+}
+
+// Migrate rewrites every object of each Cloudflare CustomResourceDefinition
+// that has objects stored at a version other than its storage version, then
+// records the storage version as the only stored version. Rewriting an
+// object through the API server converts and stores it at the storage
+// version. CustomResourceDefinitions that need no migration are skipped.
+func (m *Migrator) Migrate(ctx context.Context) ([]Result, error) {
+	l := &extv1.CustomResourceDefinitionList{}
+	if err := m.kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListCRDs)
+	}
+	sort.Slice(l.Items, func(i, j int) bool { return l.Items[i].GetName() < l.Items[j].GetName() })
+
+	results := []Result{}
+	for i := range l.Items {
+		crd := &l.Items[i]
+		if !strings.HasSuffix(crd.Spec.Group, groupSuffix) {
+			continue
+		}
+		storage := StorageVersion(crd)
+		if storage == "" {
+			return results, errors.Errorf("%s: %s", crd.GetName(), errNoStorage)
+		}
+		if !NeedsMigration(crd.Status.StoredVersions, storage) {
+			continue
+		}
+
+		r := Result{CRD: crd.GetName(), StoredVersions: crd.Status.StoredVersions, StorageVersion: storage}
+		n, err := m.rewrite(ctx, schema.GroupVersionKind{Group: crd.Spec.Group, Version: storage, Kind: crd.Spec.Names.Kind})
+		r.Rewritten = n
+		if err != nil {
+			return append(results, r), err
+		}
+		if !m.dryRun {
+			crd.Status.StoredVersions = []string{storage}
+			if err := m.kube.Status().Update(ctx, crd); err != nil {
+				return append(results, r), errors.Wrapf(err, errUpdateStoredFmt, crd.GetName())
+			}
+		}
+		m.log.Info("Migrated stored objects", "crd", r.CRD, "stored-versions", r.StoredVersions, "storage-version", storage, "rewritten", n, "dry-run", m.dryRun)
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// rewrite writes back every object of the supplied kind unchanged, and
+// returns the number of objects that were rewritten.
+func (m *Migrator) rewrite(ctx context.Context, gvk schema.GroupVersionKind) (int, error) {
+	n := 0
+	cont := ""
+	for {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := m.kube.List(ctx, l, client.Limit(pageSize), client.Continue(cont)); err != nil {
+			return n, errors.Wrapf(err, errListFmt, gvk.Kind)
+		}
+		for i := range l.Items {
+			if !m.dryRun {
+				if err := m.write(ctx, &l.Items[i]); err != nil {
+					return n, errors.Wrapf(err, errRewriteFmt, gvk.Kind, l.Items[i].GetName())
+				}
+			}
+			n++
+		}
+		if cont = l.GetContinue(); cont == "" {
+			return n, nil
+		}
+	}
+}
+
+// write updates the supplied object, getting it again if it changed since it
+// was listed. Objects deleted since they were listed are ignored.
+func (m *Migrator) write(ctx context.Context, o *unstructured.Unstructured) error {
+	first := true
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !first {
+			if err := m.kube.Get(ctx, client.ObjectKeyFromObject(o), o); err != nil {
+				return err
+			}
+		}
+		first = false
+		return m.kube.Update(ctx, o)
+	})
+	return client.IgnoreNotFound(err)
+}
+
+// StorageVersion returns the version objects of the supplied
+// CustomResourceDefinition are stored at.
+func StorageVersion(crd *extv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
+
+// NeedsMigration returns true if any of the supplied stored versions is not
+// the storage version.
+func NeedsMigration(stored []string, storage string) bool {
+	for _, v := range stored {
+		if v != storage {
+			return true
+		}
+	}
+	return false
+}
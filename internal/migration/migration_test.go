@@ -0,0 +1,209 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func crd(name, group string, stored ...string) extv1.CustomResourceDefinition {
+	return extv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: extv1.CustomResourceDefinitionSpec{
+			Group: group,
+			Names: extv1.CustomResourceDefinitionNames{Kind: "Zone", ListKind: "ZoneList"},
+			Versions: []extv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true, Storage: true},
+				{Name: "v1beta1", Served: true},
+			},
+		},
+		Status: extv1.CustomResourceDefinitionStatus{StoredVersions: stored},
+	}
+}
+
+func zone(name string) unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion("zone.cloudflare.crossplane.io/v1alpha1")
+	u.SetKind("Zone")
+	u.SetName(name)
+	return u
+}
+
+// list returns a MockListFn that returns the supplied CRDs, and the supplied
+// Zones in pages of two.
+func list(crds []extv1.CustomResourceDefinition, zones ...unstructured.Unstructured) test.MockListFn {
+	return func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+		switch l := obj.(type) {
+		case *extv1.CustomResourceDefinitionList:
+			l.Items = crds
+		case *unstructured.UnstructuredList:
+			lo := &client.ListOptions{}
+			lo.ApplyOptions(opts)
+			start := 0
+			if lo.Continue != "" {
+				start = 2
+			}
+			end := min(start+2, len(zones))
+			l.Items = zones[start:end]
+			if end < len(zones) {
+				l.SetContinue("next")
+			}
+		}
+		return nil
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	errBoom := errors.New("boom")
+	zoneCRD := "zones.zone.cloudflare.crossplane.io"
+	zoneGroup := "zone.cloudflare.crossplane.io"
+
+	type args struct {
+		kube   client.Client
+		dryRun bool
+	}
+	type want struct {
+		results []Result
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ListCRDsError": {
+			reason: "Errors listing CustomResourceDefinitions should be returned.",
+			args: args{
+				kube: &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errListCRDs),
+			},
+		},
+		"NothingToMigrate": {
+			reason: "CustomResourceDefinitions of other groups, or only stored at their storage version, should be skipped.",
+			args: args{
+				kube: &test.MockClient{
+					MockList:         list([]extv1.CustomResourceDefinition{crd("zones.example.org", "example.org", "v1alpha1", "v1beta1"), crd(zoneCRD, zoneGroup, "v1alpha1")}),
+					MockUpdate:       test.NewMockUpdateFn(errBoom),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errBoom),
+				},
+			},
+			want: want{
+				results: []Result{},
+			},
+		},
+		"Migrated": {
+			reason: "Every stored object should be rewritten across pages, and the storage version recorded as the only stored version.",
+			args: args{
+				kube: &test.MockClient{
+					MockList:   list([]extv1.CustomResourceDefinition{crd(zoneCRD, zoneGroup, "v1alpha1", "v1beta1")}, zone("a"), zone("b"), zone("c")),
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						if diff := cmp.Diff([]string{"v1alpha1"}, obj.(*extv1.CustomResourceDefinition).Status.StoredVersions); diff != "" {
+							return errors.Errorf("unexpected stored versions: %s", diff)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				results: []Result{{CRD: zoneCRD, StoredVersions: []string{"v1alpha1", "v1beta1"}, StorageVersion: "v1alpha1", Rewritten: 3}},
+			},
+		},
+		"DryRun": {
+			reason: "A dry run should count the objects that would be rewritten without writing anything.",
+			args: args{
+				kube: &test.MockClient{
+					MockList:         list([]extv1.CustomResourceDefinition{crd(zoneCRD, zoneGroup, "v1alpha1", "v1beta1")}, zone("a"), zone("b"), zone("c")),
+					MockUpdate:       test.NewMockUpdateFn(errBoom),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errBoom),
+				},
+				dryRun: true,
+			},
+			want: want{
+				results: []Result{{CRD: zoneCRD, StoredVersions: []string{"v1alpha1", "v1beta1"}, StorageVersion: "v1alpha1", Rewritten: 3}},
+			},
+		},
+		"DeletedObject": {
+			reason: "Objects deleted since they were listed should be ignored.",
+			args: args{
+				kube: &test.MockClient{
+					MockList:         list([]extv1.CustomResourceDefinition{crd(zoneCRD, zoneGroup, "v1beta1")}, zone("a")),
+					MockUpdate:       test.NewMockUpdateFn(kerrors.NewNotFound(schema.GroupResource{}, "a")),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+				},
+			},
+			want: want{
+				results: []Result{{CRD: zoneCRD, StoredVersions: []string{"v1beta1"}, StorageVersion: "v1alpha1", Rewritten: 1}},
+			},
+		},
+		"RewriteError": {
+			reason: "Errors rewriting an object should be returned without updating the stored versions.",
+			args: args{
+				kube: &test.MockClient{
+					MockList:         list([]extv1.CustomResourceDefinition{crd(zoneCRD, zoneGroup, "v1alpha1", "v1beta1")}, zone("a")),
+					MockUpdate:       test.NewMockUpdateFn(errBoom),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errors.New("stored versions must not be updated")),
+				},
+			},
+			want: want{
+				results: []Result{{CRD: zoneCRD, StoredVersions: []string{"v1alpha1", "v1beta1"}, StorageVersion: "v1alpha1"}},
+				err:     errors.Wrapf(errBoom, errRewriteFmt, "Zone", "a"),
+			},
+		},
+		"UpdateStoredVersionsError": {
+			reason: "Errors updating the stored versions should be returned.",
+			args: args{
+				kube: &test.MockClient{
+					MockList:         list([]extv1.CustomResourceDefinition{crd(zoneCRD, zoneGroup, "v1alpha1", "v1beta1")}, zone("a")),
+					MockUpdate:       test.NewMockUpdateFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errBoom),
+				},
+			},
+			want: want{
+				results: []Result{{CRD: zoneCRD, StoredVersions: []string{"v1alpha1", "v1beta1"}, StorageVersion: "v1alpha1", Rewritten: 1}},
+				err:     errors.Wrapf(errBoom, errUpdateStoredFmt, zoneCRD),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewMigrator(tc.args.kube, WithDryRun(tc.args.dryRun)).Migrate(context.Background())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nMigrate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.results, got); diff != "" {
+				t.Errorf("\n%s\nMigrate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
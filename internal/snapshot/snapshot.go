@@ -0,0 +1,195 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshot summarises the state of all Cloudflare managed resources
+// for audits and incident response.
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+// Path the snapshot is served at.
+const Path = "/debug/state"
+
+const (
+	groupSuffix = "cloudflare.crossplane.io"
+
+	errListFmt = "cannot list %s"
+)
+
+// A Resource summarises the state of a managed resource.
+type Resource struct {
+	APIVersion     string                 `json:"apiVersion"`
+	Kind           string                 `json:"kind"`
+	Name           string                 `json:"name"`
+	ExternalName   string                 `json:"externalName,omitempty"`
+	ProviderConfig string                 `json:"providerConfig,omitempty"`
+	Ready          corev1.ConditionStatus `json:"ready"`
+	Synced         corev1.ConditionStatus `json:"synced"`
+	Message        string                 `json:"message,omitempty"`
+	LastAPIError   *pcv1alpha1.APIError   `json:"lastAPIError,omitempty"`
+}
+
+// A Snapshot of the state of all managed resources.
+type Snapshot struct {
+	GeneratedAt metav1.Time    `json:"generatedAt"`
+	Total       int            `json:"total"`
+	Failing     int            `json:"failing"`
+	Kinds       map[string]int `json:"kinds"`
+	Resources   []Resource     `json:"resources"`
+}
+
+// Failing returns true if the resource is not ready or not synced.
+func (r Resource) Failing() bool {
+	return r.Ready != corev1.ConditionTrue || r.Synced != corev1.ConditionTrue
+}
+
+// ManagedKinds returns the list kinds of every Cloudflare managed resource
+// known to the supplied scheme. Resources served at several versions are
+// only listed at their storage (hub) version.
+func ManagedKinds(s *runtime.Scheme) []schema.GroupVersionKind {
+	kinds := []schema.GroupVersionKind{}
+	for gvk := range s.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Group, groupSuffix) || strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		o, err := s.New(gvk)
+		if err != nil {
+			continue
+		}
+		if _, ok := o.(resource.Managed); !ok {
+			continue
+		}
+		if _, spoke := o.(conversion.Convertible); spoke {
+			continue
+		}
+		list := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+		if s.Recognizes(list) {
+			kinds = append(kinds, list)
+		}
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i].String() < kinds[j].String() })
+	return kinds
+}
+
+// Summarise the supplied managed resource.
+func Summarise(mg resource.Managed, gvk schema.GroupVersionKind) Resource {
+	r := Resource{
+		APIVersion:   gvk.GroupVersion().String(),
+		Kind:         gvk.Kind,
+		Name:         mg.GetName(),
+		ExternalName: meta.GetExternalName(mg),
+		Ready:        mg.GetCondition(rtv1.TypeReady).Status,
+		Synced:       mg.GetCondition(rtv1.TypeSynced).Status,
+		Message:      mg.GetCondition(rtv1.TypeSynced).Message,
+	}
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		r.ProviderConfig = ref.Name
+	}
+
+	// The last API error is recorded in the observation of each kind, so
+	// it is read generically.
+	if u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg); err == nil {
+		if status, ok := u["status"].(map[string]interface{}); ok {
+			if at, ok := status["atProvider"].(map[string]interface{}); ok {
+				if e, ok := at["lastAPIError"].(map[string]interface{}); ok {
+					ae := &pcv1alpha1.APIError{}
+					if runtime.DefaultUnstructuredConverter.FromUnstructured(e, ae) == nil {
+						r.LastAPIError = ae
+					}
+				}
+			}
+		}
+	}
+	return r
+}
+
+// Take a snapshot of all managed resources. Only failing resources are
+// included if failing is true.
+func Take(ctx context.Context, c client.Reader, s *runtime.Scheme, failing bool) (*Snapshot, error) {
+	snap := &Snapshot{GeneratedAt: metav1.Now(), Kinds: map[string]int{}, Resources: []Resource{}}
+	for _, lk := range ManagedKinds(s) {
+		o, err := s.New(lk)
+		if err != nil {
+			return nil, errors.Wrapf(err, errListFmt, lk.Kind)
+		}
+		l, ok := o.(client.ObjectList)
+		if !ok {
+			continue
+		}
+		if err := c.List(ctx, l); err != nil {
+			return nil, errors.Wrapf(err, errListFmt, lk.Kind)
+		}
+		items, err := kmeta.ExtractList(l)
+		if err != nil {
+			return nil, errors.Wrapf(err, errListFmt, lk.Kind)
+		}
+		gvk := lk.GroupVersion().WithKind(strings.TrimSuffix(lk.Kind, "List"))
+		for _, i := range items {
+			mg, ok := i.(resource.Managed)
+			if !ok {
+				continue
+			}
+			r := Summarise(mg, gvk)
+			snap.Total++
+			snap.Kinds[gvk.Kind]++
+			if r.Failing() {
+				snap.Failing++
+			} else if failing {
+				continue
+			}
+			snap.Resources = append(snap.Resources, r)
+		}
+	}
+	return snap, nil
+}
+
+// NewHandler returns a handler that serves a JSON snapshot of all managed
+// resources. Pass ?failing=true to only include resources that are not
+// ready or not synced.
+func NewHandler(c client.Reader, s *runtime.Scheme) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		snap, err := Take(r.Context(), c, s, r.URL.Query().Get("failing") == "true")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(snap)
+	})
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis"
+	dnsv1alpha1 "github.com/rossigee/provider-cloudflare/apis/dns/v1alpha1"
+	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	pcv1alpha1 "github.com/rossigee/provider-cloudflare/apis/v1alpha1"
+)
+
+func record(name, externalName string, ready bool, apiErr *pcv1alpha1.APIError) dnsv1alpha1.Record {
+	r := dnsv1alpha1.Record{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	meta.SetExternalName(&r, externalName)
+	r.SetProviderConfigReference(&rtv1.Reference{Name: "default"})
+	r.Status.AtProvider.LastAPIError = apiErr
+	if ready {
+		r.SetConditions(rtv1.Available(), rtv1.ReconcileSuccess())
+	} else {
+		r.SetConditions(rtv1.Unavailable(), rtv1.ReconcileError(errors.New("boom")))
+	}
+	return r
+}
+
+func TestManagedKinds(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kinds := ManagedKinds(s)
+
+	has := map[string]bool{}
+	for _, k := range kinds {
+		has[k.String()] = true
+	}
+	if !has[dnsv1alpha1.SchemeGroupVersion.WithKind("RecordList").String()] {
+		t.Errorf("ManagedKinds(...): want the storage version of Records to be listed")
+	}
+	if has[dnsv1beta1.SchemeGroupVersion.WithKind("RecordList").String()] {
+		t.Errorf("ManagedKinds(...): want Records to be listed only at their storage version")
+	}
+	if has[pcv1alpha1.SchemeGroupVersion.WithKind("ProviderConfigList").String()] {
+		t.Errorf("ManagedKinds(...): want ProviderConfigs, which are not managed resources, not to be listed")
+	}
+}
+
+func TestTake(t *testing.T) {
+	errBoom := errors.New("boom")
+	apiErr := &pcv1alpha1.APIError{Code: 9109, Message: "Unauthorized"}
+
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	list := func(ctx context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		if l, ok := obj.(*dnsv1alpha1.RecordList); ok {
+			l.Items = []dnsv1alpha1.Record{
+				record("www", "rec-1", true, nil),
+				record("mail", "rec-2", false, apiErr),
+			}
+		}
+		return nil
+	}
+
+	www := Resource{APIVersion: "dns.cloudflare.crossplane.io/v1alpha1", Kind: "Record", Name: "www", ExternalName: "rec-1", ProviderConfig: "default", Ready: corev1.ConditionTrue, Synced: corev1.ConditionTrue}
+	mail := Resource{APIVersion: "dns.cloudflare.crossplane.io/v1alpha1", Kind: "Record", Name: "mail", ExternalName: "rec-2", ProviderConfig: "default", Ready: corev1.ConditionFalse, Synced: corev1.ConditionFalse, Message: "boom", LastAPIError: apiErr}
+
+	type want struct {
+		snap *Snapshot
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		list    test.MockListFn
+		failing bool
+		want    want
+	}{
+		"ListError": {
+			reason: "Errors listing resources should be returned",
+			list:   test.NewMockListFn(errBoom),
+			want:   want{err: errBoom},
+		},
+		"All": {
+			reason: "All managed resources should be summarised",
+			list:   list,
+			want: want{snap: &Snapshot{
+				Total: 2, Failing: 1, Kinds: map[string]int{"Record": 2},
+				Resources: []Resource{www, mail},
+			}},
+		},
+		"Failing": {
+			reason:  "Only failing resources should be summarised when requested, while still being counted",
+			list:    list,
+			failing: true,
+			want: want{snap: &Snapshot{
+				Total: 2, Failing: 1, Kinds: map[string]int{"Record": 2},
+				Resources: []Resource{mail},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Take(context.Background(), &test.MockClient{MockList: tc.list}, s, tc.failing)
+			if diff := cmp.Diff(tc.want.err, errors.Cause(err), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nTake(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.snap, got, cmpopts.IgnoreFields(Snapshot{}, "GeneratedAt"), cmpopts.IgnoreFields(pcv1alpha1.APIError{}, "Timestamp")); diff != "" {
+				t.Errorf("\n%s\nTake(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
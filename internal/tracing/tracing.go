@@ -0,0 +1,243 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing traces reconciles of managed resources, and the
+// Cloudflare API calls they make, with OpenTelemetry.
+package tracing
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/internal/version"
+)
+
+const (
+	// AnnotationTraceID is the annotation of the events emitted while a
+	// managed resource was reconciled that holds the ID of the trace of
+	// the reconcile.
+	AnnotationTraceID = "cloudflare.crossplane.io/trace-id"
+
+	// TracerName is the name of the tracer spans are started with.
+	TracerName = "github.com/rossigee/provider-cloudflare"
+
+	serviceName = "provider-cloudflare"
+
+	errNewExporter = "cannot create OTLP trace exporter"
+	errNewResource = "cannot describe the provider to the trace exporter"
+)
+
+// Setup exports traces over OTLP/HTTP, sampling the supplied ratio of
+// reconciles. The exporter is configured by the standard OTEL_EXPORTER_OTLP_*
+// environment variables. The returned function flushes and stops the
+// exporter.
+func Setup(ctx context.Context, sampleRatio float64) (func(context.Context) error, error) {
+	exp, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExporter)
+	}
+	res, err := sdkresource.Merge(sdkresource.Default(), sdkresource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", version.Version),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewResource)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// traces holds the IDs of the sampled traces of the managed resources
+// being reconciled, by UID, so that the events they emit can refer to
+// them. A managed resource is never reconciled concurrently.
+var traces sync.Map
+
+// TraceID returns the ID of the trace of the reconcile of the supplied
+// object that is in progress, if it is sampled.
+func TraceID(obj runtime.Object) (string, bool) {
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		return "", false
+	}
+	id, ok := traces.Load(o.GetUID())
+	if !ok {
+		return "", false
+	}
+	return id.(string), true
+}
+
+// NewConnecter wraps the supplied ExternalConnecter so that each reconcile
+// of a managed resource is traced as a span from Connect to Disconnect,
+// with a child span for each call of its external client. Calls to the
+// Cloudflare API made by the client are traced as children of those. The
+// trace ID of sampled reconciles is logged at debug level.
+func NewConnecter(c managed.ExternalConnecter, l logging.Logger) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c, log: l}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	log logging.Logger
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	kind := reflect.TypeOf(mg).Elem().Name()
+	rctx, span := tracer().Start(ctx, "Reconcile "+kind, trace.WithAttributes(
+		attribute.String("crossplane.kind", kind),
+		attribute.String("crossplane.name", mg.GetName()),
+		attribute.String("crossplane.external_name", meta.GetExternalName(mg)),
+		attribute.Int64("crossplane.generation", mg.GetGeneration()),
+	))
+	sc := span.SpanContext()
+	if sc.IsSampled() {
+		traces.Store(mg.GetUID(), sc.TraceID().String())
+	}
+
+	cctx, cspan := tracer().Start(rctx, "Connect")
+	ec, err := c.ExternalConnecter.Connect(cctx, mg)
+	end(cspan, err)
+	if err != nil {
+		end(span, err)
+		traces.Delete(mg.GetUID())
+		return nil, err
+	}
+
+	return &external{
+		ExternalClient: ec,
+		span:           span,
+		uid:            mg.GetUID(),
+		log:            c.log.WithValues("kind", kind, "name", mg.GetName()),
+		start:          time.Now(),
+	}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	span  trace.Span
+	uid   types.UID
+	log   logging.Logger
+	start time.Time
+}
+
+// child starts a span for a call of the external client, as a child of the
+// span of the reconcile.
+func (e *external) child(ctx context.Context, name string) (context.Context, trace.Span) {
+	return tracer().Start(trace.ContextWithSpan(ctx, e.span), name)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := e.child(ctx, "Observe")
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	span.SetAttributes(
+		attribute.Bool("crossplane.resource_exists", o.ResourceExists),
+		attribute.Bool("crossplane.resource_up_to_date", o.ResourceUpToDate),
+	)
+	end(span, err)
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := e.child(ctx, "Create")
+	c, err := e.ExternalClient.Create(ctx, mg)
+	end(span, err)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, span := e.child(ctx, "Update")
+	u, err := e.ExternalClient.Update(ctx, mg)
+	end(span, err)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	ctx, span := e.child(ctx, "Delete")
+	d, err := e.ExternalClient.Delete(ctx, mg)
+	end(span, err)
+	return d, err
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	err := e.ExternalClient.Disconnect(ctx)
+	if sc := e.span.SpanContext(); sc.IsSampled() {
+		e.log.Debug("Traced reconcile", "trace-id", sc.TraceID().String(), "duration", time.Since(e.start).String())
+	}
+	e.span.End()
+	traces.Delete(e.uid)
+	return err
+}
+
+// end ends the supplied span, recording the supplied error if it is not
+// nil.
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// NewRecorder wraps the supplied Recorder so that the events it emits
+// while a managed resource is reconciled are annotated with the ID of the
+// trace of the reconcile, if it is sampled.
+func NewRecorder(r event.Recorder) event.Recorder {
+	return &recorder{Recorder: r}
+}
+
+type recorder struct {
+	event.Recorder
+}
+
+func (r *recorder) Event(obj runtime.Object, e event.Event) {
+	if id, ok := TraceID(obj); ok {
+		r.Recorder.WithAnnotations(AnnotationTraceID, id).Event(obj, e)
+		return
+	}
+	r.Recorder.Event(obj, e)
+}
+
+func (r *recorder) WithAnnotations(keysAndValues ...string) event.Recorder {
+	return &recorder{Recorder: r.Recorder.WithAnnotations(keysAndValues...)}
+}
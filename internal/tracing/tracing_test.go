@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+// capturingRecorder records the annotations of the events it emits.
+type capturingRecorder struct {
+	annotations []string
+	events      *[][]string
+}
+
+func (r *capturingRecorder) Event(_ runtime.Object, _ event.Event) {
+	*r.events = append(*r.events, r.annotations)
+}
+
+func (r *capturingRecorder) WithAnnotations(keysAndValues ...string) event.Recorder {
+	return &capturingRecorder{annotations: append(append([]string{}, r.annotations...), keysAndValues...), events: r.events}
+}
+
+// span is the part of a finished span checked by tests.
+type span struct {
+	Name   string
+	Parent string
+	Error  bool
+}
+
+func TestConnecter(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		spans  []span
+		events [][]string
+	}
+
+	cases := map[string]struct {
+		reason     string
+		connectErr error
+		observeErr error
+		want       want
+	}{
+		"Reconcile": {
+			reason: "A reconcile should be traced as a span with children for each call, and its events annotated with its trace ID",
+			want: want{
+				spans: []span{
+					{Name: "Connect", Parent: "Reconcile Managed"},
+					{Name: "Observe", Parent: "Reconcile Managed"},
+					{Name: "Create", Parent: "Reconcile Managed"},
+					{Name: "Reconcile Managed"},
+				},
+				events: [][]string{{AnnotationTraceID, "trace"}, nil},
+			},
+		},
+		"ObserveError": {
+			reason:     "Errors of calls should be recorded on their spans",
+			observeErr: errBoom,
+			want: want{
+				spans: []span{
+					{Name: "Connect", Parent: "Reconcile Managed"},
+					{Name: "Observe", Parent: "Reconcile Managed", Error: true},
+					{Name: "Create", Parent: "Reconcile Managed"},
+					{Name: "Reconcile Managed"},
+				},
+				events: [][]string{{AnnotationTraceID, "trace"}, nil},
+			},
+		},
+		"ConnectError": {
+			reason:     "A reconcile that cannot connect should end with an error",
+			connectErr: errBoom,
+			want: want{
+				spans: []span{
+					{Name: "Connect", Parent: "Reconcile Managed", Error: true},
+					{Name: "Reconcile Managed", Error: true},
+				},
+				events: [][]string{nil, nil},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sr := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+			otel.SetTracerProvider(tp)
+			defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+			c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+				if tc.connectErr != nil {
+					return nil, tc.connectErr
+				}
+				return managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, tc.observeErr
+					},
+					CreateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, nil
+					},
+					DisconnectFn: func(ctx context.Context) error { return nil },
+				}, nil
+			}), logging.NewNopLogger())
+
+			var events [][]string
+			rec := NewRecorder(&capturingRecorder{events: &events})
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "example", UID: "uid"}}
+
+			ec, err := c.Connect(context.Background(), mg)
+			if err == nil {
+				_, _ = ec.Observe(context.Background(), mg)
+				_, _ = ec.Create(context.Background(), mg)
+				rec.Event(mg, event.Normal("Created", "created"))
+				_ = ec.Disconnect(context.Background())
+			} else {
+				rec.Event(mg, event.Warning("CannotConnect", err))
+			}
+			// Events emitted after the reconcile are not annotated.
+			rec.Event(mg, event.Normal("Later", "later"))
+
+			names := map[trace.SpanID]string{}
+			for _, s := range sr.Ended() {
+				names[s.SpanContext().SpanID()] = s.Name()
+			}
+			got := want{events: events}
+			for _, s := range sr.Ended() {
+				got.spans = append(got.spans, span{Name: s.Name(), Parent: names[s.Parent().SpanID()], Error: s.Status().Code == codes.Error})
+			}
+			// Trace IDs are random.
+			for _, e := range got.events {
+				if len(e) == 2 && e[1] != "" {
+					e[1] = "trace"
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nConnect(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRecorderUnsampled(t *testing.T) {
+	// Without a tracer provider reconciles are not sampled, so their events
+	// are not annotated.
+	c := NewConnecter(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		return managed.ExternalClientFns{DisconnectFn: func(ctx context.Context) error { return nil }}, nil
+	}), logging.NewNopLogger())
+
+	var events [][]string
+	rec := NewRecorder(&capturingRecorder{events: &events})
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "example", UID: "uid"}}
+
+	ec, err := c.Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	rec.Event(mg, event.Normal("Created", "created"))
+	_ = ec.Disconnect(context.Background())
+
+	if diff := cmp.Diff([][]string{nil}, events); diff != "" {
+		t.Errorf("Event(...): -want, +got:\n%s\n", diff)
+	}
+}
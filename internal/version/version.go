@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package version reports the version of the provider and of the libraries
+// it was built with.
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+const cloudflareGoModule = "github.com/cloudflare/cloudflare-go"
+
+// Version is the version of the provider. It is set at build time.
+var Version = "unknown"
+
+// CloudflareGo returns the version of cloudflare-go the provider was built
+// with, or "unknown" if it cannot be read from the build information.
+func CloudflareGo() string {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	return moduleVersion(bi, cloudflareGoModule)
+}
+
+// Go returns the version of Go the provider was built with.
+func Go() string {
+	return runtime.Version()
+}
+
+// moduleVersion returns the version of the supplied dependency, following
+// replacements, or "unknown" if it is not a dependency.
+func moduleVersion(bi *debug.BuildInfo, path string) string {
+	for _, d := range bi.Deps {
+		if d.Path != path {
+			continue
+		}
+		if d.Replace != nil {
+			return d.Replace.Version
+		}
+		return d.Version
+	}
+	return "unknown"
+}
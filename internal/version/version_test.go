@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestModuleVersion(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		deps   []*debug.Module
+		want   string
+	}{
+		"NotADependency": {
+			reason: "The version of a module that is not a dependency should be unknown.",
+			deps:   []*debug.Module{{Path: "github.com/pkg/errors", Version: "v0.9.1"}},
+			want:   "unknown",
+		},
+		"Dependency": {
+			reason: "The version of a dependency should be returned.",
+			deps:   []*debug.Module{{Path: cloudflareGoModule, Version: "v0.115.0"}},
+			want:   "v0.115.0",
+		},
+		"Replaced": {
+			reason: "The version of the replacement of a dependency should be returned.",
+			deps:   []*debug.Module{{Path: cloudflareGoModule, Version: "v0.115.0", Replace: &debug.Module{Path: "example.org/cloudflare-go", Version: "v0.115.1"}}},
+			want:   "v0.115.1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := moduleVersion(&debug.BuildInfo{Deps: tc.deps}, cloudflareGoModule)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nmoduleVersion(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}